@@ -3,22 +3,36 @@ package main
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/trezcool/masomo/core"
 	"github.com/trezcool/masomo/core/user"
 )
 
-// addUser updates or creates a user.User
+// cliActor is the actor of the audited actions of the CLI
+var cliActor = core.AuditActor{UserAgent: "admin-cli"}
+
+// addUser updates or creates a user.User, and records it in the audit log
 func (cli *commandLine) addUser(uname, email, pwd string, isAdmin bool) error {
 	var usr user.User
-	var err error
+	var before interface{} // nil for a new user
 	ctx := context.Background()
 	uname = core.CleanString(uname, true /* lower */)
 	email = core.CleanString(email, true /* lower */)
 
-	if usr, err = cli.usrRepo.GetUser(ctx, user.GetFilter{UsernameOrEmail: []string{uname, email}}); err != nil {
+	tx, err := cli.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	action := core.AuditUserUpdate
+	if usr, err = cli.usrRepo.GetUser(ctx, user.GetFilter{UsernameOrEmail: []string{uname, email}}, tx); err == nil {
+		before = usr
+	} else {
 		if err != user.ErrNotFound {
+			_ = tx.Rollback()
 			return err
 		}
+		action = core.AuditUserCreate
 		usr = user.User{
 			Username: uname,
 			Email:    email,
@@ -28,11 +42,26 @@ func (cli *commandLine) addUser(uname, email, pwd string, isAdmin bool) error {
 		usr.Roles = user.AllRoles
 	}
 	usr.SetActive(true)
-	if err := usr.SetPassword(pwd); err != nil {
+	if err = usr.SetPassword(pwd); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if usr, err = cli.usrRepo.UpdateOrCreateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	if _, err := cli.usrRepo.UpdateOrCreateUser(ctx, usr); err != nil {
+
+	changes, err := core.AuditDiff(before, usr)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if before != nil {
+		changes["password"] = core.AuditChange{Before: core.AuditRedacted, After: core.AuditRedacted}
+	}
+	if err = cli.auditSvc.Record(ctx, core.NewAuditEntry(cliActor, action, usr.ID, changes), tx); err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	return nil
+	return errors.Wrap(tx.Commit(), "committing transaction")
 }
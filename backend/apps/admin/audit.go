@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const dateLayout = "2006-01-02"
+
+// listAudit prints the most recent audit entries of a User, as actor or target; of all Users when uname is empty
+func (cli *commandLine) listAudit(uname, action, since string, limit int) error {
+	f := audit.Filter{Action: action, Limit: limit}
+	if since != "" {
+		t, err := time.ParseInLocation(dateLayout, since, time.Local)
+		if err != nil {
+			return errors.Errorf("invalid date %q; eg. 2026-09-01", since)
+		}
+		f.Since = t
+	}
+	if uname != "" {
+		// deleted & erased Users are only found by ID
+		if _, err := uuid.Parse(uname); err == nil {
+			f.UserID = uname
+		} else {
+			uname = core.CleanString(uname, true /* lower */)
+			usr, err := cli.usrRepo.GetUser(context.Background(), user.GetFilter{UsernameOrEmail: []string{uname}})
+			if err != nil {
+				return err
+			}
+			f.UserID = usr.ID
+		}
+	}
+	es, err := cli.auditSvc.Query(f)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tACTION\tACTOR\tTARGET\tCHANGES\tIP\tUSER AGENT")
+	for _, e := range es {
+		fields := make([]string, 0, len(e.Changes))
+		for k := range e.Changes {
+			fields = append(fields, k)
+		}
+		sort.Strings(fields)
+		actor := e.ActorID
+		if actor == "" {
+			actor = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.CreatedAt.Local().Format(time.RFC3339), e.Action, actor, e.TargetID, strings.Join(fields, ","), e.IP, e.UserAgent)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/subscription"
+)
+
+// newPayment records a manual payment for a School, e.g. a bank transfer
+func (cli *commandLine) newPayment(schoolID string, np subscription.NewPayment) error {
+	if err := np.Validate(cli.validate); err != nil {
+		return err
+	}
+	sub, p, err := cli.subSvc.NewPayment(schoolID, np)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("payment %s recorded: school %s paid until %s\n", p.ID, schoolID, sub.PaymentDueDate.Format("2006-01-02"))
+	return nil
+}
+
+// paymentWarning warns the owners of the Schools past due; meant to run periodically
+func (cli *commandLine) paymentWarning() error {
+	n, err := cli.subSvc.SendWarnings(time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d payment warning(s) sent\n", n)
+	return nil
+}
+
+// deactivateSchool deactivates the Schools whose payment extension date is reached; meant to run daily
+func (cli *commandLine) deactivateSchool() error {
+	n, err := cli.subSvc.DeactivateExpired(time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d school(s) deactivated\n", n)
+	return nil
+}
+
+// parseAmount parses a decimal amount, e.g. "120.50", into cents
+func parseAmount(s string) (int, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f <= 0 {
+		return 0, errors.Errorf("invalid amount %q", s)
+	}
+	return int(math.Round(f * 100)), nil
+}
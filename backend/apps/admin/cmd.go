@@ -4,13 +4,21 @@ import (
 	"database/sql"
 	"flag"
 	"fmt"
+	"strings"
 	"syscall"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 	"golang.org/x/term"
 
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/subscription"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 )
 
 var (
@@ -24,13 +32,52 @@ var (
 	resetPasswordUname = resetPasswordCmd.String("username", "", "The user's username or email. The password will be prompted next")
 	readPasswordFunc   = term.ReadPassword // mockable
 
+	disable2FACmd   = flag.NewFlagSet("disable2fa", flag.ExitOnError)
+	disable2FAUname = disable2FACmd.String("username", "", "The user's username or email")
+
+	newPaymentCmd       = flag.NewFlagSet("newpayment", flag.ExitOnError)
+	newPaymentSchool    = newPaymentCmd.String("school", "", "The school's ID")
+	newPaymentPeriod    = newPaymentCmd.String("period", subscription.PeriodYear, "The period paid for: Y (year) or M (month)")
+	newPaymentAmount    = newPaymentCmd.String("amount", "", "The amount paid; eg. 120.50")
+	newPaymentCurrency  = newPaymentCmd.String("currency", "USD", "The currency of the amount")
+	newPaymentReference = newPaymentCmd.String("reference", "", "The reference of the payment; eg. bank transfer ID")
+	newPaymentOwner     = newPaymentCmd.String("owner", "", "The email of the school's owner, receiving receipts & payment warnings")
+	newPaymentOwnerName = newPaymentCmd.String("ownername", "", "The name of the school's owner")
+
+	jobsListCmd    = flag.NewFlagSet("jobs list", flag.ExitOnError)
+	jobsListName   = jobsListCmd.String("name", "", "Only list the jobs with this name")
+	jobsListStatus = jobsListCmd.String("status", "", "Only list the jobs with these statuses, comma-separated; eg. pending,failed")
+	jobsListLimit  = jobsListCmd.Int("limit", 50, "The maximum number of jobs listed")
+
+	walletAwardsCmd    = flag.NewFlagSet("walletawards", flag.ExitOnError)
+	walletAwardsSchool = walletAwardsCmd.String("school", "", "The school's ID")
+	walletAwardsYear   = walletAwardsCmd.Int("year", 0, "The academic year; eg. 2026 for 2026-2027")
+	walletAwardsTerm   = walletAwardsCmd.String("term", "", "The ID of the final term of the year")
+	walletAwardsTop    = walletAwardsCmd.Int("top", wallet.DefaultTopStudents, "The number of students awarded per class")
+
+	auditCmd    = flag.NewFlagSet("audit", flag.ExitOnError)
+	auditUser   = auditCmd.String("user", "", "Only list the actions of or on this user: username, email or ID")
+	auditAction = auditCmd.String("action", "", "Only list this action; eg. user.update")
+	auditSince  = auditCmd.String("since", "", "Only list the actions since this date; eg. 2026-09-01")
+	auditLimit  = auditCmd.Int("limit", audit.DefaultLimit, "The maximum number of actions listed")
+
+	jwtKeysRotateCmd = flag.NewFlagSet("jwtkeys rotate", flag.ExitOnError)
+	jwtKeysRotateAlg = jwtKeysRotateCmd.String("alg", "", "The signing algorithm of the new key: RS256 or EdDSA; defaults to the configured one")
+
 	errHelp = errors.New("help provided")
 )
 
 type commandLine struct {
-	db      *sql.DB
-	conf    *core.Config
-	usrRepo user.Repository
+	db        *sql.DB
+	conf      *core.Config
+	validate  *validator.Validate
+	usrRepo   user.Repository
+	subSvc    subscription.ServiceInterface
+	jobSvc    job.ServiceInterface
+	walletSvc wallet.ServiceInterface
+	keySvc    jwtkey.ServiceInterface
+	tfRepo    twofactor.Repository
+	auditSvc  audit.ServiceInterface
 }
 
 func (cli *commandLine) printUsage() {
@@ -55,7 +102,7 @@ func (cli *commandLine) run(args []string) error {
 		return errHelp
 	}
 
-	switch args[1] {
+	switch strings.ToLower(args[1]) { // eg. newPayment
 	case "createdb":
 		return cli.createDB()
 
@@ -102,6 +149,124 @@ func (cli *commandLine) run(args []string) error {
 		}
 		return cli.resetPassword(*resetPasswordUname, pwd)
 
+	case "disable2fa":
+		if err := disable2FACmd.Parse(args[2:]); err != nil {
+			return err
+		}
+		if *disable2FAUname == "" {
+			disable2FACmd.Usage()
+			return errHelp
+		}
+		return cli.disable2FA(*disable2FAUname)
+
+	case "newpayment":
+		if err := newPaymentCmd.Parse(args[2:]); err != nil {
+			return err
+		}
+		if *newPaymentSchool == "" || *newPaymentAmount == "" {
+			newPaymentCmd.Usage()
+			return errHelp
+		}
+		amount, err := parseAmount(*newPaymentAmount)
+		if err != nil {
+			return err
+		}
+		return cli.newPayment(*newPaymentSchool, subscription.NewPayment{
+			Period:     *newPaymentPeriod,
+			Amount:     amount,
+			Currency:   strings.ToUpper(*newPaymentCurrency),
+			Reference:  *newPaymentReference,
+			OwnerName:  *newPaymentOwnerName,
+			OwnerEmail: *newPaymentOwner,
+		})
+
+	case "paymentwarning":
+		return cli.paymentWarning()
+
+	case "deactivateschool":
+		return cli.deactivateSchool()
+
+	case "jobs":
+		return cli.runJobs(args[2:])
+
+	case "jwtkeys":
+		return cli.runJWTKeys(args[2:])
+
+	case "audit":
+		if err := auditCmd.Parse(args[2:]); err != nil {
+			return err
+		}
+		return cli.listAudit(*auditUser, *auditAction, *auditSince, *auditLimit)
+
+	case "walletawards":
+		if err := walletAwardsCmd.Parse(args[2:]); err != nil {
+			return err
+		}
+		if *walletAwardsSchool == "" || *walletAwardsYear == 0 || *walletAwardsTerm == "" {
+			walletAwardsCmd.Usage()
+			return errHelp
+		}
+		return cli.walletAwards(*walletAwardsSchool, *walletAwardsYear, *walletAwardsTerm, *walletAwardsTop)
+
+	default:
+		cli.printUsage()
+		return errHelp
+	}
+}
+
+func (cli *commandLine) runJobs(args []string) error {
+	if len(args) == 0 {
+		cli.printUsage()
+		return errHelp
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "list":
+		if err := jobsListCmd.Parse(args[1:]); err != nil {
+			return err
+		}
+		return cli.listJobs(*jobsListName, *jobsListStatus, *jobsListLimit)
+
+	case "run":
+		if len(args) < 2 {
+			cli.printUsage()
+			return errHelp
+		}
+		return cli.runJob(args[1])
+
+	case "cancel":
+		if len(args) < 2 {
+			cli.printUsage()
+			return errHelp
+		}
+		return cli.cancelJob(args[1])
+
+	default:
+		cli.printUsage()
+		return errHelp
+	}
+}
+
+func (cli *commandLine) runJWTKeys(args []string) error {
+	if len(args) == 0 {
+		cli.printUsage()
+		return errHelp
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "list":
+		return cli.listJWTKeys()
+
+	case "rotate":
+		if err := jwtKeysRotateCmd.Parse(args[1:]); err != nil {
+			return err
+		}
+		alg := *jwtKeysRotateAlg
+		if alg == "" {
+			alg = cli.conf.Server.JWTAlgorithm
+		}
+		return cli.rotateJWTKey(alg)
+
 	default:
 		cli.printUsage()
 		return errHelp
@@ -131,5 +296,34 @@ Commands:
                                                           Optionally make them an admin
 
   resetpassword -username USERNAME|EMAIL                  Reset user's password
+
+  disable2fa -username USERNAME|EMAIL                     Disable the two-factor authentication of a user who lost
+                                                          their authenticator app & backup codes
+
+  newpayment -school ID -amount AMOUNT [-period Y|M] [-currency USD] [-reference REF] [-owner EMAIL] [-ownername NAME]
+                            Record a manual payment: extend the school's subscription,
+                            reactivate it if needed and thank its owner
+  paymentwarning            Warn the owners of schools past due (run hourly by the sidecar)
+  deactivateschool          Deactivate schools whose payment extension date is reached (run daily by the sidecar)
+
+  jobs                      Manage the background jobs run by the sidecar
+    list [-name NAME] [-status STATUS[,STATUS]] [-limit 50]
+                            List the most recent jobs
+    run NAME                Queue a job to run now: publishAnnouncements, paymentWarning, deactivateSchool, eraseUsers
+                            or purgeAuditLog
+    cancel ID               Cancel a pending job
+
+  jwtkeys                   Manage the keys signing the access tokens (JWT)
+    list                    List the keys verifying tokens: the active one & those retired during the grace period
+    rotate [-alg RS256|EdDSA]
+                            Generate a new signing key; the previous one still verifies tokens during the grace period
+
+  audit [-user USERNAME|EMAIL|ID] [-action ACTION] [-since 2026-09-01] [-limit 50]
+                            List the most recent administrative actions on users: who created, updated or deleted them;
+                            entries are purged after the audit retention (daily by the sidecar)
+
+  walletawards -school ID -year YEAR -term TERM_ID [-top 3]
+                            Compute the year-end wallet awards of a school: the richest students of each class,
+                            year level, department and school among those passing the final term (replaces previous ones)
 `
 )
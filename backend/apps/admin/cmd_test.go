@@ -6,36 +6,89 @@ import (
 	"database/sql"
 	"fmt"
 	"io/fs"
+	"log"
 	"os"
 	"strconv"
 	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
 
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/subscription"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
+	"github.com/trezcool/masomo/services/email"
+	logsvc "github.com/trezcool/masomo/services/logger"
+	"github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database/sqlboiler"
 	"github.com/trezcool/masomo/tests"
 )
 
 var (
-	db      *sql.DB
-	cli     *commandLine
-	usrRepo user.Repository
+	db        *sql.DB
+	conf      *core.Config
+	cli       *commandLine
+	usrRepo   user.Repository
+	schRepo   school.Repository
+	subRepo   subscription.Repository
+	jobSvc    job.ServiceInterface
+	gradeSvc  gradebook.ServiceInterface
+	walletSvc wallet.ServiceInterface
+	keySvc    jwtkey.ServiceInterface
+	tfSvc     twofactor.ServiceInterface
+	auditSvc  audit.ServiceInterface
 )
 
 func TestMain(m *testing.M) {
 	var err error
 
-	conf := core.NewConfig()
+	conf = core.NewConfig()
+	logger := logsvc.NewRollbarLogger(log.Default(), conf)
+	logger.Enable(false)
 
 	// set up DB & repos
 	db = testutil.OpenDB(conf)
 	usrRepo = boiledrepos.NewUserRepository(db)
+	schRepo = boiledrepos.NewSchoolRepository(db)
+	subRepo = boiledrepos.NewSubscriptionRepository(db)
+
+	// set up services
+	mailSvc := emailsvc.NewConsoleServiceMock(conf)
+	core.ParseEmailTemplates(logger)
+	schSvc := school.NewService(db, schRepo)
+	subSvc := subscription.NewService(conf, db, subRepo, schSvc, mailSvc, logger)
+	jobSvc = job.NewService(boiledrepos.NewJobRepository(db))
+	auditSvc = audit.NewService(conf, boiledrepos.NewAuditRepository(db))
+	sessionSvc := session.NewService(db, boiledrepos.NewSessionRepository(db), conf)
+	usrSvc := user.NewService(db, usrRepo, mailSvc, smssvc.NewConsoleServiceMock(conf), auditSvc, sessionSvc, schSvc, conf)
+	cwSvc := coursework.NewService(db, boiledrepos.NewCourseworkRepository(db))
+	gradeSvc = gradebook.NewService(db, boiledrepos.NewGradebookRepository(db), schSvc, cwSvc, usrSvc)
+	walletSvc = wallet.NewService(db, boiledrepos.NewWalletRepository(db), schSvc, cwSvc, gradeSvc, logger)
+	keySvc = jwtkey.NewService(conf, db, boiledrepos.NewJWTKeyRepository(db))
+	tfSvc = twofactor.NewService(conf, db, boiledrepos.NewTwoFactorRepository(db), schSvc)
 
 	// set up CLI
 	cli = &commandLine{
-		db:      db,
-		conf:    conf,
-		usrRepo: usrRepo,
+		db:        db,
+		conf:      conf,
+		validate:  validator.New(),
+		usrRepo:   usrRepo,
+		subSvc:    subSvc,
+		jobSvc:    jobSvc,
+		walletSvc: walletSvc,
+		keySvc:    keySvc,
+		tfRepo:    boiledrepos.NewTwoFactorRepository(db),
+		auditSvc:  auditSvc,
 	}
 
 	// run tests
@@ -174,4 +227,390 @@ func Test_commandLine_resetPassword(t *testing.T) {
 		})
 	}
 	readPasswordFunc = origReadPasswordFunc // reset
+
+	es, err := auditSvc.Query(audit.Filter{UserID: usr.ID, Action: core.AuditUserUpdate})
+	if err != nil || len(es) != 2 {
+		t.Fatalf("Query() = %+v, %v; want 2 entries", es, err)
+	}
+	if pwd := es[0].Changes["password"]; pwd.Before != core.AuditRedacted || pwd.After != core.AuditRedacted {
+		t.Errorf("password change = %+v; want redacted", pwd)
+	}
+}
+
+func Test_commandLine_disable2FA(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	usr := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	prov, err := tfSvc.Enroll(usr)
+	if err != nil {
+		t.Fatalf("Enroll(): %v", err)
+	}
+	code, err := twofactor.GenerateCode(prov.Secret, time.Now())
+	if err != nil {
+		t.Fatalf("GenerateCode(): %v", err)
+	}
+	if _, err = tfSvc.Activate(usr.ID, code); err != nil {
+		t.Fatalf("Activate(): %v", err)
+	}
+
+	tests := []cliTest{
+		{name: "no args", args: []string{"disable2fa"}, wantErr: errHelp},
+		{name: "user not found", args: []string{"disable2fa", "-username", "lol"}, wantErr: user.ErrNotFound},
+		{name: "disabled", args: []string{"disable2fa", "-username", usr.Email}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := cli.run(append([]string{"admin"}, tt.args...)); err != tt.wantErr {
+				t.Errorf("cli.run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if enabled, err := tfSvc.IsEnabled(usr.ID); err != nil || enabled {
+		t.Errorf("IsEnabled() = %v, %v; want false", enabled, err)
+	}
+	if es, err := auditSvc.Query(audit.Filter{UserID: usr.ID, Action: core.AuditUser2FADisable}); err != nil || len(es) != 1 {
+		t.Errorf("Query() = %+v, %v; want 1 entry", es, err)
+	}
+}
+
+func Test_commandLine_audit(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	run := func(args ...string) error {
+		t.Helper()
+		return cli.run(append([]string{"admin"}, args...))
+	}
+	origReadPasswordFunc := readPasswordFunc
+	readPasswordFunc = func(fd int) ([]byte, error) { return []byte("pwd"), nil }
+	defer func() { readPasswordFunc = origReadPasswordFunc }() // reset
+
+	// adduser creates, then updates an existing user
+	if err := run("adduser", "-username", "auditee"); err != nil {
+		t.Fatalf("adduser: %v", err)
+	}
+	if err := run("adduser", "-username", "auditee", "-admin"); err != nil {
+		t.Fatalf("adduser -admin: %v", err)
+	}
+	usr, err := usrRepo.GetUser(context.Background(), user.GetFilter{UsernameOrEmail: []string{"auditee"}})
+	if err != nil {
+		t.Fatalf("GetUser(): %v", err)
+	}
+
+	es, err := auditSvc.Query(audit.Filter{UserID: usr.ID})
+	if err != nil || len(es) != 2 {
+		t.Fatalf("Query() = %+v, %v; want 2 entries", es, err)
+	}
+	if es[0].Action != core.AuditUserUpdate || es[1].Action != core.AuditUserCreate {
+		t.Errorf("actions = %s, %s; want %s, %s", es[0].Action, es[1].Action, core.AuditUserUpdate, core.AuditUserCreate)
+	}
+	for _, e := range es {
+		if e.ActorID != "" || e.TargetID != usr.ID || e.UserAgent != cliActor.UserAgent {
+			t.Errorf("entry = %+v; want by %s on %s", e, cliActor.UserAgent, usr.ID)
+		}
+	}
+	if _, ok := es[0].Changes["roles"]; !ok {
+		t.Errorf("update changes = %+v; want roles", es[0].Changes)
+	}
+	if pwd := es[0].Changes["password"]; pwd.Before != core.AuditRedacted || pwd.After != core.AuditRedacted {
+		t.Errorf("password change = %+v; want redacted", pwd)
+	}
+
+	tests := []cliTest{
+		{name: "user not found", args: []string{"audit", "-user", "lol"}, wantErr: user.ErrNotFound},
+		{name: "by username", args: []string{"audit", "-user", usr.Username, "-since", time.Now().Format(dateLayout)}},
+		{name: "by ID", args: []string{"audit", "-user", usr.ID, "-action", core.AuditUserCreate}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := run(tt.args...); err != tt.wantErr {
+				t.Errorf("cli.run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if err = run("audit", "-user", "", "-since", "01/09/2026"); err == nil {
+		t.Error("audit -since 01/09/2026: nil error; want invalid date")
+	}
+}
+
+func Test_commandLine_billing(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	sch, err := schRepo.CreateSchool(ctx, school.School{Name: "School", IsActive: func(b bool) *bool { return &b }(true)})
+	if err != nil {
+		t.Fatalf("CreateSchool(): %v", err)
+	}
+	run := func(args ...string) error {
+		t.Helper()
+		return cli.run(append([]string{"admin"}, args...))
+	}
+
+	// a new School is on trial
+	if err = run("deactivateSchool"); err != nil {
+		t.Fatalf("deactivateSchool: %v", err)
+	}
+	if s, _ := schRepo.GetSchool(ctx, sch.ID); !*s.IsActive {
+		t.Fatal("school deactivated during its trial")
+	}
+
+	if err = run("newPayment", "-school", sch.ID); err != errHelp {
+		t.Errorf("newPayment without amount: %v; want errHelp", err)
+	}
+	if err = run("newPayment", "-school", sch.ID, "-amount", "lol"); err == nil {
+		t.Error("newPayment with invalid amount: nil error")
+	}
+	emailsvc.SentMessages = nil // reset
+	err = run("newPayment", "-school", sch.ID, "-amount", "120.50", "-period", "Y", "-reference", "TRX-1", "-owner", "owner@test.cd", "-ownername", "Owner")
+	if err != nil {
+		t.Fatalf("newPayment: %v", err)
+	}
+	sub, err := subRepo.GetSubscription(ctx, sch.ID)
+	if err != nil {
+		t.Fatalf("GetSubscription(): %v", err)
+	}
+	if sub.Status != subscription.StatusActive || sub.Plan != subscription.PlanYearly || sub.OwnerEmail != "owner@test.cd" {
+		t.Errorf("subscription = %+v; want active yearly plan of the owner", sub)
+	}
+	if due := time.Now().AddDate(1, 0, 0); sub.PaymentDueDate.Sub(due) > time.Minute || due.Sub(sub.PaymentDueDate) > time.Minute {
+		t.Errorf("PaymentDueDate = %v; want a year from now", sub.PaymentDueDate)
+	}
+	if len(emailsvc.SentMessages) != 1 || emailsvc.SentMessages[0].TemplateName != "payment-received" {
+		t.Errorf("SentMessages = %+v; want a thank-you email", emailsvc.SentMessages)
+	}
+
+	// past due: warnings until the extension date, then deactivation
+	sub.PaymentDueDate = time.Now().AddDate(0, 0, -1)
+	sub.PaymentExtensionDate = sub.PaymentDueDate.AddDate(0, 1, 0)
+	if _, err = subRepo.SaveSubscription(ctx, sub); err != nil {
+		t.Fatalf("SaveSubscription(): %v", err)
+	}
+	emailsvc.SentMessages = nil // reset
+	// the second run sends nothing: the next warning is due halfway to the extension date
+	for i := 0; i < 2; i++ {
+		if err = run("paymentWarning"); err != nil {
+			t.Fatalf("paymentWarning: %v", err)
+		}
+	}
+	if len(emailsvc.SentMessages) != 1 || emailsvc.SentMessages[0].TemplateName != "payment-warning" {
+		t.Errorf("SentMessages = %+v; want a payment warning", emailsvc.SentMessages)
+	}
+	if sub, _ = subRepo.GetSubscription(ctx, sch.ID); sub.Status != subscription.StatusPastDue || sub.WarningsSent != 1 {
+		t.Errorf("subscription = %+v; want past due after 1 warning", sub)
+	}
+
+	sub.PaymentExtensionDate = time.Now().Add(-time.Minute)
+	if _, err = subRepo.SaveSubscription(ctx, sub); err != nil {
+		t.Fatalf("SaveSubscription(): %v", err)
+	}
+	if err = run("deactivateSchool"); err != nil {
+		t.Fatalf("deactivateSchool: %v", err)
+	}
+	if s, _ := schRepo.GetSchool(ctx, sch.ID); *s.IsActive {
+		t.Error("expired school still active")
+	}
+
+	// paying reactivates the School
+	if err = run("newPayment", "-school", sch.ID, "-amount", "10", "-period", "M"); err != nil {
+		t.Fatalf("newPayment: %v", err)
+	}
+	if s, _ := schRepo.GetSchool(ctx, sch.ID); !*s.IsActive {
+		t.Error("school not reactivated")
+	}
+}
+
+func Test_commandLine_jobs(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	run := func(args ...string) error {
+		t.Helper()
+		return cli.run(append([]string{"admin"}, args...))
+	}
+	for _, args := range [][]string{{"jobs"}, {"jobs", "lol"}, {"jobs", "run"}, {"jobs", "cancel"}} {
+		if err := run(args...); err != errHelp {
+			t.Errorf("%v: %v; want errHelp", args, err)
+		}
+	}
+	if err := run("jobs", "run", "sendEmail"); err == nil {
+		t.Error("jobs run sendEmail: nil error; want error (needs a payload)")
+	}
+
+	if err := run("jobs", "run", "paymentwarning"); err != nil {
+		t.Fatalf("jobs run: %v", err)
+	}
+	if err := run("jobs", "list", "-status", "pending"); err != nil {
+		t.Fatalf("jobs list: %v", err)
+	}
+	js, err := jobSvc.Query(job.Filter{Name: core.JobPaymentWarning})
+	if err != nil || len(js) != 1 || js[0].Status != job.StatusPending {
+		t.Fatalf("jobs = %+v, %v; want 1 pending payment warning", js, err)
+	}
+
+	// a scheduled run is queued once across replicas
+	runAt := time.Now().Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if err = jobSvc.EnqueueScheduled(core.JobDeactivateSchool, runAt); err != nil {
+			t.Fatalf("EnqueueScheduled(): %v", err)
+		}
+	}
+	if js, _ = jobSvc.Query(job.Filter{Name: core.JobDeactivateSchool}); len(js) != 1 {
+		t.Errorf("len(jobs) = %d; want 1", len(js))
+	}
+
+	// claims skip the locked and future jobs
+	j, err := jobSvc.Claim("worker", []string{core.JobPaymentWarning, core.JobDeactivateSchool})
+	if err != nil || j.Name != core.JobPaymentWarning || j.Status != job.StatusRunning || j.Attempts != 1 {
+		t.Fatalf("Claim() = %+v, %v; want the running payment warning", j, err)
+	}
+	if _, err = jobSvc.Claim("other", []string{core.JobPaymentWarning, core.JobDeactivateSchool}); err != job.ErrNoJobDue {
+		t.Errorf("Claim() = %v; want ErrNoJobDue", err)
+	}
+	if err = run("jobs", "cancel", j.ID); err != job.ErrNotCancellable {
+		t.Errorf("jobs cancel running: %v; want ErrNotCancellable", err)
+	}
+
+	// failed jobs are retried with backoff
+	if j, err = jobSvc.Complete(j, errors.New("boom")); err != nil || j.Status != job.StatusPending || j.LastError != "boom" {
+		t.Fatalf("Complete() = %+v, %v; want pending retry", j, err)
+	}
+	if !j.RunAt.After(time.Now()) {
+		t.Errorf("RunAt = %v; want a retry later", j.RunAt)
+	}
+
+	if err = run("jobs", "cancel", j.ID); err != nil {
+		t.Fatalf("jobs cancel: %v", err)
+	}
+	if j, _ = jobSvc.Get(j.ID); j.Status != job.StatusCancelled {
+		t.Errorf("status = %q; want cancelled", j.Status)
+	}
+	if err = run("jobs", "cancel", "lol"); err != job.ErrJobNotFound {
+		t.Errorf("jobs cancel lol: %v; want ErrJobNotFound", err)
+	}
+}
+
+func Test_commandLine_jwtKeys(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	run := func(args ...string) error {
+		t.Helper()
+		return cli.run(append([]string{"admin"}, args...))
+	}
+	for _, args := range [][]string{{"jwtkeys"}, {"jwtkeys", "lol"}} {
+		if err := run(args...); err != errHelp {
+			t.Errorf("%v: %v; want errHelp", args, err)
+		}
+	}
+	if err := run("jwtkeys", "rotate", "-alg", "HS256"); err != jwtkey.ErrInvalidAlgorithm {
+		t.Errorf("jwtkeys rotate -alg HS256: %v; want ErrInvalidAlgorithm", err)
+	}
+
+	if err := run("jwtkeys", "rotate"); err != nil {
+		t.Fatalf("jwtkeys rotate: %v", err)
+	}
+	if err := run("jwtkeys", "rotate", "-alg", jwtkey.AlgEdDSA); err != nil {
+		t.Fatalf("jwtkeys rotate -alg EdDSA: %v", err)
+	}
+	if err := run("jwtkeys", "list"); err != nil {
+		t.Fatalf("jwtkeys list: %v", err)
+	}
+
+	// the previous key still verifies tokens during the grace period
+	keys, err := keySvc.Keys()
+	if err != nil || len(keys) != 2 {
+		t.Fatalf("Keys() = %d keys, %v; want 2", len(keys), err)
+	}
+	if !keys[0].IsActive() || keys[0].Algorithm != jwtkey.AlgEdDSA {
+		t.Errorf("keys[0] = %+v; want the active EdDSA key", keys[0])
+	}
+	if keys[1].IsActive() || keys[1].Algorithm != conf.Server.JWTAlgorithm {
+		t.Errorf("keys[1] = %+v; want the retired %s key", keys[1], conf.Server.JWTAlgorithm)
+	}
+}
+
+func Test_commandLine_walletAwards(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	sch, err := schRepo.CreateSchool(ctx, school.School{Name: "School"})
+	if err != nil {
+		t.Fatalf("CreateSchool(): %v", err)
+	}
+	cls, err := schRepo.CreateClass(ctx, school.Class{SchoolID: sch.ID, Name: "6A", YearLevel: 6, Year: 2026})
+	if err != nil {
+		t.Fatalf("CreateClass(): %v", err)
+	}
+	if err = schRepo.AddClassStudents(ctx, cls.ID, []string{student1.ID, student2.ID}); err != nil {
+		t.Fatalf("AddClassStudents(): %v", err)
+	}
+	crs, err := schRepo.CreateCourse(ctx, school.Course{ClassID: cls.ID, TeacherID: teacher.ID, Name: "Maths"})
+	if err != nil {
+		t.Fatalf("CreateCourse(): %v", err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	term, err := schRepo.CreateTerm(ctx, school.Term{SchoolID: sch.ID, Name: "T3", StartsOn: now, EndsOn: now.AddDate(0, 3, 0)})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+
+	// both Students earn points, only student1 passes the final
+	cat, err := gradeSvc.CreateCategory(crs.ID, gradebook.NewCategory{TermID: term.ID, Name: "Exams", Weight: 1})
+	if err != nil {
+		t.Fatalf("CreateCategory(): %v", err)
+	}
+	a, err := gradeSvc.CreateAssessment(crs.ID, gradebook.NewAssessment{CategoryID: cat.ID, Title: "Final", MaxScore: 20})
+	if err != nil {
+		t.Fatalf("CreateAssessment(): %v", err)
+	}
+	marks, err := gradeSvc.EnterMarks(a, gradebook.EnterMarks{Marks: []gradebook.MarkEntry{
+		{StudentID: student1.ID, Score: 16},
+		{StudentID: student2.ID, Score: 10},
+	}})
+	if err != nil {
+		t.Fatalf("EnterMarks(): %v", err)
+	}
+	if _, err = walletSvc.Allocate(crs, teacher.ID, wallet.Allocation{Points: 100}); err != nil {
+		t.Fatalf("Allocate(): %v", err)
+	}
+	if _, err = walletSvc.CreateRule(crs, wallet.NewRule{Name: "Sat the final", Event: wallet.EventMark, Points: 10}); err != nil {
+		t.Fatalf("CreateRule(): %v", err)
+	}
+	if earnings, err := walletSvc.EvaluateMarks(a, marks); err != nil || len(earnings) != 2 {
+		t.Fatalf("EvaluateMarks() = %+v, %v; want 2 earnings", earnings, err)
+	}
+
+	run := func(args ...string) error {
+		t.Helper()
+		return cli.run(append([]string{"admin"}, args...))
+	}
+	if err = run("walletAwards", "-school", sch.ID, "-year", "2026"); err != errHelp {
+		t.Errorf("walletAwards without term: %v; want errHelp", err)
+	}
+	other, err := schRepo.CreateTerm(ctx, school.Term{SchoolID: sch.ID, Name: "T4", StartsOn: now, EndsOn: now.AddDate(0, 3, 0)})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+	if err = run("walletAwards", "-school", cls.ID, "-year", "2026", "-term", other.ID); err == nil {
+		t.Error("walletAwards with a term of another school: nil error")
+	}
+
+	// running twice replaces the awards
+	for i := 0; i < 2; i++ {
+		if err = run("walletAwards", "-school", sch.ID, "-year", "2026", "-term", term.ID, "-top", "1"); err != nil {
+			t.Fatalf("walletAwards: %v", err)
+		}
+	}
+	awards, err := walletSvc.QueryAwards(sch.ID, 2026)
+	if err != nil {
+		t.Fatalf("QueryAwards(): %v", err)
+	}
+	if len(awards) != 3 {
+		t.Fatalf("len(awards) = %d; want 3 (class, year level & school)", len(awards))
+	}
+	for _, aw := range awards {
+		if aw.StudentID != student1.ID || aw.Points != 10 || aw.Average != 80 {
+			t.Errorf("award = %+v; want student1 with 10 points & 80 average", aw)
+		}
+	}
 }
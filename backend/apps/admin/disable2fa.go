@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+// disable2FA disables the two-factor authentication of a User who lost their authenticator app & backup codes;
+// they'll have to enroll again on their next login when it's required. It is recorded in the audit log.
+func (cli *commandLine) disable2FA(uname string) error {
+	ctx := context.Background()
+	usr, err := cli.usrRepo.GetUser(ctx, user.GetFilter{UsernameOrEmail: []string{uname}})
+	if err != nil {
+		return err
+	}
+
+	tx, err := cli.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if err = cli.tfRepo.DeleteEnrollment(ctx, usr.ID, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = cli.auditSvc.Record(ctx, core.NewAuditEntry(cliActor, core.AuditUser2FADisable, usr.ID, nil), tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "committing transaction")
+	}
+	fmt.Printf("two-factor authentication of %s disabled\n", uname)
+	return nil
+}
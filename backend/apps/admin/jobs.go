@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/job"
+)
+
+// runnableJobs lists the Jobs that can be run on demand: those not needing a payload
+var runnableJobs = []string{
+	core.JobPublishAnnouncements, core.JobPaymentWarning, core.JobDeactivateSchool, core.JobEraseUsers, core.JobPurgeAuditLog,
+}
+
+// listJobs prints the most recent Jobs
+func (cli *commandLine) listJobs(name, status string, limit int) error {
+	f := job.Filter{Name: name, Limit: limit}
+	if status != "" {
+		f.Statuses = strings.Split(status, ",")
+	}
+	js, err := cli.jobSvc.Query(f)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tNAME\tSTATUS\tATTEMPTS\tRUN AT\tLAST ERROR")
+	for _, j := range js {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\n",
+			j.ID, j.Name, j.Status, j.Attempts, j.MaxAttempts, j.RunAt.Format(time.RFC3339), j.LastError)
+	}
+	return w.Flush()
+}
+
+// runJob queues a Job to be run right away by the sidecar
+func (cli *commandLine) runJob(name string) error {
+	for _, n := range runnableJobs {
+		if strings.EqualFold(n, name) {
+			if err := cli.jobSvc.Enqueue(n, nil, time.Now()); err != nil {
+				return err
+			}
+			fmt.Printf("job %s queued\n", n)
+			return nil
+		}
+	}
+	return errors.Errorf("unknown job %q; one of: %s", name, strings.Join(runnableJobs, ", "))
+}
+
+// cancelJob cancels a pending Job
+func (cli *commandLine) cancelJob(id string) error {
+	j, err := cli.jobSvc.Cancel(id)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("job %s (%s) cancelled\n", j.ID, j.Name)
+	return nil
+}
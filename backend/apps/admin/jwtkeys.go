@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// listJWTKeys prints the Keys verifying the access tokens; newest first
+func (cli *commandLine) listJWTKeys() error {
+	keys, err := cli.keySvc.Keys()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KID\tALGORITHM\tCREATED AT\tRETIRED AT")
+	for _, k := range keys {
+		retiredAt := "-"
+		if !k.IsActive() {
+			retiredAt = k.RetiredAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", k.ID, k.Algorithm, k.CreatedAt.Format(time.RFC3339), retiredAt)
+	}
+	return w.Flush()
+}
+
+// rotateJWTKey generates a new Key signing the access tokens; the API picks it up within a minute
+func (cli *commandLine) rotateJWTKey(alg string) error {
+	k, err := cli.keySvc.Rotate(alg)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("jwt key %s (%s) generated; previous keys retire in %s\n", k.ID, k.Algorithm, cli.conf.Server.JWTKeyGracePeriod)
+	return nil
+}
@@ -5,8 +5,22 @@ import (
 	"log"
 	"os"
 
+	"github.com/go-playground/validator/v10"
+
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/subscription"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
+	"github.com/trezcool/masomo/services/email"
 	"github.com/trezcool/masomo/services/logger"
+	"github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database"
 	"github.com/trezcool/masomo/storage/database/sqlboiler"
 )
@@ -28,11 +42,34 @@ func main() {
 	}
 	defer func() { _ = db.Close() }()
 
+	// set up services
+	jobSvc := job.NewService(boiledrepos.NewJobRepository(db))
+	var mailSvc core.EmailService
+	if conf.Debug {
+		mailSvc = emailsvc.NewConsoleService(conf)
+	} else {
+		mailSvc = emailsvc.NewSendgridService(conf, logger, jobSvc)
+	}
+	core.ParseEmailTemplates(logger)
+	schSvc := school.NewService(db, boiledrepos.NewSchoolRepository(db))
+	auditSvc := audit.NewService(conf, boiledrepos.NewAuditRepository(db))
+	sessionSvc := session.NewService(db, boiledrepos.NewSessionRepository(db), conf)
+	usrSvc := user.NewService(db, boiledrepos.NewUserRepository(db), mailSvc, smssvc.NewConsoleService(conf), auditSvc, sessionSvc, schSvc, conf)
+	cwSvc := coursework.NewService(db, boiledrepos.NewCourseworkRepository(db))
+	gradeSvc := gradebook.NewService(db, boiledrepos.NewGradebookRepository(db), schSvc, cwSvc, usrSvc)
+
 	// start CLI
 	cli := commandLine{
-		db:      db,
-		conf:    conf,
-		usrRepo: boiledrepos.NewUserRepository(db),
+		db:        db,
+		conf:      conf,
+		validate:  validator.New(),
+		usrRepo:   boiledrepos.NewUserRepository(db),
+		subSvc:    subscription.NewService(conf, db, boiledrepos.NewSubscriptionRepository(db), schSvc, mailSvc, logger),
+		jobSvc:    jobSvc,
+		walletSvc: wallet.NewService(db, boiledrepos.NewWalletRepository(db), schSvc, cwSvc, gradeSvc, logger),
+		keySvc:    jwtkey.NewService(conf, db, boiledrepos.NewJWTKeyRepository(db)),
+		tfRepo:    boiledrepos.NewTwoFactorRepository(db),
+		auditSvc:  auditSvc,
 	}
 	if err = cli.run(os.Args); err != nil {
 		if err != errHelp {
@@ -3,20 +3,43 @@ package main
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
 	"github.com/trezcool/masomo/core/user"
 )
 
+// resetPassword sets the password of a user.User, and records it in the audit log
 func (cli *commandLine) resetPassword(uname, pwd string) error {
 	ctx := context.Background()
-	usr, err := cli.usrRepo.GetUser(ctx, user.GetFilter{UsernameOrEmail: []string{uname}})
+	tx, err := cli.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	before, err := cli.usrRepo.GetUser(ctx, user.GetFilter{UsernameOrEmail: []string{uname}}, tx)
 	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	usr := before
+	if err = usr.SetPassword(pwd); err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	if err := usr.SetPassword(pwd); err != nil {
+	if usr, err = cli.usrRepo.UpdateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	changes, err := core.AuditDiff(before, usr)
+	if err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	if _, err := cli.usrRepo.UpdateUser(ctx, usr); err != nil {
+	changes["password"] = core.AuditChange{Before: core.AuditRedacted, After: core.AuditRedacted}
+	if err = cli.auditSvc.Record(ctx, core.NewAuditEntry(cliActor, core.AuditUserUpdate, usr.ID, changes), tx); err != nil {
+		_ = tx.Rollback()
 		return err
 	}
-	return nil
+	return errors.Wrap(tx.Commit(), "committing transaction")
 }
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// walletAwards computes & prints the year-end wallet awards of a School from the reports of its final Term;
+// running it again replaces the previous awards
+func (cli *commandLine) walletAwards(schoolID string, year int, termID string, top int) error {
+	awards, err := cli.walletSvc.ComputeAwards(schoolID, year, termID, top)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SCOPE\tSCOPE ID\tRANK\tSTUDENT\tCLASS\tPOINTS\tAVERAGE")
+	for _, a := range awards {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%d\t%.2f\n",
+			a.Scope, a.ScopeID, a.Rank, a.StudentID, a.ClassID, a.Points, a.Average)
+	}
+	return w.Flush()
+}
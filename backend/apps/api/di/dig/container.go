@@ -12,9 +12,37 @@ import (
 	"github.com/pkg/errors"
 	echoapi "github.com/trezcool/masomo/apps/api/echo"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 	emailsvc "github.com/trezcool/masomo/services/email"
 	logsvc "github.com/trezcool/masomo/services/logger"
+	mediasvc "github.com/trezcool/masomo/services/media"
+	pdfsvc "github.com/trezcool/masomo/services/pdf"
+	"github.com/trezcool/masomo/services/pubsub"
+	pushsvc "github.com/trezcool/masomo/services/push"
+	smssvc "github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database"
 	boiledrepos "github.com/trezcool/masomo/storage/database/sqlboiler"
 	"go.uber.org/dig"
@@ -63,11 +91,40 @@ func newDB(conf *core.Config, loggerParam DBLoggerParam) (*sql.DB, core.DB) {
 	return db, db
 }
 
-func newEmailService(conf *core.Config, logger core.Logger) core.EmailService {
+func newEmailService(conf *core.Config, logger core.Logger, queue core.JobQueue) core.EmailService {
 	if conf.Debug {
 		return emailsvc.NewConsoleService(conf)
 	}
-	return emailsvc.NewSendgridService(conf, logger)
+	return emailsvc.NewSendgridService(conf, logger, queue)
+}
+
+func newMediaStorage(conf *core.Config) core.MediaStorage {
+	return mediasvc.NewFileSystemStorage(conf)
+}
+
+func newPDFRenderer() core.PDFRenderer {
+	return pdfsvc.NewHTMLRenderer()
+}
+
+func newPubSub(conf *core.Config, db core.DB, logger core.Logger) core.PubSub {
+	if conf.Debug {
+		return pubsub.NewMemoryPubSub()
+	}
+	return pubsub.NewPostgresPubSub(database.DataSourceName(conf), db, logger)
+}
+
+func newPushService(conf *core.Config) core.PushService {
+	if conf.VAPIDPrivateKey == "" {
+		return pushsvc.NewConsoleService()
+	}
+	return pushsvc.NewWebPushService(conf)
+}
+
+func newSMSService(conf *core.Config) core.SMSService {
+	if conf.SMSAccountSID == "" {
+		return smssvc.NewConsoleService(conf)
+	}
+	return smssvc.NewGatewayService(conf)
 }
 
 func newTranslator() ut.Translator {
@@ -88,10 +145,59 @@ func New() *dig.Container {
 	must(c.Provide(newDBLogger, dig.Name("dbLogger")))
 	must(c.Provide(newDB))
 	must(c.Provide(newEmailService))
+	must(c.Provide(newMediaStorage))
+	must(c.Provide(newPDFRenderer))
+	must(c.Provide(newPubSub))
+	must(c.Provide(newPushService))
+	must(c.Provide(newSMSService))
 	must(c.Provide(boiledrepos.NewUserRepository, dig.As(new(user.Repository))))
+	must(c.Provide(boiledrepos.NewSchoolRepository, dig.As(new(school.Repository))))
+	must(c.Provide(boiledrepos.NewCourseworkRepository, dig.As(new(coursework.Repository))))
+	must(c.Provide(boiledrepos.NewGradebookRepository, dig.As(new(gradebook.Repository))))
+	must(c.Provide(boiledrepos.NewAttendanceRepository, dig.As(new(attendance.Repository))))
+	must(c.Provide(boiledrepos.NewAnnouncementRepository, dig.As(new(announcement.Repository))))
+	must(c.Provide(boiledrepos.NewChatRepository, dig.As(new(chat.Repository))))
+	must(c.Provide(boiledrepos.NewNotificationRepository, dig.As(new(notification.Repository))))
+	must(c.Provide(boiledrepos.NewSMSRepository, dig.As(new(sms.Repository))))
+	must(c.Provide(boiledrepos.NewCalendarRepository, dig.As(new(calendar.Repository))))
+	must(c.Provide(boiledrepos.NewJobRepository, dig.As(new(job.Repository))))
+	must(c.Provide(boiledrepos.NewWalletRepository, dig.As(new(wallet.Repository))))
+	must(c.Provide(boiledrepos.NewRBACRepository, dig.As(new(rbac.Repository))))
+	must(c.Provide(boiledrepos.NewGuardianRepository, dig.As(new(guardian.Repository))))
+	must(c.Provide(boiledrepos.NewSessionRepository, dig.As(new(session.Repository))))
+	must(c.Provide(boiledrepos.NewPasswordlessRepository, dig.As(new(passwordless.Repository))))
+	must(c.Provide(boiledrepos.NewJWTKeyRepository, dig.As(new(jwtkey.Repository))))
+	must(c.Provide(boiledrepos.NewTwoFactorRepository, dig.As(new(twofactor.Repository))))
+	must(c.Provide(boiledrepos.NewOIDCRepository, dig.As(new(oidc.Repository))))
+	must(c.Provide(boiledrepos.NewPrivacyRepository, dig.As(new(privacy.Repository))))
+	must(c.Provide(boiledrepos.NewAuditRepository, dig.As(new(audit.Repository))))
 	must(c.Provide(validator.New))
 	must(c.Provide(newTranslator))
+	must(c.Provide(job.NewService, dig.As(new(job.ServiceInterface), new(core.JobQueue))))
+	must(c.Provide(audit.NewService, dig.As(new(audit.ServiceInterface), new(core.AuditLog))))
 	must(c.Provide(user.NewService, dig.As(new(user.ServiceInterface))))
+	must(c.Provide(school.NewService, dig.As(new(school.ServiceInterface), new(core.EmailPolicy))))
+	must(c.Provide(sms.NewService, dig.As(new(sms.ServiceInterface))))
+	must(c.Provide(coursework.NewService, dig.As(new(coursework.ServiceInterface))))
+	must(c.Provide(gradebook.NewService, dig.As(new(gradebook.ServiceInterface))))
+	must(c.Provide(reportcard.NewService, dig.As(new(reportcard.ServiceInterface))))
+	must(c.Provide(attendance.NewService, dig.As(new(attendance.ServiceInterface))))
+	must(c.Provide(notification.NewService, dig.As(new(notification.ServiceInterface), new(core.Notifier))))
+	must(c.Provide(announcement.NewService, dig.As(new(announcement.ServiceInterface))))
+	must(c.Provide(announcement.NewScheduler))
+	must(c.Provide(chat.NewService, dig.As(new(chat.ServiceInterface))))
+	must(c.Provide(calendar.NewService, dig.As(new(calendar.ServiceInterface))))
+	must(c.Provide(wallet.NewService, dig.As(new(wallet.ServiceInterface))))
+	must(c.Provide(rbac.NewService, dig.As(new(rbac.ServiceInterface))))
+	must(c.Provide(guardian.NewService, dig.As(new(guardian.ServiceInterface))))
+	must(c.Provide(session.NewService, dig.As(new(session.ServiceInterface), new(core.SessionRevoker))))
+	must(c.Provide(jwtkey.NewService, dig.As(new(jwtkey.ServiceInterface))))
+	must(c.Provide(twofactor.NewService, dig.As(new(twofactor.ServiceInterface))))
+	must(c.Provide(oidc.NewService, dig.As(new(oidc.ServiceInterface))))
+	must(c.Provide(passwordless.NewService, dig.As(new(passwordless.ServiceInterface))))
+	must(c.Provide(credential.NewService, dig.As(new(credential.ServiceInterface))))
+	must(c.Provide(avatar.NewService, dig.As(new(avatar.ServiceInterface))))
+	must(c.Provide(privacy.NewService, dig.As(new(privacy.ServiceInterface))))
 	must(c.Provide(echoapi.NewServer))
 
 	_ = dig.Visualize(c, os.Stdout)
@@ -14,9 +14,37 @@ import (
 	"github.com/google/wire"
 	echoapi "github.com/trezcool/masomo/apps/api/echo"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 	emailsvc "github.com/trezcool/masomo/services/email"
 	logsvc "github.com/trezcool/masomo/services/logger"
+	mediasvc "github.com/trezcool/masomo/services/media"
+	pdfsvc "github.com/trezcool/masomo/services/pdf"
+	"github.com/trezcool/masomo/services/pubsub"
+	pushsvc "github.com/trezcool/masomo/services/push"
+	smssvc "github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database"
 	boiledrepos "github.com/trezcool/masomo/storage/database/sqlboiler"
 )
@@ -59,11 +87,40 @@ func newDB(conf *core.Config, logger core.Logger) *sql.DB {
 	return db
 }
 
-func newEmailService(conf *core.Config, logger core.Logger) core.EmailService {
+func newEmailService(conf *core.Config, logger core.Logger, queue core.JobQueue) core.EmailService {
 	if conf.Debug {
 		return emailsvc.NewConsoleService(conf)
 	}
-	return emailsvc.NewSendgridService(conf, logger)
+	return emailsvc.NewSendgridService(conf, logger, queue)
+}
+
+func newMediaStorage(conf *core.Config) core.MediaStorage {
+	return mediasvc.NewFileSystemStorage(conf)
+}
+
+func newPDFRenderer() core.PDFRenderer {
+	return pdfsvc.NewHTMLRenderer()
+}
+
+func newPubSub(conf *core.Config, db core.DB, logger core.Logger) core.PubSub {
+	if conf.Debug {
+		return pubsub.NewMemoryPubSub()
+	}
+	return pubsub.NewPostgresPubSub(database.DataSourceName(conf), db, logger)
+}
+
+func newPushService(conf *core.Config) core.PushService {
+	if conf.VAPIDPrivateKey == "" {
+		return pushsvc.NewConsoleService()
+	}
+	return pushsvc.NewWebPushService(conf)
+}
+
+func newSMSService(conf *core.Config) core.SMSService {
+	if conf.SMSAccountSID == "" {
+		return smssvc.NewConsoleService(conf)
+	}
+	return smssvc.NewGatewayService(conf)
 }
 
 func newTranslator() ut.Translator {
@@ -88,13 +145,179 @@ var (
 		user.NewService,
 		wire.Bind(new(user.ServiceInterface), new(*user.Service)))
 
+	schoolSet = wire.NewSet(
+		boiledrepos.NewSchoolRepository,
+		wire.Bind(new(school.Repository), new(*boiledrepos.SchoolRepository)),
+		school.NewService,
+		wire.Bind(new(school.ServiceInterface), new(*school.Service)),
+		wire.Bind(new(core.EmailPolicy), new(*school.Service)))
+
+	courseworkSet = wire.NewSet(
+		boiledrepos.NewCourseworkRepository,
+		wire.Bind(new(coursework.Repository), new(*boiledrepos.CourseworkRepository)),
+		coursework.NewService,
+		wire.Bind(new(coursework.ServiceInterface), new(*coursework.Service)))
+
+	gradebookSet = wire.NewSet(
+		boiledrepos.NewGradebookRepository,
+		wire.Bind(new(gradebook.Repository), new(*boiledrepos.GradebookRepository)),
+		gradebook.NewService,
+		wire.Bind(new(gradebook.ServiceInterface), new(*gradebook.Service)))
+
+	reportCardSet = wire.NewSet(
+		reportcard.NewService,
+		wire.Bind(new(reportcard.ServiceInterface), new(*reportcard.Service)))
+
+	attendanceSet = wire.NewSet(
+		boiledrepos.NewAttendanceRepository,
+		wire.Bind(new(attendance.Repository), new(*boiledrepos.AttendanceRepository)),
+		attendance.NewService,
+		wire.Bind(new(attendance.ServiceInterface), new(*attendance.Service)))
+
+	announcementSet = wire.NewSet(
+		boiledrepos.NewAnnouncementRepository,
+		wire.Bind(new(announcement.Repository), new(*boiledrepos.AnnouncementRepository)),
+		announcement.NewService,
+		wire.Bind(new(announcement.ServiceInterface), new(*announcement.Service)),
+		announcement.NewScheduler)
+
+	chatSet = wire.NewSet(
+		boiledrepos.NewChatRepository,
+		wire.Bind(new(chat.Repository), new(*boiledrepos.ChatRepository)),
+		chat.NewService,
+		wire.Bind(new(chat.ServiceInterface), new(*chat.Service)))
+
+	notificationSet = wire.NewSet(
+		boiledrepos.NewNotificationRepository,
+		wire.Bind(new(notification.Repository), new(*boiledrepos.NotificationRepository)),
+		notification.NewService,
+		wire.Bind(new(notification.ServiceInterface), new(*notification.Service)),
+		wire.Bind(new(core.Notifier), new(*notification.Service)))
+
+	smsSet = wire.NewSet(
+		boiledrepos.NewSMSRepository,
+		wire.Bind(new(sms.Repository), new(*boiledrepos.SMSRepository)),
+		sms.NewService,
+		wire.Bind(new(sms.ServiceInterface), new(*sms.Service)))
+
+	calendarSet = wire.NewSet(
+		boiledrepos.NewCalendarRepository,
+		wire.Bind(new(calendar.Repository), new(*boiledrepos.CalendarRepository)),
+		calendar.NewService,
+		wire.Bind(new(calendar.ServiceInterface), new(*calendar.Service)))
+
+	walletSet = wire.NewSet(
+		boiledrepos.NewWalletRepository,
+		wire.Bind(new(wallet.Repository), new(*boiledrepos.WalletRepository)),
+		wallet.NewService,
+		wire.Bind(new(wallet.ServiceInterface), new(*wallet.Service)))
+
+	rbacSet = wire.NewSet(
+		boiledrepos.NewRBACRepository,
+		wire.Bind(new(rbac.Repository), new(*boiledrepos.RBACRepository)),
+		rbac.NewService,
+		wire.Bind(new(rbac.ServiceInterface), new(*rbac.Service)))
+
+	guardianSet = wire.NewSet(
+		boiledrepos.NewGuardianRepository,
+		wire.Bind(new(guardian.Repository), new(*boiledrepos.GuardianRepository)),
+		guardian.NewService,
+		wire.Bind(new(guardian.ServiceInterface), new(*guardian.Service)))
+
+	sessionSet = wire.NewSet(
+		boiledrepos.NewSessionRepository,
+		wire.Bind(new(session.Repository), new(*boiledrepos.SessionRepository)),
+		session.NewService,
+		wire.Bind(new(session.ServiceInterface), new(*session.Service)),
+		wire.Bind(new(core.SessionRevoker), new(*session.Service)))
+
+	jwtKeySet = wire.NewSet(
+		boiledrepos.NewJWTKeyRepository,
+		wire.Bind(new(jwtkey.Repository), new(*boiledrepos.JWTKeyRepository)),
+		jwtkey.NewService,
+		wire.Bind(new(jwtkey.ServiceInterface), new(*jwtkey.Service)))
+
+	twoFactorSet = wire.NewSet(
+		boiledrepos.NewTwoFactorRepository,
+		wire.Bind(new(twofactor.Repository), new(*boiledrepos.TwoFactorRepository)),
+		twofactor.NewService,
+		wire.Bind(new(twofactor.ServiceInterface), new(*twofactor.Service)))
+
+	oidcSet = wire.NewSet(
+		boiledrepos.NewOIDCRepository,
+		wire.Bind(new(oidc.Repository), new(*boiledrepos.OIDCRepository)),
+		oidc.NewService,
+		wire.Bind(new(oidc.ServiceInterface), new(*oidc.Service)))
+
+	passwordlessSet = wire.NewSet(
+		boiledrepos.NewPasswordlessRepository,
+		wire.Bind(new(passwordless.Repository), new(*boiledrepos.PasswordlessRepository)),
+		passwordless.NewService,
+		wire.Bind(new(passwordless.ServiceInterface), new(*passwordless.Service)))
+
+	credentialSet = wire.NewSet(
+		credential.NewService,
+		wire.Bind(new(credential.ServiceInterface), new(*credential.Service)))
+
+	avatarSet = wire.NewSet(
+		avatar.NewService,
+		wire.Bind(new(avatar.ServiceInterface), new(*avatar.Service)))
+
+	auditSet = wire.NewSet(
+		boiledrepos.NewAuditRepository,
+		wire.Bind(new(audit.Repository), new(*boiledrepos.AuditRepository)),
+		audit.NewService,
+		wire.Bind(new(audit.ServiceInterface), new(*audit.Service)),
+		wire.Bind(new(core.AuditLog), new(*audit.Service)))
+
+	privacySet = wire.NewSet(
+		boiledrepos.NewPrivacyRepository,
+		wire.Bind(new(privacy.Repository), new(*boiledrepos.PrivacyRepository)),
+		privacy.NewService,
+		wire.Bind(new(privacy.ServiceInterface), new(*privacy.Service)))
+
+	jobSet = wire.NewSet(
+		boiledrepos.NewJobRepository,
+		wire.Bind(new(job.Repository), new(*boiledrepos.JobRepository)),
+		job.NewService,
+		wire.Bind(new(job.ServiceInterface), new(*job.Service)),
+		wire.Bind(new(core.JobQueue), new(*job.Service)))
+
 	appSet = wire.NewSet(
 		core.NewConfig,
 		newLogger,
 		newEmailService,
+		newMediaStorage,
+		newPDFRenderer,
+		newPubSub,
+		newPushService,
+		newSMSService,
 		dbSet,
 		userRepoSet,
 		userSvcSet,
+		schoolSet,
+		courseworkSet,
+		gradebookSet,
+		reportCardSet,
+		attendanceSet,
+		announcementSet,
+		chatSet,
+		notificationSet,
+		smsSet,
+		calendarSet,
+		walletSet,
+		rbacSet,
+		guardianSet,
+		sessionSet,
+		jwtKeySet,
+		twoFactorSet,
+		oidcSet,
+		passwordlessSet,
+		credentialSet,
+		avatarSet,
+		privacySet,
+		auditSet,
+		jobSet,
 		validator.New,
 		newTranslator,
 		wire.Struct(new(echoapi.ServerDeps), "*"),
@@ -130,3 +353,8 @@ func NewServer() *echoapi.Server {
 	wire.Build(appSet)
 	return nil
 }
+
+func NewScheduler() *announcement.Scheduler {
+	wire.Build(appSet)
+	return nil
+}
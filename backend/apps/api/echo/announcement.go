@@ -0,0 +1,247 @@
+package echoapi
+
+import (
+	"net/http"
+	"strconv"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var errAnnNotFoundInCtx = errors.New("announcement object not found in echo.Context")
+
+const maxAttachmentSize = 10 << 20 // 10 MB
+
+type announcementApi struct {
+	conf       *core.Config
+	svc        announcement.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerAnnouncementAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	conf *core.Config,
+	svc announcement.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := announcementApi{
+		conf:       conf,
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// management
+	schMw := schoolMiddleware(api.schoolSvc)
+	g.GET("/schools/:id/announcements", api.queryAnnouncements, jwt, schMw, requirePermission(rbac.PermAnnouncementsManage))
+	g.POST("/schools/:id/announcements", api.createAnnouncement, jwt, schMw, requirePermission(rbac.PermAnnouncementsManage))
+	g.DELETE("/announcements/:id", api.deleteAnnouncement, jwt, api.announcementMiddleware, requirePermission(rbac.PermAnnouncementsManage))
+	g.POST("/announcements/:id/attachments", api.addAttachment, jwt, api.announcementMiddleware, requirePermission(rbac.PermAnnouncementsManage))
+
+	// inbox
+	g.GET("/announcements", api.inbox, jwt)
+	g.GET("/announcements/unread-count", api.unreadCount, jwt)
+	g.POST("/announcements/read", api.markAllRead, jwt)
+	g.GET("/announcements/:id", api.retrieveDelivery, jwt)
+	g.POST("/announcements/:id/read", api.markRead, jwt)
+}
+
+// Handlers
+
+func (api *announcementApi) queryAnnouncements(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	as, err := api.svc.Query(sch.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying announcements")
+	}
+	for i := range as {
+		api.signAttachments(as[i].Attachments)
+	}
+	return ctx.JSON(http.StatusOK, as)
+}
+
+// createAnnouncement schedules an Announcement, which is published to its audience at its publish time.
+func (api *announcementApi) createAnnouncement(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data announcement.NewAnnouncement
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewAnnouncement")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	a, err := api.svc.Create(sch.ID, claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "creating announcement")
+	}
+	return ctx.JSON(http.StatusCreated, a)
+}
+
+func (api *announcementApi) deleteAnnouncement(ctx echo.Context) error {
+	a, ok := ctx.Get("object").(announcement.Announcement)
+	if !ok {
+		return errors.Wrap(errAnnNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.Delete(a.ID); err != nil {
+		return errors.Wrap(err, "deleting announcement")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// addAttachment attaches an uploaded file to an Announcement, until it is published.
+func (api *announcementApi) addAttachment(ctx echo.Context) error {
+	a, ok := ctx.Get("object").(announcement.Announcement)
+	if !ok {
+		return errors.Wrap(errAnnNotFoundInCtx, "retrieving object from context")
+	}
+
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return core.NewValidationError(err, core.FieldError{Field: "file", Error: "required"})
+	}
+	if fh.Size > maxAttachmentSize {
+		return core.NewValidationError(nil, core.FieldError{Field: "file", Error: "file too large"})
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return errors.Wrap(err, "opening uploaded file")
+	}
+	defer f.Close()
+
+	ct := fh.Header.Get(echo.HeaderContentType)
+	if ct == "" {
+		ct = echo.MIMEOctetStream
+	}
+	att, err := api.svc.AddAttachment(a, fh.Filename, ct, fh.Size, f)
+	if err != nil {
+		return errors.Wrap(err, "adding attachment")
+	}
+	att.URL = core.SignMediaURL(api.conf, att.Name)
+	return ctx.JSON(http.StatusCreated, att)
+}
+
+// inbox returns the Announcements received by the context user; only the unread ones with `?unread=true`.
+func (api *announcementApi) inbox(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	unreadOnly, _ := strconv.ParseBool(ctx.QueryParam("unread"))
+
+	ds, err := api.svc.Inbox(claims.Subject, unreadOnly)
+	if err != nil {
+		return errors.Wrap(err, "querying inbox")
+	}
+	for i := range ds {
+		api.signAttachments(ds[i].Attachments)
+	}
+	return ctx.JSON(http.StatusOK, ds)
+}
+
+func (api *announcementApi) unreadCount(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	n, err := api.svc.UnreadCount(claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "counting unread announcements")
+	}
+	return ctx.JSON(http.StatusOK, UnreadCountResponse{Unread: n})
+}
+
+func (api *announcementApi) retrieveDelivery(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	d, err := api.svc.GetDelivery(ctx.Param("id"), claims.Subject)
+	if err != nil {
+		if errors.Cause(err) == announcement.ErrAnnouncementNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding announcement")
+	}
+	api.signAttachments(d.Attachments)
+	return ctx.JSON(http.StatusOK, d)
+}
+
+func (api *announcementApi) markRead(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	d, err := api.svc.GetDelivery(ctx.Param("id"), claims.Subject)
+	if err != nil {
+		if errors.Cause(err) == announcement.ErrAnnouncementNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding announcement")
+	}
+	if err = api.svc.MarkRead(claims.Subject, d.ID); err != nil {
+		return errors.Wrap(err, "marking announcement as read")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *announcementApi) markAllRead(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if err = api.svc.MarkRead(claims.Subject); err != nil {
+		return errors.Wrap(err, "marking announcements as read")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// announcementMiddleware sets the Announcement identified by the `id` param in echo.Context.
+func (api *announcementApi) announcementMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		a, err := api.svc.GetByID(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == announcement.ErrAnnouncementNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding announcement by ID")
+		}
+		ctx.Set("object", a)
+		return next(ctx)
+	}
+}
+
+// signAttachments sets the download URLs of Attachments.
+func (api *announcementApi) signAttachments(atts []announcement.Attachment) {
+	for i := range atts {
+		atts[i].URL = core.SignMediaURL(api.conf, atts[i].Name)
+	}
+}
+
+type UnreadCountResponse struct {
+	Unread int `json:"unread"`
+}
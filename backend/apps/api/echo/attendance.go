@@ -0,0 +1,214 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/school"
+)
+
+type attendanceApi struct {
+	svc        attendance.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerAttendanceAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc attendance.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := attendanceApi{
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	clsMw := classMiddleware(api.schoolSvc)
+	g.GET("/classes/:id/attendance", api.retrieveSession, jwt, clsMw)
+	g.PUT("/classes/:id/attendance", api.markAttendance, jwt, clsMw)
+	g.GET("/classes/:id/attendance/summary", api.classSummary, jwt, clsMw)
+	g.GET("/classes/:id/attendance/students/:studentId", api.studentAttendance, jwt, clsMw)
+}
+
+// Handlers
+
+// markAttendance saves the Records of a Session in bulk; eg. everyone present but the listed Students.
+func (api *attendanceApi) markAttendance(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data attendance.MarkAttendance
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to MarkAttendance")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	claims, err := api.checkAttendanceManager(ctx, cls, data.CourseID)
+	if err != nil {
+		return err
+	}
+	sess, err := api.svc.Mark(cls, claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "marking attendance")
+	}
+	return ctx.JSON(http.StatusOK, sess)
+}
+
+func (api *attendanceApi) retrieveSession(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query attendance.SessionQuery
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to SessionQuery")
+	}
+	if err := api.validate.Struct(query); err != nil {
+		return err
+	}
+	if _, err := api.checkAttendanceManager(ctx, cls, query.CourseID); err != nil {
+		return err
+	}
+
+	sess, err := api.svc.GetSession(cls, query.CourseID, query.Day())
+	if err != nil {
+		if errors.Cause(err) == attendance.ErrSessionNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding session")
+	}
+	return ctx.JSON(http.StatusOK, sess)
+}
+
+// classSummary returns the Summaries of all Students to attendance managers,
+// and only their own Summary to Students of the Class.
+func (api *attendanceApi) classSummary(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query attendance.SummaryQuery
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to SummaryQuery")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	manage, err := api.canManageAttendance(claims, cls, query.CourseID)
+	if err != nil {
+		return err
+	}
+	if !manage && !claims.IsStudent {
+		return errHttpForbidden
+	}
+	summaries, err := api.svc.ClassSummaries(cls, query.Filter(cls.ID))
+	if err != nil {
+		return errors.Wrap(err, "summarizing attendance")
+	}
+	if manage {
+		return ctx.JSON(http.StatusOK, summaries)
+	}
+	for _, s := range summaries {
+		if s.StudentID == claims.Subject {
+			return ctx.JSON(http.StatusOK, []attendance.Summary{s})
+		}
+	}
+	return errHttpNotFound
+}
+
+// studentAttendance returns the Summary and Records of a Student, to attendance managers and the Student.
+func (api *attendanceApi) studentAttendance(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query attendance.SummaryQuery
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to SummaryQuery")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	studentID := ctx.Param("studentId")
+	manage, err := api.canManageAttendance(claims, cls, query.CourseID)
+	if err != nil {
+		return err
+	}
+	if !manage && !(claims.IsStudent && claims.Subject == studentID) {
+		return errHttpNotFound
+	}
+
+	sa, err := api.svc.StudentAttendance(cls, studentID, query.Filter(cls.ID))
+	if err != nil {
+		if errors.Cause(err) == attendance.ErrStudentNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "querying student attendance")
+	}
+	return ctx.JSON(http.StatusOK, sa)
+}
+
+// checkAttendanceManager returns the context claims if the user can manage the attendance of a Class, or of its Course.
+func (api *attendanceApi) checkAttendanceManager(ctx echo.Context, cls school.Class, courseID string) (Claims, error) {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return Claims{}, errors.Wrap(err, "getting context claims")
+	}
+	manage, err := api.canManageAttendance(claims, cls, courseID)
+	if err != nil {
+		return Claims{}, err
+	}
+	if !manage {
+		return Claims{}, errHttpForbidden
+	}
+	return claims, nil
+}
+
+// canManageAttendance allows admins, Teachers of the Course for its Sessions,
+// and Teachers of any Course of the Class for its daily Sessions.
+func (api *attendanceApi) canManageAttendance(claims Claims, cls school.Class, courseID string) (bool, error) {
+	if claims.IsAdmin {
+		return true, nil
+	}
+	if !claims.IsTeacher {
+		return false, nil
+	}
+	courses, err := api.schoolSvc.QueryCourses(school.CourseFilter{ClassID: cls.ID, TeacherID: claims.Subject})
+	if err != nil {
+		return false, errors.Wrap(err, "querying courses")
+	}
+	for _, crs := range courses {
+		if courseID == "" || crs.ID == courseID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
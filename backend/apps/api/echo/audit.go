@@ -0,0 +1,48 @@
+package echoapi
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type auditApi struct {
+	svc      audit.ServiceInterface
+	validate *validator.Validate
+}
+
+func registerAuditAPI(g *echo.Group, jwt echo.MiddlewareFunc, svc audit.ServiceInterface, validate *validator.Validate) {
+	api := auditApi{svc: svc, validate: validate}
+
+	g.GET("/audit", api.query, jwt, requirePermission(rbac.PermAuditRead))
+}
+
+// auditActor returns the context User as the actor of the audited actions of a request.
+func auditActor(ctx echo.Context, ctxUsr user.User) core.AuditActor {
+	return core.AuditActor{ID: ctxUsr.ID, IP: ctx.RealIP(), UserAgent: ctx.Request().UserAgent()}
+}
+
+// Handlers
+
+func (api *auditApi) query(ctx echo.Context) error {
+	var filter audit.Filter
+	if err := ctx.Bind(&filter); err != nil {
+		return errors.Wrap(err, "binding to Filter")
+	}
+	if err := api.validate.Struct(filter); err != nil {
+		return err
+	}
+
+	es, err := api.svc.Query(filter)
+	if err != nil {
+		return errors.Wrap(err, "querying audit entries")
+	}
+	return ctx.JSON(http.StatusOK, es)
+}
@@ -1,7 +1,8 @@
 package echoapi
 
 import (
-	"sort"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -10,109 +11,232 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
 )
 
+const (
+	jwtAudience = "Academia"
+	// challenge tokens prove a User passed a stage of the login; they can't be used as access tokens
+	challengeAudience      = "Academia:2fa"      // the 1st factor of a two-factor login
+	passwordChangeAudience = "Academia:password" // the temporary password; to be changed
+	challengeExpiration    = 5 * time.Minute
+)
+
 var (
-	// appJWTConfig is the default JWT auth middleware config.
-	appName              string
-	appJWTConfig         middleware.JWTConfig
-	jwtExpiration        time.Duration
-	jwtRefreshExpiration time.Duration
-	contextUserKey       = "user"
+	appName        string
+	appKeySvc      jwtkey.ServiceInterface // signs the JWTs & provides the keys verifying them
+	jwtExpiration  time.Duration
+	jwtContextKey  = "userToken"
+	contextUserKey = "user"
 )
 
-func initAuth(conf *core.Config) {
+func initAuth(conf *core.Config, keySvc jwtkey.ServiceInterface) {
 	appName = conf.AppName
+	appKeySvc = keySvc
+	jwtExpiration = conf.Server.JWTExpiration
+}
 
-	appJWTConfig = middleware.JWTConfig{
-		SigningKey:    []byte(conf.SecretKey),
-		SigningMethod: middleware.AlgorithmHS256,
-		ContextKey:    "userToken",
-		Claims:        new(Claims),
-	}
+// jwtMiddleware authenticates requests with the JWT sent as the Bearer token of the Authorization header.
+// The `kid` header of the JWT selects the key verifying it, so that keys can be rotated.
+func jwtMiddleware() echo.MiddlewareFunc {
+	return jwtMiddlewareWithExtractor(func(ctx echo.Context) string {
+		auth := ctx.Request().Header.Get(echo.HeaderAuthorization)
+		if scheme := "Bearer "; strings.HasPrefix(auth, scheme) {
+			return auth[len(scheme):]
+		}
+		return ""
+	})
+}
 
-	jwtExpiration = conf.Server.JWTExpiration
-	jwtRefreshExpiration = conf.Server.JWTRefreshExpiration
+// queryJWTMiddleware authenticates requests with the JWT sent as the `token` query param;
+// for browsers, which cannot set headers on WebSocket & EventSource requests.
+func queryJWTMiddleware() echo.MiddlewareFunc {
+	return jwtMiddlewareWithExtractor(func(ctx echo.Context) string {
+		return ctx.QueryParam("token")
+	})
+}
+
+func jwtMiddlewareWithExtractor(extract func(echo.Context) string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			auth := extract(ctx)
+			if auth == "" {
+				return middleware.ErrJWTMissing
+			}
+			token, err := jwt.ParseWithClaims(auth, new(Claims), appKeySvc.Keyfunc)
+			if err == nil && !token.Claims.(*Claims).VerifyAudience(jwtAudience, true) {
+				err = errors.New("invalid audience")
+			}
+			if err != nil || !token.Valid {
+				return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "invalid or expired jwt", Internal: err}
+			}
+			ctx.Set(jwtContextKey, token)
+			return next(ctx)
+		}
+	}
 }
 
 // Claims represents the authorization claims transmitted via a JWT.
 type Claims struct {
 	jwt.StandardClaims
-	OrigIssuedAt int64    `json:"oriat,omitempty"`
-	Username     string   `json:"username,omitempty"`
-	Email        string   `json:"email,omitempty"`
-	IsStudent    bool     `json:"is_student,omitempty"` // -> STUDENT PORTAL
-	IsTeacher    bool     `json:"is_teacher,omitempty"` // -> TEACHER PORTAL
-	IsAdmin      bool     `json:"is_admin,omitempty"`   // -> ADMIN PORTAL
-	Roles        []string `json:"roles,omitempty"`
+	Username   string   `json:"username,omitempty"`
+	Email      string   `json:"email,omitempty"`
+	IsStudent  bool     `json:"is_student,omitempty"`  // -> STUDENT PORTAL
+	IsTeacher  bool     `json:"is_teacher,omitempty"`  // -> TEACHER PORTAL
+	IsAdmin    bool     `json:"is_admin,omitempty"`    // -> ADMIN PORTAL
+	IsGuardian bool     `json:"is_guardian,omitempty"` // -> GUARDIAN PORTAL
+	Roles      []string `json:"roles,omitempty"`
 }
 
-func GetUserClaims(usr user.User, origIat ...int64) *Claims {
+func GetUserClaims(usr user.User) *Claims {
 	now := time.Now()
-	nownix := now.Unix()
-
-	var oriat int64
-	if len(origIat) > 0 {
-		oriat = origIat[0]
-	} else {
-		oriat = nownix
-	}
-
 	claims := &Claims{
 		StandardClaims: jwt.StandardClaims{
 			Issuer:    appName,
 			Subject:   usr.ID,
-			Audience:  "Academia",
+			Audience:  jwtAudience,
 			ExpiresAt: now.Add(jwtExpiration).Unix(),
-			IssuedAt:  nownix,
+			IssuedAt:  now.Unix(),
 		},
-		OrigIssuedAt: oriat,
-		Username:     usr.Username,
-		Email:        usr.Email,
-		IsStudent:    usr.IsStudent(),
-		IsTeacher:    usr.IsTeacher(),
-		IsAdmin:      usr.IsAdmin(),
-		Roles:        usr.Roles,
+		Username:   usr.Username,
+		Email:      usr.Email,
+		IsStudent:  usr.IsStudent(),
+		IsTeacher:  usr.IsTeacher(),
+		IsAdmin:    usr.IsAdmin(),
+		IsGuardian: usr.IsGuardian(),
+		Roles:      usr.Roles,
 	}
 	return claims
 }
 
-func authenticate(uname, pwd string, svc user.ServiceInterface) (*Claims, error) {
+// authenticate checks the credentials of a User, who must be active & have a School available;
+// the User might still need to pass the 2nd factor. errPasswordChangeRequired is returned along the User
+// logging in with a temporary password.
+func authenticate(uname, pwd string, svc user.ServiceInterface, schoolSvc school.ServiceInterface) (user.User, error) {
 	usr, err := svc.GetByUsernameOrEmail(uname)
 	if err != nil {
 		if err == user.ErrNotFound {
-			return nil, errAuthenticationFailed
+			return user.User{}, errAuthenticationFailed
 		}
-		return nil, errors.Wrap(err, "finding user by username or email")
+		return user.User{}, errors.Wrap(err, "finding user by username or email")
 	}
 	if err = usr.CheckPassword(pwd); err != nil {
-		return nil, errAuthenticationFailed
+		return user.User{}, errAuthenticationFailed
+	}
+	if err = checkUserAvailable(usr, schoolSvc); err != nil {
+		return user.User{}, err
+	}
+	if usr.MustChangePassword {
+		return usr, errPasswordChangeRequired
 	}
+	return usr, nil
+}
+
+// checkUserAvailable checks that a User is still active & has a School available.
+func checkUserAvailable(usr user.User, schoolSvc school.ServiceInterface) error {
 	if usr.IsActive != nil && !*usr.IsActive {
-		return nil, errAccountDeactivated
+		return errAccountDeactivated
 	}
-	usr, err = svc.SetLastLogin(usr)
+	return checkSchoolAvailable(usr, schoolSvc)
+}
+
+// completeLogin completes the login of a User who passed the 1st factor: two-factor Users get a challenge token,
+// to complete their login at `/users/login/2fa`.
+func completeLogin(
+	usr user.User,
+	svc user.ServiceInterface,
+	twoFactorSvc twofactor.ServiceInterface,
+	sessionSvc session.ServiceInterface,
+) (LoginResponse, error) {
+	stage, err := twoFactorStage(usr, twoFactorSvc)
 	if err != nil {
-		return nil, errors.Wrap(err, "setting lastLogin")
+		return LoginResponse{}, err
+	}
+	if stage != "" {
+		challenge, err := makeChallengeToken(usr, challengeAudience)
+		if err != nil {
+			return LoginResponse{}, err
+		}
+		return LoginResponse{TwoFactor: stage, ChallengeToken: challenge}, nil
 	}
-	return GetUserClaims(usr), nil
+
+	resp, err := issueTokens(usr, svc, sessionSvc)
+	return resp, errors.Wrap(err, "issuing tokens")
 }
 
-// GenerateToken generates a signed JWT token string representing the user Claims.
-func GenerateToken(claims *Claims) (string, error) {
-	method := jwt.GetSigningMethod(appJWTConfig.SigningMethod)
-	token := jwt.NewWithClaims(method, claims)
+// issueTokens completes the login of an authenticated User: with an access token & a refresh token.
+func issueTokens(usr user.User, svc user.ServiceInterface, sessionSvc session.ServiceInterface) (LoginResponse, error) {
+	usr, err := svc.SetLastLogin(usr)
+	if err != nil {
+		return LoginResponse{}, errors.Wrap(err, "setting lastLogin")
+	}
+	token, err := GenerateToken(GetUserClaims(usr))
+	if err != nil {
+		return LoginResponse{}, errors.Wrap(err, "generating token")
+	}
+	refresh, _, err := sessionSvc.Issue(usr.ID)
+	if err != nil {
+		return LoginResponse{}, errors.Wrap(err, "issuing refresh token")
+	}
+	return LoginResponse{Token: token, RefreshToken: refresh}, nil
+}
+
+// makeChallengeToken returns a token proving that the User passed the stage of the login of an audience.
+func makeChallengeToken(usr user.User, audience string) (string, error) {
+	now := time.Now()
+	ss, err := appKeySvc.Sign(&jwt.StandardClaims{
+		Issuer:    appName,
+		Subject:   usr.ID,
+		Audience:  audience,
+		ExpiresAt: now.Add(challengeExpiration).Unix(),
+		IssuedAt:  now.Unix(),
+	})
+	return ss, errors.Wrap(err, "signing challenge token")
+}
 
-	ss, err := token.SignedString(appJWTConfig.SigningKey)
+// parseChallengeToken returns the ID of the User of a challenge token of an audience.
+func parseChallengeToken(ss, audience string) (string, error) {
+	claims := new(jwt.StandardClaims)
+	token, err := jwt.ParseWithClaims(ss, claims, appKeySvc.Keyfunc)
+	if err != nil || !token.Valid || !claims.VerifyAudience(audience, true) {
+		return "", errChallengeInvalid
+	}
+	return claims.Subject, nil
+}
+
+// checkSchoolAvailable returns errSchoolUnavailable when all the Schools a User studies or teaches in are inactive;
+// eg. deactivated for lack of payment. Admins manage all Schools, and always get in.
+func checkSchoolAvailable(usr user.User, schoolSvc school.ServiceInterface) error {
+	if usr.IsAdmin() {
+		return nil
+	}
+	schs, err := schoolSvc.MemberSchools(usr.ID)
 	if err != nil {
-		return "", errors.New("signing token")
+		return errors.Wrap(err, "querying member schools")
 	}
-	return ss, nil
+	for _, sch := range schs {
+		if sch.IsActive == nil || *sch.IsActive {
+			return nil
+		}
+	}
+	if len(schs) > 0 {
+		return errSchoolUnavailable
+	}
+	return nil
+}
+
+// GenerateToken generates a signed JWT token string representing the user Claims.
+func GenerateToken(claims *Claims) (string, error) {
+	ss, err := appKeySvc.Sign(claims)
+	return ss, errors.Wrap(err, "signing token")
 }
 
 func getContextClaims(ctx echo.Context) (Claims, error) {
-	if token, ok := ctx.Get(appJWTConfig.ContextKey).(*jwt.Token); ok {
+	if token, ok := ctx.Get(jwtContextKey).(*jwt.Token); ok {
 		if claims, ok := token.Claims.(*Claims); ok {
 			return *claims, nil
 		}
@@ -144,46 +268,52 @@ func getContextUser(ctx echo.Context, svc user.ServiceInterface, clms ...Claims)
 	return usr, nil
 }
 
-func contextHasAnyRole(ctx echo.Context, roles []string) bool {
-	if len(roles) == 0 {
-		return true
-	}
-	if claims, err := getContextClaims(ctx); err == nil {
-		sort.Strings(claims.Roles)
-		for _, role := range roles {
-			if i := sort.SearchStrings(claims.Roles, role); i < len(claims.Roles) {
-				if match := claims.Roles[i]; role == match {
-					return true
-				}
-			}
-		}
-	}
-	return false
-}
-
-func refreshToken(ctx echo.Context, svc user.ServiceInterface) (string, error) {
-	claims, err := getContextClaims(ctx)
+// refreshToken exchanges a refresh token for a new access token & refresh token pair.
+// Access tokens are short-lived: the User is checked again on every refresh.
+func refreshToken(
+	token string,
+	sessionSvc session.ServiceInterface,
+	svc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+) (LoginResponse, error) {
+	newRefresh, rt, err := sessionSvc.Rotate(token)
 	if err != nil {
-		return "", errors.Wrap(err, "getting context claims")
+		switch err {
+		case session.ErrTokenInvalid, session.ErrTokenReused:
+			return LoginResponse{}, errRefreshInvalid
+		case session.ErrTokenExpired:
+			return LoginResponse{}, errRefreshExpired
+		}
+		return LoginResponse{}, errors.Wrap(err, "rotating refresh token")
 	}
 
-	usr, err := getContextUser(ctx, svc, claims)
+	usr, err := svc.GetByID(rt.UserID)
 	if err != nil {
-		return "", errors.Wrap(err, "getting context user")
+		if errors.Cause(err) == user.ErrNotFound {
+			return LoginResponse{}, errRefreshInvalid
+		}
+		return LoginResponse{}, errors.Wrap(err, "finding user by ID")
 	}
 
 	// check if user is still active
-	if usr.IsActive != nil && !*usr.IsActive {
-		return "", errAccountDeactivated
+	if err = checkUserAvailable(usr, schoolSvc); err != nil {
+		return LoginResponse{}, err
 	}
 
-	// check if refresh has not expired
-	expTime := time.Unix(claims.OrigIssuedAt, 0).Add(jwtRefreshExpiration)
-	if time.Now().After(expTime) {
-		return "", errRefreshExpired
+	access, err := GenerateToken(GetUserClaims(usr))
+	if err != nil {
+		return LoginResponse{}, errors.Wrap(err, "generating token")
 	}
+	return LoginResponse{Token: access, RefreshToken: newRefresh}, nil
+}
 
-	newClaims := GetUserClaims(usr, claims.OrigIssuedAt)
-	token, err := GenerateToken(newClaims)
-	return token, errors.Wrap(err, "generating token")
+// jwks publishes the public keys verifying the JWTs, for the other services; see RFC 7517.
+func jwks(ctx echo.Context) error {
+	set, err := appKeySvc.JWKS()
+	if err != nil {
+		return errors.Wrap(err, "getting JWKS")
+	}
+	// verifiers refetch the keys on unknown `kid`; rotated keys stay valid way longer than this
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, set)
 }
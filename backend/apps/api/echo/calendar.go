@@ -0,0 +1,315 @@
+package echoapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var errEvtNotFoundInCtx = errors.New("calendar event object not found in echo.Context")
+
+const (
+	feedRouteName = "calendarFeed"
+	feedPast      = 90 * 24 * time.Hour // past Events kept in feeds
+)
+
+type calendarApi struct {
+	conf       *core.Config
+	svc        calendar.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerCalendarAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	conf *core.Config,
+	svc calendar.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := calendarApi{
+		conf:       conf,
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// schools
+	schMw := schoolMiddleware(api.schoolSvc)
+	g.GET("/schools/:id/calendar", api.schoolCalendar, jwt, schMw, requirePermission(rbac.PermCalendarManage))
+	g.POST("/schools/:id/calendar/events", api.createSchoolEvent, jwt, schMw, requirePermission(rbac.PermCalendarManage))
+
+	// courses
+	memberMw, managerMw := courseRouteMiddleware(jwt, api.schoolSvc)
+	g.GET("/courses/:id/calendar", api.courseCalendar, memberMw...)
+	g.POST("/courses/:id/calendar/events", api.createCourseEvent, managerMw...)
+
+	// events
+	eg := g.Group("/calendar/events/:id", jwt, api.eventMiddleware)
+	eg.PUT("", api.updateEvent)
+	eg.DELETE("", api.deleteEvent)
+
+	// context user
+	g.GET("/me/calendar", api.userCalendar, jwt)
+	g.POST("/me/calendar/feed", api.createFeed, jwt)
+	g.DELETE("/me/calendar/feed", api.revokeFeed, jwt)
+
+	// subscribed to by calendar apps, which cannot send the JWT: the token in the URL authenticates
+	g.GET("/calendar/feed/:token", api.feed).Name = feedRouteName
+}
+
+// Handlers
+
+func (api *calendarApi) bindRange(ctx echo.Context) (calendar.Range, error) {
+	var r calendar.Range
+	if err := ctx.Bind(&r); err != nil {
+		return r, core.NewValidationError(err, core.FieldError{Field: "from", Error: "must be a RFC 3339 date-time"})
+	}
+	if !r.Clean(time.Now()) {
+		return r, core.NewValidationError(nil, core.FieldError{Field: "to", Error: "must be after from"})
+	}
+	return r, nil
+}
+
+func (api *calendarApi) schoolCalendar(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	r, err := api.bindRange(ctx)
+	if err != nil {
+		return err
+	}
+	events, err := api.svc.SchoolCalendar(sch.ID, r)
+	if err != nil {
+		return errors.Wrap(err, "querying school calendar")
+	}
+	return ctx.JSON(http.StatusOK, events)
+}
+
+func (api *calendarApi) createSchoolEvent(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data calendar.NewEvent
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewEvent")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	e, err := api.svc.CreateSchoolEvent(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating event")
+	}
+	return ctx.JSON(http.StatusCreated, e)
+}
+
+func (api *calendarApi) courseCalendar(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	r, err := api.bindRange(ctx)
+	if err != nil {
+		return err
+	}
+	events, err := api.svc.CourseCalendar(crs, r)
+	if err != nil {
+		return errors.Wrap(err, "querying course calendar")
+	}
+	return ctx.JSON(http.StatusOK, events)
+}
+
+func (api *calendarApi) createCourseEvent(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data calendar.NewEvent
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewEvent")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	e, err := api.svc.CreateCourseEvent(crs, data)
+	if err != nil {
+		return errors.Wrap(err, "creating event")
+	}
+	return ctx.JSON(http.StatusCreated, e)
+}
+
+func (api *calendarApi) updateEvent(ctx echo.Context) error {
+	e, ok := ctx.Get("object").(calendar.Event)
+	if !ok {
+		return errors.Wrap(errEvtNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data calendar.NewEvent
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewEvent")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	e, err := api.svc.UpdateEvent(e.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating event")
+	}
+	return ctx.JSON(http.StatusOK, e)
+}
+
+func (api *calendarApi) deleteEvent(ctx echo.Context) error {
+	e, ok := ctx.Get("object").(calendar.Event)
+	if !ok {
+		return errors.Wrap(errEvtNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.DeleteEvent(e.ID); err != nil {
+		return errors.Wrap(err, "deleting event")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// userCalendar returns the calendars of the Schools and Courses of the context user (?from=&to= RFC 3339).
+func (api *calendarApi) userCalendar(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	r, err := api.bindRange(ctx)
+	if err != nil {
+		return err
+	}
+	events, err := api.svc.UserCalendar(claims.Subject, r)
+	if err != nil {
+		return errors.Wrap(err, "querying user calendar")
+	}
+	return ctx.JSON(http.StatusOK, events)
+}
+
+// createFeed returns the URLs of a new iCalendar feed of the context user; the previous ones stop working.
+func (api *calendarApi) createFeed(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	token, err := api.svc.CreateFeedToken(claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "creating feed token")
+	}
+
+	host := ctx.Request().Host + ctx.Echo().Reverse(feedRouteName, token+".ics")
+	return ctx.JSON(http.StatusCreated, FeedResponse{
+		URL:       ctx.Scheme() + "://" + host,
+		WebcalURL: "webcal://" + host, // opens the subscription dialog of calendar apps
+	})
+}
+
+func (api *calendarApi) revokeFeed(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if err = api.svc.RevokeFeedToken(claims.Subject); err != nil {
+		return errors.Wrap(err, "revoking feed token")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// feed returns the iCalendar feed of a User, from 90 days ago to a year from now.
+func (api *calendarApi) feed(ctx echo.Context) error {
+	usr, err := api.svc.FeedUser(strings.TrimSuffix(ctx.Param("token"), ".ics"))
+	if err != nil {
+		if errors.Cause(err) == calendar.ErrFeedNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding feed user")
+	}
+
+	now := time.Now().UTC()
+	r := calendar.Range{From: now.Add(-feedPast), To: now.AddDate(1, 0, 0)}
+	events, err := api.svc.UserCalendar(usr.ID, r)
+	if err != nil {
+		return errors.Wrap(err, "querying user calendar")
+	}
+
+	var buf bytes.Buffer
+	ics := calendar.ICS{
+		AppName: api.conf.AppName,
+		Name:    api.conf.AppName + " - " + usr.Name,
+		BaseURL: api.conf.FrontendBaseURL,
+		Stamp:   now,
+		Events:  events,
+	}
+	if err = ics.Render(&buf); err != nil {
+		return errors.Wrap(err, "rendering feed")
+	}
+	ctx.Response().Header().Set("Cache-Control", "private, max-age=3600")
+	return ctx.Blob(http.StatusOK, "text/calendar; charset=utf-8", buf.Bytes())
+}
+
+// Middlewares
+
+// eventMiddleware sets the Event identified by the `id` param in echo.Context when the context user can manage it:
+// admins manage School-wide Events, and Course Events are also managed by the Teacher of the Course.
+func (api *calendarApi) eventMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		claims, err := getContextClaims(ctx)
+		if err != nil {
+			return errors.Wrap(err, "getting context claims")
+		}
+		e, err := api.svc.GetEvent(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == calendar.ErrEventNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding event by ID")
+		}
+
+		if e.CourseID == "" {
+			if !claims.IsAdmin {
+				return errHttpForbidden
+			}
+		} else {
+			crs, err := api.schoolSvc.GetCourse(e.CourseID)
+			if err != nil {
+				return errors.Wrap(err, "finding course by ID")
+			}
+			if err = checkCourseAccess(ctx, api.schoolSvc, crs); err != nil {
+				return err
+			}
+			if !canManageCourse(claims, crs) {
+				return errHttpForbidden
+			}
+		}
+		ctx.Set("object", e)
+		return next(ctx)
+	}
+}
+
+type FeedResponse struct {
+	URL       string `json:"url"`
+	WebcalURL string `json:"webcal_url"`
+}
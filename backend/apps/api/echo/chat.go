@@ -0,0 +1,460 @@
+package echoapi
+
+import (
+	"net/http"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var (
+	errRoomNotFoundInCtx = errors.New("room object not found in echo.Context")
+	errMsgNotFoundInCtx  = errors.New("message object not found in echo.Context")
+	errUserMuted         = echo.NewHTTPError(http.StatusForbidden, "muted")
+)
+
+const (
+	wsWriteWait  = 10 * time.Second
+	wsPongWait   = 60 * time.Second
+	wsPingPeriod = wsPongWait * 9 / 10
+	wsMaxFrame   = 8 << 10 // 8 KB
+	wsBufferSize = 64      // Events queued per connection
+)
+
+// wsUpgrader accepts connections from any origin: they are authenticated with a JWT, not cookies.
+var wsUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type chatApi struct {
+	svc        chat.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerChatAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc chat.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := chatApi{
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// course endpoints
+	memberMw, managerMw := courseRouteMiddleware(jwt, api.schoolSvc)
+	cg := g.Group("/courses/:id/chat")
+	cg.GET("/rooms", api.queryRooms, memberMw...)
+	cg.POST("/rooms", api.createRoom, managerMw...)
+	cg.POST("/mutes", api.muteUser, managerMw...)
+	cg.DELETE("/mutes/:userId", api.unmuteUser, managerMw...)
+
+	// room endpoints
+	g.GET("/chat/unread", api.unread, jwt)
+	g.GET("/chat/rooms/:id/messages", api.history, jwt, api.roomMiddleware)
+	g.POST("/chat/rooms/:id/messages", api.postMessage, jwt, api.roomMiddleware)
+	g.POST("/chat/rooms/:id/read", api.markRead, jwt, api.roomMiddleware)
+	g.GET("/chat/rooms/:id/ws", api.connect, queryJWTMiddleware(), api.roomMiddleware)
+	g.DELETE("/chat/messages/:id", api.deleteMessage, jwt, api.messageMiddleware)
+}
+
+// Handlers
+
+func (api *chatApi) queryRooms(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	userID, manage, err := contextStudentID(ctx)
+	if err != nil {
+		return err
+	}
+	rooms, err := api.svc.Rooms(crs, userID, manage)
+	if err != nil {
+		return errors.Wrap(err, "querying rooms")
+	}
+	return ctx.JSON(http.StatusOK, rooms)
+}
+
+// createRoom creates a topic Room for all Students of the Course, or a group Room for some of them.
+func (api *chatApi) createRoom(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	var data chat.NewRoom
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewRoom")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	room, err := api.svc.CreateRoom(crs, claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "creating room")
+	}
+	return ctx.JSON(http.StatusCreated, room)
+}
+
+// muteUser prevents a Student from posting in the Rooms of the Course.
+func (api *chatApi) muteUser(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	var data chat.MuteUser
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to MuteUser")
+	}
+	if err = api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	mute, err := api.svc.Mute(crs.ID, claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "muting user")
+	}
+	return ctx.JSON(http.StatusOK, mute)
+}
+
+func (api *chatApi) unmuteUser(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	if err := api.svc.Unmute(crs.ID, ctx.Param("userId")); err != nil {
+		return errors.Wrap(err, "unmuting user")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// unread returns the unread counters of the context user in the Rooms of the Courses they teach or attend.
+func (api *chatApi) unread(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	var courses []school.Course
+	if claims.IsTeacher {
+		taught, err := api.schoolSvc.QueryCourses(school.CourseFilter{TeacherID: claims.Subject})
+		if err != nil {
+			return errors.Wrap(err, "querying courses")
+		}
+		courses = append(courses, taught...)
+	}
+	if claims.IsStudent {
+		attended, err := api.schoolSvc.QueryCourses(school.CourseFilter{StudentID: claims.Subject})
+		if err != nil {
+			return errors.Wrap(err, "querying courses")
+		}
+		courses = append(courses, attended...)
+	}
+
+	rooms, err := api.svc.Unread(claims.Subject, courses)
+	if err != nil {
+		return errors.Wrap(err, "counting unread messages")
+	}
+	resp := ChatUnreadResponse{Rooms: rooms}
+	for _, r := range rooms {
+		resp.Total += r.Unread
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// history returns a page of the Messages of a Room, most recent first; older pages with `?before=<next_cursor>`.
+func (api *chatApi) history(ctx echo.Context) error {
+	room, ok := ctx.Get("object").(chat.Room)
+	if !ok {
+		return errors.Wrap(errRoomNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query chat.MessageQuery
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to MessageQuery")
+	}
+	if err := api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	page, err := api.svc.History(room, query)
+	if err != nil {
+		return errors.Wrap(err, "querying history")
+	}
+	return ctx.JSON(http.StatusOK, page)
+}
+
+// postMessage posts a Message without a WebSocket connection.
+func (api *chatApi) postMessage(ctx echo.Context) error {
+	room, ok := ctx.Get("object").(chat.Room)
+	if !ok {
+		return errors.Wrap(errRoomNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	var data chat.NewMessage
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewMessage")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	msg, err := api.svc.Post(room, claims.Subject, data)
+	if err != nil {
+		if errors.Cause(err) == chat.ErrMuted {
+			return errUserMuted
+		}
+		return errors.Wrap(err, "posting message")
+	}
+	return ctx.JSON(http.StatusCreated, msg)
+}
+
+func (api *chatApi) markRead(ctx echo.Context) error {
+	room, ok := ctx.Get("object").(chat.Room)
+	if !ok {
+		return errors.Wrap(errRoomNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if err = api.svc.MarkRead(room.ID, claims.Subject); err != nil {
+		return errors.Wrap(err, "marking room as read")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *chatApi) deleteMessage(ctx echo.Context) error {
+	msg, ok := ctx.Get("object").(chat.Message)
+	if !ok {
+		return errors.Wrap(errMsgNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	msg, err = api.svc.DeleteMessage(msg, claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "deleting message")
+	}
+	return ctx.JSON(http.StatusOK, msg)
+}
+
+// connect upgrades to a WebSocket connection receiving the Events of a Room.
+// Clients post Messages and mark the Room as read by sending ChatCommands.
+func (api *chatApi) connect(ctx echo.Context) error {
+	room, ok := ctx.Get("object").(chat.Room)
+	if !ok {
+		return errors.Wrap(errRoomNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	client := &chatClient{out: make(chan interface{}, wsBufferSize), done: make(chan struct{})}
+	unsubscribe, err := api.svc.Subscribe(room, func(e chat.Event) { client.send(e) })
+	if err != nil {
+		return errors.Wrap(err, "subscribing to room")
+	}
+	defer unsubscribe()
+
+	conn, err := wsUpgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+	if err != nil {
+		return nil // the upgrader replied with an HTTP error
+	}
+	client.conn = conn
+	go client.writePump()
+	defer client.close()
+
+	conn.SetReadLimit(wsMaxFrame)
+	_ = conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error { return conn.SetReadDeadline(time.Now().Add(wsPongWait)) })
+	for {
+		var cmd ChatCommand
+		if err = conn.ReadJSON(&cmd); err != nil {
+			return nil // disconnected
+		}
+		switch cmd.Type {
+		case chatCommandMessage:
+			data := chat.NewMessage{Body: cmd.Body}
+			if err = data.Validate(api.validate); err != nil {
+				client.send(ChatError{Type: chatEventError, Error: "invalid message"})
+				continue
+			}
+			if _, err = api.svc.Post(room, claims.Subject, data); err != nil {
+				if errors.Cause(err) == chat.ErrMuted {
+					client.send(ChatError{Type: chatEventError, Error: "muted"})
+					continue
+				}
+				client.send(ChatError{Type: chatEventError, Error: "message not sent"})
+			}
+		case chatCommandRead:
+			if err = api.svc.MarkRead(room.ID, claims.Subject); err != nil {
+				client.send(ChatError{Type: chatEventError, Error: "not marked as read"})
+			}
+		default:
+			client.send(ChatError{Type: chatEventError, Error: "unknown command"})
+		}
+	}
+}
+
+// roomMiddleware sets the Room identified by the `id` param, and its Course, in echo.Context
+// when the context user can access them.
+func (api *chatApi) roomMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		room, err := api.svc.GetRoom(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == chat.ErrRoomNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding room by ID")
+		}
+		if err = setContextCourse(ctx, api.schoolSvc, room.CourseID); err != nil {
+			return err
+		}
+		userID, manage, err := contextStudentID(ctx)
+		if err != nil {
+			return err
+		}
+		if !room.IsMember(userID, manage) {
+			return errHttpNotFound
+		}
+		ctx.Set("object", room)
+		return next(ctx)
+	}
+}
+
+// messageMiddleware sets the Message identified by the `id` param in echo.Context, for the managers of its Course.
+func (api *chatApi) messageMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		msg, err := api.svc.GetMessage(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == chat.ErrMessageNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding message by ID")
+		}
+		room, err := api.svc.GetRoom(msg.RoomID)
+		if err != nil {
+			return errors.Wrap(err, "finding room by ID")
+		}
+		if err = setContextCourse(ctx, api.schoolSvc, room.CourseID); err != nil {
+			return err
+		}
+		if _, manage, err := contextStudentID(ctx); err != nil {
+			return err
+		} else if !manage {
+			return errHttpForbidden
+		}
+		ctx.Set("object", msg)
+		return next(ctx)
+	}
+}
+
+// chatClient writes the Events of a Room to a WebSocket connection.
+type chatClient struct {
+	conn *websocket.Conn
+	out  chan interface{}
+	done chan struct{}
+}
+
+// send queues a frame without blocking; slow clients are disconnected.
+func (c *chatClient) send(frame interface{}) {
+	select {
+	case <-c.done:
+	case c.out <- frame:
+	default:
+		c.close()
+	}
+}
+
+func (c *chatClient) close() {
+	select {
+	case <-c.done:
+	default:
+		close(c.done)
+	}
+}
+
+func (c *chatClient) writePump() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case <-c.done:
+			_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(wsWriteWait))
+			return
+		case frame := <-c.out:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteJSON(frame); err != nil {
+				c.close()
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// ChatCommand commands
+const (
+	chatCommandMessage = "message"
+	chatCommandRead    = "read"
+	chatEventError     = "error"
+)
+
+type (
+	// ChatCommand is sent by WebSocket clients: {"type": "message", "body": "..."} or {"type": "read"}
+	ChatCommand struct {
+		Type string `json:"type"`
+		Body string `json:"body"`
+	}
+
+	// ChatError is sent to a WebSocket client whose ChatCommand failed.
+	ChatError struct {
+		Type  string `json:"type"` // "error"
+		Error string `json:"error"`
+	}
+
+	ChatUnreadResponse struct {
+		Total int               `json:"total"`
+		Rooms []chat.RoomUnread `json:"rooms"`
+	}
+)
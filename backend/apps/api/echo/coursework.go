@@ -0,0 +1,445 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/wallet"
+)
+
+var (
+	errCwNotFoundInCtx  = errors.New("coursework object not found in echo.Context")
+	errAttNotFoundInCtx = errors.New("attempt object not found in echo.Context")
+)
+
+type courseworkApi struct {
+	svc          coursework.ServiceInterface
+	schoolSvc    school.ServiceInterface
+	gradebookSvc gradebook.ServiceInterface
+	walletSvc    wallet.ServiceInterface
+	validate     *validator.Validate
+	translator   ut.Translator
+}
+
+func registerCourseworkAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc coursework.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	gradebookSvc gradebook.ServiceInterface,
+	walletSvc wallet.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := courseworkApi{
+		svc:          svc,
+		schoolSvc:    schoolSvc,
+		gradebookSvc: gradebookSvc,
+		walletSvc:    walletSvc,
+		validate:     validate,
+		translator:   translator,
+	}
+	manager := courseManagerMiddleware()
+
+	// course endpoints
+	memberMw, managerMw := courseRouteMiddleware(jwt, api.schoolSvc)
+	cg := g.Group("/courses/:id")
+	cg.GET("/questions", api.queryQuestions, managerMw...)
+	cg.POST("/questions", api.createQuestion, managerMw...)
+	cg.PUT("/questions/:questionId", api.updateQuestion, managerMw...)
+	cg.DELETE("/questions/:questionId", api.destroyQuestion, managerMw...)
+	cg.GET("/coursework", api.query, memberMw...)
+	cg.POST("/coursework", api.create, managerMw...)
+
+	// coursework endpoints
+	dg := g.Group("/coursework/:id", jwt, api.courseworkMiddleware)
+	dg.GET("", api.retrieve)
+	dg.PUT("", api.update, manager)
+	dg.DELETE("", api.destroy, manager)
+	dg.POST("/attempts", api.startAttempt)
+	dg.GET("/attempts", api.queryAttempts)
+	dg.GET("/grades", api.queryGrades)
+
+	// attempt endpoints
+	ag := g.Group("/attempts/:id", jwt, api.attemptMiddleware)
+	ag.GET("", api.retrieveAttempt)
+	ag.POST("/submit", api.submitAttempt)
+}
+
+// Handlers
+
+func (api *courseworkApi) createQuestion(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data coursework.NewQuestion
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewQuestion")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	q, err := api.svc.CreateQuestion(crs.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating question")
+	}
+	return ctx.JSON(http.StatusCreated, q)
+}
+
+func (api *courseworkApi) queryQuestions(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	qs, err := api.svc.QueryQuestions(crs.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying questions")
+	}
+	return ctx.JSON(http.StatusOK, qs)
+}
+
+func (api *courseworkApi) getCourseQuestion(ctx echo.Context) (coursework.Question, error) {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return coursework.Question{}, errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	q, err := api.svc.GetQuestion(ctx.Param("questionId"))
+	if err != nil {
+		if errors.Cause(err) == coursework.ErrQuestionNotFound {
+			return coursework.Question{}, errHttpNotFound
+		}
+		return coursework.Question{}, errors.Wrap(err, "finding question by ID")
+	}
+	if q.CourseID != crs.ID {
+		return coursework.Question{}, errHttpNotFound
+	}
+	return q, nil
+}
+
+func (api *courseworkApi) updateQuestion(ctx echo.Context) error {
+	q, err := api.getCourseQuestion(ctx)
+	if err != nil {
+		return err
+	}
+
+	var data coursework.NewQuestion
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewQuestion")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	q, err = api.svc.UpdateQuestion(q.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating question")
+	}
+	return ctx.JSON(http.StatusOK, q)
+}
+
+func (api *courseworkApi) destroyQuestion(ctx echo.Context) error {
+	q, err := api.getCourseQuestion(ctx)
+	if err != nil {
+		return err
+	}
+	if err := api.svc.DeleteQuestion(q.ID); err != nil {
+		return errors.Wrap(err, "deleting question")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *courseworkApi) create(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data coursework.NewCoursework
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewCoursework")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	cw, err := api.svc.CreateCoursework(crs.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating coursework")
+	}
+	return ctx.JSON(http.StatusCreated, cw)
+}
+
+func (api *courseworkApi) query(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	filter := new(coursework.QueryFilter)
+	if err := ctx.Bind(filter); err != nil {
+		return ctx.JSON(http.StatusOK, []coursework.Coursework{})
+	}
+	filter.CourseID = crs.ID
+
+	cws, err := api.svc.Query(*filter)
+	if err != nil {
+		return errors.Wrap(err, "querying coursework")
+	}
+	return ctx.JSON(http.StatusOK, cws)
+}
+
+func (api *courseworkApi) retrieve(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+	return ctx.JSON(http.StatusOK, cw)
+}
+
+func (api *courseworkApi) update(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data coursework.NewCoursework
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewCoursework")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	cw, err := api.svc.Update(cw.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating coursework")
+	}
+	return ctx.JSON(http.StatusOK, cw)
+}
+
+func (api *courseworkApi) destroy(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.Delete(cw.ID); err != nil {
+		return errors.Wrap(err, "deleting coursework")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *courseworkApi) startAttempt(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if !claims.IsStudent {
+		return errHttpForbidden
+	}
+
+	att, paper, err := api.svc.StartAttempt(cw, claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "starting attempt")
+	}
+	return ctx.JSON(http.StatusCreated, AttemptResponse{Attempt: att, Paper: paper})
+}
+
+// queryAttempts returns all Attempts to Course managers, and only their own Attempts to Students.
+func (api *courseworkApi) queryAttempts(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+
+	filter := coursework.AttemptFilter{CourseworkID: cw.ID}
+	if studentID, manage, err := contextStudentID(ctx); err != nil {
+		return err
+	} else if !manage {
+		filter.StudentID = studentID
+	}
+
+	atts, err := api.svc.QueryAttempts(filter)
+	if err != nil {
+		return errors.Wrap(err, "querying attempts")
+	}
+	return ctx.JSON(http.StatusOK, atts)
+}
+
+// queryGrades returns all Grades to Course managers, and only their own Grade to Students.
+func (api *courseworkApi) queryGrades(ctx echo.Context) error {
+	cw, ok := ctx.Get("object").(coursework.Coursework)
+	if !ok {
+		return errors.Wrap(errCwNotFoundInCtx, "retrieving object from context")
+	}
+
+	var studentIDs []string
+	if studentID, manage, err := contextStudentID(ctx); err != nil {
+		return err
+	} else if !manage {
+		studentIDs = append(studentIDs, studentID)
+	}
+
+	grades, err := api.svc.Grades(cw.ID, studentIDs...)
+	if err != nil {
+		return errors.Wrap(err, "querying grades")
+	}
+	return ctx.JSON(http.StatusOK, grades)
+}
+
+func (api *courseworkApi) retrieveAttempt(ctx echo.Context) error {
+	att, ok := ctx.Get("object").(coursework.Attempt)
+	if !ok {
+		return errors.Wrap(errAttNotFoundInCtx, "retrieving object from context")
+	}
+
+	return ctx.JSON(http.StatusOK, AttemptResponse{Attempt: att, Paper: api.svc.Paper(att)})
+}
+
+func (api *courseworkApi) submitAttempt(ctx echo.Context) error {
+	att, ok := ctx.Get("object").(coursework.Attempt)
+	if !ok {
+		return errors.Wrap(errAttNotFoundInCtx, "retrieving object from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if att.StudentID != claims.Subject {
+		return errHttpForbidden
+	}
+
+	var data coursework.SubmitAttempt
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to SubmitAttempt")
+	}
+
+	att, err = api.svc.SubmitAttempt(att.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "submitting attempt")
+	}
+	// update virtual marks
+	if att.Counted {
+		if err = api.gradebookSvc.SyncVirtualMarks(att.CourseworkID, att.StudentID); err != nil {
+			return errors.Wrap(err, "syncing virtual marks")
+		}
+	}
+	// reward quiz Rules
+	if _, err = api.walletSvc.EvaluateAttempt(att); err != nil {
+		return errors.Wrap(err, "evaluating wallet rules")
+	}
+	return ctx.JSON(http.StatusOK, att)
+}
+
+// courseworkMiddleware sets the Coursework identified by the `id` param and its Course in echo.Context.
+func (api *courseworkApi) courseworkMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		cw, err := api.svc.GetByID(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == coursework.ErrCourseworkNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding coursework by ID")
+		}
+		if err = setContextCourse(ctx, api.schoolSvc, cw.CourseID); err != nil {
+			return err
+		}
+		ctx.Set("object", cw)
+		return next(ctx)
+	}
+}
+
+// attemptMiddleware sets the Attempt identified by the `id` param in echo.Context.
+// Attempts are only accessible to their Student and to Course managers.
+func (api *courseworkApi) attemptMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		att, err := api.svc.GetAttempt(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == coursework.ErrAttemptNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding attempt by ID")
+		}
+		cw, err := api.svc.GetByID(att.CourseworkID)
+		if err != nil {
+			return errors.Wrap(err, "finding coursework by ID")
+		}
+		if err = setContextCourse(ctx, api.schoolSvc, cw.CourseID); err != nil {
+			return err
+		}
+		if studentID, manage, err := contextStudentID(ctx); err != nil {
+			return err
+		} else if !manage && att.StudentID != studentID {
+			return errHttpNotFound
+		}
+		ctx.Set("object", att)
+		return next(ctx)
+	}
+}
+
+// setContextCourse sets the Course identified by courseID in echo.Context when the context user can access it.
+func setContextCourse(ctx echo.Context, svc school.ServiceInterface, courseID string) error {
+	crs, err := svc.GetCourse(courseID)
+	if err != nil {
+		return errors.Wrap(err, "finding course by ID")
+	}
+	if err = checkCourseAccess(ctx, svc, crs); err != nil {
+		return err
+	}
+	ctx.Set(contextCourseKey, crs)
+	return nil
+}
+
+// courseRouteMiddleware returns the middleware of "/courses/:id/..." endpoints for Course members and managers.
+// They are set per route: a Group with middleware would override the routes that other APIs set on the same prefix.
+func courseRouteMiddleware(jwt echo.MiddlewareFunc, schoolSvc school.ServiceInterface) (member, manager []echo.MiddlewareFunc) {
+	member = []echo.MiddlewareFunc{jwt, courseMiddleware(schoolSvc)}
+	manager = []echo.MiddlewareFunc{jwt, courseMiddleware(schoolSvc), courseManagerMiddleware()}
+	return member, manager
+}
+
+// courseManagerMiddleware only allows admins and the Teacher of the context Course.
+func courseManagerMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if _, manage, err := contextStudentID(ctx); err != nil {
+				return err
+			} else if !manage {
+				return errHttpForbidden
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// contextStudentID returns the ID of the context user and whether they can manage the context Course.
+func contextStudentID(ctx echo.Context) (string, bool, error) {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return "", false, errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return "", false, errors.Wrap(err, "getting context claims")
+	}
+	return claims.Subject, canManageCourse(claims, crs), nil
+}
+
+type AttemptResponse struct {
+	coursework.Attempt
+	Paper []coursework.PaperQuestion `json:"paper"`
+}
@@ -0,0 +1,69 @@
+package echoapi
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type credentialApi struct {
+	svc       credential.ServiceInterface
+	userSvc   user.ServiceInterface
+	schoolSvc school.ServiceInterface
+	validate  *validator.Validate
+}
+
+func registerCredentialAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc credential.ServiceInterface,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+) {
+	api := credentialApi{svc: svc, userSvc: userSvc, schoolSvc: schoolSvc, validate: validate}
+
+	clsMw := classMiddleware(api.schoolSvc)
+	g.POST("/classes/:id/credential-cards", api.classCards, jwt, clsMw, requirePermission(rbac.PermUsersUpdate))
+}
+
+// Handlers
+
+// classCards sets temporary passwords to Students of a Class, and returns the PDF of their credential cards.
+// The PDF is returned directly, not as a media link: it holds the passwords in plain text.
+func (api *credentialApi) classCards(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+	var data CredentialCardsRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to CredentialCardsRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	pdf, err := api.svc.ClassCards(cls, data.StudentIDs, auditActor(ctx, ctxUsr))
+	if err != nil {
+		return errors.Wrap(err, "generating credential cards")
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="credentials.pdf"`)
+	ctx.Response().Header().Set("Cache-Control", "no-store")
+	return ctx.Blob(http.StatusOK, "application/pdf", pdf)
+}
+
+type CredentialCardsRequest struct {
+	StudentIDs []string `json:"student_ids" validate:"required,min=1"`
+}
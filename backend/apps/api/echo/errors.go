@@ -17,9 +17,15 @@ var (
 	errUnauthorized         = echo.NewHTTPError(http.StatusUnauthorized, "user not authenticated")
 	errAuthenticationFailed = echo.NewHTTPError(http.StatusBadRequest, "authentication failed")
 	errAccountDeactivated   = echo.NewHTTPError(http.StatusForbidden, "account deactivated")
+	errSchoolUnavailable    = echo.NewHTTPError(http.StatusForbidden, "School Unavailable")
+	errRefreshInvalid       = echo.NewHTTPError(http.StatusUnauthorized, "invalid refresh token")
 	errRefreshExpired       = echo.NewHTTPError(http.StatusForbidden, "refresh has expired")
+	errChallengeInvalid     = echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired challenge token")
 	errHttpForbidden        = echo.NewHTTPError(http.StatusForbidden, "permission denied")
 	errHttpNotFound         = echo.NewHTTPError(http.StatusNotFound, "not found")
+
+	// not an HTTP error: the User logged in with a temporary password, to be changed before getting tokens
+	errPasswordChangeRequired = errors.New("password change required")
 )
 
 // newAppHTTPErrorHandler returns a custom echo.HTTPErrorHandler that knows how to handle our errors.
@@ -0,0 +1,436 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/wallet"
+)
+
+const maxImportSize = 5 << 20 // 5 MB
+
+type gradebookApi struct {
+	svc        gradebook.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	walletSvc  wallet.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerGradebookAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc gradebook.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	walletSvc wallet.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := gradebookApi{
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		walletSvc:  walletSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// course endpoints
+	memberMw, managerMw := courseRouteMiddleware(jwt, api.schoolSvc)
+	cg := g.Group("/courses/:id")
+	cg.GET("/terms", api.queryTerms, memberMw...)
+	cg.GET("/categories", api.queryCategories, memberMw...)
+	cg.POST("/categories", api.createCategory, managerMw...)
+	cg.DELETE("/categories/:categoryId", api.destroyCategory, managerMw...)
+	cg.GET("/assessments", api.queryAssessments, memberMw...)
+	cg.POST("/assessments", api.createAssessment, managerMw...)
+	cg.DELETE("/assessments/:assessmentId", api.destroyAssessment, managerMw...)
+	cg.PUT("/assessments/:assessmentId/marks", api.enterMarks, managerMw...)
+	cg.GET("/assessments/:assessmentId/imports", api.queryImports, managerMw...)
+	cg.POST("/assessments/:assessmentId/imports", api.previewImport, managerMw...)
+	cg.POST("/assessments/:assessmentId/imports/:importId/apply", api.applyImport, managerMw...)
+	cg.POST("/assessments/:assessmentId/imports/:importId/undo", api.undoImport, managerMw...)
+	cg.GET("/marks", api.queryMarks, memberMw...)
+
+	// class endpoints
+	g.GET("/classes/:id/report", api.classReport, jwt)
+}
+
+// Handlers
+
+// queryTerms returns the Terms of the School of the Course.
+func (api *gradebookApi) queryTerms(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	cls, err := api.schoolSvc.GetClass(crs.ClassID)
+	if err != nil {
+		return errors.Wrap(err, "finding class by ID")
+	}
+	terms, err := api.schoolSvc.QueryTerms(cls.SchoolID)
+	if err != nil {
+		return errors.Wrap(err, "querying terms")
+	}
+	return ctx.JSON(http.StatusOK, terms)
+}
+
+func (api *gradebookApi) createCategory(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data gradebook.NewCategory
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewCategory")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	cat, err := api.svc.CreateCategory(crs.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating category")
+	}
+	return ctx.JSON(http.StatusCreated, cat)
+}
+
+func (api *gradebookApi) queryCategories(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var query TermQuery
+	if err := ctx.Bind(&query); err != nil {
+		return ctx.JSON(http.StatusOK, []gradebook.Category{})
+	}
+
+	cats, err := api.svc.QueryCategories(crs.ID, query.TermID)
+	if err != nil {
+		return errors.Wrap(err, "querying categories")
+	}
+	return ctx.JSON(http.StatusOK, cats)
+}
+
+func (api *gradebookApi) destroyCategory(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	cat, err := api.svc.GetCategory(ctx.Param("categoryId"))
+	if err != nil {
+		if errors.Cause(err) == gradebook.ErrCategoryNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding category by ID")
+	}
+	if cat.CourseID != crs.ID {
+		return errHttpNotFound
+	}
+
+	if err = api.svc.DeleteCategory(cat.ID); err != nil {
+		return errors.Wrap(err, "deleting category")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *gradebookApi) createAssessment(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data gradebook.NewAssessment
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewAssessment")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	a, err := api.svc.CreateAssessment(crs.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating assessment")
+	}
+	return ctx.JSON(http.StatusCreated, a)
+}
+
+func (api *gradebookApi) queryAssessments(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var query TermQuery
+	if err := ctx.Bind(&query); err != nil {
+		return ctx.JSON(http.StatusOK, []gradebook.Assessment{})
+	}
+
+	as, err := api.svc.QueryAssessments(crs.ID, query.TermID)
+	if err != nil {
+		return errors.Wrap(err, "querying assessments")
+	}
+	return ctx.JSON(http.StatusOK, as)
+}
+
+// getCourseAssessment returns the Assessment identified by the `assessmentId` param if it belongs to the context Course.
+func (api *gradebookApi) getCourseAssessment(ctx echo.Context) (gradebook.Assessment, error) {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return gradebook.Assessment{}, errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	a, err := api.svc.GetAssessment(ctx.Param("assessmentId"))
+	if err != nil {
+		if errors.Cause(err) == gradebook.ErrAssessmentNotFound {
+			return gradebook.Assessment{}, errHttpNotFound
+		}
+		return gradebook.Assessment{}, errors.Wrap(err, "finding assessment by ID")
+	}
+	cat, err := api.svc.GetCategory(a.CategoryID)
+	if err != nil {
+		return gradebook.Assessment{}, errors.Wrap(err, "finding category by ID")
+	}
+	if cat.CourseID != crs.ID {
+		return gradebook.Assessment{}, errHttpNotFound
+	}
+	return a, nil
+}
+
+func (api *gradebookApi) destroyAssessment(ctx echo.Context) error {
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return err
+	}
+	if err = api.svc.DeleteAssessment(a.ID); err != nil {
+		return errors.Wrap(err, "deleting assessment")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *gradebookApi) enterMarks(ctx echo.Context) error {
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return err
+	}
+
+	var data gradebook.EnterMarks
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to EnterMarks")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	marks, err := api.svc.EnterMarks(a, data)
+	if err != nil {
+		return errors.Wrap(err, "entering marks")
+	}
+	if _, err = api.walletSvc.EvaluateMarks(a, marks); err != nil {
+		return errors.Wrap(err, "evaluating wallet rules")
+	}
+	return ctx.JSON(http.StatusOK, marks)
+}
+
+func (api *gradebookApi) queryImports(ctx echo.Context) error {
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return err
+	}
+	imps, err := api.svc.QueryImports(a.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying imports")
+	}
+	return ctx.JSON(http.StatusOK, imps)
+}
+
+// previewImport reads official Marks from an uploaded CSV or XLSX file,
+// and returns the pending MarkImport with its diff against the current Marks.
+func (api *gradebookApi) previewImport(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return err
+	}
+
+	var cols gradebook.ImportColumns
+	if err = ctx.Bind(&cols); err != nil {
+		return errors.Wrap(err, "binding to ImportColumns")
+	}
+	cols.Clean()
+
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return core.NewValidationError(err, core.FieldError{Field: "file", Error: "required"})
+	}
+	if fh.Size > maxImportSize {
+		return core.NewValidationError(nil, core.FieldError{Field: "file", Error: "file too large"})
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return errors.Wrap(err, "opening uploaded file")
+	}
+	defer f.Close()
+
+	rows, err := gradebook.ReadSheet(fh.Filename, f)
+	if err != nil {
+		return err
+	}
+	imp, err := api.svc.PreviewImport(a, claims.Subject, fh.Filename, rows, cols)
+	if err != nil {
+		return errors.Wrap(err, "previewing import")
+	}
+	return ctx.JSON(http.StatusCreated, imp)
+}
+
+// getAssessmentImport returns the MarkImport identified by the `importId` param if it belongs to the context Assessment.
+func (api *gradebookApi) getAssessmentImport(ctx echo.Context) (gradebook.MarkImport, error) {
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return gradebook.MarkImport{}, err
+	}
+	imp, err := api.svc.GetImport(ctx.Param("importId"))
+	if err != nil {
+		if errors.Cause(err) == gradebook.ErrImportNotFound {
+			return gradebook.MarkImport{}, errHttpNotFound
+		}
+		return gradebook.MarkImport{}, errors.Wrap(err, "finding import by ID")
+	}
+	if imp.AssessmentID != a.ID {
+		return gradebook.MarkImport{}, errHttpNotFound
+	}
+	return imp, nil
+}
+
+func (api *gradebookApi) applyImport(ctx echo.Context) error {
+	a, err := api.getCourseAssessment(ctx)
+	if err != nil {
+		return err
+	}
+	imp, err := api.getAssessmentImport(ctx)
+	if err != nil {
+		return err
+	}
+	if imp, err = api.svc.ApplyImport(imp); err != nil {
+		return errors.Wrap(err, "applying import")
+	}
+
+	// reward mark Rules for the new and changed Marks
+	var marks []gradebook.Mark
+	for _, e := range imp.Entries {
+		if e.Status == gradebook.EntryNew || e.Status == gradebook.EntryChanged {
+			marks = append(marks, gradebook.Mark{AssessmentID: a.ID, StudentID: e.StudentID, Score: e.Score})
+		}
+	}
+	if _, err = api.walletSvc.EvaluateMarks(a, marks); err != nil {
+		return errors.Wrap(err, "evaluating wallet rules")
+	}
+	return ctx.JSON(http.StatusOK, imp)
+}
+
+func (api *gradebookApi) undoImport(ctx echo.Context) error {
+	imp, err := api.getAssessmentImport(ctx)
+	if err != nil {
+		return err
+	}
+	if imp, err = api.svc.UndoImport(imp); err != nil {
+		return errors.Wrap(err, "undoing import")
+	}
+	return ctx.JSON(http.StatusOK, imp)
+}
+
+// queryMarks returns all Marks to Course managers, and only their own published Marks to Students.
+func (api *gradebookApi) queryMarks(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var query TermQuery
+	if err := ctx.Bind(&query); err != nil {
+		return ctx.JSON(http.StatusOK, []gradebook.Mark{})
+	}
+
+	studentID, manage, err := contextStudentID(ctx)
+	if err != nil {
+		return err
+	}
+	var marks []gradebook.Mark
+	if manage {
+		marks, err = api.svc.CourseMarks(crs, query.TermID, false)
+	} else {
+		marks, err = api.svc.CourseMarks(crs, query.TermID, true, studentID)
+	}
+	if err != nil {
+		return errors.Wrap(err, "querying marks")
+	}
+	return ctx.JSON(http.StatusOK, marks)
+}
+
+// classReport returns the Term Reports of all Students to admins,
+// and only their own Report, computed from published Marks, to Students of the Class.
+func (api *gradebookApi) classReport(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	var query ReportRequest
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to ReportRequest")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	cls, err := api.schoolSvc.GetClass(ctx.Param("id"))
+	if err != nil {
+		if errors.Cause(err) == school.ErrClassNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding class by ID")
+	}
+
+	if claims.IsAdmin {
+		reports, err := api.svc.ClassReports(cls, query.TermID, false)
+		if err != nil {
+			return errors.Wrap(err, "building reports")
+		}
+		return ctx.JSON(http.StatusOK, reports)
+	}
+
+	if claims.IsStudent {
+		reports, err := api.svc.ClassReports(cls, query.TermID, true)
+		if err != nil {
+			return errors.Wrap(err, "building reports")
+		}
+		for _, rep := range reports {
+			if rep.StudentID == claims.Subject {
+				return ctx.JSON(http.StatusOK, []gradebook.Report{rep})
+			}
+		}
+	}
+	return errHttpNotFound
+}
+
+type (
+	TermQuery struct {
+		TermID string `query:"term_id"`
+	}
+
+	ReportRequest struct {
+		TermID string `query:"term_id" validate:"required,uuid"`
+	}
+)
@@ -0,0 +1,205 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var contextChildIDKey = "childID"
+
+type guardianApi struct {
+	conf       *core.Config
+	svc        guardian.ServiceInterface
+	userSvc    user.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerGuardianAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	conf *core.Config,
+	svc guardian.ServiceInterface,
+	userSvc user.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := guardianApi{
+		conf:       conf,
+		svc:        svc,
+		userSvc:    userSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// management
+	sg := g.Group("/students/:id/guardians", jwt, api.studentMiddleware)
+	sg.GET("", api.queryGuardians, requirePermission(rbac.PermUsersRead))
+	sg.POST("", api.invite, requirePermission(rbac.PermUsersCreate))
+	sg.DELETE("/:guardianId", api.unlink, requirePermission(rbac.PermUsersUpdate))
+
+	// guardian portal: read-only
+	g.GET("/me/children", api.children, jwt)
+	cg := g.Group("/children/:id", jwt, api.childMiddleware)
+	cg.GET("/marks", api.childMarks)
+	cg.GET("/attendance", api.childAttendance)
+	cg.GET("/announcements", api.childAnnouncements)
+	cg.GET("/coursework", api.childDueDates)
+}
+
+// Handlers
+
+func (api *guardianApi) queryGuardians(ctx echo.Context) error {
+	student, ok := ctx.Get("object").(user.User)
+	if !ok {
+		return errors.Wrap(errUsrNotFoundInCtx, "retrieving object from context")
+	}
+	ms, err := api.svc.Guardians(student.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying guardians")
+	}
+	return ctx.JSON(http.StatusOK, ms)
+}
+
+// invite links a Guardian to the context Student; Guardians new to the app get an email to sign up.
+func (api *guardianApi) invite(ctx echo.Context) error {
+	student, ok := ctx.Get("object").(user.User)
+	if !ok {
+		return errors.Wrap(errUsrNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data guardian.Invitation
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to Invitation")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	l, err := api.svc.Invite(student, data, auditActor(ctx, ctxUsr))
+	if err != nil {
+		return errors.Wrap(err, "inviting guardian")
+	}
+	return ctx.JSON(http.StatusCreated, l)
+}
+
+func (api *guardianApi) unlink(ctx echo.Context) error {
+	student, ok := ctx.Get("object").(user.User)
+	if !ok {
+		return errors.Wrap(errUsrNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.Unlink(ctx.Param("guardianId"), student.ID); err != nil {
+		if errors.Cause(err) == guardian.ErrLinkNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "unlinking guardian")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *guardianApi) children(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	ms, err := api.svc.Children(claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "querying children")
+	}
+	return ctx.JSON(http.StatusOK, ms)
+}
+
+func (api *guardianApi) childMarks(ctx echo.Context) error {
+	cms, err := api.svc.ChildMarks(ctx.Get(contextChildIDKey).(string), ctx.QueryParam("term_id"))
+	if err != nil {
+		return errors.Wrap(err, "querying child marks")
+	}
+	return ctx.JSON(http.StatusOK, cms)
+}
+
+func (api *guardianApi) childAttendance(ctx echo.Context) error {
+	cas, err := api.svc.ChildAttendance(ctx.Get(contextChildIDKey).(string))
+	if err != nil {
+		return errors.Wrap(err, "querying child attendance")
+	}
+	return ctx.JSON(http.StatusOK, cas)
+}
+
+func (api *guardianApi) childAnnouncements(ctx echo.Context) error {
+	ds, err := api.svc.ChildAnnouncements(ctx.Get(contextChildIDKey).(string))
+	if err != nil {
+		return errors.Wrap(err, "querying child announcements")
+	}
+	if ds == nil {
+		ds = []announcement.Delivery{}
+	}
+	for i := range ds {
+		for j := range ds[i].Attachments {
+			ds[i].Attachments[j].URL = core.SignMediaURL(api.conf, ds[i].Attachments[j].Name)
+		}
+	}
+	return ctx.JSON(http.StatusOK, ds)
+}
+
+func (api *guardianApi) childDueDates(ctx echo.Context) error {
+	filter := new(guardian.DueDateFilter)
+	if err := ctx.Bind(filter); err != nil {
+		return ctx.JSON(http.StatusOK, []guardian.DueDate{})
+	}
+	dds, err := api.svc.ChildDueDates(ctx.Get(contextChildIDKey).(string), *filter)
+	if err != nil {
+		return errors.Wrap(err, "querying child due dates")
+	}
+	return ctx.JSON(http.StatusOK, dds)
+}
+
+// studentMiddleware sets the Student identified by the `id` param in echo.Context.
+func (api *guardianApi) studentMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		usr, err := api.userSvc.GetByID(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding user by ID")
+		}
+		if !usr.IsStudent() {
+			return errHttpNotFound
+		}
+		ctx.Set("object", usr)
+		return next(ctx)
+	}
+}
+
+// childMiddleware sets the ID of the `id` param in echo.Context when the context user is a Guardian of that Student.
+func (api *guardianApi) childMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		claims, err := getContextClaims(ctx)
+		if err != nil {
+			return errors.Wrap(err, "getting context claims")
+		}
+		ok, err := api.svc.IsGuardianOf(claims.Subject, ctx.Param("id"))
+		if err != nil {
+			return errors.Wrap(err, "checking guardian link")
+		}
+		if !ok {
+			return errHttpNotFound
+		}
+		ctx.Set(contextChildIDKey, ctx.Param("id"))
+		return next(ctx)
+	}
+}
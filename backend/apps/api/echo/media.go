@@ -0,0 +1,52 @@
+package echoapi
+
+import (
+	"mime"
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+type mediaApi struct {
+	conf  *core.Config
+	media core.MediaStorage
+}
+
+// registerMediaAPI serves media files to anyone holding a signed URL (see core.SignMediaURL).
+func registerMediaAPI(g *echo.Group, conf *core.Config, media core.MediaStorage) {
+	api := mediaApi{
+		conf:  conf,
+		media: media,
+	}
+
+	g.GET("/media/*", api.download)
+}
+
+// Handlers
+
+func (api *mediaApi) download(ctx echo.Context) error {
+	name := ctx.Param("*")
+	if err := core.VerifyMediaSignature(api.conf, name, ctx.QueryParam("expires"), ctx.QueryParam("signature")); err != nil {
+		return errHttpNotFound
+	}
+
+	f, err := api.media.Open(name)
+	if err != nil {
+		if errors.Cause(err) == core.ErrMediaNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "opening media")
+	}
+	defer f.Close()
+
+	ct := mime.TypeByExtension(path.Ext(name))
+	if ct == "" {
+		ct = echo.MIMEOctetStream
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": path.Base(name)}))
+	return ctx.Stream(http.StatusOK, ct, f)
+}
@@ -3,19 +3,78 @@ package echoapi
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// services used by requirePermission
+	permRBACSvc   rbac.ServiceInterface
+	permSchoolSvc school.ServiceInterface
 )
 
-func adminMiddleware(roles ...string) echo.MiddlewareFunc {
+func initPermissions(rbacSvc rbac.ServiceInterface, schoolSvc school.ServiceInterface) {
+	permRBACSvc = rbacSvc
+	permSchoolSvc = schoolSvc
+}
+
+// requirePermission only allows context users with all perms, granted by their built-in roles or by their
+// custom Roles in the School of the context object; it must come after the middleware setting that object.
+func requirePermission(perms ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
-			claims, err := getContextClaims(ctx)
+			ok, err := contextHasPermissions(ctx, perms...)
 			if err != nil {
-				return errors.Wrap(err, "getting context claims")
+				return err
 			}
-			if claims.IsAdmin && contextHasAnyRole(ctx, roles) {
-				return next(ctx)
+			if !ok {
+				return errHttpForbidden
 			}
-			return errHttpForbidden
+			return next(ctx)
 		}
 	}
 }
+
+// contextHasPermissions reports whether the context user has all perms; see requirePermission.
+func contextHasPermissions(ctx echo.Context, perms ...string) (bool, error) {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "getting context claims")
+	}
+	// the claims hold all that built-in roles need: most checks need no query
+	usr := user.User{ID: claims.Subject, Roles: claims.Roles}
+	if rbac.RolePermissions(usr.Roles).HasAll(perms...) {
+		return true, nil
+	}
+	schoolID, err := contextSchoolID(ctx)
+	if err != nil {
+		return false, err
+	}
+	ok, err := permRBACSvc.HasPermissions(usr, schoolID, perms...)
+	return ok, errors.Wrap(err, "checking permissions")
+}
+
+// contextSchoolID returns the ID of the School of the context object, if any.
+func contextSchoolID(ctx echo.Context) (string, error) {
+	if crs, ok := ctx.Get(contextCourseKey).(school.Course); ok {
+		cls, err := permSchoolSvc.GetClass(crs.ClassID)
+		if err != nil {
+			return "", errors.Wrap(err, "finding class by ID")
+		}
+		return cls.SchoolID, nil
+	}
+	switch obj := ctx.Get("object").(type) {
+	case school.School:
+		return obj.ID, nil
+	case school.Class:
+		return obj.SchoolID, nil
+	case announcement.Announcement:
+		return obj.SchoolID, nil
+	case rbac.Role:
+		return obj.SchoolID, nil
+	}
+	return "", nil
+}
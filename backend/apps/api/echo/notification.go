@@ -0,0 +1,234 @@
+package echoapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/notification"
+)
+
+const (
+	sseHeartbeat  = 30 * time.Second // keeps proxies from closing idle streams
+	sseBufferSize = 64               // Notifications queued per stream
+)
+
+type notificationApi struct {
+	svc        notification.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerNotificationAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc notification.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := notificationApi{
+		svc:        svc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	mg := g.Group("/me")
+	mg.GET("/notifications", api.inbox, jwt)
+	mg.POST("/notifications/read", api.markAllRead, jwt)
+	mg.GET("/notifications/stream", api.stream, queryJWTMiddleware())
+	mg.POST("/notifications/:id/read", api.markRead, jwt)
+	mg.GET("/notification-preferences", api.preferences, jwt)
+	mg.PUT("/notification-preferences", api.updatePreferences, jwt)
+	mg.GET("/push-key", api.pushKey, jwt)
+	mg.POST("/push-subscriptions", api.subscribePush, jwt)
+	mg.DELETE("/push-subscriptions", api.unsubscribePush, jwt)
+}
+
+// Handlers
+
+// inbox returns a page of the in-app Notifications of the context user (?unread=true&limit=50&offset=0).
+func (api *notificationApi) inbox(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	var query notification.Query
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to Query")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+	page, err := api.svc.Inbox(claims.Subject, query)
+	if err != nil {
+		return errors.Wrap(err, "querying notifications")
+	}
+	return ctx.JSON(http.StatusOK, page)
+}
+
+func (api *notificationApi) markRead(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	n, err := api.svc.GetNotification(ctx.Param("id"), claims.Subject)
+	if err != nil {
+		if errors.Cause(err) == notification.ErrNotificationNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding notification")
+	}
+	if err = api.svc.MarkRead(claims.Subject, n.ID); err != nil {
+		return errors.Wrap(err, "marking notification as read")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *notificationApi) markAllRead(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if err = api.svc.MarkRead(claims.Subject); err != nil {
+		return errors.Wrap(err, "marking notifications as read")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// stream sends the new in-app Notifications of the context user as server-sent events, until disconnected.
+func (api *notificationApi) stream(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	// drop the Notifications of slow clients rather than blocking the other streams
+	out := make(chan core.Notification, sseBufferSize)
+	unsubscribe, err := api.svc.Subscribe(claims.Subject, func(n core.Notification) {
+		select {
+		case out <- n:
+		default:
+		}
+	})
+	if err != nil {
+		return errors.Wrap(err, "subscribing to notifications")
+	}
+	defer unsubscribe()
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("X-Accel-Buffering", "no") // disable nginx buffering
+	resp.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprint(resp, ": connected\n\n")
+	resp.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil // disconnected
+		case n := <-out:
+			data, err := json.Marshal(n)
+			if err != nil {
+				return errors.Wrap(err, "marshalling notification")
+			}
+			_, _ = fmt.Fprintf(resp, "id: %s\nevent: notification\ndata: %s\n\n", n.ID, data)
+		case <-heartbeat.C:
+			_, _ = fmt.Fprint(resp, ": heartbeat\n\n")
+		}
+		resp.Flush()
+	}
+}
+
+func (api *notificationApi) preferences(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	prefs, err := api.svc.Preferences(claims.Subject)
+	if err != nil {
+		return errors.Wrap(err, "querying preferences")
+	}
+	return ctx.JSON(http.StatusOK, prefs)
+}
+
+// updatePreferences sets the channels of the listed events; the others are left unchanged.
+func (api *notificationApi) updatePreferences(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	var data notification.UpdatePreferences
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to UpdatePreferences")
+	}
+	if err = api.validate.Struct(data); err != nil {
+		return err
+	}
+	prefs, err := api.svc.SetPreferences(claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "setting preferences")
+	}
+	return ctx.JSON(http.StatusOK, prefs)
+}
+
+// pushKey returns the VAPID public key browsers subscribe to Web Push with; empty when Web Push is disabled.
+func (api *notificationApi) pushKey(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, PushKeyResponse{PublicKey: api.svc.PushKey()})
+}
+
+func (api *notificationApi) subscribePush(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	var data core.PushSubscription
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to PushSubscription")
+	}
+	if err = api.validate.Struct(data); err != nil {
+		return err
+	}
+	sub, err := api.svc.SavePushSubscription(claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "saving push subscription")
+	}
+	return ctx.JSON(http.StatusCreated, sub)
+}
+
+func (api *notificationApi) unsubscribePush(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	var data PushUnsubscribeRequest
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to PushUnsubscribeRequest")
+	}
+	if err = api.validate.Struct(data); err != nil {
+		return err
+	}
+	if err = api.svc.DeletePushSubscription(claims.Subject, data.Endpoint); err != nil {
+		return errors.Wrap(err, "deleting push subscription")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+type (
+	PushKeyResponse struct {
+		PublicKey string `json:"public_key"`
+	}
+
+	PushUnsubscribeRequest struct {
+		Endpoint string `json:"endpoint" validate:"required"`
+	}
+)
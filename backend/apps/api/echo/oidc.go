@@ -0,0 +1,179 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/twofactor"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type oidcApi struct {
+	svc          oidc.ServiceInterface
+	userSvc      user.ServiceInterface
+	schoolSvc    school.ServiceInterface
+	sessionSvc   session.ServiceInterface
+	twoFactorSvc twofactor.ServiceInterface
+	validate     *validator.Validate
+	translator   ut.Translator
+}
+
+func registerOIDCAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc oidc.ServiceInterface,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	sessionSvc session.ServiceInterface,
+	twoFactorSvc twofactor.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := oidcApi{
+		svc:          svc,
+		userSvc:      userSvc,
+		schoolSvc:    schoolSvc,
+		sessionSvc:   sessionSvc,
+		twoFactorSvc: twoFactorSvc,
+		validate:     validate,
+		translator:   translator,
+	}
+
+	// sign-in with an OpenID Connect provider (eg. Google Workspace)
+	ag := g.Group("/auth/oidc")
+	ag.GET("/providers", api.providers)
+	ag.GET("/:provider/authorize", api.authorize)
+	ag.POST("/:provider/callback", api.callback)
+
+	// email domains of the Users provisioned for a School
+	manage := requirePermission(rbac.PermSchoolsManage)
+	schMw := schoolMiddleware(api.schoolSvc)
+	g.GET("/schools/:id/email-domains", api.queryDomains, jwt, schMw, manage)
+	g.POST("/schools/:id/email-domains", api.setDomain, jwt, schMw, manage)
+	g.DELETE("/schools/:id/email-domains/:domain", api.deleteDomain, jwt, schMw, manage)
+}
+
+// oidcError maps the errors of the oidc.Service to HTTP errors.
+func oidcError(err error, msg string) error {
+	switch err {
+	case oidc.ErrUnknownProvider:
+		return errHttpNotFound
+	case oidc.ErrInvalidState, oidc.ErrAuthorizationFailed, oidc.ErrInvalidIDToken:
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	case oidc.ErrNoAccount:
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	return errors.Wrap(err, msg)
+}
+
+// Handlers
+
+func (api *oidcApi) providers(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, ProvidersResponse{Providers: api.svc.Providers()})
+}
+
+// authorize starts a sign-in: the frontend sends the User to the returned URL, and gets them back at the
+// redirect URI with the `code` & `state` to post to `callback`.
+func (api *oidcApi) authorize(ctx echo.Context) error {
+	provider := ctx.Param("provider")
+	uri, err := api.svc.AuthCodeURL(provider)
+	if err != nil {
+		return oidcError(err, "starting sign-in")
+	}
+	return ctx.JSON(http.StatusOK, AuthorizeResponse{AuthorizationURL: uri, RedirectURI: api.svc.RedirectURI(provider)})
+}
+
+func (api *oidcApi) callback(ctx echo.Context) error {
+	var data OIDCCallbackRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to OIDCCallbackRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	usr, err := api.svc.Authenticate(ctx.Param("provider"), data.Code, data.State)
+	if err != nil {
+		return oidcError(err, "authenticating")
+	}
+	if err = checkUserAvailable(usr, api.schoolSvc); err != nil {
+		return err
+	}
+
+	resp, err := completeLogin(usr, api.userSvc, api.twoFactorSvc, api.sessionSvc)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (api *oidcApi) queryDomains(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	ds, err := api.svc.QueryDomains(sch.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying domains")
+	}
+	return ctx.JSON(http.StatusOK, ds)
+}
+
+func (api *oidcApi) setDomain(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data oidc.NewDomain
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewDomain")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	d, err := api.svc.SetDomain(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "setting domain")
+	}
+	return ctx.JSON(http.StatusOK, d)
+}
+
+func (api *oidcApi) deleteDomain(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.DeleteDomain(sch.ID, ctx.Param("domain")); err != nil {
+		if errors.Cause(err) == oidc.ErrDomainNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "deleting domain")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+type (
+	ProvidersResponse struct {
+		Providers []string `json:"providers"`
+	}
+
+	AuthorizeResponse struct {
+		AuthorizationURL string `json:"authorization_url"`
+		RedirectURI      string `json:"redirect_uri"` // where the provider sends the User back
+	}
+
+	OIDCCallbackRequest struct {
+		Code  string `json:"code" validate:"required"`
+		State string `json:"state" validate:"required"`
+	}
+)
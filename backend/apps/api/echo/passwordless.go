@@ -0,0 +1,134 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/twofactor"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type passwordlessApi struct {
+	svc          passwordless.ServiceInterface
+	userSvc      user.ServiceInterface
+	schoolSvc    school.ServiceInterface
+	sessionSvc   session.ServiceInterface
+	twoFactorSvc twofactor.ServiceInterface
+	validate     *validator.Validate
+	translator   ut.Translator
+}
+
+func registerPasswordlessAPI(
+	g *echo.Group,
+	svc passwordless.ServiceInterface,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	sessionSvc session.ServiceInterface,
+	twoFactorSvc twofactor.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := passwordlessApi{
+		svc:          svc,
+		userSvc:      userSvc,
+		schoolSvc:    schoolSvc,
+		sessionSvc:   sessionSvc,
+		twoFactorSvc: twoFactorSvc,
+		validate:     validate,
+		translator:   translator,
+	}
+
+	// login without password, for the members of the Schools enabling it
+	lg := g.Group("/users/login/passwordless")
+	lg.POST("", api.request)
+	lg.POST("/link", api.loginWithLink)
+	lg.POST("/code", api.loginWithCode)
+}
+
+// passwordlessError maps the errors of the passwordless.Service to HTTP errors.
+func passwordlessError(err error, msg string) error {
+	switch err {
+	case passwordless.ErrInvalidLink:
+		return core.NewValidationError(nil, core.FieldError{Field: "token", Error: err.Error()})
+	case passwordless.ErrInvalidCode:
+		return core.NewValidationError(nil, core.FieldError{Field: "code", Error: err.Error()})
+	case passwordless.ErrTooManyAttempts:
+		return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+	}
+	return errors.Wrap(err, msg)
+}
+
+// Handlers
+
+func (api *passwordlessApi) request(ctx echo.Context) error {
+	var data passwordless.LoginRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to LoginRequest")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	err := api.svc.RequestLogin(data)
+	switch errors.Cause(err) {
+	case nil, user.ErrNotFound, passwordless.ErrNotAllowed, passwordless.ErrNoPhone, passwordless.ErrTooManyRequests:
+	default:
+		// do not return errors to attackers
+		ctx.Logger().Errorf("%+v", errors.Wrap(err, "requesting passwordless login"))
+	}
+	return ctx.JSON(http.StatusOK, SuccessResponse{
+		Success: "If the login supplied is associated with an account allowed to log in without password, " +
+			"a message will arrive shortly with your login " + data.Method + ".",
+	})
+}
+
+func (api *passwordlessApi) loginWithLink(ctx echo.Context) error {
+	var data passwordless.LinkLogin
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to LinkLogin")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	usr, err := api.svc.LoginWithLink(data)
+	if err != nil {
+		return passwordlessError(err, "logging in with link")
+	}
+	return api.completeLogin(ctx, usr)
+}
+
+func (api *passwordlessApi) loginWithCode(ctx echo.Context) error {
+	var data passwordless.CodeLogin
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to CodeLogin")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	usr, err := api.svc.LoginWithCode(data)
+	if err != nil {
+		return passwordlessError(err, "logging in with code")
+	}
+	return api.completeLogin(ctx, usr)
+}
+
+func (api *passwordlessApi) completeLogin(ctx echo.Context, usr user.User) error {
+	if err := checkUserAvailable(usr, api.schoolSvc); err != nil {
+		return err
+	}
+	resp, err := completeLogin(usr, api.userSvc, api.twoFactorSvc, api.sessionSvc)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
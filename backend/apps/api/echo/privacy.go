@@ -0,0 +1,40 @@
+package echoapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type privacyApi struct {
+	svc     privacy.ServiceInterface
+	userSvc user.ServiceInterface
+}
+
+func registerPrivacyAPI(g *echo.Group, jwt echo.MiddlewareFunc, svc privacy.ServiceInterface, userSvc user.ServiceInterface) {
+	api := privacyApi{svc: svc, userSvc: userSvc}
+
+	g.GET("/users/me/data", api.export, jwt)
+}
+
+// Handlers
+
+// export returns a zip archive of the personal data of the context User.
+func (api *privacyApi) export(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	data, err := api.svc.Export(usr)
+	if err != nil {
+		return errors.Wrap(err, "exporting personal data")
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="my-data.zip"`)
+	ctx.Response().Header().Set("Cache-Control", "no-store")
+	return ctx.Blob(http.StatusOK, "application/zip", data)
+}
@@ -0,0 +1,183 @@
+package echoapi
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const maxAvatarSize = 5 << 20 // 5 MB
+
+type profileApi struct {
+	userSvc   user.ServiceInterface
+	avatarSvc avatar.ServiceInterface
+	validate  *validator.Validate
+}
+
+func registerProfileAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	userSvc user.ServiceInterface,
+	avatarSvc avatar.ServiceInterface,
+	validate *validator.Validate,
+) {
+	api := profileApi{userSvc: userSvc, avatarSvc: avatarSvc, validate: validate}
+
+	// un-authed: from the links sent to the addresses
+	g.POST("/users/email-change-confirm", api.confirmEmailChange)
+	g.POST("/users/email-verify", api.verifyEmail)
+
+	// own profile
+	mg := g.Group("/users/me", jwt)
+	mg.GET("", api.retrieve)
+	mg.PATCH("", api.update)
+	mg.PUT("/avatar", api.uploadAvatar)
+	mg.DELETE("/avatar", api.removeAvatar)
+	mg.POST("/email-verification", api.requestEmailVerification)
+}
+
+func (api *profileApi) profile(usr user.User) ProfileResponse {
+	return ProfileResponse{User: usr, Avatar: api.avatarSvc.URLs(usr)}
+}
+
+// Handlers
+
+func (api *profileApi) retrieve(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	return ctx.JSON(http.StatusOK, api.profile(usr))
+}
+
+// update changes the profile of the context User; a new email is pending until confirmed from that address.
+func (api *profileApi) update(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	var data user.UpdateProfile
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to UpdateProfile")
+	}
+	if err = data.Validate(usr, api.validate, api.userSvc); err != nil {
+		return err
+	}
+
+	usr, err = api.userSvc.UpdateProfile(usr, data)
+	if err != nil {
+		return errors.Wrap(err, "updating profile")
+	}
+	resp := api.profile(usr)
+	resp.PendingEmail = data.Email
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (api *profileApi) confirmEmailChange(ctx echo.Context) error {
+	var data user.EmailChange
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to EmailChange")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	if _, err := api.userSvc.ConfirmEmailChange(data); err != nil {
+		return errors.Wrap(err, "confirming email change")
+	}
+	return ctx.JSON(http.StatusOK, SuccessResponse{Success: "Your email address has been changed."})
+}
+
+// requestEmailVerification emails a new verification link to the context User.
+func (api *profileApi) requestEmailVerification(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	switch err = api.userSvc.RequestEmailVerification(usr); err {
+	case nil:
+	case user.ErrNoEmail, user.ErrEmailVerified:
+		return core.NewValidationError(err)
+	case user.ErrTooManyRequests:
+		return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+	default:
+		return errors.Wrap(err, "requesting email verification")
+	}
+	return ctx.JSON(http.StatusOK, SuccessResponse{
+		Success: "An email will arrive in your inbox shortly with a link to verify your email address.",
+	})
+}
+
+func (api *profileApi) verifyEmail(ctx echo.Context) error {
+	var data user.EmailVerification
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to EmailVerification")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	if _, err := api.userSvc.VerifyEmail(data); err != nil {
+		return errors.Wrap(err, "verifying email")
+	}
+	return ctx.JSON(http.StatusOK, SuccessResponse{Success: "Your email address has been verified."})
+}
+
+// uploadAvatar resizes the uploaded `avatar` image (PNG, JPEG or GIF) into the thumbnails of the context User.
+func (api *profileApi) uploadAvatar(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	fh, err := ctx.FormFile("avatar")
+	if err != nil {
+		return core.NewValidationError(err, core.FieldError{Field: "avatar", Error: "required"})
+	}
+	if fh.Size > maxAvatarSize {
+		return core.NewValidationError(nil, core.FieldError{Field: "avatar", Error: "file too large"})
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return errors.Wrap(err, "opening uploaded file")
+	}
+	defer f.Close()
+
+	usr, err = api.avatarSvc.Upload(usr, f)
+	if err != nil {
+		if cause := errors.Cause(err); cause == avatar.ErrInvalidImage || cause == avatar.ErrImageTooLarge {
+			return core.NewValidationError(err, core.FieldError{Field: "avatar", Error: cause.Error()})
+		}
+		return errors.Wrap(err, "uploading avatar")
+	}
+	return ctx.JSON(http.StatusOK, api.profile(usr))
+}
+
+// removeAvatar removes the uploaded avatar of the context User, who falls back to their Gravatar.
+func (api *profileApi) removeAvatar(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	usr, err = api.avatarSvc.Remove(usr)
+	if err != nil {
+		return errors.Wrap(err, "removing avatar")
+	}
+	return ctx.JSON(http.StatusOK, api.profile(usr))
+}
+
+// ProfileResponse is the profile of the context User, with the URLs of their avatar by size (see avatar.Sizes).
+type ProfileResponse struct {
+	user.User
+	Avatar       map[int]string `json:"avatar"`
+	PendingEmail string         `json:"pending_email,omitempty"` // until confirmed from that address
+}
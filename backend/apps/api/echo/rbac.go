@@ -0,0 +1,237 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var errRoleNotFoundInCtx = errors.New("role object not found in echo.Context")
+
+type rbacApi struct {
+	svc        rbac.ServiceInterface
+	userSvc    user.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerRBACAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc rbac.ServiceInterface,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := rbacApi{
+		svc:        svc,
+		userSvc:    userSvc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	g.GET("/permissions", api.queryPermissions, jwt)
+	g.GET("/me/permissions", api.myPermissions, jwt)
+
+	// custom roles
+	manage := requirePermission(rbac.PermRolesManage)
+	schMw := schoolMiddleware(api.schoolSvc)
+	g.GET("/schools/:id/roles", api.queryRoles, jwt, schMw, manage)
+	g.POST("/schools/:id/roles", api.createRole, jwt, schMw, manage)
+
+	rg := g.Group("/roles/:id", jwt, api.roleMiddleware, manage)
+	rg.GET("", api.retrieveRole)
+	rg.PUT("", api.updateRole)
+	rg.DELETE("", api.deleteRole)
+	rg.GET("/members", api.queryMembers)
+	rg.POST("/members", api.addMembers)
+	rg.DELETE("/members", api.removeMembers)
+}
+
+// Handlers
+
+func (api *rbacApi) queryPermissions(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, rbac.Permissions)
+}
+
+// myPermissions returns the permissions of the context user, including those of their custom Roles in the
+// School set by the `school_id` query param.
+func (api *rbacApi) myPermissions(ctx echo.Context) error {
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	perms, err := api.svc.Permissions(ctxUsr, ctx.QueryParam("school_id"))
+	if err != nil {
+		return errors.Wrap(err, "getting permissions")
+	}
+	return ctx.JSON(http.StatusOK, PermissionsResponse{Permissions: perms.Slice()})
+}
+
+func (api *rbacApi) queryRoles(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	rs, err := api.svc.QueryRoles(sch.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying roles")
+	}
+	return ctx.JSON(http.StatusOK, rs)
+}
+
+func (api *rbacApi) createRole(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	var data rbac.NewRole
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewRole")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	r, err := api.svc.CreateRole(ctxUsr, sch.ID, data)
+	if err != nil {
+		if errors.Cause(err) == rbac.ErrForbidden {
+			return errHttpForbidden
+		}
+		return errors.Wrap(err, "creating role")
+	}
+	return ctx.JSON(http.StatusCreated, r)
+}
+
+func (api *rbacApi) retrieveRole(ctx echo.Context) error {
+	r, ok := ctx.Get("object").(rbac.Role)
+	if !ok {
+		return errors.Wrap(errRoleNotFoundInCtx, "retrieving object from context")
+	}
+	return ctx.JSON(http.StatusOK, r)
+}
+
+func (api *rbacApi) updateRole(ctx echo.Context) error {
+	r, ok := ctx.Get("object").(rbac.Role)
+	if !ok {
+		return errors.Wrap(errRoleNotFoundInCtx, "retrieving object from context")
+	}
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	var data rbac.NewRole
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewRole")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	r, err = api.svc.UpdateRole(ctxUsr, r, data)
+	if err != nil {
+		if errors.Cause(err) == rbac.ErrForbidden {
+			return errHttpForbidden
+		}
+		return errors.Wrap(err, "updating role")
+	}
+	return ctx.JSON(http.StatusOK, r)
+}
+
+func (api *rbacApi) deleteRole(ctx echo.Context) error {
+	r, ok := ctx.Get("object").(rbac.Role)
+	if !ok {
+		return errors.Wrap(errRoleNotFoundInCtx, "retrieving object from context")
+	}
+	if err := api.svc.DeleteRole(r.ID); err != nil {
+		return errors.Wrap(err, "deleting role")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *rbacApi) queryMembers(ctx echo.Context) error {
+	r, ok := ctx.Get("object").(rbac.Role)
+	if !ok {
+		return errors.Wrap(errRoleNotFoundInCtx, "retrieving object from context")
+	}
+	ids, err := api.svc.RoleMemberIDs(r.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying role members")
+	}
+	return ctx.JSON(http.StatusOK, rbac.RoleMembers{IDs: ids})
+}
+
+func (api *rbacApi) addMembers(ctx echo.Context) error {
+	return api.changeMembers(ctx, api.svc.AddRoleMembers)
+}
+
+func (api *rbacApi) removeMembers(ctx echo.Context) error {
+	return api.changeMembers(ctx, api.svc.RemoveRoleMembers)
+}
+
+// changeMembers binds the RoleMembers of a request & gives or takes the context Role to or from them.
+func (api *rbacApi) changeMembers(
+	ctx echo.Context,
+	change func(actor user.User, r rbac.Role, userIDs []string, auditActor core.AuditActor) error,
+) error {
+	r, ok := ctx.Get("object").(rbac.Role)
+	if !ok {
+		return errors.Wrap(errRoleNotFoundInCtx, "retrieving object from context")
+	}
+	ctxUsr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	var data rbac.RoleMembers
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to RoleMembers")
+	}
+	if err = api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	if err = change(ctxUsr, r, data.IDs, auditActor(ctx, ctxUsr)); err != nil {
+		if errors.Cause(err) == rbac.ErrForbidden {
+			return errHttpForbidden
+		}
+		return errors.Wrap(err, "changing role members")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// roleMiddleware sets the Role identified by the `id` param in echo.Context.
+func (api *rbacApi) roleMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		r, err := api.svc.GetRole(ctx.Param("id"))
+		if err != nil {
+			if errors.Cause(err) == rbac.ErrRoleNotFound {
+				return errHttpNotFound
+			}
+			return errors.Wrap(err, "finding role by ID")
+		}
+		ctx.Set("object", r)
+		return next(ctx)
+	}
+}
+
+type PermissionsResponse struct {
+	Permissions []string `json:"permissions"`
+}
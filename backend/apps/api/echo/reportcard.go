@@ -0,0 +1,133 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+)
+
+type reportCardApi struct {
+	conf       *core.Config
+	svc        reportcard.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerReportCardAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	conf *core.Config,
+	svc reportcard.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := reportCardApi{
+		conf:       conf,
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	clsMw := classMiddleware(api.schoolSvc)
+	g.GET("/classes/:id/report-cards/:studentId", api.studentCard, jwt, clsMw)
+	g.POST("/classes/:id/report-cards", api.classCards, jwt, clsMw, requirePermission(rbac.PermReportCardsManage))
+	g.POST("/classes/:id/report-cards/email", api.emailCards, jwt, clsMw, requirePermission(rbac.PermReportCardsManage))
+}
+
+// Handlers
+
+// studentCard returns a signed link to the report card of a Student, to admins and the Student,
+// whose report card only considers published Marks.
+func (api *reportCardApi) studentCard(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+	studentID := ctx.Param("studentId")
+	if !claims.IsAdmin && !(claims.IsStudent && claims.Subject == studentID) {
+		return errHttpNotFound
+	}
+
+	var query ReportRequest
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to ReportRequest")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	name, err := api.svc.StudentCard(cls, query.TermID, studentID, !claims.IsAdmin)
+	if err != nil {
+		if errors.Cause(err) == reportcard.ErrStudentNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "generating report card")
+	}
+	return ctx.JSON(http.StatusOK, MediaLinkResponse{URL: core.SignMediaURL(api.conf, name)})
+}
+
+// classCards returns a signed link to a zip file of the report cards of all Students of a Class.
+func (api *reportCardApi) classCards(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+	var query ReportRequest
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to ReportRequest")
+	}
+	if err := api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	name, err := api.svc.ClassCards(cls, query.TermID)
+	if err != nil {
+		return errors.Wrap(err, "generating report cards")
+	}
+	return ctx.JSON(http.StatusOK, MediaLinkResponse{URL: core.SignMediaURL(api.conf, name)})
+}
+
+func (api *reportCardApi) emailCards(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+	var query ReportRequest
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to ReportRequest")
+	}
+	if err := api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	sent, err := api.svc.EmailCards(cls, query.TermID)
+	if err != nil {
+		return errors.Wrap(err, "emailing report cards")
+	}
+	return ctx.JSON(http.StatusAccepted, EmailCardsResponse{Sent: sent})
+}
+
+type (
+	MediaLinkResponse struct {
+		URL string `json:"url"`
+	}
+
+	EmailCardsResponse struct {
+		Sent int `json:"sent"`
+	}
+)
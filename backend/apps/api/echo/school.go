@@ -0,0 +1,606 @@
+package echoapi
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/sms"
+)
+
+var (
+	errSchNotFoundInCtx = errors.New("school object not found in echo.Context")
+	errClsNotFoundInCtx = errors.New("class object not found in echo.Context")
+	errCrsNotFoundInCtx = errors.New("course object not found in echo.Context")
+	contextCourseKey    = "course"
+)
+
+const maxLogoSize = 1 << 20 // 1 MB
+
+type schoolApi struct {
+	svc        school.ServiceInterface
+	media      core.MediaStorage
+	notifier   core.Notifier
+	smsSvc     sms.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerSchoolAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc school.ServiceInterface,
+	media core.MediaStorage,
+	notifier core.Notifier,
+	smsSvc sms.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := schoolApi{
+		svc:        svc,
+		media:      media,
+		notifier:   notifier,
+		smsSvc:     smsSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// schools
+	sg := g.Group("/schools", jwt)
+	sg.GET("", api.querySchools, requirePermission(rbac.PermSchoolsManage))
+	sg.POST("", api.createSchool, requirePermission(rbac.PermSchoolsManage))
+
+	sdg := sg.Group("/:id", schoolMiddleware(api.svc), requirePermission(rbac.PermSchoolsManage))
+	sdg.GET("", api.retrieveSchool)
+	sdg.PUT("/branding", api.updateBranding)
+	sdg.PUT("/attendance-alert", api.updateAttendanceAlert)
+	sdg.PUT("/sms", api.updateSMSSettings)
+	sdg.PUT("/security", api.updateSecurityPolicy)
+	sdg.GET("/sms-usage", api.smsUsage)
+	sdg.GET("/departments", api.queryDepartments)
+	sdg.POST("/departments", api.createDepartment)
+	sdg.GET("/classes", api.queryClasses)
+	sdg.POST("/classes", api.createClass)
+	sdg.GET("/terms", api.queryTerms)
+	sdg.POST("/terms", api.createTerm)
+
+	// classes
+	cdg := g.Group("/classes/:id", jwt, classMiddleware(api.svc))
+	manageCls := requirePermission(rbac.PermClassesManage)
+	cdg.GET("", api.retrieveClass, manageCls)
+	cdg.GET("/students", api.queryClassStudents, manageCls)
+	cdg.POST("/students", api.addClassStudents, manageCls)
+	cdg.DELETE("/students", api.removeClassStudents, manageCls)
+	cdg.GET("/courses", api.queryClassCourses, manageCls)
+	cdg.POST("/courses", api.createCourse, manageCls)
+	cdg.POST("/publish-marks", api.publishMarks, requirePermission(rbac.PermMarksPublish))
+
+	// courses
+	crg := g.Group("/courses", jwt)
+	crg.GET("", api.queryCourses)
+	crg.GET("/:id", api.retrieveCourse, courseMiddleware(api.svc))
+}
+
+// Handlers
+
+func (api *schoolApi) createSchool(ctx echo.Context) error {
+	var data school.NewSchool
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewSchool")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	sch, err := api.svc.CreateSchool(data)
+	if err != nil {
+		return errors.Wrap(err, "creating school")
+	}
+	return ctx.JSON(http.StatusCreated, sch)
+}
+
+func (api *schoolApi) querySchools(ctx echo.Context) error {
+	schools, err := api.svc.QuerySchools()
+	if err != nil {
+		return errors.Wrap(err, "querying schools")
+	}
+	return ctx.JSON(http.StatusOK, schools)
+}
+
+func (api *schoolApi) retrieveSchool(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+	return ctx.JSON(http.StatusOK, sch)
+}
+
+// updateBranding updates the motto of a School, and its logo if one is uploaded (PNG or JPEG).
+func (api *schoolApi) updateBranding(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.SchoolBranding
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to SchoolBranding")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	if fh, err := ctx.FormFile("logo"); err == nil {
+		ext := strings.ToLower(filepath.Ext(fh.Filename))
+		if ext == ".jpeg" {
+			ext = ".jpg"
+		}
+		if (ext != ".png" && ext != ".jpg") || fh.Size > maxLogoSize {
+			return core.NewValidationError(nil, core.FieldError{Field: "logo", Error: "must be a PNG or JPEG image of 1 MB at most"})
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return errors.Wrap(err, "opening uploaded file")
+		}
+		defer f.Close()
+
+		data.Logo = "schools/" + sch.ID + "/logo" + ext
+		if err = api.media.Save(data.Logo, f); err != nil {
+			return errors.Wrap(err, "saving logo")
+		}
+	} else if err != http.ErrMissingFile && err != http.ErrNotMultipart {
+		return errors.Wrap(err, "reading logo")
+	}
+
+	sch, err := api.svc.SetBranding(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating branding")
+	}
+	return ctx.JSON(http.StatusOK, sch)
+}
+
+func (api *schoolApi) updateAttendanceAlert(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.AttendanceAlert
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to AttendanceAlert")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	sch, err := api.svc.SetAttendanceAlert(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating attendance alert")
+	}
+	return ctx.JSON(http.StatusOK, sch)
+}
+
+func (api *schoolApi) updateSecurityPolicy(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.SecurityPolicy
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to SecurityPolicy")
+	}
+
+	sch, err := api.svc.SetSecurityPolicy(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating security policy")
+	}
+	return ctx.JSON(http.StatusOK, sch)
+}
+
+func (api *schoolApi) updateSMSSettings(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.SMSSettings
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to SMSSettings")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	sch, err := api.svc.SetSMSSettings(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "updating sms settings")
+	}
+	return ctx.JSON(http.StatusOK, sch)
+}
+
+// smsUsage returns the number of text messages sent by a School in a month (?month=2026-10); the current one by default.
+func (api *schoolApi) smsUsage(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	month := time.Now()
+	if param := ctx.QueryParam("month"); param != "" {
+		var err error
+		if month, err = time.Parse("2006-01", param); err != nil {
+			return core.NewValidationError(err, core.FieldError{Field: "month", Error: "invalid value"})
+		}
+	}
+	usage, err := api.smsSvc.Usage(sch.ID, month)
+	if err != nil {
+		return errors.Wrap(err, "finding sms usage")
+	}
+	return ctx.JSON(http.StatusOK, usage)
+}
+
+func (api *schoolApi) createDepartment(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.NewDepartment
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewDepartment")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	dept, err := api.svc.CreateDepartment(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating department")
+	}
+	return ctx.JSON(http.StatusCreated, dept)
+}
+
+func (api *schoolApi) queryDepartments(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	depts, err := api.svc.QueryDepartments(sch.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying departments")
+	}
+	return ctx.JSON(http.StatusOK, depts)
+}
+
+func (api *schoolApi) createClass(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.NewClass
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewClass")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	cls, err := api.svc.CreateClass(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating class")
+	}
+	return ctx.JSON(http.StatusCreated, cls)
+}
+
+func (api *schoolApi) queryClasses(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	filter := new(school.ClassFilter)
+	if err := ctx.Bind(filter); err != nil {
+		return ctx.JSON(http.StatusOK, []school.Class{})
+	}
+	filter.SchoolID = sch.ID
+
+	classes, err := api.svc.QueryClasses(*filter)
+	if err != nil {
+		return errors.Wrap(err, "querying classes")
+	}
+	return ctx.JSON(http.StatusOK, classes)
+}
+
+func (api *schoolApi) createTerm(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.NewTerm
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewTerm")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	term, err := api.svc.CreateTerm(sch.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating term")
+	}
+	return ctx.JSON(http.StatusCreated, term)
+}
+
+func (api *schoolApi) queryTerms(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	terms, err := api.svc.QueryTerms(sch.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying terms")
+	}
+	return ctx.JSON(http.StatusOK, terms)
+}
+
+func (api *schoolApi) retrieveClass(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+	return ctx.JSON(http.StatusOK, cls)
+}
+
+// publishMarks makes the Marks entered until now viewable by the Students of the Class, and notifies them.
+func (api *schoolApi) publishMarks(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	cls, err := api.svc.PublishMarks(cls.ID)
+	if err != nil {
+		return errors.Wrap(err, "publishing marks")
+	}
+
+	studentIDs, err := api.svc.StudentIDs(cls.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying class students")
+	}
+	ns := make([]core.Notification, 0, len(studentIDs))
+	for _, id := range studentIDs {
+		ns = append(ns, core.Notification{
+			UserID: id,
+			Event:  notification.EventMarksPublished,
+			Title:  "New marks published",
+			Body:   fmt.Sprintf("Your new marks in %s are available.", cls.Name),
+			Link:   "/marks",
+		})
+	}
+	if err = api.notifier.Notify(ns...); err != nil {
+		return errors.Wrap(err, "notifying students")
+	}
+	return ctx.JSON(http.StatusOK, cls)
+}
+
+func (api *schoolApi) queryClassStudents(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	ids, err := api.svc.StudentIDs(cls.ID)
+	if err != nil {
+		return errors.Wrap(err, "querying class students")
+	}
+	return ctx.JSON(http.StatusOK, ClassStudentsRequest{IDs: ids})
+}
+
+func (api *schoolApi) addClassStudents(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data ClassStudentsRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to ClassStudentsRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	if err := api.svc.AddStudents(cls.ID, data.IDs...); err != nil {
+		return errors.Wrap(err, "adding class students")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *schoolApi) removeClassStudents(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query DestroyMultipleRequest
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to DestroyMultipleRequest")
+	}
+	if query.IDs == nil {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	if err := api.svc.RemoveStudents(cls.ID, query.IDs...); err != nil {
+		return errors.Wrap(err, "removing class students")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (api *schoolApi) createCourse(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var data school.NewCourse
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewCourse")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	crs, err := api.svc.CreateCourse(cls.ID, data)
+	if err != nil {
+		return errors.Wrap(err, "creating course")
+	}
+	return ctx.JSON(http.StatusCreated, crs)
+}
+
+func (api *schoolApi) queryClassCourses(ctx echo.Context) error {
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	courses, err := api.svc.QueryCourses(school.CourseFilter{ClassID: cls.ID})
+	if err != nil {
+		return errors.Wrap(err, "querying courses")
+	}
+	return ctx.JSON(http.StatusOK, courses)
+}
+
+// queryCourses returns all Courses to admins, and only their own Courses to Teachers and Students.
+func (api *schoolApi) queryCourses(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	filter := new(school.CourseFilter)
+	if err := ctx.Bind(filter); err != nil {
+		return ctx.JSON(http.StatusOK, []school.Course{})
+	}
+	if !claims.IsAdmin {
+		switch {
+		case claims.IsTeacher:
+			filter.TeacherID = claims.Subject
+		case claims.IsStudent:
+			filter.StudentID = claims.Subject
+		default:
+			return ctx.JSON(http.StatusOK, []school.Course{})
+		}
+	}
+
+	courses, err := api.svc.QueryCourses(*filter)
+	if err != nil {
+		return errors.Wrap(err, "querying courses")
+	}
+	return ctx.JSON(http.StatusOK, courses)
+}
+
+func (api *schoolApi) retrieveCourse(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	return ctx.JSON(http.StatusOK, crs)
+}
+
+func schoolMiddleware(svc school.ServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			sch, err := svc.GetSchool(ctx.Param("id"))
+			if err != nil {
+				if errors.Cause(err) == school.ErrSchoolNotFound {
+					return errHttpNotFound
+				}
+				return errors.Wrap(err, "finding school by ID")
+			}
+			ctx.Set("object", sch)
+			return next(ctx)
+		}
+	}
+}
+
+func classMiddleware(svc school.ServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			cls, err := svc.GetClass(ctx.Param("id"))
+			if err != nil {
+				if errors.Cause(err) == school.ErrClassNotFound {
+					return errHttpNotFound
+				}
+				return errors.Wrap(err, "finding class by ID")
+			}
+			ctx.Set("object", cls)
+			return next(ctx)
+		}
+	}
+}
+
+// courseMiddleware sets the Course identified by the `id` param in echo.Context when the context user can access it.
+func courseMiddleware(svc school.ServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			crs, err := svc.GetCourse(ctx.Param("id"))
+			if err != nil {
+				if errors.Cause(err) == school.ErrCourseNotFound {
+					return errHttpNotFound
+				}
+				return errors.Wrap(err, "finding course by ID")
+			}
+			if err = checkCourseAccess(ctx, svc, crs); err != nil {
+				return err
+			}
+			ctx.Set(contextCourseKey, crs)
+			return next(ctx)
+		}
+	}
+}
+
+// checkCourseAccess allows admins, the Teacher of the Course and its enrolled Students.
+func checkCourseAccess(ctx echo.Context, svc school.ServiceInterface, crs school.Course) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	if canManageCourse(claims, crs) {
+		return nil
+	}
+	if claims.IsStudent {
+		enrolled, err := svc.IsEnrolled(crs.ID, claims.Subject)
+		if err != nil {
+			return errors.Wrap(err, "checking enrollment")
+		}
+		if enrolled {
+			return nil
+		}
+	}
+	return errHttpNotFound
+}
+
+// canManageCourse reports whether the context user is an admin or the Teacher of the Course.
+func canManageCourse(claims Claims, crs school.Course) bool {
+	return claims.IsAdmin || (claims.IsTeacher && crs.TeacherID == claims.Subject)
+}
+
+type ClassStudentsRequest struct {
+	IDs []string `json:"ids" validate:"required,dive,uuid"`
+}
@@ -2,6 +2,7 @@ package echoapi
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,17 +16,62 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 )
 
 type (
 	ServerDeps struct {
-		dig.In     `wire:"-"`
-		Conf       *core.Config
-		Logger     core.Logger
-		UserSvc    user.ServiceInterface
-		Validate   *validator.Validate
-		Translator ut.Translator
+		dig.In          `wire:"-"`
+		Conf            *core.Config
+		Logger          core.Logger
+		UserSvc         user.ServiceInterface
+		SchoolSvc       school.ServiceInterface
+		CourseworkSvc   coursework.ServiceInterface
+		GradebookSvc    gradebook.ServiceInterface
+		ReportCardSvc   reportcard.ServiceInterface
+		AttendanceSvc   attendance.ServiceInterface
+		AnnouncementSvc announcement.ServiceInterface
+		ChatSvc         chat.ServiceInterface
+		NotificationSvc notification.ServiceInterface
+		SMSSvc          sms.ServiceInterface
+		CalendarSvc     calendar.ServiceInterface
+		WalletSvc       wallet.ServiceInterface
+		RBACSvc         rbac.ServiceInterface
+		GuardianSvc     guardian.ServiceInterface
+		SessionSvc      session.ServiceInterface
+		JWTKeySvc       jwtkey.ServiceInterface
+		TwoFactorSvc    twofactor.ServiceInterface
+		OIDCSvc         oidc.ServiceInterface
+		PasswordlessSvc passwordless.ServiceInterface
+		CredentialSvc   credential.ServiceInterface
+		AvatarSvc       avatar.ServiceInterface
+		PrivacySvc      privacy.ServiceInterface
+		AuditSvc        audit.ServiceInterface
+		Media           core.MediaStorage
+		Validate        *validator.Validate
+		Translator      ut.Translator
 	}
 
 	Server struct {
@@ -62,6 +108,7 @@ func (s *Server) setup() {
 		s.app.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{LogLevel: log.ERROR}))
 	}
 
+	s.app.IPExtractor = newIPExtractor(s.deps.Conf.Server.TrustedProxyNets)
 	s.app.HTTPErrorHandler = newAppHTTPErrorHandler(s.deps.Logger, s.deps.Translator, s.signalShutdown)
 	s.app.Debug = s.deps.Conf.Debug
 
@@ -69,13 +116,35 @@ func (s *Server) setup() {
 	// "/.well-known/health-check"
 	// "/.well-known/metrics"
 	s.app.GET("/", home) // todo: redirect to "/api" (OpenAPI docs)
+	s.app.GET("/.well-known/jwks.json", jwks)
 
 	grp := s.app.Group("/api")
 
-	initAuth(s.deps.Conf)
-	jwt := middleware.JWTWithConfig(appJWTConfig)
-
-	registerUserAPI(grp, jwt, s.deps.UserSvc, s.deps.Validate, s.deps.Translator)
+	initAuth(s.deps.Conf, s.deps.JWTKeySvc)
+	initPermissions(s.deps.RBACSvc, s.deps.SchoolSvc)
+	jwt := jwtMiddleware()
+
+	registerUserAPI(grp, jwt, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.SessionSvc, s.deps.TwoFactorSvc, s.deps.Validate, s.deps.Translator)
+	registerTwoFactorAPI(grp, jwt, s.deps.TwoFactorSvc, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.SessionSvc, s.deps.Validate, s.deps.Translator)
+	registerOIDCAPI(grp, jwt, s.deps.OIDCSvc, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.SessionSvc, s.deps.TwoFactorSvc, s.deps.Validate, s.deps.Translator)
+	registerPasswordlessAPI(grp, s.deps.PasswordlessSvc, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.SessionSvc, s.deps.TwoFactorSvc, s.deps.Validate, s.deps.Translator)
+	registerSchoolAPI(grp, jwt, s.deps.SchoolSvc, s.deps.Media, s.deps.NotificationSvc, s.deps.SMSSvc, s.deps.Validate, s.deps.Translator)
+	registerCourseworkAPI(grp, jwt, s.deps.CourseworkSvc, s.deps.SchoolSvc, s.deps.GradebookSvc, s.deps.WalletSvc, s.deps.Validate, s.deps.Translator)
+	registerGradebookAPI(grp, jwt, s.deps.GradebookSvc, s.deps.SchoolSvc, s.deps.WalletSvc, s.deps.Validate, s.deps.Translator)
+	registerReportCardAPI(grp, jwt, s.deps.Conf, s.deps.ReportCardSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerCredentialAPI(grp, jwt, s.deps.CredentialSvc, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.Validate)
+	registerProfileAPI(grp, jwt, s.deps.UserSvc, s.deps.AvatarSvc, s.deps.Validate)
+	registerPrivacyAPI(grp, jwt, s.deps.PrivacySvc, s.deps.UserSvc)
+	registerAuditAPI(grp, jwt, s.deps.AuditSvc, s.deps.Validate)
+	registerAttendanceAPI(grp, jwt, s.deps.AttendanceSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerAnnouncementAPI(grp, jwt, s.deps.Conf, s.deps.AnnouncementSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerChatAPI(grp, jwt, s.deps.ChatSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerNotificationAPI(grp, jwt, s.deps.NotificationSvc, s.deps.Validate, s.deps.Translator)
+	registerCalendarAPI(grp, jwt, s.deps.Conf, s.deps.CalendarSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerWalletAPI(grp, jwt, s.deps.WalletSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerRBACAPI(grp, jwt, s.deps.RBACSvc, s.deps.UserSvc, s.deps.SchoolSvc, s.deps.Validate, s.deps.Translator)
+	registerGuardianAPI(grp, jwt, s.deps.Conf, s.deps.GuardianSvc, s.deps.UserSvc, s.deps.Validate, s.deps.Translator)
+	registerMediaAPI(grp, s.deps.Conf, s.deps.Media)
 
 	// TODO: swagger !!
 }
@@ -110,6 +179,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) { // for test
 	s.app.ServeHTTP(w, r)
 }
 
+// newIPExtractor returns the extractor of client IPs for ctx.RealIP: X-Forwarded-For is only trusted from proxies.
+func newIPExtractor(proxies []*net.IPNet) echo.IPExtractor {
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	opts := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, p := range proxies {
+		opts = append(opts, echo.TrustIPRange(p))
+	}
+	return echo.ExtractIPFromXFFHeader(opts...)
+}
+
 func home(ctx echo.Context) error {
 	return ctx.String(http.StatusOK, "Welcome to Masomo API!")
 }
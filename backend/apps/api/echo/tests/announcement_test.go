@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_announcementApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+
+	cls, err := schRepo.GetClass(context.Background(), crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student)
+	outsiderToken := getToken(t, outsider)
+	schPath := "/api/schools/" + cls.SchoolID + "/announcements"
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	attach := func(id, filename, content string, wantCode int) announcement.Attachment {
+		t.Helper()
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		fw, _ := w.CreateFormFile("file", filename)
+		_, _ = fw.Write([]byte(content))
+		_ = w.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/announcements/"+id+"/attachments", &body)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("attach %s: code = %v; wantCode %v; body %s", filename, rec.Code, wantCode, rec.Body.String())
+		}
+		var att announcement.Attachment
+		_ = json.Unmarshal(rec.Body.Bytes(), &att)
+		return att
+	}
+
+	var classAnn, laterAnn announcement.Announcement
+
+	t.Run("create", func(t *testing.T) {
+		data := announcement.NewAnnouncement{ClassID: cls.ID, Title: "Parents meeting", Body: "Saturday at 9am.", EmailDigest: true}
+		do(http.MethodPost, schPath, teacherToken, data, http.StatusForbidden, nil)
+		do(http.MethodPost, schPath, adminToken, announcement.NewAnnouncement{Title: "No body"}, http.StatusBadRequest, nil)
+		do(http.MethodPost, schPath, adminToken, announcement.NewAnnouncement{
+			ClassID: outsider.ID, Title: "Unknown class", Body: "body",
+		}, http.StatusBadRequest, nil)
+
+		do(http.MethodPost, schPath, adminToken, data, http.StatusCreated, &classAnn)
+		if classAnn.Audience() != announcement.AudienceClass || classAnn.IsPublished() {
+			t.Errorf("announcement = %+v; want an unpublished class announcement", classAnn)
+		}
+		do(http.MethodPost, schPath, adminToken, announcement.NewAnnouncement{
+			Title: "Holidays", Body: "See you next term.", PublishAt: time.Now().Add(24 * time.Hour),
+		}, http.StatusCreated, &laterAnn)
+
+		att := attach(classAnn.ID, "agenda.txt", "1. welcome", http.StatusCreated)
+		if att.Filename != "agenda.txt" || att.Size != 10 || att.URL == "" {
+			t.Errorf("attachment = %+v; want agenda.txt of 10 bytes with a URL", att)
+		}
+
+		var as []announcement.Announcement
+		do(http.MethodGet, schPath, adminToken, nil, http.StatusOK, &as)
+		if len(as) != 2 {
+			t.Fatalf("len(announcements) = %d; want 2", len(as))
+		}
+	})
+
+	t.Run("publish", func(t *testing.T) {
+		// nothing is delivered before publication
+		var ds []announcement.Delivery
+		do(http.MethodGet, "/api/announcements", studentToken, nil, http.StatusOK, &ds)
+		if len(ds) != 0 {
+			t.Fatalf("len(inbox) = %d; want 0", len(ds))
+		}
+
+		emailsvc.SentMessages = nil // reset
+		n, err := annSvc.PublishDue(time.Now())
+		if err != nil {
+			t.Fatalf("PublishDue(): %v", err)
+		}
+		if n != 1 {
+			t.Errorf("PublishDue() = %d; want 1", n)
+		}
+		if len(emailsvc.SentMessages) != 2 {
+			t.Errorf("len(SentMessages) = %d; want 2 (student & teacher)", len(emailsvc.SentMessages))
+		}
+		if n, _ = annSvc.PublishDue(time.Now()); n != 0 {
+			t.Errorf("PublishDue() again = %d; want 0", n)
+		}
+
+		// published announcements cannot get attachments anymore
+		attach(classAnn.ID, "late.txt", "too late", http.StatusBadRequest)
+	})
+
+	t.Run("inbox", func(t *testing.T) {
+		var ds []announcement.Delivery
+		do(http.MethodGet, "/api/announcements", teacherToken, nil, http.StatusOK, &ds)
+		if len(ds) != 1 || ds[0].ID != classAnn.ID {
+			t.Fatalf("teacher inbox = %+v; want the class announcement", ds)
+		}
+		do(http.MethodGet, "/api/announcements", outsiderToken, nil, http.StatusOK, &ds)
+		if len(ds) != 0 {
+			t.Errorf("len(outsider inbox) = %d; want 0", len(ds))
+		}
+		do(http.MethodGet, "/api/announcements/"+classAnn.ID, outsiderToken, nil, http.StatusNotFound, nil)
+		do(http.MethodGet, "/api/announcements/"+laterAnn.ID, studentToken, nil, http.StatusNotFound, nil)
+
+		var d announcement.Delivery
+		do(http.MethodGet, "/api/announcements/"+classAnn.ID, studentToken, nil, http.StatusOK, &d)
+		if len(d.Attachments) != 1 || d.Attachments[0].URL == "" {
+			t.Errorf("attachments = %+v; want 1 signed attachment", d.Attachments)
+		}
+	})
+
+	t.Run("read state", func(t *testing.T) {
+		var count UnreadCountResponse
+		do(http.MethodGet, "/api/announcements/unread-count", studentToken, nil, http.StatusOK, &count)
+		if count.Unread != 1 {
+			t.Errorf("unread = %d; want 1", count.Unread)
+		}
+
+		do(http.MethodPost, "/api/announcements/"+classAnn.ID+"/read", studentToken, nil, http.StatusNoContent, nil)
+		do(http.MethodGet, "/api/announcements/unread-count", studentToken, nil, http.StatusOK, &count)
+		if count.Unread != 0 {
+			t.Errorf("unread = %d; want 0", count.Unread)
+		}
+		var ds []announcement.Delivery
+		do(http.MethodGet, "/api/announcements?unread=true", studentToken, nil, http.StatusOK, &ds)
+		if len(ds) != 0 {
+			t.Errorf("len(unread inbox) = %d; want 0", len(ds))
+		}
+
+		// the teacher's read state is separate
+		do(http.MethodPost, "/api/announcements/read", teacherToken, nil, http.StatusNoContent, nil)
+		do(http.MethodGet, "/api/announcements?unread=true", teacherToken, nil, http.StatusOK, &ds)
+		if len(ds) != 0 {
+			t.Errorf("len(teacher unread inbox) = %d; want 0", len(ds))
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		do(http.MethodDelete, "/api/announcements/"+classAnn.ID, teacherToken, nil, http.StatusForbidden, nil)
+		do(http.MethodDelete, "/api/announcements/"+classAnn.ID, adminToken, nil, http.StatusNoContent, nil)
+		do(http.MethodGet, "/api/announcements/"+classAnn.ID, studentToken, nil, http.StatusNotFound, nil)
+	})
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_attendanceApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	other := testutil.CreateUser(t, usrRepo, "Other", "other", "other@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	ctx := context.Background()
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	sch, err := schRepo.GetSchool(ctx, cls.SchoolID)
+	if err != nil {
+		t.Fatalf("GetSchool(): %v", err)
+	}
+	sch.AbsenceThreshold, sch.AttendanceContact = 2, "prefet@test.cd"
+	if _, err = schRepo.UpdateSchool(ctx, sch); err != nil {
+		t.Fatalf("UpdateSchool(): %v", err)
+	}
+
+	teacherToken := getToken(t, teacher)
+	otherToken := getToken(t, other)
+	studentToken := getToken(t, student1)
+	path := "/api/classes/" + cls.ID + "/attendance"
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	absent := func(date string) attendance.MarkAttendance {
+		return attendance.MarkAttendance{
+			Date:    date,
+			Default: attendance.StatusPresent,
+			Records: []attendance.RecordEntry{{StudentID: student2.ID, Status: attendance.StatusAbsent}},
+		}
+	}
+
+	t.Run("bulk mark", func(t *testing.T) {
+		do(http.MethodPut, path, otherToken, absent("2026-10-05"), http.StatusForbidden, nil)
+		do(http.MethodPut, path, studentToken, absent("2026-10-05"), http.StatusForbidden, nil)
+		do(http.MethodPut, path, teacherToken, attendance.MarkAttendance{Date: "05/10/2026"}, http.StatusBadRequest, nil)
+		do(http.MethodPut, path, teacherToken, attendance.MarkAttendance{
+			Date:    "2026-10-05",
+			Records: []attendance.RecordEntry{{StudentID: other.ID, Status: attendance.StatusAbsent}},
+		}, http.StatusBadRequest, nil)
+
+		var sess attendance.Session
+		do(http.MethodPut, path, teacherToken, absent("2026-10-05"), http.StatusOK, &sess)
+		if len(sess.Records) != 2 {
+			t.Fatalf("len(Records) = %d; want 2", len(sess.Records))
+		}
+		statuses := map[string]string{sess.Records[0].StudentID: sess.Records[0].Status, sess.Records[1].StudentID: sess.Records[1].Status}
+		if statuses[student1.ID] != attendance.StatusPresent || statuses[student2.ID] != attendance.StatusAbsent {
+			t.Errorf("statuses = %v; want student1 present & student2 absent", statuses)
+		}
+
+		// marking the same day again updates the Session
+		var again attendance.Session
+		do(http.MethodPut, path, teacherToken, attendance.MarkAttendance{
+			Date:    "2026-10-05",
+			Records: []attendance.RecordEntry{{StudentID: student1.ID, Status: attendance.StatusLate, Note: "bus"}},
+		}, http.StatusOK, &again)
+		if again.ID != sess.ID {
+			t.Errorf("Session ID = %s; want %s", again.ID, sess.ID)
+		}
+
+		// course sessions are separate
+		var crsSess attendance.Session
+		do(http.MethodPut, path, teacherToken, attendance.MarkAttendance{Date: "2026-10-05", CourseID: crs.ID, Default: attendance.StatusPresent}, http.StatusOK, &crsSess)
+		if crsSess.ID == sess.ID || crsSess.CourseID != crs.ID {
+			t.Errorf("course Session = %+v; want a new Session of the Course", crsSess)
+		}
+
+		do(http.MethodGet, path+"?date=2026-10-05", teacherToken, nil, http.StatusOK, &sess)
+		do(http.MethodGet, path+"?date=2026-10-06", teacherToken, nil, http.StatusNotFound, nil)
+		do(http.MethodGet, path+"?date=2026-10-05", studentToken, nil, http.StatusForbidden, nil)
+	})
+
+	t.Run("absence alert", func(t *testing.T) {
+		emailsvc.SentMessages = nil // reset
+
+		do(http.MethodPut, path, teacherToken, absent("2026-10-06"), http.StatusOK, nil)
+		if len(emailsvc.SentMessages) != 1 {
+			t.Fatalf("len(SentMessages) = %d; want 1", len(emailsvc.SentMessages))
+		}
+		msg := emailsvc.SentMessages[0]
+		if msg.To[0].Address != "prefet@test.cd" {
+			t.Errorf("To = %v; want prefet@test.cd", msg.To[0])
+		}
+		if !strings.Contains(msg.TextContent, student2.Name) {
+			t.Errorf("text content does not contain the student's name %q", student2.Name)
+		}
+
+		// re-marking the same absence, or going past the threshold, does not alert again
+		do(http.MethodPut, path, teacherToken, absent("2026-10-06"), http.StatusOK, nil)
+		do(http.MethodPut, path, teacherToken, absent("2026-10-07"), http.StatusOK, nil)
+		if len(emailsvc.SentMessages) != 1 {
+			t.Errorf("len(SentMessages) = %d; want 1", len(emailsvc.SentMessages))
+		}
+	})
+
+	t.Run("summaries", func(t *testing.T) {
+		var summaries []attendance.Summary
+		do(http.MethodGet, path+"/summary?from=2026-10-01&to=2026-10-06", teacherToken, nil, http.StatusOK, &summaries)
+		want := map[string]attendance.Summary{
+			student1.ID: {StudentID: student1.ID, Sessions: 2, Present: 1, Late: 1, Rate: 100},
+			student2.ID: {StudentID: student2.ID, Sessions: 2, Absent: 2},
+		}
+		if len(summaries) != 2 {
+			t.Fatalf("len(summaries) = %d; want 2", len(summaries))
+		}
+		for _, s := range summaries {
+			if s != want[s.StudentID] {
+				t.Errorf("summary = %+v; want %+v", s, want[s.StudentID])
+			}
+		}
+
+		do(http.MethodGet, path+"/summary", studentToken, nil, http.StatusOK, &summaries)
+		if len(summaries) != 1 || summaries[0].StudentID != student1.ID {
+			t.Errorf("student summaries = %+v; want only their own", summaries)
+		}
+
+		var sa attendance.StudentAttendance
+		do(http.MethodGet, path+"/students/"+student2.ID, teacherToken, nil, http.StatusOK, &sa)
+		if sa.Absent != 3 || len(sa.Records) != 3 {
+			t.Errorf("student attendance = %+v; want 3 absences", sa)
+		}
+		do(http.MethodGet, path+"/students/"+student2.ID+"?course_id="+crs.ID, teacherToken, nil, http.StatusOK, &sa)
+		if sa.Present != 1 || sa.Sessions != 1 {
+			t.Errorf("course attendance = %+v; want 1 presence", sa)
+		}
+		do(http.MethodGet, path+"/students/"+student1.ID, studentToken, nil, http.StatusOK, &sa)
+		do(http.MethodGet, path+"/students/"+student2.ID, studentToken, nil, http.StatusNotFound, nil)
+	})
+
+	t.Run("alert settings", func(t *testing.T) {
+		admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+		schPath := "/api/schools/" + sch.ID + "/attendance-alert"
+		do(http.MethodPut, schPath, getToken(t, admin), school.AttendanceAlert{Threshold: 3}, http.StatusBadRequest, nil)
+
+		var updated school.School
+		do(http.MethodPut, schPath, getToken(t, admin), school.AttendanceAlert{Threshold: 3, Contact: "dir@test.cd"}, http.StatusOK, &updated)
+		if updated.AbsenceThreshold != 3 || updated.AttendanceContact != "dir@test.cd" {
+			t.Errorf("school = %+v; want threshold 3 & contact dir@test.cd", updated)
+		}
+	})
+}
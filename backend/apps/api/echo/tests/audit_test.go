@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_auditApi_query(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	principal := testutil.CreateUser(t, usrRepo, "Principal", "principal", "principal@test.cd", "pwd", []string{user.RoleAdminPrincipal}, true)
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	adminToken := getToken(t, admin)
+	const spoofedIP = "203.0.113.7"
+
+	do := func(t *testing.T, method, path, token string, wantCode int, body ...[]byte) *httptest.ResponseRecorder {
+		t.Helper()
+		req, rec := newAuthRequest(method, path, token, body...)
+		req.Header.Set("User-Agent", "audit-test")
+		req.Header.Set("X-Forwarded-For", spoofedIP) // not from a trusted proxy
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		return rec
+	}
+	query := func(t *testing.T, params string) []core.AuditEntry {
+		t.Helper()
+		rec := do(t, http.MethodGet, "/api/audit?"+params, getToken(t, principal), http.StatusOK)
+		var es []core.AuditEntry
+		if err := json.Unmarshal(rec.Body.Bytes(), &es); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+		return es
+	}
+
+	do(t, http.MethodPut, "/api/users/"+student.ID, adminToken, http.StatusOK, marchallObj(t, user.UpdateUser{Roles: []string{user.RoleTeacher}}))
+	do(t, http.MethodDelete, "/api/users/"+student.ID, adminToken, http.StatusNoContent)
+	do(t, http.MethodPost, "/api/users/"+student.ID+"/restore", adminToken, http.StatusOK)
+
+	t.Run("permission", func(t *testing.T) {
+		do(t, http.MethodGet, "/api/audit", "", http.StatusUnauthorized)
+		do(t, http.MethodGet, "/api/audit", adminToken, http.StatusForbidden)
+		do(t, http.MethodGet, "/api/audit?limit=101", getToken(t, principal), http.StatusBadRequest)
+	})
+
+	t.Run("by user", func(t *testing.T) {
+		es := query(t, "user="+student.ID)
+		wantActions := []string{core.AuditUserRestore, core.AuditUserDelete, core.AuditUserUpdate} // latest first
+		if len(es) != len(wantActions) {
+			t.Fatalf("entries = %+v; want %v", es, wantActions)
+		}
+		for i, e := range es {
+			if e.Action != wantActions[i] || e.ActorID != admin.ID || e.TargetID != student.ID {
+				t.Errorf("entries[%d] = %+v; want %s by %s on %s", i, e, wantActions[i], admin.ID, student.ID)
+			}
+			if e.IP == "" || e.IP == spoofedIP || e.UserAgent != "audit-test" {
+				t.Errorf("entries[%d] IP, UserAgent = %q, %q; want the peer IP, audit-test", i, e.IP, e.UserAgent)
+			}
+		}
+
+		roles, ok := es[2].Changes["roles"]
+		if !ok || roles.Before == nil || roles.After == nil {
+			t.Errorf("update changes = %+v; want roles before & after", es[2].Changes)
+		}
+		if _, ok := es[2].Changes["updated_at"]; ok {
+			t.Errorf("update changes = %+v; want no updated_at", es[2].Changes)
+		}
+		if _, ok := es[1].Changes["deleted_at"]; !ok {
+			t.Errorf("delete changes = %+v; want deleted_at", es[1].Changes)
+		}
+
+		if es = query(t, "user="+admin.ID); len(es) != 3 {
+			t.Errorf("actor entries = %d; want 3", len(es))
+		}
+		if es = query(t, "user="+principal.ID); len(es) != 0 {
+			t.Errorf("principal entries = %+v; want none", es)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		es := query(t, "target="+student.ID+"&action="+core.AuditUserUpdate)
+		if len(es) != 1 || es[0].Action != core.AuditUserUpdate {
+			t.Errorf("update entries = %+v; want 1", es)
+		}
+		if es = query(t, "since="+time.Now().Add(time.Hour).UTC().Format(time.RFC3339)); len(es) != 0 {
+			t.Errorf("future entries = %+v; want none", es)
+		}
+		if es = query(t, "limit=1&offset=1"); len(es) != 1 || es[0].Action != core.AuditUserDelete {
+			t.Errorf("second entry = %+v; want %s", es, core.AuditUserDelete)
+		}
+	})
+
+	t.Run("append-only", func(t *testing.T) {
+		if _, err := db.Exec("UPDATE audit_log SET action = $1", core.AuditUserCreate); err == nil {
+			t.Error("UPDATE audit_log: error = nil; want refused")
+		}
+		if _, err := db.Exec("DELETE FROM audit_log"); err == nil {
+			t.Error("DELETE FROM audit_log: error = nil; want refused")
+		}
+		if _, err := auditSvc.Purge(time.Now().Add(conf.AuditRetention + time.Minute)); err == nil {
+			t.Error("Purge(future) error = nil; want refused")
+		}
+		if es, _ := auditSvc.Query(audit.Filter{Action: core.AuditUserUpdate}); len(es) != 1 {
+			t.Errorf("update entries = %+v; want 1", es)
+		}
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		n, err := auditSvc.Purge(time.Now())
+		if err != nil || n != 0 {
+			t.Fatalf("Purge() = %d, %v; want 0, nil", n, err)
+		}
+
+		old := time.Now().Add(-conf.AuditRetention - time.Hour).UTC()
+		_, err = db.Exec(
+			"INSERT INTO audit_log (id, action, target_id, created_at) VALUES ($1, $2, $3, $4)",
+			uuid.New().String(), core.AuditUserCreate, student.ID, old,
+		)
+		if err != nil {
+			t.Fatalf("inserting old entry: %v", err)
+		}
+		n, err = auditSvc.Purge(time.Now())
+		if err != nil || n != 1 {
+			t.Fatalf("Purge() = %d, %v; want 1, nil", n, err)
+		}
+		if es, _ := auditSvc.Query(audit.Filter{}); len(es) != 3 {
+			t.Errorf("entries = %d; want 3", len(es))
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_calendarApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	if _, err = schRepo.CreateTerm(ctx, school.Term{SchoolID: cls.SchoolID, Name: "Term 1", StartsOn: now.AddDate(0, 0, -7), EndsOn: now.AddDate(0, 2, 0)}); err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+	cw, err := cwRepo.CreateCoursework(ctx, coursework.Coursework{CourseID: crs.ID, Kind: coursework.KindAssignment, Title: "Homework", DueAt: now.AddDate(0, 0, 3)})
+	if err != nil {
+		t.Fatalf("CreateCoursework(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student)
+	outsiderToken := getToken(t, outsider)
+	schPath := "/api/schools/" + cls.SchoolID + "/calendar"
+	crsPath := "/api/courses/" + crs.ID + "/calendar"
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	kinds := func(events []calendar.Event) map[string]int {
+		counts := make(map[string]int)
+		for _, e := range events {
+			counts[e.Kind]++
+		}
+		return counts
+	}
+
+	holiday := calendar.NewEvent{Kind: calendar.KindHoliday, Title: "Holiday", AllDay: true, StartsAt: now.AddDate(0, 0, 10), EndsAt: now.AddDate(0, 0, 11)}
+	exam := calendar.NewEvent{Kind: calendar.KindExam, Title: "Test", StartsAt: now.AddDate(0, 0, 5).Add(8 * time.Hour), EndsAt: now.AddDate(0, 0, 5).Add(10 * time.Hour)}
+	var hol, ex calendar.Event
+
+	t.Run("events", func(t *testing.T) {
+		do(http.MethodPost, schPath+"/events", teacherToken, holiday, http.StatusForbidden, nil)
+		do(http.MethodPost, schPath+"/events", adminToken, calendar.NewEvent{Kind: "nope", Title: "x", StartsAt: now, EndsAt: now}, http.StatusBadRequest, nil)
+		do(http.MethodPost, schPath+"/events", adminToken, holiday, http.StatusCreated, &hol)
+
+		do(http.MethodPost, crsPath+"/events", studentToken, exam, http.StatusForbidden, nil)
+		do(http.MethodPost, crsPath+"/events", teacherToken, holiday, http.StatusBadRequest, nil)
+		bad := exam
+		bad.EndsAt = exam.StartsAt.Add(-time.Hour)
+		do(http.MethodPost, crsPath+"/events", teacherToken, bad, http.StatusBadRequest, nil)
+		do(http.MethodPost, crsPath+"/events", teacherToken, exam, http.StatusCreated, &ex)
+		if ex.CourseID != crs.ID || ex.SchoolID != cls.SchoolID {
+			t.Errorf("event = %+v; want course event of the school", ex)
+		}
+
+		// only admins manage School-wide events
+		do(http.MethodPut, "/api/calendar/events/"+hol.ID, teacherToken, holiday, http.StatusForbidden, nil)
+		do(http.MethodPut, "/api/calendar/events/"+ex.ID, outsiderToken, exam, http.StatusNotFound, nil)
+		do(http.MethodPut, "/api/calendar/events/"+ex.ID, studentToken, exam, http.StatusForbidden, nil)
+		exam.Title = "Algebra test"
+		do(http.MethodPut, "/api/calendar/events/"+ex.ID, teacherToken, exam, http.StatusOK, &ex)
+		if ex.Title != exam.Title {
+			t.Errorf("Title = %q; want %q", ex.Title, exam.Title)
+		}
+		do(http.MethodDelete, "/api/calendar/events/"+calendar.KindTerm+":"+hol.ID, adminToken, nil, http.StatusNotFound, nil)
+	})
+
+	t.Run("calendars", func(t *testing.T) {
+		var events []calendar.Event
+		do(http.MethodGet, schPath, adminToken, nil, http.StatusOK, &events)
+		if got := kinds(events); got[calendar.KindHoliday] != 1 || got[calendar.KindTerm] != 1 || got[calendar.KindExam] != 0 {
+			t.Errorf("school kinds = %v; want holiday & term only", got)
+		}
+
+		do(http.MethodGet, crsPath, outsiderToken, nil, http.StatusNotFound, nil)
+		do(http.MethodGet, crsPath, studentToken, nil, http.StatusOK, &events)
+		if got := kinds(events); got[calendar.KindExam] != 1 || got[calendar.KindDue] != 1 || got[calendar.KindHoliday] != 0 {
+			t.Errorf("course kinds = %v; want exam & due date only", got)
+		}
+
+		do(http.MethodGet, "/api/me/calendar", studentToken, nil, http.StatusOK, &events)
+		if got := kinds(events); got[calendar.KindHoliday] != 1 || got[calendar.KindTerm] != 1 || got[calendar.KindExam] != 1 || got[calendar.KindDue] != 1 {
+			t.Errorf("student kinds = %v; want all", got)
+		}
+		for i := 1; i < len(events); i++ {
+			if events[i].StartsAt.Before(events[i-1].StartsAt) {
+				t.Errorf("events not sorted by start: %+v", events)
+			}
+		}
+		for _, e := range events {
+			if e.Kind == calendar.KindDue && e.Link != "/coursework/"+cw.ID {
+				t.Errorf("Link = %q; want the coursework", e.Link)
+			}
+		}
+
+		// ranges
+		q := url.Values{"from": {now.AddDate(0, 0, 4).Format(time.RFC3339)}, "to": {now.AddDate(0, 0, 6).Format(time.RFC3339)}}
+		do(http.MethodGet, "/api/me/calendar?"+q.Encode(), studentToken, nil, http.StatusOK, &events)
+		if got := kinds(events); len(events) != 2 || got[calendar.KindTerm] != 1 || got[calendar.KindExam] != 1 {
+			t.Errorf("kinds = %v; want term & exam", got)
+		}
+		q.Set("to", now.Format(time.RFC3339))
+		do(http.MethodGet, "/api/me/calendar?"+q.Encode(), studentToken, nil, http.StatusBadRequest, nil)
+
+		do(http.MethodGet, "/api/me/calendar", outsiderToken, nil, http.StatusOK, &events)
+		if len(events) != 0 {
+			t.Errorf("outsider events = %+v; want none", events)
+		}
+	})
+
+	t.Run("feed", func(t *testing.T) {
+		var feed FeedResponse
+		do(http.MethodPost, "/api/me/calendar/feed", studentToken, nil, http.StatusCreated, &feed)
+		if !strings.HasPrefix(feed.WebcalURL, "webcal://") || !strings.HasSuffix(feed.URL, ".ics") {
+			t.Fatalf("feed = %+v", feed)
+		}
+		u, err := url.Parse(feed.URL)
+		if err != nil {
+			t.Fatalf("url.Parse(): %v", err)
+		}
+
+		req, rec := newRequest(http.MethodGet, u.Path)
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET feed: code = %v; body %s", rec.Code, rec.Body.String())
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/calendar") {
+			t.Errorf("Content-Type = %q; want text/calendar", ct)
+		}
+		body := rec.Body.String()
+		for _, want := range []string{"BEGIN:VCALENDAR", "SUMMARY:Holiday", "SUMMARY:Algebra test", "SUMMARY:Maths: Homework", "SUMMARY:Term 1"} {
+			if !strings.Contains(body, want) {
+				t.Errorf("feed does not contain %q:\n%s", want, body)
+			}
+		}
+
+		// a new token replaces the previous one
+		var renewed FeedResponse
+		do(http.MethodPost, "/api/me/calendar/feed", studentToken, nil, http.StatusCreated, &renewed)
+		req, rec = newRequest(http.MethodGet, u.Path)
+		if server.ServeHTTP(rec, req); rec.Code != http.StatusNotFound {
+			t.Errorf("GET replaced feed: code = %v; want 404", rec.Code)
+		}
+
+		do(http.MethodDelete, "/api/me/calendar/feed", studentToken, nil, http.StatusNoContent, nil)
+		u, _ = url.Parse(renewed.URL)
+		req, rec = newRequest(http.MethodGet, u.Path)
+		if server.ServeHTTP(rec, req); rec.Code != http.StatusNotFound {
+			t.Errorf("GET revoked feed: code = %v; want 404", rec.Code)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		do(http.MethodDelete, "/api/calendar/events/"+ex.ID, teacherToken, nil, http.StatusNoContent, nil)
+		do(http.MethodDelete, "/api/calendar/events/"+ex.ID, teacherToken, nil, http.StatusNotFound, nil)
+		do(http.MethodDelete, "/api/calendar/events/"+hol.ID, adminToken, nil, http.StatusNoContent, nil)
+	})
+}
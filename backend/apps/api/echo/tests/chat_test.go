@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_chatApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	teacherToken := getToken(t, teacher)
+	student1Token := getToken(t, student1)
+	student2Token := getToken(t, student2)
+	outsiderToken := getToken(t, outsider)
+	chatPath := "/api/courses/" + crs.ID + "/chat"
+	roomsPath := chatPath + "/rooms"
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	unread := func(token string) ChatUnreadResponse {
+		t.Helper()
+		var resp ChatUnreadResponse
+		do(http.MethodGet, "/api/chat/unread", token, nil, http.StatusOK, &resp)
+		return resp
+	}
+
+	var general, group chat.Room
+
+	t.Run("rooms", func(t *testing.T) {
+		do(http.MethodGet, roomsPath, outsiderToken, nil, http.StatusNotFound, nil)
+
+		var rooms []chat.Room
+		do(http.MethodGet, roomsPath, student1Token, nil, http.StatusOK, &rooms)
+		if len(rooms) != 1 || rooms[0].Kind != chat.KindGeneral {
+			t.Fatalf("rooms = %+v; want the general room", rooms)
+		}
+		general = rooms[0]
+
+		data := chat.NewRoom{Name: "Project A", Kind: chat.KindGroup, MemberIDs: []string{student1.ID}}
+		do(http.MethodPost, roomsPath, student1Token, data, http.StatusForbidden, nil)
+		do(http.MethodPost, roomsPath, teacherToken, chat.NewRoom{Name: "Project A", Kind: chat.KindGroup}, http.StatusBadRequest, nil)
+		do(http.MethodPost, roomsPath, teacherToken, chat.NewRoom{
+			Name: "Project A", Kind: chat.KindGroup, MemberIDs: []string{outsider.ID},
+		}, http.StatusBadRequest, nil)
+		do(http.MethodPost, roomsPath, teacherToken, data, http.StatusCreated, &group)
+
+		// group rooms are only accessible to their members
+		do(http.MethodGet, roomsPath, student2Token, nil, http.StatusOK, &rooms)
+		if len(rooms) != 1 {
+			t.Errorf("len(student2 rooms) = %d; want 1", len(rooms))
+		}
+		do(http.MethodGet, "/api/chat/rooms/"+group.ID+"/messages", student2Token, nil, http.StatusNotFound, nil)
+		do(http.MethodGet, roomsPath, teacherToken, nil, http.StatusOK, &rooms)
+		if len(rooms) != 2 {
+			t.Errorf("len(teacher rooms) = %d; want 2", len(rooms))
+		}
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		srv := httptest.NewServer(server)
+		defer srv.Close()
+		wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/chat/rooms/" + general.ID + "/ws?token="
+
+		if _, resp, err := websocket.DefaultDialer.Dial(wsURL+outsiderToken, nil); err == nil || resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("outsider Dial(): err = %v; want 404", err)
+		}
+		teacherConn, _, err := websocket.DefaultDialer.Dial(wsURL+teacherToken, nil)
+		if err != nil {
+			t.Fatalf("teacher Dial(): %v", err)
+		}
+		defer teacherConn.Close()
+		studentConn, _, err := websocket.DefaultDialer.Dial(wsURL+student1Token, nil)
+		if err != nil {
+			t.Fatalf("student Dial(): %v", err)
+		}
+		defer studentConn.Close()
+
+		read := func(conn *websocket.Conn) chat.Event {
+			t.Helper()
+			_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			var e chat.Event
+			if err := conn.ReadJSON(&e); err != nil {
+				t.Fatalf("ReadJSON(): %v", err)
+			}
+			return e
+		}
+
+		if err = studentConn.WriteJSON(ChatCommand{Type: "message", Body: "Hello!"}); err != nil {
+			t.Fatalf("WriteJSON(): %v", err)
+		}
+		for _, conn := range []*websocket.Conn{teacherConn, studentConn} {
+			e := read(conn)
+			if e.Type != chat.EventMessage || e.Message == nil || e.Message.Body != "Hello!" || e.Message.SenderID != student1.ID {
+				t.Errorf("event = %+v; want the student's message", e)
+			}
+		}
+
+		// messages posted over HTTP are broadcast too
+		var msg chat.Message
+		do(http.MethodPost, "/api/chat/rooms/"+general.ID+"/messages", teacherToken, chat.NewMessage{Body: "Welcome"}, http.StatusCreated, &msg)
+		if e := read(studentConn); e.Message == nil || e.Message.ID != msg.ID {
+			t.Errorf("event = %+v; want the teacher's message", e)
+		}
+
+		// moderation
+		do(http.MethodDelete, "/api/chat/messages/"+msg.ID, student1Token, nil, http.StatusForbidden, nil)
+		do(http.MethodDelete, "/api/chat/messages/"+msg.ID, teacherToken, nil, http.StatusOK, &msg)
+		if e := read(studentConn); e.Type != chat.EventDelete || e.Message.ID != msg.ID || e.Message.Body != "" {
+			t.Errorf("event = %+v; want the deletion of the teacher's message", e)
+		}
+		do(http.MethodPost, chatPath+"/mutes", teacherToken, chat.MuteUser{UserID: student1.ID}, http.StatusOK, nil)
+		if e := read(studentConn); e.Type != chat.EventMute || e.UserID != student1.ID {
+			t.Errorf("event = %+v; want the student's mute", e)
+		}
+		_ = studentConn.WriteJSON(ChatCommand{Type: "message", Body: "Can I?"})
+		var chatErr ChatError
+		_ = studentConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if err = studentConn.ReadJSON(&chatErr); err != nil || chatErr.Error != "muted" {
+			t.Errorf("ReadJSON() = %+v, %v; want muted error", chatErr, err)
+		}
+		do(http.MethodPost, "/api/chat/rooms/"+general.ID+"/messages", student1Token, chat.NewMessage{Body: "Please"}, http.StatusForbidden, nil)
+		do(http.MethodDelete, chatPath+"/mutes/"+student1.ID, teacherToken, nil, http.StatusNoContent, nil)
+		do(http.MethodPost, "/api/chat/rooms/"+general.ID+"/messages", student1Token, chat.NewMessage{Body: "Thanks"}, http.StatusCreated, nil)
+	})
+
+	t.Run("unread & history", func(t *testing.T) {
+		// student2 has 2 unread messages: "Hello!" & "Thanks"; the deleted one does not count
+		if resp := unread(student2Token); resp.Total != 2 || len(resp.Rooms) != 1 {
+			t.Errorf("student2 unread = %+v; want 2 in 1 room", resp)
+		}
+		// the sender read the room up to their own message
+		if resp := unread(student1Token); resp.Total != 0 || len(resp.Rooms) != 2 {
+			t.Errorf("student1 unread = %+v; want 0 in 2 rooms", resp)
+		}
+		do(http.MethodPost, "/api/chat/rooms/"+general.ID+"/read", student2Token, nil, http.StatusNoContent, nil)
+		if resp := unread(student2Token); resp.Total != 0 {
+			t.Errorf("student2 unread = %d; want 0", resp.Total)
+		}
+
+		var page chat.Page
+		path := "/api/chat/rooms/" + general.ID + "/messages"
+		do(http.MethodGet, path+"?limit=2", student2Token, nil, http.StatusOK, &page)
+		if len(page.Messages) != 2 || page.Messages[0].Body != "Thanks" || page.NextCursor == "" {
+			t.Fatalf("page = %+v; want the 2 most recent messages & a cursor", page)
+		}
+		do(http.MethodGet, path+"?limit=2&before="+page.NextCursor, student2Token, nil, http.StatusOK, &page)
+		if len(page.Messages) != 1 || page.Messages[0].Body != "Hello!" || page.NextCursor != "" {
+			t.Errorf("page = %+v; want the first message only", page)
+		}
+		do(http.MethodGet, path+"?before=nope", student2Token, nil, http.StatusBadRequest, nil)
+	})
+}
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+// createCourse creates a Course taught by teacher to a Class of students.
+func createCourse(t *testing.T, teacher user.User, students ...user.User) school.Course {
+	ctx := context.Background()
+	sch, err := schRepo.CreateSchool(ctx, school.School{Name: "School"})
+	if err != nil {
+		t.Fatalf("createCourse: CreateSchool(): %v", err)
+	}
+	cls, err := schRepo.CreateClass(ctx, school.Class{SchoolID: sch.ID, Name: "6A", YearLevel: 6, Year: 2026})
+	if err != nil {
+		t.Fatalf("createCourse: CreateClass(): %v", err)
+	}
+	ids := make([]string, 0, len(students))
+	for _, s := range students {
+		ids = append(ids, s.ID)
+	}
+	if err = schRepo.AddClassStudents(ctx, cls.ID, ids); err != nil {
+		t.Fatalf("createCourse: AddClassStudents(): %v", err)
+	}
+	crs, err := schRepo.CreateCourse(ctx, school.Course{ClassID: cls.ID, TeacherID: teacher.ID, Name: "Maths"})
+	if err != nil {
+		t.Fatalf("createCourse: CreateCourse(): %v", err)
+	}
+	return crs
+}
+
+func Test_courseworkApi_attempts(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student)
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+
+	// only the Teacher manages questions; outsiders cannot see the Course
+	nq := coursework.NewQuestion{
+		Kind:   coursework.QuestionMultiple,
+		Prompt: "Pick the even numbers",
+		Options: []coursework.Option{
+			{Text: "2", Correct: true},
+			{Text: "3"},
+			{Text: "4", Correct: true},
+		},
+		Points: 2,
+	}
+	do(http.MethodPost, "/api/courses/"+crs.ID+"/questions", studentToken, nq, http.StatusForbidden, nil)
+	do(http.MethodGet, "/api/courses/"+crs.ID, getToken(t, outsider), nil, http.StatusNotFound, nil)
+
+	var q coursework.Question
+	do(http.MethodPost, "/api/courses/"+crs.ID+"/questions", teacherToken, nq, http.StatusCreated, &q)
+
+	var cw coursework.Coursework
+	do(http.MethodPost, "/api/courses/"+crs.ID+"/coursework", teacherToken, coursework.NewCoursework{
+		Kind:        coursework.KindAssignment,
+		Title:       "Homework",
+		QuestionIDs: []string{q.ID},
+		MaxAttempts: 1,
+		DueAt:       time.Now().Add(time.Hour),
+	}, http.StatusCreated, &cw)
+
+	// start & submit an attempt with a half correct answer
+	var resp echoapi.AttemptResponse
+	do(http.MethodPost, "/api/coursework/"+cw.ID+"/attempts", studentToken, nil, http.StatusCreated, &resp)
+	if len(resp.Paper) != 1 || len(resp.Paper[0].Options) != 3 {
+		t.Fatalf("StartAttempt: unexpected paper %+v", resp.Paper)
+	}
+	var correct string
+	for _, o := range q.Options {
+		if o.Correct {
+			correct = o.ID
+			break
+		}
+	}
+
+	var att coursework.Attempt
+	answers := coursework.SubmitAttempt{Answers: map[string][]string{q.ID: {correct}}}
+	do(http.MethodPost, "/api/attempts/"+resp.ID+"/submit", studentToken, answers, http.StatusOK, &att)
+	if att.Score != 1 || att.MaxScore != 2 || !att.Counted {
+		t.Errorf("SubmitAttempt: score = %v/%v counted %v; want 1/2 counted", att.Score, att.MaxScore, att.Counted)
+	}
+	do(http.MethodPost, "/api/attempts/"+resp.ID+"/submit", studentToken, answers, http.StatusBadRequest, nil)
+
+	// max attempts reached
+	do(http.MethodPost, "/api/coursework/"+cw.ID+"/attempts", studentToken, nil, http.StatusBadRequest, nil)
+
+	var grades []coursework.Grade
+	do(http.MethodGet, "/api/coursework/"+cw.ID+"/grades", teacherToken, nil, http.StatusOK, &grades)
+	want := []coursework.Grade{{CourseworkID: cw.ID, StudentID: student.ID, Score: 1, MaxScore: 2, Attempts: 1}}
+	if len(grades) != 1 || grades[0] != want[0] {
+		t.Errorf("Grades = %+v; want %+v", grades, want)
+	}
+}
+
+func Test_courseworkApi_concurrentAttempts(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student)
+
+	send := func(method, path, token string, data interface{}, out interface{}) int {
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if out != nil && rec.Code < http.StatusBadRequest {
+			_ = json.Unmarshal(rec.Body.Bytes(), out)
+		}
+		return rec.Code
+	}
+	// concurrently sends n requests; returns the number of successful ones
+	race := func(n int, method, path string, data interface{}) int {
+		codes := make(chan int, n)
+		for i := 0; i < n; i++ {
+			go func() { codes <- send(method, path, studentToken, data, nil) }()
+		}
+		var ok int
+		for i := 0; i < n; i++ {
+			if code := <-codes; code < http.StatusBadRequest {
+				ok++
+			}
+		}
+		return ok
+	}
+
+	nq := coursework.NewQuestion{
+		Kind:    coursework.QuestionSingle,
+		Prompt:  "1 + 1",
+		Options: []coursework.Option{{Text: "2", Correct: true}, {Text: "3"}},
+		Points:  1,
+	}
+	var q coursework.Question
+	send(http.MethodPost, "/api/courses/"+crs.ID+"/questions", teacherToken, nq, &q)
+	var cw coursework.Coursework
+	send(http.MethodPost, "/api/courses/"+crs.ID+"/coursework", teacherToken, coursework.NewCoursework{
+		Kind:        coursework.KindAssignment,
+		Title:       "Homework",
+		QuestionIDs: []string{q.ID},
+		MaxAttempts: 1,
+		DueAt:       time.Now().Add(time.Hour),
+	}, &cw)
+
+	if n := race(5, http.MethodPost, "/api/coursework/"+cw.ID+"/attempts", nil); n != 1 {
+		t.Fatalf("started %d attempts; want 1", n)
+	}
+	atts, err := cwRepo.QueryAttempts(context.Background(), coursework.AttemptFilter{CourseworkID: cw.ID})
+	if err != nil || len(atts) != 1 {
+		t.Fatalf("QueryAttempts() = %+v, %v; want 1 attempt", atts, err)
+	}
+	att := atts[0]
+
+	// editing the question bank does not change the paper of a started attempt
+	var correct string
+	for _, o := range q.Options {
+		if o.Correct {
+			correct = o.ID
+		}
+	}
+	nq.Prompt = "1 + 2"
+	nq.Options = []coursework.Option{{Text: "2"}, {Text: "3", Correct: true}}
+	send(http.MethodPut, "/api/courses/"+crs.ID+"/questions/"+q.ID, teacherToken, nq, nil)
+	var resp echoapi.AttemptResponse
+	send(http.MethodGet, "/api/attempts/"+att.ID, studentToken, nil, &resp)
+	if len(resp.Paper) != 1 || resp.Paper[0].Prompt != "1 + 1" {
+		t.Errorf("paper = %+v; want the question as of the start", resp.Paper)
+	}
+
+	answers := coursework.SubmitAttempt{Answers: map[string][]string{q.ID: {correct}}}
+	if n := race(5, http.MethodPost, "/api/attempts/"+att.ID+"/submit", answers); n != 1 {
+		t.Fatalf("submitted %d times; want 1", n)
+	}
+	if att, err = cwRepo.GetAttempt(context.Background(), att.ID); err != nil || att.Score != 1 {
+		t.Errorf("attempt = %+v, %v; want scored 1 against the question as of the start", att, err)
+	}
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_credentialApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "pwd", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "pwd", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "pwd", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	cardsPath := "/api/classes/" + crs.ClassID + "/credential-cards"
+
+	post := func(t *testing.T, path, token string, body interface{}) *httptest.ResponseRecorder {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodPost, path, token, marchallObj(t, body))
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	status := func(t *testing.T, rec *httptest.ResponseRecorder, wantCode int) {
+		t.Helper()
+		if rec.Code != wantCode {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+	loginResponse := func(t *testing.T, rec *httptest.ResponseRecorder) LoginResponse {
+		t.Helper()
+		var resp LoginResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+		return resp
+	}
+
+	t.Run("credential cards", func(t *testing.T) {
+		body := CredentialCardsRequest{StudentIDs: []string{student1.ID, student2.ID}}
+		status(t, post(t, cardsPath, teacherToken, body), http.StatusForbidden)
+		status(t, post(t, cardsPath, adminToken, CredentialCardsRequest{}), http.StatusBadRequest)
+		status(t, post(t, cardsPath, adminToken, CredentialCardsRequest{StudentIDs: []string{outsider.ID}}), http.StatusBadRequest)
+
+		rec := post(t, cardsPath, adminToken, body)
+		status(t, rec, http.StatusOK)
+		if ct := rec.Header().Get("Content-Type"); ct != "application/pdf" {
+			t.Errorf("Content-Type = %q; want application/pdf", ct)
+		}
+		if !bytes.HasPrefix(rec.Body.Bytes(), []byte("%PDF")) {
+			t.Errorf("body is not a PDF")
+		}
+
+		for _, s := range []user.User{student1, student2} {
+			usr, err := usrRepo.GetUser(ctx, user.GetFilter{ID: s.ID})
+			if err != nil {
+				t.Fatalf("GetUser(): %v", err)
+			}
+			if !usr.MustChangePassword {
+				t.Errorf("%s: MustChangePassword = false; want true", s.Username)
+			}
+			if usr.CheckPassword("pwd") == nil {
+				t.Errorf("%s: password not changed", s.Username)
+			}
+			es, err := auditSvc.Query(audit.Filter{UserID: s.ID, Action: core.AuditUserTempPassword})
+			if err != nil || len(es) != 1 || es[0].ActorID != admin.ID || es[0].Changes["password"].After != core.AuditRedacted {
+				t.Errorf("%s: audit entries = %+v, %v; want the redacted password set by admin", s.Username, es, err)
+			}
+		}
+	})
+
+	t.Run("password change at login", func(t *testing.T) {
+		tempPwd := "Kp7m-x3Rq-9dzT"
+		usr, err := usrRepo.GetUser(ctx, user.GetFilter{ID: student1.ID})
+		if err != nil {
+			t.Fatalf("GetUser(): %v", err)
+		}
+		_ = usr.SetPassword(tempPwd)
+		usr.MustChangePassword = true
+		if _, err = usrRepo.UpdateUser(ctx, usr); err != nil {
+			t.Fatalf("UpdateUser(): %v", err)
+		}
+
+		rec := post(t, "/api/users/login", "", LoginRequest{Username: "student1", Password: tempPwd})
+		status(t, rec, http.StatusOK)
+		resp := loginResponse(t, rec)
+		if !resp.PasswordChange || resp.ChallengeToken == "" || resp.Token != "" {
+			t.Fatalf("login response = %+v; want a password change challenge", resp)
+		}
+
+		// the challenge cannot be used for the 2nd factor, nor as an access token
+		status(t, post(t, "/api/users/login/2fa", "", TwoFactorLoginRequest{ChallengeToken: resp.ChallengeToken, Code: "000000"}), http.StatusUnauthorized)
+		status(t, post(t, cardsPath, resp.ChallengeToken, CredentialCardsRequest{StudentIDs: []string{student1.ID}}), http.StatusUnauthorized)
+
+		change := func(pwd, confirm string) *httptest.ResponseRecorder {
+			np := user.NewPassword{Password: pwd, PasswordConfirm: confirm}
+			return post(t, "/api/users/login/password", "", PasswordChangeLoginRequest{ChallengeToken: resp.ChallengeToken, NewPassword: np})
+		}
+		status(t, change("Qw3rty-Zx9-New", "Qw3rty-Zx9-Neu"), http.StatusBadRequest)
+		status(t, change(tempPwd, tempPwd), http.StatusBadRequest)
+		status(t, post(t, "/api/users/login/password", "", PasswordChangeLoginRequest{
+			ChallengeToken: "invalid", NewPassword: user.NewPassword{Password: "Qw3rty-Zx9-New", PasswordConfirm: "Qw3rty-Zx9-New"},
+		}), http.StatusUnauthorized)
+
+		rec = change("Qw3rty-Zx9-New", "Qw3rty-Zx9-New")
+		status(t, rec, http.StatusOK)
+		if resp := loginResponse(t, rec); resp.Token == "" || resp.RefreshToken == "" {
+			t.Errorf("login response = %+v; want tokens", resp)
+		}
+
+		// the challenge is spent, & the new password logs in directly
+		status(t, change("Qw3rty-Zx9-Other", "Qw3rty-Zx9-Other"), http.StatusUnauthorized)
+		rec = post(t, "/api/users/login", "", LoginRequest{Username: "student1", Password: "Qw3rty-Zx9-New"})
+		status(t, rec, http.StatusOK)
+		if resp := loginResponse(t, rec); resp.PasswordChange || resp.Token == "" {
+			t.Errorf("login response = %+v; want tokens", resp)
+		}
+	})
+}
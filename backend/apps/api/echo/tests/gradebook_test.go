@@ -0,0 +1,278 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_gradebookApi_publishedMarks(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	cls, err := schRepo.GetClass(context.Background(), crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	term, err := schRepo.CreateTerm(context.Background(), school.Term{
+		SchoolID: cls.SchoolID, Name: "T1", StartsOn: now, EndsOn: now.Add(90 * 24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student1)
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	coursePath := "/api/courses/" + crs.ID
+	reportPath := "/api/classes/" + cls.ID + "/report?term_id=" + term.ID
+
+	var cat gradebook.Category
+	do(http.MethodPost, coursePath+"/categories", teacherToken, gradebook.NewCategory{TermID: term.ID, Name: "Tests", Weight: 1}, http.StatusCreated, &cat)
+	var a gradebook.Assessment
+	do(http.MethodPost, coursePath+"/assessments", teacherToken, gradebook.NewAssessment{CategoryID: cat.ID, Title: "T1", MaxScore: 20}, http.StatusCreated, &a)
+
+	// only the Teacher enters marks, and only for the Students of the Class
+	marksPath := coursePath + "/assessments/" + a.ID + "/marks"
+	entries := gradebook.EnterMarks{Marks: []gradebook.MarkEntry{
+		{StudentID: student1.ID, Score: 15},
+		{StudentID: student2.ID, Score: 18},
+	}}
+	do(http.MethodPut, marksPath, studentToken, entries, http.StatusForbidden, nil)
+	do(http.MethodPut, marksPath, teacherToken, gradebook.EnterMarks{Marks: []gradebook.MarkEntry{{StudentID: admin.ID, Score: 1}}}, http.StatusBadRequest, nil)
+	do(http.MethodPut, marksPath, teacherToken, gradebook.EnterMarks{Marks: []gradebook.MarkEntry{{StudentID: student1.ID, Score: 21}}}, http.StatusBadRequest, nil)
+	var marks []gradebook.Mark
+	do(http.MethodPut, marksPath, teacherToken, entries, http.StatusOK, &marks)
+	if len(marks) != 2 {
+		t.Fatalf("EnterMarks: got %d marks, want 2", len(marks))
+	}
+
+	// unpublished marks are hidden from Students
+	do(http.MethodGet, coursePath+"/marks?term_id="+term.ID, studentToken, nil, http.StatusOK, &marks)
+	if len(marks) != 0 {
+		t.Errorf("unpublished marks: got %d marks, want 0", len(marks))
+	}
+	var reports []gradebook.Report
+	do(http.MethodGet, reportPath, studentToken, nil, http.StatusOK, &reports)
+	if len(reports) != 1 || reports[0].Average != nil {
+		t.Errorf("unpublished report = %+v; want no average", reports)
+	}
+
+	// publish
+	do(http.MethodPost, "/api/classes/"+cls.ID+"/publish-marks", teacherToken, nil, http.StatusForbidden, nil)
+	do(http.MethodPost, "/api/classes/"+cls.ID+"/publish-marks", adminToken, nil, http.StatusOK, nil)
+
+	do(http.MethodGet, coursePath+"/marks?term_id="+term.ID, studentToken, nil, http.StatusOK, &marks)
+	if len(marks) != 1 || marks[0].StudentID != student1.ID || marks[0].Score != 15 {
+		t.Errorf("published marks = %+v; want own mark only", marks)
+	}
+	do(http.MethodGet, reportPath, studentToken, nil, http.StatusOK, &reports)
+	if len(reports) != 1 || reports[0].Average == nil || *reports[0].Average != 75 || reports[0].Rank != 2 {
+		t.Errorf("published report = %+v; want average 75 and rank 2", reports)
+	}
+
+	// corrections of published marks, like new marks, wait for the next publication
+	entries = gradebook.EnterMarks{Marks: []gradebook.MarkEntry{{StudentID: student1.ID, Score: 16}}}
+	do(http.MethodPut, marksPath, teacherToken, entries, http.StatusOK, nil)
+	var a2 gradebook.Assessment
+	do(http.MethodPost, coursePath+"/assessments", teacherToken, gradebook.NewAssessment{CategoryID: cat.ID, Title: "T2", MaxScore: 20}, http.StatusCreated, &a2)
+	do(http.MethodPut, coursePath+"/assessments/"+a2.ID+"/marks", teacherToken, entries, http.StatusOK, nil)
+	do(http.MethodGet, coursePath+"/marks?term_id="+term.ID, studentToken, nil, http.StatusOK, &marks)
+	if len(marks) != 1 || marks[0].AssessmentID != a.ID || marks[0].Score != 15 {
+		t.Errorf("corrected marks = %+v; want the published score of the first mark only", marks)
+	}
+	do(http.MethodGet, reportPath, studentToken, nil, http.StatusOK, &reports)
+	if len(reports) != 1 || reports[0].Average == nil || *reports[0].Average != 75 {
+		t.Errorf("corrected report = %+v; want the published average 75", reports)
+	}
+	do(http.MethodPost, "/api/classes/"+cls.ID+"/publish-marks", adminToken, nil, http.StatusOK, nil)
+	do(http.MethodGet, coursePath+"/marks?term_id="+term.ID, studentToken, nil, http.StatusOK, &marks)
+	if len(marks) != 2 || marks[0].Score != 16 || marks[1].Score != 16 {
+		t.Errorf("republished marks = %+v; want both marks at 16", marks)
+	}
+
+	// admins see all reports
+	do(http.MethodGet, reportPath, adminToken, nil, http.StatusOK, &reports)
+	if len(reports) != 2 {
+		t.Errorf("admin reports: got %d reports, want 2", len(reports))
+	}
+}
+
+func Test_gradebookApi_importMarks(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	cls, err := schRepo.GetClass(context.Background(), crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	term, err := schRepo.CreateTerm(context.Background(), school.Term{
+		SchoolID: cls.SchoolID, Name: "T1", StartsOn: now, EndsOn: now.Add(90 * 24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+	cat, err := gbRepo.CreateCategory(context.Background(), gradebook.Category{CourseID: crs.ID, TermID: term.ID, Name: "Tests", Weight: 1})
+	if err != nil {
+		t.Fatalf("CreateCategory(): %v", err)
+	}
+	a, err := gbRepo.CreateAssessment(context.Background(), gradebook.Assessment{CategoryID: cat.ID, Title: "T1", MaxScore: 20})
+	if err != nil {
+		t.Fatalf("CreateAssessment(): %v", err)
+	}
+	if err = gbRepo.SaveMarks(context.Background(), []gradebook.Mark{{AssessmentID: a.ID, StudentID: student2.ID, Score: 10}}); err != nil {
+		t.Fatalf("SaveMarks(): %v", err)
+	}
+
+	teacherToken := getToken(t, teacher)
+	importsPath := "/api/courses/" + crs.ID + "/assessments/" + a.ID + "/imports"
+	upload := func(filename, content string, wantCode int) gradebook.MarkImport {
+		t.Helper()
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		_ = w.WriteField("student_column", "Login")
+		fw, _ := w.CreateFormFile("file", filename)
+		_, _ = fw.Write([]byte(content))
+		_ = w.Close()
+
+		req := httptest.NewRequest(http.MethodPost, importsPath, &body)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+teacherToken)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("upload %s: code = %v; wantCode %v; body %s", filename, rec.Code, wantCode, rec.Body.String())
+		}
+		var imp gradebook.MarkImport
+		_ = json.Unmarshal(rec.Body.Bytes(), &imp)
+		return imp
+	}
+	post := func(path string, wantCode int) gradebook.MarkImport {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodPost, path, teacherToken)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("POST %s: code = %v; wantCode %v; body %s", path, rec.Code, wantCode, rec.Body.String())
+		}
+		var imp gradebook.MarkImport
+		_ = json.Unmarshal(rec.Body.Bytes(), &imp)
+		return imp
+	}
+	scores := func() map[string]float64 {
+		t.Helper()
+		marks, err := gbRepo.QueryMarks(context.Background(), gradebook.MarkFilter{AssessmentIDs: []string{a.ID}})
+		if err != nil {
+			t.Fatalf("QueryMarks(): %v", err)
+		}
+		s := make(map[string]float64, len(marks))
+		for _, m := range marks {
+			s[m.StudentID] = m.Score
+		}
+		return s
+	}
+
+	upload("marks.pdf", "", http.StatusBadRequest)
+	upload("marks.csv", "Login,Score\nstudent1,25\n", http.StatusBadRequest)
+
+	// preview does not change marks
+	imp := upload("marks.csv", "Login,Score\nstudent1,15\nstudent2@test.cd,12\nghost,20\n", http.StatusCreated)
+	if imp.Status != gradebook.ImportPending || len(imp.Entries) != 3 {
+		t.Fatalf("preview = %+v; want 3 pending entries", imp)
+	}
+	wantStatuses := []string{gradebook.EntryNew, gradebook.EntryChanged, gradebook.EntryUnknown}
+	for i, e := range imp.Entries {
+		if e.Status != wantStatuses[i] {
+			t.Errorf("entries[%d].Status = %v; want %v", i, e.Status, wantStatuses[i])
+		}
+	}
+	if s := scores(); len(s) != 1 || s[student2.ID] != 10 {
+		t.Errorf("marks after preview = %v; want unchanged", s)
+	}
+
+	// apply
+	importPath := importsPath + "/" + imp.ID
+	imp = post(importPath+"/apply", http.StatusOK)
+	if imp.Status != gradebook.ImportApplied {
+		t.Errorf("Status = %v; want %v", imp.Status, gradebook.ImportApplied)
+	}
+	if s := scores(); len(s) != 2 || s[student1.ID] != 15 || s[student2.ID] != 12 {
+		t.Errorf("marks after apply = %v", s)
+	}
+	post(importPath+"/apply", http.StatusBadRequest)
+
+	// undo
+	imp = post(importPath+"/undo", http.StatusOK)
+	if imp.Status != gradebook.ImportUndone {
+		t.Errorf("Status = %v; want %v", imp.Status, gradebook.ImportUndone)
+	}
+	if s := scores(); len(s) != 1 || s[student2.ID] != 10 {
+		t.Errorf("marks after undo = %v; want restored", s)
+	}
+	post(importPath+"/undo", http.StatusBadRequest)
+
+	// history
+	req, rec := newAuthRequest(http.MethodGet, importsPath, teacherToken)
+	server.ServeHTTP(rec, req)
+	var imps []gradebook.MarkImport
+	if err = json.Unmarshal(rec.Body.Bytes(), &imps); err != nil || len(imps) != 1 {
+		t.Errorf("history = %+v (%v); want 1 import", imps, err)
+	}
+
+	// concurrent applies: only one goes through, recording the scores it replaced
+	imp = upload("marks.csv", "Login,Score\nstudent1,18\n", http.StatusCreated)
+	importPath = importsPath + "/" + imp.ID
+	codes := make(chan int, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			req, rec := newAuthRequest(http.MethodPost, importPath+"/apply", teacherToken)
+			server.ServeHTTP(rec, req)
+			codes <- rec.Code
+		}()
+	}
+	if c1, c2 := <-codes, <-codes; c1+c2 != http.StatusOK+http.StatusBadRequest {
+		t.Errorf("concurrent apply codes = %d, %d; want %d & %d", c1, c2, http.StatusOK, http.StatusBadRequest)
+	}
+	post(importPath+"/undo", http.StatusOK)
+	if s := scores(); len(s) != 1 || s[student2.ID] != 10 {
+		t.Errorf("marks after undo = %v; want restored", s)
+	}
+}
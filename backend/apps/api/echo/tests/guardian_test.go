@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_guardianApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	stranger := testutil.CreateUser(t, usrRepo, "Student 3", "student3", "student3@test.cd", "", []string{user.RoleStudent}, true)
+	createCourse(t, teacher, student1)
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	signupRegex := regexp.MustCompile(`/signup/([\w-]+)/([\w-]+)`)
+	inv := guardian.Invitation{Name: "Mama", Email: "mama@test.cd", Relationship: guardian.RelMother}
+
+	// only holders of `users.create` invite Guardians, of Students only
+	do(http.MethodPost, "/api/students/"+student1.ID+"/guardians", teacherToken, inv, http.StatusForbidden, nil)
+	do(http.MethodPost, "/api/students/"+teacher.ID+"/guardians", adminToken, inv, http.StatusNotFound, nil)
+	do(http.MethodPost, "/api/students/"+student1.ID+"/guardians", adminToken, guardian.Invitation{Name: "Mama", Email: "mama@test.cd", Relationship: "aunt"}, http.StatusBadRequest, nil)
+	do(http.MethodPost, "/api/students/"+student1.ID+"/guardians", adminToken, guardian.Invitation{Name: "Teacher", Email: teacher.Email, Relationship: guardian.RelFather}, http.StatusBadRequest, nil)
+
+	// new Guardians are invited by email; siblings share them
+	emailsvc.SentMessages = nil // reset
+	var l guardian.Link
+	do(http.MethodPost, "/api/students/"+student1.ID+"/guardians", adminToken, inv, http.StatusCreated, &l)
+	do(http.MethodPost, "/api/students/"+student2.ID+"/guardians", adminToken, inv, http.StatusCreated, nil)
+	if len(emailsvc.SentMessages) != 2 {
+		t.Fatalf("len(SentMessages) = %d; want 2 (invitation & reminder)", len(emailsvc.SentMessages))
+	}
+	match := signupRegex.FindStringSubmatch(emailsvc.SentMessages[1].TextContent)
+	if match == nil {
+		t.Fatalf("text content does not match %v", signupRegex)
+	}
+	var guardians []guardian.Member
+	do(http.MethodGet, "/api/students/"+student1.ID+"/guardians", adminToken, nil, http.StatusOK, &guardians)
+	if len(guardians) != 1 || guardians[0].ID != l.GuardianID || !guardians[0].Invited {
+		t.Errorf("guardians = %+v; want the invited guardian", guardians)
+	}
+	es, err := auditSvc.Query(audit.Filter{UserID: l.GuardianID, Action: core.AuditUserInvite})
+	if err != nil || len(es) != 1 || es[0].ActorID != admin.ID {
+		t.Errorf("audit entries = %+v, %v; want the invitation by admin", es, err)
+	}
+
+	// invited Guardians cannot log in before signing up
+	login := LoginRequest{Username: inv.Email, Password: "Guardian.Pwd1"}
+	req, rec := newRequest(http.MethodPost, "/api/users/login", marchallObj(t, login))
+	server.ServeHTTP(rec, req)
+	if rec.Code == http.StatusOK {
+		t.Fatal("login before signup: want failure")
+	}
+	signup := user.ResetUserPassword{UID: match[1], Token: match[2], Password: login.Password, PasswordConfirm: login.Password}
+	var g user.User
+	do(http.MethodPost, "/api/users/signup", "", signup, http.StatusOK, &g)
+	if !g.IsGuardian() || g.IsActive == nil || !*g.IsActive {
+		t.Errorf("guardian = %+v; want an active guardian", g)
+	}
+	do(http.MethodPost, "/api/users/signup", "", signup, http.StatusBadRequest, nil)
+	var resp LoginResponse
+	req, rec = newRequest(http.MethodPost, "/api/users/login", marchallObj(t, login))
+	server.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login after signup: code = %v; body %s", rec.Code, rec.Body.String())
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	guardianToken := resp.Token
+
+	// read-only views of their children
+	var children []guardian.Member
+	do(http.MethodGet, "/api/me/children", guardianToken, nil, http.StatusOK, &children)
+	if len(children) != 2 || children[0].Relationship != guardian.RelMother {
+		t.Errorf("children = %+v; want 2", children)
+	}
+	var marks []guardian.CourseMarks
+	do(http.MethodGet, "/api/children/"+student1.ID+"/marks", guardianToken, nil, http.StatusOK, &marks)
+	if len(marks) != 1 {
+		t.Errorf("marks = %+v; want 1 course", marks)
+	}
+	var att []guardian.ClassAttendance
+	do(http.MethodGet, "/api/children/"+student1.ID+"/attendance", guardianToken, nil, http.StatusOK, &att)
+	if len(att) != 1 {
+		t.Errorf("attendance = %+v; want 1 class", att)
+	}
+	do(http.MethodGet, "/api/children/"+student1.ID+"/announcements", guardianToken, nil, http.StatusOK, nil)
+	do(http.MethodGet, "/api/children/"+student1.ID+"/coursework", guardianToken, nil, http.StatusOK, nil)
+	do(http.MethodGet, "/api/children/"+stranger.ID+"/marks", guardianToken, nil, http.StatusNotFound, nil)
+	do(http.MethodGet, "/api/children/not-a-uuid/marks", guardianToken, nil, http.StatusNotFound, nil)
+
+	// unlinking
+	do(http.MethodDelete, "/api/students/"+student2.ID+"/guardians/"+g.ID, adminToken, nil, http.StatusNoContent, nil)
+	do(http.MethodDelete, "/api/students/"+student2.ID+"/guardians/"+g.ID, adminToken, nil, http.StatusNotFound, nil)
+	do(http.MethodGet, "/api/children/"+student2.ID+"/marks", guardianToken, nil, http.StatusNotFound, nil)
+
+	// deactivating an invited Guardian revokes their invitation
+	emailsvc.SentMessages = nil // reset
+	papa := guardian.Invitation{Name: "Papa", Email: "papa@test.cd", Relationship: guardian.RelFather}
+	do(http.MethodPost, "/api/students/"+student2.ID+"/guardians", adminToken, papa, http.StatusCreated, &l)
+	if match = signupRegex.FindStringSubmatch(emailsvc.SentMessages[0].TextContent); match == nil {
+		t.Fatalf("text content does not match %v", signupRegex)
+	}
+	inactive := false
+	do(http.MethodPut, "/api/users/"+l.GuardianID, adminToken, user.UpdateUser{Name: papa.Name, Email: papa.Email, IsActive: &inactive}, http.StatusOK, nil)
+	do(http.MethodGet, "/api/students/"+student2.ID+"/guardians", adminToken, nil, http.StatusOK, &guardians)
+	if len(guardians) != 1 || guardians[0].Invited {
+		t.Errorf("guardians = %+v; want the deactivated guardian, not invited", guardians)
+	}
+	signup = user.ResetUserPassword{UID: match[1], Token: match[2], Password: login.Password, PasswordConfirm: login.Password}
+	do(http.MethodPost, "/api/users/signup", "", signup, http.StatusBadRequest, nil)
+}
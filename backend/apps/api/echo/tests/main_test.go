@@ -17,18 +17,56 @@ import (
 	"github.com/go-playground/validator/v10"
 	. "github.com/trezcool/masomo/apps/api/echo"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 	"github.com/trezcool/masomo/services/email"
 	logsvc "github.com/trezcool/masomo/services/logger"
+	"github.com/trezcool/masomo/services/media"
+	"github.com/trezcool/masomo/services/pdf"
+	"github.com/trezcool/masomo/services/pubsub"
+	"github.com/trezcool/masomo/services/push"
+	"github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database/sqlboiler"
 	"github.com/trezcool/masomo/tests"
 )
 
 var (
-	db      *sql.DB
-	conf    *core.Config
-	server  *Server
-	usrRepo user.Repository
+	db       *sql.DB
+	conf     *core.Config
+	server   *Server
+	usrRepo  user.Repository
+	schRepo  school.Repository
+	cwRepo   coursework.Repository
+	gbRepo   gradebook.Repository
+	sessRepo session.Repository
+	annSvc   announcement.ServiceInterface
+	rbacSvc  rbac.ServiceInterface
+	keySvc   jwtkey.ServiceInterface
+	tfSvc    twofactor.ServiceInterface
+	oidcProv *testutil.OIDCProvider
+	privSvc  privacy.ServiceInterface
+	auditSvc audit.ServiceInterface
 
 	errMissingToken = httpErr{Error: "missing or malformed jwt"}
 )
@@ -46,10 +84,46 @@ func TestMain(m *testing.M) {
 	// set up DB & repos
 	db = testutil.OpenDB(conf)
 	usrRepo = boiledrepos.NewUserRepository(db)
+	schRepo = boiledrepos.NewSchoolRepository(db)
+	cwRepo = boiledrepos.NewCourseworkRepository(db)
+	gbRepo = boiledrepos.NewGradebookRepository(db)
+	sessRepo = boiledrepos.NewSessionRepository(db)
 
 	// set up services
 	mailSvc := emailsvc.NewConsoleServiceMock(conf)
-	usrSvc := user.NewServiceMock(db, usrRepo, mailSvc, conf)
+	smsGateway := smssvc.NewConsoleServiceMock(conf)
+	auditSvc = audit.NewService(conf, boiledrepos.NewAuditRepository(db))
+	sessionSvc := session.NewService(db, sessRepo, conf)
+	schSvc := school.NewService(db, schRepo)
+	usrSvc := user.NewServiceMock(db, usrRepo, mailSvc, smsGateway, auditSvc, sessionSvc, schSvc, conf)
+	smsSvc := sms.NewService(boiledrepos.NewSMSRepository(db), schSvc, smsGateway, logger)
+	cwSvc := coursework.NewService(db, cwRepo)
+	gbSvc := gradebook.NewService(db, gbRepo, schSvc, cwSvc, usrSvc)
+	conf.MediaRoot, err = os.MkdirTemp("", "masomo-media-")
+	if err != nil {
+		fmt.Printf("os.MkdirTemp(): %v", err)
+		os.Exit(1)
+	}
+	media := mediasvc.NewFileSystemStorage(conf)
+	cardSvc := reportcard.NewService(conf, gbSvc, schSvc, usrSvc, media, pdfsvc.NewHTMLRenderer(), mailSvc)
+	attSvc := attendance.NewService(conf, db, boiledrepos.NewAttendanceRepository(db), schSvc, usrSvc, mailSvc, smsSvc, logger)
+	ps := pubsub.NewMemoryPubSub()
+	notifSvc := notification.NewService(conf, db, boiledrepos.NewNotificationRepository(db), usrSvc, mailSvc, pushsvc.NewConsoleServiceMock(), ps, logger)
+	annSvc = announcement.NewService(conf, db, boiledrepos.NewAnnouncementRepository(db), schSvc, usrSvc, media, mailSvc, notifSvc, smsSvc)
+	chatSvc := chat.NewService(db, boiledrepos.NewChatRepository(db), schSvc, ps, logger)
+	calSvc := calendar.NewService(db, boiledrepos.NewCalendarRepository(db), schSvc, cwSvc, usrSvc)
+	walletSvc := wallet.NewService(db, boiledrepos.NewWalletRepository(db), schSvc, cwSvc, gbSvc, logger)
+	rbacSvc = rbac.NewService(db, boiledrepos.NewRBACRepository(db), usrSvc, auditSvc)
+	guardSvc := guardian.NewService(boiledrepos.NewGuardianRepository(db), usrSvc, schSvc, gbSvc, attSvc, annSvc, cwSvc)
+	keySvc = jwtkey.NewService(conf, db, boiledrepos.NewJWTKeyRepository(db))
+	tfSvc = twofactor.NewService(conf, db, boiledrepos.NewTwoFactorRepository(db), schSvc)
+	oidcProv = testutil.NewOIDCProvider("masomo", "secret") // signs in with "google"
+	conf.OIDC.Google = core.OIDCProviderConf{Issuer: oidcProv.URL, ClientID: oidcProv.ClientID, ClientSecret: oidcProv.ClientSecret}
+	oidcSvc := oidc.NewService(conf, boiledrepos.NewOIDCRepository(db), usrSvc)
+	passwordlessSvc := passwordless.NewServiceMock(conf, boiledrepos.NewPasswordlessRepository(db), usrSvc, schSvc, mailSvc, smsGateway)
+	credentialSvc := credential.NewService(conf, usrSvc, schSvc, pdfsvc.NewHTMLRenderer())
+	avatarSvc := avatar.NewService(conf, usrSvc, media)
+	privSvc = privacy.NewService(conf, db, boiledrepos.NewPrivacyRepository(db), usrSvc, schSvc, guardSvc, notifSvc, avatarSvc)
 
 	// =========================================================================
 	// Initialization
@@ -59,18 +133,43 @@ func TestMain(m *testing.M) {
 	translator, _ := uni.GetTranslator("en")
 	core.InitValidators(validate, translator)
 	user.InitValidators(validate, translator)
+	coursework.InitValidators(validate, translator)
 
 	core.ParseEmailTemplates(logger)
+	core.ParseDocumentTemplates(logger)
 	user.LoadCommonPasswords(logger)
 
 	// set up server
 	server = NewServer(
 		ServerDeps{
-			Conf:       conf,
-			Logger:     logger,
-			UserSvc:    usrSvc,
-			Validate:   validate,
-			Translator: translator,
+			Conf:            conf,
+			Logger:          logger,
+			UserSvc:         usrSvc,
+			SchoolSvc:       schSvc,
+			CourseworkSvc:   cwSvc,
+			GradebookSvc:    gbSvc,
+			ReportCardSvc:   cardSvc,
+			AttendanceSvc:   attSvc,
+			AnnouncementSvc: annSvc,
+			ChatSvc:         chatSvc,
+			NotificationSvc: notifSvc,
+			SMSSvc:          smsSvc,
+			CalendarSvc:     calSvc,
+			WalletSvc:       walletSvc,
+			RBACSvc:         rbacSvc,
+			GuardianSvc:     guardSvc,
+			SessionSvc:      sessionSvc,
+			JWTKeySvc:       keySvc,
+			TwoFactorSvc:    tfSvc,
+			OIDCSvc:         oidcSvc,
+			PasswordlessSvc: passwordlessSvc,
+			CredentialSvc:   credentialSvc,
+			AvatarSvc:       avatarSvc,
+			PrivacySvc:      privSvc,
+			AuditSvc:        auditSvc,
+			Media:           media,
+			Validate:        validate,
+			Translator:      translator,
 		},
 	)
 
@@ -78,6 +177,9 @@ func TestMain(m *testing.M) {
 	code := m.Run()
 
 	// clean up
+	_ = ps.Close()
+	oidcProv.Close()
+	_ = os.RemoveAll(conf.MediaRoot)
 	if err = db.Close(); err != nil {
 		fmt.Printf("db.Close(): %v", err)
 		os.Exit(1)
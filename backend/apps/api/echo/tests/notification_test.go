@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/services/push"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_notificationApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	studentToken := getToken(t, student)
+	publishPath := "/api/classes/" + crs.ClassID + "/publish-marks"
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	inbox := func(token, query string) notification.Page {
+		t.Helper()
+		var page notification.Page
+		do(http.MethodGet, "/api/me/notifications"+query, token, nil, http.StatusOK, &page)
+		return page
+	}
+
+	t.Run("preferences", func(t *testing.T) {
+		var prefs []notification.Preference
+		do(http.MethodGet, "/api/me/notification-preferences", studentToken, nil, http.StatusOK, &prefs)
+		if len(prefs) != len(notification.Events()) {
+			t.Fatalf("len(prefs) = %d; want %d", len(prefs), len(notification.Events()))
+		}
+
+		data := notification.UpdatePreferences{Preferences: []notification.Preference{{Event: "nope", InApp: true}}}
+		do(http.MethodPut, "/api/me/notification-preferences", studentToken, data, http.StatusBadRequest, nil)
+		data.Preferences[0] = notification.Preference{Event: notification.EventMarksPublished, InApp: true, Push: true}
+		do(http.MethodPut, "/api/me/notification-preferences", studentToken, data, http.StatusOK, &prefs)
+		for _, p := range prefs {
+			if p.Event == notification.EventMarksPublished && p.Enabled(notification.ChannelEmail) {
+				t.Errorf("pref = %+v; want email disabled", p)
+			}
+		}
+	})
+
+	t.Run("channels", func(t *testing.T) {
+		sub := core.PushSubscription{Endpoint: "https://push.test.cd/abc", P256dh: "key", Auth: "secret"}
+		do(http.MethodPost, "/api/me/push-subscriptions", studentToken, core.PushSubscription{Endpoint: "nope"}, http.StatusBadRequest, nil)
+		do(http.MethodPost, "/api/me/push-subscriptions", studentToken, sub, http.StatusCreated, nil)
+
+		emailsvc.SentMessages = nil // reset
+		pushsvc.SentMessages = nil  // reset
+		do(http.MethodPost, publishPath, adminToken, nil, http.StatusOK, nil)
+
+		page := inbox(studentToken, "")
+		if page.Unread != 1 || len(page.Notifications) != 1 || page.Notifications[0].Event != notification.EventMarksPublished {
+			t.Fatalf("page = %+v; want 1 unread marks notification", page)
+		}
+		if len(pushsvc.SentMessages) != 1 || pushsvc.SentMessages[0].Subscription.Endpoint != sub.Endpoint {
+			t.Errorf("push messages = %+v; want 1 to the student's browser", pushsvc.SentMessages)
+		}
+		if len(emailsvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0 (disabled)", len(emailsvc.SentMessages))
+		}
+		if page = inbox(teacherToken, ""); len(page.Notifications) != 0 {
+			t.Errorf("teacher inbox = %+v; want empty", page)
+		}
+
+		do(http.MethodDelete, "/api/me/push-subscriptions", studentToken, PushUnsubscribeRequest{Endpoint: sub.Endpoint}, http.StatusNoContent, nil)
+		pushsvc.SentMessages = nil // reset
+		do(http.MethodPost, publishPath, adminToken, nil, http.StatusOK, nil)
+		if len(pushsvc.SentMessages) != 0 {
+			t.Errorf("len(push messages) = %d; want 0 (unsubscribed)", len(pushsvc.SentMessages))
+		}
+	})
+
+	t.Run("read", func(t *testing.T) {
+		page := inbox(studentToken, "?limit=1")
+		if page.Unread != 2 || len(page.Notifications) != 1 {
+			t.Fatalf("page = %+v; want 1 of 2 unread", page)
+		}
+		id := page.Notifications[0].ID
+		do(http.MethodPost, "/api/me/notifications/"+id+"/read", teacherToken, nil, http.StatusNotFound, nil)
+		do(http.MethodPost, "/api/me/notifications/"+id+"/read", studentToken, nil, http.StatusNoContent, nil)
+		if page = inbox(studentToken, "?unread=true"); page.Unread != 1 || len(page.Notifications) != 1 || page.Notifications[0].ID == id {
+			t.Errorf("page = %+v; want the other notification only", page)
+		}
+		do(http.MethodPost, "/api/me/notifications/read", studentToken, nil, http.StatusNoContent, nil)
+		if page = inbox(studentToken, ""); page.Unread != 0 || len(page.Notifications) != 2 {
+			t.Errorf("page = %+v; want 2 read notifications", page)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		srv := httptest.NewServer(server)
+		defer srv.Close()
+
+		if resp, err := http.Get(srv.URL + "/api/me/notifications/stream"); err != nil || resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("GET stream without token: %v, %v; want 400", resp, err)
+		}
+		resp, err := http.Get(srv.URL + "/api/me/notifications/stream?token=" + studentToken)
+		if err != nil {
+			t.Fatalf("GET stream: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Fatalf("Content-Type = %q; want text/event-stream", ct)
+		}
+
+		events := make(chan string, 1)
+		go func() {
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				if line := scanner.Text(); strings.HasPrefix(line, "data: ") {
+					events <- strings.TrimPrefix(line, "data: ")
+				}
+			}
+		}()
+
+		do(http.MethodPost, publishPath, adminToken, nil, http.StatusOK, nil)
+		select {
+		case data := <-events:
+			var n core.Notification
+			if err = json.Unmarshal([]byte(data), &n); err != nil || n.UserID != student.ID || n.Event != notification.EventMarksPublished {
+				t.Errorf("event = %s, %v; want the student's marks notification", data, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	})
+}
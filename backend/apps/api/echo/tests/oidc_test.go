@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_oidcApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	crs := createCourse(t, teacher)
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	adminToken := getToken(t, admin)
+	domainsPath := "/api/schools/" + cls.SchoolID + "/email-domains"
+
+	authorize := func(t *testing.T) string {
+		t.Helper()
+		req, rec := newRequest(http.MethodGet, "/api/auth/oidc/google/authorize")
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("authorize failed! code = %v; body %s", rec.Code, rec.Body.String())
+		}
+		var resp echoapi.AuthorizeResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+		if resp.RedirectURI != conf.FrontendBaseURL+"/auth/oidc/google/callback" {
+			t.Errorf("authorize failed! redirect_uri %s", resp.RedirectURI)
+		}
+		return resp.AuthorizationURL
+	}
+	callback := func(t *testing.T, code, state string) *httptest.ResponseRecorder {
+		t.Helper()
+		body := marchallObj(t, echoapi.OIDCCallbackRequest{Code: code, State: state})
+		req, rec := newRequest(http.MethodPost, "/api/auth/oidc/google/callback", body)
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	signIn := func(t *testing.T, id testutil.OIDCIdentity) *httptest.ResponseRecorder {
+		t.Helper()
+		code, state := oidcProv.Authorize(t, authorize(t), id)
+		return callback(t, code, state)
+	}
+	checkTokens := func(t *testing.T, rec *httptest.ResponseRecorder) {
+		t.Helper()
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+		var resp echoapi.LoginResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+		if resp.Token == "" || resp.RefreshToken == "" {
+			t.Errorf("failed! empty token(s) %+v", resp)
+		}
+	}
+	noAccount := marchallObj(t, httpErr{Error: oidc.ErrNoAccount.Error()})
+	invalidState := marchallObj(t, httpErr{Error: oidc.ErrInvalidState.Error()})
+
+	t.Run("Providers", func(t *testing.T) {
+		req, rec := newRequest(http.MethodGet, "/api/auth/oidc/providers")
+		server.ServeHTTP(rec, req)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusOK, wantData: marchallObj(t, echoapi.ProvidersResponse{Providers: []string{"google"}})}, rec)
+
+		req, rec = newRequest(http.MethodGet, "/api/auth/oidc/lol/authorize")
+		server.ServeHTTP(rec, req)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusNotFound, wantData: marchallObj(t, httpErr{Error: "not found"})}, rec)
+	})
+
+	t.Run("Linked by verified email", func(t *testing.T) {
+		checkTokens(t, signIn(t, testutil.OIDCIdentity{Subject: "g-teacher", Email: "TEACHER@test.cd", EmailVerified: true}))
+
+		// then by the subject; emails may change
+		checkTokens(t, signIn(t, testutil.OIDCIdentity{Subject: "g-teacher", Email: "t@gmail.com"}))
+	})
+
+	t.Run("Unverified email", func(t *testing.T) {
+		rec := signIn(t, testutil.OIDCIdentity{Subject: "g-admin", Email: admin.Email})
+		checkCodeAndData(t, httpTest{wantCode: http.StatusForbidden, wantData: noAccount}, rec)
+	})
+
+	t.Run("States are single-use", func(t *testing.T) {
+		code, state := oidcProv.Authorize(t, authorize(t), testutil.OIDCIdentity{Subject: "g-teacher"})
+		checkTokens(t, callback(t, code, state))
+
+		code, _ = oidcProv.Authorize(t, authorize(t), testutil.OIDCIdentity{Subject: "g-teacher"})
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: invalidState}, callback(t, code, state))
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: invalidState}, callback(t, code, "lol"))
+	})
+
+	t.Run("PKCE", func(t *testing.T) {
+		// a code stolen from another sign-in can't be exchanged: the verifier of the state doesn't match
+		stolen, _ := oidcProv.Authorize(t, authorize(t), testutil.OIDCIdentity{Subject: "g-teacher"})
+		_, state := oidcProv.Authorize(t, authorize(t), testutil.OIDCIdentity{Subject: "g-admin"})
+		rec := callback(t, stolen, state)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: oidc.ErrAuthorizationFailed.Error()})}, rec)
+	})
+
+	t.Run("Provisioned by email domain", func(t *testing.T) {
+		newcomer := testutil.OIDCIdentity{Subject: "g-new", Email: "newcomer@school.cd", EmailVerified: true, Name: "New Comer"}
+		checkCodeAndData(t, httpTest{wantCode: http.StatusForbidden, wantData: noAccount}, signIn(t, newcomer))
+
+		post := func(nd oidc.NewDomain) *httptest.ResponseRecorder {
+			req, rec := newAuthRequest(http.MethodPost, domainsPath, adminToken, marchallObj(t, nd))
+			server.ServeHTTP(rec, req)
+			return rec
+		}
+		if rec := post(oidc.NewDomain{Domain: "school.cd", Role: user.RoleAdmin}); rec.Code != http.StatusBadRequest {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+		}
+		if rec := post(oidc.NewDomain{Domain: "School.CD", Role: user.RoleStudent}); rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		checkTokens(t, signIn(t, newcomer))
+		usr, err := usrRepo.GetUser(ctx, user.GetFilter{Email: "newcomer@school.cd"})
+		if err != nil {
+			t.Fatalf("GetUser(): %v", err)
+		}
+		if usr.Name != "New Comer" || !usr.IsStudent() || usr.IsAdmin() {
+			t.Errorf("failed! provisioned user %+v", usr)
+		}
+
+		// the domain belongs to a single School
+		other := createCourse(t, teacher)
+		otherCls, err := schRepo.GetClass(ctx, other.ClassID)
+		if err != nil {
+			t.Fatalf("GetClass(): %v", err)
+		}
+		req, rec := newAuthRequest(http.MethodPost, "/api/schools/"+otherCls.SchoolID+"/email-domains", adminToken, marchallObj(t, oidc.NewDomain{Domain: "school.cd", Role: user.RoleStudent}))
+		server.ServeHTTP(rec, req)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusBadRequest, wantData: marchallObj(t, map[string]string{"domain": oidc.ErrDomainTaken.Error()})}, rec)
+
+		req, rec = newAuthRequest(http.MethodDelete, domainsPath+"/school.cd", adminToken)
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusNoContent, rec.Body.String())
+		}
+		req, rec = newAuthRequest(http.MethodGet, domainsPath, adminToken)
+		server.ServeHTTP(rec, req)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusOK, wantData: marchallList(t)}, rec)
+	})
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/services/sms"
+	"github.com/trezcool/masomo/tests"
+)
+
+var (
+	loginLinkRegex = regexp.MustCompile(`/login/link/([\w-]+)/([\w-]+)`)
+	loginCodeRegex = regexp.MustCompile(`\b(\d{6})\b`)
+)
+
+func Test_passwordlessApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student)
+	student.Phone = "+243810000002"
+	if _, err := usrRepo.UpdateUser(ctx, student); err != nil {
+		t.Fatalf("UpdateUser(): %v", err)
+	}
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	adminToken := getToken(t, admin)
+
+	post := func(t *testing.T, path string, body interface{}) *httptest.ResponseRecorder {
+		t.Helper()
+		req, rec := newRequest(http.MethodPost, path, marchallObj(t, body))
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	status := func(t *testing.T, rec *httptest.ResponseRecorder, wantCode int) {
+		t.Helper()
+		if rec.Code != wantCode {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+	request := func(t *testing.T, lr passwordless.LoginRequest) {
+		t.Helper()
+		emailsvc.SentMessages = nil // reset
+		smssvc.SentMessages = nil   // reset
+		if rec := post(t, "/api/users/login/passwordless", lr); rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+	}
+	loggedIn := func(t *testing.T, rec *httptest.ResponseRecorder) {
+		t.Helper()
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+		var resp echoapi.LoginResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+		if resp.Token == "" || resp.RefreshToken == "" {
+			t.Errorf("failed! empty token(s) %+v", resp)
+		}
+	}
+
+	t.Run("Disabled by default", func(t *testing.T) {
+		request(t, passwordless.LoginRequest{Login: "student", Method: passwordless.MethodLink})
+		if len(emailsvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0", len(emailsvc.SentMessages))
+		}
+	})
+
+	setPolicy := func(t *testing.T, sp school.SecurityPolicy) {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodPut, "/api/schools/"+cls.SchoolID+"/security", adminToken, marchallObj(t, sp))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+	}
+	setPolicy(t, school.SecurityPolicy{PasswordlessLogin: true})
+
+	t.Run("Never for admins", func(t *testing.T) {
+		request(t, passwordless.LoginRequest{Login: "admin", Method: passwordless.MethodCode})
+		if len(emailsvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0", len(emailsvc.SentMessages))
+		}
+	})
+
+	t.Run("Links are only sent by email", func(t *testing.T) {
+		rec := post(t, "/api/users/login/passwordless", passwordless.LoginRequest{Login: "student", Method: passwordless.MethodLink, Channel: passwordless.ChannelSMS})
+		status(t, rec, http.StatusBadRequest)
+	})
+
+	t.Run("Not to unverified addresses when the School requires verified ones", func(t *testing.T) {
+		setPolicy(t, school.SecurityPolicy{PasswordlessLogin: true, RequireVerifiedEmail: true})
+		defer setPolicy(t, school.SecurityPolicy{PasswordlessLogin: true})
+
+		request(t, passwordless.LoginRequest{Login: "student", Method: passwordless.MethodLink})
+		if len(emailsvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0", len(emailsvc.SentMessages))
+		}
+	})
+
+	t.Run("Login link", func(t *testing.T) {
+		request(t, passwordless.LoginRequest{Login: "student@test.cd", Method: passwordless.MethodLink})
+		if len(emailsvc.SentMessages) != 1 {
+			t.Fatalf("len(SentMessages) = %d; want 1", len(emailsvc.SentMessages))
+		}
+		match := loginLinkRegex.FindStringSubmatch(emailsvc.SentMessages[0].TextContent)
+		if match == nil {
+			t.Fatalf("text content does not match %v", loginLinkRegex)
+		}
+		body := passwordless.LinkLogin{UID: match[1], Token: match[2]}
+
+		rec := post(t, "/api/users/login/passwordless/link", passwordless.LinkLogin{UID: match[1], Token: match[2] + "x"})
+		status(t, rec, http.StatusBadRequest)
+
+		loggedIn(t, post(t, "/api/users/login/passwordless/link", body))
+
+		// links are single-use
+		rec = post(t, "/api/users/login/passwordless/link", body)
+		status(t, rec, http.StatusBadRequest)
+	})
+
+	t.Run("Login code by SMS", func(t *testing.T) {
+		request(t, passwordless.LoginRequest{Login: "student", Method: passwordless.MethodCode, Channel: passwordless.ChannelSMS})
+		if len(smssvc.SentMessages) != 1 || smssvc.SentMessages[0].To != student.Phone {
+			t.Fatalf("SentMessages = %+v; want 1 to %s", smssvc.SentMessages, student.Phone)
+		}
+		match := loginCodeRegex.FindStringSubmatch(smssvc.SentMessages[0].Body)
+		if match == nil {
+			t.Fatalf("body does not match %v", loginCodeRegex)
+		}
+		body := passwordless.CodeLogin{Login: "student", Code: match[1]}
+
+		loggedIn(t, post(t, "/api/users/login/passwordless/code", body))
+
+		// codes are single-use
+		rec := post(t, "/api/users/login/passwordless/code", body)
+		status(t, rec, http.StatusBadRequest)
+	})
+
+	t.Run("Too many attempts", func(t *testing.T) {
+		request(t, passwordless.LoginRequest{Login: "teacher", Method: passwordless.MethodCode})
+		match := loginCodeRegex.FindStringSubmatch(emailsvc.SentMessages[0].TextContent)
+		if match == nil {
+			t.Fatalf("text content does not match %v", loginCodeRegex)
+		}
+		wrong := "000000"
+		if match[1] == wrong {
+			wrong = "000001"
+		}
+		for i := 0; i < 5; i++ {
+			rec := post(t, "/api/users/login/passwordless/code", passwordless.CodeLogin{Login: "teacher", Code: wrong})
+			status(t, rec, http.StatusBadRequest)
+		}
+		rec := post(t, "/api/users/login/passwordless/code", passwordless.CodeLogin{Login: "teacher", Code: match[1]})
+		status(t, rec, http.StatusTooManyRequests)
+
+		// the code was invalidated once the attempts ran out: it is rejected in the next window too
+		if _, err := db.Exec("UPDATE passwordless_limit SET attempts_reset_at = now()"); err != nil {
+			t.Fatalf("db.Exec(): %v", err)
+		}
+		rec = post(t, "/api/users/login/passwordless/code", passwordless.CodeLogin{Login: "teacher", Code: match[1]})
+		status(t, rec, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_userApi_softDelete(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	adminToken := getToken(t, admin)
+
+	do := func(t *testing.T, method, path, token string, wantCode int) *httptest.ResponseRecorder {
+		t.Helper()
+		req, rec := newAuthRequest(method, path, token)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		return rec
+	}
+	login := func(t *testing.T, wantCode int) {
+		t.Helper()
+		req, rec := newRequest(http.MethodPost, "/api/users/login", marchallObj(t, echoapi.LoginRequest{Username: "student", Password: "pwd"}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("login: code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+
+	do(t, http.MethodDelete, "/api/users/"+student.ID, adminToken, http.StatusNoContent)
+
+	t.Run("deleted users are hidden", func(t *testing.T) {
+		do(t, http.MethodGet, "/api/users/"+student.ID, adminToken, http.StatusNotFound)
+		rec := do(t, http.MethodGet, "/api/users", adminToken, http.StatusOK)
+		if ok, _ := jsonBytesEqual(rec.Body.Bytes(), marchallList(t, teacher, admin)); !ok {
+			t.Errorf("users = %s; want teacher & admin", rec.Body.String())
+		}
+		login(t, http.StatusBadRequest)
+	})
+
+	t.Run("deleted users are listed on demand", func(t *testing.T) {
+		rec := do(t, http.MethodGet, "/api/users?deleted=true", adminToken, http.StatusOK)
+		var usrs []user.User
+		if err := json.Unmarshal(rec.Body.Bytes(), &usrs); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+		if len(usrs) != 1 || usrs[0].ID != student.ID || usrs[0].DeletedAt.IsZero() {
+			t.Errorf("deleted users = %+v; want [%s] with deleted_at", usrs, student.ID)
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		do(t, http.MethodPost, "/api/users/"+student.ID+"/restore", getToken(t, teacher), http.StatusForbidden)
+		do(t, http.MethodPost, "/api/users/"+teacher.ID+"/restore", adminToken, http.StatusNotFound) // not deleted
+		do(t, http.MethodPost, "/api/users/"+student.ID+"/restore", adminToken, http.StatusOK)
+		do(t, http.MethodGet, "/api/users/"+student.ID, adminToken, http.StatusOK)
+		login(t, http.StatusOK)
+	})
+
+	t.Run("erasure", func(t *testing.T) {
+		do(t, http.MethodDelete, "/api/users/"+student.ID, adminToken, http.StatusNoContent)
+
+		// not deleted long enough
+		n, err := privSvc.EraseDeleted(time.Now())
+		if err != nil || n != 0 {
+			t.Fatalf("EraseDeleted() = %d, %v; want 0, nil", n, err)
+		}
+		n, err = privSvc.EraseDeleted(time.Now().Add(conf.UserErasureDelay + time.Minute))
+		if err != nil || n != 1 {
+			t.Fatalf("EraseDeleted() = %d, %v; want 1, nil", n, err)
+		}
+
+		// gone for good: the username & email are free again
+		do(t, http.MethodPost, "/api/users/"+student.ID+"/restore", adminToken, http.StatusNotFound)
+		rec := do(t, http.MethodGet, "/api/users?deleted=true", adminToken, http.StatusOK)
+		if ok, _ := jsonBytesEqual(rec.Body.Bytes(), marchallList(t, []interface{}{}...)); !ok {
+			t.Errorf("deleted users = %s; want none", rec.Body.String())
+		}
+		_ = testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	})
+
+	t.Run("restore above own role", func(t *testing.T) {
+		principal := testutil.CreateUser(t, usrRepo, "Principal", "principal", "principal@test.cd", "pwd", []string{user.RoleAdminPrincipal}, true)
+		if _, err := usrRepo.SoftDeleteUsersByID(context.Background(), []string{principal.ID}, time.Now()); err != nil {
+			t.Fatalf("SoftDeleteUsersByID(): %v", err)
+		}
+		do(t, http.MethodPost, "/api/users/"+principal.ID+"/restore", adminToken, http.StatusForbidden)
+	})
+}
+
+func Test_privacyApi_export(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	_ = createCourse(t, teacher, student)
+
+	req, rec := newAuthRequest(http.MethodGet, "/api/users/me/data", "")
+	server.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("code = %v; wantCode %v", rec.Code, http.StatusUnauthorized)
+	}
+
+	req, rec = newAuthRequest(http.MethodGet, "/api/users/me/data", getToken(t, student))
+	server.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("Content-Type = %q; want application/zip", ct)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "no-store" {
+		t.Errorf("Cache-Control = %q; want no-store", cc)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader(): %v", err)
+	}
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	for _, name := range []string{
+		"profile.json", "schools.json", "guardians.json", "children.json", "marks.json",
+		"attendance.json", "notifications.json", "notification-preferences.json",
+	} {
+		if files[name] == nil {
+			t.Errorf("missing %s", name)
+		}
+	}
+
+	f, err := files["profile.json"].Open()
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	var usr user.User
+	if err = json.NewDecoder(f).Decode(&usr); err != nil {
+		t.Fatalf("Decode(): %v", err)
+	}
+	if usr.ID != student.ID || usr.Email != student.Email {
+		t.Errorf("profile = %+v; want %+v", usr, student)
+	}
+}
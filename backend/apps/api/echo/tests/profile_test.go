@@ -0,0 +1,288 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/email"
+	"github.com/trezcool/masomo/tests"
+)
+
+var (
+	emailChangeRegex = regexp.MustCompile(`/email-change/([\w-]+)/([\w-]+)\?email=(\S+)`)
+	emailVerifyRegex = regexp.MustCompile(`/email-verify/([\w-]+)/([\w-]+)`)
+)
+
+func Test_profileApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	usr := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "LolC@t123", []string{user.RoleStudent}, true)
+	_ = testutil.CreateUser(t, usrRepo, "Other", "other", "other@test.cd", "LolC@t123", []string{user.RoleStudent}, true)
+	token := getToken(t, usr)
+
+	do := func(t *testing.T, method, path string, body interface{}, wantCode int) echoapi.ProfileResponse {
+		t.Helper()
+		var data []byte
+		if body != nil {
+			data = marchallObj(t, body)
+		}
+		req, rec := newAuthRequest(method, path, token, data)
+		server.ServeHTTP(rec, req)
+		return profileResponse(t, rec, wantCode)
+	}
+	upload := func(t *testing.T, content []byte, wantCode int) echoapi.ProfileResponse {
+		t.Helper()
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		fw, _ := w.CreateFormFile("avatar", "me.png")
+		_, _ = fw.Write(content)
+		_ = w.Close()
+
+		req := httptest.NewRequest(http.MethodPut, "/api/users/me/avatar", &body)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return profileResponse(t, rec, wantCode)
+	}
+	login := func(t *testing.T, pwd string, wantCode int) {
+		t.Helper()
+		req, rec := newRequest(http.MethodPost, "/api/users/login", marchallObj(t, echoapi.LoginRequest{Username: "student", Password: pwd}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Errorf("login: code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+
+	t.Run("retrieve", func(t *testing.T) {
+		req, rec := newRequest(http.MethodGet, "/api/users/me")
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("code = %v; wantCode %v", rec.Code, http.StatusUnauthorized)
+		}
+
+		p := do(t, http.MethodGet, "/api/users/me", nil, http.StatusOK)
+		if p.ID != usr.ID || p.Username != "student" {
+			t.Errorf("profile = %+v; want %s", p, usr.Username)
+		}
+		if u := p.Avatar[256]; !strings.HasPrefix(u, "https://www.gravatar.com/avatar/") || !strings.Contains(u, "s=256") {
+			t.Errorf("avatar[256] = %q; want a gravatar", u)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		p := do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{Name: "Student Renamed", Phone: "+243810000001"}, http.StatusOK)
+		if p.Name != "Student Renamed" || p.Phone != "+243810000001" || p.Email != "student@test.cd" {
+			t.Errorf("profile = %+v; want renamed with a phone", p)
+		}
+	})
+
+	t.Run("password change", func(t *testing.T) {
+		do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{Password: "N3w-P@ssword", PasswordConfirm: "N3w-P@ssword"}, http.StatusBadRequest)
+		do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{
+			CurrentPassword: "wrong", Password: "N3w-P@ssword", PasswordConfirm: "N3w-P@ssword",
+		}, http.StatusBadRequest)
+		do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{
+			CurrentPassword: "LolC@t123", Password: "weak", PasswordConfirm: "weak",
+		}, http.StatusBadRequest)
+		do(t, http.MethodPut, "/api/users/"+usr.ID, user.UpdateUser{Password: "N3w-P@ssword", PasswordConfirm: "N3w-P@ssword"}, http.StatusForbidden)
+		login(t, "LolC@t123", http.StatusOK)
+
+		do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{
+			CurrentPassword: "LolC@t123", Password: "N3w-P@ssword", PasswordConfirm: "N3w-P@ssword",
+		}, http.StatusOK)
+		login(t, "LolC@t123", http.StatusBadRequest)
+		login(t, "N3w-P@ssword", http.StatusOK)
+	})
+
+	t.Run("email change", func(t *testing.T) {
+		emailsvc.SentMessages = nil // reset
+		do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{Email: "other@test.cd"}, http.StatusBadRequest)
+
+		p := do(t, http.MethodPatch, "/api/users/me", user.UpdateProfile{Email: "New@Test.cd"}, http.StatusOK)
+		if p.Email != "student@test.cd" || p.PendingEmail != "new@test.cd" {
+			t.Errorf("profile = %+v; want new@test.cd pending", p)
+		}
+		if len(emailsvc.SentMessages) != 1 || emailsvc.SentMessages[0].To[0].Address != "new@test.cd" {
+			t.Fatalf("SentMessages = %+v; want 1 to new@test.cd", emailsvc.SentMessages)
+		}
+		match := emailChangeRegex.FindStringSubmatch(emailsvc.SentMessages[0].TextContent)
+		if match == nil {
+			t.Fatalf("text content does not match %v", emailChangeRegex)
+		}
+		email, _ := url.QueryUnescape(match[3])
+
+		confirm := func(ec user.EmailChange, wantCode int) {
+			t.Helper()
+			req, rec := newRequest(http.MethodPost, "/api/users/email-change-confirm", marchallObj(t, ec))
+			server.ServeHTTP(rec, req)
+			if rec.Code != wantCode {
+				t.Errorf("confirm: code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+			}
+		}
+		confirm(user.EmailChange{UID: match[1], Email: "evil@test.cd", Token: match[2]}, http.StatusBadRequest)
+		confirm(user.EmailChange{UID: match[1], Email: email, Token: "invalid"}, http.StatusBadRequest)
+		confirm(user.EmailChange{UID: match[1], Email: email, Token: match[2]}, http.StatusOK)
+		confirm(user.EmailChange{UID: match[1], Email: email, Token: match[2]}, http.StatusBadRequest) // used
+
+		if p = do(t, http.MethodGet, "/api/users/me", nil, http.StatusOK); p.Email != "new@test.cd" || p.EmailVerifiedAt.IsZero() {
+			t.Errorf("profile = %+v; want new@test.cd verified", p)
+		}
+	})
+
+	t.Run("avatar", func(t *testing.T) {
+		upload(t, []byte("not an image"), http.StatusBadRequest)
+
+		img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+		for x := 0; x < 300; x++ {
+			for y := 0; y < 200; y++ {
+				img.SetRGBA(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+			}
+		}
+		var buff bytes.Buffer
+		_ = png.Encode(&buff, img)
+
+		p := upload(t, buff.Bytes(), http.StatusOK)
+		u := p.Avatar[64]
+		if !strings.HasPrefix(u, "http://localhost:8000/api/media/users/"+usr.ID+"/avatar-") {
+			t.Fatalf("avatar[64] = %q; want a media URL", u)
+		}
+		req, rec := newRequest(http.MethodGet, strings.TrimPrefix(u, "http://localhost:8000"))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET avatar: code = %v; wantCode %v", rec.Code, http.StatusOK)
+		}
+		thumb, err := png.Decode(rec.Body)
+		if err != nil {
+			t.Fatalf("png.Decode(): %v", err)
+		}
+		if b := thumb.Bounds(); b.Dx() != 64 || b.Dy() != 64 {
+			t.Errorf("thumbnail bounds = %v; want 64x64", b)
+		}
+
+		p = do(t, http.MethodDelete, "/api/users/me/avatar", nil, http.StatusOK)
+		if !strings.HasPrefix(p.Avatar[64], "https://www.gravatar.com/avatar/") {
+			t.Errorf("avatar[64] = %q; want a gravatar", p.Avatar[64])
+		}
+	})
+}
+
+func Test_profileApi_emailVerification(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	usr := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "LolC@t123", []string{user.RoleStudent}, true)
+	token := getToken(t, usr)
+
+	// the School of the student requires verified emails
+	crs := createCourse(t, teacher, usr)
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	sch, err := schRepo.GetSchool(ctx, cls.SchoolID)
+	if err != nil {
+		t.Fatalf("GetSchool(): %v", err)
+	}
+	sch.RequireVerifiedEmail = true
+	if _, err = schRepo.UpdateSchool(ctx, sch); err != nil {
+		t.Fatalf("UpdateSchool(): %v", err)
+	}
+
+	resend := func(t *testing.T, wantCode int) {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodPost, "/api/users/me/email-verification", token)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Errorf("resend: code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+	verify := func(t *testing.T, ev user.EmailVerification, wantCode int) {
+		t.Helper()
+		req, rec := newRequest(http.MethodPost, "/api/users/email-verify", marchallObj(t, ev))
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Errorf("verify: code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+	resetPassword := func(t *testing.T) []core.EmailMessage {
+		t.Helper()
+		emailsvc.SentMessages = nil // reset
+		req, rec := newRequest(http.MethodPost, "/api/users/password-reset", marchallObj(t, echoapi.PasswordResetRequest{Email: usr.Email}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("password reset: code = %v; wantCode %v", rec.Code, http.StatusOK)
+		}
+		return emailsvc.SentMessages
+	}
+
+	t.Run("unverified", func(t *testing.T) {
+		if msgs := resetPassword(t); len(msgs) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0 (unverified email)", len(msgs))
+		}
+	})
+
+	var match []string
+	t.Run("resend", func(t *testing.T) {
+		emailsvc.SentMessages = nil // reset
+		resend(t, http.StatusOK)
+		if len(emailsvc.SentMessages) != 1 || emailsvc.SentMessages[0].To[0].Address != "student@test.cd" {
+			t.Fatalf("SentMessages = %+v; want 1 to student@test.cd", emailsvc.SentMessages)
+		}
+		if match = emailVerifyRegex.FindStringSubmatch(emailsvc.SentMessages[0].TextContent); match == nil {
+			t.Fatalf("text content does not match %v", emailVerifyRegex)
+		}
+
+		resend(t, http.StatusOK)
+		resend(t, http.StatusOK)
+		resend(t, http.StatusTooManyRequests)
+	})
+
+	t.Run("verify", func(t *testing.T) {
+		verify(t, user.EmailVerification{UID: match[1]}, http.StatusBadRequest)
+		verify(t, user.EmailVerification{UID: match[1], Token: "invalid"}, http.StatusBadRequest)
+		verify(t, user.EmailVerification{UID: match[1], Token: match[2]}, http.StatusOK)
+
+		req, rec := newAuthRequest(http.MethodGet, "/api/users/me", token)
+		server.ServeHTTP(rec, req)
+		if p := profileResponse(t, rec, http.StatusOK); p.EmailVerifiedAt.IsZero() {
+			t.Errorf("email_verified_at = %v; want set", p.EmailVerifiedAt)
+		}
+		resend(t, http.StatusBadRequest) // already verified
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		if msgs := resetPassword(t); len(msgs) != 1 || msgs[0].TemplateName != "password-reset" {
+			t.Errorf("SentMessages = %+v; want a password reset email", msgs)
+		}
+	})
+}
+
+func profileResponse(t *testing.T, rec *httptest.ResponseRecorder, wantCode int) echoapi.ProfileResponse {
+	t.Helper()
+	if rec.Code != wantCode {
+		t.Fatalf("code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+	}
+	var p echoapi.ProfileResponse
+	if wantCode == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+	}
+	return p
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_rbacApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	owner := testutil.CreateUser(t, usrRepo, "Owner", "owner", "owner@test.cd", "", []string{user.RoleAdminOwner}, true)
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	deputy := testutil.CreateUser(t, usrRepo, "Deputy", "deputy", "deputy@test.cd", "", []string{user.RoleTeacher}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+
+	ctx := context.Background()
+	sch, err := schRepo.CreateSchool(ctx, school.School{Name: "School"})
+	if err != nil {
+		t.Fatalf("CreateSchool(): %v", err)
+	}
+	other, err := schRepo.CreateSchool(ctx, school.School{Name: "Other"})
+	if err != nil {
+		t.Fatalf("CreateSchool(): %v", err)
+	}
+	cls, err := schRepo.CreateClass(ctx, school.Class{SchoolID: sch.ID, Name: "6A", YearLevel: 6, Year: 2026})
+	if err != nil {
+		t.Fatalf("CreateClass(): %v", err)
+	}
+	otherCls, err := schRepo.CreateClass(ctx, school.Class{SchoolID: other.ID, Name: "6A", YearLevel: 6, Year: 2026})
+	if err != nil {
+		t.Fatalf("CreateClass(): %v", err)
+	}
+	if _, err = schRepo.CreateCourse(ctx, school.Course{ClassID: cls.ID, TeacherID: deputy.ID, Name: "Maths"}); err != nil {
+		t.Fatalf("CreateCourse(): %v", err)
+	}
+	if _, err = schRepo.CreateCourse(ctx, school.Course{ClassID: otherCls.ID, TeacherID: teacher.ID, Name: "Maths"}); err != nil {
+		t.Fatalf("CreateCourse(): %v", err)
+	}
+
+	ownerToken := getToken(t, owner)
+	adminToken := getToken(t, admin)
+	deputyToken := getToken(t, deputy)
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	rolesPath := "/api/schools/" + sch.ID + "/roles"
+	deputyRole := rbac.NewRole{Name: "Deputy", Permissions: []string{rbac.PermClassesManage, rbac.PermWalletRead}}
+
+	// only holders of `roles.manage` manage roles
+	do(http.MethodPost, rolesPath, adminToken, deputyRole, http.StatusForbidden, nil)
+	do(http.MethodPost, rolesPath, ownerToken, rbac.NewRole{Name: "Deputy", Permissions: []string{"users.fly"}}, http.StatusBadRequest, nil)
+	do(http.MethodPost, rolesPath, ownerToken, rbac.NewRole{Name: "Deputy", Permissions: []string{rbac.PermUsersRead}}, http.StatusBadRequest, nil)
+	var r rbac.Role
+	do(http.MethodPost, rolesPath, ownerToken, deputyRole, http.StatusCreated, &r)
+	if r.SchoolID != sch.ID || len(r.Permissions) != 2 {
+		t.Errorf("role = %+v; want 2 permissions in the school", r)
+	}
+	do(http.MethodPost, rolesPath, ownerToken, deputyRole, http.StatusBadRequest, nil)
+
+	// members cannot be above the actor, and study or teach in the School
+	memberPath := "/api/roles/" + r.ID + "/members"
+	do(http.MethodPost, memberPath, ownerToken, rbac.RoleMembers{IDs: []string{"5f0c2b36-1a7e-4e0e-9d46-7b2b8b1a0c11"}}, http.StatusBadRequest, nil)
+	do(http.MethodPost, memberPath, ownerToken, rbac.RoleMembers{IDs: []string{teacher.ID}}, http.StatusBadRequest, nil)
+	do(http.MethodPost, memberPath, ownerToken, rbac.RoleMembers{IDs: []string{deputy.ID}}, http.StatusNoContent, nil)
+	var members rbac.RoleMembers
+	do(http.MethodGet, memberPath, ownerToken, nil, http.StatusOK, &members)
+	if len(members.IDs) != 1 || members.IDs[0] != deputy.ID {
+		t.Errorf("members = %v; want deputy", members.IDs)
+	}
+	es, err := auditSvc.Query(audit.Filter{UserID: deputy.ID, Action: core.AuditRoleMemberAdd})
+	if err != nil || len(es) != 1 || es[0].ActorID != owner.ID || es[0].Changes["role"].After != r.ID {
+		t.Errorf("audit entries = %+v, %v; want the role given by owner", es, err)
+	}
+
+	// custom roles only apply to the resources of their School
+	var perms PermissionsResponse
+	do(http.MethodGet, "/api/me/permissions?school_id="+sch.ID, deputyToken, nil, http.StatusOK, &perms)
+	if len(perms.Permissions) != 2 {
+		t.Errorf("permissions = %v; want those of the deputy role", perms.Permissions)
+	}
+	do(http.MethodGet, "/api/me/permissions", deputyToken, nil, http.StatusOK, &perms)
+	if len(perms.Permissions) != 0 {
+		t.Errorf("permissions = %v; want none outside the school", perms.Permissions)
+	}
+	do(http.MethodGet, "/api/classes/"+cls.ID+"/students", deputyToken, nil, http.StatusOK, nil)
+	do(http.MethodGet, "/api/classes/"+otherCls.ID+"/students", deputyToken, nil, http.StatusForbidden, nil)
+	do(http.MethodPost, "/api/classes/"+cls.ID+"/publish-marks", deputyToken, nil, http.StatusForbidden, nil)
+	do(http.MethodGet, "/api/users", deputyToken, nil, http.StatusForbidden, nil)
+
+	// role hierarchy
+	do(http.MethodDelete, "/api/users/"+admin.ID, adminToken, nil, http.StatusForbidden, nil)
+	do(http.MethodDelete, "/api/users/"+owner.ID, adminToken, nil, http.StatusForbidden, nil)
+	do(http.MethodDelete, "/api/users?id="+teacher.ID+"&id="+owner.ID, adminToken, nil, http.StatusForbidden, nil)
+	do(http.MethodPut, "/api/users/"+owner.ID, adminToken, user.UpdateUser{Name: "Demoted"}, http.StatusForbidden, nil)
+	do(http.MethodPut, "/api/users/"+teacher.ID, deputyToken, user.UpdateUser{Name: "Renamed"}, http.StatusNotFound, nil)
+	do(http.MethodDelete, "/api/users?id="+teacher.ID, adminToken, nil, http.StatusNoContent, nil)
+
+	// roles are deleted with their members
+	do(http.MethodDelete, "/api/roles/"+r.ID, ownerToken, nil, http.StatusNoContent, nil)
+	do(http.MethodGet, "/api/roles/"+r.ID, ownerToken, nil, http.StatusNotFound, nil)
+	do(http.MethodGet, "/api/classes/"+cls.ID+"/students", deputyToken, nil, http.StatusForbidden, nil)
+}
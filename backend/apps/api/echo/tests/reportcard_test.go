@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_reportCardApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	cls, err := schRepo.GetClass(context.Background(), crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	term, err := schRepo.CreateTerm(context.Background(), school.Term{
+		SchoolID: cls.SchoolID, Name: "T1", StartsOn: now, EndsOn: now.Add(90 * 24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	studentToken := getToken(t, student1)
+
+	link := func(method, path, token string, wantCode int) string {
+		t.Helper()
+		req, rec := newAuthRequest(method, path, token)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if wantCode != http.StatusOK {
+			return ""
+		}
+		var resp echoapi.MediaLinkResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+		}
+		return strings.TrimPrefix(resp.URL, "http://localhost:8000")
+	}
+	download := func(path string, wantCode int) []byte {
+		t.Helper()
+		req, rec := newRequest(http.MethodGet, path)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("GET %s: code = %v; wantCode %v", path, rec.Code, wantCode)
+		}
+		return rec.Body.Bytes()
+	}
+
+	clsPath := "/api/classes/" + cls.ID + "/report-cards"
+	query := "?term_id=" + term.ID
+
+	t.Run("class cards", func(t *testing.T) {
+		link(http.MethodPost, clsPath+query, studentToken, http.StatusForbidden)
+		link(http.MethodPost, clsPath, adminToken, http.StatusBadRequest)
+
+		url := link(http.MethodPost, clsPath+query, adminToken, http.StatusOK)
+		data := download(url, http.StatusOK)
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("zip.NewReader(): %v", err)
+		}
+		if len(zr.File) != 2 {
+			t.Fatalf("len(zip files) = %d; want 2", len(zr.File))
+		}
+		if zr.File[0].Name != "Report Card - Student 1.pdf" {
+			t.Errorf("zip file name = %q; want %q", zr.File[0].Name, "Report Card - Student 1.pdf")
+		}
+
+		download(url+"x", http.StatusNotFound) // tampered signature
+	})
+
+	t.Run("student card", func(t *testing.T) {
+		url := link(http.MethodGet, clsPath+"/"+student1.ID+query, studentToken, http.StatusOK)
+		if data := download(url, http.StatusOK); !bytes.HasPrefix(data, []byte("%PDF")) {
+			t.Errorf("report card is not a PDF document")
+		}
+		link(http.MethodGet, clsPath+"/"+student2.ID+query, studentToken, http.StatusNotFound)
+		link(http.MethodGet, clsPath+"/"+student2.ID+query, adminToken, http.StatusOK)
+	})
+
+	t.Run("email cards", func(t *testing.T) {
+		req, rec := newAuthRequest(http.MethodPost, clsPath+"/email"+query, adminToken)
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("code = %v; wantCode %v; body %s", rec.Code, http.StatusAccepted, rec.Body.String())
+		}
+		var resp echoapi.EmailCardsResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+		if resp.Sent != 2 {
+			t.Errorf("sent = %d; want 2", resp.Sent)
+		}
+	})
+}
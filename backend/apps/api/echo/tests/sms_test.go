@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/services/sms"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_smsApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "", "", []string{user.RoleStudent}, true)
+	sibling := testutil.CreateUser(t, usrRepo, "Sibling", "sibling", "", "", []string{user.RoleStudent}, true)
+	other := testutil.CreateUser(t, usrRepo, "Other", "other", "", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student, sibling, other)
+
+	// the siblings share the phone of a parent
+	phones := map[*user.User]string{&teacher: "+243810000001", &student: "+243810000002", &sibling: "+243810000002", &other: "+243810000003"}
+	for usr, phone := range phones {
+		usr.Phone = phone
+		if _, err := usrRepo.UpdateUser(ctx, *usr); err != nil {
+			t.Fatalf("UpdateUser(): %v", err)
+		}
+	}
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	schPath := "/api/schools/" + cls.SchoolID
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	publish := func(title string) {
+		t.Helper()
+		data := announcement.NewAnnouncement{ClassID: cls.ID, Title: title, Body: "Saturday at 9am.", SendSMS: true}
+		do(http.MethodPost, schPath+"/announcements", adminToken, data, http.StatusCreated, nil)
+		if _, err := annSvc.PublishDue(time.Now()); err != nil {
+			t.Fatalf("PublishDue(): %v", err)
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		smssvc.SentMessages = nil // reset
+		publish("Parents meeting")
+		if len(smssvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0 (no quota)", len(smssvc.SentMessages))
+		}
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		do(http.MethodPut, schPath+"/sms", adminToken, school.SMSSettings{SenderID: "Lycee Wima Kin"}, http.StatusBadRequest, nil)
+		do(http.MethodPut, schPath+"/sms", adminToken, school.SMSSettings{MonthlyQuota: -1}, http.StatusBadRequest, nil)
+
+		var sch school.School
+		do(http.MethodPut, schPath+"/sms", adminToken, school.SMSSettings{SenderID: "Wima", MonthlyQuota: 2}, http.StatusOK, &sch)
+		if sch.SMSSenderID != "Wima" || sch.SMSMonthlyQuota != 2 {
+			t.Errorf("school = %+v; want sender Wima and quota 2", sch)
+		}
+	})
+
+	t.Run("quota", func(t *testing.T) {
+		smssvc.SentMessages = nil // reset
+		publish("Parents meeting, again")
+		// 3 phones, 2 texts allowed
+		if len(smssvc.SentMessages) != 2 {
+			t.Fatalf("len(SentMessages) = %d; want 2", len(smssvc.SentMessages))
+		}
+		for _, msg := range smssvc.SentMessages {
+			if msg.From != "Wima" || !strings.HasPrefix(msg.Body, "Parents meeting, again: ") {
+				t.Errorf("msg = %+v; want the announcement from Wima", msg)
+			}
+		}
+
+		var usage sms.Usage
+		do(http.MethodGet, schPath+"/sms-usage", adminToken, nil, http.StatusOK, &usage)
+		if usage.Sent != 2 || usage.Quota != 2 || usage.Remaining() != 0 {
+			t.Errorf("usage = %+v; want 2 of 2 sent", usage)
+		}
+		do(http.MethodGet, schPath+"/sms-usage?month=2020-01", adminToken, nil, http.StatusOK, &usage)
+		if usage.Sent != 0 {
+			t.Errorf("usage = %+v; want none sent in 2020-01", usage)
+		}
+		do(http.MethodGet, schPath+"/sms-usage?month=lol", adminToken, nil, http.StatusBadRequest, nil)
+
+		smssvc.SentMessages = nil // reset
+		publish("Quota reached")
+		if len(smssvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0 (quota reached)", len(smssvc.SentMessages))
+		}
+	})
+
+	t.Run("password reset", func(t *testing.T) {
+		path := "/api/users/password-reset"
+		do(http.MethodPost, path, "", map[string]string{"phone": "0810000002"}, http.StatusBadRequest, nil)
+
+		smssvc.SentMessages = nil // reset
+		do(http.MethodPost, path, "", map[string]string{"phone": "+243810000009"}, http.StatusOK, nil)
+		if len(smssvc.SentMessages) != 0 {
+			t.Errorf("len(SentMessages) = %d; want 0 (unknown phone)", len(smssvc.SentMessages))
+		}
+
+		do(http.MethodPost, path, "", map[string]string{"phone": student.Phone}, http.StatusOK, nil)
+		if len(smssvc.SentMessages) != 2 {
+			t.Fatalf("len(SentMessages) = %d; want 2 (student & sibling)", len(smssvc.SentMessages))
+		}
+		for _, msg := range smssvc.SentMessages {
+			if msg.To != student.Phone || msg.From != conf.SMSSenderID || !strings.Contains(msg.Body, "/password-reset/") {
+				t.Errorf("msg = %+v; want a reset link from the default sender", msg)
+			}
+		}
+	})
+}
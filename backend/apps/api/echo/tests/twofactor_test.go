@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/twofactor"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_twoFactorApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	crs := createCourse(t, teacher)
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+
+	post := func(t *testing.T, path, token string, body interface{}) *httptest.ResponseRecorder {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodPost, path, token, marchallObj(t, body))
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder, wantCode int, v interface{}) {
+		t.Helper()
+		if rec.Code != wantCode {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+	}
+	// codes are single-use: each call uses the code of a later time step
+	step := 0
+	code := func(t *testing.T, secret string) string {
+		t.Helper()
+		step++
+		c, err := twofactor.GenerateCode(secret, time.Now().Add(time.Duration(step)*30*time.Second))
+		if err != nil {
+			t.Fatalf("GenerateCode(): %v", err)
+		}
+		return c
+	}
+	twofactor.NowFunc = func() time.Time { return time.Now().Add(time.Duration(step) * 30 * time.Second) }
+	defer func() { twofactor.NowFunc = time.Now }()
+
+	login := func(t *testing.T, uname string) echoapi.LoginResponse {
+		t.Helper()
+		var resp echoapi.LoginResponse
+		decode(t, post(t, "/api/users/login", "", echoapi.LoginRequest{Username: uname, Password: "pwd"}), http.StatusOK, &resp)
+		return resp
+	}
+
+	// enroll & activate
+	var prov twofactor.Provisioning
+	decode(t, post(t, "/api/users/2fa/enroll", teacherToken, nil), http.StatusOK, &prov)
+	if prov.Secret == "" || prov.URI == "" {
+		t.Fatalf("enroll failed! %+v", prov)
+	}
+
+	t.Run("Invalid activation code", func(t *testing.T) {
+		rec := post(t, "/api/users/2fa/activate", teacherToken, echoapi.TwoFactorCodeRequest{Code: "000000"})
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+		}
+	})
+
+	var backup echoapi.BackupCodesResponse
+	decode(t, post(t, "/api/users/2fa/activate", teacherToken, echoapi.TwoFactorCodeRequest{Code: code(t, prov.Secret)}), http.StatusOK, &backup)
+	if len(backup.BackupCodes) != 10 {
+		t.Fatalf("activate failed! %d backup codes", len(backup.BackupCodes))
+	}
+
+	t.Run("Password is not enough", func(t *testing.T) {
+		resp := login(t, "teacher")
+		if resp.Token != "" || resp.RefreshToken != "" || resp.TwoFactor != "verify" || resp.ChallengeToken == "" {
+			t.Fatalf("failed! %+v", resp)
+		}
+
+		// the challenge token is no access token
+		req, rec := newAuthRequest(http.MethodGet, "/api/users/2fa", resp.ChallengeToken)
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("failed! code = %v; wantCode %v", rec.Code, http.StatusUnauthorized)
+		}
+
+		rec = post(t, "/api/users/login/2fa", "", echoapi.TwoFactorLoginRequest{ChallengeToken: "lol", Code: code(t, prov.Secret)})
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid or expired challenge token"})}, rec)
+
+		var tokens echoapi.LoginResponse
+		decode(t, post(t, "/api/users/login/2fa", "", echoapi.TwoFactorLoginRequest{ChallengeToken: resp.ChallengeToken, Code: code(t, prov.Secret)}), http.StatusOK, &tokens)
+		if tokens.Token == "" || tokens.RefreshToken == "" {
+			t.Errorf("failed! empty token(s) %+v", tokens)
+		}
+	})
+
+	t.Run("Backup codes are single-use", func(t *testing.T) {
+		resp := login(t, "teacher")
+		body := echoapi.TwoFactorLoginRequest{ChallengeToken: resp.ChallengeToken, Code: backup.BackupCodes[0]}
+		if rec := post(t, "/api/users/login/2fa", "", body); rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+		if rec := post(t, "/api/users/login/2fa", "", body); rec.Code != http.StatusBadRequest {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+		}
+
+		var st twofactor.Status
+		req, rec := newAuthRequest(http.MethodGet, "/api/users/2fa", teacherToken)
+		server.ServeHTTP(rec, req)
+		decode(t, rec, http.StatusOK, &st)
+		if !st.Enabled || st.Required || st.BackupCodesLeft != 9 {
+			t.Errorf("failed! status %+v", st)
+		}
+	})
+
+	t.Run("Locked out after too many failures", func(t *testing.T) {
+		resp := login(t, "teacher")
+		body := echoapi.TwoFactorLoginRequest{ChallengeToken: resp.ChallengeToken, Code: "000000"}
+		for i := 0; i < 5; i++ {
+			_ = post(t, "/api/users/login/2fa", "", body)
+		}
+		// even valid codes are refused for a while
+		body.Code = code(t, prov.Secret)
+		rec := post(t, "/api/users/login/2fa", "", body)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusTooManyRequests, wantData: marchallObj(t, httpErr{Error: twofactor.ErrTooManyAttempts.Error()})}, rec)
+
+		step += 30 // 15 minutes later
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		req, rec := newAuthRequest(http.MethodDelete, "/api/users/2fa", teacherToken, marchallObj(t, echoapi.TwoFactorCodeRequest{Code: code(t, prov.Secret)}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusNoContent, rec.Body.String())
+		}
+		if resp := login(t, "teacher"); resp.Token == "" || resp.TwoFactor != "" {
+			t.Errorf("failed! %+v", resp)
+		}
+	})
+
+	t.Run("Required for admins by a School", func(t *testing.T) {
+		cls, err := schRepo.GetClass(ctx, crs.ClassID)
+		if err != nil {
+			t.Fatalf("GetClass(): %v", err)
+		}
+		req, rec := newAuthRequest(http.MethodPut, "/api/schools/"+cls.SchoolID+"/security", adminToken, marchallObj(t, school.SecurityPolicy{RequireAdmin2FA: true}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		// the admin enrolls at login
+		resp := login(t, "admin")
+		if resp.Token != "" || resp.TwoFactor != "enroll" || resp.ChallengeToken == "" {
+			t.Fatalf("failed! %+v", resp)
+		}
+		var adminProv twofactor.Provisioning
+		decode(t, post(t, "/api/users/login/2fa/enroll", "", echoapi.ChallengeRequest{ChallengeToken: resp.ChallengeToken}), http.StatusOK, &adminProv)
+
+		var tokens echoapi.LoginResponse
+		body := echoapi.TwoFactorLoginRequest{ChallengeToken: resp.ChallengeToken, Code: code(t, adminProv.Secret)}
+		decode(t, post(t, "/api/users/login/2fa", "", body), http.StatusOK, &tokens)
+		if tokens.Token == "" || tokens.RefreshToken == "" || len(tokens.BackupCodes) != 10 {
+			t.Errorf("failed! %+v", tokens)
+		}
+
+		// & can't disable it
+		req, rec = newAuthRequest(http.MethodDelete, "/api/users/2fa", adminToken, marchallObj(t, echoapi.TwoFactorCodeRequest{Code: code(t, adminProv.Secret)}))
+		server.ServeHTTP(rec, req)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: twofactor.ErrRequired.Error()})}, rec)
+	})
+}
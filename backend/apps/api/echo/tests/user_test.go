@@ -3,8 +3,11 @@ package tests
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"net/mail"
 	"net/url"
 	"regexp"
@@ -16,6 +19,8 @@ import (
 	"github.com/dgrijalva/jwt-go"
 
 	"github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/session"
 	"github.com/trezcool/masomo/core/user"
 	"github.com/trezcool/masomo/services/email"
 	"github.com/trezcool/masomo/tests"
@@ -156,57 +161,48 @@ func Test_userApi_userQuery(t *testing.T) {
 	}
 }
 
-func Test_userApi_userRefreshToken(t *testing.T) {
+func Test_userApi_userLogin(t *testing.T) {
 	testutil.ResetDB(t, db)
+	ctx := context.Background()
 
-	naughty := testutil.CreateUser(t, usrRepo, "N Dog", "ndog", "ndog@test.cd", "", []string{user.RoleStudent}, false) // 😂
-	student := testutil.CreateUser(t, usrRepo, "Hero", "hero", "user3@test.cd", "", []string{user.RoleStudent}, true)
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin, user.RoleTeacher}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "pwd", []string{user.RoleTeacher}, true)
+	student := testutil.CreateUser(t, usrRepo, "Student", "student", "student@test.cd", "pwd", []string{user.RoleStudent}, true)
+	_ = testutil.CreateUser(t, usrRepo, "Newcomer", "newcomer", "newcomer@test.cd", "pwd", []string{user.RoleStudent}, true)
+	crs := createCourse(t, admin, student)
+	_ = createCourse(t, teacher) // another School
 
-	now := time.Now()
-	unrefreshableClaims := &echoapi.Claims{
-		StandardClaims: jwt.StandardClaims{
-			Issuer:    "Masomo",
-			Subject:   student.ID,
-			Audience:  "Academia",
-			ExpiresAt: now.Add(conf.Server.JWTExpiration).Unix(),
-			IssuedAt:  now.Unix(),
-		},
-		OrigIssuedAt: now.Add(-2 * conf.Server.JWTRefreshExpiration).Unix(), // older than threshold
-		IsStudent:    student.IsStudent(),
-		IsTeacher:    student.IsTeacher(),
-		IsAdmin:      student.IsAdmin(),
-		Roles:        student.Roles,
+	// the School of the student is deactivated for lack of payment
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
 	}
-	unrefreshableToken, err := echoapi.GenerateToken(unrefreshableClaims)
+	sch, err := schRepo.GetSchool(ctx, cls.SchoolID)
 	if err != nil {
-		t.Fatalf("GenerateToken(): %v", err)
+		t.Fatalf("GetSchool(): %v", err)
+	}
+	sch.SetActive(false)
+	if _, err = schRepo.UpdateSchool(ctx, sch); err != nil {
+		t.Fatalf("UpdateSchool(): %v", err)
 	}
 
 	tests := []httpTest{
-		{name: "Auth required", wantCode: http.StatusUnauthorized, wantData: marchallObj(t, errMissingToken)},
-		{name: "Inactive user not allowed", token: getToken(t, naughty), wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: "account deactivated"})},
-		{name: "Refresh period expired", token: unrefreshableToken, wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: "refresh has expired"})},
-		{name: "Token refreshed", token: getToken(t, student), wantCode: http.StatusOK},
+		{name: "Invalid credentials", body: marchallObj(t, echoapi.LoginRequest{Username: "student", Password: "lol"}), wantCode: http.StatusBadRequest},
+		{name: "School unavailable", body: marchallObj(t, echoapi.LoginRequest{Username: "student", Password: "pwd"}), wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: "School Unavailable"})},
+		{name: "Admins always get in", body: marchallObj(t, echoapi.LoginRequest{Username: "admin", Password: "pwd"}), wantCode: http.StatusOK},
+		{name: "Active school", body: marchallObj(t, echoapi.LoginRequest{Username: "teacher", Password: "pwd"}), wantCode: http.StatusOK},
+		{name: "No school yet", body: marchallObj(t, echoapi.LoginRequest{Username: "newcomer", Password: "pwd"}), wantCode: http.StatusOK},
 	}
 	for _, tt := range tests {
 		tt.method = http.MethodPost
-		tt.path = "/api/users/token-refresh"
+		tt.path = "/api/users/login"
 
 		t.Run(tt.name, func(t *testing.T) {
-			req, rec := newAuthRequest(tt.method, tt.path, tt.token, tt.body)
+			req, rec := newRequest(tt.method, tt.path, tt.body)
 			server.ServeHTTP(rec, req)
-
-			// cannot guess new token.. just check that it's not empty
-			if tt.wantCode == http.StatusOK {
+			if tt.wantData == nil {
 				if rec.Code != tt.wantCode {
-					t.Errorf("failed! code = %v; wantCode %v", rec.Code, tt.wantCode)
-				}
-				var respData echoapi.LoginResponse
-				if err := json.Unmarshal(rec.Body.Bytes(), &respData); err != nil {
-					t.Errorf("json.Unmarshal() failed! err %v", err)
-				}
-				if respData.Token == "" {
-					t.Error("failed! empty token")
+					t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, tt.wantCode, rec.Body.String())
 				}
 				return
 			}
@@ -215,6 +211,228 @@ func Test_userApi_userRefreshToken(t *testing.T) {
 	}
 }
 
+func Test_userApi_userRefreshToken(t *testing.T) {
+	testutil.ResetDB(t, db)
+	ctx := context.Background()
+
+	naughty := testutil.CreateUser(t, usrRepo, "N Dog", "ndog", "ndog@test.cd", "", []string{user.RoleStudent}, false) // 😂
+	student := testutil.CreateUser(t, usrRepo, "Hero", "hero", "user3@test.cd", "pwd", []string{user.RoleStudent}, true)
+
+	refresh := func(t *testing.T, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		body := marchallObj(t, echoapi.RefreshRequest{RefreshToken: token})
+		req, rec := newRequest(http.MethodPost, "/api/users/token-refresh", body)
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) echoapi.LoginResponse {
+		t.Helper()
+		var respData echoapi.LoginResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &respData); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+		return respData
+	}
+	// storeToken stores a refresh token of a new family for usr; the service never exposes tokens' hashes
+	storeToken := func(t *testing.T, usr user.User, token string, expiresAt time.Time) {
+		t.Helper()
+		hash := sha256.Sum256([]byte(token))
+		rt := session.RefreshToken{UserID: usr.ID, ExpiresAt: expiresAt}
+		if _, err := sessRepo.CreateToken(ctx, rt, hex.EncodeToString(hash[:])); err != nil {
+			t.Fatalf("CreateToken(): %v", err)
+		}
+	}
+
+	// login returns both tokens
+	req, rec := newRequest(http.MethodPost, "/api/users/login", marchallObj(t, echoapi.LoginRequest{Username: "hero", Password: "pwd"}))
+	server.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login failed! code = %v; body %s", rec.Code, rec.Body.String())
+	}
+	login := decode(t, rec)
+	if login.Token == "" || login.RefreshToken == "" {
+		t.Fatalf("login failed! empty token(s) %+v", login)
+	}
+
+	t.Run("Refresh token required", func(t *testing.T) {
+		rec := refresh(t, "")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusBadRequest, wantData: marchallObj(t, echoapi.RefreshRequest{RefreshToken: "this field is required"})}, rec)
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		rec := refresh(t, "lol")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+	})
+
+	t.Run("Inactive user not allowed", func(t *testing.T) {
+		storeToken(t, naughty, "naughty-token", time.Now().Add(time.Hour))
+		rec := refresh(t, "naughty-token")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: "account deactivated"})}, rec)
+	})
+
+	t.Run("Refresh period expired", func(t *testing.T) {
+		storeToken(t, student, "expired-token", time.Now().Add(-time.Minute))
+		rec := refresh(t, "expired-token")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusForbidden, wantData: marchallObj(t, httpErr{Error: "refresh has expired"})}, rec)
+	})
+
+	var rotated echoapi.LoginResponse
+	t.Run("Token refreshed", func(t *testing.T) {
+		rec := refresh(t, login.RefreshToken)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+		// cannot guess new tokens.. just check that they're not empty & rotated
+		rotated = decode(t, rec)
+		if rotated.Token == "" {
+			t.Error("failed! empty token")
+		}
+		if rotated.RefreshToken == "" || rotated.RefreshToken == login.RefreshToken {
+			t.Errorf("failed! refresh token not rotated: %q", rotated.RefreshToken)
+		}
+	})
+
+	t.Run("Reused token revokes the session", func(t *testing.T) {
+		rec := refresh(t, login.RefreshToken)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+
+		// the rotated token is revoked too
+		rec = refresh(t, rotated.RefreshToken)
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		storeToken(t, student, "session-token", time.Now().Add(time.Hour))
+		req, rec := newRequest(http.MethodPost, "/api/users/logout", marchallObj(t, echoapi.RefreshRequest{RefreshToken: "session-token"}))
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusNoContent, rec.Body.String())
+		}
+
+		rec = refresh(t, "session-token")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+	})
+
+	t.Run("Password reset revokes all sessions", func(t *testing.T) {
+		storeToken(t, student, "laptop-token", time.Now().Add(time.Hour))
+		storeToken(t, student, "phone-token", time.Now().Add(time.Hour))
+		token, err := user.MakeToken(student)
+		if err != nil {
+			t.Fatalf("MakeToken(): %v", err)
+		}
+		body := marchallObj(t, user.ResetUserPassword{Token: token, UID: user.EncodeUID(student), Password: "LolC@t123", PasswordConfirm: "LolC@t123"})
+		req, rec := newRequest(http.MethodPost, "/api/users/password-reset-confirm", body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("failed! code = %v; wantCode %v; body %s", rec.Code, http.StatusOK, rec.Body.String())
+		}
+
+		for _, token := range []string{"laptop-token", "phone-token"} {
+			rec = refresh(t, token)
+			checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+		}
+	})
+
+	t.Run("Deletion revokes all sessions", func(t *testing.T) {
+		storeToken(t, student, "deleted-token", time.Now().Add(time.Hour))
+		admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "pwd", []string{user.RoleAdmin}, true)
+		adminToken := getToken(t, admin)
+		for _, r := range []struct{ method, path string }{
+			{http.MethodDelete, "/api/users/" + student.ID},
+			{http.MethodPost, "/api/users/" + student.ID + "/restore"},
+		} {
+			req, rec := newAuthRequest(r.method, r.path, adminToken)
+			server.ServeHTTP(rec, req)
+			if rec.Code >= http.StatusBadRequest {
+				t.Fatalf("%s %s: code = %v; body %s", r.method, r.path, rec.Code, rec.Body.String())
+			}
+		}
+		// restoring the User does not revive their sessions
+		rec := refresh(t, "deleted-token")
+		checkCodeAndData(t, httpTest{wantCode: http.StatusUnauthorized, wantData: marchallObj(t, httpErr{Error: "invalid refresh token"})}, rec)
+	})
+}
+
+func Test_jwtKeys(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	student := testutil.CreateUser(t, usrRepo, "Hero", "hero", "user3@test.cd", "", []string{user.RoleStudent}, true)
+	path := "/api/users/" + student.ID
+	kid := func(t *testing.T, token string) string {
+		t.Helper()
+		parsed, _, err := new(jwt.Parser).ParseUnverified(token, new(echoapi.Claims))
+		if err != nil {
+			t.Fatalf("ParseUnverified(): %v", err)
+		}
+		k, _ := parsed.Header["kid"].(string)
+		return k
+	}
+	queryJWKS := func(t *testing.T) jwtkey.JWKS {
+		t.Helper()
+		req, rec := newRequest(http.MethodGet, "/.well-known/jwks.json")
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("jwks: code = %v; body %s", rec.Code, rec.Body.String())
+		}
+		var set jwtkey.JWKS
+		if err := json.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+			t.Fatalf("json.Unmarshal() failed! err %v", err)
+		}
+		return set
+	}
+	checkToken := func(t *testing.T, token string, wantCode int) {
+		t.Helper()
+		req, rec := newAuthRequest(http.MethodGet, path, token)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Errorf("failed! code = %v; wantCode %v; body %s", rec.Code, wantCode, rec.Body.String())
+		}
+	}
+
+	if _, err := keySvc.Rotate(jwtkey.AlgRS256); err != nil {
+		t.Fatalf("Rotate(): %v", err)
+	}
+	rsaToken := getToken(t, student)
+	checkToken(t, rsaToken, http.StatusOK)
+	set := queryJWKS(t)
+	if len(set.Keys) != 1 || set.Keys[0].Kid != kid(t, rsaToken) || set.Keys[0].Kty != "RSA" {
+		t.Fatalf("jwks = %+v; want the RSA key of the token", set)
+	}
+
+	// the previous key still verifies tokens during the grace period
+	if _, err := keySvc.Rotate(jwtkey.AlgEdDSA); err != nil {
+		t.Fatalf("Rotate(): %v", err)
+	}
+	edToken := getToken(t, student)
+	if kid(t, edToken) == kid(t, rsaToken) {
+		t.Error("failed! token signed with the retired key")
+	}
+	checkToken(t, edToken, http.StatusOK)
+	checkToken(t, rsaToken, http.StatusOK)
+	if set = queryJWKS(t); len(set.Keys) != 2 || set.Keys[0].Kid != kid(t, edToken) || set.Keys[0].Crv != "Ed25519" {
+		t.Errorf("jwks = %+v; want the Ed25519 key first", set)
+	}
+
+	// unknown keys & the secret key are rejected
+	pk, err := jwtkey.GenerateKey(jwtkey.AlgEdDSA)
+	if err != nil {
+		t.Fatalf("GenerateKey(): %v", err)
+	}
+	forged := jwt.NewWithClaims(jwtkey.SigningMethodEdDSA, echoapi.GetUserClaims(student))
+	forged.Header["kid"] = kid(t, edToken)
+	forgedToken, err := forged.SignedString(pk)
+	if err != nil {
+		t.Fatalf("SignedString(): %v", err)
+	}
+	checkToken(t, forgedToken, http.StatusUnauthorized)
+
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, echoapi.GetUserClaims(student)).SignedString([]byte(conf.SecretKey))
+	if err != nil {
+		t.Fatalf("SignedString(): %v", err)
+	}
+	checkToken(t, hmacToken, http.StatusUnauthorized)
+}
+
 func Test_userApi_userResetPassword(t *testing.T) {
 	testutil.ResetDB(t, db)
 
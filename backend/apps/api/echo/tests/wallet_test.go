@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/trezcool/masomo/apps/api/echo"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_walletApi(t *testing.T) {
+	testutil.ResetDB(t, db)
+
+	admin := testutil.CreateUser(t, usrRepo, "Admin", "admin", "admin@test.cd", "", []string{user.RoleAdmin}, true)
+	teacher := testutil.CreateUser(t, usrRepo, "Teacher", "teacher", "teacher@test.cd", "", []string{user.RoleTeacher}, true)
+	student1 := testutil.CreateUser(t, usrRepo, "Student 1", "student1", "student1@test.cd", "", []string{user.RoleStudent}, true)
+	student2 := testutil.CreateUser(t, usrRepo, "Student 2", "student2", "student2@test.cd", "", []string{user.RoleStudent}, true)
+	outsider := testutil.CreateUser(t, usrRepo, "Outsider", "outsider", "outsider@test.cd", "", []string{user.RoleStudent}, true)
+	crs := createCourse(t, teacher, student1, student2)
+
+	ctx := context.Background()
+	cls, err := schRepo.GetClass(ctx, crs.ClassID)
+	if err != nil {
+		t.Fatalf("GetClass(): %v", err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	term, err := schRepo.CreateTerm(ctx, school.Term{
+		SchoolID: cls.SchoolID, Name: "T1", StartsOn: now, EndsOn: now.Add(90 * 24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("CreateTerm(): %v", err)
+	}
+	cat, err := gbRepo.CreateCategory(ctx, gradebook.Category{CourseID: crs.ID, TermID: term.ID, Name: "Tests", Weight: 1})
+	if err != nil {
+		t.Fatalf("CreateCategory(): %v", err)
+	}
+	a, err := gbRepo.CreateAssessment(ctx, gradebook.Assessment{CategoryID: cat.ID, Title: "T1", MaxScore: 20})
+	if err != nil {
+		t.Fatalf("CreateAssessment(): %v", err)
+	}
+
+	adminToken := getToken(t, admin)
+	teacherToken := getToken(t, teacher)
+	student1Token := getToken(t, student1)
+	student2Token := getToken(t, student2)
+	outsiderToken := getToken(t, outsider)
+
+	do := func(method, path, token string, data interface{}, wantCode int, out interface{}) {
+		t.Helper()
+		var body []byte
+		if data != nil {
+			body = marchallObj(t, data)
+		}
+		req, rec := newAuthRequest(method, path, token, body)
+		server.ServeHTTP(rec, req)
+		if rec.Code != wantCode {
+			t.Fatalf("%s %s: code = %v; wantCode %v; body %s", method, path, rec.Code, wantCode, rec.Body.String())
+		}
+		if out != nil {
+			if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+				t.Fatalf("%s %s: json.Unmarshal(): %v", method, path, err)
+			}
+		}
+	}
+	walletPath := "/api/courses/" + crs.ID + "/wallet"
+	marksPath := "/api/courses/" + crs.ID + "/assessments/" + a.ID + "/marks"
+
+	// only admins allocate budgets
+	do(http.MethodPost, walletPath+"/budget", teacherToken, wallet.Allocation{Points: 100}, http.StatusForbidden, nil)
+	do(http.MethodPost, walletPath+"/budget", adminToken, wallet.Allocation{Points: 0}, http.StatusBadRequest, nil)
+	var b wallet.Budget
+	do(http.MethodPost, walletPath+"/budget", adminToken, wallet.Allocation{Points: 100, Memo: "Term 1"}, http.StatusOK, &b)
+	if b.Allocated != 100 || b.Balance != 100 || b.Year != cls.Year {
+		t.Errorf("budget = %+v; want 100 allocated", b)
+	}
+	do(http.MethodPost, walletPath+"/budget", adminToken, wallet.Allocation{Points: -500}, http.StatusBadRequest, nil)
+
+	// rules
+	var r wallet.Rule
+	do(http.MethodPost, walletPath+"/rules", student1Token, wallet.NewRule{Name: "A", Event: wallet.EventMark, Points: 1}, http.StatusForbidden, nil)
+	do(http.MethodPost, walletPath+"/rules", teacherToken, wallet.NewRule{Name: "A", Event: wallet.EventQuiz, TargetID: a.ID, Points: 1}, http.StatusBadRequest, nil)
+	do(http.MethodPost, walletPath+"/rules", teacherToken, wallet.NewRule{Name: "Top marks", Event: wallet.EventMark, TargetID: a.ID, MinPercent: 80, Points: 30}, http.StatusCreated, &r)
+
+	// entering marks pays the Students meeting the rule, once
+	entries := gradebook.EnterMarks{Marks: []gradebook.MarkEntry{
+		{StudentID: student1.ID, Score: 15},
+		{StudentID: student2.ID, Score: 18},
+	}}
+	for i := 0; i < 2; i++ {
+		do(http.MethodPut, marksPath, teacherToken, entries, http.StatusOK, nil)
+	}
+	var cw CourseWalletResponse
+	do(http.MethodGet, walletPath, student1Token, nil, http.StatusOK, &cw)
+	if cw.Budget.Allocated != 100 || cw.Budget.Spent != 30 || cw.Budget.Balance != 70 || len(cw.Rules) != 1 {
+		t.Errorf("course wallet = %+v; want 30 of 100 points spent by 1 rule", cw)
+	}
+
+	var w wallet.Wallet
+	do(http.MethodGet, "/api/me/wallet", student2Token, nil, http.StatusBadRequest, nil)
+	do(http.MethodGet, "/api/me/wallet?year=2026", student2Token, nil, http.StatusOK, &w)
+	if w.Account.Balance != 30 || len(w.Transactions) != 1 || w.Transactions[0].RuleID != r.ID {
+		t.Errorf("wallet = %+v; want 30 points earned by the rule", w)
+	}
+	do(http.MethodGet, "/api/me/wallet?year=2026", student1Token, nil, http.StatusOK, &w)
+	if w.Account.Balance != 0 || len(w.Transactions) != 0 {
+		t.Errorf("wallet = %+v; want empty", w)
+	}
+
+	// leaderboards
+	var standings []wallet.Standing
+	boardPath := "/api/classes/" + cls.ID + "/wallet/leaderboard"
+	do(http.MethodGet, boardPath, outsiderToken, nil, http.StatusNotFound, nil)
+	do(http.MethodGet, boardPath, student1Token, nil, http.StatusOK, &standings)
+	if len(standings) != 2 || standings[0].StudentID != student2.ID || standings[0].Rank != 1 || standings[1].Points != 0 {
+		t.Errorf("class leaderboard = %+v; want student2 first", standings)
+	}
+	schoolBoardPath := "/api/schools/" + cls.SchoolID + "/wallet/leaderboard"
+	do(http.MethodGet, schoolBoardPath+"?year=2026", teacherToken, nil, http.StatusForbidden, nil)
+	do(http.MethodGet, schoolBoardPath, adminToken, nil, http.StatusBadRequest, nil)
+	do(http.MethodGet, schoolBoardPath+"?year=2026&year_level=6&limit=1", adminToken, nil, http.StatusOK, &standings)
+	if len(standings) != 1 || standings[0].StudentID != student2.ID {
+		t.Errorf("school leaderboard = %+v; want student2 only", standings)
+	}
+
+	// only Course managers delete rules
+	do(http.MethodDelete, "/api/wallet/rules/"+r.ID, student2Token, nil, http.StatusNotFound, nil)
+	do(http.MethodDelete, "/api/wallet/rules/"+r.ID, teacherToken, nil, http.StatusNoContent, nil)
+	do(http.MethodDelete, "/api/wallet/rules/"+r.ID, teacherToken, nil, http.StatusNotFound, nil)
+}
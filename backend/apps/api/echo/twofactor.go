@@ -0,0 +1,291 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/twofactor"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const (
+	twoFactorVerify = "verify" // the User enters a code of their authenticator app, or a backup code
+	twoFactorEnroll = "enroll" // 2FA is required but not enabled yet: the User enrolls before logging in
+)
+
+var errTooManyAttempts = echo.NewHTTPError(http.StatusTooManyRequests, twofactor.ErrTooManyAttempts.Error())
+
+type twoFactorApi struct {
+	svc        twofactor.ServiceInterface
+	userSvc    user.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	sessionSvc session.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerTwoFactorAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc twofactor.ServiceInterface,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	sessionSvc session.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := twoFactorApi{
+		svc:        svc,
+		userSvc:    userSvc,
+		schoolSvc:  schoolSvc,
+		sessionSvc: sessionSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// 2nd step of the login: authed by the challenge token
+	lg := g.Group("/users/login/2fa")
+	lg.POST("", api.login)
+	lg.POST("/enroll", api.loginEnroll)
+
+	// own settings
+	sg := g.Group("/users/2fa", jwt)
+	sg.GET("", api.status)
+	sg.POST("/enroll", api.enroll)
+	sg.POST("/activate", api.activate)
+	sg.POST("/backup-codes", api.regenerateBackupCodes)
+	sg.DELETE("", api.disable)
+}
+
+// twoFactorStage returns the 2FA stage of the login of a User; empty when the password is enough.
+func twoFactorStage(usr user.User, svc twofactor.ServiceInterface) (string, error) {
+	enabled, err := svc.IsEnabled(usr.ID)
+	if err != nil {
+		return "", errors.Wrap(err, "checking 2fa")
+	}
+	if enabled {
+		return twoFactorVerify, nil
+	}
+	required, err := svc.Required(usr)
+	if err != nil {
+		return "", errors.Wrap(err, "checking 2fa policy")
+	}
+	if required {
+		return twoFactorEnroll, nil
+	}
+	return "", nil
+}
+
+// twoFactorError maps the errors of the twofactor.Service to HTTP errors.
+func twoFactorError(err error, msg string) error {
+	switch err {
+	case twofactor.ErrInvalidCode:
+		return core.NewValidationError(nil, core.FieldError{Field: "code", Error: err.Error()})
+	case twofactor.ErrNotEnrolled, twofactor.ErrNotEnabled, twofactor.ErrAlreadyEnabled:
+		return core.NewValidationError(err)
+	case twofactor.ErrTooManyAttempts:
+		return errTooManyAttempts
+	case twofactor.ErrRequired:
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	return errors.Wrap(err, msg)
+}
+
+// Handlers
+
+// challengeUser returns the User of a challenge token; still allowed to log in.
+func (api *twoFactorApi) challengeUser(token string) (user.User, error) {
+	userID, err := parseChallengeToken(token, challengeAudience)
+	if err != nil {
+		return user.User{}, err
+	}
+	usr, err := api.userSvc.GetByID(userID)
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return user.User{}, errChallengeInvalid
+		}
+		return user.User{}, errors.Wrap(err, "finding user by ID")
+	}
+	if err = checkUserAvailable(usr, api.schoolSvc); err != nil {
+		return user.User{}, err
+	}
+	return usr, nil
+}
+
+// login completes a two-factor login with a code; enrolling Users activate 2FA & get their backup codes.
+func (api *twoFactorApi) login(ctx echo.Context) error {
+	var data TwoFactorLoginRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to TwoFactorLoginRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	usr, err := api.challengeUser(data.ChallengeToken)
+	if err != nil {
+		return err
+	}
+	stage, err := twoFactorStage(usr, api.svc)
+	if err != nil {
+		return err
+	}
+
+	var backupCodes []string
+	switch stage {
+	case twoFactorVerify:
+		err = api.svc.Verify(usr.ID, data.Code)
+	case twoFactorEnroll:
+		backupCodes, err = api.svc.Activate(usr.ID, data.Code)
+	default: // 2FA was disabled meanwhile
+		err = twofactor.ErrNotEnabled
+	}
+	if err != nil {
+		return twoFactorError(err, "verifying code")
+	}
+
+	resp, err := issueTokens(usr, api.userSvc, api.sessionSvc)
+	if err != nil {
+		return errors.Wrap(err, "issuing tokens")
+	}
+	resp.BackupCodes = backupCodes
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// loginEnroll enrolls a User required to use 2FA but who didn't enable it yet.
+func (api *twoFactorApi) loginEnroll(ctx echo.Context) error {
+	var data ChallengeRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to ChallengeRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	usr, err := api.challengeUser(data.ChallengeToken)
+	if err != nil {
+		return err
+	}
+	prov, err := api.svc.Enroll(usr)
+	if err != nil {
+		return twoFactorError(err, "enrolling")
+	}
+	return ctx.JSON(http.StatusOK, prov)
+}
+
+func (api *twoFactorApi) status(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	st, err := api.svc.Status(usr)
+	if err != nil {
+		return errors.Wrap(err, "getting 2fa status")
+	}
+	return ctx.JSON(http.StatusOK, st)
+}
+
+func (api *twoFactorApi) enroll(ctx echo.Context) error {
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	prov, err := api.svc.Enroll(usr)
+	if err != nil {
+		return twoFactorError(err, "enrolling")
+	}
+	return ctx.JSON(http.StatusOK, prov)
+}
+
+func (api *twoFactorApi) bindCode(ctx echo.Context) (TwoFactorCodeRequest, error) {
+	var data TwoFactorCodeRequest
+	if err := ctx.Bind(&data); err != nil {
+		return data, errors.Wrap(err, "binding to TwoFactorCodeRequest")
+	}
+	return data, api.validate.Struct(data)
+}
+
+func (api *twoFactorApi) activate(ctx echo.Context) error {
+	data, err := api.bindCode(ctx)
+	if err != nil {
+		return err
+	}
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	codes, err := api.svc.Activate(usr.ID, data.Code)
+	if err != nil {
+		return twoFactorError(err, "activating 2fa")
+	}
+	return ctx.JSON(http.StatusOK, BackupCodesResponse{BackupCodes: codes})
+}
+
+func (api *twoFactorApi) regenerateBackupCodes(ctx echo.Context) error {
+	data, err := api.bindCode(ctx)
+	if err != nil {
+		return err
+	}
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	codes, err := api.svc.RegenerateBackupCodes(usr.ID, data.Code)
+	if err != nil {
+		return twoFactorError(err, "regenerating backup codes")
+	}
+	return ctx.JSON(http.StatusOK, BackupCodesResponse{BackupCodes: codes})
+}
+
+// disable disables 2FA after verifying a code; unless a School requires it.
+func (api *twoFactorApi) disable(ctx echo.Context) error {
+	data, err := api.bindCode(ctx)
+	if err != nil {
+		return err
+	}
+	usr, err := getContextUser(ctx, api.userSvc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+
+	required, err := api.svc.Required(usr)
+	if err != nil {
+		return errors.Wrap(err, "checking 2fa policy")
+	}
+	if required {
+		return twoFactorError(twofactor.ErrRequired, "")
+	}
+	if err = api.svc.Verify(usr.ID, data.Code); err != nil {
+		return twoFactorError(err, "verifying code")
+	}
+	if err = api.svc.Disable(usr.ID); err != nil {
+		return errors.Wrap(err, "disabling 2fa")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+type (
+	TwoFactorLoginRequest struct {
+		ChallengeToken string `json:"challenge_token" validate:"required"`
+		Code           string `json:"code" validate:"required"` // TOTP or backup code
+	}
+
+	ChallengeRequest struct {
+		ChallengeToken string `json:"challenge_token" validate:"required"`
+	}
+
+	TwoFactorCodeRequest struct {
+		Code string `json:"code" validate:"required"` // TOTP or backup code
+	}
+
+	BackupCodesResponse struct {
+		BackupCodes []string `json:"backup_codes"` // shown only once
+	}
+)
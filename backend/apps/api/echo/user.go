@@ -2,7 +2,6 @@ package echoapi
 
 import (
 	"net/http"
-	"sort"
 
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
@@ -10,6 +9,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
 )
 
@@ -19,22 +22,31 @@ var (
 )
 
 type userApi struct {
-	svc        user.ServiceInterface
-	validate   *validator.Validate
-	translator ut.Translator
+	svc          user.ServiceInterface
+	schoolSvc    school.ServiceInterface
+	sessionSvc   session.ServiceInterface
+	twoFactorSvc twofactor.ServiceInterface
+	validate     *validator.Validate
+	translator   ut.Translator
 }
 
 func registerUserAPI(
 	g *echo.Group,
 	jwt echo.MiddlewareFunc,
 	svc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	sessionSvc session.ServiceInterface,
+	twoFactorSvc twofactor.ServiceInterface,
 	validate *validator.Validate,
 	translator ut.Translator,
 ) {
 	api := userApi{
-		svc:        svc,
-		validate:   validate,
-		translator: translator,
+		svc:          svc,
+		schoolSvc:    schoolSvc,
+		sessionSvc:   sessionSvc,
+		twoFactorSvc: twoFactorSvc,
+		validate:     validate,
+		translator:   translator,
 	}
 
 	ug := g.Group("/users")
@@ -44,22 +56,26 @@ func registerUserAPI(
 	// TODO: no concurrent sessions
 	// TODO: rate limit `/password-reset` & `/password-reset-confirm`
 	ug.POST("/login", api.login)
+	ug.POST("/login/password", api.changeTemporaryPassword)
+	ug.POST("/token-refresh", api.refreshToken)
+	ug.POST("/logout", api.logout)
 	ug.POST("/password-reset", api.resetPassword)
 	ug.POST("/password-reset-confirm", api.confirmPasswordReset)
+	ug.POST("/signup", api.signup)
 
 	// authed endpoints
 	ag := ug.Group("", jwt)
-	ag.POST("/token-refresh", api.refreshToken)
-	ag.POST("/register", api.create, adminMiddleware())
-	ag.GET("", api.query, adminMiddleware())
-	ag.DELETE("", api.destroyMultiple, adminMiddleware())
-	ag.GET("/roles", api.queryRoles, adminMiddleware())
+	ag.POST("/register", api.create, requirePermission(rbac.PermUsersCreate))
+	ag.GET("", api.query, requirePermission(rbac.PermUsersRead))
+	ag.DELETE("", api.destroyMultiple, requirePermission(rbac.PermUsersDelete))
+	ag.GET("/roles", api.queryRoles, requirePermission(rbac.PermUsersRead))
+	ag.POST("/:id/restore", api.restore, requirePermission(rbac.PermUsersDelete)) // soft-deleted: not found by the detail endpoints
 
 	// detail endpoints
 	dg := ag.Group("/:id", ctxUserOrAdminMiddleware(api.svc))
 	dg.GET("", api.retrieve)
 	dg.PUT("", api.update)
-	dg.DELETE("", api.destroy, adminMiddleware())
+	dg.DELETE("", api.destroy, requirePermission(rbac.PermUsersDelete))
 }
 
 // Handlers
@@ -78,11 +94,11 @@ func (api *userApi) create(ctx echo.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "getting context user")
 	}
-	if user.MaxRolePriority(data.Roles) > user.MaxRolePriority(ctxUsr.Roles) {
+	if !rbac.CanGrant(ctxUsr, data.Roles) {
 		return core.NewValidationError(nil, core.FieldError{Field: "roles", Error: errNoPermsToSetRoles})
 	}
 
-	usr, err := api.svc.Create(data)
+	usr, err := api.svc.Create(data, auditActor(ctx, ctxUsr))
 	if err != nil {
 		return errors.Wrap(err, "creating user")
 	}
@@ -99,19 +115,58 @@ func (api *userApi) login(ctx echo.Context) error {
 		return err
 	}
 
-	claims, err := authenticate(data.Username, data.Password, api.svc)
+	usr, err := authenticate(data.Username, data.Password, api.svc, api.schoolSvc)
+	if err == errPasswordChangeRequired {
+		challenge, err := makeChallengeToken(usr, passwordChangeAudience)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(http.StatusOK, LoginResponse{PasswordChange: true, ChallengeToken: challenge})
+	}
 	if err != nil {
 		if errors.Cause(err) == user.ErrNotFound {
 			return core.NewValidationError(errors.New("invalid credentials"))
 		}
 		return errors.Wrap(err, "authenticating")
 	}
-	token, err := GenerateToken(claims)
+
+	resp, err := completeLogin(usr, api.svc, api.twoFactorSvc, api.sessionSvc)
 	if err != nil {
-		return errors.Wrap(err, "generating token")
+		return err
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// changeTemporaryPassword replaces the temporary password of a User logging in, then completes their login.
+func (api *userApi) changeTemporaryPassword(ctx echo.Context) error {
+	var data PasswordChangeLoginRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to PasswordChangeLoginRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, LoginResponse{Token: token})
+	userID, err := parseChallengeToken(data.ChallengeToken, passwordChangeAudience)
+	if err != nil {
+		return err
+	}
+	usr, err := api.svc.ChangeTemporaryPassword(userID, data.NewPassword)
+	if err != nil {
+		if cause := errors.Cause(err); cause == user.ErrNotFound || cause == user.ErrNoTempPwd {
+			return errChallengeInvalid
+		}
+		return errors.Wrap(err, "changing temporary password")
+	}
+	if err = checkUserAvailable(usr, api.schoolSvc); err != nil {
+		return err
+	}
+
+	resp, err := completeLogin(usr, api.svc, api.twoFactorSvc, api.sessionSvc)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, resp)
 }
 
 func (api *userApi) resetPassword(ctx echo.Context) error {
@@ -123,6 +178,17 @@ func (api *userApi) resetPassword(ctx echo.Context) error {
 		return err
 	}
 
+	if data.Email == "" {
+		if err := api.svc.RequestPasswordResetSMS(data.Phone); !(err == nil || errors.Cause(err) == user.ErrNotFound) {
+			// do not return errors to attackers
+			ctx.Logger().Errorf("%+v", errors.Wrap(err, "requesting password reset sms"))
+		}
+		return ctx.JSON(http.StatusOK, SuccessResponse{
+			Success: "If the phone number supplied is associated with an active account on this system, " +
+				"a text message will arrive shortly with instructions to reset your password.",
+		})
+	}
+
 	if err := api.svc.RequestPasswordReset(data.Email); !(err == nil || errors.Cause(err) == user.ErrNotFound) {
 		// do not return errors to attackers
 		ctx.Logger().Errorf("%+v", errors.Wrap(err, "requesting password reset"))
@@ -148,6 +214,23 @@ func (api *userApi) confirmPasswordReset(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, SuccessResponse{Success: "Password has been reset with the new password."})
 }
 
+// signup sets the password of an invited User, who can log in afterwards.
+func (api *userApi) signup(ctx echo.Context) error {
+	var data user.ResetUserPassword
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to ResetUserPassword")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	usr, err := api.svc.AcceptInvite(data)
+	if err != nil {
+		return errors.Wrap(err, "accepting invitation")
+	}
+	return ctx.JSON(http.StatusOK, usr)
+}
+
 func (api *userApi) query(ctx echo.Context) error {
 	filter := new(user.QueryFilter)
 	if err := ctx.Bind(filter); err != nil {
@@ -182,7 +265,7 @@ func (api *userApi) update(ctx echo.Context) error {
 	}
 
 	var data user.UpdateUser
-	if err := ctx.Bind(data); err != nil {
+	if err := ctx.Bind(&data); err != nil {
 		return errors.Wrap(err, "binding to UpdateUser")
 	}
 
@@ -190,24 +273,36 @@ func (api *userApi) update(ctx echo.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "getting context user")
 	}
-	if !ctxUsr.IsAdmin() {
-		// `IsActive` and `Roles` can only be changed by admin
-		// `Username` and `Email` can only be changed by admin for now
-		if data.IsActive != nil || data.Roles != nil || data.Username != "" || data.Email != "" {
+	canUpdate, err := contextHasPermissions(ctx, rbac.PermUsersUpdate)
+	if err != nil {
+		return err
+	}
+	if !canUpdate {
+		// other Users can only be updated with `users.update`
+		if usr.ID != ctxUsr.ID {
+			return errHttpForbidden
+		}
+		// `IsActive` and `Roles` can only be changed with `users.update`
+		// `Username` and `Email` can only be changed with `users.update`; Users change their email at `/users/me`
+		// so does `Password`: Users change theirs at `/users/me`, with their current password
+		if data.IsActive != nil || data.Roles != nil || data.Username != "" || data.Email != "" || data.Password != "" {
 			return errHttpForbidden
 		}
 	}
+	if err = rbac.CheckHierarchy(ctxUsr, rbac.ActionUpdate, usr); err != nil {
+		return errHttpForbidden
+	}
 
 	if err := data.Validate(usr, api.validate, api.svc); err != nil {
 		return err
 	}
 
 	// ctxUser cannot set a role > their own max role
-	if user.MaxRolePriority(data.Roles) > user.MaxRolePriority(ctxUsr.Roles) {
+	if !rbac.CanGrant(ctxUsr, data.Roles) {
 		return core.NewValidationError(nil, core.FieldError{Field: "roles", Error: errNoPermsToSetRoles})
 	}
 
-	usr, err = api.svc.Update(usr.ID, data)
+	usr, err = api.svc.Update(usr.ID, data, auditActor(ctx, ctxUsr))
 	if err != nil {
 		return errors.Wrap(errUsrNotFoundInCtx, "updating user")
 	}
@@ -221,23 +316,49 @@ func (api *userApi) destroy(ctx echo.Context) error {
 		return errors.Wrap(errUsrNotFoundInCtx, "retrieving object from context")
 	}
 
-	// Say No to Suicide! ctxUser cannot delete themselves
+	// Say No to Suicide! ctxUser cannot delete themselves, nor a User with a max role > theirs
 	ctxUsr, err := getContextUser(ctx, api.svc)
 	if err != nil {
 		return errors.Wrap(err, "getting context user")
 	}
-	if usr.ID == ctxUsr.ID {
+	if err = rbac.CheckHierarchy(ctxUsr, rbac.ActionDelete, usr); err != nil {
 		return errHttpForbidden
 	}
 
-	// TODO: ctxUser cannot delete a User with a max role > theirs
-
-	if err := api.svc.Delete(usr.ID); err != nil {
+	if err := api.svc.Delete(auditActor(ctx, ctxUsr), usr.ID); err != nil {
 		return errors.Wrap(errUsrNotFoundInCtx, "deleting user")
 	}
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// restore restores a soft-deleted User, until their data is erased.
+func (api *userApi) restore(ctx echo.Context) error {
+	ctxUsr, err := getContextUser(ctx, api.svc)
+	if err != nil {
+		return errors.Wrap(err, "getting context user")
+	}
+	usr, err := api.svc.GetDeletedByID(ctx.Param("id"))
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding deleted user by ID")
+	}
+	// ctxUser cannot restore a User with a max role > theirs, like they cannot update them
+	if err = rbac.CheckHierarchy(ctxUsr, rbac.ActionUpdate, usr); err != nil {
+		return errHttpForbidden
+	}
+
+	usr, err = api.svc.Restore(usr.ID, auditActor(ctx, ctxUsr))
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "restoring user")
+	}
+	return ctx.JSON(http.StatusOK, usr)
+}
+
 func (api *userApi) destroyMultiple(ctx echo.Context) error {
 	var query DestroyMultipleRequest
 	if err := ctx.Bind(&query); err != nil {
@@ -247,21 +368,27 @@ func (api *userApi) destroyMultiple(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusNoContent)
 	}
 
-	// Say No to Suicide! ctxUser cannot delete themselves
+	// Say No to Suicide! ctxUser cannot delete themselves, nor a User with a max role > theirs
 	ctxUsr, err := getContextUser(ctx, api.svc)
 	if err != nil {
 		return errors.Wrap(err, "getting context user")
 	}
-	sort.Strings(query.IDs)
-	if i := sort.SearchStrings(query.IDs, ctxUsr.ID); i < len(query.IDs) {
-		if match := query.IDs[i]; ctxUsr.ID == match {
-			return errHttpForbidden
+	targets := make([]user.User, 0, len(query.IDs))
+	for _, id := range query.IDs {
+		usr, err := api.svc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue
+			}
+			return errors.Wrap(err, "finding user by ID")
 		}
+		targets = append(targets, usr)
+	}
+	if err = rbac.CheckHierarchy(ctxUsr, rbac.ActionDelete, targets...); err != nil {
+		return errHttpForbidden
 	}
 
-	// TODO: ctxUser cannot delete a User with a max role > theirs
-
-	if err := api.svc.Delete(query.IDs...); err != nil {
+	if err := api.svc.Delete(auditActor(ctx, ctxUsr), query.IDs...); err != nil {
 		return errors.Wrap(errUsrNotFoundInCtx, "deleting users")
 	}
 	return ctx.NoContent(http.StatusNoContent)
@@ -272,11 +399,35 @@ func (api *userApi) queryRoles(ctx echo.Context) error {
 }
 
 func (api *userApi) refreshToken(ctx echo.Context) error {
-	token, err := refreshToken(ctx, api.svc)
+	var data RefreshRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to RefreshRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	resp, err := refreshToken(data.RefreshToken, api.sessionSvc, api.svc, api.schoolSvc)
 	if err != nil {
 		return errors.Wrap(err, "refreshing token")
 	}
-	return ctx.JSON(http.StatusOK, LoginResponse{Token: token})
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// logout revokes the session of a refresh token; access tokens are left to expire.
+func (api *userApi) logout(ctx echo.Context) error {
+	var data RefreshRequest
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to RefreshRequest")
+	}
+	if err := api.validate.Struct(data); err != nil {
+		return err
+	}
+
+	if err := api.sessionSvc.RevokeFamily(data.RefreshToken); err != nil && err != session.ErrTokenInvalid {
+		return errors.Wrap(err, "revoking session")
+	}
+	return ctx.NoContent(http.StatusNoContent)
 }
 
 func ctxUserOrAdminMiddleware(svc user.ServiceInterface) echo.MiddlewareFunc {
@@ -287,7 +438,11 @@ func ctxUserOrAdminMiddleware(svc user.ServiceInterface) echo.MiddlewareFunc {
 				return errors.Wrap(err, "getting context user")
 			}
 
-			if ctx.Param("id") == ctxUsr.ID || ctxUsr.IsAdmin() {
+			canRead, err := contextHasPermissions(ctx, rbac.PermUsersRead)
+			if err != nil {
+				return err
+			}
+			if ctx.Param("id") == ctxUsr.ID || canRead {
 				if usr, err := svc.GetByID(ctx.Param("id")); err == nil {
 					ctx.Set("object", usr)
 					return next(ctx)
@@ -306,12 +461,31 @@ type (
 		Password string `json:"password" validate:"required"`
 	}
 
+	// LoginResponse either completes a login with tokens,
+	// or requires the 2nd factor of the User, sent with the ChallengeToken to `/users/login/2fa`,
+	// or requires a new password replacing the temporary one, sent with the ChallengeToken to `/users/login/password`.
 	LoginResponse struct {
-		Token string `json:"token"`
+		Token          string   `json:"token,omitempty"`
+		RefreshToken   string   `json:"refresh_token,omitempty"`
+		TwoFactor      string   `json:"two_factor,omitempty"` // verify | enroll
+		PasswordChange bool     `json:"password_change,omitempty"`
+		ChallengeToken string   `json:"challenge_token,omitempty"`
+		BackupCodes    []string `json:"backup_codes,omitempty"` // when enrolled at login; shown only once
+	}
+
+	PasswordChangeLoginRequest struct {
+		ChallengeToken string `json:"challenge_token" validate:"required"`
+		user.NewPassword
+	}
+
+	RefreshRequest struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
 	}
 
+	// PasswordResetRequest is sent by email, or by SMS when only the phone is provided.
 	PasswordResetRequest struct {
-		Email string `json:"email" validate:"required,email"`
+		Email string `json:"email" validate:"required_without=Phone,omitempty,email"`
+		Phone string `json:"phone,omitempty" validate:"omitempty,e164"`
 	}
 
 	SuccessResponse struct {
@@ -330,5 +504,6 @@ func (lr *LoginRequest) Validate(validate *validator.Validate) error {
 
 func (pr *PasswordResetRequest) Validate(validate *validator.Validate) error {
 	pr.Email = core.CleanString(pr.Email, true /* lower */)
+	pr.Phone = core.CleanString(pr.Phone)
 	return validate.Struct(pr)
 }
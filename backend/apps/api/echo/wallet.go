@@ -0,0 +1,287 @@
+package echoapi
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/wallet"
+)
+
+type walletApi struct {
+	svc        wallet.ServiceInterface
+	schoolSvc  school.ServiceInterface
+	validate   *validator.Validate
+	translator ut.Translator
+}
+
+func registerWalletAPI(
+	g *echo.Group,
+	jwt echo.MiddlewareFunc,
+	svc wallet.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	validate *validator.Validate,
+	translator ut.Translator,
+) {
+	api := walletApi{
+		svc:        svc,
+		schoolSvc:  schoolSvc,
+		validate:   validate,
+		translator: translator,
+	}
+
+	// courses
+	memberMw, managerMw := courseRouteMiddleware(jwt, api.schoolSvc)
+	g.GET("/courses/:id/wallet", api.courseWallet, memberMw...)
+	g.POST("/courses/:id/wallet/budget", api.allocate, jwt, courseMiddleware(api.schoolSvc), requirePermission(rbac.PermWalletAllocate))
+	g.POST("/courses/:id/wallet/rules", api.createRule, managerMw...)
+	g.DELETE("/wallet/rules/:id", api.destroyRule, jwt)
+
+	// leaderboards & awards
+	g.GET("/classes/:id/wallet/leaderboard", api.classLeaderboard, jwt, classMiddleware(api.schoolSvc))
+	schMw := schoolMiddleware(api.schoolSvc)
+	g.GET("/schools/:id/wallet/leaderboard", api.schoolLeaderboard, jwt, schMw, requirePermission(rbac.PermWalletRead))
+	g.GET("/schools/:id/wallet/awards", api.awards, jwt, schMw, requirePermission(rbac.PermWalletRead))
+
+	// context user
+	g.GET("/me/wallet", api.userWallet, jwt)
+}
+
+// Handlers
+
+// courseWallet returns the budget and the Rules of the Course.
+func (api *walletApi) courseWallet(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+	b, err := api.svc.Budget(crs)
+	if err != nil {
+		return errors.Wrap(err, "computing budget")
+	}
+	rules, err := api.svc.QueryRules(crs)
+	if err != nil {
+		return errors.Wrap(err, "querying rules")
+	}
+	return ctx.JSON(http.StatusOK, CourseWalletResponse{Budget: b, Rules: rules})
+}
+
+// allocate moves points from the School to the budget of the Course; admins only.
+func (api *walletApi) allocate(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data wallet.Allocation
+	if err = ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to Allocation")
+	}
+	if err = data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	b, err := api.svc.Allocate(crs, claims.Subject, data)
+	if err != nil {
+		return errors.Wrap(err, "allocating points")
+	}
+	return ctx.JSON(http.StatusOK, b)
+}
+
+func (api *walletApi) createRule(ctx echo.Context) error {
+	crs, ok := ctx.Get(contextCourseKey).(school.Course)
+	if !ok {
+		return errors.Wrap(errCrsNotFoundInCtx, "retrieving course from context")
+	}
+
+	var data wallet.NewRule
+	if err := ctx.Bind(&data); err != nil {
+		return errors.Wrap(err, "binding to NewRule")
+	}
+	if err := data.Validate(api.validate); err != nil {
+		return err
+	}
+
+	r, err := api.svc.CreateRule(crs, data)
+	if err != nil {
+		return errors.Wrap(err, "creating rule")
+	}
+	return ctx.JSON(http.StatusCreated, r)
+}
+
+// destroyRule deletes a Rule; only admins and the Teacher of its Course can.
+func (api *walletApi) destroyRule(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	r, err := api.svc.GetRule(ctx.Param("id"))
+	if err != nil {
+		if errors.Cause(err) == wallet.ErrRuleNotFound {
+			return errHttpNotFound
+		}
+		return errors.Wrap(err, "finding rule by ID")
+	}
+	crs, err := api.schoolSvc.GetCourse(r.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "finding course by ID")
+	}
+	if !canManageCourse(claims, crs) {
+		return errHttpNotFound
+	}
+
+	if err = api.svc.DeleteRule(r.ID); err != nil {
+		return errors.Wrap(err, "deleting rule")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// classLeaderboard ranks the Students of the Class for its year;
+// open to admins, the Teachers of the Class and its Students.
+func (api *walletApi) classLeaderboard(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+	cls, ok := ctx.Get("object").(school.Class)
+	if !ok {
+		return errors.Wrap(errClsNotFoundInCtx, "retrieving object from context")
+	}
+
+	var allowed bool
+	switch {
+	case claims.IsAdmin:
+		allowed = true
+	case claims.IsTeacher:
+		courses, err := api.schoolSvc.QueryCourses(school.CourseFilter{ClassID: cls.ID, TeacherID: claims.Subject})
+		if err != nil {
+			return errors.Wrap(err, "querying courses")
+		}
+		allowed = len(courses) > 0
+	}
+	if !allowed && claims.IsStudent {
+		ids, err := api.schoolSvc.StudentIDs(cls.ID)
+		if err != nil {
+			return errors.Wrap(err, "querying class students")
+		}
+		for _, id := range ids {
+			if id == claims.Subject {
+				allowed = true
+				break
+			}
+		}
+	}
+	if !allowed {
+		return errHttpNotFound
+	}
+
+	var query LimitQuery
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to LimitQuery")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	standings, err := api.svc.Leaderboard(wallet.LeaderboardFilter{
+		SchoolID: cls.SchoolID,
+		Year:     cls.Year,
+		ClassID:  cls.ID,
+		Limit:    query.Limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "building leaderboard")
+	}
+	return ctx.JSON(http.StatusOK, standings)
+}
+
+// schoolLeaderboard ranks the Students of the School for a year, optionally by year level or department.
+func (api *walletApi) schoolLeaderboard(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var f wallet.LeaderboardFilter
+	if err := ctx.Bind(&f); err != nil {
+		return errors.Wrap(err, "binding to LeaderboardFilter")
+	}
+	if err := api.validate.Struct(f); err != nil {
+		return err
+	}
+	f.SchoolID = sch.ID
+
+	standings, err := api.svc.Leaderboard(f)
+	if err != nil {
+		return errors.Wrap(err, "building leaderboard")
+	}
+	return ctx.JSON(http.StatusOK, standings)
+}
+
+// awards returns the year-end Awards of the School, as computed by the `walletawards` admin command.
+func (api *walletApi) awards(ctx echo.Context) error {
+	sch, ok := ctx.Get("object").(school.School)
+	if !ok {
+		return errors.Wrap(errSchNotFoundInCtx, "retrieving object from context")
+	}
+
+	var query YearQuery
+	if err := ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to YearQuery")
+	}
+	if err := api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	awards, err := api.svc.QueryAwards(sch.ID, query.Year)
+	if err != nil {
+		return errors.Wrap(err, "querying awards")
+	}
+	return ctx.JSON(http.StatusOK, awards)
+}
+
+// userWallet returns the Account of the context user for an academic year, with its Transactions.
+func (api *walletApi) userWallet(ctx echo.Context) error {
+	claims, err := getContextClaims(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting context claims")
+	}
+
+	var query YearQuery
+	if err = ctx.Bind(&query); err != nil {
+		return errors.Wrap(err, "binding to YearQuery")
+	}
+	if err = api.validate.Struct(query); err != nil {
+		return err
+	}
+
+	w, err := api.svc.Wallet(claims.Subject, query.Year)
+	if err != nil {
+		return errors.Wrap(err, "finding wallet")
+	}
+	return ctx.JSON(http.StatusOK, w)
+}
+
+type (
+	CourseWalletResponse struct {
+		Budget wallet.Budget `json:"budget"`
+		Rules  []wallet.Rule `json:"rules"`
+	}
+
+	LimitQuery struct {
+		Limit int `query:"limit" validate:"min=0"`
+	}
+
+	YearQuery struct {
+		Year int `query:"year" validate:"required,min=2000"`
+	}
+)
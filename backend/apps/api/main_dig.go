@@ -13,6 +13,8 @@ import (
 	dig_container "github.com/trezcool/masomo/apps/api/di/dig"
 	echoapi "github.com/trezcool/masomo/apps/api/echo"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/coursework"
 	"github.com/trezcool/masomo/core/user"
 )
 
@@ -27,6 +29,7 @@ func startWithDig() {
 		validate *validator.Validate,
 		translator ut.Translator,
 		server *echoapi.Server,
+		scheduler *announcement.Scheduler,
 	) {
 		// =========================================================================
 		// Initialize App
@@ -35,8 +38,10 @@ func startWithDig() {
 
 		core.InitValidators(validate, translator)
 		user.InitValidators(validate, translator)
+		coursework.InitValidators(validate, translator)
 
 		core.ParseEmailTemplates(apiLogger)
+		core.ParseDocumentTemplates(apiLogger)
 
 		user.LoadCommonPasswords(apiLogger)
 
@@ -71,6 +76,17 @@ func startWithDig() {
 			server.Start()
 		}()
 
+		// =========================================================================
+		// Start Background Jobs
+
+		jobsCtx, stopJobs := context.WithCancel(context.Background())
+		defer stopJobs()
+
+		// run by the sidecar otherwise
+		if !conf.SidecarJobs {
+			go scheduler.Run(jobsCtx)
+		}
+
 		// =========================================================================
 		// Shutdown
 
@@ -14,9 +14,37 @@ import (
 	"github.com/go-playground/validator/v10"
 	echoapi "github.com/trezcool/masomo/apps/api/echo"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/credential"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/core/reportcard"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/twofactor"
 	"github.com/trezcool/masomo/core/user"
+	"github.com/trezcool/masomo/core/wallet"
 	emailsvc "github.com/trezcool/masomo/services/email"
 	logsvc "github.com/trezcool/masomo/services/logger"
+	mediasvc "github.com/trezcool/masomo/services/media"
+	pdfsvc "github.com/trezcool/masomo/services/pdf"
+	"github.com/trezcool/masomo/services/pubsub"
+	pushsvc "github.com/trezcool/masomo/services/push"
+	smssvc "github.com/trezcool/masomo/services/sms"
 	"github.com/trezcool/masomo/storage/database"
 	boiledrepos "github.com/trezcool/masomo/storage/database/sqlboiler"
 )
@@ -52,13 +80,56 @@ func startManual() {
 	}()
 
 	// set up services
+	jobSvc := job.NewService(boiledrepos.NewJobRepository(db))
 	var mailSvc core.EmailService
 	if conf.Debug {
 		mailSvc = emailsvc.NewConsoleService(conf)
 	} else {
-		mailSvc = emailsvc.NewSendgridService(conf, logger)
+		mailSvc = emailsvc.NewSendgridService(conf, logger, jobSvc)
 	}
-	usrSvc := user.NewService(db, boiledrepos.NewUserRepository(db), mailSvc, conf)
+	var smsGateway core.SMSService
+	if conf.SMSAccountSID == "" {
+		smsGateway = smssvc.NewConsoleService(conf)
+	} else {
+		smsGateway = smssvc.NewGatewayService(conf)
+	}
+	auditSvc := audit.NewService(conf, boiledrepos.NewAuditRepository(db))
+	sessionSvc := session.NewService(db, boiledrepos.NewSessionRepository(db), conf)
+	schSvc := school.NewService(db, boiledrepos.NewSchoolRepository(db))
+	usrSvc := user.NewService(db, boiledrepos.NewUserRepository(db), mailSvc, smsGateway, auditSvc, sessionSvc, schSvc, conf)
+	smsSvc := sms.NewService(boiledrepos.NewSMSRepository(db), schSvc, smsGateway, logger)
+	cwSvc := coursework.NewService(db, boiledrepos.NewCourseworkRepository(db))
+	gradeSvc := gradebook.NewService(db, boiledrepos.NewGradebookRepository(db), schSvc, cwSvc, usrSvc)
+	media := mediasvc.NewFileSystemStorage(conf)
+	cardSvc := reportcard.NewService(conf, gradeSvc, schSvc, usrSvc, media, pdfsvc.NewHTMLRenderer(), mailSvc)
+	attSvc := attendance.NewService(conf, db, boiledrepos.NewAttendanceRepository(db), schSvc, usrSvc, mailSvc, smsSvc, logger)
+	var ps core.PubSub
+	if conf.Debug {
+		ps = pubsub.NewMemoryPubSub()
+	} else {
+		ps = pubsub.NewPostgresPubSub(database.DataSourceName(conf), db, logger)
+	}
+	defer func() { _ = ps.Close() }()
+	var pushSvc core.PushService
+	if conf.VAPIDPrivateKey == "" {
+		pushSvc = pushsvc.NewConsoleService()
+	} else {
+		pushSvc = pushsvc.NewWebPushService(conf)
+	}
+	notifSvc := notification.NewService(conf, db, boiledrepos.NewNotificationRepository(db), usrSvc, mailSvc, pushSvc, ps, logger)
+	annSvc := announcement.NewService(conf, db, boiledrepos.NewAnnouncementRepository(db), schSvc, usrSvc, media, mailSvc, notifSvc, smsSvc)
+	chatSvc := chat.NewService(db, boiledrepos.NewChatRepository(db), schSvc, ps, logger)
+	calSvc := calendar.NewService(db, boiledrepos.NewCalendarRepository(db), schSvc, cwSvc, usrSvc)
+	walletSvc := wallet.NewService(db, boiledrepos.NewWalletRepository(db), schSvc, cwSvc, gradeSvc, logger)
+	rbacSvc := rbac.NewService(db, boiledrepos.NewRBACRepository(db), usrSvc, auditSvc)
+	guardSvc := guardian.NewService(boiledrepos.NewGuardianRepository(db), usrSvc, schSvc, gradeSvc, attSvc, annSvc, cwSvc)
+	keySvc := jwtkey.NewService(conf, db, boiledrepos.NewJWTKeyRepository(db))
+	tfSvc := twofactor.NewService(conf, db, boiledrepos.NewTwoFactorRepository(db), schSvc)
+	oidcSvc := oidc.NewService(conf, boiledrepos.NewOIDCRepository(db), usrSvc)
+	passwordlessSvc := passwordless.NewService(conf, boiledrepos.NewPasswordlessRepository(db), usrSvc, schSvc, mailSvc, smsGateway)
+	credentialSvc := credential.NewService(conf, usrSvc, schSvc, pdfsvc.NewHTMLRenderer())
+	avatarSvc := avatar.NewService(conf, usrSvc, media)
+	privacySvc := privacy.NewService(conf, db, boiledrepos.NewPrivacyRepository(db), usrSvc, schSvc, guardSvc, notifSvc, avatarSvc)
 
 	// =========================================================================
 	// Initialize App
@@ -70,8 +141,10 @@ func startManual() {
 	translator := newTranslator()
 	core.InitValidators(validate, translator)
 	user.InitValidators(validate, translator)
+	coursework.InitValidators(validate, translator)
 
 	core.ParseEmailTemplates(logger)
+	core.ParseDocumentTemplates(logger)
 
 	user.LoadCommonPasswords(logger)
 
@@ -96,11 +169,34 @@ func startManual() {
 
 	server := echoapi.NewServer(
 		echoapi.ServerDeps{
-			Conf:       conf,
-			Logger:     logger,
-			UserSvc:    usrSvc,
-			Validate:   validate,
-			Translator: translator,
+			Conf:            conf,
+			Logger:          logger,
+			UserSvc:         usrSvc,
+			SchoolSvc:       schSvc,
+			CourseworkSvc:   cwSvc,
+			GradebookSvc:    gradeSvc,
+			ReportCardSvc:   cardSvc,
+			AttendanceSvc:   attSvc,
+			AnnouncementSvc: annSvc,
+			ChatSvc:         chatSvc,
+			NotificationSvc: notifSvc,
+			SMSSvc:          smsSvc,
+			CalendarSvc:     calSvc,
+			WalletSvc:       walletSvc,
+			RBACSvc:         rbacSvc,
+			GuardianSvc:     guardSvc,
+			SessionSvc:      sessionSvc,
+			JWTKeySvc:       keySvc,
+			TwoFactorSvc:    tfSvc,
+			OIDCSvc:         oidcSvc,
+			PasswordlessSvc: passwordlessSvc,
+			CredentialSvc:   credentialSvc,
+			AvatarSvc:       avatarSvc,
+			PrivacySvc:      privacySvc,
+			AuditSvc:        auditSvc,
+			Media:           media,
+			Validate:        validate,
+			Translator:      translator,
 		},
 	)
 
@@ -108,6 +204,17 @@ func startManual() {
 		server.Start()
 	}()
 
+	// =========================================================================
+	// Start Background Jobs
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// run by the sidecar otherwise
+	if !conf.SidecarJobs {
+		go announcement.NewScheduler(conf, annSvc, logger).Run(jobsCtx)
+	}
+
 	// =========================================================================
 	// Shutdown
 
@@ -8,6 +8,7 @@ import (
 
 	wire_container "github.com/trezcool/masomo/apps/api/di/wire"
 	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/coursework"
 	"github.com/trezcool/masomo/core/user"
 )
 
@@ -19,6 +20,7 @@ func startWithWire() {
 	validate := wire_container.NewValidate()
 	translator := wire_container.NewTranslator()
 	server := wire_container.NewServer()
+	scheduler := wire_container.NewScheduler()
 
 	// =========================================================================
 	// Initialize App
@@ -27,8 +29,10 @@ func startWithWire() {
 
 	core.InitValidators(validate, translator)
 	user.InitValidators(validate, translator)
+	coursework.InitValidators(validate, translator)
 
 	core.ParseEmailTemplates(apiLogger)
+	core.ParseDocumentTemplates(apiLogger)
 
 	user.LoadCommonPasswords(apiLogger)
 	defer func() {
@@ -61,6 +65,17 @@ func startWithWire() {
 		server.Start()
 	}()
 
+	// =========================================================================
+	// Start Background Jobs
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// run by the sidecar otherwise
+	if !conf.SidecarJobs {
+		go scheduler.Run(jobsCtx)
+	}
+
 	// =========================================================================
 	// Shutdown
 
@@ -1,5 +1,176 @@
 package main
 
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/subscription"
+	"github.com/trezcool/masomo/core/user"
+	emailsvc "github.com/trezcool/masomo/services/email"
+	logsvc "github.com/trezcool/masomo/services/logger"
+	mediasvc "github.com/trezcool/masomo/services/media"
+	"github.com/trezcool/masomo/services/pubsub"
+	pushsvc "github.com/trezcool/masomo/services/push"
+	smssvc "github.com/trezcool/masomo/services/sms"
+	"github.com/trezcool/masomo/storage/database"
+	boiledrepos "github.com/trezcool/masomo/storage/database/sqlboiler"
+)
+
+// The sidecar runs the background Jobs: set `sidecarJobs` for the API to stop running them itself.
+// Replicas can run side by side: each Job is run once.
 func main() {
+	// =========================================================================
+	// Set up Dependencies
+
+	conf := core.NewConfig()
+
+	// set up logger
+	logger := logsvc.NewRollbarLogger(
+		log.New(os.Stdout, "SIDECAR : ", log.LstdFlags|log.Lmicroseconds|log.Lshortfile),
+		conf,
+	)
+	logger.Enable(!conf.Debug)
+
+	// set up DB; migrated by the API
+	db, err := database.Open(conf)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("opening database: %v", err), err)
+	}
+	defer func() {
+		if err = db.Close(); err != nil {
+			logger.Fatal("Failed to close DB", err)
+		}
+	}()
+
+	// set up services
+	jobSvc := job.NewService(boiledrepos.NewJobRepository(db))
+	sendgrid := emailsvc.NewSendgridService(conf, logger, jobSvc)
+	var mailSvc core.EmailService = sendgrid
+	if conf.Debug {
+		mailSvc = emailsvc.NewConsoleService(conf)
+	}
+	var smsGateway core.SMSService
+	if conf.SMSAccountSID == "" {
+		smsGateway = smssvc.NewConsoleService(conf)
+	} else {
+		smsGateway = smssvc.NewGatewayService(conf)
+	}
+	auditSvc := audit.NewService(conf, boiledrepos.NewAuditRepository(db))
+	sessionSvc := session.NewService(db, boiledrepos.NewSessionRepository(db), conf)
+	schSvc := school.NewService(db, boiledrepos.NewSchoolRepository(db))
+	usrSvc := user.NewService(db, boiledrepos.NewUserRepository(db), mailSvc, smsGateway, auditSvc, sessionSvc, schSvc, conf)
+	smsSvc := sms.NewService(boiledrepos.NewSMSRepository(db), schSvc, smsGateway, logger)
+	media := mediasvc.NewFileSystemStorage(conf)
+	var ps core.PubSub
+	if conf.Debug {
+		ps = pubsub.NewMemoryPubSub()
+	} else {
+		ps = pubsub.NewPostgresPubSub(database.DataSourceName(conf), db, logger)
+	}
+	defer func() { _ = ps.Close() }()
+	var pushSvc core.PushService
+	if conf.VAPIDPrivateKey == "" {
+		pushSvc = pushsvc.NewConsoleService()
+	} else {
+		pushSvc = pushsvc.NewWebPushService(conf)
+	}
+	notifSvc := notification.NewService(conf, db, boiledrepos.NewNotificationRepository(db), usrSvc, mailSvc, pushSvc, ps, logger)
+	annSvc := announcement.NewService(conf, db, boiledrepos.NewAnnouncementRepository(db), schSvc, usrSvc, media, mailSvc, notifSvc, smsSvc)
+	subSvc := subscription.NewService(conf, db, boiledrepos.NewSubscriptionRepository(db), schSvc, mailSvc, logger)
+	cwSvc := coursework.NewService(db, boiledrepos.NewCourseworkRepository(db))
+	gradeSvc := gradebook.NewService(db, boiledrepos.NewGradebookRepository(db), schSvc, cwSvc, usrSvc)
+	attSvc := attendance.NewService(conf, db, boiledrepos.NewAttendanceRepository(db), schSvc, usrSvc, mailSvc, smsSvc, logger)
+	guardSvc := guardian.NewService(boiledrepos.NewGuardianRepository(db), usrSvc, schSvc, gradeSvc, attSvc, annSvc, cwSvc)
+	avatarSvc := avatar.NewService(conf, usrSvc, media)
+	privacySvc := privacy.NewService(conf, db, boiledrepos.NewPrivacyRepository(db), usrSvc, schSvc, guardSvc, notifSvc, avatarSvc)
+
+	// =========================================================================
+	// Initialize App
+
+	logger.Info(fmt.Sprintf("Sidecar initializing : version %q", conf.Build))
+	defer logger.Info("Sidecar stopped")
+
+	core.ParseEmailTemplates(logger)
+
+	// =========================================================================
+	// Register Jobs
+
+	runner := job.NewRunner(conf, jobSvc, logger)
+
+	schedules := []struct {
+		name, spec string
+		run        func(t time.Time) (int, error)
+		msg        string
+	}{
+		{core.JobPublishAnnouncements, "@every 1m", annSvc.PublishDue, "%d announcement(s) published"},
+		{core.JobPaymentWarning, "@hourly", subSvc.SendWarnings, "%d payment warning(s) sent"},
+		{core.JobDeactivateSchool, "@daily", subSvc.DeactivateExpired, "%d school(s) deactivated"},
+		{core.JobEraseUsers, "@daily", privacySvc.EraseDeleted, "%d user(s) erased"},
+		{core.JobPurgeAuditLog, "@daily", auditSvc.Purge, "%d audit entries purged"},
+	}
+	for _, s := range schedules {
+		s := s
+		err = runner.Schedule(s.name, s.spec, func(ctx context.Context, j job.Job) error {
+			n, err := s.run(time.Now())
+			if n > 0 {
+				logger.Info(fmt.Sprintf(s.msg, n))
+			}
+			return err
+		})
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("scheduling jobs: %v", err), err)
+		}
+	}
+
+	runner.Handle(core.JobSendEmail, func(ctx context.Context, j job.Job) error {
+		return sendgrid.Resend(j.Payload)
+	})
+
+	// =========================================================================
+	// Start Runner
+
+	go func() {
+		runner.Start()
+	}()
+
+	// =========================================================================
+	// Shutdown
+
+	select {
+	case err = <-runner.Errors():
+		logger.Fatal(fmt.Sprintf("runner error: %v", err), err)
+
+	case sig := <-runner.ShutdownSignal():
+		logger.Info(fmt.Sprintf("%v: Start shutdown...", sig))
+
+		// give running jobs a deadline for completion
+		ctx, cancel := context.WithTimeout(context.Background(), conf.Server.ShutdownTimeout)
+		defer cancel()
+
+		// asking workers to stop claiming jobs
+		if err = runner.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("could not stop runner gracefully: %v", err), err)
 
+			if err = runner.Close(); err != nil {
+				logger.Fatal(fmt.Sprintf("could not force stop runner: %v", err), err)
+			}
+		}
+	}
 }
@@ -0,0 +1,117 @@
+package announcement
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Audiences
+const (
+	AudienceSchool     = "school"     // Students and Teachers of all Classes of the School
+	AudienceDepartment = "department" // Students and Teachers of the Classes of the Department
+	AudienceClass      = "class"      // Students and Teachers of the Class
+)
+
+// Announcement is a message from admins to an audience, published at PublishAt until ExpiresAt.
+type Announcement struct {
+	ID           string       `json:"id"` // UUID
+	SchoolID     string       `json:"school_id"`
+	DepartmentID string       `json:"department_id,omitempty"`
+	ClassID      string       `json:"class_id,omitempty"`
+	AuthorID     string       `json:"author_id,omitempty"`
+	Title        string       `json:"title"`
+	Body         string       `json:"body"`
+	EmailDigest  bool         `json:"email_digest"` // also email it to recipients once published
+	SendSMS      bool         `json:"send_sms"`     // also text it to recipients with a phone once published
+	PublishAt    time.Time    `json:"publish_at"`   // UTC
+	ExpiresAt    time.Time    `json:"expires_at"`   // UTC; never expires when zero
+	PublishedAt  time.Time    `json:"published_at"` // UTC; zero until fanned out to recipients
+	Attachments  []Attachment `json:"attachments"`
+	CreatedAt    time.Time    `json:"created_at"` // UTC
+	UpdatedAt    time.Time    `json:"updated_at"` // UTC
+}
+
+func (a Announcement) Audience() string {
+	switch {
+	case a.ClassID != "":
+		return AudienceClass
+	case a.DepartmentID != "":
+		return AudienceDepartment
+	default:
+		return AudienceSchool
+	}
+}
+
+func (a Announcement) IsPublished() bool {
+	return !a.PublishedAt.IsZero()
+}
+
+// IsExpired reports whether an Announcement is expired at t.
+func (a Announcement) IsExpired(t time.Time) bool {
+	return !a.ExpiresAt.IsZero() && !t.Before(a.ExpiresAt)
+}
+
+type Attachment struct {
+	ID             string    `json:"id"` // UUID
+	AnnouncementID string    `json:"announcement_id"`
+	Name           string    `json:"-"` // media name
+	Filename       string    `json:"filename"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	URL            string    `json:"url,omitempty"` // signed media URL
+	CreatedAt      time.Time `json:"created_at"`    // UTC
+	UpdatedAt      time.Time `json:"updated_at"`    // UTC
+}
+
+// Delivery is an Announcement as received by a recipient.
+type Delivery struct {
+	Announcement
+	ReadAt time.Time `json:"read_at"` // UTC; zero when unread
+}
+
+// NewAnnouncement contains information needed to create a new Announcement.
+// It targets a Class if ClassID is set, else a Department if DepartmentID is set, else the whole School.
+type NewAnnouncement struct {
+	DepartmentID string    `json:"department_id" validate:"omitempty,uuid"`
+	ClassID      string    `json:"class_id" validate:"omitempty,uuid"`
+	Title        string    `json:"title" validate:"required,max=254"`
+	Body         string    `json:"body" validate:"required"`
+	EmailDigest  bool      `json:"email_digest"`
+	SendSMS      bool      `json:"send_sms"`   // within the SMS quota of the School
+	PublishAt    time.Time `json:"publish_at"` // published as soon as possible when zero
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func (na *NewAnnouncement) Validate(validate *validator.Validate) error {
+	na.Title = core.CleanString(na.Title)
+	na.Body = core.CleanString(na.Body)
+	if err := validate.Struct(na); err != nil {
+		return err
+	}
+	if na.ExpiresAt.IsZero() {
+		return nil
+	}
+	start := time.Now()
+	if na.PublishAt.After(start) {
+		start = na.PublishAt
+	}
+	if !na.ExpiresAt.After(start) {
+		return core.NewValidationError(nil, core.FieldError{Field: "expires_at", Error: "must be after the publish time"})
+	}
+	return nil
+}
+
+type Filter struct {
+	SchoolID string
+	// Due selects unpublished Announcements to publish at that time; ie. not expired yet
+	Due time.Time
+}
+
+type DeliveryFilter struct {
+	UserID     string
+	At         time.Time // only Announcements not expired at that time, when set
+	UnreadOnly bool
+}
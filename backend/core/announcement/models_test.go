@@ -0,0 +1,61 @@
+package announcement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestNewAnnouncement_Validate(t *testing.T) {
+	validate := validator.New()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		na      NewAnnouncement
+		wantErr bool
+	}{
+		{name: "no title", na: NewAnnouncement{Title: " ", Body: "body"}, wantErr: true},
+		{name: "invalid class", na: NewAnnouncement{Title: "title", Body: "body", ClassID: "6A"}, wantErr: true},
+		{name: "expired", na: NewAnnouncement{Title: "title", Body: "body", ExpiresAt: now.Add(-time.Hour)}, wantErr: true},
+		{
+			name:    "expires before publish",
+			na:      NewAnnouncement{Title: "title", Body: "body", PublishAt: now.Add(48 * time.Hour), ExpiresAt: now.Add(24 * time.Hour)},
+			wantErr: true,
+		},
+		{name: "now", na: NewAnnouncement{Title: "title", Body: "body"}},
+		{
+			name: "scheduled",
+			na:   NewAnnouncement{Title: "title", Body: "body", PublishAt: now.Add(24 * time.Hour), ExpiresAt: now.Add(48 * time.Hour)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.na.Validate(validate); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAnnouncement_Audience(t *testing.T) {
+	if got := (Announcement{}).Audience(); got != AudienceSchool {
+		t.Errorf("Audience() = %q; want %q", got, AudienceSchool)
+	}
+	if got := (Announcement{DepartmentID: "dept"}).Audience(); got != AudienceDepartment {
+		t.Errorf("Audience() = %q; want %q", got, AudienceDepartment)
+	}
+	if got := (Announcement{DepartmentID: "dept", ClassID: "cls"}).Audience(); got != AudienceClass {
+		t.Errorf("Audience() = %q; want %q", got, AudienceClass)
+	}
+}
+
+func TestAcademicYear(t *testing.T) {
+	if got := academicYear(time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)); got != 2026 {
+		t.Errorf("academicYear(2027-03) = %d; want 2026", got)
+	}
+	if got := academicYear(time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)); got != 2026 {
+		t.Errorf("academicYear(2026-09) = %d; want 2026", got)
+	}
+}
@@ -0,0 +1,49 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Scheduler periodically publishes the due Announcements in the background.
+type Scheduler struct {
+	svc      ServiceInterface
+	logger   core.Logger
+	interval time.Duration
+}
+
+func NewScheduler(conf *core.Config, svc ServiceInterface, logger core.Logger) *Scheduler {
+	return &Scheduler{
+		svc:      svc,
+		logger:   logger,
+		interval: conf.AnnouncementInterval,
+	}
+}
+
+// Run publishes the due Announcements right away, then at every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(t time.Time) {
+	n, err := s.svc.PublishDue(t)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("publishing announcements: %v", err), err)
+	}
+	if n > 0 {
+		s.logger.Info(fmt.Sprintf("%d announcement(s) published", n))
+	}
+}
@@ -0,0 +1,419 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/mail"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrAlreadyPublished     = errors.New("announcement already published")
+	errInvalidValue         = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateAnnouncement(ctx context.Context, a Announcement, exec ...core.DBExecutor) (Announcement, error)
+		// QueryAnnouncements returns the Announcements matching all non-zero Filter fields, most recent first.
+		QueryAnnouncements(ctx context.Context, filter Filter, exec ...core.DBExecutor) ([]Announcement, error)
+		GetAnnouncement(ctx context.Context, id string, exec ...core.DBExecutor) (Announcement, error)
+		UpdateAnnouncement(ctx context.Context, a Announcement, exec ...core.DBExecutor) (Announcement, error)
+		DeleteAnnouncement(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		CreateAttachment(ctx context.Context, att Attachment, exec ...core.DBExecutor) (Attachment, error)
+
+		AddRecipients(ctx context.Context, announcementID string, userIDs []string, exec ...core.DBExecutor) error
+		// QueryDeliveries returns the published Announcements received by a User, most recent first.
+		QueryDeliveries(ctx context.Context, filter DeliveryFilter, exec ...core.DBExecutor) ([]Delivery, error)
+		GetDelivery(ctx context.Context, announcementID, userID string, exec ...core.DBExecutor) (Delivery, error)
+		CountDeliveries(ctx context.Context, filter DeliveryFilter, exec ...core.DBExecutor) (int, error)
+		// MarkRead sets the read time of the unread Announcements of a User; all of them when no ID is given.
+		MarkRead(ctx context.Context, userID string, at time.Time, announcementIDs []string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		Create(schoolID, authorID string, na NewAnnouncement) (Announcement, error)
+		Query(schoolID string) ([]Announcement, error)
+		GetByID(id string) (Announcement, error)
+		Delete(id string) error
+		// AddAttachment saves a file attached to an unpublished Announcement.
+		AddAttachment(a Announcement, filename, contentType string, size int64, r io.Reader) (Attachment, error)
+
+		// PublishDue publishes the Announcements due at t to their recipients, notifies them, texts them,
+		// emails the digests, and returns the number of Announcements published.
+		PublishDue(t time.Time) (int, error)
+
+		// Inbox returns the published and unexpired Announcements received by a User.
+		Inbox(userID string, unreadOnly bool) ([]Delivery, error)
+		GetDelivery(id, userID string) (Delivery, error)
+		UnreadCount(userID string) (int, error)
+		// MarkRead marks Announcements received by a User as read; all of them when no ID is given.
+		MarkRead(userID string, ids ...string) error
+	}
+
+	Service struct {
+		conf      *core.Config
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		userSvc   user.ServiceInterface
+		media     core.MediaStorage
+		mailSvc   core.EmailService
+		notifier  core.Notifier
+		smsSvc    sms.ServiceInterface
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	userSvc user.ServiceInterface,
+	media core.MediaStorage,
+	mailSvc core.EmailService,
+	notifier core.Notifier,
+	smsSvc sms.ServiceInterface,
+) *Service {
+	return &Service{
+		conf:      conf,
+		db:        db,
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		userSvc:   userSvc,
+		media:     media,
+		mailSvc:   mailSvc,
+		notifier:  notifier,
+		smsSvc:    smsSvc,
+	}
+}
+
+func (svc *Service) Create(schoolID, authorID string, na NewAnnouncement) (Announcement, error) {
+	// the audience must belong to the School
+	if na.ClassID != "" {
+		cls, err := svc.schoolSvc.GetClass(na.ClassID)
+		if err != nil && errors.Cause(err) != school.ErrClassNotFound {
+			return Announcement{}, err
+		}
+		if err != nil || cls.SchoolID != schoolID {
+			return Announcement{}, core.NewValidationError(nil, core.FieldError{Field: "class_id", Error: errInvalidValue})
+		}
+		na.DepartmentID = cls.DepartmentID
+	} else if na.DepartmentID != "" {
+		depts, err := svc.schoolSvc.QueryDepartments(schoolID)
+		if err != nil {
+			return Announcement{}, err
+		}
+		found := false
+		for _, dept := range depts {
+			found = found || dept.ID == na.DepartmentID
+		}
+		if !found {
+			return Announcement{}, core.NewValidationError(nil, core.FieldError{Field: "department_id", Error: errInvalidValue})
+		}
+	}
+
+	publishAt := na.PublishAt.UTC()
+	if publishAt.IsZero() {
+		publishAt = time.Now().UTC()
+	}
+	a, err := svc.repo.CreateAnnouncement(context.Background(), Announcement{
+		SchoolID:     schoolID,
+		DepartmentID: na.DepartmentID,
+		ClassID:      na.ClassID,
+		AuthorID:     authorID,
+		Title:        na.Title,
+		Body:         na.Body,
+		EmailDigest:  na.EmailDigest,
+		SendSMS:      na.SendSMS,
+		PublishAt:    publishAt,
+		ExpiresAt:    na.ExpiresAt.UTC(),
+	})
+	return a, errors.Wrap(err, "creating announcement")
+}
+
+func (svc *Service) Query(schoolID string) ([]Announcement, error) {
+	as, err := svc.repo.QueryAnnouncements(context.Background(), Filter{SchoolID: schoolID})
+	return as, errors.Wrap(err, "querying announcements")
+}
+
+func (svc *Service) GetByID(id string) (Announcement, error) {
+	a, err := svc.repo.GetAnnouncement(context.Background(), id)
+	return a, errors.Wrap(err, "finding announcement by ID")
+}
+
+func (svc *Service) Delete(id string) error {
+	return errors.Wrap(svc.repo.DeleteAnnouncement(context.Background(), id), "deleting announcement")
+}
+
+func (svc *Service) AddAttachment(a Announcement, filename, contentType string, size int64, r io.Reader) (Attachment, error) {
+	if a.IsPublished() {
+		return Attachment{}, core.NewValidationError(ErrAlreadyPublished)
+	}
+
+	id := uuid.New().String()
+	name := "announcements/" + a.ID + "/" + id + strings.ToLower(path.Ext(filename))
+	if err := svc.media.Save(name, r); err != nil {
+		return Attachment{}, errors.Wrap(err, "saving attachment")
+	}
+	att, err := svc.repo.CreateAttachment(context.Background(), Attachment{
+		ID:             id,
+		AnnouncementID: a.ID,
+		Name:           name,
+		Filename:       filename,
+		ContentType:    contentType,
+		Size:           size,
+	})
+	return att, errors.Wrap(err, "creating attachment")
+}
+
+func (svc *Service) PublishDue(t time.Time) (int, error) {
+	ctx := context.Background()
+	t = t.UTC()
+
+	due, err := svc.repo.QueryAnnouncements(ctx, Filter{Due: t})
+	if err != nil {
+		return 0, errors.Wrap(err, "querying due announcements")
+	}
+
+	digests := make(map[string][]Announcement) // {userID: Announcements}
+	for _, a := range due {
+		userIDs, err := svc.recipients(a, t)
+		if err != nil {
+			return 0, err
+		}
+		a.PublishedAt = t
+		if err = svc.publish(ctx, a, userIDs); err != nil {
+			return 0, err
+		}
+		if err = svc.notify(a, userIDs); err != nil {
+			return 0, err
+		}
+		if a.SendSMS {
+			if err = svc.sendTexts(a, userIDs); err != nil {
+				return 0, err
+			}
+		}
+		if a.EmailDigest {
+			for _, id := range userIDs {
+				digests[id] = append(digests[id], a)
+			}
+		}
+	}
+
+	if err = svc.sendDigests(digests); err != nil {
+		return len(due), err
+	}
+	return len(due), nil
+}
+
+// publish fans an Announcement out to its recipients.
+func (svc *Service) publish(ctx context.Context, a Announcement, userIDs []string) error {
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if len(userIDs) > 0 {
+		if err = svc.repo.AddRecipients(ctx, a.ID, userIDs, tx); err != nil {
+			_ = tx.Rollback()
+			return errors.Wrap(err, "adding recipients")
+		}
+	}
+	if _, err = svc.repo.UpdateAnnouncement(ctx, a, tx); err != nil {
+		_ = tx.Rollback()
+		return errors.Wrap(err, "updating announcement")
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// notify notifies the recipients of a published Announcement.
+func (svc *Service) notify(a Announcement, userIDs []string) error {
+	ns := make([]core.Notification, 0, len(userIDs))
+	for _, id := range userIDs {
+		ns = append(ns, core.Notification{
+			UserID: id,
+			Event:  notification.EventAnnouncement,
+			Title:  a.Title,
+			Body:   a.Body,
+			Link:   "/announcements/" + a.ID,
+		})
+	}
+	return errors.Wrap(svc.notifier.Notify(ns...), "notifying recipients")
+}
+
+// sendTexts texts a published Announcement to its recipients with a phone, once per phone, within the SMS quota
+// of the School; the recipients beyond the quota are skipped.
+func (svc *Service) sendTexts(a Announcement, userIDs []string) error {
+	body := sms.Truncate(a.Title+": "+a.Body, sms.MaxLength)
+	seen := make(map[string]bool)
+	var messages []core.SMSMessage
+	for _, id := range userIDs {
+		usr, err := svc.userSvc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return err
+		}
+		if usr.Phone != "" && !seen[usr.Phone] {
+			seen[usr.Phone] = true
+			messages = append(messages, core.SMSMessage{To: usr.Phone, Body: body})
+		}
+	}
+	if _, err := svc.smsSvc.Send(a.SchoolID, messages...); err != nil && errors.Cause(err) != sms.ErrQuotaExceeded {
+		return errors.Wrap(err, "texting recipients")
+	}
+	return nil
+}
+
+// recipients returns the IDs of the Students and Teachers of the Classes targeted by an Announcement.
+// Department and School Announcements target the Classes of the academic year at t.
+func (svc *Service) recipients(a Announcement, t time.Time) ([]string, error) {
+	var classIDs []string
+	if a.Audience() == AudienceClass {
+		classIDs = []string{a.ClassID}
+	} else {
+		classes, err := svc.schoolSvc.QueryClasses(school.ClassFilter{
+			SchoolID:     a.SchoolID,
+			DepartmentID: a.DepartmentID,
+			Year:         academicYear(t),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cls := range classes {
+			classIDs = append(classIDs, cls.ID)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, classID := range classIDs {
+		studentIDs, err := svc.schoolSvc.StudentIDs(classID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range studentIDs {
+			add(id)
+		}
+		courses, err := svc.schoolSvc.QueryCourses(school.CourseFilter{ClassID: classID})
+		if err != nil {
+			return nil, err
+		}
+		for _, crs := range courses {
+			add(crs.TeacherID)
+		}
+	}
+	return ids, nil
+}
+
+// sendDigests emails each recipient the Announcements just published for them.
+func (svc *Service) sendDigests(digests map[string][]Announcement) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	userIDs := make([]string, 0, len(digests))
+	for id := range digests {
+		userIDs = append(userIDs, id)
+	}
+	sort.Strings(userIDs)
+
+	messages := make([]*core.EmailMessage, 0, len(digests))
+	for _, id := range userIDs {
+		usr, err := svc.userSvc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return err
+		}
+		ok, err := svc.userSvc.MayEmail(usr)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		as := digests[id]
+		subject := fmt.Sprintf("%d new announcements", len(as))
+		if len(as) == 1 {
+			subject = as[0].Title
+		}
+		messages = append(messages, &core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      subject,
+			TemplateName: "announcement-digest",
+			TemplateData: map[string]interface{}{"User": usr, "Announcements": as},
+			Conf:         svc.conf,
+		})
+	}
+	svc.mailSvc.SendMessages(messages...)
+	return nil
+}
+
+func (svc *Service) Inbox(userID string, unreadOnly bool) ([]Delivery, error) {
+	ds, err := svc.repo.QueryDeliveries(context.Background(), DeliveryFilter{
+		UserID:     userID,
+		At:         time.Now().UTC(),
+		UnreadOnly: unreadOnly,
+	})
+	return ds, errors.Wrap(err, "querying deliveries")
+}
+
+func (svc *Service) GetDelivery(id, userID string) (Delivery, error) {
+	d, err := svc.repo.GetDelivery(context.Background(), id, userID)
+	if err != nil {
+		return Delivery{}, errors.Wrap(err, "finding delivery")
+	}
+	if d.IsExpired(time.Now()) {
+		return Delivery{}, ErrAnnouncementNotFound
+	}
+	return d, nil
+}
+
+func (svc *Service) UnreadCount(userID string) (int, error) {
+	n, err := svc.repo.CountDeliveries(context.Background(), DeliveryFilter{
+		UserID:     userID,
+		At:         time.Now().UTC(),
+		UnreadOnly: true,
+	})
+	return n, errors.Wrap(err, "counting unread deliveries")
+}
+
+func (svc *Service) MarkRead(userID string, ids ...string) error {
+	err := svc.repo.MarkRead(context.Background(), userID, time.Now().UTC(), ids)
+	return errors.Wrap(err, "marking announcements as read")
+}
+
+// academicYear returns the start year of the academic year at t; eg. 2026 for 2026-2027, which starts in September.
+func academicYear(t time.Time) int {
+	if t.Month() < time.September {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
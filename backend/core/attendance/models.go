@@ -0,0 +1,126 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+const dateLayout = "2006-01-02"
+
+// Record statuses
+const (
+	StatusPresent = "present"
+	StatusAbsent  = "absent"
+	StatusLate    = "late"
+	StatusExcused = "excused" // justified absence
+)
+
+// Session is the roll call of a Class on a given date; daily, or for one of its Courses.
+type Session struct {
+	ID        string    `json:"id"` // UUID
+	ClassID   string    `json:"class_id"`
+	CourseID  string    `json:"course_id,omitempty"` // empty for daily sessions
+	Date      time.Time `json:"date"`
+	TakenByID string    `json:"taken_by_id,omitempty"`
+	Records   []Record  `json:"records"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+type Record struct {
+	SessionID string    `json:"session_id"`
+	StudentID string    `json:"student_id"`
+	Status    string    `json:"status"`
+	Note      string    `json:"note,omitempty"`
+	Date      time.Time `json:"date"`                // of the Session
+	CourseID  string    `json:"course_id,omitempty"` // of the Session
+	CreatedAt time.Time `json:"created_at"`          // UTC
+	UpdatedAt time.Time `json:"updated_at"`          // UTC
+}
+
+// Summary counts the Records of a Student over a period.
+type Summary struct {
+	StudentID string  `json:"student_id"`
+	Sessions  int     `json:"sessions"`
+	Present   int     `json:"present"`
+	Absent    int     `json:"absent"`
+	Late      int     `json:"late"`
+	Excused   int     `json:"excused"`
+	Rate      float64 `json:"rate"` // percentage of Sessions attended, late or not
+}
+
+// StudentAttendance is the Summary of a Student with the Records it was computed from.
+type StudentAttendance struct {
+	Summary
+	Records []Record `json:"records"`
+}
+
+type RecordEntry struct {
+	StudentID string `json:"student_id" validate:"required,uuid"`
+	Status    string `json:"status" validate:"required,oneof=present absent late excused"`
+	Note      string `json:"note" validate:"max=254"`
+}
+
+// MarkAttendance contains the Records of a Session, created if needed.
+// Students of the Class not listed are given the Default status, if any.
+type MarkAttendance struct {
+	Date     string        `json:"date" validate:"required,datetime=2006-01-02"`
+	CourseID string        `json:"course_id" validate:"omitempty,uuid"`
+	Default  string        `json:"default" validate:"omitempty,oneof=present absent late excused"`
+	Records  []RecordEntry `json:"records" validate:"dive"`
+}
+
+func (ma *MarkAttendance) Validate(validate *validator.Validate) error {
+	for i := range ma.Records {
+		ma.Records[i].Note = core.CleanString(ma.Records[i].Note)
+	}
+	return validate.Struct(ma)
+}
+
+// Day returns the parsed Date of a validated MarkAttendance.
+func (ma MarkAttendance) Day() time.Time {
+	d, _ := time.Parse(dateLayout, ma.Date)
+	return d
+}
+
+// SessionQuery identifies a Session of a Class.
+type SessionQuery struct {
+	Date     string `query:"date" validate:"required,datetime=2006-01-02"`
+	CourseID string `query:"course_id" validate:"omitempty,uuid"`
+}
+
+// Day returns the parsed Date of a validated SessionQuery.
+func (sq SessionQuery) Day() time.Time {
+	d, _ := time.Parse(dateLayout, sq.Date)
+	return d
+}
+
+// SummaryQuery selects the Sessions summarized: the daily ones, or the ones of a Course, in an optional date range.
+type SummaryQuery struct {
+	CourseID string `query:"course_id" validate:"omitempty,uuid"`
+	From     string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To       string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// Filter returns the RecordFilter of a validated SummaryQuery.
+func (sq SummaryQuery) Filter(classID string) RecordFilter {
+	filter := RecordFilter{ClassID: classID, CourseID: sq.CourseID, DailyOnly: sq.CourseID == ""}
+	filter.From, _ = time.Parse(dateLayout, sq.From)
+	filter.To, _ = time.Parse(dateLayout, sq.To)
+	return filter
+}
+
+// RecordFilter filters Records by the Session they belong to; zero values are ignored.
+type RecordFilter struct {
+	SessionID string
+	ClassID   string
+	CourseID  string
+	DailyOnly bool // only Records of daily Sessions
+	StudentID string
+	Status    string
+	From      time.Time // inclusive
+	To        time.Time // inclusive
+}
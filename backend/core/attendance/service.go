@@ -0,0 +1,347 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrSessionNotFound = errors.New("attendance session not found")
+	ErrStudentNotFound = errors.New("student not found in class")
+	errInvalidValue    = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateSession(ctx context.Context, s Session, exec ...core.DBExecutor) (Session, error)
+		// GetSession returns the Session of a Class on a date; the daily one when courseID is empty.
+		GetSession(ctx context.Context, classID, courseID string, date time.Time, exec ...core.DBExecutor) (Session, error)
+		UpdateSession(ctx context.Context, s Session, exec ...core.DBExecutor) (Session, error)
+
+		// SaveRecords inserts new Records and updates existing ones.
+		SaveRecords(ctx context.Context, records []Record, exec ...core.DBExecutor) error
+		// QueryRecords returns the Records matching all non-zero RecordFilter fields, ordered by date.
+		QueryRecords(ctx context.Context, filter RecordFilter, exec ...core.DBExecutor) ([]Record, error)
+	}
+
+	ServiceInterface interface {
+		// Mark saves the Records of a Session of a Class, and alerts the School when Students reach its absence threshold;
+		// Students with a phone are alerted by SMS too.
+		Mark(cls school.Class, takenByID string, ma MarkAttendance) (Session, error)
+		// GetSession returns the Session of a Class on a date with its Records; the daily one when courseID is empty.
+		GetSession(cls school.Class, courseID string, date time.Time) (Session, error)
+		// ClassSummaries returns the Summaries of all Students of a Class.
+		ClassSummaries(cls school.Class, filter RecordFilter) ([]Summary, error)
+		// StudentAttendance returns the Summary and Records of a Student of a Class, or ErrStudentNotFound.
+		StudentAttendance(cls school.Class, studentID string, filter RecordFilter) (StudentAttendance, error)
+	}
+
+	Service struct {
+		conf      *core.Config
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		userSvc   user.ServiceInterface
+		mailSvc   core.EmailService
+		smsSvc    sms.ServiceInterface
+		logger    core.Logger
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	userSvc user.ServiceInterface,
+	mailSvc core.EmailService,
+	smsSvc sms.ServiceInterface,
+	logger core.Logger,
+) *Service {
+	return &Service{
+		conf:      conf,
+		db:        db,
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		userSvc:   userSvc,
+		mailSvc:   mailSvc,
+		smsSvc:    smsSvc,
+		logger:    logger,
+	}
+}
+
+func (svc *Service) Mark(cls school.Class, takenByID string, ma MarkAttendance) (Session, error) {
+	ctx := context.Background()
+
+	if ma.CourseID != "" {
+		crs, err := svc.schoolSvc.GetCourse(ma.CourseID)
+		if err != nil && errors.Cause(err) != school.ErrCourseNotFound {
+			return Session{}, err
+		}
+		if err != nil || crs.ClassID != cls.ID {
+			return Session{}, core.NewValidationError(nil, core.FieldError{Field: "course_id", Error: errInvalidValue})
+		}
+	}
+
+	// only the Students of the Class can be marked
+	studentIDs, err := svc.schoolSvc.StudentIDs(cls.ID)
+	if err != nil {
+		return Session{}, err
+	}
+	enrolled := make(map[string]bool, len(studentIDs))
+	for _, id := range studentIDs {
+		enrolled[id] = true
+	}
+	statuses := make(map[string]RecordEntry, len(studentIDs))
+	if ma.Default != "" {
+		for _, id := range studentIDs {
+			statuses[id] = RecordEntry{StudentID: id, Status: ma.Default}
+		}
+	}
+	for _, entry := range ma.Records {
+		if !enrolled[entry.StudentID] {
+			return Session{}, core.NewValidationError(nil, core.FieldError{Field: "student_id", Error: errInvalidValue})
+		}
+		statuses[entry.StudentID] = entry
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Session{}, errors.Wrap(err, "starting transaction")
+	}
+	sess, alerts, err := svc.mark(ctx, cls, takenByID, ma, statuses, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Session{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return Session{}, errors.Wrap(err, "committing transaction")
+	}
+
+	if len(alerts.emails) > 0 {
+		svc.mailSvc.SendMessages(alerts.emails...)
+	}
+	if len(alerts.texts) > 0 {
+		// within the SMS quota of the School
+		if _, err = svc.smsSvc.Send(cls.SchoolID, alerts.texts...); err != nil && errors.Cause(err) != sms.ErrQuotaExceeded {
+			svc.logger.Error(fmt.Sprintf("sending absence alert sms: %v", err), err)
+		}
+	}
+	return sess, nil
+}
+
+// absenceAlerts are sent to the School by email, and to the Students with a phone by SMS.
+type absenceAlerts struct {
+	emails []*core.EmailMessage
+	texts  []core.SMSMessage
+}
+
+// mark saves a Session and its Records, and returns the absence alerts to send once committed.
+func (svc *Service) mark(
+	ctx context.Context,
+	cls school.Class,
+	takenByID string,
+	ma MarkAttendance,
+	statuses map[string]RecordEntry,
+	tx core.DBExecutor,
+) (Session, absenceAlerts, error) {
+	sess, err := svc.repo.GetSession(ctx, cls.ID, ma.CourseID, ma.Day(), tx)
+	switch err {
+	case nil:
+		sess.TakenByID = takenByID
+		if sess, err = svc.repo.UpdateSession(ctx, sess, tx); err != nil {
+			return Session{}, absenceAlerts{}, errors.Wrap(err, "updating session")
+		}
+	case ErrSessionNotFound:
+		sess, err = svc.repo.CreateSession(ctx, Session{ClassID: cls.ID, CourseID: ma.CourseID, Date: ma.Day(), TakenByID: takenByID}, tx)
+		if err != nil {
+			return Session{}, absenceAlerts{}, errors.Wrap(err, "creating session")
+		}
+	default:
+		return Session{}, absenceAlerts{}, errors.Wrap(err, "finding session")
+	}
+
+	previous, err := svc.repo.QueryRecords(ctx, RecordFilter{SessionID: sess.ID}, tx)
+	if err != nil {
+		return Session{}, absenceAlerts{}, errors.Wrap(err, "querying records")
+	}
+	wasAbsent := make(map[string]bool, len(previous))
+	for _, rec := range previous {
+		wasAbsent[rec.StudentID] = rec.Status == StatusAbsent
+	}
+
+	records := make([]Record, 0, len(statuses))
+	var newlyAbsent []string
+	for id, entry := range statuses {
+		records = append(records, Record{SessionID: sess.ID, StudentID: id, Status: entry.Status, Note: entry.Note})
+		if entry.Status == StatusAbsent && !wasAbsent[id] {
+			newlyAbsent = append(newlyAbsent, id)
+		}
+	}
+	if len(records) > 0 {
+		if err = svc.repo.SaveRecords(ctx, records, tx); err != nil {
+			return Session{}, absenceAlerts{}, errors.Wrap(err, "saving records")
+		}
+	}
+
+	alerts, err := svc.absenceAlerts(ctx, cls, sess.Date, newlyAbsent, tx)
+	if err != nil {
+		return Session{}, absenceAlerts{}, err
+	}
+	if sess.Records, err = svc.repo.QueryRecords(ctx, RecordFilter{SessionID: sess.ID}, tx); err != nil {
+		return Session{}, absenceAlerts{}, errors.Wrap(err, "querying records")
+	}
+	return sess, alerts, nil
+}
+
+// absenceAlerts returns an alert for each Student whose absences, in the Term of the date, just reached the threshold
+// of the School. Absences are counted since the start of the academic year when no Term includes the date.
+func (svc *Service) absenceAlerts(ctx context.Context, cls school.Class, date time.Time, studentIDs []string, tx core.DBExecutor) (absenceAlerts, error) {
+	var alerts absenceAlerts
+	if len(studentIDs) == 0 {
+		return alerts, nil
+	}
+	sch, err := svc.schoolSvc.GetSchool(cls.SchoolID)
+	if err != nil {
+		return alerts, err
+	}
+	if sch.AbsenceThreshold <= 0 || sch.AttendanceContact == "" {
+		return alerts, nil
+	}
+	emailContact, err := svc.mayEmailContact(sch.AttendanceContact)
+	if err != nil {
+		return alerts, err
+	}
+
+	period := fmt.Sprintf("%d-%d", cls.Year, cls.Year+1)
+	from := time.Date(cls.Year, time.September, 1, 0, 0, 0, 0, time.UTC)
+	terms, err := svc.schoolSvc.QueryTerms(cls.SchoolID)
+	if err != nil {
+		return alerts, err
+	}
+	for _, term := range terms {
+		if !date.Before(term.StartsOn) && !date.After(term.EndsOn) {
+			period, from = term.Name, term.StartsOn
+			break
+		}
+	}
+
+	for _, id := range studentIDs {
+		absences, err := svc.repo.QueryRecords(ctx, RecordFilter{
+			ClassID:   cls.ID,
+			StudentID: id,
+			Status:    StatusAbsent,
+			From:      from,
+			To:        date,
+		}, tx)
+		if err != nil {
+			return alerts, errors.Wrap(err, "querying absences")
+		}
+		if len(absences) != sch.AbsenceThreshold {
+			continue
+		}
+
+		student, err := svc.userSvc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return alerts, err
+		}
+		if emailContact {
+			alerts.emails = append(alerts.emails, &core.EmailMessage{
+				To:           []mail.Address{{Address: sch.AttendanceContact}},
+				Subject:      fmt.Sprintf("Absence Alert - %s", student.Name),
+				TemplateName: "absence-alert",
+				TemplateData: map[string]interface{}{
+					"School":   sch,
+					"Class":    cls,
+					"Student":  student,
+					"Absences": len(absences),
+					"Period":   period,
+				},
+				Conf: svc.conf,
+			})
+		}
+		if student.Phone != "" {
+			alerts.texts = append(alerts.texts, core.SMSMessage{
+				To: student.Phone,
+				Body: sms.Truncate(fmt.Sprintf(
+					"%s: %s (%s) has been absent %d times in %s.", sch.Name, student.Name, cls.Name, len(absences), period,
+				), sms.MaxLength),
+			})
+		}
+	}
+	return alerts, nil
+}
+
+// mayEmailContact reports whether absence alerts may be emailed to the attendance contact of a School: when it is
+// the address of a User, eg. of the principal, the same gate as account emails applies.
+func (svc *Service) mayEmailContact(address string) (bool, error) {
+	usr, err := svc.userSvc.GetByEmail(address)
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return true, nil // a mailbox of the School
+		}
+		return false, errors.Wrap(err, "finding attendance contact")
+	}
+	return svc.userSvc.MayEmail(usr)
+}
+
+func (svc *Service) GetSession(cls school.Class, courseID string, date time.Time) (Session, error) {
+	ctx := context.Background()
+	sess, err := svc.repo.GetSession(ctx, cls.ID, courseID, date)
+	if err != nil {
+		return Session{}, errors.Wrap(err, "finding session")
+	}
+	sess.Records, err = svc.repo.QueryRecords(ctx, RecordFilter{SessionID: sess.ID})
+	return sess, errors.Wrap(err, "querying records")
+}
+
+func (svc *Service) ClassSummaries(cls school.Class, filter RecordFilter) ([]Summary, error) {
+	studentIDs, err := svc.schoolSvc.StudentIDs(cls.ID)
+	if err != nil {
+		return nil, err
+	}
+	filter.ClassID = cls.ID
+	records, err := svc.repo.QueryRecords(context.Background(), filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying records")
+	}
+	return Summarize(studentIDs, records), nil
+}
+
+func (svc *Service) StudentAttendance(cls school.Class, studentID string, filter RecordFilter) (StudentAttendance, error) {
+	studentIDs, err := svc.schoolSvc.StudentIDs(cls.ID)
+	if err != nil {
+		return StudentAttendance{}, err
+	}
+	enrolled := false
+	for _, id := range studentIDs {
+		enrolled = enrolled || id == studentID
+	}
+	if !enrolled {
+		return StudentAttendance{}, ErrStudentNotFound
+	}
+
+	filter.ClassID = cls.ID
+	filter.StudentID = studentID
+	records, err := svc.repo.QueryRecords(context.Background(), filter)
+	if err != nil {
+		return StudentAttendance{}, errors.Wrap(err, "querying records")
+	}
+	return StudentAttendance{Summary: Summarize([]string{studentID}, records)[0], Records: records}, nil
+}
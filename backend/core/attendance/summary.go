@@ -0,0 +1,40 @@
+package attendance
+
+import "math"
+
+// Summarize counts the Records of each Student, in the order of studentIDs.
+// Records of other Students are ignored.
+func Summarize(studentIDs []string, records []Record) []Summary {
+	summaries := make([]Summary, len(studentIDs))
+	index := make(map[string]int, len(studentIDs))
+	for i, id := range studentIDs {
+		summaries[i].StudentID = id
+		index[id] = i
+	}
+
+	for _, rec := range records {
+		i, ok := index[rec.StudentID]
+		if !ok {
+			continue
+		}
+		s := &summaries[i]
+		s.Sessions++
+		switch rec.Status {
+		case StatusPresent:
+			s.Present++
+		case StatusAbsent:
+			s.Absent++
+		case StatusLate:
+			s.Late++
+		case StatusExcused:
+			s.Excused++
+		}
+	}
+
+	for i := range summaries {
+		if s := &summaries[i]; s.Sessions > 0 {
+			s.Rate = math.Round(float64(s.Present+s.Late)/float64(s.Sessions)*10000) / 100
+		}
+	}
+	return summaries
+}
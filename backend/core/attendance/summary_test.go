@@ -0,0 +1,29 @@
+package attendance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	records := []Record{
+		{StudentID: "s1", Status: StatusPresent},
+		{StudentID: "s1", Status: StatusLate},
+		{StudentID: "s1", Status: StatusAbsent},
+		{StudentID: "s2", Status: StatusExcused},
+		{StudentID: "s2", Status: StatusAbsent},
+		{StudentID: "s2", Status: StatusPresent},
+		{StudentID: "s2", Status: StatusPresent},
+		{StudentID: "other", Status: StatusAbsent},
+	}
+
+	got := Summarize([]string{"s1", "s2", "s3"}, records)
+	want := []Summary{
+		{StudentID: "s1", Sessions: 3, Present: 1, Absent: 1, Late: 1, Rate: 66.67},
+		{StudentID: "s2", Sessions: 4, Present: 2, Absent: 1, Excused: 1, Rate: 50},
+		{StudentID: "s3"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summarize() = %+v; want %+v", got, want)
+	}
+}
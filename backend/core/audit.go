@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Audited actions
+const (
+	AuditUserCreate       = "user.create"
+	AuditUserInvite       = "user.invite"
+	AuditUserUpdate       = "user.update"
+	AuditUserTempPassword = "user.temporary_password"
+	AuditUser2FADisable   = "user.2fa_disable"
+	AuditUserDelete       = "user.delete"
+	AuditUserRestore      = "user.restore"
+	AuditRoleMemberAdd    = "role.member_add"    // on the User given the custom Role
+	AuditRoleMemberRemove = "role.member_remove" // on the User the custom Role is taken from
+)
+
+// AuditRedacted replaces the values of secret & personal fields in AuditChanges; eg. passwords, emails.
+const AuditRedacted = "[redacted]"
+
+var (
+	// fields changing on every write, left out of the diffs
+	auditIgnoredFields = map[string]bool{"updated_at": true}
+	// personal data, outliving the erasure of the User in the log otherwise; only their change is recorded
+	auditPersonalFields = map[string]bool{"name": true, "username": true, "email": true, "phone": true}
+)
+
+type (
+	// AuditActor is who performs an audited action, and from where; the zero value is the system.
+	AuditActor struct {
+		ID        string // UUID of the User; empty for the admin CLI & the system
+		IP        string
+		UserAgent string
+	}
+
+	// AuditChange is the value of a field before & after an audited action; nil when absent.
+	AuditChange struct {
+		Before interface{} `json:"before"`
+		After  interface{} `json:"after"`
+	}
+
+	// AuditEntry records an administrative action; entries are never updated.
+	AuditEntry struct {
+		ID        string                 `json:"id"` // UUID
+		ActorID   string                 `json:"actor_id"`
+		Action    string                 `json:"action"`
+		TargetID  string                 `json:"target_id"`
+		Changes   map[string]AuditChange `json:"changes"` // by JSON field
+		IP        string                 `json:"ip"`
+		UserAgent string                 `json:"user_agent"`
+		CreatedAt time.Time              `json:"created_at"` // UTC
+	}
+
+	// AuditLog is any service appending AuditEntries.
+	AuditLog interface {
+		// Record appends an entry; a sql.Tx is passed as exec for the entry to be written with the audited change.
+		Record(ctx context.Context, e AuditEntry, exec ...DBExecutor) error
+	}
+)
+
+// NewAuditEntry returns the entry of an action of actor on a target.
+func NewAuditEntry(actor AuditActor, action, targetID string, changes map[string]AuditChange) AuditEntry {
+	return AuditEntry{
+		ActorID:   actor.ID,
+		Action:    action,
+		TargetID:  targetID,
+		Changes:   changes,
+		IP:        actor.IP,
+		UserAgent: actor.UserAgent,
+	}
+}
+
+// AuditDiff returns the fields differing between the JSON objects of before & after; nil for an absent object.
+// The non-empty values of personal fields are redacted.
+func AuditDiff(before, after interface{}) (map[string]AuditChange, error) {
+	b, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]AuditChange)
+	for k, v := range b {
+		if !auditIgnoredFields[k] && !reflect.DeepEqual(v, a[k]) {
+			changes[k] = AuditChange{Before: auditValue(k, v), After: auditValue(k, a[k])}
+		}
+	}
+	for k, v := range a {
+		if _, ok := b[k]; !ok && !auditIgnoredFields[k] && v != nil {
+			changes[k] = AuditChange{After: auditValue(k, v)}
+		}
+	}
+	return changes, nil
+}
+
+func auditValue(field string, v interface{}) interface{} {
+	if auditPersonalFields[field] && v != nil && v != "" {
+		return AuditRedacted
+	}
+	return v
+}
+
+func auditFields(obj interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if obj == nil {
+		return fields, nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding audited object")
+	}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrap(err, "decoding audited object")
+	}
+	return fields, nil
+}
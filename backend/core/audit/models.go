@@ -0,0 +1,20 @@
+package audit
+
+import "time"
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 100
+)
+
+// Filter selects AuditEntries, most recent first; zero values match all.
+type Filter struct {
+	UserID   string    `query:"user"`   // the actor or the target
+	ActorID  string    `query:"actor"`  // UUID
+	TargetID string    `query:"target"` // UUID
+	Action   string    `query:"action"` // eg. user.update
+	Since    time.Time `query:"since"`
+	Until    time.Time `query:"until"`
+	Limit    int       `query:"limit" validate:"omitempty,min=1,max=100"`
+	Offset   int       `query:"offset" validate:"omitempty,min=0"`
+}
@@ -0,0 +1,64 @@
+// Package audit keeps the log of the administrative actions: who created, updated, deleted or re-roled a User.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// CreateEntry appends an AuditEntry; entries are never updated.
+		CreateEntry(ctx context.Context, e core.AuditEntry, exec ...core.DBExecutor) (core.AuditEntry, error)
+		// QueryEntries returns the AuditEntries matching f, most recent first.
+		QueryEntries(ctx context.Context, f Filter, exec ...core.DBExecutor) ([]core.AuditEntry, error)
+		// DeleteEntriesBefore deletes the AuditEntries created before t, which may not be in the future;
+		// it returns their number.
+		DeleteEntriesBefore(ctx context.Context, t time.Time, exec ...core.DBExecutor) (int, error)
+	}
+
+	ServiceInterface interface {
+		core.AuditLog
+		Query(f Filter) ([]core.AuditEntry, error)
+		// Purge deletes the entries older than Config.AuditRetention at t; it returns their number.
+		// Entries are kept forever when the retention is zero.
+		Purge(t time.Time) (int, error)
+	}
+
+	Service struct {
+		conf *core.Config
+		repo Repository
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, repo Repository) *Service {
+	return &Service{conf: conf, repo: repo}
+}
+
+func (svc *Service) Record(ctx context.Context, e core.AuditEntry, exec ...core.DBExecutor) error {
+	_, err := svc.repo.CreateEntry(ctx, e, exec...)
+	return errors.Wrap(err, "recording audit entry")
+}
+
+func (svc *Service) Query(f Filter) ([]core.AuditEntry, error) {
+	if f.Limit == 0 {
+		f.Limit = DefaultLimit
+	}
+	es, err := svc.repo.QueryEntries(context.Background(), f)
+	return es, errors.Wrap(err, "querying audit entries")
+}
+
+func (svc *Service) Purge(t time.Time) (int, error) {
+	if svc.conf.AuditRetention <= 0 {
+		return 0, nil
+	}
+	n, err := svc.repo.DeleteEntriesBefore(context.Background(), t.Add(-svc.conf.AuditRetention))
+	return n, errors.Wrap(err, "purging audit entries")
+}
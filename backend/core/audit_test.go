@@ -0,0 +1,58 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuditDiff(t *testing.T) {
+	type obj struct {
+		Name      string   `json:"name"`
+		Email     string   `json:"email"`
+		Phone     string   `json:"phone"`
+		Roles     []string `json:"roles"`
+		UpdatedAt string   `json:"updated_at"`
+	}
+	before := obj{Name: "Old", Email: "old@test.cd", Roles: []string{"student"}, UpdatedAt: "before"}
+	after := obj{Name: "New", Phone: "+243810000000", Roles: []string{"teacher"}, UpdatedAt: "after"}
+
+	tests := []struct {
+		name          string
+		before, after interface{}
+		want          map[string]AuditChange
+	}{
+		{
+			name:   "update",
+			before: before,
+			after:  after,
+			want: map[string]AuditChange{
+				"name":  {Before: AuditRedacted, After: AuditRedacted},
+				"email": {Before: AuditRedacted, After: ""},
+				"phone": {Before: "", After: AuditRedacted},
+				"roles": {Before: []interface{}{"student"}, After: []interface{}{"teacher"}},
+			},
+		},
+		{
+			name:  "create",
+			after: after,
+			want: map[string]AuditChange{
+				"name":  {After: AuditRedacted},
+				"email": {After: ""},
+				"phone": {After: AuditRedacted},
+				"roles": {After: []interface{}{"teacher"}},
+			},
+		},
+		{name: "no change", before: before, after: before, want: map[string]AuditChange{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AuditDiff(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("AuditDiff() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuditDiff() = %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package avatar
+
+import (
+	"image"
+	"image/draw"
+)
+
+// thumbnail crops the center square of an image, and scales it to size x size pixels.
+// Pixels are averaged over the source area of each destination pixel (box filter), which is smooth when downscaling.
+func thumbnail(img image.Image, size int) *image.RGBA {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	origin := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+
+	// premultiplied pixels of the square
+	src := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(src, src.Bounds(), img, origin, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for dy := 0; dy < size; dy++ {
+		y0, y1 := span(dy, size, side)
+		for dx := 0; dx < size; dx++ {
+			x0, x1 := span(dx, size, side)
+			var sum [4]int
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride:]
+				for x := x0; x < x1; x++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(row[x*4+c])
+					}
+				}
+			}
+			n := (y1 - y0) * (x1 - x0)
+			i := dy*dst.Stride + dx*4
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// span returns the source range [from, to) of the destination pixel i; at least 1 pixel wide when upscaling.
+func span(i, size, side int) (int, int) {
+	from, to := i*side/size, (i+1)*side/size
+	if to <= from {
+		to = from + 1
+	}
+	return from, to
+}
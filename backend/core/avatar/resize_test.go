@@ -0,0 +1,56 @@
+package avatar
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestThumbnail(t *testing.T) {
+	// 6x4: a black column on each side, cropped off; white & gray halves in the center square
+	img := image.NewRGBA(image.Rect(0, 0, 6, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 6; x++ {
+			c := color.RGBA{A: 255}
+			switch {
+			case x >= 1 && x < 3:
+				c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			case x >= 3 && x < 5:
+				c = color.RGBA{R: 100, G: 100, B: 100, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	tests := []struct {
+		name string
+		size int
+		want map[image.Point]color.RGBA
+	}{
+		{name: "downscale", size: 2, want: map[image.Point]color.RGBA{
+			{0, 0}: {R: 255, G: 255, B: 255, A: 255},
+			{1, 1}: {R: 100, G: 100, B: 100, A: 255},
+		}},
+		{name: "average", size: 1, want: map[image.Point]color.RGBA{
+			{0, 0}: {R: 178, G: 178, B: 178, A: 255},
+		}},
+		{name: "upscale", size: 8, want: map[image.Point]color.RGBA{
+			{0, 0}: {R: 255, G: 255, B: 255, A: 255},
+			{3, 7}: {R: 255, G: 255, B: 255, A: 255},
+			{4, 0}: {R: 100, G: 100, B: 100, A: 255},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := thumbnail(img, tt.size)
+			if b := got.Bounds(); b.Dx() != tt.size || b.Dy() != tt.size {
+				t.Fatalf("thumbnail() bounds = %v; want %dx%d", b, tt.size, tt.size)
+			}
+			for pt, want := range tt.want {
+				if c := got.RGBAAt(pt.X, pt.Y); c != want {
+					t.Errorf("thumbnail() at %v = %v; want %v", pt, c, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package avatar manages the avatars of Users: uploaded images resized into thumbnails,
+// falling back to the Gravatar of their email.
+package avatar
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif" // registers the decoder
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const (
+	maxDimension = 4096 // pixels; larger images are refused before being decoded
+	gravatarURL  = "https://www.gravatar.com/avatar/"
+)
+
+var (
+	// Sizes of the thumbnails, in pixels
+	Sizes = []int{256, 128, 64}
+
+	// errors
+	ErrInvalidImage  = errors.New("must be a PNG, JPEG or GIF image")
+	ErrImageTooLarge = errors.Errorf("image must be %dx%d pixels at most", maxDimension, maxDimension)
+)
+
+type (
+	ServiceInterface interface {
+		// Upload resizes an image into the avatar thumbnails of a User.
+		Upload(usr user.User, r io.Reader) (user.User, error)
+		// Remove removes the uploaded avatar of a User, who falls back to their Gravatar.
+		Remove(usr user.User) (user.User, error)
+		// URLs returns the URLs of the avatar of a User by size: signed media URLs of the uploaded avatar,
+		// or the Gravatar of their email; none without either.
+		URLs(usr user.User) map[int]string
+		// DeleteFiles deletes all the avatars ever uploaded by a User; on the erasure of their personal data.
+		DeleteFiles(usr user.User) error
+	}
+
+	Service struct {
+		conf    *core.Config
+		userSvc user.ServiceInterface
+		media   core.MediaStorage
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, userSvc user.ServiceInterface, media core.MediaStorage) *Service {
+	return &Service{conf: conf, userSvc: userSvc, media: media}
+}
+
+func (svc *Service) Upload(usr user.User, r io.Reader) (user.User, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return user.User{}, errors.Wrap(err, "reading image")
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return user.User{}, ErrInvalidImage
+	}
+	if cfg.Width > maxDimension || cfg.Height > maxDimension {
+		return user.User{}, ErrImageTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return user.User{}, ErrInvalidImage
+	}
+
+	// a new name for every upload, so that cached thumbnails are not served
+	prefix := userDir(usr) + "/avatar-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	for _, size := range Sizes {
+		var buff bytes.Buffer
+		if err = png.Encode(&buff, thumbnail(img, size)); err != nil {
+			return user.User{}, errors.Wrap(err, "encoding thumbnail")
+		}
+		if err = svc.media.Save(thumbnailName(prefix, size), &buff); err != nil {
+			return user.User{}, errors.Wrap(err, "saving thumbnail")
+		}
+	}
+	return svc.userSvc.SetAvatar(usr.ID, prefix)
+}
+
+func (svc *Service) Remove(usr user.User) (user.User, error) {
+	return svc.userSvc.SetAvatar(usr.ID, "")
+}
+
+func (svc *Service) URLs(usr user.User) map[int]string {
+	urls := make(map[int]string, len(Sizes))
+	switch {
+	case usr.Avatar != "":
+		for _, size := range Sizes {
+			urls[size] = core.SignMediaURL(svc.conf, thumbnailName(usr.Avatar, size))
+		}
+	case usr.Email != "":
+		// https://en.gravatar.com/site/implement/images/
+		hash := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(usr.Email))))
+		for _, size := range Sizes {
+			urls[size] = fmt.Sprintf("%s%s?s=%d&d=identicon", gravatarURL, hex.EncodeToString(hash[:]), size)
+		}
+	}
+	return urls
+}
+
+func (svc *Service) DeleteFiles(usr user.User) error {
+	return errors.Wrap(svc.media.Delete(userDir(usr)), "deleting avatars")
+}
+
+func userDir(usr user.User) string {
+	return "users/" + usr.ID
+}
+
+func thumbnailName(prefix string, size int) string {
+	return prefix + "-" + strconv.Itoa(size) + ".png"
+}
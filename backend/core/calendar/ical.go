@@ -0,0 +1,83 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsDate     = "20060102"
+	icsDateTime = "20060102T150405Z"
+	icsMaxLine  = 75 // octets, excluding the line break
+)
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// ICS is an iCalendar (RFC 5545) feed of Events.
+type ICS struct {
+	AppName string // product & UID domain
+	Name    string // displayed by calendar apps
+	BaseURL string // of the frontend; Event links are relative to it
+	Stamp   time.Time
+	Events  []Event
+}
+
+// Render writes the feed to w.
+func (ics ICS) Render(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeFolded(bw, name+":"+value)
+	}
+	stamp := ics.Stamp.UTC().Format(icsDateTime)
+	domain := strings.ToLower(ics.AppName)
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//"+ics.AppName+"//Calendar//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", icsEscaper.Replace(ics.Name))
+	line("X-PUBLISHED-TTL", "PT1H") // refresh hint
+	for _, e := range ics.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", strings.ReplaceAll(e.ID, ":", "-")+"@"+domain)
+		line("DTSTAMP", stamp)
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE", e.StartsAt.UTC().Format(icsDate))
+			line("DTEND;VALUE=DATE", e.EndsAt.UTC().AddDate(0, 0, 1).Format(icsDate)) // exclusive
+		} else {
+			line("DTSTART", e.StartsAt.UTC().Format(icsDateTime))
+			line("DTEND", e.EndsAt.UTC().Format(icsDateTime))
+		}
+		line("SUMMARY", icsEscaper.Replace(e.Title))
+		if e.Description != "" {
+			line("DESCRIPTION", icsEscaper.Replace(e.Description))
+		}
+		line("CATEGORIES", strings.ToUpper(e.Kind))
+		if e.Link != "" {
+			line("URL", strings.TrimRight(ics.BaseURL, "/")+e.Link)
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+
+	return bw.Flush()
+}
+
+// writeFolded writes a content line, folded into lines of at most icsMaxLine octets without splitting characters.
+func writeFolded(w *bufio.Writer, s string) {
+	max := icsMaxLine
+	for len(s) > max {
+		cut := max
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		max = icsMaxLine - 1 // the leading space counts
+	}
+	_, _ = w.WriteString(s + "\r\n")
+}
@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestICS_Render(t *testing.T) {
+	day := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	ics := ICS{
+		AppName: "Masomo",
+		Name:    "Masomo - Student",
+		BaseURL: "https://masomo.cd/",
+		Stamp:   day,
+		Events: []Event{
+			{ID: "5d1c6a2e-0000-4000-8000-000000000001", Kind: KindHoliday, Title: "Christmas; holidays, yay", AllDay: true, StartsAt: day, EndsAt: day.AddDate(0, 0, 7)},
+			{ID: "due:5d1c6a2e-0000-4000-8000-000000000002", Kind: KindDue, Title: "Maths: Homework", Description: strings.Repeat("é", 60), StartsAt: day.Add(8 * time.Hour), EndsAt: day.Add(8 * time.Hour), Link: "/coursework/5d1c6a2e-0000-4000-8000-000000000002"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := ics.Render(&buf); err != nil {
+		t.Fatalf("Render() = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Errorf("feed does not end with END:VCALENDAR CRLF")
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(l) > icsMaxLine {
+			t.Errorf("len(%q) = %d; want <= %d", l, len(l), icsMaxLine)
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("line %q splits a character", l)
+		}
+	}
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	for _, want := range []string{
+		"UID:5d1c6a2e-0000-4000-8000-000000000001@masomo\r\n",
+		"DTSTART;VALUE=DATE:20261224\r\n",
+		"DTEND;VALUE=DATE:20270101\r\n", // exclusive end
+		`SUMMARY:Christmas\; holidays\, yay` + "\r\n",
+		"UID:due-5d1c6a2e-0000-4000-8000-000000000002@masomo\r\n",
+		"DTSTART:20261224T080000Z\r\n",
+		"DESCRIPTION:" + strings.Repeat("é", 60) + "\r\n",
+		"URL:https://masomo.cd/coursework/5d1c6a2e-0000-4000-8000-000000000002\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("feed does not contain %q:\n%s", want, unfolded)
+		}
+	}
+}
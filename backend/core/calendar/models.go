@@ -0,0 +1,105 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Event kinds
+const (
+	KindHoliday = "holiday" // the School is closed
+	KindExam    = "exam"    // exam period of the School, or test of a Course
+	KindEvent   = "event"   // eg. parents meeting, sports day, field trip
+
+	// derived from other records; not editable
+	KindTerm = "term" // a Term of the School
+	KindDue  = "due"  // the due date of a Coursework
+)
+
+// Event is an entry of a calendar: an Event of a School or Course, or one derived from a Term or Coursework.
+type Event struct {
+	ID          string    `json:"id"` // UUID; "<kind>:<UUID>" for derived Events
+	SchoolID    string    `json:"school_id"`
+	CourseID    string    `json:"course_id,omitempty"` // School-wide when empty
+	Kind        string    `json:"kind"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	AllDay      bool      `json:"all_day"`        // StartsAt & EndsAt are dates when set
+	StartsAt    time.Time `json:"starts_at"`      // UTC
+	EndsAt      time.Time `json:"ends_at"`        // UTC; last day of all-day Events
+	Link        string    `json:"link,omitempty"` // frontend path of the related page
+	CreatedAt   time.Time `json:"created_at"`     // UTC
+	UpdatedAt   time.Time `json:"updated_at"`     // UTC
+}
+
+// Overlaps reports whether an Event takes place, even partly, between from and to.
+func (e Event) Overlaps(from, to time.Time) bool {
+	end := e.EndsAt
+	if e.AllDay {
+		end = end.AddDate(0, 0, 1)
+	}
+	return e.StartsAt.Before(to) && !end.Before(from)
+}
+
+// IsDerived reports whether an Event is derived from another record; eg. a Term.
+func (e Event) IsDerived() bool {
+	return e.Kind == KindTerm || e.Kind == KindDue
+}
+
+// NewEvent contains information needed to create or replace an Event.
+type NewEvent struct {
+	Kind        string    `json:"kind" validate:"required,oneof=holiday exam event"`
+	Title       string    `json:"title" validate:"required,max=254"`
+	Description string    `json:"description"`
+	AllDay      bool      `json:"all_day"`
+	StartsAt    time.Time `json:"starts_at" validate:"required"`
+	EndsAt      time.Time `json:"ends_at" validate:"required"`
+}
+
+func (ne *NewEvent) Validate(validate *validator.Validate) error {
+	ne.Title = core.CleanString(ne.Title)
+	ne.Description = core.CleanString(ne.Description)
+	ne.StartsAt, ne.EndsAt = ne.StartsAt.UTC(), ne.EndsAt.UTC()
+	if ne.AllDay {
+		ne.StartsAt, ne.EndsAt = ne.StartsAt.Truncate(24*time.Hour), ne.EndsAt.Truncate(24*time.Hour)
+	}
+	if err := validate.Struct(ne); err != nil {
+		return err
+	}
+	if ne.EndsAt.Before(ne.StartsAt) {
+		return core.NewValidationError(nil, core.FieldError{Field: "ends_at", Error: "must not be before the start"})
+	}
+	return nil
+}
+
+// Range selects the Events taking place between From and To; the current academic year by default.
+type Range struct {
+	From time.Time `query:"from"`
+	To   time.Time `query:"to"`
+}
+
+// Clean sets the default bounds of a Range at t, and reports whether it is valid.
+func (r *Range) Clean(t time.Time) bool {
+	year := t.Year()
+	if t.Month() < time.September {
+		year--
+	}
+	if r.From.IsZero() {
+		r.From = time.Date(year, time.September, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if r.To.IsZero() {
+		r.To = r.From.AddDate(1, 0, 0)
+	}
+	r.From, r.To = r.From.UTC(), r.To.UTC()
+	return r.To.After(r.From)
+}
+
+type Filter struct {
+	SchoolIDs []string // School-wide Events of these Schools
+	CourseIDs []string // Events of these Courses
+	From      time.Time
+	To        time.Time
+}
@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRange_Clean(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		r         Range
+		at        time.Time
+		wantFrom  time.Time
+		wantTo    time.Time
+		wantValid bool
+	}{
+		{"defaults in autumn", Range{}, date(2026, 10, 19), date(2026, 9, 1), date(2027, 9, 1), true},
+		{"defaults in spring", Range{}, date(2027, 3, 1), date(2026, 9, 1), date(2027, 9, 1), true},
+		{"default end", Range{From: date(2026, 1, 1)}, date(2026, 10, 19), date(2026, 1, 1), date(2027, 1, 1), true},
+		{"inverted", Range{From: date(2026, 2, 1), To: date(2026, 1, 1)}, date(2026, 10, 19), date(2026, 2, 1), date(2026, 1, 1), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.r
+			if valid := r.Clean(tc.at); valid != tc.wantValid {
+				t.Errorf("Clean() = %v; want %v", valid, tc.wantValid)
+			}
+			if !r.From.Equal(tc.wantFrom) || !r.To.Equal(tc.wantTo) {
+				t.Errorf("Range = %v - %v; want %v - %v", r.From, r.To, tc.wantFrom, tc.wantTo)
+			}
+		})
+	}
+}
+
+func TestEvent_Overlaps(t *testing.T) {
+	day := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	holiday := Event{AllDay: true, StartsAt: day, EndsAt: day.AddDate(0, 0, 1)}
+	meeting := Event{StartsAt: day.Add(9 * time.Hour), EndsAt: day.Add(11 * time.Hour)}
+
+	tests := []struct {
+		name     string
+		e        Event
+		from, to time.Time
+		want     bool
+	}{
+		{"all-day last day", holiday, day.AddDate(0, 0, 1).Add(20 * time.Hour), day.AddDate(0, 0, 3), true},
+		{"all-day after", holiday, day.AddDate(0, 0, 2).Add(time.Second), day.AddDate(0, 0, 3), false},
+		{"all-day before", holiday, day.AddDate(0, 0, -2), day, false},
+		{"timed partly", meeting, day.Add(10 * time.Hour), day.AddDate(0, 0, 1), true},
+		{"timed after", meeting, day.Add(12 * time.Hour), day.AddDate(0, 0, 1), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.e.Overlaps(tc.from, tc.to); got != tc.want {
+				t.Errorf("Overlaps() = %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const feedTokenSize = 32 // random bytes
+
+var (
+	// errors
+	ErrEventNotFound = errors.New("calendar event not found")
+	ErrFeedNotFound  = errors.New("calendar feed not found")
+	errInvalidValue  = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateEvent(ctx context.Context, e Event, exec ...core.DBExecutor) (Event, error)
+		// QueryEvents returns the Events matching a Filter, by start time.
+		QueryEvents(ctx context.Context, filter Filter, exec ...core.DBExecutor) ([]Event, error)
+		GetEvent(ctx context.Context, id string, exec ...core.DBExecutor) (Event, error)
+		UpdateEvent(ctx context.Context, e Event, exec ...core.DBExecutor) (Event, error)
+		DeleteEvent(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		// SaveFeed sets the token hash of the feed of a User, replacing the previous one.
+		SaveFeed(ctx context.Context, userID, tokenHash string, exec ...core.DBExecutor) error
+		// GetFeedUserID returns the ID of the User whose feed has the token hash.
+		GetFeedUserID(ctx context.Context, tokenHash string, exec ...core.DBExecutor) (string, error)
+		DeleteFeed(ctx context.Context, userID string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		CreateSchoolEvent(schoolID string, ne NewEvent) (Event, error)
+		CreateCourseEvent(crs school.Course, ne NewEvent) (Event, error)
+		GetEvent(id string) (Event, error)
+		UpdateEvent(id string, ne NewEvent) (Event, error)
+		DeleteEvent(id string) error
+
+		// SchoolCalendar returns the School-wide Events and Terms of a School.
+		SchoolCalendar(schoolID string, r Range) ([]Event, error)
+		// CourseCalendar returns the Events and Coursework due dates of a Course.
+		CourseCalendar(crs school.Course, r Range) ([]Event, error)
+		// UserCalendar returns the calendars of the Schools and Courses of a Student or Teacher.
+		UserCalendar(userID string, r Range) ([]Event, error)
+
+		// CreateFeedToken returns a new token for the iCalendar feed of a User; the previous one is revoked.
+		CreateFeedToken(userID string) (string, error)
+		RevokeFeedToken(userID string) error
+		// FeedUser returns the active User of the feed token, or ErrFeedNotFound.
+		FeedUser(token string) (user.User, error)
+	}
+
+	Service struct {
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		cwSvc     coursework.ServiceInterface
+		userSvc   user.ServiceInterface
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	cwSvc coursework.ServiceInterface,
+	userSvc user.ServiceInterface,
+) *Service {
+	return &Service{
+		db:        db,
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		cwSvc:     cwSvc,
+		userSvc:   userSvc,
+	}
+}
+
+func (svc *Service) CreateSchoolEvent(schoolID string, ne NewEvent) (Event, error) {
+	e, err := svc.repo.CreateEvent(context.Background(), Event{
+		SchoolID:    schoolID,
+		Kind:        ne.Kind,
+		Title:       ne.Title,
+		Description: ne.Description,
+		AllDay:      ne.AllDay,
+		StartsAt:    ne.StartsAt,
+		EndsAt:      ne.EndsAt,
+	})
+	return e, errors.Wrap(err, "creating event")
+}
+
+func (svc *Service) CreateCourseEvent(crs school.Course, ne NewEvent) (Event, error) {
+	if ne.Kind == KindHoliday { // holidays are School-wide
+		return Event{}, core.NewValidationError(nil, core.FieldError{Field: "kind", Error: errInvalidValue})
+	}
+	cls, err := svc.schoolSvc.GetClass(crs.ClassID)
+	if err != nil {
+		return Event{}, err
+	}
+	e, err := svc.repo.CreateEvent(context.Background(), Event{
+		SchoolID:    cls.SchoolID,
+		CourseID:    crs.ID,
+		Kind:        ne.Kind,
+		Title:       ne.Title,
+		Description: ne.Description,
+		AllDay:      ne.AllDay,
+		StartsAt:    ne.StartsAt,
+		EndsAt:      ne.EndsAt,
+	})
+	return e, errors.Wrap(err, "creating event")
+}
+
+func (svc *Service) GetEvent(id string) (Event, error) {
+	e, err := svc.repo.GetEvent(context.Background(), id)
+	return e, errors.Wrap(err, "finding event by ID")
+}
+
+func (svc *Service) UpdateEvent(id string, ne NewEvent) (Event, error) {
+	e, err := svc.GetEvent(id)
+	if err != nil {
+		return Event{}, err
+	}
+	if e.CourseID != "" && ne.Kind == KindHoliday {
+		return Event{}, core.NewValidationError(nil, core.FieldError{Field: "kind", Error: errInvalidValue})
+	}
+	e.Kind = ne.Kind
+	e.Title = ne.Title
+	e.Description = ne.Description
+	e.AllDay = ne.AllDay
+	e.StartsAt = ne.StartsAt
+	e.EndsAt = ne.EndsAt
+	e, err = svc.repo.UpdateEvent(context.Background(), e)
+	return e, errors.Wrap(err, "updating event")
+}
+
+func (svc *Service) DeleteEvent(id string) error {
+	return errors.Wrap(svc.repo.DeleteEvent(context.Background(), id), "deleting event")
+}
+
+func (svc *Service) SchoolCalendar(schoolID string, r Range) ([]Event, error) {
+	return svc.calendar([]string{schoolID}, nil, r)
+}
+
+func (svc *Service) CourseCalendar(crs school.Course, r Range) ([]Event, error) {
+	return svc.calendar(nil, []school.Course{crs}, r)
+}
+
+func (svc *Service) UserCalendar(userID string, r Range) ([]Event, error) {
+	usr, err := svc.userSvc.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var courses []school.Course
+	classIDs := make(map[string]bool)
+	if usr.IsStudent() {
+		classes, err := svc.schoolSvc.QueryClasses(school.ClassFilter{StudentID: usr.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, cls := range classes {
+			classIDs[cls.ID] = true
+		}
+		if courses, err = svc.schoolSvc.QueryCourses(school.CourseFilter{StudentID: usr.ID}); err != nil {
+			return nil, err
+		}
+	} else if usr.IsTeacher() {
+		if courses, err = svc.schoolSvc.QueryCourses(school.CourseFilter{TeacherID: usr.ID}); err != nil {
+			return nil, err
+		}
+	}
+	for _, crs := range courses {
+		classIDs[crs.ClassID] = true
+	}
+
+	// the Schools of the Classes
+	seen := make(map[string]bool)
+	var schoolIDs []string
+	for id := range classIDs {
+		cls, err := svc.schoolSvc.GetClass(id)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[cls.SchoolID] {
+			seen[cls.SchoolID] = true
+			schoolIDs = append(schoolIDs, cls.SchoolID)
+		}
+	}
+	return svc.calendar(schoolIDs, courses, r)
+}
+
+// calendar returns the School-wide Events and Terms of Schools, and the Events and Coursework due dates of Courses,
+// taking place in a Range; by start time.
+func (svc *Service) calendar(schoolIDs []string, courses []school.Course, r Range) ([]Event, error) {
+	if len(schoolIDs) == 0 && len(courses) == 0 {
+		return []Event{}, nil
+	}
+	courseIDs := make([]string, 0, len(courses))
+	for _, crs := range courses {
+		courseIDs = append(courseIDs, crs.ID)
+	}
+	events, err := svc.repo.QueryEvents(context.Background(), Filter{SchoolIDs: schoolIDs, CourseIDs: courseIDs, From: r.From, To: r.To})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying events")
+	}
+
+	for _, schoolID := range schoolIDs {
+		terms, err := svc.schoolSvc.QueryTerms(schoolID)
+		if err != nil {
+			return nil, err
+		}
+		for _, term := range terms {
+			e := Event{
+				ID:       KindTerm + ":" + term.ID,
+				SchoolID: schoolID,
+				Kind:     KindTerm,
+				Title:    term.Name,
+				AllDay:   true,
+				StartsAt: term.StartsOn.UTC(),
+				EndsAt:   term.EndsOn.UTC(),
+			}
+			if e.Overlaps(r.From, r.To) {
+				events = append(events, e)
+			}
+		}
+	}
+
+	for _, crs := range courses {
+		cws, err := svc.cwSvc.Query(coursework.QueryFilter{CourseID: crs.ID, DueFrom: r.From, DueTo: r.To})
+		if err != nil {
+			return nil, err
+		}
+		for _, cw := range cws {
+			if cw.DueAt.IsZero() {
+				continue
+			}
+			events = append(events, Event{
+				ID:       KindDue + ":" + cw.ID,
+				CourseID: crs.ID,
+				Kind:     KindDue,
+				Title:    crs.Name + ": " + cw.Title,
+				StartsAt: cw.DueAt.UTC(),
+				EndsAt:   cw.DueAt.UTC(),
+				Link:     "/coursework/" + cw.ID,
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].StartsAt.Before(events[j].StartsAt) })
+	return events, nil
+}
+
+func (svc *Service) CreateFeedToken(userID string) (string, error) {
+	b := make([]byte, feedTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generating token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if err := svc.repo.SaveFeed(context.Background(), userID, hashToken(token)); err != nil {
+		return "", errors.Wrap(err, "saving feed")
+	}
+	return token, nil
+}
+
+func (svc *Service) RevokeFeedToken(userID string) error {
+	return errors.Wrap(svc.repo.DeleteFeed(context.Background(), userID), "deleting feed")
+}
+
+func (svc *Service) FeedUser(token string) (user.User, error) {
+	if token == "" {
+		return user.User{}, ErrFeedNotFound
+	}
+	userID, err := svc.repo.GetFeedUserID(context.Background(), hashToken(token))
+	if err != nil {
+		return user.User{}, errors.Wrap(err, "finding feed")
+	}
+	usr, err := svc.userSvc.GetByID(userID)
+	if err != nil {
+		return user.User{}, err
+	}
+	if usr.IsActive == nil || !*usr.IsActive {
+		return user.User{}, ErrFeedNotFound
+	}
+	return usr, nil
+}
+
+// hashToken returns the hex SHA-256 of a feed token; tokens are long random strings, so no salt is needed.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
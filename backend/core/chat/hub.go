@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// pubsubTopic is the PubSub topic of chat Events, shared by all replicas.
+const pubsubTopic = "chat"
+
+type subscriber struct {
+	room Room
+	send func(Event)
+}
+
+// hub forwards the Events received from the PubSub to the local subscribers of their Room.
+type hub struct {
+	ps core.PubSub
+
+	once sync.Once
+	err  error
+
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+func newHub(ps core.PubSub) *hub {
+	return &hub{
+		ps:   ps,
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+// start subscribes to the PubSub on first use.
+func (h *hub) start() error {
+	h.once.Do(func() {
+		ch, err := h.ps.Subscribe(pubsubTopic)
+		if err != nil {
+			h.err = errors.Wrap(err, "subscribing to chat events")
+			return
+		}
+		go func() {
+			for payload := range ch {
+				var e Event
+				if err := json.Unmarshal(payload, &e); err == nil {
+					h.dispatch(e)
+				}
+			}
+		}()
+	})
+	return h.err
+}
+
+func (h *hub) dispatch(e Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs {
+		if sub.room.ID == e.RoomID || (e.RoomID == "" && sub.room.CourseID == e.CourseID) {
+			sub.send(e)
+		}
+	}
+}
+
+// subscribe calls send with the Events of a Room until unsubscribed; send must not block.
+func (h *hub) subscribe(room Room, send func(Event)) (unsubscribe func(), err error) {
+	if err = h.start(); err != nil {
+		return nil, err
+	}
+	sub := &subscriber{room: room, send: send}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return func() {
+		h.mu.Lock()
+		delete(h.subs, sub)
+		h.mu.Unlock()
+	}, nil
+}
+
+func (h *hub) publish(e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshalling event")
+	}
+	return errors.Wrap(h.ps.Publish(pubsubTopic, payload), "publishing event")
+}
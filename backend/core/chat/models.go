@@ -0,0 +1,174 @@
+package chat
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Room kinds
+const (
+	KindGeneral = "general" // all Students of the Course, created on demand
+	KindTopic   = "topic"   // all Students of the Course
+	KindGroup   = "group"   // the listed Students of the Course
+)
+
+// Event types
+const (
+	EventMessage = "message" // new Message
+	EventDelete  = "delete"  // Message deleted by a moderator
+	EventMute    = "mute"    // User muted in the Course
+	EventUnmute  = "unmute"  // User unmuted in the Course
+)
+
+const defaultPageSize = 50
+
+// Room is a chat room of a Course; its Teacher and the admins can access all of them.
+type Room struct {
+	ID          string    `json:"id"` // UUID
+	CourseID    string    `json:"course_id"`
+	Name        string    `json:"name"`
+	Kind        string    `json:"kind"`
+	MemberIDs   []string  `json:"member_ids,omitempty"` // Students of group Rooms
+	CreatedByID string    `json:"created_by_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"` // UTC
+	UpdatedAt   time.Time `json:"updated_at"` // UTC
+}
+
+// IsMember reports whether a member of the Course can access the Room; managers can access all Rooms.
+func (r Room) IsMember(userID string, manage bool) bool {
+	if manage || r.Kind != KindGroup {
+		return true
+	}
+	for _, id := range r.MemberIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type Message struct {
+	ID          string    `json:"id"` // UUID
+	RoomID      string    `json:"room_id"`
+	SenderID    string    `json:"sender_id,omitempty"`
+	Body        string    `json:"body"` // empty once deleted
+	DeletedAt   time.Time `json:"deleted_at"`
+	DeletedByID string    `json:"deleted_by_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"` // UTC
+	UpdatedAt   time.Time `json:"updated_at"` // UTC
+}
+
+func (m Message) IsDeleted() bool {
+	return !m.DeletedAt.IsZero()
+}
+
+// Cursor returns the pagination cursor of the Messages sent before this one.
+func (m Message) Cursor() string {
+	raw := strconv.FormatInt(m.CreatedAt.UnixNano(), 10) + "_" + m.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Page is a page of Messages, most recent first.
+type Page struct {
+	Messages   []Message `json:"messages"`
+	NextCursor string    `json:"next_cursor,omitempty"` // of older Messages; empty on the last page
+}
+
+// Mute prevents a User from posting in the Rooms of a Course.
+type Mute struct {
+	CourseID  string    `json:"course_id"`
+	UserID    string    `json:"user_id"`
+	Until     time.Time `json:"until"` // UTC; muted indefinitely when zero
+	MutedByID string    `json:"muted_by_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// IsActive reports whether the User is still muted at t.
+func (m Mute) IsActive(t time.Time) bool {
+	return m.Until.IsZero() || t.Before(m.Until)
+}
+
+// Event is broadcast to the subscribers of a Room; or of all Rooms of a Course when RoomID is empty.
+type Event struct {
+	Type     string    `json:"type"`
+	RoomID   string    `json:"room_id,omitempty"`
+	CourseID string    `json:"course_id"`
+	Message  *Message  `json:"message,omitempty"`
+	UserID   string    `json:"user_id,omitempty"` // muted or unmuted User
+	Until    time.Time `json:"until,omitempty"`
+}
+
+// RoomUnread is the number of unread Messages of a Room for a User.
+type RoomUnread struct {
+	RoomID   string `json:"room_id"`
+	CourseID string `json:"course_id"`
+	Name     string `json:"name"`
+	Unread   int    `json:"unread"`
+}
+
+type NewRoom struct {
+	Name      string   `json:"name" validate:"required,max=100"`
+	Kind      string   `json:"kind" validate:"required,oneof=topic group"`
+	MemberIDs []string `json:"member_ids" validate:"required_if=Kind group,dive,uuid"`
+}
+
+func (nr *NewRoom) Validate(validate *validator.Validate) error {
+	nr.Name = core.CleanString(nr.Name)
+	if nr.Kind != KindGroup {
+		nr.MemberIDs = nil
+	}
+	return validate.Struct(nr)
+}
+
+type NewMessage struct {
+	Body string `json:"body" validate:"required,max=1000"`
+}
+
+func (nm *NewMessage) Validate(validate *validator.Validate) error {
+	nm.Body = strings.TrimSpace(nm.Body)
+	return validate.Struct(nm)
+}
+
+// MuteUser mutes a User of a Course until a given time; indefinitely when zero.
+type MuteUser struct {
+	UserID string    `json:"user_id" validate:"required,uuid"`
+	Until  time.Time `json:"until"`
+}
+
+// MessageQuery selects a page of the history of a Room.
+type MessageQuery struct {
+	Before string `query:"before"` // cursor
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type MessageFilter struct {
+	RoomID string
+	// Messages sent before the one at (BeforeTime, BeforeID), when set
+	BeforeTime time.Time
+	BeforeID   string
+	Limit      int
+}
+
+// parseCursor returns the creation time and ID of the Message of a cursor.
+func parseCursor(cursor string) (time.Time, string, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	parts := strings.SplitN(string(raw), "_", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return time.Time{}, "", false
+	}
+	nsec, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return time.Unix(0, nsec).UTC(), parts[1], true
+}
@@ -0,0 +1,82 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestMessage_Cursor(t *testing.T) {
+	m := Message{ID: "8d4c8e2e-5c4f-4b8a-9d0e-6f1f2a3b4c5d", CreatedAt: time.Date(2026, time.October, 19, 8, 30, 0, 123456000, time.UTC)}
+
+	at, id, ok := parseCursor(m.Cursor())
+	if !ok || id != m.ID || !at.Equal(m.CreatedAt) {
+		t.Errorf("parseCursor(Cursor()) = %v, %q, %v; want %v, %q, true", at, id, ok, m.CreatedAt, m.ID)
+	}
+	for _, cursor := range []string{"", "nope", "MTIz", "YWJjX2lk"} {
+		if _, _, ok = parseCursor(cursor); ok {
+			t.Errorf("parseCursor(%q) ok; want invalid", cursor)
+		}
+	}
+}
+
+func TestRoom_IsMember(t *testing.T) {
+	group := Room{Kind: KindGroup, MemberIDs: []string{"s1"}}
+	tests := []struct {
+		name   string
+		room   Room
+		userID string
+		manage bool
+		want   bool
+	}{
+		{name: "general", room: Room{Kind: KindGeneral}, userID: "s2", want: true},
+		{name: "topic", room: Room{Kind: KindTopic}, userID: "s2", want: true},
+		{name: "group member", room: group, userID: "s1", want: true},
+		{name: "group outsider", room: group, userID: "s2", want: false},
+		{name: "group manager", room: group, userID: "t1", manage: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.room.IsMember(tt.userID, tt.manage); got != tt.want {
+				t.Errorf("IsMember() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMute_IsActive(t *testing.T) {
+	now := time.Now()
+	if !(Mute{}).IsActive(now) {
+		t.Error("indefinite mute not active")
+	}
+	if !(Mute{Until: now.Add(time.Hour)}).IsActive(now) {
+		t.Error("mute not active before its end")
+	}
+	if (Mute{Until: now}).IsActive(now) {
+		t.Error("mute active at its end")
+	}
+}
+
+func TestNewRoom_Validate(t *testing.T) {
+	validate := validator.New()
+	tests := []struct {
+		name    string
+		nr      NewRoom
+		wantErr bool
+	}{
+		{name: "no name", nr: NewRoom{Name: " ", Kind: KindTopic}, wantErr: true},
+		{name: "general", nr: NewRoom{Name: "General", Kind: KindGeneral}, wantErr: true},
+		{name: "group without members", nr: NewRoom{Name: "Group", Kind: KindGroup}, wantErr: true},
+		{name: "invalid member", nr: NewRoom{Name: "Group", Kind: KindGroup, MemberIDs: []string{"s1"}}, wantErr: true},
+		{name: "topic", nr: NewRoom{Name: "Algebra", Kind: KindTopic, MemberIDs: []string{"s1"}}},
+		{name: "group", nr: NewRoom{Name: "Group", Kind: KindGroup, MemberIDs: []string{"8d4c8e2e-5c4f-4b8a-9d0e-6f1f2a3b4c5d"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.nr.Validate(validate); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var (
+	// errors
+	ErrRoomNotFound    = errors.New("room not found")
+	ErrMessageNotFound = errors.New("message not found")
+	ErrMuteNotFound    = errors.New("mute not found")
+	ErrMuted           = errors.New("user muted")
+	errInvalidValue    = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateRoom(ctx context.Context, r Room, exec ...core.DBExecutor) (Room, error)
+		// CreateGeneralRoom creates the general Room of a Course, or returns it if it already exists.
+		CreateGeneralRoom(ctx context.Context, courseID string, exec ...core.DBExecutor) (Room, error)
+		QueryRooms(ctx context.Context, courseIDs []string, exec ...core.DBExecutor) ([]Room, error)
+		GetRoom(ctx context.Context, id string, exec ...core.DBExecutor) (Room, error)
+
+		CreateMessage(ctx context.Context, m Message, exec ...core.DBExecutor) (Message, error)
+		// QueryMessages returns the Messages matching a MessageFilter, most recent first.
+		QueryMessages(ctx context.Context, filter MessageFilter, exec ...core.DBExecutor) ([]Message, error)
+		GetMessage(ctx context.Context, id string, exec ...core.DBExecutor) (Message, error)
+		UpdateMessage(ctx context.Context, m Message, exec ...core.DBExecutor) (Message, error)
+
+		SetLastRead(ctx context.Context, roomID, userID string, at time.Time, exec ...core.DBExecutor) error
+		// CountUnread returns the number of Messages not sent by a User since they last read each Room.
+		CountUnread(ctx context.Context, userID string, roomIDs []string, exec ...core.DBExecutor) (map[string]int, error)
+
+		SaveMute(ctx context.Context, m Mute, exec ...core.DBExecutor) (Mute, error)
+		GetMute(ctx context.Context, courseID, userID string, exec ...core.DBExecutor) (Mute, error)
+		DeleteMute(ctx context.Context, courseID, userID string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Rooms returns the Rooms of a Course accessible to a member; creating its general Room if needed.
+		Rooms(crs school.Course, userID string, manage bool) ([]Room, error)
+		CreateRoom(crs school.Course, creatorID string, nr NewRoom) (Room, error)
+		GetRoom(id string) (Room, error)
+
+		History(room Room, query MessageQuery) (Page, error)
+		// Post saves a Message and broadcasts it to the subscribers of its Room; unless the sender is muted.
+		Post(room Room, senderID string, nm NewMessage) (Message, error)
+		GetMessage(id string) (Message, error)
+		// DeleteMessage erases the body of a Message, and broadcasts its deletion.
+		DeleteMessage(m Message, byID string) (Message, error)
+
+		Mute(courseID, byID string, mu MuteUser) (Mute, error)
+		Unmute(courseID, userID string) error
+
+		MarkRead(roomID, userID string) error
+		// Unread returns the unread counters of a User in the Rooms of their Courses.
+		Unread(userID string, courses []school.Course) ([]RoomUnread, error)
+
+		// Subscribe calls send with the Events of a Room until unsubscribed; send must not block.
+		Subscribe(room Room, send func(Event)) (unsubscribe func(), err error)
+	}
+
+	Service struct {
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		logger    core.Logger
+		hub       *hub
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(db core.DB, repo Repository, schoolSvc school.ServiceInterface, ps core.PubSub, logger core.Logger) *Service {
+	return &Service{
+		db:        db,
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		logger:    logger,
+		hub:       newHub(ps),
+	}
+}
+
+func (svc *Service) Rooms(crs school.Course, userID string, manage bool) ([]Room, error) {
+	ctx := context.Background()
+	if _, err := svc.repo.CreateGeneralRoom(ctx, crs.ID); err != nil {
+		return nil, errors.Wrap(err, "creating general room")
+	}
+	rooms, err := svc.repo.QueryRooms(ctx, []string{crs.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying rooms")
+	}
+	accessible := make([]Room, 0, len(rooms))
+	for _, r := range rooms {
+		if r.IsMember(userID, manage) {
+			accessible = append(accessible, r)
+		}
+	}
+	return accessible, nil
+}
+
+func (svc *Service) CreateRoom(crs school.Course, creatorID string, nr NewRoom) (Room, error) {
+	// members of group Rooms must be enrolled in the Course
+	for i, id := range nr.MemberIDs {
+		enrolled, err := svc.schoolSvc.IsEnrolled(crs.ID, id)
+		if err != nil {
+			return Room{}, errors.Wrap(err, "checking enrollment")
+		}
+		if !enrolled {
+			return Room{}, core.NewValidationError(nil, core.FieldError{Field: fmt.Sprintf("member_ids[%d]", i), Error: errInvalidValue})
+		}
+	}
+
+	r, err := svc.repo.CreateRoom(context.Background(), Room{
+		CourseID:    crs.ID,
+		Name:        nr.Name,
+		Kind:        nr.Kind,
+		MemberIDs:   nr.MemberIDs,
+		CreatedByID: creatorID,
+	})
+	return r, errors.Wrap(err, "creating room")
+}
+
+func (svc *Service) GetRoom(id string) (Room, error) {
+	r, err := svc.repo.GetRoom(context.Background(), id)
+	return r, errors.Wrap(err, "finding room by ID")
+}
+
+func (svc *Service) History(room Room, query MessageQuery) (Page, error) {
+	filter := MessageFilter{RoomID: room.ID, Limit: query.Limit}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	if query.Before != "" {
+		t, id, ok := parseCursor(query.Before)
+		if !ok {
+			return Page{}, core.NewValidationError(nil, core.FieldError{Field: "before", Error: errInvalidValue})
+		}
+		filter.BeforeTime, filter.BeforeID = t, id
+	}
+
+	// fetch one more Message to know whether there are older ones
+	filter.Limit++
+	msgs, err := svc.repo.QueryMessages(context.Background(), filter)
+	if err != nil {
+		return Page{}, errors.Wrap(err, "querying messages")
+	}
+	page := Page{Messages: msgs}
+	if len(msgs) == filter.Limit {
+		page.Messages = msgs[:len(msgs)-1]
+		page.NextCursor = page.Messages[len(page.Messages)-1].Cursor()
+	}
+	return page, nil
+}
+
+func (svc *Service) Post(room Room, senderID string, nm NewMessage) (Message, error) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // DB precision; for cursors
+
+	mute, err := svc.repo.GetMute(ctx, room.CourseID, senderID)
+	if err != nil && errors.Cause(err) != ErrMuteNotFound {
+		return Message{}, errors.Wrap(err, "finding mute")
+	}
+	if err == nil && mute.IsActive(now) {
+		return Message{}, ErrMuted
+	}
+
+	m, err := svc.repo.CreateMessage(ctx, Message{
+		RoomID:    room.ID,
+		SenderID:  senderID,
+		Body:      nm.Body,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return Message{}, errors.Wrap(err, "creating message")
+	}
+	// the sender has read the Room up to their own Message
+	if err = svc.repo.SetLastRead(ctx, room.ID, senderID, now); err != nil {
+		return Message{}, errors.Wrap(err, "setting last read")
+	}
+
+	svc.publish(Event{Type: EventMessage, RoomID: room.ID, CourseID: room.CourseID, Message: &m})
+	return m, nil
+}
+
+func (svc *Service) GetMessage(id string) (Message, error) {
+	m, err := svc.repo.GetMessage(context.Background(), id)
+	return m, errors.Wrap(err, "finding message by ID")
+}
+
+func (svc *Service) DeleteMessage(m Message, byID string) (Message, error) {
+	if m.IsDeleted() {
+		return m, nil
+	}
+	room, err := svc.repo.GetRoom(context.Background(), m.RoomID)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "finding room by ID")
+	}
+
+	m.Body = ""
+	m.DeletedAt = time.Now().UTC()
+	m.DeletedByID = byID
+	m, err = svc.repo.UpdateMessage(context.Background(), m)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "updating message")
+	}
+
+	svc.publish(Event{Type: EventDelete, RoomID: m.RoomID, CourseID: room.CourseID, Message: &m})
+	return m, nil
+}
+
+func (svc *Service) Mute(courseID, byID string, mu MuteUser) (Mute, error) {
+	crs, err := svc.schoolSvc.GetCourse(courseID)
+	if err != nil {
+		return Mute{}, errors.Wrap(err, "finding course by ID")
+	}
+	enrolled, err := svc.schoolSvc.IsEnrolled(crs.ID, mu.UserID)
+	if err != nil {
+		return Mute{}, errors.Wrap(err, "checking enrollment")
+	}
+	if !enrolled {
+		return Mute{}, core.NewValidationError(nil, core.FieldError{Field: "user_id", Error: errInvalidValue})
+	}
+
+	m, err := svc.repo.SaveMute(context.Background(), Mute{
+		CourseID:  crs.ID,
+		UserID:    mu.UserID,
+		Until:     mu.Until.UTC(),
+		MutedByID: byID,
+	})
+	if err != nil {
+		return Mute{}, errors.Wrap(err, "saving mute")
+	}
+
+	svc.publish(Event{Type: EventMute, CourseID: m.CourseID, UserID: m.UserID, Until: m.Until})
+	return m, nil
+}
+
+func (svc *Service) Unmute(courseID, userID string) error {
+	if err := svc.repo.DeleteMute(context.Background(), courseID, userID); err != nil {
+		return errors.Wrap(err, "deleting mute")
+	}
+	svc.publish(Event{Type: EventUnmute, CourseID: courseID, UserID: userID})
+	return nil
+}
+
+func (svc *Service) MarkRead(roomID, userID string) error {
+	err := svc.repo.SetLastRead(context.Background(), roomID, userID, time.Now().UTC())
+	return errors.Wrap(err, "setting last read")
+}
+
+func (svc *Service) Unread(userID string, courses []school.Course) ([]RoomUnread, error) {
+	ctx := context.Background()
+	if len(courses) == 0 {
+		return []RoomUnread{}, nil
+	}
+	teacherOf := make(map[string]bool) // {courseID: userID is its Teacher}
+	courseIDs := make([]string, 0, len(courses))
+	for _, crs := range courses {
+		teacherOf[crs.ID] = crs.TeacherID == userID
+		courseIDs = append(courseIDs, crs.ID)
+	}
+
+	rooms, err := svc.repo.QueryRooms(ctx, courseIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying rooms")
+	}
+	roomIDs := make([]string, 0, len(rooms))
+	for _, r := range rooms {
+		if r.IsMember(userID, teacherOf[r.CourseID]) {
+			roomIDs = append(roomIDs, r.ID)
+		}
+	}
+	counts, err := svc.repo.CountUnread(ctx, userID, roomIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "counting unread messages")
+	}
+
+	unread := make([]RoomUnread, 0, len(roomIDs))
+	for _, r := range rooms {
+		if r.IsMember(userID, teacherOf[r.CourseID]) {
+			unread = append(unread, RoomUnread{RoomID: r.ID, CourseID: r.CourseID, Name: r.Name, Unread: counts[r.ID]})
+		}
+	}
+	return unread, nil
+}
+
+func (svc *Service) Subscribe(room Room, send func(Event)) (func(), error) {
+	return svc.hub.subscribe(room, send)
+}
+
+// publish broadcasts an Event to the subscribers of all replicas; a failure does not undo the saved changes.
+func (svc *Service) publish(e Event) {
+	if err := svc.hub.publish(e); err != nil {
+		svc.logger.Error(fmt.Sprintf("broadcasting chat event: %v", err), err)
+	}
+}
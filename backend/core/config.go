@@ -27,10 +27,29 @@ type (
 		SecretKey            string
 		FrontendBaseURL      string
 		PasswordResetTimeout time.Duration
+		LoginLinkTimeout     time.Duration // of passwordless login links & codes
+		MediaRoot            string        // directory of uploaded and generated files
+		MediaBaseURL         string        // URL of the media endpoint of the API
+		MediaURLExpiration   time.Duration
+		AnnouncementInterval time.Duration // how often due Announcements are published
+		TrialPeriod          time.Duration // of new Schools, before their first payment
+		UserErasureDelay     time.Duration // soft-deleted Users can be restored during it; their personal data is erased after
+		AuditRetention       time.Duration // audit log entries are purged after it; kept forever when zero
+		SidecarJobs          bool          // background Jobs are run by the sidecar instead of the API
+		JobWorkers           int           // number of Jobs run concurrently by a sidecar
+		JobPollInterval      time.Duration // how often idle sidecar workers look for due Jobs
+		JobTimeout           time.Duration // of a Job run
 		SendgridApiKey       string
+		VAPIDPublicKey       string // Web Push keys; push messages are printed when unset
+		VAPIDPrivateKey      string
+		SMSGatewayURL        string // base URL of the Twilio-compatible SMS gateway
+		SMSAccountSID        string // text messages are printed when unset
+		SMSAuthToken         string
+		SMSSenderID          string // default sender ID; Schools may set their own
 		RollbarToken         string
 		Database             dbConf
 		Server               srvConf
+		OIDC                 oidcConf
 	}
 
 	dbConf struct {
@@ -52,6 +71,23 @@ type (
 		ShutdownTimeout      time.Duration
 		JWTExpiration        time.Duration
 		JWTRefreshExpiration time.Duration
+		JWTAlgorithm         string        // RS256 | EdDSA; of the keys generated
+		JWTKeyGracePeriod    time.Duration // retired keys still verify tokens during this period
+		TrustedProxies       string        // comma-separated CIDRs of the reverse proxies whose X-Forwarded-For is trusted
+		TrustedProxyNets     []*net.IPNet  `mapstructure:"-"` // parsed TrustedProxies; client IPs are the peers' without any
+	}
+
+	// oidcConf configures the sign-in with OpenID Connect providers; a provider is disabled without a client ID.
+	oidcConf struct {
+		LoginTimeout time.Duration // to complete a sign-in with a provider
+		Google       OIDCProviderConf
+		Microsoft    OIDCProviderConf
+	}
+
+	OIDCProviderConf struct {
+		Issuer       string // discovery is at {Issuer}/.well-known/openid-configuration
+		ClientID     string
+		ClientSecret string
 	}
 )
 
@@ -70,6 +106,18 @@ func (sc srvConf) Address() string {
 	return net.JoinHostPort(sc.Host, sc.Port)
 }
 
+// Providers returns the enabled OIDC providers, by name
+func (oc oidcConf) Providers() map[string]OIDCProviderConf {
+	provs := make(map[string]OIDCProviderConf)
+	if oc.Google.ClientID != "" {
+		provs["google"] = oc.Google
+	}
+	if oc.Microsoft.ClientID != "" {
+		provs["microsoft"] = oc.Microsoft
+	}
+	return provs
+}
+
 // NewConfig returns the application's Config instance
 func NewConfig() *Config {
 	v := viper.New()
@@ -103,7 +151,25 @@ func NewConfig() *Config {
 	v.SetDefault("secretKey", "poq5-wer)enb$+57=dz&uoxh2(h!x)#*c2(#yg4h^$cegm2emy")
 	v.SetDefault("frontendBaseURL", "http://localhost:8080")
 	v.SetDefault("passwordResetTimeout", 3*24*time.Hour)
+	v.SetDefault("loginLinkTimeout", 15*time.Minute)
+	v.SetDefault("mediaRoot", "media")
+	v.SetDefault("mediaBaseURL", "http://localhost:8000/api/media")
+	v.SetDefault("mediaURLExpiration", 24*time.Hour)
+	v.SetDefault("announcementInterval", time.Minute)
+	v.SetDefault("trialPeriod", 30*24*time.Hour)
+	v.SetDefault("userErasureDelay", 30*24*time.Hour)
+	v.SetDefault("auditRetention", 2*365*24*time.Hour)
+	v.SetDefault("sidecarJobs", false)
+	v.SetDefault("jobWorkers", 4)
+	v.SetDefault("jobPollInterval", 5*time.Second)
+	v.SetDefault("jobTimeout", 10*time.Minute)
 	v.SetDefault("sendgridApiKey", "")
+	v.SetDefault("vapidPublicKey", "")
+	v.SetDefault("vapidPrivateKey", "")
+	v.SetDefault("smsGatewayURL", "https://api.twilio.com")
+	v.SetDefault("smsAccountSID", "")
+	v.SetDefault("smsAuthToken", "")
+	v.SetDefault("smsSenderID", appName)
 	v.SetDefault("rollbarToken", "")
 
 	v.SetDefault("database.engine", "postgres")
@@ -120,8 +186,19 @@ func NewConfig() *Config {
 	v.SetDefault("server.port", "8000")
 	v.SetDefault("server.debugHost", "0.0.0.0:9000")
 	v.SetDefault("server.shutdownTimeout", 5*time.Second)
-	v.SetDefault("server.jwtExpiration", 7*24*time.Hour)
-	v.SetDefault("server.jwtRefreshExpiration", 4*time.Hour)
+	v.SetDefault("server.jwtExpiration", 15*time.Minute)
+	v.SetDefault("server.jwtRefreshExpiration", 30*24*time.Hour)
+	v.SetDefault("server.jwtAlgorithm", "RS256")
+	v.SetDefault("server.jwtKeyGracePeriod", time.Hour)
+	v.SetDefault("server.trustedProxies", "")
+
+	v.SetDefault("oidc.loginTimeout", 10*time.Minute)
+	v.SetDefault("oidc.google.issuer", "https://accounts.google.com")
+	v.SetDefault("oidc.google.clientID", "")
+	v.SetDefault("oidc.google.clientSecret", "")
+	v.SetDefault("oidc.microsoft.issuer", "https://login.microsoftonline.com/organizations/v2.0")
+	v.SetDefault("oidc.microsoft.clientID", "")
+	v.SetDefault("oidc.microsoft.clientSecret", "")
 	// --------------------------------------------------------------------
 
 	// check env vars and override defaults
@@ -131,6 +208,16 @@ func NewConfig() *Config {
 	if err := v.Unmarshal(&conf); err != nil {
 		log.Fatal(err)
 	}
+	for _, cidr := range strings.Split(conf.Server.TrustedProxies, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Fatalf("%+v", errors.Wrap(err, "parsing server.trustedProxies"))
+		}
+		conf.Server.TrustedProxyNets = append(conf.Server.TrustedProxyNets, ipNet)
+	}
 
 	if conf.Debug {
 		log.Printf("\n\nConf: %v\n\n", v.AllSettings())
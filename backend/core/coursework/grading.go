@@ -0,0 +1,97 @@
+package coursework
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/rand"
+)
+
+// newSeed returns a random seed for an Attempt.
+func newSeed() (int64, error) {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b[:]) &^ (1 << 63)), nil
+}
+
+// BuildPaper shuffles the order of questions and of their options with seed.
+// The same questions and seed always produce the same paper.
+func BuildPaper(questions []Question, seed int64) []PaperQuestion {
+	rnd := rand.New(rand.NewSource(seed))
+
+	paper := make([]PaperQuestion, 0, len(questions))
+	for _, q := range questions {
+		pq := PaperQuestion{
+			ID:      q.ID,
+			Kind:    q.Kind,
+			Prompt:  q.Prompt,
+			Options: make([]PaperOption, 0, len(q.Options)),
+			Points:  q.Points,
+		}
+		for _, o := range q.Options {
+			pq.Options = append(pq.Options, PaperOption{ID: o.ID, Text: o.Text})
+		}
+		rnd.Shuffle(len(pq.Options), func(i, j int) { pq.Options[i], pq.Options[j] = pq.Options[j], pq.Options[i] })
+		paper = append(paper, pq)
+	}
+	rnd.Shuffle(len(paper), func(i, j int) { paper[i], paper[j] = paper[j], paper[i] })
+	return paper
+}
+
+// ScoreQuestion grades the options selected for a Question:
+//   - single: all the points for the correct option, nothing otherwise
+//   - multiple: partial credit; each correct option selected earns its share of points,
+//     each incorrect option selected cancels one share. The score is never negative.
+func ScoreQuestion(q Question, selected []string) float64 {
+	isCorrect := make(map[string]bool, len(q.Options))
+	for _, o := range q.Options {
+		isCorrect[o.ID] = o.Correct
+	}
+
+	seen := make(map[string]bool, len(selected))
+	var hits, misses int
+	for _, id := range selected {
+		correct, ok := isCorrect[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if correct {
+			hits++
+		} else {
+			misses++
+		}
+	}
+
+	switch q.Kind {
+	case QuestionSingle:
+		if hits == 1 && misses == 0 {
+			return q.Points
+		}
+		return 0
+	case QuestionMultiple:
+		total := q.correctCount()
+		if total == 0 {
+			return 0
+		}
+		credit := math.Max(0, float64(hits-misses)/float64(total))
+		return roundScore(credit * q.Points)
+	default:
+		return 0
+	}
+}
+
+// ScoreAnswers grades answers ({questionID: [optionID]}) against questions.
+func ScoreAnswers(questions []Question, answers map[string][]string) (score, maxScore float64) {
+	for _, q := range questions {
+		maxScore += q.Points
+		score += ScoreQuestion(q, answers[q.ID])
+	}
+	return roundScore(score), roundScore(maxScore)
+}
+
+func roundScore(s float64) float64 {
+	return math.Round(s*100) / 100
+}
@@ -0,0 +1,160 @@
+package coursework
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScoreQuestion(t *testing.T) {
+	single := Question{
+		ID:   "q1",
+		Kind: QuestionSingle,
+		Options: []Option{
+			{ID: "a", Correct: true},
+			{ID: "b"},
+			{ID: "c"},
+		},
+		Points: 2,
+	}
+	multiple := Question{
+		ID:   "q2",
+		Kind: QuestionMultiple,
+		Options: []Option{
+			{ID: "a", Correct: true},
+			{ID: "b", Correct: true},
+			{ID: "c", Correct: true},
+			{ID: "d"},
+		},
+		Points: 3,
+	}
+
+	tests := []struct {
+		name     string
+		q        Question
+		selected []string
+		want     float64
+	}{
+		{name: "single: no answer", q: single},
+		{name: "single: correct", q: single, selected: []string{"a"}, want: 2},
+		{name: "single: incorrect", q: single, selected: []string{"b"}},
+		{name: "single: correct + incorrect", q: single, selected: []string{"a", "b"}},
+		{name: "single: duplicate correct", q: single, selected: []string{"a", "a"}, want: 2},
+		{name: "single: unknown option", q: single, selected: []string{"z"}},
+		{name: "multiple: no answer", q: multiple},
+		{name: "multiple: all correct", q: multiple, selected: []string{"c", "a", "b"}, want: 3},
+		{name: "multiple: partial", q: multiple, selected: []string{"a", "b"}, want: 2},
+		{name: "multiple: partial + incorrect", q: multiple, selected: []string{"a", "b", "d"}, want: 1},
+		{name: "multiple: one third", q: multiple, selected: []string{"a", "unknown"}, want: 1},
+		{name: "multiple: never negative", q: multiple, selected: []string{"d"}},
+		{name: "multiple: all options", q: multiple, selected: []string{"a", "b", "c", "d"}, want: 2},
+		{name: "multiple: rounding", q: Question{Kind: QuestionMultiple, Options: multiple.Options, Points: 1}, selected: []string{"a"}, want: 0.33},
+		{name: "unknown kind", q: Question{Kind: "essay", Options: single.Options, Points: 1}, selected: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreQuestion(tt.q, tt.selected); got != tt.want {
+				t.Errorf("ScoreQuestion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("ScoreAnswers", func(t *testing.T) {
+		answers := map[string][]string{"q1": {"a"}, "q2": {"a", "d"}, "unknown": {"a"}}
+		score, maxScore := ScoreAnswers([]Question{single, multiple}, answers)
+		if score != 2 || maxScore != 5 {
+			t.Errorf("ScoreAnswers() = (%v, %v), want (2, 5)", score, maxScore)
+		}
+	})
+}
+
+func TestBuildPaper(t *testing.T) {
+	var questions []Question
+	for _, qid := range []string{"q1", "q2", "q3", "q4", "q5"} {
+		questions = append(questions, Question{
+			ID:   qid,
+			Kind: QuestionSingle,
+			Options: []Option{
+				{ID: qid + "a", Text: "A", Correct: true},
+				{ID: qid + "b", Text: "B"},
+				{ID: qid + "c", Text: "C"},
+				{ID: qid + "d", Text: "D"},
+			},
+			Points: 1,
+		})
+	}
+
+	paper := BuildPaper(questions, 42)
+	if !reflect.DeepEqual(paper, BuildPaper(questions, 42)) {
+		t.Error("BuildPaper() is not deterministic for the same seed")
+	}
+	if len(paper) != len(questions) {
+		t.Fatalf("BuildPaper() returned %d questions, want %d", len(paper), len(questions))
+	}
+
+	seen := make(map[string]bool)
+	for _, pq := range paper {
+		seen[pq.ID] = true
+		if len(pq.Options) != 4 {
+			t.Errorf("BuildPaper() question %s has %d options, want 4", pq.ID, len(pq.Options))
+		}
+	}
+	if len(seen) != len(questions) {
+		t.Errorf("BuildPaper() lost questions: %v", seen)
+	}
+
+	// some seed must produce a different order
+	var shuffled bool
+	for seed := int64(1); seed < 20 && !shuffled; seed++ {
+		shuffled = !reflect.DeepEqual(paper, BuildPaper(questions, seed))
+	}
+	if !shuffled {
+		t.Error("BuildPaper() does not shuffle")
+	}
+}
+
+func TestCoursework_dates(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		cw          Coursework
+		wantStarted bool
+		wantFrozen  bool
+	}{
+		{name: "tutorial: no dates", cw: Coursework{Kind: KindTutorial}, wantStarted: true},
+		{name: "tutorial: past due", cw: Coursework{Kind: KindTutorial, DueAt: past}, wantStarted: true},
+		{name: "tutorial: not started", cw: Coursework{Kind: KindTutorial, StartsAt: future}},
+		{name: "assignment: open", cw: Coursework{Kind: KindAssignment, StartsAt: past, DueAt: future}, wantStarted: true},
+		{name: "assignment: past due", cw: Coursework{Kind: KindAssignment, DueAt: past}, wantStarted: true, wantFrozen: true},
+		{name: "assignment: not started", cw: Coursework{Kind: KindAssignment, StartsAt: future, DueAt: future.Add(time.Hour)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cw.HasStarted(now); got != tt.wantStarted {
+				t.Errorf("HasStarted() = %v, want %v", got, tt.wantStarted)
+			}
+			if got := tt.cw.GradeFrozen(now); got != tt.wantFrozen {
+				t.Errorf("GradeFrozen() = %v, want %v", got, tt.wantFrozen)
+			}
+		})
+	}
+}
+
+func Test_computeGrades(t *testing.T) {
+	atts := []Attempt{
+		{CourseworkID: "cw", StudentID: "s1", Score: 3, MaxScore: 5, Counted: true},
+		{CourseworkID: "cw", StudentID: "s1", Score: 4, MaxScore: 5, Counted: true},
+		{CourseworkID: "cw", StudentID: "s1", Score: 5, MaxScore: 5}, // after due date
+		{CourseworkID: "cw", StudentID: "s2", Score: 5, MaxScore: 5},
+	}
+	want := []Grade{
+		{CourseworkID: "cw", StudentID: "s1", Score: 4, MaxScore: 5, Attempts: 3},
+		{CourseworkID: "cw", StudentID: "s2", Score: 0, MaxScore: 5, Attempts: 1},
+	}
+	if got := computeGrades(atts); !reflect.DeepEqual(got, want) {
+		t.Errorf("computeGrades() = %v, want %v", got, want)
+	}
+}
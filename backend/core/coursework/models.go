@@ -0,0 +1,190 @@
+package coursework
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Coursework kinds
+const (
+	KindTutorial   = "tutorial"   // can be retaken as many times as possible
+	KindAssignment = "assignment" // grades freeze once the due date passes
+)
+
+// Question kinds
+const (
+	QuestionSingle   = "single"   // radio
+	QuestionMultiple = "multiple" // checkboxes
+)
+
+type Option struct {
+	ID      string `json:"id"`
+	Text    string `json:"text" validate:"required"`
+	Correct bool   `json:"correct"`
+}
+
+// Question is a multiple-choice question of a Course's question bank.
+type Question struct {
+	ID        string    `json:"id"` // UUID
+	CourseID  string    `json:"course_id"`
+	Kind      string    `json:"kind"`
+	Prompt    string    `json:"prompt"`
+	Options   []Option  `json:"options"`
+	Points    float64   `json:"points"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+func (q Question) correctCount() int {
+	var cnt int
+	for _, o := range q.Options {
+		if o.Correct {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// Coursework is a Tutorial or an Assignment of a Course.
+// Virtual Coursework have Questions and are graded automatically.
+type Coursework struct {
+	ID           string    `json:"id"` // UUID
+	CourseID     string    `json:"course_id"`
+	Kind         string    `json:"kind"`
+	Title        string    `json:"title"`
+	Instructions string    `json:"instructions"`
+	QuestionIDs  []string  `json:"question_ids"`
+	MaxAttempts  int       `json:"max_attempts"` // 0: unlimited
+	StartsAt     time.Time `json:"starts_at"`    // UTC
+	DueAt        time.Time `json:"due_at"`       // UTC
+	CreatedAt    time.Time `json:"created_at"`   // UTC
+	UpdatedAt    time.Time `json:"updated_at"`   // UTC
+}
+
+func (cw Coursework) IsVirtual() bool { return len(cw.QuestionIDs) > 0 }
+
+// HasStarted reports whether Students can start an Attempt at t.
+func (cw Coursework) HasStarted(t time.Time) bool {
+	return cw.StartsAt.IsZero() || !t.Before(cw.StartsAt)
+}
+
+// GradeFrozen reports whether Attempts submitted at t no longer count towards the grade.
+func (cw Coursework) GradeFrozen(t time.Time) bool {
+	return cw.Kind == KindAssignment && !cw.DueAt.IsZero() && t.After(cw.DueAt)
+}
+
+// Attempt is a Student's take on a virtual Coursework.
+// The order of Questions and Options is randomized with Seed, which is kept so that any Attempt can be reproduced.
+// The Questions are copied when the Attempt starts: later edits of the question bank change neither its paper nor its score.
+type Attempt struct {
+	ID           string              `json:"id"` // UUID
+	CourseworkID string              `json:"coursework_id"`
+	StudentID    string              `json:"student_id"`
+	Seed         int64               `json:"seed"`
+	Questions    []Question          `json:"-"`       // with the correct answers, as of the start
+	Answers      map[string][]string `json:"answers"` // {questionID: [optionID]}
+	Score        float64             `json:"score"`
+	MaxScore     float64             `json:"max_score"`
+	Counted      bool                `json:"counted"`      // whether the Attempt counts towards the grade
+	StartedAt    time.Time           `json:"started_at"`   // UTC
+	SubmittedAt  time.Time           `json:"submitted_at"` // UTC
+}
+
+func (att Attempt) IsSubmitted() bool { return !att.SubmittedAt.IsZero() }
+
+// PaperQuestion is a Question as presented to a Student: without the correct answers.
+type PaperQuestion struct {
+	ID      string        `json:"id"`
+	Kind    string        `json:"kind"`
+	Prompt  string        `json:"prompt"`
+	Options []PaperOption `json:"options"`
+	Points  float64       `json:"points"`
+}
+
+type PaperOption struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// Grade is the best score among the counted Attempts of a Student.
+type Grade struct {
+	CourseworkID string  `json:"coursework_id"`
+	StudentID    string  `json:"student_id"`
+	Score        float64 `json:"score"`
+	MaxScore     float64 `json:"max_score"`
+	Attempts     int     `json:"attempts"`
+}
+
+// NewQuestion contains information needed to create or replace a Question.
+type NewQuestion struct {
+	Kind    string   `json:"kind" validate:"required,oneof=single multiple"`
+	Prompt  string   `json:"prompt" validate:"required"`
+	Options []Option `json:"options" validate:"required,min=2,dive"`
+	Points  float64  `json:"points" validate:"gt=0"`
+}
+
+func (nq *NewQuestion) Validate(validate *validator.Validate) error {
+	nq.Prompt = core.CleanString(nq.Prompt)
+	for i := range nq.Options {
+		nq.Options[i].Text = core.CleanString(nq.Options[i].Text)
+	}
+	if err := validate.Struct(nq); err != nil {
+		return err
+	}
+
+	// checked here rather than by a struct validator, for it not to depend on InitValidators:
+	// a Question without a single correct option is always scored 0
+	q := Question{Kind: nq.Kind, Options: nq.Options}
+	switch cnt := q.correctCount(); {
+	case nq.Kind == QuestionSingle && cnt != 1:
+		return core.NewValidationError(nil, core.FieldError{Field: "options", Error: singleAnswerText})
+	case cnt == 0:
+		return core.NewValidationError(nil, core.FieldError{Field: "options", Error: someAnswerText})
+	}
+	return nil
+}
+
+// NewCoursework contains information needed to create or replace a Coursework.
+type NewCoursework struct {
+	Kind         string    `json:"kind" validate:"required,oneof=tutorial assignment"`
+	Title        string    `json:"title" validate:"required"`
+	Instructions string    `json:"instructions"`
+	QuestionIDs  []string  `json:"question_ids" validate:"omitempty,dive,uuid"`
+	MaxAttempts  int       `json:"max_attempts" validate:"min=0"`
+	StartsAt     time.Time `json:"starts_at"`
+	DueAt        time.Time `json:"due_at"`
+}
+
+func (nc *NewCoursework) Validate(validate *validator.Validate) error {
+	nc.Title = core.CleanString(nc.Title)
+	nc.Instructions = core.CleanString(nc.Instructions)
+	if nc.Kind == KindTutorial {
+		nc.MaxAttempts = 0 // unlimited retakes
+	}
+	return validate.Struct(nc)
+}
+
+type SubmitAttempt struct {
+	Answers map[string][]string `json:"answers"`
+}
+
+type QueryFilter struct {
+	CourseID string    `query:"-"`
+	Kind     string    `query:"kind"`
+	DueFrom  time.Time `query:"due_from"`
+	DueTo    time.Time `query:"due_to"`
+}
+
+type QuestionFilter struct {
+	CourseID string
+	IDs      []string
+}
+
+type AttemptFilter struct {
+	CourseworkID  string
+	StudentID     string
+	SubmittedOnly bool
+}
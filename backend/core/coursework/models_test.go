@@ -0,0 +1,39 @@
+package coursework
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestNewQuestion_Validate(t *testing.T) {
+	validate := validator.New()
+
+	options := func(correct ...bool) []Option {
+		opts := make([]Option, 0, len(correct))
+		for _, c := range correct {
+			opts = append(opts, Option{Text: "option", Correct: c})
+		}
+		return opts
+	}
+	tests := []struct {
+		name    string
+		nq      NewQuestion
+		wantErr bool
+	}{
+		{name: "single", nq: NewQuestion{Kind: QuestionSingle, Options: options(true, false)}},
+		{name: "single: no correct option", nq: NewQuestion{Kind: QuestionSingle, Options: options(false, false)}, wantErr: true},
+		{name: "single: several correct options", nq: NewQuestion{Kind: QuestionSingle, Options: options(true, true)}, wantErr: true},
+		{name: "multiple", nq: NewQuestion{Kind: QuestionMultiple, Options: options(true, true, false)}},
+		{name: "multiple: no correct option", nq: NewQuestion{Kind: QuestionMultiple, Options: options(false, false)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.nq.Prompt = "prompt"
+			tt.nq.Points = 1
+			if err := tt.nq.Validate(validate); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
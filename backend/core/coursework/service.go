@@ -0,0 +1,379 @@
+package coursework
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	NowFunc = time.Now // mockable
+
+	// errors
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrCourseworkNotFound = errors.New("coursework not found")
+	ErrAttemptNotFound    = errors.New("attempt not found")
+
+	ErrNotVirtual         = errors.New("coursework has no questions")
+	ErrNotStarted         = errors.New("coursework has not started yet")
+	ErrMaxAttemptsReached = errors.New("maximum number of attempts reached")
+	ErrAttemptSubmitted   = errors.New("attempt already submitted")
+	errForeignQuestion    = errors.New("question does not belong to the course")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateQuestion(ctx context.Context, q Question, exec ...core.DBExecutor) (Question, error)
+		QueryQuestions(ctx context.Context, filter QuestionFilter, exec ...core.DBExecutor) ([]Question, error)
+		GetQuestion(ctx context.Context, id string, exec ...core.DBExecutor) (Question, error)
+		UpdateQuestion(ctx context.Context, q Question, exec ...core.DBExecutor) (Question, error)
+		DeleteQuestion(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		CreateCoursework(ctx context.Context, cw Coursework, exec ...core.DBExecutor) (Coursework, error)
+		// QueryCoursework returns all Coursework or filters them by applying AND operation on available QueryFilter fields.
+		QueryCoursework(ctx context.Context, filter QueryFilter, exec ...core.DBExecutor) ([]Coursework, error)
+		GetCoursework(ctx context.Context, id string, exec ...core.DBExecutor) (Coursework, error)
+		// LockCoursework gets a Coursework and locks it (`FOR UPDATE`) until the end of the sql.Tx passed as exec.
+		LockCoursework(ctx context.Context, id string, exec ...core.DBExecutor) (Coursework, error)
+		UpdateCoursework(ctx context.Context, cw Coursework, exec ...core.DBExecutor) (Coursework, error)
+		DeleteCoursework(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		CreateAttempt(ctx context.Context, att Attempt, exec ...core.DBExecutor) (Attempt, error)
+		QueryAttempts(ctx context.Context, filter AttemptFilter, exec ...core.DBExecutor) ([]Attempt, error)
+		CountAttempts(ctx context.Context, filter AttemptFilter, exec ...core.DBExecutor) (int, error)
+		GetAttempt(ctx context.Context, id string, exec ...core.DBExecutor) (Attempt, error)
+		// LockAttempt gets an Attempt and locks it (`FOR UPDATE`) until the end of the sql.Tx passed as exec.
+		LockAttempt(ctx context.Context, id string, exec ...core.DBExecutor) (Attempt, error)
+		UpdateAttempt(ctx context.Context, att Attempt, exec ...core.DBExecutor) (Attempt, error)
+	}
+
+	ServiceInterface interface {
+		CreateQuestion(courseID string, nq NewQuestion) (Question, error)
+		QueryQuestions(courseID string) ([]Question, error)
+		GetQuestion(id string) (Question, error)
+		UpdateQuestion(id string, nq NewQuestion) (Question, error)
+		DeleteQuestion(id string) error
+
+		CreateCoursework(courseID string, nc NewCoursework) (Coursework, error)
+		Query(filter QueryFilter) ([]Coursework, error)
+		GetByID(id string) (Coursework, error)
+		Update(id string, nc NewCoursework) (Coursework, error)
+		Delete(id string) error
+
+		// StartAttempt starts a new Attempt of a Student and returns its randomized paper.
+		StartAttempt(cw Coursework, studentID string) (Attempt, []PaperQuestion, error)
+		GetAttempt(id string) (Attempt, error)
+		// Paper rebuilds the randomized paper of an Attempt from its questions & seed.
+		Paper(att Attempt) []PaperQuestion
+		SubmitAttempt(id string, sa SubmitAttempt) (Attempt, error)
+		QueryAttempts(filter AttemptFilter) ([]Attempt, error)
+		// Grades returns the Grade of each Student who submitted an Attempt of the Coursework.
+		Grades(courseworkID string, studentID ...string) ([]Grade, error)
+	}
+
+	Service struct {
+		db   core.DB
+		repo Repository
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(db core.DB, repo Repository) *Service {
+	return &Service{
+		db:   db,
+		repo: repo,
+	}
+}
+
+func (svc *Service) newOptions(opts []Option) []Option {
+	options := make([]Option, 0, len(opts))
+	for _, o := range opts {
+		options = append(options, Option{ID: uuid.New().String(), Text: o.Text, Correct: o.Correct})
+	}
+	return options
+}
+
+func (svc *Service) CreateQuestion(courseID string, nq NewQuestion) (Question, error) {
+	q, err := svc.repo.CreateQuestion(context.Background(), Question{
+		CourseID: courseID,
+		Kind:     nq.Kind,
+		Prompt:   nq.Prompt,
+		Options:  svc.newOptions(nq.Options),
+		Points:   nq.Points,
+	})
+	return q, errors.Wrap(err, "creating question")
+}
+
+func (svc *Service) QueryQuestions(courseID string) ([]Question, error) {
+	qs, err := svc.repo.QueryQuestions(context.Background(), QuestionFilter{CourseID: courseID})
+	return qs, errors.Wrap(err, "querying questions")
+}
+
+func (svc *Service) GetQuestion(id string) (Question, error) {
+	q, err := svc.repo.GetQuestion(context.Background(), id)
+	return q, errors.Wrap(err, "finding question by ID")
+}
+
+func (svc *Service) UpdateQuestion(id string, nq NewQuestion) (Question, error) {
+	q, err := svc.GetQuestion(id)
+	if err != nil {
+		return Question{}, err
+	}
+
+	// keep the IDs of unchanged options so that previous answers still match
+	existing := make(map[string]string, len(q.Options)) // {text: id}
+	for _, o := range q.Options {
+		existing[o.Text] = o.ID
+	}
+	options := svc.newOptions(nq.Options)
+	for i, o := range options {
+		if oid, ok := existing[o.Text]; ok {
+			options[i].ID = oid
+		}
+	}
+
+	q.Kind = nq.Kind
+	q.Prompt = nq.Prompt
+	q.Options = options
+	q.Points = nq.Points
+	q, err = svc.repo.UpdateQuestion(context.Background(), q)
+	return q, errors.Wrap(err, "updating question")
+}
+
+func (svc *Service) DeleteQuestion(id string) error {
+	return errors.Wrap(svc.repo.DeleteQuestion(context.Background(), id), "deleting question")
+}
+
+// checkQuestions ensures that all questions belong to the Course.
+func (svc *Service) checkQuestions(ctx context.Context, courseID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	qs, err := svc.repo.QueryQuestions(ctx, QuestionFilter{CourseID: courseID, IDs: ids})
+	if err != nil {
+		return errors.Wrap(err, "querying questions")
+	}
+	if len(qs) != len(ids) {
+		return core.NewValidationError(errForeignQuestion, core.FieldError{Field: "question_ids", Error: errForeignQuestion.Error()})
+	}
+	return nil
+}
+
+func (svc *Service) CreateCoursework(courseID string, nc NewCoursework) (Coursework, error) {
+	ctx := context.Background()
+	if err := svc.checkQuestions(ctx, courseID, nc.QuestionIDs); err != nil {
+		return Coursework{}, err
+	}
+	cw, err := svc.repo.CreateCoursework(ctx, Coursework{
+		CourseID:     courseID,
+		Kind:         nc.Kind,
+		Title:        nc.Title,
+		Instructions: nc.Instructions,
+		QuestionIDs:  nc.QuestionIDs,
+		MaxAttempts:  nc.MaxAttempts,
+		StartsAt:     nc.StartsAt,
+		DueAt:        nc.DueAt,
+	})
+	return cw, errors.Wrap(err, "creating coursework")
+}
+
+func (svc *Service) Query(filter QueryFilter) ([]Coursework, error) {
+	cws, err := svc.repo.QueryCoursework(context.Background(), filter)
+	return cws, errors.Wrap(err, "querying coursework")
+}
+
+func (svc *Service) GetByID(id string) (Coursework, error) {
+	cw, err := svc.repo.GetCoursework(context.Background(), id)
+	return cw, errors.Wrap(err, "finding coursework by ID")
+}
+
+func (svc *Service) Update(id string, nc NewCoursework) (Coursework, error) {
+	ctx := context.Background()
+	cw, err := svc.GetByID(id)
+	if err != nil {
+		return Coursework{}, err
+	}
+	if err = svc.checkQuestions(ctx, cw.CourseID, nc.QuestionIDs); err != nil {
+		return Coursework{}, err
+	}
+
+	cw.Kind = nc.Kind
+	cw.Title = nc.Title
+	cw.Instructions = nc.Instructions
+	cw.QuestionIDs = nc.QuestionIDs
+	cw.MaxAttempts = nc.MaxAttempts
+	cw.StartsAt = nc.StartsAt
+	cw.DueAt = nc.DueAt
+	cw, err = svc.repo.UpdateCoursework(ctx, cw)
+	return cw, errors.Wrap(err, "updating coursework")
+}
+
+func (svc *Service) Delete(id string) error {
+	return errors.Wrap(svc.repo.DeleteCoursework(context.Background(), id), "deleting coursework")
+}
+
+// questions returns the Questions of a Coursework in their original order, skipping deleted ones.
+func (svc *Service) questions(ctx context.Context, cw Coursework, exec ...core.DBExecutor) ([]Question, error) {
+	qs, err := svc.repo.QueryQuestions(ctx, QuestionFilter{CourseID: cw.CourseID, IDs: cw.QuestionIDs}, exec...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying questions")
+	}
+	byID := make(map[string]Question, len(qs))
+	for _, q := range qs {
+		byID[q.ID] = q
+	}
+	ordered := make([]Question, 0, len(qs))
+	for _, id := range cw.QuestionIDs {
+		if q, ok := byID[id]; ok {
+			ordered = append(ordered, q)
+		}
+	}
+	return ordered, nil
+}
+
+func (svc *Service) StartAttempt(cw Coursework, studentID string) (Attempt, []PaperQuestion, error) {
+	ctx := context.Background()
+	now := NowFunc().UTC()
+
+	if !cw.IsVirtual() {
+		return Attempt{}, nil, core.NewValidationError(ErrNotVirtual)
+	}
+	if !cw.HasStarted(now) {
+		return Attempt{}, nil, core.NewValidationError(ErrNotStarted)
+	}
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Attempt{}, nil, errors.Wrap(err, "starting transaction")
+	}
+	// concurrent starts wait for the lock, then count the Attempts created meanwhile
+	if cw, err = svc.repo.LockCoursework(ctx, cw.ID, tx); err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, nil, err
+	}
+	if cw.Kind == KindAssignment && cw.MaxAttempts > 0 {
+		cnt, err := svc.repo.CountAttempts(ctx, AttemptFilter{CourseworkID: cw.ID, StudentID: studentID}, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return Attempt{}, nil, errors.Wrap(err, "counting attempts")
+		}
+		if cnt >= cw.MaxAttempts {
+			_ = tx.Rollback()
+			return Attempt{}, nil, core.NewValidationError(ErrMaxAttemptsReached)
+		}
+	}
+
+	qs, err := svc.questions(ctx, cw, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, nil, err
+	}
+	seed, err := newSeed()
+	if err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, nil, errors.Wrap(err, "generating seed")
+	}
+	att, err := svc.repo.CreateAttempt(ctx, Attempt{
+		CourseworkID: cw.ID,
+		StudentID:    studentID,
+		Seed:         seed,
+		Questions:    qs,
+		StartedAt:    now,
+	}, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, nil, errors.Wrap(err, "creating attempt")
+	}
+	if err = tx.Commit(); err != nil {
+		return Attempt{}, nil, errors.Wrap(err, "committing transaction")
+	}
+	return att, BuildPaper(att.Questions, att.Seed), nil
+}
+
+func (svc *Service) GetAttempt(id string) (Attempt, error) {
+	att, err := svc.repo.GetAttempt(context.Background(), id)
+	return att, errors.Wrap(err, "finding attempt by ID")
+}
+
+func (svc *Service) Paper(att Attempt) []PaperQuestion {
+	return BuildPaper(att.Questions, att.Seed)
+}
+
+func (svc *Service) SubmitAttempt(id string, sa SubmitAttempt) (Attempt, error) {
+	ctx := context.Background()
+	now := NowFunc().UTC()
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Attempt{}, errors.Wrap(err, "starting transaction")
+	}
+	// a concurrent submission waits for the lock, then finds the Attempt submitted
+	att, err := svc.repo.LockAttempt(ctx, id, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, err
+	}
+	if att.IsSubmitted() {
+		_ = tx.Rollback()
+		return Attempt{}, core.NewValidationError(ErrAttemptSubmitted)
+	}
+	cw, err := svc.repo.GetCoursework(ctx, att.CourseworkID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, errors.Wrap(err, "finding coursework by ID")
+	}
+
+	att.Answers = sa.Answers
+	att.Score, att.MaxScore = ScoreAnswers(att.Questions, sa.Answers)
+	att.Counted = !cw.GradeFrozen(now)
+	att.SubmittedAt = now
+	if att, err = svc.repo.UpdateAttempt(ctx, att, tx); err != nil {
+		_ = tx.Rollback()
+		return Attempt{}, errors.Wrap(err, "updating attempt")
+	}
+	return att, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) QueryAttempts(filter AttemptFilter) ([]Attempt, error) {
+	atts, err := svc.repo.QueryAttempts(context.Background(), filter)
+	return atts, errors.Wrap(err, "querying attempts")
+}
+
+func (svc *Service) Grades(courseworkID string, studentID ...string) ([]Grade, error) {
+	filter := AttemptFilter{CourseworkID: courseworkID, SubmittedOnly: true}
+	if len(studentID) > 0 {
+		filter.StudentID = studentID[0]
+	}
+	atts, err := svc.QueryAttempts(filter)
+	if err != nil {
+		return nil, err
+	}
+	return computeGrades(atts), nil
+}
+
+// computeGrades keeps the best counted score of each Student; submitted Attempts are expected.
+func computeGrades(atts []Attempt) []Grade {
+	grades := make([]Grade, 0)
+	idx := make(map[string]int) // {studentID: index in grades}
+	for _, att := range atts {
+		i, ok := idx[att.StudentID]
+		if !ok {
+			grades = append(grades, Grade{CourseworkID: att.CourseworkID, StudentID: att.StudentID, MaxScore: att.MaxScore})
+			i = len(grades) - 1
+			idx[att.StudentID] = i
+		}
+		g := &grades[i]
+		g.Attempts++
+		if att.Counted && att.Score >= g.Score {
+			g.Score = att.Score
+			g.MaxScore = att.MaxScore
+		}
+	}
+	return grades
+}
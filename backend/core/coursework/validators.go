@@ -0,0 +1,40 @@
+package coursework
+
+import (
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	singleAnswerText = "exactly one option must be correct"
+	someAnswerText   = "at least one option must be correct"
+
+	dueWithStartTag  = "duewithstart"
+	dueWithStartText = "due date is required when a start date is set"
+
+	dueAfterStartTag  = "dueafterstart"
+	dueAfterStartText = "due date must be after the start date"
+)
+
+// InitValidators registers validators
+func InitValidators(validate *validator.Validate, translator ut.Translator) {
+	validate.RegisterStructValidation(courseworkStructValidation, NewCoursework{})
+	core.RegisterCustomTranslation(validate, translator, dueWithStartTag, dueWithStartText)
+	core.RegisterCustomTranslation(validate, translator, dueAfterStartTag, dueAfterStartText)
+}
+
+// courseworkStructValidation does struct level validation on NewCoursework structs.
+func courseworkStructValidation(sl validator.StructLevel) {
+	switch v := sl.Current().Interface().(type) {
+	case NewCoursework:
+		// when setting the start date of an Assignment, its due date must also be set
+		if v.Kind == KindAssignment && !v.StartsAt.IsZero() && v.DueAt.IsZero() {
+			sl.ReportError(v.DueAt, "due_at", "DueAt", dueWithStartTag, "")
+		}
+		if !v.StartsAt.IsZero() && !v.DueAt.IsZero() && !v.DueAt.After(v.StartsAt) {
+			sl.ReportError(v.DueAt, "due_at", "DueAt", dueAfterStartTag, "")
+		}
+	}
+}
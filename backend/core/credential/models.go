@@ -0,0 +1,25 @@
+package credential
+
+import (
+	htmltmpl "html/template"
+
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+// Document is the data of the `credential-cards` document template.
+type Document struct {
+	School   school.School
+	Class    school.Class
+	LoginURL string
+	Cards    []Card
+}
+
+// Card holds the credentials of a Student; printed & handed over to them.
+type Card struct {
+	Student   user.User
+	Login     string       // username; or email when not set
+	Password  string       // temporary; changed at the first login
+	QRCode    htmltmpl.URL // data URI of the QR code of the login page
+	PageBreak bool         // the card starts a new page
+}
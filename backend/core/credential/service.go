@@ -0,0 +1,139 @@
+package credential
+
+import (
+	"bytes"
+	"encoding/base64"
+	htmltmpl "html/template"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/qrcode"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const (
+	documentTemplate = "credential-cards"
+	cardsPerPage     = 3
+	qrCodeScale      = 8 // pixels per module
+)
+
+type (
+	ServiceInterface interface {
+		// ClassCards sets temporary passwords to Students of a Class, and returns the PDF of their credential cards.
+		// The document is not saved: the passwords are only shown once; their change is audited on behalf of actor.
+		ClassCards(cls school.Class, studentIDs []string, actor core.AuditActor) ([]byte, error)
+	}
+
+	Service struct {
+		conf      *core.Config
+		userSvc   user.ServiceInterface
+		schoolSvc school.ServiceInterface
+		pdf       core.PDFRenderer
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, userSvc user.ServiceInterface, schoolSvc school.ServiceInterface, pdf core.PDFRenderer) *Service {
+	return &Service{conf: conf, userSvc: userSvc, schoolSvc: schoolSvc, pdf: pdf}
+}
+
+func (svc *Service) ClassCards(cls school.Class, studentIDs []string, actor core.AuditActor) ([]byte, error) {
+	ids, err := svc.classStudentIDs(cls, studentIDs)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := svc.schoolSvc.GetSchool(cls.SchoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	tps, err := svc.userSvc.SetTemporaryPasswords(actor, ids...)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting temporary passwords")
+	}
+	sort.SliceStable(tps, func(i, j int) bool { return tps[i].User.Name < tps[j].User.Name })
+
+	doc := Document{School: sch, Class: cls, LoginURL: svc.loginURL(""), Cards: make([]Card, 0, len(tps))}
+	for i, tp := range tps {
+		login := tp.User.Username
+		if login == "" {
+			login = tp.User.Email
+		}
+		qr, err := svc.qrCode(svc.loginURL(login))
+		if err != nil {
+			return nil, err
+		}
+		doc.Cards = append(doc.Cards, Card{
+			Student:   tp.User,
+			Login:     login,
+			Password:  tp.Password,
+			QRCode:    qr,
+			PageBreak: i > 0 && i%cardsPerPage == 0,
+		})
+	}
+	return svc.render(doc)
+}
+
+// classStudentIDs checks that the Students belong to a Class, and returns their distinct IDs.
+func (svc *Service) classStudentIDs(cls school.Class, studentIDs []string) ([]string, error) {
+	classIDs, err := svc.schoolSvc.StudentIDs(cls.ID)
+	if err != nil {
+		return nil, err
+	}
+	enrolled := make(map[string]bool, len(classIDs))
+	for _, id := range classIDs {
+		enrolled[id] = true
+	}
+
+	ids := make([]string, 0, len(studentIDs))
+	seen := make(map[string]bool, len(studentIDs))
+	for _, id := range studentIDs {
+		if !enrolled[id] {
+			return nil, core.NewValidationError(nil, core.FieldError{Field: "student_ids", Error: "invalid value"})
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// loginURL returns the URL of the login page of the frontend; with the login filled in when given.
+func (svc *Service) loginURL(login string) string {
+	u := strings.TrimSuffix(svc.conf.FrontendBaseURL, "/") + "/login"
+	if login != "" {
+		u += "?" + url.Values{"username": {login}}.Encode()
+	}
+	return u
+}
+
+func (svc *Service) qrCode(text string) (htmltmpl.URL, error) {
+	code, err := qrcode.Encode(text)
+	if err != nil {
+		return "", errors.Wrap(err, "encoding qr code")
+	}
+	img, err := code.PNG(qrCodeScale)
+	if err != nil {
+		return "", err
+	}
+	return htmltmpl.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(img)), nil
+}
+
+func (svc *Service) render(doc Document) ([]byte, error) {
+	html, err := core.RenderDocument(documentTemplate, doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering document")
+	}
+	var buff bytes.Buffer
+	if err = svc.pdf.Render(&buff, html); err != nil {
+		return nil, errors.Wrap(err, "rendering pdf")
+	}
+	return buff.Bytes(), nil
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"bytes"
+	htmltmpl "html/template"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/fs"
+)
+
+var documentTemplates map[string]*htmltmpl.Template
+
+// PDFRenderer is any service that can convert HTML documents to PDF
+type PDFRenderer interface {
+	Render(w io.Writer, html string) error
+}
+
+// RenderDocument executes the document template of the given name (without ext) with data.
+func RenderDocument(name string, data interface{}) (string, error) {
+	tmpl, ok := documentTemplates[name]
+	if !ok {
+		return "", errors.Errorf("document template %q not found", name)
+	}
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, data); err != nil {
+		return "", errors.Wrap(err, "executing template")
+	}
+	return buff.String(), nil
+}
+
+func ParseDocumentTemplates(logger Logger) {
+	documentTemplates = make(map[string]*htmltmpl.Template)
+
+	rp := "assets/templates/document/"
+	fps, err := fs.Glob(appfs.FS, rp+"*.gohtml")
+	if err != nil {
+		logger.Fatal(errors.Wrap(err, "globbing").Error(), err)
+	}
+
+	for _, fp := range fps {
+		fname := filepath.Base(fp)
+		if strings.HasPrefix(fname, "base") {
+			continue
+		}
+		tmpl, err := htmltmpl.ParseFS(appfs.FS, rp+"base.gohtml", fp)
+		if err != nil {
+			logger.Fatal(errors.Wrap(err, "parsing .gohtml files").Error(), err)
+		}
+		documentTemplates[strings.TrimSuffix(fname, ".gohtml")] = tmpl
+	}
+}
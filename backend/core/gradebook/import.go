@@ -0,0 +1,162 @@
+package gradebook
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	defaultStudentColumns = []string{"username", "email"}
+	defaultScoreColumn    = "score"
+)
+
+// ReadSheet returns the rows of a CSV file or of the first sheet of an XLSX file.
+func ReadSheet(filename string, r io.Reader) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return readCSV(r)
+	case ".xlsx":
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, core.NewValidationError(err, core.FieldError{Field: "file", Error: "invalid XLSX file"})
+		}
+		defer f.Close()
+		rows, err := f.GetRows(f.GetSheetName(0))
+		return rows, errors.Wrap(err, "reading sheet rows")
+	default:
+		return nil, core.NewValidationError(ErrUnsupportedSheet, core.FieldError{Field: "file", Error: "only CSV and XLSX files are supported"})
+	}
+}
+
+// readCSV reads comma or semicolon separated values; the latter are common in French locales.
+func readCSV(r io.Reader) ([][]string, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file")
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")) // UTF-8 BOM
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	firstLine := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		firstLine = data[:i]
+	}
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, core.NewValidationError(err, core.FieldError{Field: "file", Error: "invalid CSV file"})
+	}
+	return rows, nil
+}
+
+// DiffImport maps spreadsheet rows to Students and compares their scores to the current Marks.
+// The first non-empty row is the header; rows without a student or a score are skipped.
+// students maps lowercase usernames and emails to Student IDs; current maps Student IDs to their scores.
+func DiffImport(rows [][]string, cols ImportColumns, students map[string]string, current map[string]float64, maxScore float64) ([]ImportEntry, error) {
+	header := 0
+	for header < len(rows) && isBlankRow(rows[header]) {
+		header++
+	}
+	if header == len(rows) {
+		return nil, core.NewValidationError(nil, core.FieldError{Field: "file", Error: "no rows found"})
+	}
+
+	studentCol, scoreCol := -1, -1
+	studentNames := defaultStudentColumns
+	if cols.Student != "" {
+		studentNames = []string{cols.Student}
+	}
+	scoreName := defaultScoreColumn
+	if cols.Score != "" {
+		scoreName = cols.Score
+	}
+	for i, name := range rows[header] {
+		name = core.CleanString(name)
+		for _, n := range studentNames {
+			if studentCol < 0 && strings.EqualFold(name, n) {
+				studentCol = i
+			}
+		}
+		if scoreCol < 0 && strings.EqualFold(name, scoreName) {
+			scoreCol = i
+		}
+	}
+	if studentCol < 0 {
+		return nil, core.NewValidationError(nil, core.FieldError{Field: "student_column", Error: "column not found"})
+	}
+	if scoreCol < 0 {
+		return nil, core.NewValidationError(nil, core.FieldError{Field: "score_column", Error: "column not found"})
+	}
+
+	entries := make([]ImportEntry, 0, len(rows)-header-1)
+	seen := make(map[string]int)
+	for i := header + 1; i < len(rows); i++ {
+		line := i + 1
+		student, rawScore := cell(rows[i], studentCol), cell(rows[i], scoreCol)
+		if student == "" || rawScore == "" {
+			continue
+		}
+
+		score, err := strconv.ParseFloat(strings.Replace(rawScore, ",", ".", 1), 64) // decimal comma
+		if err != nil || score < 0 || score > maxScore {
+			return nil, core.NewValidationError(err, core.FieldError{
+				Field: "file",
+				Error: fmt.Sprintf("line %d: score must be a number between 0 and %v", line, maxScore),
+			})
+		}
+
+		entry := ImportEntry{Line: line, Student: student, Score: score, Status: EntryUnknown}
+		if id, ok := students[strings.ToLower(student)]; ok {
+			if prev, ok := seen[id]; ok {
+				return nil, core.NewValidationError(nil, core.FieldError{
+					Field: "file",
+					Error: fmt.Sprintf("line %d: student already found on line %d", line, prev),
+				})
+			}
+			seen[id] = line
+			entry.StudentID = id
+			entry.Status = EntryNew
+			if prev, ok := current[id]; ok {
+				entry.Previous = &prev
+				entry.Status = EntryChanged
+				if prev == score {
+					entry.Status = EntryUnchanged
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func cell(row []string, col int) string {
+	if col < len(row) {
+		return core.CleanString(row[col])
+	}
+	return ""
+}
+
+func isBlankRow(row []string) bool {
+	for _, c := range row {
+		if core.CleanString(c) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package gradebook
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffImport(t *testing.T) {
+	// semicolon separated, with decimal commas, as exported by French spreadsheets
+	csv := "\xef\xbb\xbf" + `Nom;Email;Note
+Student 1;s1@test.cd;15
+Student 2;S2@TEST.CD;12,5
+Student 3;s3@test.cd;10
+Stranger;x@test.cd;20
+Absent;s4@test.cd;
+`
+	rows, err := ReadSheet("marks.csv", strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	students := map[string]string{"s1@test.cd": "s1", "s2@test.cd": "s2", "s3@test.cd": "s3", "s4@test.cd": "s4"}
+	current := map[string]float64{"s2": 11, "s3": 10}
+	entries, err := DiffImport(rows, ImportColumns{Score: "note"}, students, current, 20)
+	if err != nil {
+		t.Fatalf("DiffImport() error = %v", err)
+	}
+
+	tests := []struct {
+		line         int
+		studentID    string
+		status       string
+		score        float64
+		wantPrevious bool
+	}{
+		{line: 2, studentID: "s1", status: EntryNew, score: 15},
+		{line: 3, studentID: "s2", status: EntryChanged, score: 12.5, wantPrevious: true},
+		{line: 4, studentID: "s3", status: EntryUnchanged, score: 10, wantPrevious: true},
+		{line: 5, status: EntryUnknown, score: 20},
+	}
+	if len(entries) != len(tests) {
+		t.Fatalf("DiffImport() returned %d entries, want %d: %+v", len(entries), len(tests), entries)
+	}
+	for i, tt := range tests {
+		e := entries[i]
+		if e.Line != tt.line || e.StudentID != tt.studentID || e.Status != tt.status || e.Score != tt.score || (e.Previous != nil) != tt.wantPrevious {
+			t.Errorf("entries[%d] = %+v; want %+v", i, e, tt)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		rows [][]string
+		cols ImportColumns
+	}{
+		{name: "no rows", rows: [][]string{{"", ""}}},
+		{name: "missing student column", rows: [][]string{{"name", "score"}}},
+		{name: "missing score column", rows: [][]string{{"username", "note"}}},
+		{name: "score too high", rows: [][]string{{"username", "score"}, {"s1@test.cd", "21"}}},
+		{name: "invalid score", rows: [][]string{{"username", "score"}, {"s1@test.cd", "A"}}},
+		{name: "duplicate student", rows: [][]string{{"email", "score"}, {"s1@test.cd", "1"}, {"S1@test.cd", "2"}}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DiffImport(tt.rows, tt.cols, students, current, 20); err == nil {
+				t.Error("DiffImport() error = nil; want validation error")
+			}
+		})
+	}
+
+	if _, err = ReadSheet("marks.pdf", strings.NewReader("")); err == nil {
+		t.Error("ReadSheet(.pdf) error = nil; want validation error")
+	}
+}
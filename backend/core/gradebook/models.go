@@ -0,0 +1,161 @@
+package gradebook
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Mark kinds
+const (
+	KindVirtual  = "virtual"  // automatically updated by virtual Coursework
+	KindOfficial = "official" // entered by the Teacher
+)
+
+// Category groups the Assessments of a Course for a Term; eg. "Interrogations", "Examens".
+// The average of a Course is the weighted average of its Categories.
+type Category struct {
+	ID        string    `json:"id"` // UUID
+	CourseID  string    `json:"course_id"`
+	TermID    string    `json:"term_id"`
+	Name      string    `json:"name"`
+	Weight    float64   `json:"weight"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// Assessment is anything marked in a Category.
+// Assessments linked to a Coursework hold virtual Marks, the others hold official Marks.
+type Assessment struct {
+	ID           string    `json:"id"` // UUID
+	CategoryID   string    `json:"category_id"`
+	CourseworkID string    `json:"coursework_id,omitempty"`
+	Title        string    `json:"title"`
+	MaxScore     float64   `json:"max_score"`
+	CreatedAt    time.Time `json:"created_at"` // UTC
+	UpdatedAt    time.Time `json:"updated_at"` // UTC
+}
+
+func (a Assessment) Kind() string {
+	if a.CourseworkID != "" {
+		return KindVirtual
+	}
+	return KindOfficial
+}
+
+type Mark struct {
+	AssessmentID   string    `json:"assessment_id"`
+	StudentID      string    `json:"student_id"`
+	Score          float64   `json:"score"`
+	PublishedScore *float64  `json:"published_score"` // as of the last publication of the Marks; the one Students see
+	CreatedAt      time.Time `json:"created_at"`      // UTC
+	UpdatedAt      time.Time `json:"updated_at"`      // UTC
+}
+
+// NewCategory contains information needed to create a new Category.
+type NewCategory struct {
+	TermID string  `json:"term_id" validate:"required,uuid"`
+	Name   string  `json:"name" validate:"required"`
+	Weight float64 `json:"weight" validate:"gt=0"`
+}
+
+func (nc *NewCategory) Validate(validate *validator.Validate) error {
+	nc.Name = core.CleanString(nc.Name)
+	return validate.Struct(nc)
+}
+
+// NewAssessment contains information needed to create a new Assessment.
+type NewAssessment struct {
+	CategoryID   string  `json:"category_id" validate:"required,uuid"`
+	CourseworkID string  `json:"coursework_id" validate:"omitempty,uuid"`
+	Title        string  `json:"title" validate:"required"`
+	MaxScore     float64 `json:"max_score" validate:"gt=0"`
+}
+
+func (na *NewAssessment) Validate(validate *validator.Validate) error {
+	na.Title = core.CleanString(na.Title)
+	return validate.Struct(na)
+}
+
+type MarkEntry struct {
+	StudentID string  `json:"student_id" validate:"required,uuid"`
+	Score     float64 `json:"score" validate:"min=0"`
+}
+
+// EnterMarks contains the official Marks entered by a Teacher for an Assessment.
+type EnterMarks struct {
+	Marks []MarkEntry `json:"marks" validate:"required,dive"`
+}
+
+func (em *EnterMarks) Validate(validate *validator.Validate) error {
+	return validate.Struct(em)
+}
+
+type CategoryFilter struct {
+	CourseIDs []string
+	TermID    string
+}
+
+type AssessmentFilter struct {
+	CourseIDs    []string
+	TermID       string
+	CategoryID   string
+	CourseworkID string
+}
+
+type MarkFilter struct {
+	AssessmentIDs []string
+	StudentID     string
+}
+
+// MarkImport statuses
+const (
+	ImportPending = "pending" // previewed, waiting for confirmation
+	ImportApplied = "applied"
+	ImportUndone  = "undone"
+)
+
+// ImportEntry statuses, compared to the current Marks
+const (
+	EntryNew       = "new"
+	EntryChanged   = "changed"
+	EntryUnchanged = "unchanged"
+	EntryUnknown   = "unknown" // not a Student of the Class
+)
+
+// MarkImport is an upload of official Marks from a spreadsheet, kept as history so it can be undone.
+type MarkImport struct {
+	ID           string        `json:"id"` // UUID
+	AssessmentID string        `json:"assessment_id"`
+	AuthorID     string        `json:"author_id"`
+	Filename     string        `json:"filename"`
+	Status       string        `json:"status"`
+	Entries      []ImportEntry `json:"entries"`
+	AppliedAt    time.Time     `json:"applied_at"` // UTC
+	UndoneAt     time.Time     `json:"undone_at"`  // UTC
+	CreatedAt    time.Time     `json:"created_at"` // UTC
+	UpdatedAt    time.Time     `json:"updated_at"` // UTC
+}
+
+// ImportEntry is a spreadsheet row, diffed against the Mark of the Student.
+type ImportEntry struct {
+	Line      int      `json:"line"`
+	Student   string   `json:"student"` // username or email, as found in the spreadsheet
+	StudentID string   `json:"student_id,omitempty"`
+	Status    string   `json:"status"`
+	Score     float64  `json:"score"`
+	Previous  *float64 `json:"previous"` // nil when the Student had no Mark
+}
+
+// ImportColumns maps the header of spreadsheet columns to Students and scores.
+type ImportColumns struct {
+	Student string `form:"student_column"` // column of usernames or emails; defaults to "username" or "email"
+	Score   string `form:"score_column"`   // defaults to "score"
+}
+
+func (ic *ImportColumns) Clean() {
+	ic.Student = core.CleanString(ic.Student)
+	ic.Score = core.CleanString(ic.Score)
+}
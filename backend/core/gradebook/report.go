@@ -0,0 +1,138 @@
+package gradebook
+
+import (
+	"math"
+	"sort"
+
+	"github.com/trezcool/masomo/core/school"
+)
+
+type CategoryAverage struct {
+	CategoryID string   `json:"category_id"`
+	Name       string   `json:"name"`
+	Weight     float64  `json:"weight"`
+	Average    *float64 `json:"average"` // percentage; nil when not marked yet
+}
+
+type CourseAverage struct {
+	CourseID   string            `json:"course_id"`
+	Name       string            `json:"name"`
+	Average    *float64          `json:"average"` // percentage; nil when not marked yet
+	Categories []CategoryAverage `json:"categories"`
+}
+
+// Report is the Term report of a Student.
+type Report struct {
+	StudentID string          `json:"student_id"`
+	ClassID   string          `json:"class_id"`
+	TermID    string          `json:"term_id"`
+	Average   *float64        `json:"average"` // percentage; nil when not marked yet
+	Rank      int             `json:"rank"`    // within the Class; 0 when not marked yet
+	ClassSize int             `json:"class_size"`
+	Courses   []CourseAverage `json:"courses"`
+}
+
+// BuildReports computes the Term Reports of the Students of a Class from their Marks,
+// and ranks them by Term average. Students with the same average share the same rank.
+//   - Category average: total score / total max score of the marked Assessments
+//   - Course average: weighted average of the marked Categories
+//   - Term average: average of the marked Courses
+func BuildReports(
+	classID, termID string,
+	studentIDs []string,
+	courses []school.Course,
+	categories []Category,
+	assessments []Assessment,
+	marks []Mark,
+) []Report {
+	type total struct{ score, max float64 }
+
+	assmtByID := make(map[string]Assessment, len(assessments))
+	for _, a := range assessments {
+		assmtByID[a.ID] = a
+	}
+	totals := make(map[string]map[string]*total) // {studentID: {categoryID: total}}
+	for _, m := range marks {
+		a, ok := assmtByID[m.AssessmentID]
+		if !ok {
+			continue
+		}
+		if totals[m.StudentID] == nil {
+			totals[m.StudentID] = make(map[string]*total)
+		}
+		t := totals[m.StudentID][a.CategoryID]
+		if t == nil {
+			t = new(total)
+			totals[m.StudentID][a.CategoryID] = t
+		}
+		t.score += m.Score
+		t.max += a.MaxScore
+	}
+
+	catsByCourse := make(map[string][]Category)
+	for _, c := range categories {
+		catsByCourse[c.CourseID] = append(catsByCourse[c.CourseID], c)
+	}
+
+	reports := make([]Report, 0, len(studentIDs))
+	for _, sid := range studentIDs {
+		rep := Report{
+			StudentID: sid,
+			ClassID:   classID,
+			TermID:    termID,
+			ClassSize: len(studentIDs),
+			Courses:   make([]CourseAverage, 0, len(courses)),
+		}
+		var termSum float64
+		var termCnt int
+		for _, crs := range courses {
+			ca := CourseAverage{CourseID: crs.ID, Name: crs.Name, Categories: make([]CategoryAverage, 0)}
+			var wSum, wTotal float64
+			for _, cat := range catsByCourse[crs.ID] {
+				cavg := CategoryAverage{CategoryID: cat.ID, Name: cat.Name, Weight: cat.Weight}
+				if t := totals[sid][cat.ID]; t != nil && t.max > 0 {
+					cavg.Average = percentage(t.score / t.max * 100)
+					wSum += *cavg.Average * cat.Weight
+					wTotal += cat.Weight
+				}
+				ca.Categories = append(ca.Categories, cavg)
+			}
+			if wTotal > 0 {
+				ca.Average = percentage(wSum / wTotal)
+				termSum += *ca.Average
+				termCnt++
+			}
+			rep.Courses = append(rep.Courses, ca)
+		}
+		if termCnt > 0 {
+			rep.Average = percentage(termSum / float64(termCnt))
+		}
+		reports = append(reports, rep)
+	}
+
+	rankReports(reports)
+	return reports
+}
+
+// rankReports sets the competition rank ("1224") of marked Reports.
+func rankReports(reports []Report) {
+	ranked := make([]*Report, 0, len(reports))
+	for i := range reports {
+		if reports[i].Average != nil {
+			ranked = append(ranked, &reports[i])
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return *ranked[i].Average > *ranked[j].Average })
+	for i, rep := range ranked {
+		if i > 0 && *rep.Average == *ranked[i-1].Average {
+			rep.Rank = ranked[i-1].Rank
+		} else {
+			rep.Rank = i + 1
+		}
+	}
+}
+
+func percentage(p float64) *float64 {
+	p = math.Round(p*100) / 100
+	return &p
+}
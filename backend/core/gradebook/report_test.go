@@ -0,0 +1,89 @@
+package gradebook
+
+import (
+	"testing"
+
+	"github.com/trezcool/masomo/core/school"
+)
+
+func TestBuildReports(t *testing.T) {
+	courses := []school.Course{{ID: "maths", Name: "Maths"}, {ID: "french", Name: "French"}}
+	categories := []Category{
+		{ID: "m-tests", CourseID: "maths", Weight: 1},
+		{ID: "m-exams", CourseID: "maths", Weight: 3},
+		{ID: "f-tests", CourseID: "french", Weight: 1},
+	}
+	assessments := []Assessment{
+		{ID: "m-t1", CategoryID: "m-tests", MaxScore: 10},
+		{ID: "m-t2", CategoryID: "m-tests", MaxScore: 10},
+		{ID: "m-e1", CategoryID: "m-exams", MaxScore: 40},
+		{ID: "f-t1", CategoryID: "f-tests", MaxScore: 20},
+	}
+	marks := []Mark{
+		// s1: maths = (50% * 1 + 75% * 3) / 4 = 68.75; french = 50; term = 59.38
+		{AssessmentID: "m-t1", StudentID: "s1", Score: 5},
+		{AssessmentID: "m-t2", StudentID: "s1", Score: 5},
+		{AssessmentID: "m-e1", StudentID: "s1", Score: 30},
+		{AssessmentID: "f-t1", StudentID: "s1", Score: 10},
+		// s2: maths = 100 (exams not marked yet); french = 18.75 -> term = 59.38
+		{AssessmentID: "m-t1", StudentID: "s2", Score: 10},
+		{AssessmentID: "f-t1", StudentID: "s2", Score: 3.75},
+		// s3: french = 100
+		{AssessmentID: "f-t1", StudentID: "s3", Score: 20},
+		// unknown assessment
+		{AssessmentID: "other", StudentID: "s4", Score: 20},
+	}
+
+	reports := BuildReports("cls", "term", []string{"s1", "s2", "s3", "s4"}, courses, categories, assessments, marks)
+	if len(reports) != 4 {
+		t.Fatalf("BuildReports() returned %d reports, want 4", len(reports))
+	}
+
+	tests := []struct {
+		studentID   string
+		wantAverage *float64
+		wantRank    int
+		wantMaths   *float64
+	}{
+		{studentID: "s1", wantAverage: fPtr(59.38), wantRank: 2, wantMaths: fPtr(68.75)},
+		{studentID: "s2", wantAverage: fPtr(59.38), wantRank: 2, wantMaths: fPtr(100)},
+		{studentID: "s3", wantAverage: fPtr(100), wantRank: 1},
+		{studentID: "s4"},
+	}
+	for i, tt := range tests {
+		t.Run(tt.studentID, func(t *testing.T) {
+			rep := reports[i]
+			if rep.StudentID != tt.studentID || rep.ClassID != "cls" || rep.TermID != "term" || rep.ClassSize != 4 {
+				t.Fatalf("unexpected report %+v", rep)
+			}
+			if !fEqual(rep.Average, tt.wantAverage) {
+				t.Errorf("Average = %v, want %v", fStr(rep.Average), fStr(tt.wantAverage))
+			}
+			if rep.Rank != tt.wantRank {
+				t.Errorf("Rank = %v, want %v", rep.Rank, tt.wantRank)
+			}
+			if len(rep.Courses) != 2 || rep.Courses[0].CourseID != "maths" {
+				t.Fatalf("unexpected courses %+v", rep.Courses)
+			}
+			if !fEqual(rep.Courses[0].Average, tt.wantMaths) {
+				t.Errorf("Maths average = %v, want %v", fStr(rep.Courses[0].Average), fStr(tt.wantMaths))
+			}
+		})
+	}
+}
+
+func fPtr(f float64) *float64 { return &f }
+
+func fEqual(f1, f2 *float64) bool {
+	if f1 == nil || f2 == nil {
+		return f1 == f2
+	}
+	return *f1 == *f2
+}
+
+func fStr(f *float64) interface{} {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
@@ -0,0 +1,579 @@
+package gradebook
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrCategoryNotFound   = errors.New("category not found")
+	ErrAssessmentNotFound = errors.New("assessment not found")
+	ErrImportNotFound     = errors.New("import not found")
+
+	ErrVirtualAssessment = errors.New("virtual marks are updated automatically")
+	ErrUnsupportedSheet  = errors.New("unsupported spreadsheet format")
+	ErrImportNotPending  = errors.New("import already applied or undone")
+	ErrImportNotApplied  = errors.New("import not applied")
+	ErrImportOutdated    = errors.New("marks changed since the import was applied")
+	errInvalidValue      = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateCategory(ctx context.Context, cat Category, exec ...core.DBExecutor) (Category, error)
+		// QueryCategories returns all Categories or filters them by applying AND operation on available CategoryFilter fields.
+		QueryCategories(ctx context.Context, filter CategoryFilter, exec ...core.DBExecutor) ([]Category, error)
+		GetCategory(ctx context.Context, id string, exec ...core.DBExecutor) (Category, error)
+		DeleteCategory(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		CreateAssessment(ctx context.Context, a Assessment, exec ...core.DBExecutor) (Assessment, error)
+		// QueryAssessments returns all Assessments or filters them by applying AND operation on available AssessmentFilter fields.
+		QueryAssessments(ctx context.Context, filter AssessmentFilter, exec ...core.DBExecutor) ([]Assessment, error)
+		GetAssessment(ctx context.Context, id string, exec ...core.DBExecutor) (Assessment, error)
+		DeleteAssessment(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		// SaveMarks inserts new Marks and updates existing ones.
+		SaveMarks(ctx context.Context, marks []Mark, exec ...core.DBExecutor) error
+		QueryMarks(ctx context.Context, filter MarkFilter, exec ...core.DBExecutor) ([]Mark, error)
+		DeleteMarks(ctx context.Context, assessmentID string, studentIDs []string, exec ...core.DBExecutor) error
+
+		CreateImport(ctx context.Context, imp MarkImport, exec ...core.DBExecutor) (MarkImport, error)
+		// QueryImports returns the MarkImports of an Assessment, most recent first.
+		QueryImports(ctx context.Context, assessmentID string, exec ...core.DBExecutor) ([]MarkImport, error)
+		GetImport(ctx context.Context, id string, exec ...core.DBExecutor) (MarkImport, error)
+		// LockImport gets a MarkImport and locks it (`FOR UPDATE`) until the end of the sql.Tx passed as exec.
+		LockImport(ctx context.Context, id string, exec ...core.DBExecutor) (MarkImport, error)
+		UpdateImport(ctx context.Context, imp MarkImport, exec ...core.DBExecutor) (MarkImport, error)
+	}
+
+	ServiceInterface interface {
+		CreateCategory(courseID string, nc NewCategory) (Category, error)
+		QueryCategories(courseID, termID string) ([]Category, error)
+		GetCategory(id string) (Category, error)
+		DeleteCategory(id string) error
+
+		CreateAssessment(courseID string, na NewAssessment) (Assessment, error)
+		QueryAssessments(courseID, termID string) ([]Assessment, error)
+		GetAssessment(id string) (Assessment, error)
+		DeleteAssessment(id string) error
+
+		// EnterMarks saves the official Marks of an Assessment.
+		EnterMarks(a Assessment, em EnterMarks) ([]Mark, error)
+		// SyncVirtualMarks updates the virtual Marks of the Assessments linked to a Coursework from its Grades.
+		SyncVirtualMarks(courseworkID string, studentID ...string) error
+		// CourseMarks returns the Marks of a Course for a Term; only the published ones when publishedOnly.
+		CourseMarks(course school.Course, termID string, publishedOnly bool, studentID ...string) ([]Mark, error)
+		// ClassReports returns the ranked Term Reports of the Students of a Class.
+		ClassReports(cls school.Class, termID string, publishedOnly bool) ([]Report, error)
+
+		// PreviewImport saves a pending MarkImport of official Marks read from spreadsheet rows.
+		PreviewImport(a Assessment, authorID, filename string, rows [][]string, cols ImportColumns) (MarkImport, error)
+		// ApplyImport saves the new and changed Marks of a pending MarkImport.
+		ApplyImport(imp MarkImport) (MarkImport, error)
+		// UndoImport restores the Marks replaced by an applied MarkImport.
+		UndoImport(imp MarkImport) (MarkImport, error)
+		QueryImports(assessmentID string) ([]MarkImport, error)
+		GetImport(id string) (MarkImport, error)
+	}
+
+	Service struct {
+		db            core.DB
+		repo          Repository
+		schoolSvc     school.ServiceInterface
+		courseworkSvc coursework.ServiceInterface
+		userSvc       user.ServiceInterface
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	courseworkSvc coursework.ServiceInterface,
+	userSvc user.ServiceInterface,
+) *Service {
+	return &Service{
+		db:            db,
+		repo:          repo,
+		schoolSvc:     schoolSvc,
+		courseworkSvc: courseworkSvc,
+		userSvc:       userSvc,
+	}
+}
+
+func (svc *Service) CreateCategory(courseID string, nc NewCategory) (Category, error) {
+	// the Term must belong to the School of the Course
+	crs, err := svc.schoolSvc.GetCourse(courseID)
+	if err != nil {
+		return Category{}, err
+	}
+	cls, err := svc.schoolSvc.GetClass(crs.ClassID)
+	if err != nil {
+		return Category{}, err
+	}
+	term, err := svc.schoolSvc.GetTerm(nc.TermID)
+	if err != nil {
+		if errors.Cause(err) == school.ErrTermNotFound {
+			return Category{}, core.NewValidationError(err, core.FieldError{Field: "term_id", Error: errInvalidValue})
+		}
+		return Category{}, err
+	}
+	if term.SchoolID != cls.SchoolID {
+		return Category{}, core.NewValidationError(nil, core.FieldError{Field: "term_id", Error: errInvalidValue})
+	}
+
+	cat, err := svc.repo.CreateCategory(context.Background(), Category{
+		CourseID: courseID,
+		TermID:   nc.TermID,
+		Name:     nc.Name,
+		Weight:   nc.Weight,
+	})
+	return cat, errors.Wrap(err, "creating category")
+}
+
+func (svc *Service) QueryCategories(courseID, termID string) ([]Category, error) {
+	cats, err := svc.repo.QueryCategories(context.Background(), CategoryFilter{CourseIDs: []string{courseID}, TermID: termID})
+	return cats, errors.Wrap(err, "querying categories")
+}
+
+func (svc *Service) GetCategory(id string) (Category, error) {
+	cat, err := svc.repo.GetCategory(context.Background(), id)
+	return cat, errors.Wrap(err, "finding category by ID")
+}
+
+func (svc *Service) DeleteCategory(id string) error {
+	return errors.Wrap(svc.repo.DeleteCategory(context.Background(), id), "deleting category")
+}
+
+func (svc *Service) CreateAssessment(courseID string, na NewAssessment) (Assessment, error) {
+	ctx := context.Background()
+
+	cat, err := svc.repo.GetCategory(ctx, na.CategoryID)
+	if err != nil && err != ErrCategoryNotFound {
+		return Assessment{}, errors.Wrap(err, "finding category by ID")
+	}
+	if err == ErrCategoryNotFound || cat.CourseID != courseID {
+		return Assessment{}, core.NewValidationError(err, core.FieldError{Field: "category_id", Error: errInvalidValue})
+	}
+	if na.CourseworkID != "" {
+		cw, err := svc.courseworkSvc.GetByID(na.CourseworkID)
+		if err != nil && errors.Cause(err) != coursework.ErrCourseworkNotFound {
+			return Assessment{}, err
+		}
+		if err != nil || cw.CourseID != courseID || !cw.IsVirtual() {
+			return Assessment{}, core.NewValidationError(nil, core.FieldError{Field: "coursework_id", Error: errInvalidValue})
+		}
+	}
+
+	a, err := svc.repo.CreateAssessment(ctx, Assessment{
+		CategoryID:   na.CategoryID,
+		CourseworkID: na.CourseworkID,
+		Title:        na.Title,
+		MaxScore:     na.MaxScore,
+	})
+	if err != nil {
+		return Assessment{}, errors.Wrap(err, "creating assessment")
+	}
+
+	// import the Grades already obtained
+	if a.Kind() == KindVirtual {
+		if err = svc.syncVirtualMarks(ctx, a); err != nil {
+			return Assessment{}, err
+		}
+	}
+	return a, nil
+}
+
+func (svc *Service) QueryAssessments(courseID, termID string) ([]Assessment, error) {
+	as, err := svc.repo.QueryAssessments(context.Background(), AssessmentFilter{CourseIDs: []string{courseID}, TermID: termID})
+	return as, errors.Wrap(err, "querying assessments")
+}
+
+func (svc *Service) GetAssessment(id string) (Assessment, error) {
+	a, err := svc.repo.GetAssessment(context.Background(), id)
+	return a, errors.Wrap(err, "finding assessment by ID")
+}
+
+func (svc *Service) DeleteAssessment(id string) error {
+	return errors.Wrap(svc.repo.DeleteAssessment(context.Background(), id), "deleting assessment")
+}
+
+func (svc *Service) EnterMarks(a Assessment, em EnterMarks) ([]Mark, error) {
+	ctx := context.Background()
+
+	if a.Kind() == KindVirtual {
+		return nil, core.NewValidationError(ErrVirtualAssessment)
+	}
+
+	// only the Students of the Class can be marked
+	studentIDs, err := svc.studentIDs(a)
+	if err != nil {
+		return nil, err
+	}
+	enrolled := make(map[string]bool, len(studentIDs))
+	for _, id := range studentIDs {
+		enrolled[id] = true
+	}
+
+	marks := make([]Mark, 0, len(em.Marks))
+	for _, entry := range em.Marks {
+		if !enrolled[entry.StudentID] {
+			return nil, core.NewValidationError(nil, core.FieldError{Field: "student_id", Error: errInvalidValue})
+		}
+		if entry.Score > a.MaxScore {
+			return nil, core.NewValidationError(nil, core.FieldError{Field: "score", Error: "must be less than or equal to the max score"})
+		}
+		marks = append(marks, Mark{AssessmentID: a.ID, StudentID: entry.StudentID, Score: entry.Score})
+	}
+
+	if err = svc.saveMarks(ctx, marks); err != nil {
+		return nil, err
+	}
+	marks, err = svc.repo.QueryMarks(ctx, MarkFilter{AssessmentIDs: []string{a.ID}})
+	return marks, errors.Wrap(err, "querying marks")
+}
+
+// studentIDs returns the IDs of the Students of the Class of the Course of an Assessment.
+func (svc *Service) studentIDs(a Assessment) ([]string, error) {
+	cat, err := svc.GetCategory(a.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := svc.schoolSvc.GetCourse(cat.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	return svc.schoolSvc.StudentIDs(crs.ClassID)
+}
+
+// saveMarks saves all marks or none.
+func (svc *Service) saveMarks(ctx context.Context, marks []Mark) error {
+	if len(marks) == 0 {
+		return nil
+	}
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if err = svc.repo.SaveMarks(ctx, marks, tx); err != nil {
+		_ = tx.Rollback()
+		return errors.Wrap(err, "saving marks")
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) SyncVirtualMarks(courseworkID string, studentID ...string) error {
+	ctx := context.Background()
+	as, err := svc.repo.QueryAssessments(ctx, AssessmentFilter{CourseworkID: courseworkID})
+	if err != nil {
+		return errors.Wrap(err, "querying assessments")
+	}
+	for _, a := range as {
+		if err = svc.syncVirtualMarks(ctx, a, studentID...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncVirtualMarks scales the Grades of the Coursework of a virtual Assessment to its max score.
+func (svc *Service) syncVirtualMarks(ctx context.Context, a Assessment, studentID ...string) error {
+	grades, err := svc.courseworkSvc.Grades(a.CourseworkID, studentID...)
+	if err != nil {
+		return err
+	}
+	marks := make([]Mark, 0, len(grades))
+	for _, g := range grades {
+		if g.MaxScore <= 0 {
+			continue
+		}
+		score := math.Round(g.Score/g.MaxScore*a.MaxScore*100) / 100
+		marks = append(marks, Mark{AssessmentID: a.ID, StudentID: g.StudentID, Score: score})
+	}
+	return svc.saveMarks(ctx, marks)
+}
+
+func (svc *Service) CourseMarks(course school.Course, termID string, publishedOnly bool, studentID ...string) ([]Mark, error) {
+	ctx := context.Background()
+
+	as, err := svc.repo.QueryAssessments(ctx, AssessmentFilter{CourseIDs: []string{course.ID}, TermID: termID})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying assessments")
+	}
+	if len(as) == 0 {
+		return []Mark{}, nil
+	}
+	filter := MarkFilter{AssessmentIDs: make([]string, 0, len(as))}
+	for _, a := range as {
+		filter.AssessmentIDs = append(filter.AssessmentIDs, a.ID)
+	}
+	if len(studentID) > 0 {
+		filter.StudentID = studentID[0]
+	}
+	marks, err := svc.repo.QueryMarks(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying marks")
+	}
+
+	if publishedOnly {
+		marks = publishedMarks(marks)
+	}
+	return marks, nil
+}
+
+func (svc *Service) ClassReports(cls school.Class, termID string, publishedOnly bool) ([]Report, error) {
+	ctx := context.Background()
+
+	studentIDs, err := svc.schoolSvc.StudentIDs(cls.ID)
+	if err != nil {
+		return nil, err
+	}
+	courses, err := svc.schoolSvc.QueryCourses(school.CourseFilter{ClassID: cls.ID})
+	if err != nil {
+		return nil, err
+	}
+	courseIDs := make([]string, 0, len(courses))
+	for _, crs := range courses {
+		courseIDs = append(courseIDs, crs.ID)
+	}
+
+	var (
+		cats  []Category
+		as    []Assessment
+		marks []Mark
+	)
+	if len(courseIDs) > 0 {
+		cats, err = svc.repo.QueryCategories(ctx, CategoryFilter{CourseIDs: courseIDs, TermID: termID})
+		if err != nil {
+			return nil, errors.Wrap(err, "querying categories")
+		}
+		as, err = svc.repo.QueryAssessments(ctx, AssessmentFilter{CourseIDs: courseIDs, TermID: termID})
+		if err != nil {
+			return nil, errors.Wrap(err, "querying assessments")
+		}
+	}
+	if len(as) > 0 {
+		filter := MarkFilter{AssessmentIDs: make([]string, 0, len(as))}
+		for _, a := range as {
+			filter.AssessmentIDs = append(filter.AssessmentIDs, a.ID)
+		}
+		marks, err = svc.repo.QueryMarks(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "querying marks")
+		}
+	}
+	if publishedOnly {
+		marks = publishedMarks(marks)
+	}
+
+	return BuildReports(cls.ID, termID, studentIDs, courses, cats, as, marks), nil
+}
+
+// publishedMarks returns the published Marks, with their scores as of the publication.
+func publishedMarks(marks []Mark) []Mark {
+	published := make([]Mark, 0, len(marks))
+	for _, m := range marks {
+		if m.PublishedScore != nil {
+			m.Score = *m.PublishedScore
+			published = append(published, m)
+		}
+	}
+	return published
+}
+
+func (svc *Service) PreviewImport(a Assessment, authorID, filename string, rows [][]string, cols ImportColumns) (MarkImport, error) {
+	ctx := context.Background()
+
+	if a.Kind() == KindVirtual {
+		return MarkImport{}, core.NewValidationError(ErrVirtualAssessment)
+	}
+
+	// Students are found by username or email
+	studentIDs, err := svc.studentIDs(a)
+	if err != nil {
+		return MarkImport{}, err
+	}
+	students := make(map[string]string, len(studentIDs)*2)
+	for _, id := range studentIDs {
+		usr, err := svc.userSvc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return MarkImport{}, err
+		}
+		if usr.Username != "" {
+			students[strings.ToLower(usr.Username)] = usr.ID
+		}
+		if usr.Email != "" {
+			students[strings.ToLower(usr.Email)] = usr.ID
+		}
+	}
+
+	current, err := svc.currentScores(ctx, a.ID)
+	if err != nil {
+		return MarkImport{}, err
+	}
+	entries, err := DiffImport(rows, cols, students, current, a.MaxScore)
+	if err != nil {
+		return MarkImport{}, err
+	}
+
+	imp, err := svc.repo.CreateImport(ctx, MarkImport{
+		AssessmentID: a.ID,
+		AuthorID:     authorID,
+		Filename:     filename,
+		Status:       ImportPending,
+		Entries:      entries,
+	})
+	return imp, errors.Wrap(err, "creating import")
+}
+
+func (svc *Service) ApplyImport(imp MarkImport) (MarkImport, error) {
+	return svc.saveImport(context.Background(), imp.ID, applyImport)
+}
+
+// applyImport returns the applied MarkImport and the Marks it saves.
+func applyImport(imp MarkImport, current map[string]float64) (MarkImport, []Mark, []string, error) {
+	if imp.Status != ImportPending {
+		return MarkImport{}, nil, nil, core.NewValidationError(ErrImportNotPending)
+	}
+
+	// Marks may have changed since the preview: record the scores actually replaced, for undo
+	var marks []Mark
+	for i, entry := range imp.Entries {
+		if entry.Status == EntryUnknown {
+			continue
+		}
+		entry.Status, entry.Previous = EntryNew, nil
+		if prev, ok := current[entry.StudentID]; ok {
+			entry.Previous = &prev
+			entry.Status = EntryChanged
+			if prev == entry.Score {
+				entry.Status = EntryUnchanged
+			}
+		}
+		imp.Entries[i] = entry
+		if entry.Status != EntryUnchanged {
+			marks = append(marks, Mark{AssessmentID: imp.AssessmentID, StudentID: entry.StudentID, Score: entry.Score})
+		}
+	}
+	imp.Status = ImportApplied
+	imp.AppliedAt = time.Now().UTC()
+	return imp, marks, nil, nil
+}
+
+func (svc *Service) UndoImport(imp MarkImport) (MarkImport, error) {
+	return svc.saveImport(context.Background(), imp.ID, undoImport)
+}
+
+// undoImport returns the undone MarkImport, the Marks it restores and the Students whose Marks it deletes.
+func undoImport(imp MarkImport, current map[string]float64) (MarkImport, []Mark, []string, error) {
+	if imp.Status != ImportApplied {
+		return MarkImport{}, nil, nil, core.NewValidationError(ErrImportNotApplied)
+	}
+
+	var (
+		restored []Mark
+		deleted  []string
+	)
+	for _, entry := range imp.Entries {
+		if entry.Status != EntryNew && entry.Status != EntryChanged {
+			continue
+		}
+		// do not overwrite Marks entered after the import
+		if score, ok := current[entry.StudentID]; !ok || score != entry.Score {
+			return MarkImport{}, nil, nil, core.NewValidationError(ErrImportOutdated)
+		}
+		if entry.Previous == nil {
+			deleted = append(deleted, entry.StudentID)
+		} else {
+			restored = append(restored, Mark{AssessmentID: imp.AssessmentID, StudentID: entry.StudentID, Score: *entry.Previous})
+		}
+	}
+	imp.Status = ImportUndone
+	imp.UndoneAt = time.Now().UTC()
+	return imp, restored, deleted, nil
+}
+
+// saveImport locks a MarkImport, then updates it by change, from its Marks' current scores, along with
+// the Marks change saves and deletes; all or none. Concurrent applies or undos of the import are serialized.
+func (svc *Service) saveImport(
+	ctx context.Context,
+	id string,
+	change func(imp MarkImport, current map[string]float64) (MarkImport, []Mark, []string, error),
+) (MarkImport, error) {
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return MarkImport{}, errors.Wrap(err, "starting transaction")
+	}
+	imp, err := svc.repo.LockImport(ctx, id, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return MarkImport{}, errors.Wrap(err, "locking import")
+	}
+	current, err := svc.currentScores(ctx, imp.AssessmentID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return MarkImport{}, err
+	}
+	imp, saved, deletedStudentIDs, err := change(imp, current)
+	if err != nil {
+		_ = tx.Rollback()
+		return MarkImport{}, err
+	}
+
+	if len(saved) > 0 {
+		if err = svc.repo.SaveMarks(ctx, saved, tx); err != nil {
+			_ = tx.Rollback()
+			return MarkImport{}, errors.Wrap(err, "saving marks")
+		}
+	}
+	if len(deletedStudentIDs) > 0 {
+		if err = svc.repo.DeleteMarks(ctx, imp.AssessmentID, deletedStudentIDs, tx); err != nil {
+			_ = tx.Rollback()
+			return MarkImport{}, errors.Wrap(err, "deleting marks")
+		}
+	}
+	if imp, err = svc.repo.UpdateImport(ctx, imp, tx); err != nil {
+		_ = tx.Rollback()
+		return MarkImport{}, errors.Wrap(err, "updating import")
+	}
+	return imp, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// currentScores maps Student IDs to their scores for an Assessment.
+func (svc *Service) currentScores(ctx context.Context, assessmentID string, exec ...core.DBExecutor) (map[string]float64, error) {
+	marks, err := svc.repo.QueryMarks(ctx, MarkFilter{AssessmentIDs: []string{assessmentID}}, exec...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying marks")
+	}
+	scores := make(map[string]float64, len(marks))
+	for _, m := range marks {
+		scores[m.StudentID] = m.Score
+	}
+	return scores, nil
+}
+
+func (svc *Service) QueryImports(assessmentID string) ([]MarkImport, error) {
+	imps, err := svc.repo.QueryImports(context.Background(), assessmentID)
+	return imps, errors.Wrap(err, "querying imports")
+}
+
+func (svc *Service) GetImport(id string) (MarkImport, error) {
+	imp, err := svc.repo.GetImport(context.Background(), id)
+	return imp, errors.Wrap(err, "finding import by ID")
+}
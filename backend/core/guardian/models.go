@@ -0,0 +1,85 @@
+package guardian
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+)
+
+// Relationships of Guardians to Students
+const (
+	RelMother      = "mother"
+	RelFather      = "father"
+	RelGrandparent = "grandparent"
+	RelSibling     = "sibling"
+	RelGuardian    = "guardian" // legal guardian
+	RelOther       = "other"
+)
+
+// Link links a Guardian to a Student they follow.
+type Link struct {
+	GuardianID   string    `json:"guardian_id"`
+	StudentID    string    `json:"student_id"`
+	Relationship string    `json:"relationship"`
+	CreatedAt    time.Time `json:"created_at"` // UTC
+	UpdatedAt    time.Time `json:"updated_at"` // UTC
+}
+
+// Member is a Guardian or a Student on the other end of a Link.
+type Member struct {
+	ID           string `json:"id"` // User ID
+	Name         string `json:"name"`
+	Email        string `json:"email,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+	Relationship string `json:"relationship"`
+	Invited      bool   `json:"invited,omitempty"` // has not signed up yet
+}
+
+// Invitation contains information needed to link a Guardian to a Student; new Guardians are invited by email.
+type Invitation struct {
+	Name         string `json:"name" validate:"required"`
+	Email        string `json:"email" validate:"required,email"`
+	Phone        string `json:"phone" validate:"omitempty,e164"`
+	Relationship string `json:"relationship" validate:"required,oneof=mother father grandparent sibling guardian other"`
+}
+
+func (inv *Invitation) Validate(validate *validator.Validate) error {
+	inv.Name = core.CleanString(inv.Name)
+	inv.Email = core.CleanString(inv.Email, true /* lower */)
+	inv.Phone = core.CleanString(inv.Phone)
+	inv.Relationship = core.CleanString(inv.Relationship, true /* lower */)
+	return validate.Struct(inv)
+}
+
+// CourseMarks are the published Marks of a Student in a Course.
+type CourseMarks struct {
+	Course school.Course    `json:"course"`
+	Marks  []gradebook.Mark `json:"marks"`
+}
+
+// ClassAttendance is the attendance of a Student in a Class.
+type ClassAttendance struct {
+	Class      school.Class                 `json:"class"`
+	Attendance attendance.StudentAttendance `json:"attendance"`
+}
+
+// DueDate is the due date of a Coursework of a Student.
+type DueDate struct {
+	CourseworkID string    `json:"coursework_id"`
+	CourseID     string    `json:"course_id"`
+	CourseName   string    `json:"course_name"`
+	Kind         string    `json:"kind"`
+	Title        string    `json:"title"`
+	DueAt        time.Time `json:"due_at"` // UTC
+}
+
+// DueDateFilter filters DueDates by due time.
+type DueDateFilter struct {
+	DueFrom time.Time `query:"due_from"`
+	DueTo   time.Time `query:"due_to"`
+}
@@ -0,0 +1,38 @@
+package guardian
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestInvitation_Validate(t *testing.T) {
+	validate := validator.New()
+	tests := []struct {
+		name    string
+		inv     Invitation
+		want    Invitation
+		wantErr bool
+	}{
+		{
+			name: "cleaned",
+			inv:  Invitation{Name: " Mama ", Email: " Mama@Test.CD ", Phone: " +243810000000 ", Relationship: " Mother"},
+			want: Invitation{Name: "Mama", Email: "mama@test.cd", Phone: "+243810000000", Relationship: RelMother},
+		},
+		{name: "no name", inv: Invitation{Email: "mama@test.cd", Relationship: RelMother}, wantErr: true},
+		{name: "no email", inv: Invitation{Name: "Mama", Relationship: RelMother}, wantErr: true},
+		{name: "bad phone", inv: Invitation{Name: "Mama", Email: "mama@test.cd", Phone: "0810000000", Relationship: RelMother}, wantErr: true},
+		{name: "unknown relationship", inv: Invitation{Name: "Mama", Email: "mama@test.cd", Relationship: "aunt"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.inv.Validate(validate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.inv != tt.want {
+				t.Errorf("Validate() = %+v; want %+v", tt.inv, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package guardian
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrLinkNotFound = errors.New("guardian link not found")
+	ErrNotStudent   = errors.New("user is not a student")
+	errNotGuardian  = "already used by a user who is not a guardian"
+	errNotVerified  = "used by a guardian who has not verified it yet"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// SaveLink creates a Link, or updates the relationship of an existing one.
+		SaveLink(ctx context.Context, l Link, exec ...core.DBExecutor) (Link, error)
+		// QueryLinks returns the Links of a Guardian when guardianID is set, and/or of a Student when studentID is set.
+		QueryLinks(ctx context.Context, guardianID, studentID string, exec ...core.DBExecutor) ([]Link, error)
+		// DeleteLink returns ErrLinkNotFound when the Guardian is not linked to the Student.
+		DeleteLink(ctx context.Context, guardianID, studentID string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Invite links a Guardian to a Student; Guardians new to the app are invited to sign up by email, which is
+		// audited on behalf of actor.
+		Invite(student user.User, inv Invitation, actor core.AuditActor) (Link, error)
+		Unlink(guardianID, studentID string) error
+		Guardians(studentID string) ([]Member, error)
+		Children(guardianID string) ([]Member, error)
+		IsGuardianOf(guardianID, studentID string) (bool, error)
+
+		// ChildMarks returns the published Marks of a Student, by Course; of a Term when termID is set.
+		ChildMarks(studentID, termID string) ([]CourseMarks, error)
+		// ChildAttendance returns the attendance of a Student in each of their Classes.
+		ChildAttendance(studentID string) ([]ClassAttendance, error)
+		// ChildAnnouncements returns the Announcements delivered to a Student.
+		ChildAnnouncements(studentID string) ([]announcement.Delivery, error)
+		// ChildDueDates returns the due dates of the Coursework of a Student, soonest first.
+		ChildDueDates(studentID string, filter DueDateFilter) ([]DueDate, error)
+	}
+
+	Service struct {
+		repo          Repository
+		userSvc       user.ServiceInterface
+		schoolSvc     school.ServiceInterface
+		gradebookSvc  gradebook.ServiceInterface
+		attendanceSvc attendance.ServiceInterface
+		annSvc        announcement.ServiceInterface
+		cwSvc         coursework.ServiceInterface
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	repo Repository,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	gradebookSvc gradebook.ServiceInterface,
+	attendanceSvc attendance.ServiceInterface,
+	annSvc announcement.ServiceInterface,
+	cwSvc coursework.ServiceInterface,
+) *Service {
+	return &Service{
+		repo:          repo,
+		userSvc:       userSvc,
+		schoolSvc:     schoolSvc,
+		gradebookSvc:  gradebookSvc,
+		attendanceSvc: attendanceSvc,
+		annSvc:        annSvc,
+		cwSvc:         cwSvc,
+	}
+}
+
+func (svc *Service) Invite(student user.User, inv Invitation, actor core.AuditActor) (Link, error) {
+	if !student.IsStudent() {
+		return Link{}, ErrNotStudent
+	}
+
+	usr, err := svc.userSvc.GetByEmail(inv.Email)
+	switch {
+	case errors.Cause(err) == user.ErrNotFound:
+		usr, err = svc.userSvc.Invite(user.InviteUser{
+			Name:  inv.Name,
+			Email: inv.Email,
+			Phone: inv.Phone,
+			Roles: []string{user.RoleGuardian},
+		}, actor)
+		if err != nil {
+			return Link{}, errors.Wrap(err, "inviting guardian")
+		}
+	case err != nil:
+		return Link{}, errors.Wrap(err, "finding user by email")
+	case !usr.IsGuardian():
+		return Link{}, core.NewValidationError(nil, core.FieldError{Field: "email", Error: errNotGuardian})
+	case usr.IsInvited():
+		// the previous invitation may have expired; it verifies the address once accepted
+		if err = svc.userSvc.ResendInvite(usr); err != nil {
+			return Link{}, errors.Wrap(err, "resending invitation")
+		}
+	case !usr.IsEmailVerified():
+		// the account may not be the invited Guardian's: the policy of the School of the Student applies
+		required, err := svc.schoolSvc.RequiresVerifiedEmail(student.ID)
+		if err != nil {
+			return Link{}, err
+		}
+		if required {
+			return Link{}, core.NewValidationError(nil, core.FieldError{Field: "email", Error: errNotVerified})
+		}
+	}
+
+	l, err := svc.repo.SaveLink(context.Background(), Link{
+		GuardianID:   usr.ID,
+		StudentID:    student.ID,
+		Relationship: inv.Relationship,
+	})
+	return l, errors.Wrap(err, "saving link")
+}
+
+func (svc *Service) Unlink(guardianID, studentID string) error {
+	return errors.Wrap(svc.repo.DeleteLink(context.Background(), guardianID, studentID), "deleting link")
+}
+
+func (svc *Service) Guardians(studentID string) ([]Member, error) {
+	ls, err := svc.repo.QueryLinks(context.Background(), "", studentID)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying links")
+	}
+	return svc.members(ls, func(l Link) string { return l.GuardianID })
+}
+
+func (svc *Service) Children(guardianID string) ([]Member, error) {
+	ls, err := svc.repo.QueryLinks(context.Background(), guardianID, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "querying links")
+	}
+	return svc.members(ls, func(l Link) string { return l.StudentID })
+}
+
+// members returns the Users at the end of Links given by memberID.
+func (svc *Service) members(ls []Link, memberID func(l Link) string) ([]Member, error) {
+	ms := make([]Member, 0, len(ls))
+	for _, l := range ls {
+		usr, err := svc.userSvc.GetByID(memberID(l))
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return nil, err
+		}
+		ms = append(ms, Member{
+			ID:           usr.ID,
+			Name:         usr.Name,
+			Email:        usr.Email,
+			Phone:        usr.Phone,
+			Relationship: l.Relationship,
+			Invited:      usr.IsInvited(),
+		})
+	}
+	return ms, nil
+}
+
+func (svc *Service) IsGuardianOf(guardianID, studentID string) (bool, error) {
+	ls, err := svc.repo.QueryLinks(context.Background(), guardianID, studentID)
+	if err != nil {
+		return false, errors.Wrap(err, "querying links")
+	}
+	return len(ls) > 0, nil
+}
+
+func (svc *Service) ChildMarks(studentID, termID string) ([]CourseMarks, error) {
+	crss, err := svc.schoolSvc.QueryCourses(school.CourseFilter{StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+	cms := make([]CourseMarks, 0, len(crss))
+	for _, crs := range crss {
+		marks, err := svc.gradebookSvc.CourseMarks(crs, termID, true /* publishedOnly */, studentID)
+		if err != nil {
+			return nil, err
+		}
+		cms = append(cms, CourseMarks{Course: crs, Marks: marks})
+	}
+	return cms, nil
+}
+
+func (svc *Service) ChildAttendance(studentID string) ([]ClassAttendance, error) {
+	clss, err := svc.schoolSvc.QueryClasses(school.ClassFilter{StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+	cas := make([]ClassAttendance, 0, len(clss))
+	for _, cls := range clss {
+		sa, err := svc.attendanceSvc.StudentAttendance(cls, studentID, attendance.RecordFilter{})
+		if err != nil {
+			return nil, err
+		}
+		cas = append(cas, ClassAttendance{Class: cls, Attendance: sa})
+	}
+	return cas, nil
+}
+
+func (svc *Service) ChildAnnouncements(studentID string) ([]announcement.Delivery, error) {
+	ds, err := svc.annSvc.Inbox(studentID, false)
+	return ds, errors.Wrap(err, "querying inbox")
+}
+
+func (svc *Service) ChildDueDates(studentID string, filter DueDateFilter) ([]DueDate, error) {
+	crss, err := svc.schoolSvc.QueryCourses(school.CourseFilter{StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+	dds := make([]DueDate, 0)
+	for _, crs := range crss {
+		cws, err := svc.cwSvc.Query(coursework.QueryFilter{CourseID: crs.ID, DueFrom: filter.DueFrom, DueTo: filter.DueTo})
+		if err != nil {
+			return nil, err
+		}
+		for _, cw := range cws {
+			if cw.DueAt.IsZero() {
+				continue
+			}
+			dds = append(dds, DueDate{
+				CourseworkID: cw.ID,
+				CourseID:     crs.ID,
+				CourseName:   crs.Name,
+				Kind:         cw.Kind,
+				Title:        cw.Title,
+				DueAt:        cw.DueAt,
+			})
+		}
+	}
+	sort.SliceStable(dds, func(i, j int) bool { return dds[i].DueAt.Before(dds[j].DueAt) })
+	return dds, nil
+}
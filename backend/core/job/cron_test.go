@@ -0,0 +1,63 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	invalid := []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"*/0 * * * *", "5-1 * * * *", "a * * * *", "@every", "@every lol", "@every 10ms", "@yearly"}
+	for _, spec := range invalid {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("ParseSchedule(%q) = nil error; want error", spec)
+		}
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	at := func(s string) time.Time {
+		t.Helper()
+		tm, err := time.Parse("2006-01-02 15:04:05", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	from := at("2026-10-19 10:12:30") // a Monday
+
+	tests := []struct {
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{spec: "* * * * *", from: from, want: at("2026-10-19 10:13:00")},
+		{spec: "*/15 * * * *", from: from, want: at("2026-10-19 10:15:00")},
+		{spec: "0 * * * *", from: from, want: at("2026-10-19 11:00:00")},
+		{spec: "@hourly", from: at("2026-10-19 10:00:00"), want: at("2026-10-19 11:00:00")},
+		{spec: "@daily", from: from, want: at("2026-10-20 00:00:00")},
+		{spec: "@weekly", from: from, want: at("2026-10-25 00:00:00")},
+		{spec: "@monthly", from: from, want: at("2026-11-01 00:00:00")},
+		{spec: "30 8 * * 1-5", from: at("2026-10-23 09:00:00"), want: at("2026-10-26 08:30:00")}, // Fri -> Mon
+		{spec: "0 0 * * 7", from: from, want: at("2026-10-25 00:00:00")},                         // 7 is Sunday
+		{spec: "0 9,17 * * *", from: from, want: at("2026-10-19 17:00:00")},
+		{spec: "5/20 * * * *", from: from, want: at("2026-10-19 10:25:00")},
+		{spec: "0 0 31 * *", from: from, want: at("2026-10-31 00:00:00")},
+		{spec: "0 0 31 * *", from: at("2026-10-31 00:00:00"), want: at("2026-12-31 00:00:00")},
+		{spec: "0 0 29 2 *", from: from, want: at("2028-02-29 00:00:00")},
+		{spec: "0 0 1 * 1", from: from, want: at("2026-10-26 00:00:00")}, // the 1st or a Monday
+		{spec: "0 0 30 2 *", from: from, want: time.Time{}},              // never
+		{spec: "@every 1m", from: from, want: at("2026-10-19 10:13:00")},
+		{spec: "@every 6h", from: from, want: at("2026-10-19 12:00:00")},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.spec)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tt.spec, err)
+			continue
+		}
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("%q.Next(%s) = %s; want %s", tt.spec, tt.from, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Statuses
+const (
+	StatusPending   = "pending"   // waiting for RunAt; also between retries
+	StatusRunning   = "running"   // claimed by a worker
+	StatusSucceeded = "succeeded" // finished
+	StatusFailed    = "failed"    // MaxAttempts reached
+	StatusCancelled = "cancelled" // cancelled before running
+)
+
+const (
+	DefaultMaxAttempts = 5
+	minBackoff         = 30 * time.Second
+	maxBackoff         = time.Hour
+)
+
+// Job is a background task run by the sidecar.
+type Job struct {
+	ID          string          `json:"id"` // UUID
+	Name        string          `json:"name"`
+	Key         string          `json:"key"`     // unique; dedupes the scheduled runs across replicas
+	Payload     json.RawMessage `json:"payload"` // JSON-encoded
+	Status      string          `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"` // UTC; next attempt
+	LockedBy    string          `json:"locked_by"`
+	LockedAt    time.Time       `json:"locked_at"` // UTC
+	LastError   string          `json:"last_error"`
+	FinishedAt  time.Time       `json:"finished_at"` // UTC
+	CreatedAt   time.Time       `json:"created_at"`  // UTC
+	UpdatedAt   time.Time       `json:"updated_at"`  // UTC
+}
+
+// Decode decodes the payload of a Job into v.
+func (j Job) Decode(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+// Backoff returns the delay before retrying a Job after its nth failed attempt: doubled at every attempt.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 8 {
+		return maxBackoff // avoid overflow
+	}
+	if d := minBackoff << (attempt - 1); d < maxBackoff {
+		return d
+	}
+	return maxBackoff
+}
+
+// Filter filters the Jobs listed; zero values match all.
+type Filter struct {
+	Name     string
+	Statuses []string
+	Limit    int
+}
@@ -0,0 +1,23 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		7:  32 * time.Minute,
+		8:  time.Hour,
+		50: time.Hour,
+	}
+	for attempt, want := range tests {
+		if got := Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %v; want %v", attempt, got, want)
+		}
+	}
+}
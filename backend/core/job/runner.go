@@ -0,0 +1,232 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Handler runs a Job; the Job is retried with backoff when it returns an error.
+// ctx is done once the Job times out or the Runner is closed.
+type Handler func(ctx context.Context, j Job) error
+
+type scheduled struct {
+	name     string
+	schedule Schedule
+	next     time.Time
+}
+
+// Runner runs the registered Jobs with a pool of workers, and queues the runs of the periodic ones.
+// Replicas share the job table: each Job is claimed by a single worker.
+type Runner struct {
+	svc       ServiceInterface
+	logger    core.Logger
+	worker    string // ID of this replica
+	workers   int
+	interval  time.Duration // polling interval of idle workers and of the schedules
+	timeout   time.Duration // of a Job
+	handlers  map[string]Handler
+	schedules []*scheduled
+	shutdown  chan os.Signal
+	errors    chan error
+	stop      chan struct{} // closed to stop claiming Jobs
+	stopOnce  sync.Once
+	ctx       context.Context // of the running Jobs
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func NewRunner(conf *core.Config, svc ServiceInterface, logger core.Logger) *Runner {
+	host, _ := os.Hostname()
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Runner{
+		svc:      svc,
+		logger:   logger,
+		worker:   fmt.Sprintf("%s:%d", host, os.Getpid()),
+		workers:  conf.JobWorkers,
+		interval: conf.JobPollInterval,
+		timeout:  conf.JobTimeout,
+		handlers: make(map[string]Handler),
+		errors:   make(chan error, 1),
+		stop:     make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	if r.workers < 1 {
+		r.workers = 1
+	}
+
+	// channel of shutdown signals
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
+	r.shutdown = shutdown
+	return r
+}
+
+// Handle registers the Handler of the Jobs named name.
+func (r *Runner) Handle(name string, h Handler) {
+	r.handlers[name] = h
+}
+
+// Schedule registers the Handler of a periodic Job; see ParseSchedule for the format of spec.
+func (r *Runner) Schedule(name, spec string, h Handler) error {
+	s, err := ParseSchedule(spec)
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	r.Handle(name, h)
+	r.schedules = append(r.schedules, &scheduled{name: name, schedule: s})
+	return nil
+}
+
+// Start runs the Jobs until the Runner is shut down.
+func (r *Runner) Start() {
+	if len(r.handlers) == 0 {
+		r.errors <- errors.New("no job registered")
+		return
+	}
+	names := make([]string, 0, len(r.handlers))
+	for name := range r.handlers {
+		names = append(names, name)
+	}
+
+	r.wg.Add(r.workers + 1)
+	go r.scheduleLoop()
+	for i := 0; i < r.workers; i++ {
+		go r.workLoop(fmt.Sprintf("%s:%d", r.worker, i), names)
+	}
+	r.wg.Wait()
+}
+
+func (r *Runner) Errors() <-chan error {
+	return r.errors
+}
+
+func (r *Runner) ShutdownSignal() <-chan os.Signal {
+	return r.shutdown
+}
+
+// Shutdown stops claiming Jobs and waits for the running ones to finish, until ctx is done.
+func (r *Runner) Shutdown(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close cancels the running Jobs; they will be retried.
+func (r *Runner) Close() error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	r.cancel()
+	return nil
+}
+
+// sleep waits for d; it returns false once the Runner is stopped.
+func (r *Runner) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-r.stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// scheduleLoop queues the periodic Jobs when due (missed runs are skipped),
+// and retries the Jobs of the workers that died.
+func (r *Runner) scheduleLoop() {
+	defer r.wg.Done()
+
+	now := time.Now()
+	for _, s := range r.schedules {
+		s.next = s.schedule.Next(now)
+	}
+	for {
+		now = time.Now()
+		for _, s := range r.schedules {
+			if s.next.IsZero() || s.next.After(now) {
+				continue
+			}
+			if err := r.svc.EnqueueScheduled(s.name, s.next); err != nil {
+				r.logger.Error(fmt.Sprintf("scheduling job %s: %v", s.name, err), err)
+				continue
+			}
+			s.next = s.schedule.Next(now)
+		}
+
+		if n, err := r.svc.ReleaseStale(2 * r.timeout); err != nil {
+			r.logger.Error(fmt.Sprintf("releasing stale jobs: %v", err), err)
+		} else if n > 0 {
+			r.logger.Info(fmt.Sprintf("%d stale job(s) released", n))
+		}
+
+		if !r.sleep(r.interval) {
+			return
+		}
+	}
+}
+
+func (r *Runner) workLoop(worker string, names []string) {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		default:
+		}
+
+		j, err := r.svc.Claim(worker, names)
+		if err != nil {
+			if err != ErrNoJobDue {
+				r.logger.Error(fmt.Sprintf("claiming job: %v", err), err)
+			}
+			if !r.sleep(r.interval) {
+				return
+			}
+			continue
+		}
+
+		runErr := r.run(j)
+		if j, err = r.svc.Complete(j, runErr); err != nil {
+			r.logger.Error(fmt.Sprintf("completing job %s (%s): %v", j.Name, j.ID, err), err)
+			continue
+		}
+		switch j.Status {
+		case StatusFailed:
+			r.logger.Error(fmt.Sprintf("job %s (%s) failed after %d attempts: %v", j.Name, j.ID, j.Attempts, runErr), runErr)
+		case StatusPending:
+			r.logger.Info(fmt.Sprintf("job %s (%s) will be retried at %s: %v", j.Name, j.ID, j.RunAt.Format(time.RFC3339), runErr))
+		}
+	}
+}
+
+// run runs the Handler of a Job, recovering its panics.
+func (r *Runner) run(j Job) (err error) {
+	ctx, cancel := context.WithTimeout(r.ctx, r.timeout)
+	defer cancel()
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = errors.Errorf("panic: %v", rec)
+		}
+	}()
+	return r.handlers[j.Name](ctx, j)
+}
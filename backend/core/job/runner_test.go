@@ -0,0 +1,141 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+func (nopLogger) Fatal(string, ...interface{}) {}
+
+// memService is an in-memory ServiceInterface
+type memService struct {
+	mu   sync.Mutex
+	jobs []*Job
+}
+
+func (svc *memService) Enqueue(name string, payload interface{}, runAt time.Time) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.jobs = append(svc.jobs, &Job{ID: name, Name: name, Status: StatusPending, MaxAttempts: 2, RunAt: runAt})
+	return nil
+}
+
+func (svc *memService) EnqueueScheduled(name string, runAt time.Time) error {
+	return svc.Enqueue(name, nil, runAt)
+}
+
+func (svc *memService) Query(Filter) ([]Job, error) { return nil, nil }
+func (svc *memService) Get(string) (Job, error)     { return Job{}, nil }
+func (svc *memService) Cancel(string) (Job, error)  { return Job{}, nil }
+
+func (svc *memService) Claim(worker string, names []string) (Job, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, j := range svc.jobs {
+		if j.Status == StatusPending && !j.RunAt.After(time.Now()) {
+			j.Status, j.LockedBy = StatusRunning, worker
+			j.Attempts++
+			return *j, nil
+		}
+	}
+	return Job{}, ErrNoJobDue
+}
+
+func (svc *memService) Complete(j Job, runErr error) (Job, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, mj := range svc.jobs {
+		if mj.ID == j.ID {
+			switch {
+			case runErr == nil:
+				mj.Status = StatusSucceeded
+			case mj.Attempts >= mj.MaxAttempts:
+				mj.Status = StatusFailed
+			default:
+				mj.Status = StatusPending // retry right away
+			}
+			return *mj, nil
+		}
+	}
+	return j, ErrJobNotFound
+}
+
+func (svc *memService) ReleaseStale(time.Duration) (int, error) { return 0, nil }
+
+func (svc *memService) status(id string) string {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, j := range svc.jobs {
+		if j.ID == id {
+			return j.Status
+		}
+	}
+	return ""
+}
+
+func TestRunner(t *testing.T) {
+	conf := &core.Config{JobWorkers: 2, JobPollInterval: 10 * time.Millisecond, JobTimeout: time.Second}
+	svc := new(memService)
+	r := NewRunner(conf, svc, nopLogger{})
+
+	released := make(chan struct{})
+	started := make(chan struct{})
+	r.Handle("ok", func(ctx context.Context, j Job) error { return nil })
+	r.Handle("ko", func(ctx context.Context, j Job) error { return errors.New("ko") })
+	r.Handle("panic", func(ctx context.Context, j Job) error { panic("boom") })
+	r.Handle("slow", func(ctx context.Context, j Job) error {
+		close(started)
+		<-released
+		return nil
+	})
+	if err := r.Schedule("lol", "* * *", nil); err == nil {
+		t.Error("Schedule(invalid spec) = nil error; want error")
+	}
+
+	for _, name := range []string{"ok", "ko", "panic"} {
+		_ = svc.Enqueue(name, nil, time.Now())
+	}
+	go r.Start()
+
+	waitFor := func(id, status string) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for svc.status(id) != status {
+			if time.Now().After(deadline) {
+				t.Fatalf("job %s: status = %q; want %q", id, svc.status(id), status)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	waitFor("ok", StatusSucceeded)
+	waitFor("ko", StatusFailed) // after 2 attempts
+	waitFor("panic", StatusFailed)
+
+	// Shutdown waits for the running jobs
+	_ = svc.Enqueue("slow", nil, time.Now())
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := r.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Shutdown() = %v; want DeadlineExceeded", err)
+	}
+	close(released)
+	if err := r.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() = %v; want nil", err)
+	}
+	if st := svc.status("slow"); st != StatusSucceeded {
+		t.Errorf("slow job status = %q; want %q", st, StatusSucceeded)
+	}
+}
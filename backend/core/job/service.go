@@ -0,0 +1,156 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	// errors
+	ErrJobNotFound    = errors.New("job not found")
+	ErrNoJobDue       = errors.New("no job due")
+	ErrNotCancellable = errors.New("only pending jobs can be cancelled")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// CreateJob creates a Job; it returns false, without error, when a Job with the same Key exists.
+		CreateJob(ctx context.Context, j Job, exec ...core.DBExecutor) (Job, bool, error)
+		GetJob(ctx context.Context, id string, exec ...core.DBExecutor) (Job, error)
+		// QueryJobs returns the Jobs matching f, most recent first.
+		QueryJobs(ctx context.Context, f Filter, exec ...core.DBExecutor) ([]Job, error)
+		// ClaimJob locks the next pending Job named one of names and due at t for worker, skipping the Jobs
+		// locked by other workers (`FOR UPDATE SKIP LOCKED`), then marks it running; ErrNoJobDue when none.
+		ClaimJob(ctx context.Context, worker string, names []string, t time.Time, exec ...core.DBExecutor) (Job, error)
+		UpdateJob(ctx context.Context, j Job, exec ...core.DBExecutor) (Job, error)
+		// ReleaseStaleJobs puts back to pending the running Jobs locked before t, whose worker died;
+		// it returns their number.
+		ReleaseStaleJobs(ctx context.Context, t time.Time, exec ...core.DBExecutor) (int, error)
+	}
+
+	ServiceInterface interface {
+		core.JobQueue
+		// EnqueueScheduled queues the run of a periodic Job at runAt, once across replicas.
+		EnqueueScheduled(name string, runAt time.Time) error
+		Query(f Filter) ([]Job, error)
+		Get(id string) (Job, error)
+		Cancel(id string) (Job, error)
+		// Claim returns the next Job due for worker, among names; ErrNoJobDue when none.
+		Claim(worker string, names []string) (Job, error)
+		// Complete records the outcome of a claimed Job: failed Jobs are retried with backoff until MaxAttempts.
+		Complete(j Job, runErr error) (Job, error)
+		// ReleaseStale retries the running Jobs locked for longer than timeout; it returns their number.
+		ReleaseStale(timeout time.Duration) (int, error)
+	}
+
+	Service struct {
+		repo Repository
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (svc *Service) Enqueue(name string, payload interface{}, runAt time.Time) error {
+	_, err := svc.enqueue(name, "", payload, runAt)
+	return err
+}
+
+func (svc *Service) EnqueueScheduled(name string, runAt time.Time) error {
+	key := name + "@" + runAt.UTC().Format(time.RFC3339)
+	_, err := svc.enqueue(name, key, nil, runAt)
+	return err
+}
+
+func (svc *Service) enqueue(name, key string, payload interface{}, runAt time.Time) (Job, error) {
+	var data json.RawMessage
+	if payload != nil {
+		var err error
+		if data, err = json.Marshal(payload); err != nil {
+			return Job{}, errors.Wrap(err, "encoding job payload")
+		}
+	}
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+	j, _, err := svc.repo.CreateJob(context.Background(), Job{
+		ID:          uuid.New().String(),
+		Name:        name,
+		Key:         key,
+		Payload:     data,
+		Status:      StatusPending,
+		MaxAttempts: DefaultMaxAttempts,
+		RunAt:       runAt.UTC(),
+	})
+	return j, errors.Wrap(err, "creating job")
+}
+
+func (svc *Service) Query(f Filter) ([]Job, error) {
+	js, err := svc.repo.QueryJobs(context.Background(), f)
+	return js, errors.Wrap(err, "querying jobs")
+}
+
+func (svc *Service) Get(id string) (Job, error) {
+	j, err := svc.repo.GetJob(context.Background(), id)
+	if err == ErrJobNotFound {
+		return Job{}, err
+	}
+	return j, errors.Wrap(err, "finding job")
+}
+
+func (svc *Service) Cancel(id string) (Job, error) {
+	j, err := svc.Get(id)
+	if err != nil {
+		return Job{}, err
+	}
+	if j.Status != StatusPending {
+		return Job{}, ErrNotCancellable
+	}
+	j.Status = StatusCancelled
+	j.FinishedAt = time.Now().UTC()
+	j, err = svc.repo.UpdateJob(context.Background(), j)
+	return j, errors.Wrap(err, "updating job")
+}
+
+func (svc *Service) Claim(worker string, names []string) (Job, error) {
+	j, err := svc.repo.ClaimJob(context.Background(), worker, names, time.Now().UTC())
+	if err == ErrNoJobDue {
+		return Job{}, err
+	}
+	return j, errors.Wrap(err, "claiming job")
+}
+
+func (svc *Service) Complete(j Job, runErr error) (Job, error) {
+	now := time.Now().UTC()
+	j.LockedBy, j.LockedAt = "", time.Time{}
+	switch {
+	case runErr == nil:
+		j.Status = StatusSucceeded
+		j.FinishedAt = now
+	case j.Attempts >= j.MaxAttempts:
+		j.Status = StatusFailed
+		j.LastError = runErr.Error()
+		j.FinishedAt = now
+	default:
+		j.Status = StatusPending
+		j.LastError = runErr.Error()
+		j.RunAt = now.Add(Backoff(j.Attempts))
+	}
+	j, err := svc.repo.UpdateJob(context.Background(), j)
+	return j, errors.Wrap(err, "updating job")
+}
+
+func (svc *Service) ReleaseStale(timeout time.Duration) (int, error) {
+	n, err := svc.repo.ReleaseStaleJobs(context.Background(), time.Now().UTC().Add(-timeout))
+	return n, errors.Wrap(err, "releasing stale jobs")
+}
@@ -0,0 +1,19 @@
+package core
+
+import "time"
+
+// Background Jobs, run by the sidecar
+const (
+	JobPublishAnnouncements = "publishAnnouncements"
+	JobPaymentWarning       = "paymentWarning"
+	JobDeactivateSchool     = "deactivateSchool"
+	JobEraseUsers           = "eraseUsers"    // erases the personal data of Users soft-deleted long enough
+	JobPurgeAuditLog        = "purgeAuditLog" // deletes the audit entries older than the retention
+	JobSendEmail            = "sendEmail"     // retries emails that failed to send
+)
+
+// JobQueue is any service queuing background Jobs.
+type JobQueue interface {
+	// Enqueue queues a Job to run at runAt with a JSON-encoded payload; failing Jobs are retried with backoff.
+	Enqueue(name string, payload interface{}, runAt time.Time) error
+}
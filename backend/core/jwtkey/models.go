@@ -0,0 +1,115 @@
+package jwtkey
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+
+	rsaKeyBits = 2048
+)
+
+// Algorithms are the supported signing algorithms
+var Algorithms = []string{AlgRS256, AlgEdDSA}
+
+type (
+	// Key is an asymmetric key signing the access JWTs; its ID is the `kid` header of the tokens it signs.
+	// Only the newest active Key signs tokens; retired Keys still verify them during a grace period.
+	Key struct {
+		ID         string        `json:"id"` // UUID
+		Algorithm  string        `json:"algorithm"`
+		PrivateKey crypto.Signer `json:"-"`          // *rsa.PrivateKey | ed25519.PrivateKey
+		RetiredAt  time.Time     `json:"retired_at"` // UTC; zero while active
+		CreatedAt  time.Time     `json:"created_at"` // UTC
+		UpdatedAt  time.Time     `json:"updated_at"` // UTC
+	}
+
+	// JWK is the public part of a Key, as a JSON Web Key (RFC 7517)
+	JWK struct {
+		Kty string `json:"kty"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+		N   string `json:"n,omitempty"`   // RSA modulus
+		E   string `json:"e,omitempty"`   // RSA exponent
+		Crv string `json:"crv,omitempty"` // OKP curve
+		X   string `json:"x,omitempty"`   // OKP public key
+	}
+
+	// JWKS is the JSON Web Key Set published to the services verifying the access JWTs
+	JWKS struct {
+		Keys []JWK `json:"keys"`
+	}
+)
+
+func (k Key) IsActive() bool { return k.RetiredAt.IsZero() }
+
+// PublicKey returns the key verifying the tokens signed by k
+func (k Key) PublicKey() crypto.PublicKey { return k.PrivateKey.Public() }
+
+// JWK returns the public part of k
+func (k Key) JWK() JWK {
+	jwk := JWK{Use: "sig", Alg: k.Algorithm, Kid: k.ID}
+	switch pub := k.PublicKey().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// GenerateKey generates a private key for the signing algorithm
+func GenerateKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case AlgRS256:
+		pk, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		return pk, errors.Wrap(err, "generating RSA key")
+	case AlgEdDSA:
+		_, pk, err := ed25519.GenerateKey(rand.Reader)
+		return pk, errors.Wrap(err, "generating Ed25519 key")
+	}
+	return nil, ErrInvalidAlgorithm
+}
+
+// EncodePrivateKey encodes a private key to PEM (PKCS #8), for storage
+func EncodePrivateKey(pk crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(pk)
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling private key")
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// DecodePrivateKey decodes a private key encoded by EncodePrivateKey
+func DecodePrivateKey(s string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("decoding private key PEM")
+	}
+	pk, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing private key")
+	}
+	signer, ok := pk.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key")
+	}
+	return signer, nil
+}
@@ -0,0 +1,58 @@
+package jwtkey
+
+import (
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		alg     string
+		wantKty string
+	}{
+		{alg: AlgRS256, wantKty: "RSA"},
+		{alg: AlgEdDSA, wantKty: "OKP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			pk, err := GenerateKey(tt.alg)
+			if err != nil {
+				t.Fatalf("GenerateKey(): %v", err)
+			}
+
+			// stored as PEM
+			s, err := EncodePrivateKey(pk)
+			if err != nil {
+				t.Fatalf("EncodePrivateKey(): %v", err)
+			}
+			if pk, err = DecodePrivateKey(s); err != nil {
+				t.Fatalf("DecodePrivateKey(): %v", err)
+			}
+			k := Key{ID: "kid", Algorithm: tt.alg, PrivateKey: pk}
+
+			jwk := k.JWK()
+			if jwk.Kty != tt.wantKty || jwk.Kid != k.ID || jwk.Alg != tt.alg || jwk.Use != "sig" {
+				t.Errorf("JWK() = %+v; want kty %s", jwk, tt.wantKty)
+			}
+
+			// signs & verifies tokens
+			token := jwt.NewWithClaims(jwt.GetSigningMethod(tt.alg), jwt.StandardClaims{Subject: "usr"})
+			ss, err := token.SignedString(k.PrivateKey)
+			if err != nil {
+				t.Fatalf("SignedString(): %v", err)
+			}
+			parsed, err := jwt.Parse(ss, func(*jwt.Token) (interface{}, error) { return k.PublicKey(), nil })
+			if err != nil || !parsed.Valid {
+				t.Errorf("jwt.Parse() = %v; want valid token", err)
+			}
+		})
+	}
+
+	if _, err := GenerateKey("HS256"); err != ErrInvalidAlgorithm {
+		t.Errorf("GenerateKey(HS256) = %v; want ErrInvalidAlgorithm", err)
+	}
+	if _, err := DecodePrivateKey("lol"); err == nil {
+		t.Error("DecodePrivateKey(lol) = nil; want error")
+	}
+}
@@ -0,0 +1,230 @@
+package jwtkey
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+const (
+	// the verifying Keys are reloaded at reloadInterval; and on unknown `kid`, at most every reloadThrottle,
+	// so that the Keys rotated by another process are picked up
+	reloadInterval = time.Minute
+	reloadThrottle = 5 * time.Second
+)
+
+var (
+	// errors
+	ErrKeyNotFound      = errors.New("unknown jwt key id")
+	ErrInvalidAlgorithm = errors.New("invalid jwt signing algorithm")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateKey(ctx context.Context, k Key, exec ...core.DBExecutor) (Key, error)
+		// QueryKeys returns the active Keys & those retired after retiredSince; newest first.
+		QueryKeys(ctx context.Context, retiredSince time.Time, exec ...core.DBExecutor) ([]Key, error)
+		// RetireKeys retires the active Keys.
+		RetireKeys(ctx context.Context, at time.Time, exec ...core.DBExecutor) error
+		// DeleteKeys deletes the Keys retired before retiredBefore.
+		DeleteKeys(ctx context.Context, retiredBefore time.Time, exec ...core.DBExecutor) (int, error)
+	}
+
+	ServiceInterface interface {
+		// Rotate generates a new signing Key and retires the previous ones,
+		// which still verify the tokens they signed during the grace period.
+		Rotate(alg string) (Key, error)
+		// Keys returns the Keys verifying tokens: the active ones & those in their grace period; newest first.
+		Keys() ([]Key, error)
+		JWKS() (JWKS, error)
+		// Sign signs the claims with the newest active Key; one is generated when there's none yet.
+		Sign(claims jwt.Claims) (string, error)
+		// Keyfunc returns the public key verifying a token, per its `kid`; it's a jwt.Keyfunc.
+		Keyfunc(token *jwt.Token) (interface{}, error)
+	}
+
+	Service struct {
+		db    core.DB
+		repo  Repository
+		alg   string
+		grace time.Duration
+
+		mu       sync.RWMutex
+		keys     []Key // cache; newest first
+		loadedAt time.Time
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, db core.DB, repo Repository) *Service {
+	return &Service{
+		db:    db,
+		repo:  repo,
+		alg:   conf.Server.JWTAlgorithm,
+		grace: conf.Server.JWTKeyGracePeriod,
+	}
+}
+
+func checkAlgorithm(alg string) error {
+	for _, a := range Algorithms {
+		if a == alg {
+			return nil
+		}
+	}
+	return ErrInvalidAlgorithm
+}
+
+// newKey generates & stores a new active Key
+func (svc *Service) newKey(ctx context.Context, alg string, exec ...core.DBExecutor) (Key, error) {
+	pk, err := GenerateKey(alg)
+	if err != nil {
+		return Key{}, err
+	}
+	k, err := svc.repo.CreateKey(ctx, Key{Algorithm: alg, PrivateKey: pk}, exec...)
+	return k, errors.Wrap(err, "creating key")
+}
+
+func (svc *Service) Rotate(alg string) (Key, error) {
+	if err := checkAlgorithm(alg); err != nil {
+		return Key{}, err
+	}
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Key{}, errors.Wrap(err, "starting transaction")
+	}
+	if err = svc.repo.RetireKeys(ctx, now, tx); err != nil {
+		_ = tx.Rollback()
+		return Key{}, errors.Wrap(err, "retiring keys")
+	}
+	k, err := svc.newKey(ctx, alg, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Key{}, err
+	}
+	if _, err = svc.repo.DeleteKeys(ctx, now.Add(-svc.grace), tx); err != nil {
+		_ = tx.Rollback()
+		return Key{}, errors.Wrap(err, "deleting expired keys")
+	}
+	if err = tx.Commit(); err != nil {
+		return Key{}, errors.Wrap(err, "committing transaction")
+	}
+
+	svc.mu.Lock()
+	svc.loadedAt = time.Time{}
+	svc.mu.Unlock()
+	return k, nil
+}
+
+// load reloads the cache when it's older than maxAge
+func (svc *Service) load(maxAge time.Duration) ([]Key, error) {
+	svc.mu.RLock()
+	keys, loadedAt := svc.keys, svc.loadedAt
+	svc.mu.RUnlock()
+	if time.Since(loadedAt) < maxAge {
+		return keys, nil
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if time.Since(svc.loadedAt) < maxAge { // reloaded meanwhile
+		return svc.keys, nil
+	}
+	keys, err := svc.repo.QueryKeys(context.Background(), time.Now().UTC().Add(-svc.grace))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying keys")
+	}
+	svc.keys, svc.loadedAt = keys, time.Now()
+	return keys, nil
+}
+
+func (svc *Service) Keys() ([]Key, error) {
+	return svc.load(reloadInterval)
+}
+
+func (svc *Service) JWKS() (JWKS, error) {
+	keys, err := svc.Keys()
+	if err != nil {
+		return JWKS{}, err
+	}
+	jwks := JWKS{Keys: make([]JWK, 0, len(keys))}
+	for _, k := range keys {
+		jwks.Keys = append(jwks.Keys, k.JWK())
+	}
+	return jwks, nil
+}
+
+// signingKey returns the newest active Key, generating one when there's none yet
+func (svc *Service) signingKey() (Key, error) {
+	keys, err := svc.Keys()
+	if err != nil {
+		return Key{}, err
+	}
+	for _, k := range keys {
+		if k.IsActive() {
+			return k, nil
+		}
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, k := range svc.keys { // generated meanwhile
+		if k.IsActive() {
+			return k, nil
+		}
+	}
+	k, err := svc.newKey(context.Background(), svc.alg)
+	if err != nil {
+		return Key{}, err
+	}
+	svc.keys = append([]Key{k}, svc.keys...)
+	return k, nil
+}
+
+func (svc *Service) Sign(claims jwt.Claims) (string, error) {
+	k, err := svc.signingKey()
+	if err != nil {
+		return "", errors.Wrap(err, "getting signing key")
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(k.Algorithm), claims)
+	token.Header["kid"] = k.ID
+	ss, err := token.SignedString(k.PrivateKey)
+	return ss, errors.Wrap(err, "signing token")
+}
+
+func (svc *Service) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	find := func(keys []Key) (interface{}, error) {
+		for _, k := range keys {
+			if k.ID == kid {
+				if token.Method.Alg() != k.Algorithm {
+					return nil, ErrInvalidAlgorithm
+				}
+				return k.PublicKey(), nil
+			}
+		}
+		return nil, ErrKeyNotFound
+	}
+
+	keys, err := svc.Keys()
+	if err != nil {
+		return nil, err
+	}
+	if key, err := find(keys); err != ErrKeyNotFound {
+		return key, err
+	}
+	// maybe rotated by another process
+	if keys, err = svc.load(reloadThrottle); err != nil {
+		return nil, err
+	}
+	return find(keys)
+}
@@ -6,6 +6,7 @@ import (
 	htmltmpl "html/template"
 	"io"
 	"io/fs"
+	"io/ioutil"
 	"net/http"
 	"net/mail"
 	"os"
@@ -57,6 +58,13 @@ type (
 		// SendMessages sends messages concurrently
 		SendMessages(messages ...*EmailMessage)
 	}
+
+	// EmailPolicy is any service telling whether the email addresses of Users must be verified to be emailed.
+	EmailPolicy interface {
+		// RequiresVerifiedEmail reports whether a School of a User, or of the Students they are the Guardian of,
+		// requires verified addresses.
+		RequiresVerifiedEmail(userID string) (bool, error)
+	}
 )
 
 func (m *EmailMessage) getContextData() ContextData {
@@ -130,11 +138,11 @@ func (m *EmailMessage) Render() error {
 }
 
 func (m *EmailMessage) Attach(r io.Reader, filename string, ct ...string) error {
-	at := Attachment{Filename: filename}
+	at := Attachment{Filename: filename, Content: new(bytes.Buffer)}
 
 	// read content
-	var content []byte
-	if _, err := r.Read(content); err != nil {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
 		return errors.Wrap(err, "reading content")
 	}
 	// base64 encode content
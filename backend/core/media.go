@@ -0,0 +1,67 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// errors
+	ErrMediaNotFound    = errors.New("media not found")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrSignatureExpired = errors.New("signature expired")
+)
+
+// MediaStorage is any service that can store files, like uploads and generated documents.
+// Names are slash separated paths relative to the storage root; eg. "report-cards/<classID>/<termID>.zip"
+type MediaStorage interface {
+	Save(name string, r io.Reader) error
+	// Open returns ErrMediaNotFound if the file does not exist.
+	Open(name string) (io.ReadCloser, error)
+	// Delete deletes a file, or a directory with all its files; deleting a missing file is not an error.
+	Delete(name string) error
+}
+
+// SignMediaURL returns a URL to download a media file until it expires.
+func SignMediaURL(conf *Config, name string, expiration ...time.Duration) string {
+	exp := conf.MediaURLExpiration
+	if len(expiration) > 0 {
+		exp = expiration[0]
+	}
+	expires := strconv.FormatInt(time.Now().Add(exp).Unix(), 10)
+
+	q := make(url.Values)
+	q.Set("expires", expires)
+	q.Set("signature", mediaSignature(conf, name, expires))
+	return strings.TrimSuffix(conf.MediaBaseURL, "/") + "/" + name + "?" + q.Encode()
+}
+
+// VerifyMediaSignature checks that the signature of a media URL is valid and not expired.
+func VerifyMediaSignature(conf *Config, name, expires, signature string) error {
+	if subtle.ConstantTimeCompare([]byte(mediaSignature(conf, name, expires)), []byte(signature)) == 0 {
+		return ErrInvalidSignature
+	}
+	ts, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if time.Now().Unix() > ts {
+		return ErrSignatureExpired
+	}
+	return nil
+}
+
+func mediaSignature(conf *Config, name, expires string) string {
+	mac := hmac.New(sha256.New, []byte(conf.SecretKey))
+	_, _ = mac.Write([]byte(name + ":" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
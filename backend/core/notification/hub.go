@@ -0,0 +1,91 @@
+package notification
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// pubsubTopic is the PubSub topic of in-app Notifications, shared by all replicas.
+const pubsubTopic = "notifications"
+
+type subscriber struct {
+	userID string
+	send   func(core.Notification)
+}
+
+// hub forwards the Notifications received from the PubSub to the local subscribers of their User.
+type hub struct {
+	ps core.PubSub
+
+	once sync.Once
+	err  error
+
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+func newHub(ps core.PubSub) *hub {
+	return &hub{
+		ps:   ps,
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+// start subscribes to the PubSub on first use.
+func (h *hub) start() error {
+	h.once.Do(func() {
+		ch, err := h.ps.Subscribe(pubsubTopic)
+		if err != nil {
+			h.err = errors.Wrap(err, "subscribing to notifications")
+			return
+		}
+		go func() {
+			for payload := range ch {
+				var n core.Notification
+				if err := json.Unmarshal(payload, &n); err == nil {
+					h.dispatch(n)
+				}
+			}
+		}()
+	})
+	return h.err
+}
+
+func (h *hub) dispatch(n core.Notification) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs {
+		if sub.userID == n.UserID {
+			sub.send(n)
+		}
+	}
+}
+
+// subscribe calls send with the Notifications of a User until unsubscribed; send must not block.
+func (h *hub) subscribe(userID string, send func(core.Notification)) (unsubscribe func(), err error) {
+	if err = h.start(); err != nil {
+		return nil, err
+	}
+	sub := &subscriber{userID: userID, send: send}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return func() {
+		h.mu.Lock()
+		delete(h.subs, sub)
+		h.mu.Unlock()
+	}, nil
+}
+
+func (h *hub) publish(n core.Notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return errors.Wrap(err, "marshalling notification")
+	}
+	return errors.Wrap(h.ps.Publish(pubsubTopic, payload), "publishing notification")
+}
@@ -0,0 +1,93 @@
+package notification
+
+import (
+	"sort"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Channels
+const (
+	ChannelInApp = "in_app" // inbox & live stream
+	ChannelEmail = "email"
+	ChannelPush  = "push" // Web Push
+)
+
+// Events
+const (
+	EventAnnouncement   = "announcement"    // an Announcement was published
+	EventMarksPublished = "marks_published" // the new Marks of a Class are viewable
+)
+
+const defaultPageSize = 50
+
+// defaults are the Preferences of the notified events, until changed by Users.
+var defaults = map[string]Preference{
+	// announcements already have an opt-in email digest
+	EventAnnouncement:   {Event: EventAnnouncement, InApp: true, Push: true},
+	EventMarksPublished: {Event: EventMarksPublished, InApp: true, Email: true, Push: true},
+}
+
+// Events returns the notified events.
+func Events() []string {
+	events := make([]string, 0, len(defaults))
+	for e := range defaults {
+		events = append(events, e)
+	}
+	sort.Strings(events)
+	return events
+}
+
+// Preference holds the channels a User chose for an event.
+type Preference struct {
+	Event string `json:"event" validate:"required,oneof=announcement marks_published"`
+	InApp bool   `json:"in_app"`
+	Email bool   `json:"email"`
+	Push  bool   `json:"push"`
+}
+
+// Enabled reports whether a channel is enabled.
+func (p Preference) Enabled(channel string) bool {
+	switch channel {
+	case ChannelInApp:
+		return p.InApp
+	case ChannelEmail:
+		return p.Email
+	case ChannelPush:
+		return p.Push
+	default:
+		return false
+	}
+}
+
+// UpdatePreferences changes the channels of some events; the others are left unchanged.
+type UpdatePreferences struct {
+	Preferences []Preference `json:"preferences" validate:"required,dive"`
+}
+
+// Page is a page of the inbox of a User, most recent first.
+type Page struct {
+	Unread        int                 `json:"unread"`
+	Notifications []core.Notification `json:"notifications"`
+}
+
+// Query selects a page of the inbox of a User.
+type Query struct {
+	UnreadOnly bool `query:"unread"`
+	Limit      int  `query:"limit" validate:"omitempty,min=1,max=100"`
+	Offset     int  `query:"offset" validate:"omitempty,min=0"`
+}
+
+type Filter struct {
+	UserID     string
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+// Subscription is a Web Push subscription of a User.
+type Subscription struct {
+	core.PushSubscription
+	ID     string `json:"id"` // UUID
+	UserID string `json:"user_id"`
+}
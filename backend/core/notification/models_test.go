@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestPreference_Validate(t *testing.T) {
+	validate := validator.New()
+
+	// the `oneof` tag must list all the notified events
+	for _, e := range Events() {
+		if err := validate.Struct(defaults[e]); err != nil {
+			t.Errorf("Validate(%q) = %v; want nil", e, err)
+		}
+		if defaults[e].Event != e {
+			t.Errorf("defaults[%q].Event = %q", e, defaults[e].Event)
+		}
+	}
+	if err := validate.Struct(Preference{Event: "nope"}); err == nil {
+		t.Error(`Validate("nope") = nil; want error`)
+	}
+}
+
+func TestPreference_Enabled(t *testing.T) {
+	p := Preference{InApp: true, Push: true}
+	tests := map[string]bool{
+		ChannelInApp: true,
+		ChannelEmail: false,
+		ChannelPush:  true,
+		"sms":        false,
+	}
+	for channel, want := range tests {
+		if got := p.Enabled(channel); got != want {
+			t.Errorf("Enabled(%q) = %v; want %v", channel, got, want)
+		}
+	}
+}
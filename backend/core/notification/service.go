@@ -0,0 +1,335 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateNotifications(ctx context.Context, ns []core.Notification, exec ...core.DBExecutor) error
+		// QueryNotifications returns the Notifications matching a Filter, most recent first.
+		QueryNotifications(ctx context.Context, filter Filter, exec ...core.DBExecutor) ([]core.Notification, error)
+		GetNotification(ctx context.Context, id, userID string, exec ...core.DBExecutor) (core.Notification, error)
+		CountUnread(ctx context.Context, userID string, exec ...core.DBExecutor) (int, error)
+		// MarkRead sets the read time of the unread Notifications of a User; all of them when no ID is given.
+		MarkRead(ctx context.Context, userID string, at time.Time, ids []string, exec ...core.DBExecutor) error
+
+		// QueryPreferences returns the Preferences saved by a User.
+		QueryPreferences(ctx context.Context, userID string, exec ...core.DBExecutor) ([]Preference, error)
+		SavePreference(ctx context.Context, userID string, p Preference, exec ...core.DBExecutor) error
+
+		// SaveSubscription creates a Subscription, or moves it to the User if its endpoint already exists.
+		SaveSubscription(ctx context.Context, s Subscription, exec ...core.DBExecutor) (Subscription, error)
+		QuerySubscriptions(ctx context.Context, userID string, exec ...core.DBExecutor) ([]Subscription, error)
+		DeleteSubscription(ctx context.Context, userID, endpoint string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		core.Notifier
+
+		// Inbox returns a page of the in-app Notifications of a User, and their unread count.
+		Inbox(userID string, query Query) (Page, error)
+		GetNotification(id, userID string) (core.Notification, error)
+		// MarkRead marks Notifications of a User as read; all of them when no ID is given.
+		MarkRead(userID string, ids ...string) error
+		// Subscribe calls send with the in-app Notifications of a User until unsubscribed; send must not block.
+		Subscribe(userID string, send func(core.Notification)) (unsubscribe func(), err error)
+
+		// Preferences returns the Preferences of a User for all events.
+		Preferences(userID string) ([]Preference, error)
+		SetPreferences(userID string, up UpdatePreferences) ([]Preference, error)
+
+		// PushKey returns the VAPID public key browsers subscribe with.
+		PushKey() string
+		SavePushSubscription(userID string, sub core.PushSubscription) (Subscription, error)
+		DeletePushSubscription(userID, endpoint string) error
+	}
+
+	Service struct {
+		conf    *core.Config
+		db      core.DB
+		repo    Repository
+		userSvc user.ServiceInterface
+		mailSvc core.EmailService
+		push    core.PushService
+		logger  core.Logger
+		hub     *hub
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	db core.DB,
+	repo Repository,
+	userSvc user.ServiceInterface,
+	mailSvc core.EmailService,
+	push core.PushService,
+	ps core.PubSub,
+	logger core.Logger,
+) *Service {
+	return &Service{
+		conf:    conf,
+		db:      db,
+		repo:    repo,
+		userSvc: userSvc,
+		mailSvc: mailSvc,
+		push:    push,
+		logger:  logger,
+		hub:     newHub(ps),
+	}
+}
+
+// pushMessage is the payload of Web Push messages, displayed by the service worker of the frontend.
+type pushMessage struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Link  string `json:"link,omitempty"`
+}
+
+func (svc *Service) Notify(notifications ...core.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // DB precision
+
+	prefs := make(map[string]map[string]Preference) // {userID: {event: Preference}}
+	var inApp, emails, pushes []core.Notification
+	for _, n := range notifications {
+		n.ID = uuid.New().String()
+		n.ReadAt = time.Time{}
+		n.CreatedAt = now
+
+		if _, ok := prefs[n.UserID]; !ok {
+			ps, err := svc.preferences(ctx, n.UserID)
+			if err != nil {
+				return err
+			}
+			prefs[n.UserID] = ps
+		}
+		p, ok := prefs[n.UserID][n.Event]
+		if !ok {
+			p = Preference{Event: n.Event, InApp: true} // unknown events are only delivered in-app
+		}
+		if p.InApp {
+			inApp = append(inApp, n)
+		}
+		if p.Email {
+			emails = append(emails, n)
+		}
+		if p.Push {
+			pushes = append(pushes, n)
+		}
+	}
+
+	if len(inApp) > 0 {
+		tx, err := svc.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "starting transaction")
+		}
+		if err = svc.repo.CreateNotifications(ctx, inApp, tx); err != nil {
+			_ = tx.Rollback()
+			return errors.Wrap(err, "creating notifications")
+		}
+		if err = tx.Commit(); err != nil {
+			return errors.Wrap(err, "committing transaction")
+		}
+		for _, n := range inApp {
+			if err = svc.hub.publish(n); err != nil {
+				svc.logger.Error(fmt.Sprintf("broadcasting notification: %v", err), err)
+			}
+		}
+	}
+	svc.sendEmails(emails)
+	svc.sendPushes(pushes)
+	return nil
+}
+
+// sendEmails emails Notifications to their Users; failures are logged.
+func (svc *Service) sendEmails(ns []core.Notification) {
+	if len(ns) == 0 {
+		return
+	}
+	users := make(map[string]user.User) // the Users who may be emailed
+	skipped := make(map[string]bool)
+	messages := make([]*core.EmailMessage, 0, len(ns))
+	for _, n := range ns {
+		if skipped[n.UserID] {
+			continue
+		}
+		usr, ok := users[n.UserID]
+		if !ok {
+			var err error
+			if usr, err = svc.userSvc.GetByID(n.UserID); err != nil {
+				svc.logger.Error(fmt.Sprintf("finding notified user: %v", err), err)
+				continue
+			}
+			if ok, err = svc.userSvc.MayEmail(usr); !ok {
+				if err != nil {
+					svc.logger.Error(fmt.Sprintf("checking notified user email: %v", err), err)
+				}
+				skipped[n.UserID] = true
+				continue
+			}
+			users[n.UserID] = usr
+		}
+		messages = append(messages, &core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      n.Title,
+			TemplateName: "notification",
+			TemplateData: map[string]interface{}{"User": usr, "Notification": n},
+			Conf:         svc.conf,
+		})
+	}
+	svc.mailSvc.SendMessages(messages...)
+}
+
+// sendPushes sends Notifications concurrently to the browsers of their Users; failures are logged,
+// and the subscriptions gone are deleted.
+func (svc *Service) sendPushes(ns []core.Notification) {
+	if len(ns) == 0 {
+		return
+	}
+	ctx := context.Background()
+	subs := make(map[string][]Subscription) // {userID: Subscriptions}
+	var wg sync.WaitGroup
+	for _, n := range ns {
+		ss, ok := subs[n.UserID]
+		if !ok {
+			var err error
+			if ss, err = svc.repo.QuerySubscriptions(ctx, n.UserID); err != nil {
+				svc.logger.Error(fmt.Sprintf("querying push subscriptions: %v", err), err)
+				continue
+			}
+			subs[n.UserID] = ss
+		}
+		payload, _ := json.Marshal(pushMessage{Title: n.Title, Body: n.Body, Link: n.Link})
+
+		for _, s := range ss {
+			wg.Add(1)
+			go func(s Subscription) {
+				defer wg.Done()
+				err := svc.push.Send(s.PushSubscription, payload)
+				if errors.Cause(err) == core.ErrPushSubscriptionGone {
+					err = svc.repo.DeleteSubscription(ctx, s.UserID, s.Endpoint)
+				}
+				if err != nil {
+					svc.logger.Error(fmt.Sprintf("sending push notification: %v", err), err)
+				}
+			}(s)
+		}
+	}
+	wg.Wait()
+}
+
+func (svc *Service) Inbox(userID string, query Query) (Page, error) {
+	ctx := context.Background()
+	filter := Filter{UserID: userID, UnreadOnly: query.UnreadOnly, Limit: query.Limit, Offset: query.Offset}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	ns, err := svc.repo.QueryNotifications(ctx, filter)
+	if err != nil {
+		return Page{}, errors.Wrap(err, "querying notifications")
+	}
+	unread, err := svc.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return Page{}, errors.Wrap(err, "counting unread notifications")
+	}
+	return Page{Unread: unread, Notifications: ns}, nil
+}
+
+func (svc *Service) GetNotification(id, userID string) (core.Notification, error) {
+	n, err := svc.repo.GetNotification(context.Background(), id, userID)
+	return n, errors.Wrap(err, "finding notification")
+}
+
+func (svc *Service) MarkRead(userID string, ids ...string) error {
+	err := svc.repo.MarkRead(context.Background(), userID, time.Now().UTC(), ids)
+	return errors.Wrap(err, "marking notifications as read")
+}
+
+func (svc *Service) Subscribe(userID string, send func(core.Notification)) (func(), error) {
+	return svc.hub.subscribe(userID, send)
+}
+
+func (svc *Service) Preferences(userID string) ([]Preference, error) {
+	prefs, err := svc.preferences(context.Background(), userID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Preference, 0, len(prefs))
+	for _, e := range Events() {
+		list = append(list, prefs[e])
+	}
+	return list, nil
+}
+
+// preferences returns the Preferences of a User for all events, by event.
+func (svc *Service) preferences(ctx context.Context, userID string) (map[string]Preference, error) {
+	saved, err := svc.repo.QueryPreferences(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying preferences")
+	}
+	prefs := make(map[string]Preference, len(defaults))
+	for e, p := range defaults {
+		prefs[e] = p
+	}
+	for _, p := range saved {
+		if _, ok := defaults[p.Event]; ok { // ignore retired events
+			prefs[p.Event] = p
+		}
+	}
+	return prefs, nil
+}
+
+func (svc *Service) SetPreferences(userID string, up UpdatePreferences) ([]Preference, error) {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting transaction")
+	}
+	for _, p := range up.Preferences {
+		if err = svc.repo.SavePreference(ctx, userID, p, tx); err != nil {
+			_ = tx.Rollback()
+			return nil, errors.Wrap(err, "saving preference")
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "committing transaction")
+	}
+	return svc.Preferences(userID)
+}
+
+func (svc *Service) PushKey() string {
+	return svc.push.PublicKey()
+}
+
+func (svc *Service) SavePushSubscription(userID string, sub core.PushSubscription) (Subscription, error) {
+	s, err := svc.repo.SaveSubscription(context.Background(), Subscription{PushSubscription: sub, UserID: userID})
+	return s, errors.Wrap(err, "saving push subscription")
+}
+
+func (svc *Service) DeletePushSubscription(userID, endpoint string) error {
+	err := svc.repo.DeleteSubscription(context.Background(), userID, endpoint)
+	return errors.Wrap(err, "deleting push subscription")
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPushSubscriptionGone is returned by PushService when a subscription expired or was revoked by the browser.
+var ErrPushSubscriptionGone = errors.New("push subscription gone")
+
+type (
+	// Notification informs a User of an event; eg. an Announcement was published.
+	Notification struct {
+		ID        string    `json:"id"` // UUID
+		UserID    string    `json:"user_id"`
+		Event     string    `json:"event"`
+		Title     string    `json:"title"`
+		Body      string    `json:"body"`
+		Link      string    `json:"link,omitempty"` // frontend path of the related page
+		ReadAt    time.Time `json:"read_at"`        // UTC; zero when unread
+		CreatedAt time.Time `json:"created_at"`     // UTC
+	}
+
+	// Notifier is any service delivering Notifications through the channels chosen by their Users for each event.
+	Notifier interface {
+		// Notify delivers Notifications; failing channels are logged, not returned.
+		Notify(notifications ...Notification) error
+	}
+
+	// PushSubscription is the Web Push subscription of a browser.
+	PushSubscription struct {
+		Endpoint string `json:"endpoint" validate:"required,url"`
+		P256dh   string `json:"p256dh" validate:"required"` // public key of the browser
+		Auth     string `json:"auth" validate:"required"`   // authentication secret
+	}
+
+	// PushService is any service sending Web Push messages to browsers.
+	PushService interface {
+		// PublicKey returns the VAPID public key browsers subscribe with.
+		PublicKey() string
+		Send(sub PushSubscription, payload []byte) error
+	}
+)
@@ -0,0 +1,190 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+type (
+	// Login is a pending sign-in with a Provider, identified by its `state`; single-use.
+	Login struct {
+		State        string    `json:"state"`
+		Provider     string    `json:"provider"`
+		CodeVerifier string    `json:"-"` // PKCE (RFC 7636)
+		Nonce        string    `json:"-"`
+		ExpiresAt    time.Time `json:"expires_at"` // UTC
+		CreatedAt    time.Time `json:"created_at"` // UTC
+	}
+
+	// Identity is the account of a User with a Provider.
+	Identity struct {
+		ID        string    `json:"id"` // UUID
+		UserID    string    `json:"user_id"`
+		Provider  string    `json:"provider"`
+		Subject   string    `json:"subject"` // `sub` claim; stable, unlike emails
+		Email     string    `json:"email"`
+		SchoolID  string    `json:"school_id,omitempty"` // when provisioned by a Domain
+		CreatedAt time.Time `json:"created_at"`          // UTC
+	}
+
+	// Domain lets Users signing in with a verified email of the domain be provisioned for a School.
+	Domain struct {
+		Domain    string    `json:"domain"`
+		SchoolID  string    `json:"school_id"`
+		Role      string    `json:"role"`       // of the provisioned Users
+		CreatedAt time.Time `json:"created_at"` // UTC
+		UpdatedAt time.Time `json:"updated_at"` // UTC
+	}
+
+	// IDClaims are the claims of an ID token used to identify a User.
+	IDClaims struct {
+		Issuer        string   `json:"iss"`
+		Subject       string   `json:"sub"`
+		Audience      audience `json:"aud"`
+		ExpiresAt     int64    `json:"exp"`
+		IssuedAt      int64    `json:"iat"`
+		Nonce         string   `json:"nonce"`
+		Email         string   `json:"email"`
+		EmailVerified flexBool `json:"email_verified"`
+		Name          string   `json:"name"`
+		TenantID      string   `json:"tid"` // Microsoft; part of the issuer of multi-tenant apps
+	}
+
+	// audience is the `aud` claim: a string or an array of strings
+	audience []string
+
+	// flexBool is a boolean claim some providers send as a string
+	flexBool bool
+
+	// discovery is the OpenID Provider metadata
+	discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+
+	jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+)
+
+// Valid checks the time claims of the ID token; the other claims are checked by the provider.
+func (c IDClaims) Valid() error {
+	now := NowFunc().Unix()
+	if c.ExpiresAt == 0 || now > c.ExpiresAt+int64(clockSkew.Seconds()) {
+		return errors.New("token is expired")
+	}
+	if c.IssuedAt > now+int64(clockSkew.Seconds()) {
+		return errors.New("token used before issued")
+	}
+	return nil
+}
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+	*a = ss
+	return nil
+}
+
+func (a audience) contains(aud string) bool {
+	for _, s := range a {
+		if s == aud {
+			return true
+		}
+	}
+	return false
+}
+
+func (fb *flexBool) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch val := v.(type) {
+	case bool:
+		*fb = flexBool(val)
+	case string:
+		*fb = flexBool(strings.EqualFold(val, "true"))
+	}
+	return nil
+}
+
+// rsaKeys returns the RSA signing keys of the set, by ID
+func (ks jwks) rsaKeys() map[string]*rsa.PublicKey {
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range ks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	return keys
+}
+
+// randomString returns a random URL-safe string of n bytes of entropy
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generating random string")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge returns the S256 PKCE challenge of a verifier
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// emailDomain returns the domain of an email address
+func emailDomain(email string) string {
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return strings.ToLower(email[i+1:])
+	}
+	return ""
+}
+
+// NewDomain contains information needed to set a Domain of a School.
+type NewDomain struct {
+	Domain string `json:"domain" validate:"required,fqdn"`
+	Role   string `json:"role" validate:"required,oneof=student: teacher:"` // never admins
+}
+
+func (nd *NewDomain) Validate(validate *validator.Validate) error {
+	nd.Domain = core.CleanString(nd.Domain, true /* lower */)
+	return validate.Struct(nd)
+}
@@ -0,0 +1,90 @@
+package oidc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test_codeChallenge(t *testing.T) {
+	// RFC 7636, Appendix B
+	if got := codeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("codeChallenge() = %s", got)
+	}
+}
+
+func TestIDClaims_unmarshal(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		wantAud      string
+		wantVerified bool
+	}{
+		{name: "string aud", data: `{"aud": "masomo", "email_verified": true}`, wantAud: "masomo", wantVerified: true},
+		{name: "array aud", data: `{"aud": ["other", "masomo"], "email_verified": false}`, wantAud: "masomo"},
+		{name: "string email_verified", data: `{"aud": "masomo", "email_verified": "true"}`, wantAud: "masomo", wantVerified: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c IDClaims
+			if err := json.Unmarshal([]byte(tt.data), &c); err != nil {
+				t.Fatalf("json.Unmarshal(): %v", err)
+			}
+			if !c.Audience.contains(tt.wantAud) || bool(c.EmailVerified) != tt.wantVerified {
+				t.Errorf("IDClaims = %+v", c)
+			}
+		})
+	}
+}
+
+func Test_checkClaims(t *testing.T) {
+	valid := IDClaims{Issuer: "https://issuer", Subject: "123", Audience: audience{"masomo"}, Nonce: "n0nce"}
+	msIssuer := "https://login.microsoftonline.com/{tenantid}/v2.0"
+
+	tests := []struct {
+		name    string
+		claims  func(c IDClaims) IDClaims
+		issuer  string
+		wantErr error
+	}{
+		{name: "valid", claims: func(c IDClaims) IDClaims { return c }, issuer: "https://issuer"},
+		{name: "wrong issuer", claims: func(c IDClaims) IDClaims { return c }, issuer: "https://other", wantErr: ErrInvalidIDToken},
+		{name: "wrong audience", claims: func(c IDClaims) IDClaims { c.Audience = audience{"other"}; return c }, issuer: "https://issuer", wantErr: ErrInvalidIDToken},
+		{name: "wrong nonce", claims: func(c IDClaims) IDClaims { c.Nonce = "lol"; return c }, issuer: "https://issuer", wantErr: ErrInvalidIDToken},
+		{name: "no subject", claims: func(c IDClaims) IDClaims { c.Subject = ""; return c }, issuer: "https://issuer", wantErr: ErrInvalidIDToken},
+		{
+			name: "tenant issuer",
+			claims: func(c IDClaims) IDClaims {
+				c.Issuer = "https://login.microsoftonline.com/t1/v2.0"
+				c.TenantID = "t1"
+				return c
+			},
+			issuer: msIssuer,
+		},
+		{
+			name: "other tenant",
+			claims: func(c IDClaims) IDClaims {
+				c.Issuer = "https://login.microsoftonline.com/t1/v2.0"
+				c.TenantID = "t2"
+				return c
+			},
+			issuer:  msIssuer,
+			wantErr: ErrInvalidIDToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkClaims(tt.claims(valid), tt.issuer, "masomo", "n0nce"); err != tt.wantErr {
+				t.Errorf("checkClaims() = %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_emailDomain(t *testing.T) {
+	if got := emailDomain("john@School.CD"); got != "school.cd" {
+		t.Errorf("emailDomain() = %q; want school.cd", got)
+	}
+	if got := emailDomain("john"); got != "" {
+		t.Errorf("emailDomain() = %q; want empty", got)
+	}
+}
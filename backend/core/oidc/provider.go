@@ -0,0 +1,193 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+const (
+	clockSkew         = time.Minute      // tolerated between the provider & us
+	keysRefreshPeriod = 5 * time.Minute  // keys are re-fetched at most this often on unknown `kid`s
+	discoveryTTL      = 24 * time.Hour   // metadata rarely changes
+	httpTimeout       = 10 * time.Second // of the requests to the provider
+)
+
+// provider talks to an OpenID Provider: discovery, authorization-code exchange & ID token validation.
+type provider struct {
+	name   string
+	conf   core.OIDCProviderConf
+	client *http.Client
+
+	mu            sync.Mutex
+	meta          discovery
+	metaFetchedAt time.Time
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+func newProvider(name string, conf core.OIDCProviderConf) *provider {
+	return &provider{
+		name:   name,
+		conf:   conf,
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (p *provider) getJSON(ctx context.Context, uri string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("GET %s: %s", uri, resp.Status)
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(v), "decoding response")
+}
+
+// discover returns the metadata of the provider; cached.
+func (p *provider) discover(ctx context.Context) (discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta.Issuer != "" && NowFunc().Sub(p.metaFetchedAt) < discoveryTTL {
+		return p.meta, nil
+	}
+
+	var meta discovery
+	uri := strings.TrimSuffix(p.conf.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, uri, &meta); err != nil {
+		return discovery{}, errors.Wrap(err, "fetching "+p.name+" discovery")
+	}
+	if meta.Issuer == "" || meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return discovery{}, errors.New("incomplete " + p.name + " discovery")
+	}
+	p.meta, p.metaFetchedAt = meta, NowFunc()
+	return meta, nil
+}
+
+// authCodeURL returns the URL of the consent page of the provider
+func (p *provider) authCodeURL(meta discovery, redirectURI string, l Login) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.conf.ClientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("scope", "openid email profile")
+	v.Set("state", l.State)
+	v.Set("nonce", l.Nonce)
+	v.Set("code_challenge", codeChallenge(l.CodeVerifier))
+	v.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + v.Encode()
+}
+
+// exchange exchanges an authorization code for an ID token; ErrAuthorizationFailed when the provider refuses.
+func (p *provider) exchange(ctx context.Context, meta discovery, code, verifier, redirectURI string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("client_id", p.conf.ClientID)
+	form.Set("client_secret", p.conf.ClientSecret)
+	form.Set("code_verifier", verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "sending request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", errors.Wrap(err, "reading response")
+	}
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError {
+		return "", ErrAuthorizationFailed // invalid_grant, etc.
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("POST %s: %s", meta.TokenEndpoint, resp.Status)
+	}
+
+	var data struct {
+		IDToken string `json:"id_token"`
+	}
+	if err = json.Unmarshal(body, &data); err != nil {
+		return "", errors.Wrap(err, "decoding response")
+	}
+	if data.IDToken == "" {
+		return "", ErrInvalidIDToken
+	}
+	return data.IDToken, nil
+}
+
+// publicKey returns a signing key of the provider; the keys are re-fetched on unknown IDs, for rotations.
+func (p *provider) publicKey(ctx context.Context, meta discovery, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if !p.keysFetchedAt.IsZero() && NowFunc().Sub(p.keysFetchedAt) < keysRefreshPeriod {
+		return nil, errors.New("unknown key ID")
+	}
+
+	var ks jwks
+	if err := p.getJSON(ctx, meta.JWKSURI, &ks); err != nil {
+		return nil, errors.Wrap(err, "fetching "+p.name+" keys")
+	}
+	p.keys, p.keysFetchedAt = ks.rsaKeys(), NowFunc()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, errors.New("unknown key ID")
+}
+
+// verifyIDToken validates an ID token (signature, issuer, audience, expiry & nonce) and returns its claims.
+func (p *provider) verifyIDToken(ctx context.Context, meta discovery, raw, nonce string) (IDClaims, error) {
+	var claims IDClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.publicKey(ctx, meta, kid)
+	})
+	if err != nil {
+		return IDClaims{}, ErrInvalidIDToken
+	}
+	return claims, checkClaims(claims, meta.Issuer, p.conf.ClientID, nonce)
+}
+
+// checkClaims checks the claims of a signed ID token; the issuer of multi-tenant apps contains a `{tenantid}` placeholder.
+func checkClaims(claims IDClaims, issuer, clientID, nonce string) error {
+	issuer = strings.ReplaceAll(issuer, "{tenantid}", claims.TenantID)
+	if claims.Issuer != issuer || !claims.Audience.contains(clientID) || claims.Nonce != nonce || claims.Subject == "" {
+		return ErrInvalidIDToken
+	}
+	return nil
+}
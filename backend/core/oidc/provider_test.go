@@ -0,0 +1,64 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/tests"
+)
+
+func Test_provider_verifyIDToken(t *testing.T) {
+	fake := testutil.NewOIDCProvider("masomo", "secret")
+	defer fake.Close()
+
+	p := newProvider("fake", core.OIDCProviderConf{Issuer: fake.URL, ClientID: "masomo", ClientSecret: "secret"})
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		t.Fatalf("discover(): %v", err)
+	}
+
+	now := time.Now()
+	claims := func(override jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss": fake.URL, "sub": "123", "aud": "masomo", "nonce": "n0nce",
+			"exp": now.Add(time.Hour).Unix(), "iat": now.Unix(), "email": "john@school.cd", "email_verified": true,
+		}
+		for k, v := range override {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: fake.IDToken(t, claims(nil))},
+		{name: "expired", token: fake.IDToken(t, claims(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()})), wantErr: ErrInvalidIDToken},
+		{name: "wrong audience", token: fake.IDToken(t, claims(jwt.MapClaims{"aud": "other"})), wantErr: ErrInvalidIDToken},
+		{name: "wrong issuer", token: fake.IDToken(t, claims(jwt.MapClaims{"iss": "https://evil"})), wantErr: ErrInvalidIDToken},
+		{name: "wrong nonce", token: fake.IDToken(t, claims(jwt.MapClaims{"nonce": "lol"})), wantErr: ErrInvalidIDToken},
+		{name: "tampered", token: fake.IDToken(t, claims(nil)) + "x", wantErr: ErrInvalidIDToken},
+		{name: "unsigned", token: func() string {
+			ss, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims(nil)).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return ss
+		}(), wantErr: ErrInvalidIDToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := p.verifyIDToken(ctx, meta, tt.token, "n0nce")
+			if err != tt.wantErr {
+				t.Fatalf("verifyIDToken() = %v; want %v", err, tt.wantErr)
+			}
+			if err == nil && (c.Subject != "123" || c.Email != "john@school.cd" || !c.EmailVerified) {
+				t.Errorf("verifyIDToken() = %+v", c)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	NowFunc = time.Now // mockable
+
+	// errors
+	ErrUnknownProvider     = errors.New("unknown sign-in provider")
+	ErrInvalidState        = errors.New("invalid or expired sign-in")
+	ErrAuthorizationFailed = errors.New("sign-in refused by the provider")
+	ErrInvalidIDToken      = errors.New("invalid ID token")
+	ErrNoAccount           = errors.New("no account matches this identity")
+	ErrIdentityNotFound    = errors.New("identity not found")
+	ErrDomainNotFound      = errors.New("email domain not found")
+	ErrDomainTaken         = errors.New("email domain already used by another school")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// CreateLogin creates a Login, and deletes the expired ones.
+		CreateLogin(ctx context.Context, l Login, exec ...core.DBExecutor) error
+		// TakeLogin deletes & returns the Login of a state; ErrInvalidState when not found.
+		TakeLogin(ctx context.Context, state string, exec ...core.DBExecutor) (Login, error)
+
+		CreateIdentity(ctx context.Context, i Identity, exec ...core.DBExecutor) (Identity, error)
+		GetIdentity(ctx context.Context, provider, subject string, exec ...core.DBExecutor) (Identity, error)
+
+		// SaveDomain creates a Domain, or updates the School & role of an existing one.
+		SaveDomain(ctx context.Context, d Domain, exec ...core.DBExecutor) (Domain, error)
+		GetDomain(ctx context.Context, domain string, exec ...core.DBExecutor) (Domain, error)
+		QueryDomains(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]Domain, error)
+		DeleteDomain(ctx context.Context, domain string, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Providers returns the names of the enabled providers.
+		Providers() []string
+		// AuthCodeURL starts a sign-in with a provider: the User is sent to the returned URL to consent,
+		// then redirected to RedirectURI with the `code` & `state` to pass to Authenticate.
+		AuthCodeURL(provider string) (string, error)
+		RedirectURI(provider string) string
+		// Authenticate completes a sign-in and returns the User of the identity: on their first sign-in, Users are
+		// linked by their verified email, or provisioned for the School of their email Domain.
+		Authenticate(provider, code, state string) (user.User, error)
+
+		SetDomain(schoolID string, nd NewDomain) (Domain, error)
+		QueryDomains(schoolID string) ([]Domain, error)
+		DeleteDomain(schoolID, domain string) error
+	}
+
+	Service struct {
+		repo         Repository
+		userSvc      user.ServiceInterface
+		providers    map[string]*provider
+		redirectURL  string // of the frontend
+		loginTimeout time.Duration
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, repo Repository, userSvc user.ServiceInterface) *Service {
+	providers := make(map[string]*provider)
+	for name, pc := range conf.OIDC.Providers() {
+		providers[name] = newProvider(name, pc)
+	}
+	return &Service{
+		repo:         repo,
+		userSvc:      userSvc,
+		providers:    providers,
+		redirectURL:  strings.TrimSuffix(conf.FrontendBaseURL, "/") + "/auth/oidc/",
+		loginTimeout: conf.OIDC.LoginTimeout,
+	}
+}
+
+func (svc *Service) Providers() []string {
+	names := make([]string, 0, len(svc.providers))
+	for name := range svc.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (svc *Service) RedirectURI(provider string) string {
+	return svc.redirectURL + provider + "/callback"
+}
+
+func (svc *Service) AuthCodeURL(provider string) (string, error) {
+	p, ok := svc.providers[provider]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+	ctx := context.Background()
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	l := Login{Provider: provider, ExpiresAt: NowFunc().Add(svc.loginTimeout).UTC()}
+	if l.State, err = randomString(32); err != nil {
+		return "", err
+	}
+	if l.CodeVerifier, err = randomString(32); err != nil {
+		return "", err
+	}
+	if l.Nonce, err = randomString(16); err != nil {
+		return "", err
+	}
+	if err = svc.repo.CreateLogin(ctx, l); err != nil {
+		return "", errors.Wrap(err, "creating login")
+	}
+	return p.authCodeURL(meta, svc.RedirectURI(provider), l), nil
+}
+
+func (svc *Service) Authenticate(provider, code, state string) (user.User, error) {
+	p, ok := svc.providers[provider]
+	if !ok {
+		return user.User{}, ErrUnknownProvider
+	}
+	ctx := context.Background()
+	l, err := svc.repo.TakeLogin(ctx, state)
+	if err != nil {
+		if err == ErrInvalidState {
+			return user.User{}, err
+		}
+		return user.User{}, errors.Wrap(err, "taking login")
+	}
+	if l.Provider != provider || NowFunc().After(l.ExpiresAt) {
+		return user.User{}, ErrInvalidState
+	}
+
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+	idToken, err := p.exchange(ctx, meta, code, l.CodeVerifier, svc.RedirectURI(provider))
+	if err != nil {
+		return user.User{}, err
+	}
+	claims, err := p.verifyIDToken(ctx, meta, idToken, l.Nonce)
+	if err != nil {
+		return user.User{}, err
+	}
+	return svc.identityUser(ctx, provider, claims)
+}
+
+// identityUser returns the User of an identity; linking or provisioning them on their first sign-in.
+func (svc *Service) identityUser(ctx context.Context, provider string, claims IDClaims) (user.User, error) {
+	id, err := svc.repo.GetIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		usr, err := svc.userSvc.GetByID(id.UserID)
+		return usr, errors.Wrap(err, "getting identity user")
+	}
+	if err != ErrIdentityNotFound {
+		return user.User{}, errors.Wrap(err, "getting identity")
+	}
+
+	// unverified emails may belong to anyone
+	email := core.CleanString(claims.Email, true /* lower */)
+	if email == "" || !claims.EmailVerified {
+		return user.User{}, ErrNoAccount
+	}
+	id = Identity{Provider: provider, Subject: claims.Subject, Email: email}
+
+	usr, err := svc.userSvc.GetByEmail(email)
+	if err != nil {
+		if errors.Cause(err) != user.ErrNotFound {
+			return user.User{}, errors.Wrap(err, "getting user by email")
+		}
+		if usr, err = svc.provision(ctx, &id, claims); err != nil {
+			return user.User{}, err
+		}
+	}
+
+	id.UserID = usr.ID
+	if _, err = svc.repo.CreateIdentity(ctx, id); err != nil {
+		return user.User{}, errors.Wrap(err, "creating identity")
+	}
+	return usr, nil
+}
+
+// provision creates the User of an identity for the School of their email Domain.
+func (svc *Service) provision(ctx context.Context, id *Identity, claims IDClaims) (user.User, error) {
+	d, err := svc.repo.GetDomain(ctx, emailDomain(id.Email))
+	if err != nil {
+		if err == ErrDomainNotFound {
+			return user.User{}, ErrNoAccount
+		}
+		return user.User{}, errors.Wrap(err, "getting domain")
+	}
+	id.SchoolID = d.SchoolID
+
+	name := core.CleanString(claims.Name)
+	if name == "" {
+		name = id.Email[:strings.Index(id.Email, "@")]
+	}
+	// an unguessable password: the User signs in with the provider, or resets it
+	pwd := make([]byte, 32)
+	if _, err = rand.Read(pwd); err != nil {
+		return user.User{}, errors.Wrap(err, "generating password")
+	}
+	usr, err := svc.userSvc.Create(user.NewUser{
+		Name:          name,
+		Email:         id.Email,
+		Password:      base64.RawURLEncoding.EncodeToString(pwd),
+		Roles:         []string{d.Role},
+		EmailVerified: true, // by the provider
+	}, core.AuditActor{}) // provisioned by the system
+	return usr, errors.Wrap(err, "creating user")
+}
+
+func (svc *Service) SetDomain(schoolID string, nd NewDomain) (Domain, error) {
+	ctx := context.Background()
+	d, err := svc.repo.GetDomain(ctx, nd.Domain)
+	switch {
+	case err == nil && d.SchoolID != schoolID:
+		return Domain{}, core.NewValidationError(nil, core.FieldError{Field: "domain", Error: ErrDomainTaken.Error()})
+	case err != nil && err != ErrDomainNotFound:
+		return Domain{}, errors.Wrap(err, "getting domain")
+	}
+	d, err = svc.repo.SaveDomain(ctx, Domain{Domain: nd.Domain, SchoolID: schoolID, Role: nd.Role})
+	return d, errors.Wrap(err, "saving domain")
+}
+
+func (svc *Service) QueryDomains(schoolID string) ([]Domain, error) {
+	ds, err := svc.repo.QueryDomains(context.Background(), schoolID)
+	return ds, errors.Wrap(err, "querying domains")
+}
+
+func (svc *Service) DeleteDomain(schoolID, domain string) error {
+	ctx := context.Background()
+	d, err := svc.repo.GetDomain(ctx, core.CleanString(domain, true /* lower */))
+	if err != nil {
+		if err == ErrDomainNotFound {
+			return err
+		}
+		return errors.Wrap(err, "getting domain")
+	}
+	if d.SchoolID != schoolID {
+		return ErrDomainNotFound
+	}
+	return errors.Wrap(svc.repo.DeleteDomain(ctx, d.Domain), "deleting domain")
+}
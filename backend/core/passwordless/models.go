@@ -0,0 +1,77 @@
+package passwordless
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// login methods
+const (
+	MethodLink = "link"
+	MethodCode = "code"
+)
+
+// channels the login links & codes are sent through
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+type (
+	// LoginRequest asks for a login link or a one-time code; links are only sent by email.
+	LoginRequest struct {
+		Login   string `json:"login" validate:"required"` // username or email
+		Method  string `json:"method" validate:"required,oneof=link code"`
+		Channel string `json:"channel" validate:"omitempty,oneof=email sms"` // email when empty
+	}
+
+	// LinkLogin holds the UID & token of a login link.
+	LinkLogin struct {
+		UID   string `json:"uid" validate:"required"`
+		Token string `json:"token" validate:"required"`
+	}
+
+	CodeLogin struct {
+		Login string `json:"login" validate:"required"` // username or email
+		Code  string `json:"code" validate:"required"`
+	}
+
+	// Limit counts the login requests & failed attempts of a User in their current windows.
+	Limit struct {
+		UserID             string
+		Requests           int
+		Attempts           int
+		CodesInvalidBefore time.Time // codes issued before are rejected; set once attempts run out
+	}
+)
+
+func (lr *LoginRequest) Validate(validate *validator.Validate) error {
+	lr.Login = core.CleanString(lr.Login, true /* lower */)
+	lr.Method = core.CleanString(lr.Method, true /* lower */)
+	lr.Channel = core.CleanString(lr.Channel, true /* lower */)
+	if lr.Channel == "" {
+		lr.Channel = ChannelEmail
+	}
+	if err := validate.Struct(lr); err != nil {
+		return err
+	}
+	if lr.Method == MethodLink && lr.Channel != ChannelEmail {
+		return core.NewValidationError(nil, core.FieldError{Field: "channel", Error: "links are only sent by email"})
+	}
+	return nil
+}
+
+func (ll *LinkLogin) Validate(validate *validator.Validate) error {
+	ll.UID = core.CleanString(ll.UID)
+	ll.Token = core.CleanString(ll.Token)
+	return validate.Struct(ll)
+}
+
+func (cl *CodeLogin) Validate(validate *validator.Validate) error {
+	cl.Login = core.CleanString(cl.Login, true /* lower */)
+	cl.Code = core.CleanString(cl.Code)
+	return validate.Struct(cl)
+}
@@ -0,0 +1,289 @@
+package passwordless
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const (
+	maxRequests = 3 // login links & codes sent to a User per timeout
+	maxAttempts = 5 // login attempts of a User per timeout; codes are only 6 digits
+)
+
+var (
+	// errors
+	ErrNotAllowed      = errors.New("passwordless login not allowed")
+	ErrNoPhone         = errors.New("no phone number to text the code to")
+	ErrInvalidLink     = errors.New("invalid or expired login link")
+	ErrInvalidCode     = errors.New("invalid or expired login code")
+	ErrTooManyRequests = errors.New("too many login requests; try again later")
+	ErrTooManyAttempts = errors.New("too many failed attempts; try again later")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// CountRequest counts a login request of a User at t, in a window starting with their first request.
+		CountRequest(ctx context.Context, userID string, t time.Time, window time.Duration, exec ...core.DBExecutor) (Limit, error)
+		// CountAttempt counts a login attempt of a User at t, in a window starting with their first attempt.
+		CountAttempt(ctx context.Context, userID string, t time.Time, window time.Duration, exec ...core.DBExecutor) (Limit, error)
+		ResetAttempts(ctx context.Context, userID string, exec ...core.DBExecutor) error
+		// InvalidateCodes rejects the login codes of a User issued before t.
+		InvalidateCodes(ctx context.Context, userID string, t time.Time, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Allowed reports whether a User may log in without password: never admins, and only members of
+		// an active School enabling it.
+		Allowed(usr user.User) (bool, error)
+		// RequestLogin sends a login link or a one-time code to a User allowed to log in without password.
+		RequestLogin(lr LoginRequest) error
+		// LoginWithLink returns the User of a login link; links are single-use.
+		LoginWithLink(ll LinkLogin) (user.User, error)
+		// LoginWithCode returns the User of a one-time code; codes are single-use.
+		LoginWithCode(cl CodeLogin) (user.User, error)
+	}
+
+	Service struct {
+		conf      *core.Config
+		repo      Repository
+		userSvc   user.ServiceInterface
+		schoolSvc school.ServiceInterface
+		mailSvc   core.EmailService
+		smsSvc    core.SMSService
+		timeout   time.Duration
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	repo Repository,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	mailSvc core.EmailService,
+	smsSvc core.SMSService,
+) *Service {
+	return &Service{
+		conf:      conf,
+		repo:      repo,
+		userSvc:   userSvc,
+		schoolSvc: schoolSvc,
+		mailSvc:   mailSvc,
+		smsSvc:    smsSvc,
+		timeout:   conf.LoginLinkTimeout,
+	}
+}
+
+func (svc *Service) Allowed(usr user.User) (bool, error) {
+	if usr.IsAdmin() {
+		return false, nil
+	}
+	schs, err := svc.schoolSvc.MemberSchools(usr.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "querying member schools")
+	}
+	for _, sch := range schs {
+		if sch.PasswordlessLogin && (sch.IsActive == nil || *sch.IsActive) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (svc *Service) RequestLogin(lr LoginRequest) error {
+	send, err := svc.prepareLogin(lr)
+	if err != nil {
+		return err
+	}
+	// do not wait for it; avoid giving clues to attackers
+	go send()
+	return nil
+}
+
+// prepareLogin checks a LoginRequest, and returns the func sending the link or code.
+func (svc *Service) prepareLogin(lr LoginRequest) (func(), error) {
+	usr, err := svc.userSvc.GetByUsernameOrEmail(lr.Login)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding user by username or email")
+	}
+	if err = svc.checkAllowed(usr); err != nil {
+		return nil, err
+	}
+	switch {
+	case lr.Method == MethodLink || lr.Channel == ChannelEmail:
+		ok, err := svc.userSvc.MayEmail(usr)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrNotAllowed
+		}
+	case usr.Phone == "":
+		return nil, ErrNoPhone
+	}
+	lim, err := svc.repo.CountRequest(context.Background(), usr.ID, time.Now(), svc.timeout)
+	if err != nil {
+		return nil, err
+	}
+	if lim.Requests > maxRequests {
+		return nil, ErrTooManyRequests
+	}
+
+	switch {
+	case lr.Method == MethodLink:
+		return func() { svc.sendLink(usr) }, nil
+	case lr.Channel == ChannelSMS:
+		return func() { svc.sendCodeSMS(usr) }, nil
+	default:
+		return func() { svc.sendCodeMail(usr) }, nil
+	}
+}
+
+func (svc *Service) checkAllowed(usr user.User) error {
+	if usr.IsActive != nil && !*usr.IsActive {
+		return ErrNotAllowed
+	}
+	ok, err := svc.Allowed(usr)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotAllowed
+	}
+	return nil
+}
+
+func (svc *Service) sendLink(usr user.User) {
+	token, err := user.MakeLoginToken(usr)
+	if err != nil {
+		log.Printf("%+v", errors.Wrap(err, "making login token")) // todo: logger
+		return
+	}
+	svc.mailSvc.SendMessages(
+		&core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      "Login Link",
+			TemplateName: "login-link",
+			TemplateData: map[string]interface{}{
+				"User":      usr,
+				"LoginPath": fmt.Sprintf("/login/link/%s/%s", user.EncodeUID(usr), token),
+				"Timeout":   int(svc.timeout.Minutes()),
+			},
+			Conf: svc.conf,
+		},
+	)
+}
+
+func (svc *Service) sendCodeMail(usr user.User) {
+	code, err := user.MakeLoginCode(usr)
+	if err != nil {
+		log.Printf("%+v", errors.Wrap(err, "making login code")) // todo: logger
+		return
+	}
+	svc.mailSvc.SendMessages(
+		&core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      "Login Code",
+			TemplateName: "login-code",
+			TemplateData: map[string]interface{}{
+				"User":    usr,
+				"Code":    code,
+				"Timeout": int(svc.timeout.Minutes()),
+			},
+			Conf: svc.conf,
+		},
+	)
+}
+
+// sendCodeSMS texts the login code of a User; with the default sender ID, like password reset links.
+func (svc *Service) sendCodeSMS(usr user.User) {
+	code, err := user.MakeLoginCode(usr)
+	if err != nil {
+		log.Printf("%+v", errors.Wrap(err, "making login code")) // todo: logger
+		return
+	}
+	err = svc.smsSvc.Send(core.SMSMessage{
+		To: usr.Phone,
+		Body: fmt.Sprintf(
+			"%s: your login code is %s. It expires in %d minutes; never share it.",
+			svc.conf.AppName, code, int(svc.timeout.Minutes())),
+	})
+	if err != nil {
+		log.Printf("%+v", errors.Wrap(err, "sending login code sms")) // todo: logger
+	}
+}
+
+func (svc *Service) LoginWithLink(ll LinkLogin) (user.User, error) {
+	uid, err := user.DecodeUID(ll.UID)
+	if err != nil {
+		return user.User{}, ErrInvalidLink
+	}
+	usr, err := svc.userSvc.GetByID(uid)
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return user.User{}, ErrInvalidLink
+		}
+		return user.User{}, errors.Wrap(err, "finding user by ID")
+	}
+	return svc.login(usr, ErrInvalidLink, func(Limit) bool {
+		return user.VerifyLoginToken(usr, ll.Token, svc.timeout)
+	})
+}
+
+func (svc *Service) LoginWithCode(cl CodeLogin) (user.User, error) {
+	usr, err := svc.userSvc.GetByUsernameOrEmail(cl.Login)
+	if err != nil {
+		if errors.Cause(err) == user.ErrNotFound {
+			return user.User{}, ErrInvalidCode
+		}
+		return user.User{}, errors.Wrap(err, "finding user by username or email")
+	}
+	return svc.login(usr, ErrInvalidCode, func(lim Limit) bool {
+		return user.VerifyLoginCode(usr, cl.Code, svc.timeout, lim.CodesInvalidBefore)
+	})
+}
+
+// login checks the attempt of a User, and uses up their link or code: links & codes are bound to the last login.
+// The codes issued so far are invalidated once the attempts run out, so they cannot be tried in the next window.
+func (svc *Service) login(usr user.User, errInvalid error, verify func(lim Limit) bool) (user.User, error) {
+	ctx := context.Background()
+	now := time.Now()
+	lim, err := svc.repo.CountAttempt(ctx, usr.ID, now, svc.timeout)
+	if err != nil {
+		return user.User{}, err
+	}
+	if lim.Attempts > maxAttempts {
+		return user.User{}, ErrTooManyAttempts
+	}
+	if err = svc.checkAllowed(usr); err != nil {
+		if err == ErrNotAllowed {
+			return user.User{}, errInvalid
+		}
+		return user.User{}, err
+	}
+	if !verify(lim) {
+		if lim.Attempts == maxAttempts {
+			if err = svc.repo.InvalidateCodes(ctx, usr.ID, now); err != nil {
+				return user.User{}, err
+			}
+		}
+		return user.User{}, errInvalid
+	}
+	if err = svc.repo.ResetAttempts(ctx, usr.ID); err != nil {
+		return user.User{}, err
+	}
+
+	usr, err = svc.userSvc.SetLastLogin(usr)
+	return usr, errors.Wrap(err, "setting lastLogin")
+}
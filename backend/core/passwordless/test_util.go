@@ -0,0 +1,32 @@
+package passwordless
+
+import (
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+type serviceMock struct {
+	Service
+}
+
+func NewServiceMock(
+	conf *core.Config,
+	repo Repository,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	mailSvc core.EmailService,
+	smsSvc core.SMSService,
+) *serviceMock {
+	return &serviceMock{Service: *NewService(conf, repo, userSvc, schoolSvc, mailSvc, smsSvc)}
+}
+
+func (svc *serviceMock) RequestLogin(lr LoginRequest) error {
+	send, err := svc.prepareLogin(lr)
+	if err != nil {
+		return err
+	}
+	// run synchronously
+	send()
+	return nil
+}
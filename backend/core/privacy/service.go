@@ -0,0 +1,160 @@
+// Package privacy exports the personal data of Users, and erases it once they have been deleted long enough.
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/avatar"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const inboxPageSize = 100
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// EraseUser anonymizes a User and deletes their personal data: sessions, second factors, identities,
+		// notifications, guardian links, roles, chat memberships; the bodies of their chat messages are erased.
+		// Academic records (enrolments, marks, attendance, attempts, wallets) are kept, for the aggregates of Schools.
+		EraseUser(ctx context.Context, userID string, t time.Time, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Export returns a zip archive of the personal data of a User, as JSON files.
+		Export(usr user.User) ([]byte, error)
+		// EraseDeleted erases the personal data of the Users soft-deleted for Config.UserErasureDelay at t;
+		// it returns their number.
+		EraseDeleted(t time.Time) (int, error)
+	}
+
+	Service struct {
+		conf        *core.Config
+		db          core.DB
+		repo        Repository
+		userSvc     user.ServiceInterface
+		schoolSvc   school.ServiceInterface
+		guardianSvc guardian.ServiceInterface
+		notifSvc    notification.ServiceInterface
+		avatarSvc   avatar.ServiceInterface
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	db core.DB,
+	repo Repository,
+	userSvc user.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	guardianSvc guardian.ServiceInterface,
+	notifSvc notification.ServiceInterface,
+	avatarSvc avatar.ServiceInterface,
+) *Service {
+	return &Service{
+		conf:        conf,
+		db:          db,
+		repo:        repo,
+		userSvc:     userSvc,
+		schoolSvc:   schoolSvc,
+		guardianSvc: guardianSvc,
+		notifSvc:    notifSvc,
+		avatarSvc:   avatarSvc,
+	}
+}
+
+func (svc *Service) Export(usr user.User) ([]byte, error) {
+	files := []struct {
+		name string
+		data func() (interface{}, error)
+	}{
+		{"profile.json", func() (interface{}, error) { return usr, nil }},
+		{"schools.json", func() (interface{}, error) { return svc.schoolSvc.MemberSchools(usr.ID) }},
+		{"guardians.json", func() (interface{}, error) { return svc.guardianSvc.Guardians(usr.ID) }},
+		{"children.json", func() (interface{}, error) { return svc.guardianSvc.Children(usr.ID) }},
+		{"marks.json", func() (interface{}, error) { return svc.guardianSvc.ChildMarks(usr.ID, "") }},
+		{"attendance.json", func() (interface{}, error) { return svc.guardianSvc.ChildAttendance(usr.ID) }},
+		{"notifications.json", func() (interface{}, error) { return svc.notifications(usr.ID) }},
+		{"notification-preferences.json", func() (interface{}, error) { return svc.notifSvc.Preferences(usr.ID) }},
+	}
+
+	var buff bytes.Buffer
+	zw := zip.NewWriter(&buff)
+	for _, f := range files {
+		data, err := f.data()
+		if err != nil {
+			return nil, errors.Wrap(err, "exporting "+f.name)
+		}
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating "+f.name)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err = enc.Encode(data); err != nil {
+			return nil, errors.Wrap(err, "encoding "+f.name)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "closing zip")
+	}
+	return buff.Bytes(), nil
+}
+
+// notifications returns all the in-app Notifications of a User.
+func (svc *Service) notifications(userID string) ([]core.Notification, error) {
+	ns := make([]core.Notification, 0)
+	for offset := 0; ; offset += inboxPageSize {
+		page, err := svc.notifSvc.Inbox(userID, notification.Query{Limit: inboxPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, page.Notifications...)
+		if len(page.Notifications) < inboxPageSize {
+			return ns, nil
+		}
+	}
+}
+
+func (svc *Service) EraseDeleted(t time.Time) (int, error) {
+	usrs, err := svc.userSvc.Query(&user.QueryFilter{Deleted: true, DeletedTo: t.Add(-svc.conf.UserErasureDelay)}, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, usr := range usrs {
+		if err = svc.erase(usr, t); err != nil {
+			return n, errors.Wrapf(err, "erasing user %s", usr.ID)
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (svc *Service) erase(usr user.User, t time.Time) error {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if err = svc.repo.EraseUser(ctx, usr.ID, t, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "committing transaction")
+	}
+	// files cannot be rolled back: deleted once the User is anonymized
+	return svc.avatarSvc.DeleteFiles(usr)
+}
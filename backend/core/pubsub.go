@@ -0,0 +1,9 @@
+package core
+
+// PubSub is any service broadcasting messages to the subscribers of a topic; eg. across all API replicas.
+type PubSub interface {
+	Publish(topic string, payload []byte) error
+	// Subscribe returns a channel receiving the payloads published to a topic, which is closed with the PubSub.
+	Subscribe(topic string) (<-chan []byte, error)
+	Close() error
+}
@@ -0,0 +1,278 @@
+package qrcode
+
+// matrix is a QR code symbol being built; function modules are reserved, and never masked.
+type matrix struct {
+	version  int
+	size     int
+	dark     [][]bool
+	reserved [][]bool
+}
+
+func newMatrix(version int) *matrix {
+	size := 17 + 4*version
+	m := &matrix{version: version, size: size, dark: make([][]bool, size), reserved: make([][]bool, size)}
+	for y := 0; y < size; y++ {
+		m.dark[y] = make([]bool, size)
+		m.reserved[y] = make([]bool, size)
+	}
+
+	// finder patterns & their separators
+	for _, c := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				d := max(abs(dx), abs(dy))
+				m.set(c[0]+dx, c[1]+dy, d != 2 && d != 4)
+			}
+		}
+	}
+
+	// alignment patterns, except over the finder patterns
+	centers := alignmentCenters[version]
+	for i, cy := range centers {
+		for j, cx := range centers {
+			if (i == 0 && j == 0) || (i == 0 && j == len(centers)-1) || (i == len(centers)-1 && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					m.set(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// timing patterns
+	for i := 8; i < size-8; i++ {
+		m.set(i, 6, i%2 == 0)
+		m.set(6, i, i%2 == 0)
+	}
+
+	// format information areas, set once the mask is chosen; & the dark module
+	m.placeFormat(0)
+	m.set(8, size-8, true)
+
+	// version information
+	if version >= 7 {
+		bits := versionBits(version)
+		for i := 0; i < 18; i++ {
+			dark := (bits>>uint(i))&1 == 1
+			a, b := size-11+i%3, i/3
+			m.set(a, b, dark)
+			m.set(b, a, dark)
+		}
+	}
+	return m
+}
+
+// set sets a function module; out of range ones are ignored (finder separators at the edges).
+func (m *matrix) set(x, y int, dark bool) {
+	if x < 0 || y < 0 || x >= m.size || y >= m.size {
+		return
+	}
+	m.dark[y][x] = dark
+	m.reserved[y][x] = true
+}
+
+func (m *matrix) copy() *matrix {
+	c := &matrix{version: m.version, size: m.size, dark: make([][]bool, m.size), reserved: m.reserved}
+	for y := range m.dark {
+		c.dark[y] = append([]bool(nil), m.dark[y]...)
+	}
+	return c
+}
+
+// placeData places the codewords in the zigzag order, from the bottom-right corner, skipping the function modules.
+func (m *matrix) placeData(codewords []byte) {
+	i, n := 0, len(codewords)*8
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 { // skip the vertical timing pattern
+			right = 5
+		}
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				upward := ((right + 1) & 2) == 0
+				y := vert
+				if upward {
+					y = m.size - 1 - vert
+				}
+				if m.reserved[y][x] {
+					continue
+				}
+				if i < n {
+					m.dark[y][x] = (codewords[i/8]>>uint(7-i%8))&1 == 1
+					i++
+				} // remainder bits stay light
+			}
+		}
+	}
+}
+
+func (m *matrix) applyMask(mask int) {
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			if m.reserved[y][x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			m.dark[y][x] = m.dark[y][x] != invert
+		}
+	}
+}
+
+// placeFormat places both copies of the format information of a mask.
+func (m *matrix) placeFormat(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return (bits>>uint(i))&1 == 1 }
+
+	// around the top-left finder
+	for i := 0; i <= 5; i++ {
+		m.set(8, i, bit(i))
+	}
+	m.set(8, 7, bit(6))
+	m.set(8, 8, bit(7))
+	m.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.set(14-i, 8, bit(i))
+	}
+
+	// split between the other finders
+	for i := 0; i < 8; i++ {
+		m.set(m.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.set(8, m.size-15+i, bit(i))
+	}
+}
+
+// formatBits returns the 15-bit format information: EC level & mask, BCH(15,5) protected & masked.
+func formatBits(mask int) int {
+	data := eclBits<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// versionBits returns the 18-bit version information, BCH(18,6) protected.
+func versionBits(version int) int {
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1f25)
+	}
+	return version<<12 | rem
+}
+
+// penalty scores how hard the symbol is to read; the rules of ISO/IEC 18004 section 7.8.3.
+func (m *matrix) penalty() int {
+	score := 0
+	size := m.size
+	at := func(x, y int, vertical bool) bool {
+		if vertical {
+			return m.dark[x][y]
+		}
+		return m.dark[y][x]
+	}
+
+	for _, vertical := range []bool{false, true} {
+		for y := 0; y < size; y++ {
+			// N1: runs of 5+ modules of the same color
+			run := 1
+			for x := 1; x < size; x++ {
+				if at(x, y, vertical) == at(x-1, y, vertical) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+			if run >= 5 {
+				score += run - 2
+			}
+
+			// N3: finder-like patterns (1:1:3:1:1) with 4 light modules on a side
+			for x := 0; x+7 <= size; x++ {
+				if !(at(x, y, vertical) && !at(x+1, y, vertical) && at(x+2, y, vertical) && at(x+3, y, vertical) &&
+					at(x+4, y, vertical) && !at(x+5, y, vertical) && at(x+6, y, vertical)) {
+					continue
+				}
+				if m.light(x-4, x, y, vertical) || m.light(x+7, x+11, y, vertical) {
+					score += 40
+				}
+			}
+		}
+	}
+
+	// N2: 2x2 blocks of the same color
+	darkCount := 0
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if m.dark[y][x] {
+				darkCount++
+			}
+			if x+1 < size && y+1 < size {
+				c := m.dark[y][x]
+				if m.dark[y][x+1] == c && m.dark[y+1][x] == c && m.dark[y+1][x+1] == c {
+					score += 3
+				}
+			}
+		}
+	}
+
+	// N4: deviation of the proportion of dark modules from 50%, by steps of 5%
+	total := size * size
+	k := (abs(darkCount*20-total*10) + total - 1) / total
+	return score + max(k-1, 0)*10
+}
+
+// light reports whether the modules of a line in [from, to) are light; outside the symbol is light.
+func (m *matrix) light(from, to, y int, vertical bool) bool {
+	for x := from; x < to; x++ {
+		if x < 0 || x >= m.size {
+			continue
+		}
+		dark := m.dark[y][x]
+		if vertical {
+			dark = m.dark[x][y]
+		}
+		if dark {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
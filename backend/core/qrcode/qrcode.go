@@ -0,0 +1,263 @@
+// Package qrcode encodes text into QR codes (ISO/IEC 18004): byte mode, error correction level M,
+// versions 1 to 10; ie. up to 213 bytes, plenty for URLs.
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maxVersion = 10
+	quietZone  = 4 // modules of light border required around the symbol
+	eclBits    = 0 // error correction level M in the format information
+)
+
+var (
+	// errors
+	ErrTooLong = errors.New("text too long for a QR code")
+)
+
+// error correction blocks of level M, by version
+type blocks struct {
+	ecLen  int   // EC codewords per block
+	groups []int // data codewords of the blocks; a block per item
+}
+
+var versionBlocks = [maxVersion + 1]blocks{
+	1:  {ecLen: 10, groups: []int{16}},
+	2:  {ecLen: 16, groups: []int{28}},
+	3:  {ecLen: 26, groups: []int{44}},
+	4:  {ecLen: 18, groups: []int{32, 32}},
+	5:  {ecLen: 24, groups: []int{43, 43}},
+	6:  {ecLen: 16, groups: []int{27, 27, 27, 27}},
+	7:  {ecLen: 18, groups: []int{31, 31, 31, 31}},
+	8:  {ecLen: 22, groups: []int{38, 38, 39, 39}},
+	9:  {ecLen: 22, groups: []int{36, 36, 36, 37, 37}},
+	10: {ecLen: 26, groups: []int{43, 43, 43, 43, 44}},
+}
+
+// centers of the alignment patterns, by version
+var alignmentCenters = [maxVersion + 1][]int{
+	2:  {6, 18},
+	3:  {6, 22},
+	4:  {6, 26},
+	5:  {6, 30},
+	6:  {6, 34},
+	7:  {6, 22, 38},
+	8:  {6, 24, 42},
+	9:  {6, 26, 46},
+	10: {6, 28, 50},
+}
+
+// Code is a QR code symbol; without its quiet zone.
+type Code struct {
+	Size    int      // modules per side
+	modules [][]bool // dark modules; [y][x]
+}
+
+// Dark reports whether the module at (x, y) is dark.
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Encode returns the QR code of a text, in the smallest version fitting it.
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+	version := 0
+	for v := 1; v <= maxVersion; v++ {
+		if len(data) <= dataCapacity(v)-charCountBits(v)/8-1 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, ErrTooLong
+	}
+
+	m := newMatrix(version)
+	m.placeData(interleave(version, encodeData(version, data)))
+
+	// keep the mask with the lowest penalty
+	var best *matrix
+	for mask := 0; mask < 8; mask++ {
+		masked := m.copy()
+		masked.applyMask(mask)
+		masked.placeFormat(mask)
+		if best == nil || masked.penalty() < best.penalty() {
+			best = masked
+		}
+	}
+	return &Code{Size: best.size, modules: best.dark}, nil
+}
+
+// PNG returns the image of a QR code with its quiet zone; scale is the size of the modules in pixels.
+func (c *Code) PNG(scale int) ([]byte, error) {
+	if scale < 1 {
+		scale = 1
+	}
+	size := (c.Size + 2*quietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			mx, my := x/scale-quietZone, y/scale-quietZone
+			if mx >= 0 && my >= 0 && mx < c.Size && my < c.Size && c.modules[my][mx] {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	var buff bytes.Buffer
+	if err := png.Encode(&buff, img); err != nil {
+		return nil, errors.Wrap(err, "encoding png")
+	}
+	return buff.Bytes(), nil
+}
+
+// dataCapacity returns the number of data codewords of a version.
+func dataCapacity(version int) int {
+	n := 0
+	for _, g := range versionBlocks[version].groups {
+		n += g
+	}
+	return n
+}
+
+// charCountBits returns the length of the character count indicator of the byte mode.
+func charCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// encodeData returns the data codewords of a version: mode, count, data, terminator & padding.
+func encodeData(version int, data []byte) []byte {
+	var bb bitBuffer
+	bb.append(0x4, 4) // byte mode
+	bb.append(len(data), charCountBits(version))
+	for _, b := range data {
+		bb.append(int(b), 8)
+	}
+
+	capacity := dataCapacity(version) * 8
+	if n := capacity - len(bb); n > 0 {
+		if n > 4 {
+			n = 4
+		}
+		bb.append(0, n) // terminator
+	}
+	if n := len(bb) % 8; n > 0 {
+		bb.append(0, 8-n)
+	}
+	for pad := 0; len(bb) < capacity; pad ^= 1 {
+		bb.append([]int{0xec, 0x11}[pad], 8)
+	}
+	return bb.bytes()
+}
+
+// interleave splits the data codewords into blocks, appends their EC codewords, and interleaves them.
+func interleave(version int, data []byte) []byte {
+	bl := versionBlocks[version]
+	gen := rsGenerator(bl.ecLen)
+
+	var dataBlocks, ecBlocks [][]byte
+	maxLen := 0
+	for _, n := range bl.groups {
+		block := data[:n]
+		data = data[n:]
+		dataBlocks = append(dataBlocks, block)
+		ecBlocks = append(ecBlocks, rsRemainder(block, gen))
+		if n > maxLen {
+			maxLen = n
+		}
+	}
+
+	var out []byte
+	for i := 0; i < maxLen; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				out = append(out, block[i])
+			}
+		}
+	}
+	for i := 0; i < bl.ecLen; i++ {
+		for _, block := range ecBlocks {
+			out = append(out, block[i])
+		}
+	}
+	return out
+}
+
+type bitBuffer []bool
+
+func (bb *bitBuffer) append(val, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*bb = append(*bb, (val>>uint(i))&1 == 1)
+	}
+}
+
+func (bb bitBuffer) bytes() []byte {
+	out := make([]byte, len(bb)/8)
+	for i, bit := range bb {
+		if bit {
+			out[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	return out
+}
+
+// ---- Reed-Solomon over GF(256), with the primitive polynomial 0x11d ----
+
+// gfMul multiplies in GF(256)
+func gfMul(x, y byte) byte {
+	var z byte
+	for i := 7; i >= 0; i-- {
+		hi := z & 0x80
+		z <<= 1
+		if hi != 0 {
+			z ^= 0x1d
+		}
+		if (y>>uint(i))&1 == 1 {
+			z ^= x
+		}
+	}
+	return z
+}
+
+// rsGenerator returns the coefficients of the generator polynomial of a degree, highest first; without the leading 1.
+func rsGenerator(degree int) []byte {
+	gen := make([]byte, degree)
+	gen[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		// multiply by (x - root)
+		for j := range gen {
+			gen[j] = gfMul(gen[j], root)
+			if j+1 < len(gen) {
+				gen[j] ^= gen[j+1]
+			}
+		}
+		root = gfMul(root, 0x02)
+	}
+	return gen
+}
+
+// rsRemainder returns the EC codewords of data.
+func rsRemainder(data, gen []byte) []byte {
+	rem := make([]byte, len(gen))
+	for _, b := range data {
+		factor := b ^ rem[0]
+		copy(rem, rem[1:])
+		rem[len(rem)-1] = 0
+		for i := range rem {
+			rem[i] ^= gfMul(gen[i], factor)
+		}
+	}
+	return rem
+}
@@ -0,0 +1,210 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRSRemainder(t *testing.T) {
+	// 1-M "HELLO WORLD" (alphanumeric); from the thonky.com QR code tutorial
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := rsRemainder(data, rsGenerator(10)); !reflect.DeepEqual(got, want) {
+		t.Errorf("rsRemainder() = %v; want %v", got, want)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	// level M
+	want := []string{
+		"101010000010010", "101000100100101", "101111001111100", "101101101001011",
+		"100010111111001", "100000011001110", "100111110010111", "100101010100000",
+	}
+	for mask, w := range want {
+		if got := formatBits(mask); got != parseBits(w) {
+			t.Errorf("formatBits(%d) = %015b; want %s", mask, got, w)
+		}
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	tests := map[int]string{7: "000111110010010100", 8: "001000010110111100", 10: "001010010011010011"}
+	for v, w := range tests {
+		if got := versionBits(v); got != parseBits(w) {
+			t.Errorf("versionBits(%d) = %018b; want %s", v, got, w)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		wantVersion int
+		wantErr     error
+	}{
+		{name: "v1", text: strings.Repeat("a", 14), wantVersion: 1},
+		{name: "v2", text: strings.Repeat("a", 15), wantVersion: 2},
+		{name: "url", text: "https://masomo.example.com/login?username=jdoe2026", wantVersion: 4},
+		{name: "v7 with version info", text: strings.Repeat("b", 120), wantVersion: 7},
+		{name: "v10", text: strings.Repeat("c", 213), wantVersion: 10},
+		{name: "too long", text: strings.Repeat("d", 214), wantErr: ErrTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Encode(tt.text)
+			if err != tt.wantErr {
+				t.Fatalf("Encode() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if want := 17 + 4*tt.wantVersion; code.Size != want {
+				t.Fatalf("Encode() size = %d; want %d", code.Size, want)
+			}
+			if got := decode(t, code, tt.wantVersion); got != tt.text {
+				t.Errorf("decode() = %q; want %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestCode_PNG(t *testing.T) {
+	code, err := Encode("hello")
+	if err != nil {
+		t.Fatalf("Encode(): %v", err)
+	}
+	b, err := code.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG(): %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("png.Decode(): %v", err)
+	}
+	if want := (21 + 2*quietZone) * 4; img.Bounds().Dx() != want || img.Bounds().Dy() != want {
+		t.Errorf("PNG() bounds = %v; want %dx%d", img.Bounds(), want, want)
+	}
+}
+
+func parseBits(s string) int {
+	n := 0
+	for _, c := range s {
+		n = n<<1 | int(c-'0')
+	}
+	return n
+}
+
+// decode reads a Code back: format information, unmasking, codewords & data of its blocks.
+func decode(t *testing.T, code *Code, version int) string {
+	t.Helper()
+	m := newMatrix(version) // for the function modules
+	m.dark = code.modules
+
+	// both copies of the format information must match a mask of level M
+	var first, second int
+	for i := 0; i <= 5; i++ {
+		first |= b2i(code.Dark(8, i)) << uint(i)
+	}
+	first |= b2i(code.Dark(8, 7))<<6 | b2i(code.Dark(8, 8))<<7 | b2i(code.Dark(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		first |= b2i(code.Dark(14-i, 8)) << uint(i)
+	}
+	for i := 0; i < 8; i++ {
+		second |= b2i(code.Dark(code.Size-1-i, 8)) << uint(i)
+	}
+	for i := 8; i < 15; i++ {
+		second |= b2i(code.Dark(8, code.Size-15+i)) << uint(i)
+	}
+	if first != second {
+		t.Fatalf("format information copies differ: %015b & %015b", first, second)
+	}
+	mask := -1
+	for i := 0; i < 8; i++ {
+		if formatBits(i) == first {
+			mask = i
+		}
+	}
+	if mask < 0 {
+		t.Fatalf("invalid format information %015b", first)
+	}
+	if !code.Dark(8, code.Size-8) {
+		t.Fatal("missing dark module")
+	}
+
+	// unmask & read the codewords in the zigzag order
+	m.applyMask(mask)
+	bl := versionBlocks[version]
+	total := dataCapacity(version) + bl.ecLen*len(bl.groups)
+	var bits bitBuffer
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if ((right + 1) & 2) == 0 {
+					y = m.size - 1 - vert
+				}
+				if !m.reserved[y][x] && len(bits) < total*8 {
+					bits = append(bits, m.dark[y][x])
+				}
+			}
+		}
+	}
+	codewords := bits.bytes()
+
+	// de-interleave the blocks & check their EC codewords
+	blocks := make([][]byte, len(bl.groups))
+	i := 0
+	for k := 0; k < bl.groups[len(bl.groups)-1]; k++ {
+		for b, n := range bl.groups {
+			if k < n {
+				blocks[b] = append(blocks[b], codewords[i])
+				i++
+			}
+		}
+	}
+	var data []byte
+	gen := rsGenerator(bl.ecLen)
+	for b, block := range blocks {
+		var ec []byte
+		for k := 0; k < bl.ecLen; k++ {
+			ec = append(ec, codewords[i+k*len(blocks)+b])
+		}
+		if want := rsRemainder(block, gen); !reflect.DeepEqual(ec, want) {
+			t.Fatalf("block %d: EC codewords = %v; want %v", b, ec, want)
+		}
+		data = append(data, block...)
+	}
+
+	// mode, count & text
+	if data[0]>>4 != 0x4 {
+		t.Fatalf("mode = %x; want 4 (byte)", data[0]>>4)
+	}
+	var n int
+	var rest []byte
+	if charCountBits(version) == 8 {
+		n = int(data[0]&0x0f)<<4 | int(data[1]>>4)
+		rest = data[1:]
+	} else {
+		n = int(data[0]&0x0f)<<12 | int(data[1])<<4 | int(data[2]>>4)
+		rest = data[2:]
+	}
+	text := make([]byte, n)
+	for k := range text {
+		text[k] = rest[k]<<4 | rest[k+1]>>4
+	}
+	return string(text)
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
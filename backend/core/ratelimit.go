@@ -0,0 +1,61 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+const rateLimiterPruneSize = 1024 // keys kept before the ended windows are forgotten
+
+// RateLimiter limits the number of events per key during a fixed window; eg. login attempts per User.
+// Counts are kept in memory: each instance of the app limits on its own.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu      sync.Mutex
+	windows map[string]rateWindow
+}
+
+type rateWindow struct {
+	count   int
+	resetAt time.Time
+}
+
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{limit: limit, window: window, windows: make(map[string]rateWindow)}
+}
+
+// Allow records an event for the key, and reports whether it is within the limit.
+func (rl *RateLimiter) Allow(key string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	w, ok := rl.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		if len(rl.windows) >= rateLimiterPruneSize {
+			rl.prune(now)
+		}
+		w = rateWindow{resetAt: now.Add(rl.window)}
+	}
+	w.count++
+	rl.windows[key] = w
+	return w.count <= rl.limit
+}
+
+// Reset forgets the events of the key; eg. after a successful login.
+func (rl *RateLimiter) Reset(key string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	delete(rl.windows, key)
+}
+
+// prune forgets the ended windows
+func (rl *RateLimiter) prune(now time.Time) {
+	for key, w := range rl.windows {
+		if !now.Before(w.resetAt) {
+			delete(rl.windows, key)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package rbac
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+// Permissions
+const (
+	PermUsersRead           = "users.read"
+	PermUsersCreate         = "users.create"
+	PermUsersUpdate         = "users.update"
+	PermUsersDelete         = "users.delete"
+	PermRolesManage         = "roles.manage"
+	PermSchoolsManage       = "schools.manage"
+	PermClassesManage       = "classes.manage"
+	PermMarksPublish        = "marks.publish"
+	PermReportCardsManage   = "reportcards.manage"
+	PermAnnouncementsManage = "announcements.manage"
+	PermCalendarManage      = "calendar.manage"
+	PermWalletAllocate      = "wallet.allocate"
+	PermWalletRead          = "wallet.read"
+	PermAuditRead           = "audit.read"
+)
+
+// Hierarchy actions
+const (
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+var (
+	Permissions = []Permission{
+		{Name: PermUsersRead, Description: "List & view users", Global: true},
+		{Name: PermUsersCreate, Description: "Register users", Global: true},
+		{Name: PermUsersUpdate, Description: "Update users, their roles & status", Global: true},
+		{Name: PermUsersDelete, Description: "Delete users", Global: true},
+		{Name: PermRolesManage, Description: "Manage the custom roles of a school & their members"},
+		{Name: PermSchoolsManage, Description: "Create & configure schools, their departments, classes & terms"},
+		{Name: PermClassesManage, Description: "Manage classes, their students & courses"},
+		{Name: PermMarksPublish, Description: "Publish the marks of a class"},
+		{Name: PermReportCardsManage, Description: "Generate & email the report cards of a class"},
+		{Name: PermAnnouncementsManage, Description: "Publish school announcements"},
+		{Name: PermCalendarManage, Description: "Manage the calendar of a school"},
+		{Name: PermWalletAllocate, Description: "Allocate wallet points to course budgets"},
+		{Name: PermWalletRead, Description: "View school leaderboards & wallet awards"},
+		{Name: PermAuditRead, Description: "View the audit log of the actions on users", Global: true},
+	}
+
+	// rolePermissions maps built-in roles to their permissions, which apply to all Schools.
+	// Teachers & Students get their rights from the Courses they teach or attend.
+	rolePermissions = map[string][]string{
+		user.RoleAdminOwner:     allPermissions(),
+		user.RoleAdminPrincipal: allPermissions(),
+		user.RoleAdmin:          except(allPermissions(), PermRolesManage, PermAuditRead),
+	}
+)
+
+func allPermissions() []string {
+	all := make([]string, 0, len(Permissions))
+	for _, p := range Permissions {
+		all = append(all, p.Name)
+	}
+	return all
+}
+
+func except(perms []string, excluded ...string) []string {
+	ex := NewSet(excluded...)
+	ps := make([]string, 0, len(perms))
+	for _, p := range perms {
+		if !ex.Has(p) {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// IsPermission reports whether perm is a known permission.
+func IsPermission(perm string) bool {
+	_, ok := permission(perm)
+	return ok
+}
+
+func permission(name string) (Permission, bool) {
+	for _, p := range Permissions {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Permission{}, false
+}
+
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Global      bool   `json:"global"` // checked outside of any School: custom Roles cannot grant it
+}
+
+// Set is a set of permissions.
+type Set map[string]struct{}
+
+func NewSet(perms ...string) Set {
+	s := make(Set, len(perms))
+	s.Add(perms...)
+	return s
+}
+
+func (s Set) Add(perms ...string) {
+	for _, p := range perms {
+		s[p] = struct{}{}
+	}
+}
+
+func (s Set) Has(perm string) bool {
+	_, ok := s[perm]
+	return ok
+}
+
+// HasAll reports whether all perms are in the Set.
+func (s Set) HasAll(perms ...string) bool {
+	for _, p := range perms {
+		if !s.Has(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Slice returns the permissions of the Set, in the order of Permissions.
+func (s Set) Slice() []string {
+	perms := make([]string, 0, len(s))
+	for _, p := range Permissions {
+		if s.Has(p.Name) {
+			perms = append(perms, p.Name)
+		}
+	}
+	return perms
+}
+
+// RolePermissions returns the permissions granted by built-in roles.
+func RolePermissions(roles []string) Set {
+	s := NewSet()
+	for _, r := range roles {
+		s.Add(rolePermissions[r]...)
+	}
+	return s
+}
+
+// CanGrant reports whether an actor may give roles to a User: none can be above the actor's highest role.
+func CanGrant(actor user.User, roles []string) bool {
+	return user.MaxRolePriority(roles) <= user.MaxRolePriority(actor.Roles)
+}
+
+// CheckHierarchy returns ErrForbidden when an actor cannot act on one of targets:
+// Users cannot delete themselves, nor update or delete a User whose highest role is above theirs.
+func CheckHierarchy(actor user.User, action string, targets ...user.User) error {
+	for _, t := range targets {
+		if t.ID == actor.ID {
+			if action == ActionDelete {
+				return ErrForbidden
+			}
+			continue
+		}
+		if !CanGrant(actor, t.Roles) {
+			return ErrForbidden
+		}
+	}
+	return nil
+}
+
+// Role is a custom role of a School: its permissions only apply to the resources of the School.
+type Role struct {
+	ID          string    `json:"id"` // UUID
+	SchoolID    string    `json:"school_id"`
+	Name        string    `json:"name"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"` // UTC
+	UpdatedAt   time.Time `json:"updated_at"` // UTC
+}
+
+// NewRole contains information needed to create or update a custom Role.
+type NewRole struct {
+	Name        string   `json:"name" validate:"required,max=100"`
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+func (nr *NewRole) Validate(validate *validator.Validate) error {
+	nr.Name = core.CleanString(nr.Name)
+	if err := validate.Struct(nr); err != nil {
+		return err
+	}
+	perms := NewSet()
+	for _, name := range nr.Permissions {
+		p, ok := permission(name)
+		if !ok {
+			return core.NewValidationError(nil, core.FieldError{Field: "permissions", Error: "unknown permission " + name})
+		}
+		if p.Global {
+			return core.NewValidationError(nil, core.FieldError{Field: "permissions", Error: "global permission " + name})
+		}
+		perms.Add(name)
+	}
+	nr.Permissions = perms.Slice() // deduped & sorted
+	return nil
+}
+
+// RoleMembers contains the IDs of the Users added to or removed from a custom Role.
+type RoleMembers struct {
+	IDs []string `json:"ids" validate:"required,dive,uuid"`
+}
@@ -0,0 +1,114 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+func TestRolePermissions(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []string
+		has   []string
+		lacks []string
+	}{
+		{name: "owner", roles: []string{user.RoleAdminOwner}, has: allPermissions()},
+		{name: "admin", roles: []string{user.RoleAdmin}, has: []string{PermUsersCreate, PermMarksPublish}, lacks: []string{PermRolesManage, PermAuditRead}},
+		{name: "teacher", roles: []string{user.RoleTeacher}, lacks: []string{PermUsersRead, PermMarksPublish}},
+		{name: "combined", roles: []string{user.RoleTeacher, user.RoleAdminPrincipal}, has: []string{PermRolesManage, PermAuditRead}},
+		{name: "unknown", roles: []string{"admin:unknown"}, lacks: []string{PermUsersRead}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perms := RolePermissions(tt.roles)
+			if !perms.HasAll(tt.has...) {
+				t.Errorf("RolePermissions() = %v; want all of %v", perms.Slice(), tt.has)
+			}
+			for _, p := range tt.lacks {
+				if perms.Has(p) {
+					t.Errorf("RolePermissions() has %q", p)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckHierarchy(t *testing.T) {
+	owner := user.User{ID: "owner", Roles: []string{user.RoleAdminOwner}}
+	admin := user.User{ID: "admin", Roles: []string{user.RoleAdmin}}
+	teacher := user.User{ID: "teacher", Roles: []string{user.RoleTeacher}}
+	tests := []struct {
+		name    string
+		actor   user.User
+		action  string
+		targets []user.User
+		wantErr bool
+	}{
+		{name: "update lower", actor: admin, action: ActionUpdate, targets: []user.User{teacher}},
+		{name: "delete lower", actor: admin, action: ActionDelete, targets: []user.User{teacher}},
+		{name: "update peer", actor: admin, action: ActionUpdate, targets: []user.User{{ID: "admin2", Roles: []string{user.RoleAdmin}}}},
+		{name: "update higher", actor: admin, action: ActionUpdate, targets: []user.User{owner}, wantErr: true},
+		{name: "delete higher", actor: admin, action: ActionDelete, targets: []user.User{teacher, owner}, wantErr: true},
+		{name: "update self", actor: teacher, action: ActionUpdate, targets: []user.User{teacher}},
+		{name: "delete self", actor: owner, action: ActionDelete, targets: []user.User{teacher, owner}, wantErr: true},
+		{name: "no targets", actor: teacher, action: ActionDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckHierarchy(tt.actor, tt.action, tt.targets...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckHierarchy() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err != ErrForbidden {
+				t.Errorf("CheckHierarchy() error = %v; want ErrForbidden", err)
+			}
+		})
+	}
+}
+
+func TestNewRole_Validate(t *testing.T) {
+	validate := validator.New()
+	tests := []struct {
+		name      string
+		nr        NewRole
+		wantPerms []string
+		wantErr   bool
+	}{
+		{
+			name:      "cleaned",
+			nr:        NewRole{Name: "  Deputy  ", Permissions: []string{PermMarksPublish, PermClassesManage, PermMarksPublish}},
+			wantPerms: []string{PermClassesManage, PermMarksPublish},
+		},
+		{name: "no name", nr: NewRole{Permissions: []string{PermClassesManage}}, wantErr: true},
+		{name: "no permissions", nr: NewRole{Name: "Deputy"}, wantErr: true},
+		{name: "unknown permission", nr: NewRole{Name: "Deputy", Permissions: []string{"users.fly"}}, wantErr: true},
+		{name: "global permission", nr: NewRole{Name: "Deputy", Permissions: []string{PermClassesManage, PermUsersRead}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.nr.Validate(validate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.nr.Name != "Deputy" {
+				t.Errorf("Name = %q; want %q", tt.nr.Name, "Deputy")
+			}
+			if !reflect.DeepEqual(tt.nr.Permissions, tt.wantPerms) {
+				t.Errorf("Permissions = %v; want %v", tt.nr.Permissions, tt.wantPerms)
+			}
+		})
+	}
+
+	nr := NewRole{Name: "Deputy", Permissions: []string{"users.fly"}}
+	if _, ok := nr.Validate(validate).(*core.ValidationError); !ok {
+		t.Error("Validate(unknown permission): want a *core.ValidationError")
+	}
+}
@@ -0,0 +1,236 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/user"
+)
+
+var (
+	// errors
+	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleExists   = errors.New("a role with this name already exists")
+	ErrForbidden    = errors.New("not enough rights")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// CreateRole returns ErrRoleExists when the School has a Role with the same name.
+		CreateRole(ctx context.Context, r Role, exec ...core.DBExecutor) (Role, error)
+		QueryRoles(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]Role, error)
+		GetRole(ctx context.Context, id string, exec ...core.DBExecutor) (Role, error)
+		// UpdateRole returns ErrRoleExists when the School has another Role with the same name.
+		UpdateRole(ctx context.Context, r Role, exec ...core.DBExecutor) (Role, error)
+		DeleteRole(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		AddRoleMembers(ctx context.Context, roleID string, userIDs []string, exec ...core.DBExecutor) error
+		RemoveRoleMembers(ctx context.Context, roleID string, userIDs []string, exec ...core.DBExecutor) error
+		QueryRoleMemberIDs(ctx context.Context, roleID string, exec ...core.DBExecutor) ([]string, error)
+		// QuerySchoolMemberIDs returns the IDs among userIDs of the Students & Teachers of a School.
+		QuerySchoolMemberIDs(ctx context.Context, schoolID string, userIDs []string, exec ...core.DBExecutor) ([]string, error)
+		// QueryMemberRoles returns the Roles of a School a User is a member of.
+		QueryMemberRoles(ctx context.Context, userID, schoolID string, exec ...core.DBExecutor) ([]Role, error)
+	}
+
+	ServiceInterface interface {
+		// Permissions returns the permissions of a User: those of their built-in roles, and those of their
+		// custom Roles in the School when schoolID is set.
+		Permissions(usr user.User, schoolID string) (Set, error)
+		// HasPermissions reports whether a User has all perms; see Permissions.
+		HasPermissions(usr user.User, schoolID string, perms ...string) (bool, error)
+
+		// CreateRole creates a custom Role of a School; actors can only grant the permissions they have.
+		CreateRole(actor user.User, schoolID string, nr NewRole) (Role, error)
+		QueryRoles(schoolID string) ([]Role, error)
+		GetRole(id string) (Role, error)
+		// UpdateRole renames a custom Role and replaces its permissions; see CreateRole.
+		UpdateRole(actor user.User, r Role, nr NewRole) (Role, error)
+		DeleteRole(id string) error
+
+		// AddRoleMembers gives a custom Role to Students & Teachers of its School; actors cannot give Roles with
+		// permissions they lack, nor to Users above them in the role hierarchy. The change of each User is recorded
+		// in the audit log.
+		AddRoleMembers(actor user.User, r Role, userIDs []string, auditActor core.AuditActor) error
+		// RemoveRoleMembers takes a custom Role from Users; see AddRoleMembers.
+		RemoveRoleMembers(actor user.User, r Role, userIDs []string, auditActor core.AuditActor) error
+		RoleMemberIDs(roleID string) ([]string, error)
+	}
+
+	Service struct {
+		db       core.DB
+		repo     Repository
+		userSvc  user.ServiceInterface
+		auditLog core.AuditLog
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(db core.DB, repo Repository, userSvc user.ServiceInterface, auditLog core.AuditLog) *Service {
+	return &Service{db: db, repo: repo, userSvc: userSvc, auditLog: auditLog}
+}
+
+func (svc *Service) Permissions(usr user.User, schoolID string) (Set, error) {
+	perms := RolePermissions(usr.Roles)
+	if schoolID == "" {
+		return perms, nil
+	}
+	roles, err := svc.repo.QueryMemberRoles(context.Background(), usr.ID, schoolID)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying member roles")
+	}
+	for _, r := range roles {
+		perms.Add(r.Permissions...)
+	}
+	return perms, nil
+}
+
+func (svc *Service) HasPermissions(usr user.User, schoolID string, perms ...string) (bool, error) {
+	// built-in roles first: most checks need no query
+	if RolePermissions(usr.Roles).HasAll(perms...) {
+		return true, nil
+	}
+	if schoolID == "" {
+		return false, nil
+	}
+	all, err := svc.Permissions(usr, schoolID)
+	if err != nil {
+		return false, err
+	}
+	return all.HasAll(perms...), nil
+}
+
+// checkGrant returns ErrForbidden unless an actor has all the permissions of a Role in its School.
+func (svc *Service) checkGrant(actor user.User, schoolID string, perms []string) error {
+	ok, err := svc.HasPermissions(actor, schoolID, perms...)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (svc *Service) CreateRole(actor user.User, schoolID string, nr NewRole) (Role, error) {
+	if err := svc.checkGrant(actor, schoolID, nr.Permissions); err != nil {
+		return Role{}, err
+	}
+	r, err := svc.repo.CreateRole(context.Background(), Role{SchoolID: schoolID, Name: nr.Name, Permissions: nr.Permissions})
+	if err == ErrRoleExists {
+		return Role{}, core.NewValidationError(err, core.FieldError{Field: "name", Error: err.Error()})
+	}
+	return r, errors.Wrap(err, "creating role")
+}
+
+func (svc *Service) QueryRoles(schoolID string) ([]Role, error) {
+	rs, err := svc.repo.QueryRoles(context.Background(), schoolID)
+	return rs, errors.Wrap(err, "querying roles")
+}
+
+func (svc *Service) GetRole(id string) (Role, error) {
+	r, err := svc.repo.GetRole(context.Background(), id)
+	return r, errors.Wrap(err, "finding role by ID")
+}
+
+func (svc *Service) UpdateRole(actor user.User, r Role, nr NewRole) (Role, error) {
+	// both the permissions taken & given must be the actor's
+	if err := svc.checkGrant(actor, r.SchoolID, append(nr.Permissions, r.Permissions...)); err != nil {
+		return Role{}, err
+	}
+	r.Name, r.Permissions = nr.Name, nr.Permissions
+	r, err := svc.repo.UpdateRole(context.Background(), r)
+	if err == ErrRoleExists {
+		return Role{}, core.NewValidationError(err, core.FieldError{Field: "name", Error: err.Error()})
+	}
+	return r, errors.Wrap(err, "updating role")
+}
+
+func (svc *Service) DeleteRole(id string) error {
+	return errors.Wrap(svc.repo.DeleteRole(context.Background(), id), "deleting role")
+}
+
+// checkMembers returns ErrForbidden unless an actor can give or take a Role to or from Users.
+func (svc *Service) checkMembers(actor user.User, r Role, userIDs []string) error {
+	if err := svc.checkGrant(actor, r.SchoolID, r.Permissions); err != nil {
+		return err
+	}
+	targets := make([]user.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		usr, err := svc.userSvc.GetByID(id)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				return core.NewValidationError(err, core.FieldError{Field: "ids", Error: "unknown user " + id})
+			}
+			return err
+		}
+		targets = append(targets, usr)
+	}
+	return CheckHierarchy(actor, ActionUpdate, targets...)
+}
+
+func (svc *Service) AddRoleMembers(actor user.User, r Role, userIDs []string, auditActor core.AuditActor) error {
+	if err := svc.checkMembers(actor, r, userIDs); err != nil {
+		return err
+	}
+	memberIDs, err := svc.repo.QuerySchoolMemberIDs(context.Background(), r.SchoolID, userIDs)
+	if err != nil {
+		return errors.Wrap(err, "querying school members")
+	}
+	members := NewSet(memberIDs...)
+	for _, id := range userIDs {
+		if !members.Has(id) {
+			return core.NewValidationError(nil, core.FieldError{Field: "ids", Error: "not in the school " + id})
+		}
+	}
+	err = svc.changeMembers(r, userIDs, auditActor, core.AuditRoleMemberAdd, svc.repo.AddRoleMembers)
+	return errors.Wrap(err, "adding role members")
+}
+
+func (svc *Service) RemoveRoleMembers(actor user.User, r Role, userIDs []string, auditActor core.AuditActor) error {
+	if err := svc.checkMembers(actor, r, userIDs); err != nil {
+		return err
+	}
+	err := svc.changeMembers(r, userIDs, auditActor, core.AuditRoleMemberRemove, svc.repo.RemoveRoleMembers)
+	return errors.Wrap(err, "removing role members")
+}
+
+// changeMembers gives or takes a Role to or from Users, and records the change of each User in the same transaction.
+func (svc *Service) changeMembers(
+	r Role,
+	userIDs []string,
+	actor core.AuditActor,
+	action string,
+	change func(ctx context.Context, roleID string, userIDs []string, exec ...core.DBExecutor) error,
+) error {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if err = change(ctx, r.ID, userIDs, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	role := core.AuditChange{After: r.ID}
+	if action == core.AuditRoleMemberRemove {
+		role = core.AuditChange{Before: r.ID}
+	}
+	for _, id := range userIDs {
+		e := core.NewAuditEntry(actor, action, id, map[string]core.AuditChange{"role": role})
+		if err = svc.auditLog.Record(ctx, e, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) RoleMemberIDs(roleID string) ([]string, error) {
+	ids, err := svc.repo.QueryRoleMemberIDs(context.Background(), roleID)
+	return ids, errors.Wrap(err, "querying role members")
+}
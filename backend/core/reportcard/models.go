@@ -0,0 +1,69 @@
+package reportcard
+
+import (
+	"fmt"
+	htmltmpl "html/template"
+	"time"
+
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+// Card is the data of the `report-card` document template.
+type Card struct {
+	School   school.School
+	Logo     htmltmpl.URL // data URI of the School logo; empty when not set
+	Class    school.Class
+	Term     school.Term
+	Student  user.User
+	Average  string
+	Rank     string
+	Courses  []CardCourse
+	IssuedOn time.Time
+}
+
+type CardCourse struct {
+	Name       string
+	Average    string
+	Categories []CardCategory
+}
+
+type CardCategory struct {
+	Name    string
+	Weight  float64
+	Average string
+}
+
+func newCard(sch school.School, logo htmltmpl.URL, cls school.Class, term school.Term, student user.User, rep gradebook.Report) Card {
+	card := Card{
+		School:   sch,
+		Logo:     logo,
+		Class:    cls,
+		Term:     term,
+		Student:  student,
+		Average:  formatAverage(rep.Average),
+		Rank:     "-",
+		Courses:  make([]CardCourse, 0, len(rep.Courses)),
+		IssuedOn: time.Now(),
+	}
+	if rep.Rank > 0 {
+		card.Rank = fmt.Sprintf("%d / %d", rep.Rank, rep.ClassSize)
+	}
+	for _, crs := range rep.Courses {
+		cc := CardCourse{Name: crs.Name, Average: formatAverage(crs.Average)}
+		for _, cat := range crs.Categories {
+			cc.Categories = append(cc.Categories, CardCategory{Name: cat.Name, Weight: cat.Weight, Average: formatAverage(cat.Average)})
+		}
+		card.Courses = append(card.Courses, cc)
+	}
+	return card
+}
+
+// formatAverage formats a percentage; "-" when not marked yet.
+func formatAverage(avg *float64) string {
+	if avg == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f %%", *avg)
+}
@@ -0,0 +1,261 @@
+package reportcard
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	htmltmpl "html/template"
+	"io/ioutil"
+	"net/mail"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const documentTemplate = "report-card"
+
+var (
+	// errors
+	ErrStudentNotFound = errors.New("student not found in class")
+)
+
+type (
+	ServiceInterface interface {
+		// StudentCard generates the PDF report card of a Student for a Term and returns its media name.
+		// Only published Marks are considered when publishedOnly.
+		StudentCard(cls school.Class, termID, studentID string, publishedOnly bool) (string, error)
+		// ClassCards generates the PDF report cards of all Students of a Class in a zip file and returns its media name.
+		ClassCards(cls school.Class, termID string) (string, error)
+		// EmailCards emails their PDF report card to the Students of a Class, and returns the number of emails sent.
+		EmailCards(cls school.Class, termID string) (int, error)
+	}
+
+	Service struct {
+		conf         *core.Config
+		gradebookSvc gradebook.ServiceInterface
+		schoolSvc    school.ServiceInterface
+		userSvc      user.ServiceInterface
+		media        core.MediaStorage
+		pdf          core.PDFRenderer
+		mailSvc      core.EmailService
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	gradebookSvc gradebook.ServiceInterface,
+	schoolSvc school.ServiceInterface,
+	userSvc user.ServiceInterface,
+	media core.MediaStorage,
+	pdf core.PDFRenderer,
+	mailSvc core.EmailService,
+) *Service {
+	return &Service{
+		conf:         conf,
+		gradebookSvc: gradebookSvc,
+		schoolSvc:    schoolSvc,
+		userSvc:      userSvc,
+		media:        media,
+		pdf:          pdf,
+		mailSvc:      mailSvc,
+	}
+}
+
+func (svc *Service) StudentCard(cls school.Class, termID, studentID string, publishedOnly bool) (string, error) {
+	cards, err := svc.cards(cls, termID, publishedOnly, studentID)
+	if err != nil {
+		return "", err
+	}
+	if len(cards) == 0 {
+		return "", ErrStudentNotFound
+	}
+
+	doc, err := svc.render(cards[0])
+	if err != nil {
+		return "", err
+	}
+	dir := mediaDir(cls, termID)
+	if publishedOnly {
+		dir += "/published"
+	}
+	name := dir + "/" + studentID + ".pdf"
+	return name, errors.Wrap(svc.media.Save(name, doc), "saving report card")
+}
+
+func (svc *Service) ClassCards(cls school.Class, termID string) (string, error) {
+	cards, err := svc.cards(cls, termID, false)
+	if err != nil {
+		return "", err
+	}
+
+	var buff bytes.Buffer
+	zw := zip.NewWriter(&buff)
+	used := make(map[string]bool, len(cards))
+	for _, card := range cards {
+		doc, err := svc.render(card)
+		if err != nil {
+			return "", err
+		}
+		fname := cardFilename(card.Student)
+		if used[fname] { // homonyms
+			fname = strings.TrimSuffix(fname, ".pdf") + " - " + card.Student.ID + ".pdf"
+		}
+		used[fname] = true
+
+		w, err := zw.Create(fname)
+		if err != nil {
+			return "", errors.Wrap(err, "creating zip entry")
+		}
+		if _, err = doc.WriteTo(w); err != nil {
+			return "", errors.Wrap(err, "writing zip entry")
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return "", errors.Wrap(err, "closing zip")
+	}
+
+	name := mediaDir(cls, termID) + ".zip"
+	return name, errors.Wrap(svc.media.Save(name, &buff), "saving report cards")
+}
+
+func (svc *Service) EmailCards(cls school.Class, termID string) (int, error) {
+	cards, err := svc.cards(cls, termID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	messages := make([]*core.EmailMessage, 0, len(cards))
+	for _, card := range cards {
+		if card.Student.Email == "" {
+			continue
+		}
+		doc, err := svc.render(card)
+		if err != nil {
+			return 0, err
+		}
+		msg := &core.EmailMessage{
+			To:           []mail.Address{{Name: card.Student.Name, Address: card.Student.Email}},
+			Subject:      fmt.Sprintf("Report Card - %s", card.Term.Name),
+			TemplateName: "report-card",
+			TemplateData: map[string]interface{}{"Card": card},
+			Conf:         svc.conf,
+		}
+		if err = msg.Attach(doc, cardFilename(card.Student), "application/pdf"); err != nil {
+			return 0, errors.Wrap(err, "attaching report card")
+		}
+		messages = append(messages, msg)
+	}
+	svc.mailSvc.SendMessages(messages...)
+	return len(messages), nil
+}
+
+// cards returns the Cards of the Students of a Class, sorted by name; only the ones of the given Students if any.
+func (svc *Service) cards(cls school.Class, termID string, publishedOnly bool, studentID ...string) ([]Card, error) {
+	term, err := svc.schoolSvc.GetTerm(termID)
+	if err != nil && errors.Cause(err) != school.ErrTermNotFound {
+		return nil, err
+	}
+	if err != nil || term.SchoolID != cls.SchoolID {
+		return nil, core.NewValidationError(nil, core.FieldError{Field: "term_id", Error: "invalid value"})
+	}
+	sch, err := svc.schoolSvc.GetSchool(cls.SchoolID)
+	if err != nil {
+		return nil, err
+	}
+	logo, err := svc.logo(sch)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := svc.gradebookSvc.ClassReports(cls, termID, publishedOnly)
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(studentID))
+	for _, id := range studentID {
+		wanted[id] = true
+	}
+
+	cards := make([]Card, 0, len(reports))
+	for _, rep := range reports {
+		if len(wanted) > 0 && !wanted[rep.StudentID] {
+			continue
+		}
+		student, err := svc.userSvc.GetByID(rep.StudentID)
+		if err != nil {
+			if errors.Cause(err) == user.ErrNotFound {
+				continue // soft-deleted
+			}
+			return nil, err
+		}
+		cards = append(cards, newCard(sch, logo, cls, term, student, rep))
+	}
+	sort.SliceStable(cards, func(i, j int) bool { return cards[i].Student.Name < cards[j].Student.Name })
+	return cards, nil
+}
+
+// logo returns the data URI of the logo of a School, so documents do not depend on external resources.
+func (svc *Service) logo(sch school.School) (htmltmpl.URL, error) {
+	if sch.Logo == "" {
+		return "", nil
+	}
+	f, err := svc.media.Open(sch.Logo)
+	if err != nil {
+		if errors.Cause(err) == core.ErrMediaNotFound {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "opening logo")
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", errors.Wrap(err, "reading logo")
+	}
+
+	imgType := strings.TrimPrefix(path.Ext(sch.Logo), ".")
+	if imgType == "jpg" {
+		imgType = "jpeg"
+	}
+	return htmltmpl.URL("data:image/" + imgType + ";base64," + base64.StdEncoding.EncodeToString(data)), nil
+}
+
+func (svc *Service) render(card Card) (*bytes.Buffer, error) {
+	doc, err := core.RenderDocument(documentTemplate, card)
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering document")
+	}
+	var buff bytes.Buffer
+	if err = svc.pdf.Render(&buff, doc); err != nil {
+		return nil, errors.Wrap(err, "rendering pdf")
+	}
+	return &buff, nil
+}
+
+func mediaDir(cls school.Class, termID string) string {
+	return "report-cards/" + termID + "/" + cls.ID
+}
+
+// cardFilename returns a file name safe for all platforms; eg. "Report Card - Jean Kabila.pdf"
+func cardFilename(student user.User) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ' || r == '-' || r == '.' {
+			return r
+		}
+		return -1
+	}, student.Name)
+	if name = strings.TrimSpace(name); name == "" {
+		name = student.Username
+	}
+	return "Report Card - " + name + ".pdf"
+}
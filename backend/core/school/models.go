@@ -0,0 +1,188 @@
+package school
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+type School struct {
+	ID       string `json:"id"` // UUID
+	Name     string `json:"name"`
+	IsActive *bool  `json:"is_active"`
+	Motto    string `json:"motto"`
+	Logo     string `json:"logo"` // media name; printed on documents
+	// AbsenceThreshold is the number of absences of a Student in a Term triggering an alert to the AttendanceContact.
+	AbsenceThreshold  int    `json:"absence_threshold"` // disabled when 0
+	AttendanceContact string `json:"attendance_contact"`
+	SMSSenderID       string `json:"sms_sender_id"`      // the default sender ID is used when empty
+	SMSMonthlyQuota   int    `json:"sms_monthly_quota"`  // text messages sent per calendar month; disabled when 0
+	RequireAdmin2FA   bool   `json:"require_admin_2fa"`  // admins must log in with two-factor authentication
+	PasswordlessLogin bool   `json:"passwordless_login"` // members may log in by email link or code; never admins
+	// RequireVerifiedEmail keeps account emails (password resets, login links, notifications...) from being sent to
+	// unverified addresses of members, and of their Guardians.
+	RequireVerifiedEmail bool      `json:"require_verified_email"`
+	CreatedAt            time.Time `json:"created_at"` // UTC
+	UpdatedAt            time.Time `json:"updated_at"` // UTC
+}
+
+func (s *School) SetActive(val bool) {
+	s.IsActive = func(b bool) *bool { return &b }(val)
+}
+
+type Department struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// Class is a group of Students of the same YearLevel for a given academic Year.
+type Class struct {
+	ID                  string    `json:"id"` // UUID
+	SchoolID            string    `json:"school_id"`
+	DepartmentID        string    `json:"department_id,omitempty"`
+	Name                string    `json:"name"`
+	YearLevel           int       `json:"year_level"`
+	Year                int       `json:"year"`                  // academic year start; eg. 2026 for 2026-2027
+	MarksPublishedUntil time.Time `json:"marks_published_until"` // UTC; Students see the scores of their Marks as of then
+	CreatedAt           time.Time `json:"created_at"`            // UTC
+	UpdatedAt           time.Time `json:"updated_at"`            // UTC
+}
+
+// Term is a period of the academic year of a School; eg. "1er Trimestre".
+type Term struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"starts_on"`
+	EndsOn    time.Time `json:"ends_on"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// Course is a subject taught to a Class by a Teacher.
+// Students of the Class are enrolled in all of its Courses.
+type Course struct {
+	ID        string    `json:"id"` // UUID
+	ClassID   string    `json:"class_id"`
+	TeacherID string    `json:"teacher_id,omitempty"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// NewSchool contains information needed to create a new School.
+type NewSchool struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (ns *NewSchool) Validate(validate *validator.Validate) error {
+	ns.Name = core.CleanString(ns.Name)
+	return validate.Struct(ns)
+}
+
+// SchoolBranding contains the School identity printed on documents.
+type SchoolBranding struct {
+	Motto string `form:"motto" validate:"max=254"`
+	Logo  string `form:"-"` // media name of the uploaded logo; unchanged when empty
+}
+
+func (sb *SchoolBranding) Validate(validate *validator.Validate) error {
+	sb.Motto = core.CleanString(sb.Motto)
+	return validate.Struct(sb)
+}
+
+// AttendanceAlert configures the absence alerts of a School.
+type AttendanceAlert struct {
+	Threshold int    `json:"threshold" validate:"min=0"`
+	Contact   string `json:"contact" validate:"required_with=Threshold,omitempty,email"`
+}
+
+func (aa *AttendanceAlert) Validate(validate *validator.Validate) error {
+	aa.Contact = core.CleanString(aa.Contact)
+	if aa.Threshold == 0 {
+		aa.Contact = ""
+	}
+	return validate.Struct(aa)
+}
+
+// SMSSettings configures the text messages sent on behalf of a School.
+type SMSSettings struct {
+	SenderID     string `json:"sender_id" validate:"omitempty,alphanum,max=11"` // alphanumeric sender IDs are limited to 11 chars
+	MonthlyQuota int    `json:"monthly_quota" validate:"min=0"`
+}
+
+func (ss *SMSSettings) Validate(validate *validator.Validate) error {
+	ss.SenderID = core.CleanString(ss.SenderID)
+	return validate.Struct(ss)
+}
+
+// SecurityPolicy configures the authentication requirements of a School.
+type SecurityPolicy struct {
+	RequireAdmin2FA      bool `json:"require_admin_2fa"`
+	PasswordlessLogin    bool `json:"passwordless_login"`
+	RequireVerifiedEmail bool `json:"require_verified_email"`
+}
+
+// NewDepartment contains information needed to create a new Department.
+type NewDepartment struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (nd *NewDepartment) Validate(validate *validator.Validate) error {
+	nd.Name = core.CleanString(nd.Name)
+	return validate.Struct(nd)
+}
+
+// NewClass contains information needed to create a new Class.
+type NewClass struct {
+	DepartmentID string `json:"department_id" validate:"omitempty,uuid"`
+	Name         string `json:"name" validate:"required"`
+	YearLevel    int    `json:"year_level" validate:"required,min=1"`
+	Year         int    `json:"year" validate:"required,min=2000"`
+}
+
+func (nc *NewClass) Validate(validate *validator.Validate) error {
+	nc.Name = core.CleanString(nc.Name)
+	return validate.Struct(nc)
+}
+
+// NewTerm contains information needed to create a new Term.
+type NewTerm struct {
+	Name     string    `json:"name" validate:"required"`
+	StartsOn time.Time `json:"starts_on" validate:"required"`
+	EndsOn   time.Time `json:"ends_on" validate:"required,gtfield=StartsOn"`
+}
+
+func (nt *NewTerm) Validate(validate *validator.Validate) error {
+	nt.Name = core.CleanString(nt.Name)
+	return validate.Struct(nt)
+}
+
+// NewCourse contains information needed to create a new Course.
+type NewCourse struct {
+	TeacherID string `json:"teacher_id" validate:"omitempty,uuid"`
+	Name      string `json:"name" validate:"required"`
+}
+
+func (nc *NewCourse) Validate(validate *validator.Validate) error {
+	nc.Name = core.CleanString(nc.Name)
+	return validate.Struct(nc)
+}
+
+type ClassFilter struct {
+	SchoolID     string `query:"school_id"`
+	DepartmentID string `query:"department_id"`
+	Year         int    `query:"year"`
+	StudentID    string `query:"-"`
+}
+
+type CourseFilter struct {
+	ClassID   string `query:"class_id"`
+	TeacherID string `query:"teacher_id"`
+	StudentID string `query:"-"`
+}
@@ -0,0 +1,323 @@
+package school
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	// errors
+	ErrSchoolNotFound     = errors.New("school not found")
+	ErrDepartmentNotFound = errors.New("department not found")
+	ErrClassNotFound      = errors.New("class not found")
+	ErrTermNotFound       = errors.New("term not found")
+	ErrCourseNotFound     = errors.New("course not found")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateSchool(ctx context.Context, sch School, exec ...core.DBExecutor) (School, error)
+		QuerySchools(ctx context.Context, exec ...core.DBExecutor) ([]School, error)
+		// QueryMemberSchools returns the Schools a User studies or teaches in.
+		QueryMemberSchools(ctx context.Context, userID string, exec ...core.DBExecutor) ([]School, error)
+		// RequiresVerifiedEmail reports whether a School a User studies or teaches in, or one of the Students they
+		// are the Guardian of studies in, requires verified email addresses.
+		RequiresVerifiedEmail(ctx context.Context, userID string, exec ...core.DBExecutor) (bool, error)
+		GetSchool(ctx context.Context, id string, exec ...core.DBExecutor) (School, error)
+		UpdateSchool(ctx context.Context, sch School, exec ...core.DBExecutor) (School, error)
+
+		CreateDepartment(ctx context.Context, dept Department, exec ...core.DBExecutor) (Department, error)
+		QueryDepartments(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]Department, error)
+		GetDepartment(ctx context.Context, id string, exec ...core.DBExecutor) (Department, error)
+
+		CreateClass(ctx context.Context, cls Class, exec ...core.DBExecutor) (Class, error)
+		// QueryClasses returns all Classes or filters them by applying AND operation on available ClassFilter fields.
+		QueryClasses(ctx context.Context, filter ClassFilter, exec ...core.DBExecutor) ([]Class, error)
+		GetClass(ctx context.Context, id string, exec ...core.DBExecutor) (Class, error)
+		UpdateClass(ctx context.Context, cls Class, exec ...core.DBExecutor) (Class, error)
+		// PublishClassMarks copies the current scores of the Marks of a Class to their published ones.
+		PublishClassMarks(ctx context.Context, classID string, exec ...core.DBExecutor) error
+		AddClassStudents(ctx context.Context, classID string, studentIDs []string, exec ...core.DBExecutor) error
+		RemoveClassStudents(ctx context.Context, classID string, studentIDs []string, exec ...core.DBExecutor) (int, error)
+		QueryClassStudentIDs(ctx context.Context, classID string, exec ...core.DBExecutor) ([]string, error)
+
+		CreateTerm(ctx context.Context, term Term, exec ...core.DBExecutor) (Term, error)
+		QueryTerms(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]Term, error)
+		GetTerm(ctx context.Context, id string, exec ...core.DBExecutor) (Term, error)
+
+		CreateCourse(ctx context.Context, crs Course, exec ...core.DBExecutor) (Course, error)
+		// QueryCourses returns all Courses or filters them by applying AND operation on available CourseFilter fields.
+		QueryCourses(ctx context.Context, filter CourseFilter, exec ...core.DBExecutor) ([]Course, error)
+		GetCourse(ctx context.Context, id string, exec ...core.DBExecutor) (Course, error)
+		// IsEnrolled checks whether a Student belongs to the Class of a Course.
+		IsEnrolled(ctx context.Context, courseID, studentID string, exec ...core.DBExecutor) (bool, error)
+	}
+
+	ServiceInterface interface {
+		core.EmailPolicy
+
+		CreateSchool(ns NewSchool) (School, error)
+		QuerySchools() ([]School, error)
+		// MemberSchools returns the Schools a User studies or teaches in.
+		MemberSchools(userID string) ([]School, error)
+		GetSchool(id string) (School, error)
+		SetSchoolActive(id string, active bool) (School, error)
+		SetBranding(id string, sb SchoolBranding) (School, error)
+		SetAttendanceAlert(id string, aa AttendanceAlert) (School, error)
+		SetSMSSettings(id string, ss SMSSettings) (School, error)
+		SetSecurityPolicy(id string, sp SecurityPolicy) (School, error)
+
+		CreateDepartment(schoolID string, nd NewDepartment) (Department, error)
+		QueryDepartments(schoolID string) ([]Department, error)
+
+		CreateClass(schoolID string, nc NewClass) (Class, error)
+		QueryClasses(filter ClassFilter) ([]Class, error)
+		GetClass(id string) (Class, error)
+		// PublishMarks makes the current scores of the Marks of a Class viewable by its Students.
+		PublishMarks(classID string) (Class, error)
+		AddStudents(classID string, studentIDs ...string) error
+		RemoveStudents(classID string, studentIDs ...string) error
+		StudentIDs(classID string) ([]string, error)
+
+		CreateTerm(schoolID string, nt NewTerm) (Term, error)
+		QueryTerms(schoolID string) ([]Term, error)
+		GetTerm(id string) (Term, error)
+
+		CreateCourse(classID string, nc NewCourse) (Course, error)
+		QueryCourses(filter CourseFilter) ([]Course, error)
+		GetCourse(id string) (Course, error)
+		IsEnrolled(courseID, studentID string) (bool, error)
+	}
+
+	Service struct {
+		db   core.DB
+		repo Repository
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(db core.DB, repo Repository) *Service {
+	return &Service{
+		db:   db,
+		repo: repo,
+	}
+}
+
+func (svc *Service) CreateSchool(ns NewSchool) (School, error) {
+	sch := School{Name: ns.Name}
+	sch.SetActive(true)
+	sch, err := svc.repo.CreateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "creating school")
+}
+
+func (svc *Service) QuerySchools() ([]School, error) {
+	schs, err := svc.repo.QuerySchools(context.Background())
+	return schs, errors.Wrap(err, "querying schools")
+}
+
+func (svc *Service) MemberSchools(userID string) ([]School, error) {
+	schs, err := svc.repo.QueryMemberSchools(context.Background(), userID)
+	return schs, errors.Wrap(err, "querying member schools")
+}
+
+// RequiresVerifiedEmail also applies the policies of the Schools of the Students a User is the Guardian of.
+func (svc *Service) RequiresVerifiedEmail(userID string) (bool, error) {
+	required, err := svc.repo.RequiresVerifiedEmail(context.Background(), userID)
+	return required, errors.Wrap(err, "checking email verification policy")
+}
+
+func (svc *Service) GetSchool(id string) (School, error) {
+	sch, err := svc.repo.GetSchool(context.Background(), id)
+	return sch, errors.Wrap(err, "finding school by ID")
+}
+
+func (svc *Service) SetBranding(id string, sb SchoolBranding) (School, error) {
+	sch, err := svc.GetSchool(id)
+	if err != nil {
+		return School{}, err
+	}
+	sch.Motto = sb.Motto
+	if sb.Logo != "" {
+		sch.Logo = sb.Logo
+	}
+	sch, err = svc.repo.UpdateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "updating school")
+}
+
+func (svc *Service) SetAttendanceAlert(id string, aa AttendanceAlert) (School, error) {
+	sch, err := svc.GetSchool(id)
+	if err != nil {
+		return School{}, err
+	}
+	sch.AbsenceThreshold = aa.Threshold
+	sch.AttendanceContact = aa.Contact
+	sch, err = svc.repo.UpdateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "updating school")
+}
+
+func (svc *Service) SetSMSSettings(id string, ss SMSSettings) (School, error) {
+	sch, err := svc.GetSchool(id)
+	if err != nil {
+		return School{}, err
+	}
+	sch.SMSSenderID = ss.SenderID
+	sch.SMSMonthlyQuota = ss.MonthlyQuota
+	sch, err = svc.repo.UpdateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "updating school")
+}
+
+func (svc *Service) SetSecurityPolicy(id string, sp SecurityPolicy) (School, error) {
+	sch, err := svc.GetSchool(id)
+	if err != nil {
+		return School{}, err
+	}
+	sch.RequireAdmin2FA = sp.RequireAdmin2FA
+	sch.PasswordlessLogin = sp.PasswordlessLogin
+	sch.RequireVerifiedEmail = sp.RequireVerifiedEmail
+	sch, err = svc.repo.UpdateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "updating school")
+}
+
+func (svc *Service) SetSchoolActive(id string, active bool) (School, error) {
+	sch, err := svc.GetSchool(id)
+	if err != nil {
+		return School{}, err
+	}
+	sch.SetActive(active)
+	sch, err = svc.repo.UpdateSchool(context.Background(), sch)
+	return sch, errors.Wrap(err, "updating school")
+}
+
+func (svc *Service) CreateDepartment(schoolID string, nd NewDepartment) (Department, error) {
+	dept, err := svc.repo.CreateDepartment(context.Background(), Department{SchoolID: schoolID, Name: nd.Name})
+	return dept, errors.Wrap(err, "creating department")
+}
+
+func (svc *Service) QueryDepartments(schoolID string) ([]Department, error) {
+	depts, err := svc.repo.QueryDepartments(context.Background(), schoolID)
+	return depts, errors.Wrap(err, "querying departments")
+}
+
+func (svc *Service) CreateClass(schoolID string, nc NewClass) (Class, error) {
+	ctx := context.Background()
+	if nc.DepartmentID != "" {
+		dept, err := svc.repo.GetDepartment(ctx, nc.DepartmentID)
+		if err != nil {
+			if err == ErrDepartmentNotFound {
+				return Class{}, core.NewValidationError(err, core.FieldError{Field: "department_id", Error: "invalid value"})
+			}
+			return Class{}, errors.Wrap(err, "finding department by ID")
+		}
+		if dept.SchoolID != schoolID {
+			return Class{}, core.NewValidationError(nil, core.FieldError{Field: "department_id", Error: "invalid value"})
+		}
+	}
+
+	cls, err := svc.repo.CreateClass(ctx, Class{
+		SchoolID:     schoolID,
+		DepartmentID: nc.DepartmentID,
+		Name:         nc.Name,
+		YearLevel:    nc.YearLevel,
+		Year:         nc.Year,
+	})
+	return cls, errors.Wrap(err, "creating class")
+}
+
+func (svc *Service) QueryClasses(filter ClassFilter) ([]Class, error) {
+	classes, err := svc.repo.QueryClasses(context.Background(), filter)
+	return classes, errors.Wrap(err, "querying classes")
+}
+
+func (svc *Service) GetClass(id string) (Class, error) {
+	cls, err := svc.repo.GetClass(context.Background(), id)
+	return cls, errors.Wrap(err, "finding class by ID")
+}
+
+func (svc *Service) PublishMarks(classID string) (Class, error) {
+	cls, err := svc.GetClass(classID)
+	if err != nil {
+		return Class{}, err
+	}
+	cls.MarksPublishedUntil = time.Now().UTC()
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Class{}, errors.Wrap(err, "starting transaction")
+	}
+	if cls, err = svc.repo.UpdateClass(ctx, cls, tx); err != nil {
+		_ = tx.Rollback()
+		return Class{}, errors.Wrap(err, "updating class")
+	}
+	if err = svc.repo.PublishClassMarks(ctx, cls.ID, tx); err != nil {
+		_ = tx.Rollback()
+		return Class{}, errors.Wrap(err, "publishing class marks")
+	}
+	return cls, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) AddStudents(classID string, studentIDs ...string) error {
+	if len(studentIDs) == 0 {
+		return nil
+	}
+	return errors.Wrap(svc.repo.AddClassStudents(context.Background(), classID, studentIDs), "adding class students")
+}
+
+func (svc *Service) RemoveStudents(classID string, studentIDs ...string) error {
+	if len(studentIDs) == 0 {
+		return nil
+	}
+	_, err := svc.repo.RemoveClassStudents(context.Background(), classID, studentIDs)
+	return errors.Wrap(err, "removing class students")
+}
+
+func (svc *Service) StudentIDs(classID string) ([]string, error) {
+	ids, err := svc.repo.QueryClassStudentIDs(context.Background(), classID)
+	return ids, errors.Wrap(err, "querying class students")
+}
+
+func (svc *Service) CreateTerm(schoolID string, nt NewTerm) (Term, error) {
+	term, err := svc.repo.CreateTerm(context.Background(), Term{
+		SchoolID: schoolID,
+		Name:     nt.Name,
+		StartsOn: nt.StartsOn,
+		EndsOn:   nt.EndsOn,
+	})
+	return term, errors.Wrap(err, "creating term")
+}
+
+func (svc *Service) QueryTerms(schoolID string) ([]Term, error) {
+	terms, err := svc.repo.QueryTerms(context.Background(), schoolID)
+	return terms, errors.Wrap(err, "querying terms")
+}
+
+func (svc *Service) GetTerm(id string) (Term, error) {
+	term, err := svc.repo.GetTerm(context.Background(), id)
+	return term, errors.Wrap(err, "finding term by ID")
+}
+
+func (svc *Service) CreateCourse(classID string, nc NewCourse) (Course, error) {
+	crs, err := svc.repo.CreateCourse(context.Background(), Course{ClassID: classID, TeacherID: nc.TeacherID, Name: nc.Name})
+	return crs, errors.Wrap(err, "creating course")
+}
+
+func (svc *Service) QueryCourses(filter CourseFilter) ([]Course, error) {
+	courses, err := svc.repo.QueryCourses(context.Background(), filter)
+	return courses, errors.Wrap(err, "querying courses")
+}
+
+func (svc *Service) GetCourse(id string) (Course, error) {
+	crs, err := svc.repo.GetCourse(context.Background(), id)
+	return crs, errors.Wrap(err, "finding course by ID")
+}
+
+func (svc *Service) IsEnrolled(courseID, studentID string) (bool, error) {
+	ok, err := svc.repo.IsEnrolled(context.Background(), courseID, studentID)
+	return ok, errors.Wrap(err, "checking enrollment")
+}
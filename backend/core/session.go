@@ -0,0 +1,10 @@
+package core
+
+import "context"
+
+// SessionRevoker is any service revoking the login sessions of Users.
+type SessionRevoker interface {
+	// RevokeUserSessions revokes all the refresh tokens of a User; a sql.Tx is passed as exec for the revocation
+	// to be committed with the change requiring it (password reset, deletion...).
+	RevokeUserSessions(ctx context.Context, userID string, exec ...DBExecutor) error
+}
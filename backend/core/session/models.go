@@ -0,0 +1,50 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const tokenSize = 32 // random bytes
+
+// RefreshToken is an opaque credential exchanged once for a new access JWT & a new RefreshToken.
+// The RefreshTokens rotated from the same login form a family, which expires with its first token.
+type RefreshToken struct {
+	ID        string    `json:"id"` // UUID
+	FamilyID  string    `json:"family_id"`
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"` // UTC
+	UsedAt    time.Time `json:"used_at"`    // UTC; zero until rotated
+	RevokedAt time.Time `json:"revoked_at"` // UTC; zero unless revoked
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// IsUsed reports whether the RefreshToken was already exchanged.
+func (rt RefreshToken) IsUsed() bool { return !rt.UsedAt.IsZero() }
+
+// IsRevoked reports whether the family of the RefreshToken was revoked.
+func (rt RefreshToken) IsRevoked() bool { return !rt.RevokedAt.IsZero() }
+
+// IsExpired reports whether the RefreshToken has expired at t.
+func (rt RefreshToken) IsExpired(t time.Time) bool { return !t.Before(rt.ExpiresAt) }
+
+// newToken returns a random token & its hash, which is stored instead of the token.
+func newToken() (token, hash string, err error) {
+	b := make([]byte, tokenSize)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", errors.Wrap(err, "generating token")
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
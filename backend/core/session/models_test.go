@@ -0,0 +1,48 @@
+package session
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      bool
+	}{
+		{name: "future", expiresAt: now.Add(time.Minute), want: false},
+		{name: "now", expiresAt: now, want: true},
+		{name: "past", expiresAt: now.Add(-time.Minute), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := RefreshToken{ExpiresAt: tt.expiresAt}
+			if got := rt.IsExpired(now); got != tt.want {
+				t.Errorf("IsExpired() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	token, hash, err := newToken()
+	if err != nil {
+		t.Fatalf("newToken(): %v", err)
+	}
+	if token == "" || hash == token {
+		t.Errorf("newToken() = %q, %q; want a token & its hash", token, hash)
+	}
+	if got := hashToken(token); got != hash {
+		t.Errorf("hashToken() = %q; want %q", got, hash)
+	}
+
+	other, _, err := newToken()
+	if err != nil {
+		t.Fatalf("newToken(): %v", err)
+	}
+	if other == token {
+		t.Error("newToken() returned the same token twice")
+	}
+}
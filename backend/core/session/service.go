@@ -0,0 +1,154 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	// errors
+	ErrTokenInvalid = errors.New("invalid refresh token")
+	ErrTokenExpired = errors.New("refresh has expired")
+	ErrTokenReused  = errors.New("refresh token reused")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		CreateToken(ctx context.Context, rt RefreshToken, tokenHash string, exec ...core.DBExecutor) (RefreshToken, error)
+		// LockTokenByHash returns the RefreshToken with the hash, locked until the end of the transaction,
+		// or ErrTokenInvalid.
+		LockTokenByHash(ctx context.Context, tokenHash string, exec ...core.DBExecutor) (RefreshToken, error)
+		MarkTokenUsed(ctx context.Context, id string, at time.Time, exec ...core.DBExecutor) error
+		RevokeFamily(ctx context.Context, familyID string, at time.Time, exec ...core.DBExecutor) error
+		RevokeUserTokens(ctx context.Context, userID string, at time.Time, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		core.SessionRevoker
+
+		// Issue returns the first RefreshToken of a new family, on login.
+		Issue(userID string) (string, RefreshToken, error)
+		// Rotate exchanges a RefreshToken for a new one of the same family.
+		// Reusing an exchanged token revokes its whole family: either the User or an attacker holds a stolen token.
+		Rotate(token string) (string, RefreshToken, error)
+		// RevokeFamily revokes the family of a RefreshToken; on logout.
+		RevokeFamily(token string) error
+	}
+
+	Service struct {
+		db         core.DB
+		repo       Repository
+		expiration time.Duration
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(db core.DB, repo Repository, conf *core.Config) *Service {
+	return &Service{db: db, repo: repo, expiration: conf.Server.JWTRefreshExpiration}
+}
+
+func (svc *Service) Issue(userID string) (string, RefreshToken, error) {
+	return svc.create(context.Background(), RefreshToken{
+		UserID:    userID,
+		ExpiresAt: time.Now().UTC().Add(svc.expiration),
+	})
+}
+
+// create stores a new RefreshToken; of a new family unless rt.FamilyID is set.
+func (svc *Service) create(ctx context.Context, rt RefreshToken, exec ...core.DBExecutor) (string, RefreshToken, error) {
+	token, hash, err := newToken()
+	if err != nil {
+		return "", RefreshToken{}, err
+	}
+	rt, err = svc.repo.CreateToken(ctx, rt, hash, exec...)
+	if err != nil {
+		return "", RefreshToken{}, errors.Wrap(err, "creating refresh token")
+	}
+	return token, rt, nil
+}
+
+func (svc *Service) Rotate(token string) (string, RefreshToken, error) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", RefreshToken{}, errors.Wrap(err, "starting transaction")
+	}
+	// concurrent rotations of the same token wait for each other: only the first succeeds
+	rt, err := svc.repo.LockTokenByHash(ctx, hashToken(token), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if err == ErrTokenInvalid {
+			return "", RefreshToken{}, err
+		}
+		return "", RefreshToken{}, errors.Wrap(err, "locking refresh token")
+	}
+
+	switch {
+	case rt.IsRevoked():
+		_ = tx.Rollback()
+		return "", RefreshToken{}, ErrTokenInvalid
+	case rt.IsUsed():
+		if err = svc.repo.RevokeFamily(ctx, rt.FamilyID, now, tx); err != nil {
+			_ = tx.Rollback()
+			return "", RefreshToken{}, errors.Wrap(err, "revoking token family")
+		}
+		if err = tx.Commit(); err != nil {
+			return "", RefreshToken{}, errors.Wrap(err, "committing transaction")
+		}
+		return "", RefreshToken{}, ErrTokenReused
+	case rt.IsExpired(now):
+		_ = tx.Rollback()
+		return "", RefreshToken{}, ErrTokenExpired
+	}
+
+	if err = svc.repo.MarkTokenUsed(ctx, rt.ID, now, tx); err != nil {
+		_ = tx.Rollback()
+		return "", RefreshToken{}, errors.Wrap(err, "marking refresh token used")
+	}
+	newToken, newRT, err := svc.create(ctx, RefreshToken{
+		FamilyID:  rt.FamilyID,
+		UserID:    rt.UserID,
+		ExpiresAt: rt.ExpiresAt,
+	}, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return "", RefreshToken{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return "", RefreshToken{}, errors.Wrap(err, "committing transaction")
+	}
+	return newToken, newRT, nil
+}
+
+func (svc *Service) RevokeFamily(token string) error {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	rt, err := svc.repo.LockTokenByHash(ctx, hashToken(token), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if err == ErrTokenInvalid {
+			return err
+		}
+		return errors.Wrap(err, "locking refresh token")
+	}
+	if err = svc.repo.RevokeFamily(ctx, rt.FamilyID, time.Now().UTC(), tx); err != nil {
+		_ = tx.Rollback()
+		return errors.Wrap(err, "revoking token family")
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) RevokeUserSessions(ctx context.Context, userID string, exec ...core.DBExecutor) error {
+	return errors.Wrap(svc.repo.RevokeUserTokens(ctx, userID, time.Now().UTC(), exec...), "revoking user tokens")
+}
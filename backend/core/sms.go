@@ -0,0 +1,15 @@
+package core
+
+type (
+	// SMSMessage is a text message sent to a phone.
+	SMSMessage struct {
+		To   string // E.164 phone number; eg. +243810000000
+		From string // sender ID; eg. the name of a School
+		Body string
+	}
+
+	// SMSService is any service sending text messages through an SMS gateway.
+	SMSService interface {
+		Send(msg SMSMessage) error
+	}
+)
@@ -0,0 +1,37 @@
+package sms
+
+import "time"
+
+// Usage is the number of text messages sent on behalf of a School in a calendar month.
+type Usage struct {
+	SchoolID string    `json:"school_id"`
+	Month    time.Time `json:"month"` // first day of the month, UTC
+	Sent     int       `json:"sent"`
+	Quota    int       `json:"quota"` // SMS disabled when 0
+}
+
+// Remaining returns the number of text messages the School may still send this month.
+func (u Usage) Remaining() int {
+	if u.Sent >= u.Quota {
+		return 0
+	}
+	return u.Quota - u.Sent
+}
+
+// MonthOf returns the first day of the month of t, in UTC.
+func MonthOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Truncate shortens a text to fit in n characters, ending it with an ellipsis when cut.
+func Truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	if n < 1 {
+		return ""
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,46 @@
+package sms
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		text string
+		n    int
+		want string
+	}{
+		{text: "Parents meeting", n: 160, want: "Parents meeting"},
+		{text: "Parents meeting", n: 15, want: "Parents meeting"},
+		{text: "Parents meeting", n: 8, want: "Parents…"},
+		{text: "Réunion des parents", n: 4, want: "Réu…"},
+		{text: "Parents meeting", n: 0, want: ""},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.text, tt.n); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q; want %q", tt.text, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestUsage_Remaining(t *testing.T) {
+	tests := map[Usage]int{
+		{Sent: 0, Quota: 0}:  0,
+		{Sent: 3, Quota: 10}: 7,
+		{Sent: 10, Quota: 5}: 0, // quota lowered during the month
+	}
+	for u, want := range tests {
+		if got := u.Remaining(); got != want {
+			t.Errorf("%+v.Remaining() = %d; want %d", u, got, want)
+		}
+	}
+}
+
+func TestMonthOf(t *testing.T) {
+	kinshasa := time.FixedZone("WAT", 3600)
+	got := MonthOf(time.Date(2026, time.November, 1, 0, 30, 0, 0, kinshasa)) // still October in UTC
+	if want := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("MonthOf() = %v; want %v", got, want)
+	}
+}
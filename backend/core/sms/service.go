@@ -0,0 +1,105 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+)
+
+// MaxLength is the number of characters of a single-part text message.
+const MaxLength = 160
+
+var (
+	// errors
+	ErrQuotaExceeded = errors.New("monthly sms quota exceeded")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// IncrementUsage counts a text message sent by a School in a month, unless its quota is reached;
+		// it reports whether the message was counted.
+		IncrementUsage(ctx context.Context, schoolID string, month time.Time, quota int, exec ...core.DBExecutor) (bool, error)
+		// GetSent returns the number of text messages sent by a School in a month.
+		GetSent(ctx context.Context, schoolID string, month time.Time, exec ...core.DBExecutor) (int, error)
+	}
+
+	ServiceInterface interface {
+		// Send texts messages on behalf of a School, from its sender ID and within its monthly quota.
+		// It returns the number of messages sent, and ErrQuotaExceeded when some were dropped;
+		// failing messages count towards the quota and are logged.
+		Send(schoolID string, messages ...core.SMSMessage) (int, error)
+		// Usage returns the SMS Usage of a School in the month of t.
+		Usage(schoolID string, t time.Time) (Usage, error)
+	}
+
+	Service struct {
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		smsSvc    core.SMSService
+		logger    core.Logger
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(repo Repository, schoolSvc school.ServiceInterface, smsSvc core.SMSService, logger core.Logger) *Service {
+	return &Service{
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		smsSvc:    smsSvc,
+		logger:    logger,
+	}
+}
+
+func (svc *Service) Send(schoolID string, messages ...core.SMSMessage) (int, error) {
+	if len(messages) == 0 {
+		return 0, nil
+	}
+	sch, err := svc.schoolSvc.GetSchool(schoolID)
+	if err != nil {
+		return 0, err
+	}
+	if sch.SMSMonthlyQuota <= 0 {
+		return 0, ErrQuotaExceeded
+	}
+
+	ctx := context.Background()
+	month := MonthOf(time.Now())
+	var sent int
+	for _, msg := range messages {
+		// reserve the message before sending it, so concurrent senders cannot exceed the quota
+		ok, err := svc.repo.IncrementUsage(ctx, sch.ID, month, sch.SMSMonthlyQuota)
+		if err != nil {
+			return sent, errors.Wrap(err, "incrementing sms usage")
+		}
+		if !ok {
+			return sent, ErrQuotaExceeded
+		}
+		msg.From = sch.SMSSenderID // the default sender ID is used when empty
+		if err = svc.smsSvc.Send(msg); err != nil {
+			svc.logger.Error(fmt.Sprintf("sending sms: %v", err), err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (svc *Service) Usage(schoolID string, t time.Time) (Usage, error) {
+	sch, err := svc.schoolSvc.GetSchool(schoolID)
+	if err != nil {
+		return Usage{}, err
+	}
+	month := MonthOf(t)
+	sent, err := svc.repo.GetSent(context.Background(), sch.ID, month)
+	if err != nil {
+		return Usage{}, errors.Wrap(err, "finding sms usage")
+	}
+	return Usage{SchoolID: sch.ID, Month: month, Sent: sent, Quota: sch.SMSMonthlyQuota}, nil
+}
@@ -0,0 +1,153 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Plans
+const (
+	PlanTrial   = "trial"
+	PlanMonthly = "monthly"
+	PlanYearly  = "yearly"
+)
+
+// Statuses
+const (
+	StatusTrialing = "trialing" // until the end of the trial
+	StatusActive   = "active"   // paid until PaymentDueDate
+	StatusPastDue  = "past_due" // PaymentDueDate reached: warnings are sent until PaymentExtensionDate
+	StatusExpired  = "expired"  // PaymentExtensionDate reached: the School is deactivated
+)
+
+// Payment periods
+const (
+	PeriodYear  = "Y"
+	PeriodMonth = "M"
+)
+
+const (
+	extensionMonths    = 1              // PaymentExtensionDate = PaymentDueDate + 1 month
+	minWarningInterval = 24 * time.Hour // warnings stop once they would be sent more often
+)
+
+// transitions lists the Statuses a Subscription may move to, by Status.
+var transitions = map[string][]string{
+	StatusTrialing: {StatusActive, StatusPastDue, StatusExpired},
+	StatusActive:   {StatusActive, StatusPastDue, StatusExpired},
+	StatusPastDue:  {StatusActive, StatusExpired},
+	StatusExpired:  {StatusActive},
+}
+
+// Subscription is the billing state of a School.
+type Subscription struct {
+	SchoolID             string    `json:"school_id"`
+	Plan                 string    `json:"plan"`
+	Status               string    `json:"status"`
+	OwnerName            string    `json:"owner_name"`
+	OwnerEmail           string    `json:"owner_email"`            // receives receipts & payment warnings
+	PaymentDueDate       time.Time `json:"payment_due_date"`       // UTC; end of the trial or of the paid period
+	PaymentExtensionDate time.Time `json:"payment_extension_date"` // UTC; the School is deactivated once reached
+	WarningsSent         int       `json:"warnings_sent"`          // since PaymentDueDate
+	LastWarnedAt         time.Time `json:"last_warned_at"`         // UTC
+	CreatedAt            time.Time `json:"created_at"`             // UTC
+	UpdatedAt            time.Time `json:"updated_at"`             // UTC
+}
+
+// NewTrial returns the trial Subscription of a School created at t.
+func NewTrial(schoolID string, t time.Time, trial time.Duration) Subscription {
+	due := t.UTC().Add(trial)
+	return Subscription{
+		SchoolID:             schoolID,
+		Plan:                 PlanTrial,
+		Status:               StatusTrialing,
+		PaymentDueDate:       due,
+		PaymentExtensionDate: due.AddDate(0, extensionMonths, 0),
+	}
+}
+
+// CanTransition reports whether a Subscription may move to status.
+func (s Subscription) CanTransition(status string) bool {
+	for _, st := range transitions[s.Status] {
+		if st == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Pay extends a Subscription by a paid period from t, or from PaymentDueDate when paid in advance.
+func (s *Subscription) Pay(period string, t time.Time) {
+	from := t.UTC()
+	if s.Status != StatusTrialing && s.PaymentDueDate.After(from) {
+		from = s.PaymentDueDate // keep the remaining paid time
+	}
+	if period == PeriodMonth {
+		s.Plan = PlanMonthly
+		s.PaymentDueDate = from.AddDate(0, 1, 0)
+	} else {
+		s.Plan = PlanYearly
+		s.PaymentDueDate = from.AddDate(1, 0, 0)
+	}
+	s.PaymentExtensionDate = s.PaymentDueDate.AddDate(0, extensionMonths, 0)
+	s.Status = StatusActive
+	s.WarningsSent = 0
+	s.LastWarnedAt = time.Time{}
+}
+
+// WarningDue reports whether a payment warning should be sent at t.
+// Warnings are sent from PaymentDueDate until PaymentExtensionDate, exponentially more often:
+// the nth one once the time left is at most 1/2^n of the extension period.
+func (s Subscription) WarningDue(t time.Time) bool {
+	if s.Status == StatusExpired || t.Before(s.PaymentDueDate) || !t.Before(s.PaymentExtensionDate) {
+		return false
+	}
+	if s.WarningsSent >= 31 {
+		return false // avoid overflow
+	}
+	threshold := s.PaymentExtensionDate.Sub(s.PaymentDueDate) >> s.WarningsSent
+	if s.WarningsSent > 0 && threshold < minWarningInterval {
+		return false
+	}
+	return s.PaymentExtensionDate.Sub(t) <= threshold
+}
+
+// Expired reports whether the School of a Subscription should be deactivated at t.
+func (s Subscription) Expired(t time.Time) bool {
+	return !t.Before(s.PaymentExtensionDate)
+}
+
+// Payment is a payment received for the Subscription of a School.
+type Payment struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Period    string    `json:"period"` // Y | M
+	Amount    int       `json:"amount"` // in cents
+	Currency  string    `json:"currency"`
+	Reference string    `json:"reference"`
+	PaidAt    time.Time `json:"paid_at"`    // UTC
+	CreatedAt time.Time `json:"created_at"` // UTC
+}
+
+// NewPayment contains information needed to record a Payment.
+type NewPayment struct {
+	Period     string    `validate:"required,oneof=Y M"`
+	Amount     int       `validate:"min=1"`
+	Currency   string    `validate:"required,len=3"`
+	Reference  string    `validate:"max=100"`
+	PaidAt     time.Time // now when zero
+	OwnerName  string    `validate:"max=254"`
+	OwnerEmail string    `validate:"omitempty,email"` // replaces the owner of the Subscription when set
+}
+
+func (np *NewPayment) Validate(validate *validator.Validate) error {
+	np.Period = core.CleanString(np.Period)
+	np.Currency = core.CleanString(np.Currency)
+	np.Reference = core.CleanString(np.Reference)
+	np.OwnerName = core.CleanString(np.OwnerName)
+	np.OwnerEmail = core.CleanString(np.OwnerEmail, true /* lower */)
+	return validate.Struct(np)
+}
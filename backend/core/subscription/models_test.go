@@ -0,0 +1,87 @@
+package subscription
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscription_Pay(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	trial := NewTrial("id", now.AddDate(0, 0, -10), 30*24*time.Hour)
+	trial.Pay(PeriodYear, now)
+	if trial.Status != StatusActive || trial.Plan != PlanYearly || !trial.PaymentDueDate.Equal(now.AddDate(1, 0, 0)) {
+		t.Errorf("trial.Pay() = %+v; want yearly plan due in a year", trial)
+	}
+	if !trial.PaymentExtensionDate.Equal(trial.PaymentDueDate.AddDate(0, 1, 0)) {
+		t.Errorf("PaymentExtensionDate = %v; want a month after the due date", trial.PaymentExtensionDate)
+	}
+
+	// paid in advance: the remaining time is kept
+	early := trial
+	early.Pay(PeriodMonth, now)
+	if early.Plan != PlanMonthly || !early.PaymentDueDate.Equal(now.AddDate(1, 1, 0)) {
+		t.Errorf("early.Pay() = %+v; want due a month after the previous due date", early)
+	}
+
+	// past due: paid from now
+	late := Subscription{Status: StatusPastDue, PaymentDueDate: now.AddDate(0, 0, -5), WarningsSent: 3, LastWarnedAt: now}
+	late.Pay(PeriodMonth, now)
+	if late.Status != StatusActive || !late.PaymentDueDate.Equal(now.AddDate(0, 1, 0)) || late.WarningsSent != 0 || !late.LastWarnedAt.IsZero() {
+		t.Errorf("late.Pay() = %+v; want active, due in a month, warnings reset", late)
+	}
+}
+
+func TestSubscription_CanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{StatusTrialing, StatusPastDue, true},
+		{StatusActive, StatusTrialing, false},
+		{StatusPastDue, StatusActive, true},
+		{StatusPastDue, StatusTrialing, false},
+		{StatusExpired, StatusActive, true},
+		{StatusExpired, StatusPastDue, false},
+	}
+	for _, tc := range tests {
+		if got := (Subscription{Status: tc.from}).CanTransition(tc.to); got != tc.want {
+			t.Errorf("CanTransition(%s -> %s) = %v; want %v", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestSubscription_WarningDue(t *testing.T) {
+	due := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sub := Subscription{Status: StatusActive, PaymentDueDate: due, PaymentExtensionDate: due.Add(32 * 24 * time.Hour)}
+
+	if sub.WarningDue(due.Add(-time.Hour)) {
+		t.Error("WarningDue() before the due date = true")
+	}
+
+	// simulate a daily job: warnings get exponentially more frequent (days 0, 16, 24, 28, 30, 31)
+	var days []int
+	for d := 0; d < 40; d++ {
+		at := due.AddDate(0, 0, d)
+		if sub.WarningDue(at) {
+			days = append(days, d)
+			sub.Status = StatusPastDue
+			sub.WarningsSent++
+		}
+	}
+	want := []int{0, 16, 24, 28, 30, 31}
+	if len(days) != len(want) {
+		t.Fatalf("warning days = %v; want %v", days, want)
+	}
+	for i := range want {
+		if days[i] != want[i] {
+			t.Fatalf("warning days = %v; want %v", days, want)
+		}
+	}
+
+	sub.Status = StatusExpired
+	sub.WarningsSent = 0
+	if sub.WarningDue(due) {
+		t.Error("WarningDue() when expired = true")
+	}
+}
@@ -0,0 +1,262 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var (
+	// errors
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrInvalidTransition    = errors.New("invalid subscription status transition")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		GetSubscription(ctx context.Context, schoolID string, exec ...core.DBExecutor) (Subscription, error)
+		// SaveSubscription creates or updates the Subscription of a School.
+		SaveSubscription(ctx context.Context, sub Subscription, exec ...core.DBExecutor) (Subscription, error)
+		CreatePayment(ctx context.Context, p Payment, exec ...core.DBExecutor) (Payment, error)
+		// QueryPayments returns the Payments of a School, most recent first.
+		QueryPayments(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]Payment, error)
+	}
+
+	ServiceInterface interface {
+		// Get returns the Subscription of a School; Schools never paid for are on trial since their creation.
+		Get(schoolID string) (Subscription, error)
+		// NewPayment records a Payment, extends the Subscription of its School, reactivates the School if needed
+		// and thanks the owner.
+		NewPayment(schoolID string, np NewPayment) (Subscription, Payment, error)
+		QueryPayments(schoolID string) ([]Payment, error)
+		// SendWarnings emails the owners of the Subscriptions past due at t, exponentially more often
+		// as deactivation gets closer; it returns the number of warnings sent.
+		SendWarnings(t time.Time) (int, error)
+		// DeactivateExpired deactivates the Schools whose Subscription expired at t; it returns their number.
+		DeactivateExpired(t time.Time) (int, error)
+	}
+
+	Service struct {
+		conf      *core.Config
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		mailSvc   core.EmailService
+		logger    core.Logger
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	conf *core.Config,
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	mailSvc core.EmailService,
+	logger core.Logger,
+) *Service {
+	return &Service{
+		conf:      conf,
+		db:        db,
+		repo:      repo,
+		schoolSvc: schoolSvc,
+		mailSvc:   mailSvc,
+		logger:    logger,
+	}
+}
+
+func (svc *Service) Get(schoolID string) (Subscription, error) {
+	sch, err := svc.schoolSvc.GetSchool(schoolID)
+	if err != nil {
+		return Subscription{}, err
+	}
+	return svc.get(context.Background(), sch)
+}
+
+func (svc *Service) get(ctx context.Context, sch school.School, exec ...core.DBExecutor) (Subscription, error) {
+	sub, err := svc.repo.GetSubscription(ctx, sch.ID, exec...)
+	if err == ErrSubscriptionNotFound {
+		return NewTrial(sch.ID, sch.CreatedAt, svc.conf.TrialPeriod), nil
+	}
+	return sub, errors.Wrap(err, "finding subscription")
+}
+
+// transition moves a Subscription to status.
+func (svc *Service) transition(sub *Subscription, status string) error {
+	if !sub.CanTransition(status) {
+		return errors.Wrapf(ErrInvalidTransition, "%s -> %s", sub.Status, status)
+	}
+	sub.Status = status
+	return nil
+}
+
+func (svc *Service) NewPayment(schoolID string, np NewPayment) (Subscription, Payment, error) {
+	sch, err := svc.schoolSvc.GetSchool(schoolID)
+	if err != nil {
+		return Subscription{}, Payment{}, err
+	}
+	if np.PaidAt.IsZero() {
+		np.PaidAt = time.Now()
+	}
+	ctx := context.Background()
+
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Subscription{}, Payment{}, errors.Wrap(err, "starting transaction")
+	}
+	sub, err := svc.get(ctx, sch, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Subscription{}, Payment{}, err
+	}
+	if err = svc.transition(&sub, StatusActive); err != nil {
+		_ = tx.Rollback()
+		return Subscription{}, Payment{}, err
+	}
+	sub.Pay(np.Period, np.PaidAt)
+	if np.OwnerEmail != "" {
+		sub.OwnerName, sub.OwnerEmail = np.OwnerName, np.OwnerEmail
+	}
+	if sub, err = svc.repo.SaveSubscription(ctx, sub, tx); err != nil {
+		_ = tx.Rollback()
+		return Subscription{}, Payment{}, errors.Wrap(err, "saving subscription")
+	}
+	p, err := svc.repo.CreatePayment(ctx, Payment{
+		SchoolID:  schoolID,
+		Period:    np.Period,
+		Amount:    np.Amount,
+		Currency:  np.Currency,
+		Reference: np.Reference,
+		PaidAt:    np.PaidAt.UTC(),
+	}, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Subscription{}, Payment{}, errors.Wrap(err, "creating payment")
+	}
+	if err = tx.Commit(); err != nil {
+		return Subscription{}, Payment{}, errors.Wrap(err, "committing transaction")
+	}
+
+	if sch.IsActive != nil && !*sch.IsActive {
+		if sch, err = svc.schoolSvc.SetSchoolActive(sch.ID, true); err != nil {
+			return Subscription{}, Payment{}, err
+		}
+	}
+	if msg := svc.ownerMessage(sub, "Thank you for your payment", "payment-received", map[string]interface{}{
+		"School":       sch,
+		"Subscription": sub,
+		"Payment":      p,
+		"Amount":       fmt.Sprintf("%d.%02d %s", p.Amount/100, p.Amount%100, p.Currency),
+	}); msg != nil {
+		svc.mailSvc.SendMessages(msg)
+	}
+	return sub, p, nil
+}
+
+func (svc *Service) QueryPayments(schoolID string) ([]Payment, error) {
+	ps, err := svc.repo.QueryPayments(context.Background(), schoolID)
+	return ps, errors.Wrap(err, "querying payments")
+}
+
+func (svc *Service) SendWarnings(t time.Time) (int, error) {
+	ctx := context.Background()
+	schs, err := svc.schoolSvc.QuerySchools()
+	if err != nil {
+		return 0, err
+	}
+
+	var messages []*core.EmailMessage
+	for _, sch := range schs {
+		if sch.IsActive != nil && !*sch.IsActive {
+			continue
+		}
+		sub, err := svc.get(ctx, sch)
+		if err != nil {
+			return 0, err
+		}
+		if !sub.WarningDue(t) {
+			continue
+		}
+		if sub.Status != StatusPastDue {
+			if err = svc.transition(&sub, StatusPastDue); err != nil {
+				return 0, err
+			}
+		}
+		sub.WarningsSent++
+		sub.LastWarnedAt = t.UTC()
+		if sub, err = svc.repo.SaveSubscription(ctx, sub); err != nil {
+			return 0, errors.Wrap(err, "saving subscription")
+		}
+
+		msg := svc.ownerMessage(sub, sch.Name+" will be deactivated soon", "payment-warning", map[string]interface{}{
+			"School":       sch,
+			"Subscription": sub,
+			"DaysLeft":     int(sub.PaymentExtensionDate.Sub(t).Hours()/24) + 1,
+		})
+		if msg == nil {
+			svc.logger.Info(fmt.Sprintf("no owner to warn about the subscription of school %s", sch.ID))
+			continue
+		}
+		messages = append(messages, msg)
+	}
+	svc.mailSvc.SendMessages(messages...)
+	return len(messages), nil
+}
+
+func (svc *Service) DeactivateExpired(t time.Time) (int, error) {
+	ctx := context.Background()
+	schs, err := svc.schoolSvc.QuerySchools()
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, sch := range schs {
+		if sch.IsActive != nil && !*sch.IsActive {
+			continue
+		}
+		sub, err := svc.get(ctx, sch)
+		if err != nil {
+			return n, err
+		}
+		if !sub.Expired(t) {
+			continue
+		}
+		if sub.Status != StatusExpired {
+			if err = svc.transition(&sub, StatusExpired); err != nil {
+				return n, err
+			}
+			if _, err = svc.repo.SaveSubscription(ctx, sub); err != nil {
+				return n, errors.Wrap(err, "saving subscription")
+			}
+		}
+		if _, err = svc.schoolSvc.SetSchoolActive(sch.ID, false); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// ownerMessage returns an email to the owner of a Subscription; nil when it has no owner.
+func (svc *Service) ownerMessage(sub Subscription, subject, tmpl string, data map[string]interface{}) *core.EmailMessage {
+	if sub.OwnerEmail == "" {
+		return nil
+	}
+	data["Owner"] = mail.Address{Name: sub.OwnerName, Address: sub.OwnerEmail}
+	return &core.EmailMessage{
+		To:           []mail.Address{{Name: sub.OwnerName, Address: sub.OwnerEmail}},
+		Subject:      subject,
+		TemplateName: tmpl,
+		TemplateData: data,
+		Conf:         svc.conf,
+	}
+}
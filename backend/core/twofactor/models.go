@@ -0,0 +1,171 @@
+package twofactor
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TOTP parameters (RFC 6238); the defaults of the authenticator apps
+const (
+	totpDigits = 6
+	totpPeriod = 30 // seconds
+	totpSkew   = 1  // steps accepted before & after the current one; for clock drifts
+	secretSize = 20 // bytes; as recommended for HMAC-SHA1
+
+	backupCodesCount    = 10
+	backupCodeLength    = 10
+	backupCodesAlphabet = "abcdefghjkmnpqrstuvwxyz23456789" // no look-alikes: 0/o, 1/l/i
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type (
+	// Enrollment is the TOTP secret of a User; two-factor authentication is enabled once a first code is verified.
+	Enrollment struct {
+		UserID         string    `json:"user_id"`
+		Secret         string    `json:"-"`          // base32
+		EnabledAt      time.Time `json:"enabled_at"` // UTC; zero until the first code is verified
+		LastStep       int64     `json:"-"`          // time step of the last code used; codes are single-use
+		FailedAttempts int       `json:"-"`
+		LockedUntil    time.Time `json:"-"`          // UTC; too many failed attempts
+		CreatedAt      time.Time `json:"created_at"` // UTC
+		UpdatedAt      time.Time `json:"updated_at"` // UTC
+	}
+
+	// Provisioning is shown to a User enrolling, to add their account to an authenticator app; the URI as a QR code.
+	Provisioning struct {
+		Secret string `json:"secret"` // to be entered manually when the QR code can't be scanned
+		URI    string `json:"uri"`    // otpauth://totp/...
+	}
+
+	// BackupCode is a single-use code to log in without the authenticator app; hashed like passwords.
+	BackupCode struct {
+		ID       string    `json:"id"` // UUID
+		UserID   string    `json:"user_id"`
+		CodeHash []byte    `json:"-"`
+		UsedAt   time.Time `json:"used_at"` // UTC
+	}
+
+	// Status describes the two-factor authentication of a User.
+	Status struct {
+		Enabled         bool `json:"enabled"`
+		Required        bool `json:"required"` // by the policy of a School
+		BackupCodesLeft int  `json:"backup_codes_left"`
+	}
+)
+
+func (e Enrollment) IsEnabled() bool { return !e.EnabledAt.IsZero() }
+
+func (e Enrollment) IsLocked(t time.Time) bool { return t.Before(e.LockedUntil) }
+
+// newSecret generates a random TOTP secret
+func newSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generating secret")
+	}
+	return b32.EncodeToString(b), nil
+}
+
+// provisioningURI returns the Key Uri Format understood by the authenticator apps;
+// see https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func provisioningURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(totpPeriod))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+func timeStep(t time.Time) int64 { return t.Unix() / totpPeriod }
+
+// totpCode returns the code of a secret at a time step (RFC 4226 HOTP)
+func totpCode(secret string, step int64) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.Wrap(err, "decoding secret")
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	h := hmac.New(sha1.New, key)
+	h.Write(msg)
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, bin%1000000), nil
+}
+
+// GenerateCode returns the TOTP code of a secret at t; as an authenticator app would.
+func GenerateCode(secret string, t time.Time) (string, error) {
+	return totpCode(secret, timeStep(t))
+}
+
+// matchTOTP returns the time step matched by a code at t; steps up to lastStep were used already.
+func matchTOTP(secret, code string, t time.Time, lastStep int64) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	now := timeStep(t)
+	for step := now - totpSkew; step <= now+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		want, err := totpCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(want), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// normalizeCode removes the spaces & dashes users type in codes
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// newBackupCodes returns random backup codes, formatted as xxxxx-xxxxx, & their hashes
+func newBackupCodes() ([]string, [][]byte, error) {
+	codes := make([]string, 0, backupCodesCount)
+	hashes := make([][]byte, 0, backupCodesCount)
+	max := big.NewInt(int64(len(backupCodesAlphabet)))
+	for i := 0; i < backupCodesCount; i++ {
+		b := make([]byte, backupCodeLength)
+		for j := range b {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "generating backup code")
+			}
+			b[j] = backupCodesAlphabet[n.Int64()]
+		}
+		hash, err := bcrypt.GenerateFromPassword(b, bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "hashing backup code")
+		}
+		half := backupCodeLength / 2
+		codes = append(codes, string(b[:half])+"-"+string(b[half:]))
+		hashes = append(hashes, hash)
+	}
+	return codes, hashes, nil
+}
+
+func (bc BackupCode) matches(code string) bool {
+	return bcrypt.CompareHashAndPassword(bc.CodeHash, []byte(code)) == nil
+}
@@ -0,0 +1,125 @@
+package twofactor
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// the secret of the RFC 6238 test vectors: "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+	for _, tt := range tests {
+		got, err := GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateCode(): %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("GenerateCode(%d) = %s; want %s", tt.unix, got, tt.want)
+		}
+	}
+
+	if _, err := GenerateCode("not base32!", time.Now()); err == nil {
+		t.Error("GenerateCode(invalid secret) = nil; want error")
+	}
+}
+
+func Test_matchTOTP(t *testing.T) {
+	secret, err := newSecret()
+	if err != nil {
+		t.Fatalf("newSecret(): %v", err)
+	}
+	now := time.Unix(1234567890, 0)
+	step := timeStep(now)
+	code := func(t time.Time) string {
+		c, _ := GenerateCode(secret, t)
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		lastStep int64
+		wantStep int64
+		wantOk   bool
+	}{
+		{name: "current", code: code(now), wantStep: step, wantOk: true},
+		{name: "previous step", code: code(now.Add(-totpPeriod * time.Second)), wantStep: step - 1, wantOk: true},
+		{name: "next step", code: code(now.Add(totpPeriod * time.Second)), wantStep: step + 1, wantOk: true},
+		{name: "too old", code: code(now.Add(-2 * totpPeriod * time.Second))},
+		{name: "replayed", code: code(now), lastStep: step},
+		{name: "wrong length", code: "12345"},
+		{name: "wrong code", code: "abcdef"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOk := matchTOTP(secret, tt.code, now, tt.lastStep)
+			if gotOk != tt.wantOk || gotStep != tt.wantStep {
+				t.Errorf("matchTOTP() = %d, %v; want %d, %v", gotStep, gotOk, tt.wantStep, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_provisioningURI(t *testing.T) {
+	uri := provisioningURI("Masomo", "john@test.cd", rfcSecret)
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("url.Parse(): %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Masomo:john@test.cd" {
+		t.Errorf("provisioningURI() = %s", uri)
+	}
+	q := u.Query()
+	if q.Get("secret") != rfcSecret || q.Get("issuer") != "Masomo" || q.Get("digits") != "6" || q.Get("period") != "30" {
+		t.Errorf("provisioningURI() query = %v", q)
+	}
+}
+
+func Test_normalizeCode(t *testing.T) {
+	if got := normalizeCode(" 123 456 "); got != "123456" {
+		t.Errorf("normalizeCode() = %q; want 123456", got)
+	}
+	if got := normalizeCode("ABCDE-fghjk"); got != "abcdefghjk" {
+		t.Errorf("normalizeCode() = %q; want abcdefghjk", got)
+	}
+}
+
+func Test_newBackupCodes(t *testing.T) {
+	codes, hashes, err := newBackupCodes()
+	if err != nil {
+		t.Fatalf("newBackupCodes(): %v", err)
+	}
+	if len(codes) != backupCodesCount || len(hashes) != backupCodesCount {
+		t.Fatalf("newBackupCodes() = %d codes, %d hashes; want %d", len(codes), len(hashes), backupCodesCount)
+	}
+	seen := make(map[string]bool)
+	for i, c := range codes {
+		if len(c) != backupCodeLength+1 || c[backupCodeLength/2] != '-' {
+			t.Errorf("code %q; want xxxxx-xxxxx", c)
+		}
+		if seen[c] {
+			t.Errorf("duplicate code %q", c)
+		}
+		seen[c] = true
+
+		bc := BackupCode{CodeHash: hashes[i]}
+		if !bc.matches(normalizeCode(strings.ToUpper(c))) {
+			t.Errorf("BackupCode.matches(%q) = false; want true", c)
+		}
+		if bc.matches(c) {
+			t.Errorf("BackupCode.matches(%q) = true; want false (not normalized)", c)
+		}
+	}
+}
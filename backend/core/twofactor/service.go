@@ -0,0 +1,288 @@
+package twofactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/core/user"
+)
+
+const (
+	maxFailedAttempts = 5
+	lockDuration      = 15 * time.Minute
+)
+
+var (
+	NowFunc = time.Now // mockable
+
+	// errors
+	ErrNotEnrolled     = errors.New("two-factor authentication not enrolled")
+	ErrNotEnabled      = errors.New("two-factor authentication not enabled")
+	ErrAlreadyEnabled  = errors.New("two-factor authentication already enabled")
+	ErrRequired        = errors.New("two-factor authentication is required")
+	ErrInvalidCode     = errors.New("invalid code")
+	ErrTooManyAttempts = errors.New("too many failed attempts; try again later")
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// SaveEnrollment creates or updates the Enrollment of a User.
+		SaveEnrollment(ctx context.Context, e Enrollment, exec ...core.DBExecutor) (Enrollment, error)
+		GetEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) (Enrollment, error)
+		// LockEnrollment returns the Enrollment of a User, locked until the end of the transaction.
+		LockEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) (Enrollment, error)
+		// DeleteEnrollment deletes the Enrollment & the BackupCodes of a User.
+		DeleteEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) error
+
+		// ReplaceBackupCodes deletes the BackupCodes of a User and creates new ones with the hashes.
+		ReplaceBackupCodes(ctx context.Context, userID string, hashes [][]byte, exec ...core.DBExecutor) error
+		// QueryBackupCodes returns the unused BackupCodes of a User.
+		QueryBackupCodes(ctx context.Context, userID string, exec ...core.DBExecutor) ([]BackupCode, error)
+		UseBackupCode(ctx context.Context, id string, at time.Time, exec ...core.DBExecutor) error
+	}
+
+	ServiceInterface interface {
+		// Enroll generates a new TOTP secret for a User, replacing any pending one.
+		Enroll(usr user.User) (Provisioning, error)
+		// Activate enables two-factor authentication once the User proves their authenticator app works,
+		// and returns their BackupCodes; shown only once.
+		Activate(userID, code string) ([]string, error)
+		// Verify checks a TOTP code, or consumes a BackupCode. The User is locked out for a while after too many failures.
+		Verify(userID, code string) error
+		// RegenerateBackupCodes replaces the BackupCodes of a User, after verifying a code.
+		RegenerateBackupCodes(userID, code string) ([]string, error)
+		// Disable disables two-factor authentication; the caller must have checked the User's identity.
+		Disable(userID string) error
+		IsEnabled(userID string) (bool, error)
+		// Required reports whether a School makes two-factor authentication mandatory for the User.
+		Required(usr user.User) (bool, error)
+		Status(usr user.User) (Status, error)
+	}
+
+	Service struct {
+		db        core.DB
+		repo      Repository
+		schoolSvc school.ServiceInterface
+		issuer    string
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(conf *core.Config, db core.DB, repo Repository, schoolSvc school.ServiceInterface) *Service {
+	return &Service{db: db, repo: repo, schoolSvc: schoolSvc, issuer: conf.AppName}
+}
+
+func (svc *Service) Enroll(usr user.User) (Provisioning, error) {
+	ctx := context.Background()
+	e, err := svc.repo.GetEnrollment(ctx, usr.ID)
+	if err != nil && err != ErrNotEnrolled {
+		return Provisioning{}, errors.Wrap(err, "getting enrollment")
+	}
+	if e.IsEnabled() {
+		return Provisioning{}, ErrAlreadyEnabled
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return Provisioning{}, err
+	}
+	if _, err = svc.repo.SaveEnrollment(ctx, Enrollment{UserID: usr.ID, Secret: secret}); err != nil {
+		return Provisioning{}, errors.Wrap(err, "saving enrollment")
+	}
+
+	account := usr.Email
+	if account == "" {
+		account = usr.Username
+	}
+	return Provisioning{Secret: secret, URI: provisioningURI(svc.issuer, account, secret)}, nil
+}
+
+// checkCode checks a code of a locked Enrollment and updates it accordingly; BackupCodes are only accepted once enabled.
+func (svc *Service) checkCode(ctx context.Context, e Enrollment, code string, tx core.DBExecutor) (Enrollment, error) {
+	now := NowFunc().UTC()
+	if e.IsLocked(now) {
+		return e, ErrTooManyAttempts
+	}
+
+	code = normalizeCode(code)
+	ok := false
+	if step, matched := matchTOTP(e.Secret, code, now, e.LastStep); matched {
+		e.LastStep = step
+		ok = true
+	} else if e.IsEnabled() {
+		bcs, err := svc.repo.QueryBackupCodes(ctx, e.UserID, tx)
+		if err != nil {
+			return e, errors.Wrap(err, "querying backup codes")
+		}
+		for _, bc := range bcs {
+			if bc.matches(code) {
+				if err = svc.repo.UseBackupCode(ctx, bc.ID, now, tx); err != nil {
+					return e, errors.Wrap(err, "using backup code")
+				}
+				ok = true
+				break
+			}
+		}
+	}
+
+	if ok {
+		e.FailedAttempts = 0
+	} else {
+		e.FailedAttempts++
+		if e.FailedAttempts >= maxFailedAttempts {
+			e.FailedAttempts = 0
+			e.LockedUntil = now.Add(lockDuration)
+		}
+	}
+	e, err := svc.repo.SaveEnrollment(ctx, e, tx)
+	if err != nil {
+		return e, errors.Wrap(err, "saving enrollment")
+	}
+	if !ok {
+		return e, ErrInvalidCode
+	}
+	return e, nil
+}
+
+// withCode runs fn once the code is checked, in the same transaction; failed attempts are recorded.
+func (svc *Service) withCode(userID, code string, fn func(ctx context.Context, e Enrollment, tx core.DBExecutor) error) error {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	e, err := svc.repo.LockEnrollment(ctx, userID, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		if err == ErrNotEnrolled {
+			return err
+		}
+		return errors.Wrap(err, "locking enrollment")
+	}
+
+	e, err = svc.checkCode(ctx, e, code, tx)
+	switch err {
+	case nil:
+		if err = fn(ctx, e, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	case ErrInvalidCode:
+		// keep track of the failed attempt
+	default:
+		_ = tx.Rollback()
+		return err
+	}
+	if cErr := tx.Commit(); cErr != nil {
+		return errors.Wrap(cErr, "committing transaction")
+	}
+	return err
+}
+
+func (svc *Service) Activate(userID, code string) ([]string, error) {
+	var codes []string
+	err := svc.withCode(userID, code, func(ctx context.Context, e Enrollment, tx core.DBExecutor) error {
+		if e.IsEnabled() {
+			return ErrAlreadyEnabled
+		}
+		e.EnabledAt = NowFunc().UTC()
+		if _, err := svc.repo.SaveEnrollment(ctx, e, tx); err != nil {
+			return errors.Wrap(err, "saving enrollment")
+		}
+		var err error
+		codes, err = svc.replaceBackupCodes(ctx, userID, tx)
+		return err
+	})
+	return codes, err
+}
+
+func (svc *Service) replaceBackupCodes(ctx context.Context, userID string, tx core.DBExecutor) ([]string, error) {
+	codes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err = svc.repo.ReplaceBackupCodes(ctx, userID, hashes, tx); err != nil {
+		return nil, errors.Wrap(err, "replacing backup codes")
+	}
+	return codes, nil
+}
+
+func (svc *Service) Verify(userID, code string) error {
+	return svc.withCode(userID, code, func(_ context.Context, e Enrollment, _ core.DBExecutor) error {
+		if !e.IsEnabled() {
+			return ErrNotEnabled
+		}
+		return nil
+	})
+}
+
+func (svc *Service) RegenerateBackupCodes(userID, code string) ([]string, error) {
+	var codes []string
+	err := svc.withCode(userID, code, func(ctx context.Context, e Enrollment, tx core.DBExecutor) error {
+		if !e.IsEnabled() {
+			return ErrNotEnabled
+		}
+		var err error
+		codes, err = svc.replaceBackupCodes(ctx, userID, tx)
+		return err
+	})
+	return codes, err
+}
+
+func (svc *Service) Disable(userID string) error {
+	return errors.Wrap(svc.repo.DeleteEnrollment(context.Background(), userID), "deleting enrollment")
+}
+
+func (svc *Service) IsEnabled(userID string) (bool, error) {
+	e, err := svc.repo.GetEnrollment(context.Background(), userID)
+	if err != nil {
+		if err == ErrNotEnrolled {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "getting enrollment")
+	}
+	return e.IsEnabled(), nil
+}
+
+// Required reports whether two-factor authentication is mandatory for a User:
+// for admins, who manage all Schools, as soon as one School requires it.
+func (svc *Service) Required(usr user.User) (bool, error) {
+	if !usr.IsAdmin() {
+		return false, nil
+	}
+	schs, err := svc.schoolSvc.QuerySchools()
+	if err != nil {
+		return false, errors.Wrap(err, "querying schools")
+	}
+	for _, sch := range schs {
+		if sch.RequireAdmin2FA {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (svc *Service) Status(usr user.User) (Status, error) {
+	var st Status
+	var err error
+	if st.Enabled, err = svc.IsEnabled(usr.ID); err != nil {
+		return Status{}, err
+	}
+	if st.Required, err = svc.Required(usr); err != nil {
+		return Status{}, err
+	}
+	if st.Enabled {
+		bcs, err := svc.repo.QueryBackupCodes(context.Background(), usr.ID)
+		if err != nil {
+			return Status{}, errors.Wrap(err, "querying backup codes")
+		}
+		st.BackupCodesLeft = len(bcs)
+	}
+	return st, nil
+}
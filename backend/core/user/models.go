@@ -1,10 +1,13 @@
 package user
 
 import (
+	"crypto/rand"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/trezcool/masomo/core"
@@ -20,15 +23,19 @@ const (
 	// Teacher
 	RoleTeacher = "teacher:"
 
+	// Guardian
+	RoleGuardian = "guardian:"
+
 	// Student
 	RoleStudent = "student:"
 )
 
 var (
-	AdminRoles   = []string{RoleAdmin, RoleAdminOwner, RoleAdminPrincipal}
-	TeacherRoles = []string{RoleTeacher}
-	StudentRoles = []string{RoleStudent}
-	AllRoles     = getAllRoles()
+	AdminRoles    = []string{RoleAdmin, RoleAdminOwner, RoleAdminPrincipal}
+	TeacherRoles  = []string{RoleTeacher}
+	GuardianRoles = []string{RoleGuardian}
+	StudentRoles  = []string{RoleStudent}
+	AllRoles      = getAllRoles()
 
 	rolePriorities = map[string]int{
 		// Admins: 30 - 21
@@ -39,12 +46,16 @@ var (
 		// Teachers: 20 - 11
 		RoleTeacher: 11,
 
-		// Students: 10 - 1
+		// Guardians: 10 - 6
+		RoleGuardian: 6,
+
+		// Students: 5 - 1
 		RoleStudent: 1,
 	}
 
 	Roles = []Role{
 		{Name: "Student", Value: RoleStudent},
+		{Name: "Guardian", Value: RoleGuardian},
 		{Name: "Teacher", Value: RoleTeacher},
 		{Name: "Admin", Value: RoleAdmin},
 		{Name: "Admin Principal", Value: RoleAdminPrincipal},
@@ -53,9 +64,10 @@ var (
 )
 
 func getAllRoles() []string {
-	all := make([]string, 0, 5)
+	all := make([]string, 0, 6)
 	all = append(all, AdminRoles...)
 	all = append(all, TeacherRoles...)
+	all = append(all, GuardianRoles...)
 	all = append(all, StudentRoles...)
 	return all
 }
@@ -80,16 +92,22 @@ type Role struct {
 }
 
 type User struct {
-	ID           string    `json:"id"` // UUID
-	Name         string    `json:"name"`
-	Username     string    `json:"username"`
-	Email        string    `json:"email"`
-	IsActive     *bool     `json:"is_active"`
-	Roles        []string  `json:"roles"`
-	PasswordHash []byte    `json:"-"`
-	CreatedAt    time.Time `json:"created_at"` // UTC
-	UpdatedAt    time.Time `json:"updated_at"` // UTC
-	LastLogin    time.Time `json:"last_login"` // UTC
+	ID                 string    `json:"id"` // UUID
+	Name               string    `json:"name"`
+	Username           string    `json:"username"`
+	Email              string    `json:"email"`
+	EmailVerifiedAt    time.Time `json:"email_verified_at"` // UTC; zero until verified, and when the email changes
+	Phone              string    `json:"phone"`             // E.164; may be shared, eg. by siblings using a parent's phone
+	IsActive           *bool     `json:"is_active"`
+	Roles              []string  `json:"roles"`
+	PasswordHash       []byte    `json:"-"`
+	MustChangePassword bool      `json:"must_change_password"` // set with a temporary password; changed at the next login
+	InvitedAt          time.Time `json:"invited_at"`           // UTC; zero once the invitation is accepted, or the User deactivated
+	Avatar             string    `json:"-"`                    // media name prefix of the uploaded avatar; see core/avatar
+	CreatedAt          time.Time `json:"created_at"`           // UTC
+	UpdatedAt          time.Time `json:"updated_at"`           // UTC
+	LastLogin          time.Time `json:"last_login"`           // UTC
+	DeletedAt          time.Time `json:"deleted_at"`           // UTC; soft-deleted Users are erased after Config.UserErasureDelay
 }
 
 func (u *User) SetPassword(pwd string) error {
@@ -109,6 +127,11 @@ func (u *User) SetActive(val bool) {
 	u.IsActive = func(b bool) *bool { return &b }(val)
 }
 
+// IsEmailVerified reports whether the User has proven to own their email address.
+func (u *User) IsEmailVerified() bool {
+	return u.Email != "" && !u.EmailVerifiedAt.IsZero()
+}
+
 func (u *User) RoleStartsWith(prefix string) bool {
 	for _, role := range u.Roles {
 		if strings.HasPrefix(role, prefix) {
@@ -130,20 +153,32 @@ func (u *User) IsStudent() bool {
 	return u.RoleStartsWith(RoleStudent)
 }
 
+func (u *User) IsGuardian() bool {
+	return u.RoleStartsWith(RoleGuardian)
+}
+
+// IsInvited reports whether a User was invited & has not signed up yet.
+func (u *User) IsInvited() bool {
+	return !u.InvitedAt.IsZero()
+}
+
 // NewUser contains information needed to create a new User.
 type NewUser struct {
 	Name            string   `json:"name" validate:"required"`
 	Username        string   `json:"username" validate:"omitempty,min=6,alphanum_"`
 	Email           string   `json:"email" validate:"omitempty,email"`
+	Phone           string   `json:"phone" validate:"omitempty,e164"`
 	Password        string   `json:"password" validate:"required"`
 	PasswordConfirm string   `json:"password_confirm" validate:"required,eqfield=Password"`
 	Roles           []string `json:"roles" validate:"omitempty,allroles"`
+	EmailVerified   bool     `json:"-"` // eg. by an OpenID provider; no verification email is sent
 }
 
 func (nu *NewUser) Validate(validate *validator.Validate, svc ServiceInterface) error {
 	nu.Name = core.CleanString(nu.Name)
 	nu.Username = core.CleanString(nu.Username, true /* lower */)
 	nu.Email = core.CleanString(nu.Email, true /* lower */)
+	nu.Phone = core.CleanString(nu.Phone)
 
 	if err := validate.Struct(nu); err != nil {
 		return err
@@ -156,6 +191,7 @@ type UpdateUser struct {
 	Name            string   `json:"name"`
 	Username        string   `json:"username" validate:"omitempty,min=6,alphanum_"`
 	Email           string   `json:"email" validate:"omitempty,email"`
+	Phone           string   `json:"phone" validate:"omitempty,e164"`
 	IsActive        *bool    `json:"is_active"`
 	Roles           []string `json:"roles" validate:"omitempty,allroles"`
 	Password        string   `json:"password" validate:"omitempty"`
@@ -184,12 +220,95 @@ func (uu *UpdateUser) Validate(origUsr User, validate *validator.Validate, svc S
 		uu.Email = origUsr.Email
 	}
 
+	phone := core.CleanString(uu.Phone)
+	if phone != "" {
+		uu.Phone = phone
+	} else {
+		uu.Phone = origUsr.Phone
+	}
+
 	if err := validate.Struct(uu); err != nil {
 		return err
 	}
 	return svc.CheckUniqueness(uu.Username, uu.Email, origUsr)
 }
 
+// UpdateProfile defines what information Users may change on their own profile.
+// Email changes are confirmed from the new address; password changes require the current password.
+type UpdateProfile struct {
+	Name            string `json:"name"`
+	Email           string `json:"email" validate:"omitempty,email"`
+	Phone           string `json:"phone" validate:"omitempty,e164"`
+	CurrentPassword string `json:"current_password" validate:"required_with=Password"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"password_confirm" validate:"required_with=Password,eqfield=Password"`
+}
+
+func (up *UpdateProfile) Validate(origUsr User, validate *validator.Validate, svc ServiceInterface) error {
+	up.Name = core.CleanString(up.Name)
+	if up.Name == "" {
+		up.Name = origUsr.Name
+	}
+	up.Phone = core.CleanString(up.Phone)
+	if up.Phone == "" {
+		up.Phone = origUsr.Phone
+	}
+	up.Email = core.CleanString(up.Email, true /* lower */)
+	if up.Email == origUsr.Email {
+		up.Email = ""
+	}
+
+	if err := validate.Struct(up); err != nil {
+		return err
+	}
+	if up.Email != "" {
+		return svc.CheckUniqueness("", up.Email, origUsr)
+	}
+	return nil
+}
+
+// EmailChange confirms the change of the email of a User, from the link sent to the new address.
+type EmailChange struct {
+	UID   string `json:"uid" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+	Token string `json:"token" validate:"required"`
+}
+
+func (ec *EmailChange) Validate(validate *validator.Validate) error {
+	ec.Email = core.CleanString(ec.Email, true /* lower */)
+	return validate.Struct(ec)
+}
+
+// EmailVerification verifies the email of a User, from the link sent to their address.
+type EmailVerification struct {
+	UID   string `json:"uid" validate:"required"`
+	Token string `json:"token" validate:"required"`
+}
+
+func (ev *EmailVerification) Validate(validate *validator.Validate) error {
+	return validate.Struct(ev)
+}
+
+// InviteUser contains information needed to invite a new User, who sets their password on signup.
+type InviteUser struct {
+	Name  string   `json:"name" validate:"required"`
+	Email string   `json:"email" validate:"required,email"`
+	Phone string   `json:"phone" validate:"omitempty,e164"`
+	Roles []string `json:"roles" validate:"omitempty,allroles"`
+}
+
+func (iu *InviteUser) Validate(validate *validator.Validate, svc ServiceInterface) error {
+	iu.Name = core.CleanString(iu.Name)
+	iu.Email = core.CleanString(iu.Email, true /* lower */)
+	iu.Phone = core.CleanString(iu.Phone)
+
+	if err := validate.Struct(iu); err != nil {
+		return err
+	}
+	return svc.CheckUniqueness("", iu.Email)
+}
+
+// ResetUserPassword is used to reset the password of a User, or to set it when accepting an invitation.
 type ResetUserPassword struct {
 	Token           string `json:"token,omitempty" validate:"required"`
 	UID             string `json:"uid,omitempty" validate:"required"`
@@ -199,12 +318,61 @@ type ResetUserPassword struct {
 
 func (rp ResetUserPassword) Validate(validate *validator.Validate) error { return validate.Struct(rp) }
 
+// NewPassword replaces the temporary password of a User.
+type NewPassword struct {
+	Password        string `json:"password,omitempty" validate:"required"`
+	PasswordConfirm string `json:"password_confirm,omitempty" validate:"required,eqfield=Password"`
+}
+
+func (np NewPassword) Validate(validate *validator.Validate) error { return validate.Struct(np) }
+
+// TemporaryPassword is the generated password of a User; shown only once.
+type TemporaryPassword struct {
+	User     User
+	Password string
+}
+
+// temporary passwords are printed, and typed by young Students: no look-alikes (0/O/o, 1/l/I, 5/S, 2/Z)
+const (
+	tempPwdGroups   = 3
+	tempPwdGroupLen = 4
+	tempPwdLower    = "abcdefghjkmnpqrtuvwxy"
+	tempPwdUpper    = "ABCDEFGHJKLMNPQRTUVWXY"
+	tempPwdDigits   = "346789"
+)
+
+// newTemporaryPassword returns a strong password complying with the password policy; eg. "Kp7m-x3Rq-9dzT".
+func newTemporaryPassword() (string, error) {
+	alphabet := tempPwdLower + tempPwdUpper + tempPwdDigits
+	max := big.NewInt(int64(len(alphabet)))
+	for {
+		var pwd strings.Builder
+		for i := 0; i < tempPwdGroups*tempPwdGroupLen; i++ {
+			if i > 0 && i%tempPwdGroupLen == 0 {
+				pwd.WriteByte('-')
+			}
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", errors.Wrap(err, "generating password")
+			}
+			pwd.WriteByte(alphabet[n.Int64()])
+		}
+		s := pwd.String()
+		if strings.ContainsAny(s, tempPwdLower) && strings.ContainsAny(s, tempPwdUpper) && strings.ContainsAny(s, tempPwdDigits) {
+			return s, nil
+		}
+	}
+}
+
 type QueryFilter struct {
 	Search      string    `query:"search"`
 	Roles       []string  `query:"role"`
+	Phone       string    `query:"phone"`
 	IsActive    *bool     `query:"is_active"`
 	CreatedFrom time.Time `query:"created_from"`
 	CreatedTo   time.Time `query:"created_to"`
+	Deleted     bool      `query:"deleted"`    // only the soft-deleted Users; they are excluded otherwise
+	DeletedTo   time.Time `query:"deleted_to"` // with Deleted
 }
 
 func (qf *QueryFilter) Clean() {
@@ -216,4 +384,5 @@ type GetFilter struct {
 	Username        string
 	Email           string
 	UsernameOrEmail []string // [uname] | [username, email]
+	Deleted         bool     // only the soft-deleted Users; they are excluded otherwise
 }
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+func TestNewTemporaryPassword(t *testing.T) {
+	validate := validator.New()
+	_en := en.New()
+	translator, _ := ut.New(_en, _en).GetTranslator("en")
+	core.InitValidators(validate, translator)
+	InitValidators(validate, translator)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		pwd, err := newTemporaryPassword()
+		if err != nil {
+			t.Fatalf("newTemporaryPassword(): %v", err)
+		}
+		if groups := strings.Split(pwd, "-"); len(groups) != tempPwdGroups {
+			t.Errorf("newTemporaryPassword() = %q; want %d groups", pwd, tempPwdGroups)
+		}
+		if err = (NewPassword{Password: pwd, PasswordConfirm: pwd}).Validate(validate); err != nil {
+			t.Errorf("newTemporaryPassword() = %q; does not comply with the password policy: %v", pwd, err)
+		}
+		if seen[pwd] {
+			t.Errorf("newTemporaryPassword() = %q; returned twice", pwd)
+		}
+		seen[pwd] = true
+	}
+}
@@ -2,9 +2,12 @@ package user
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"net/mail"
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -12,13 +15,21 @@ import (
 	"github.com/trezcool/masomo/core"
 )
 
+// maxVerificationRequests limits the verification emails a User may request per hour.
+const maxVerificationRequests = 3
+
 var (
 	secretKey            string
 	passwordResetTimeout time.Duration
 
 	// errors
-	ErrNotFound   = errors.New("user not found")
-	ErrUserExists = errors.New("a user with this username or email already exists")
+	ErrNotFound        = errors.New("user not found")
+	ErrUserExists      = errors.New("a user with this username or email already exists")
+	ErrNotInvited      = errors.New("user has already joined")
+	ErrNoTempPwd       = errors.New("user has no temporary password")
+	ErrNoEmail         = errors.New("user has no email")
+	ErrEmailVerified   = errors.New("email already verified")
+	ErrTooManyRequests = errors.New("too many verification requests; try again later")
 )
 
 type (
@@ -32,22 +43,58 @@ type (
 		GetUser(ctx context.Context, filter GetFilter, exec ...core.DBExecutor) (User, error)
 		UpdateUser(ctx context.Context, user User, exec ...core.DBExecutor) (User, error)
 		UpdateOrCreateUser(ctx context.Context, user User, exec ...core.DBExecutor) (User, error)
-		DeleteUsersByID(ctx context.Context, ids []string, exec ...core.DBExecutor) (int, error)
+		// SoftDeleteUsersByID marks Users as deleted at t; the ones already deleted are left unchanged.
+		SoftDeleteUsersByID(ctx context.Context, ids []string, t time.Time, exec ...core.DBExecutor) (int, error)
 	}
 
 	ServiceInterface interface {
 		CheckUniqueness(uname, email string, exclUsers ...User) error
-		Create(nu NewUser) (User, error)
+		// Create, Invite, Update, SetTemporaryPasswords, Delete & Restore record their change in the audit log,
+		// on behalf of actor.
+		Create(nu NewUser, actor core.AuditActor) (User, error)
 		Query(filter *QueryFilter, ordering []core.DBOrdering) ([]User, error)
 		GetByID(id string) (User, error)
+		// GetDeletedByID returns a soft-deleted User who has not been erased yet.
+		GetDeletedByID(id string) (User, error)
 		GetByUsername(uname string) (User, error)
 		GetByEmail(email string) (User, error)
 		GetByUsernameOrEmail(uname string) (User, error)
-		Update(id string, uu UpdateUser) (User, error)
+		Update(id string, uu UpdateUser, actor core.AuditActor) (User, error)
+		// UpdateProfile applies the changes of a User to their own profile; the email is only changed once confirmed
+		// from the new address, which is sent a link to ConfirmEmailChange.
+		UpdateProfile(usr User, up UpdateProfile) (User, error)
+		ConfirmEmailChange(ec EmailChange) (User, error)
+		// RequestEmailVerification emails a new verification link to a User; links are also sent when Users are
+		// created, or change their email.
+		RequestEmailVerification(usr User) error
+		// VerifyEmail marks the email of a User as verified, from the link sent to their address.
+		VerifyEmail(ev EmailVerification) (User, error)
+		// MayEmail reports whether account emails (password resets, login links, digests...) may be sent to a User:
+		// not to an unverified address when a School of theirs requires verified ones, as it may not be theirs.
+		// Verification & invitation emails are exempt: they verify the address.
+		MayEmail(usr User) (bool, error)
+		// SetAvatar sets the media name prefix of the avatar of a User; empty to remove it.
+		SetAvatar(id, avatar string) (User, error)
 		SetLastLogin(usr User) (User, error)
 		RequestPasswordReset(email string) error
+		// RequestPasswordResetSMS texts a password reset link to each User with the phone.
+		RequestPasswordResetSMS(phone string) error
 		ResetPassword(rp ResetUserPassword) error
-		Delete(ids ...string) error
+		// Invite creates an inactive User & emails them a signup link to set their password; see AcceptInvite.
+		Invite(iu InviteUser, actor core.AuditActor) (User, error)
+		// ResendInvite emails a new signup link to a User who has not accepted their invitation yet.
+		ResendInvite(usr User) error
+		// AcceptInvite sets the password of an invited User & activates them.
+		AcceptInvite(rp ResetUserPassword) (User, error)
+		// SetTemporaryPasswords generates a temporary password for each User, who must change it at their next login.
+		// Passwords are returned in the order of the IDs; all are set or none.
+		SetTemporaryPasswords(actor core.AuditActor, ids ...string) ([]TemporaryPassword, error)
+		// ChangeTemporaryPassword replaces the temporary password of a User; see User.MustChangePassword.
+		ChangeTemporaryPassword(id string, np NewPassword) (User, error)
+		// Delete soft-deletes Users: they are hidden, and their academic records kept, until they are restored or erased.
+		Delete(actor core.AuditActor, ids ...string) error
+		// Restore restores a soft-deleted User who has not been erased yet.
+		Restore(id string, actor core.AuditActor) (User, error)
 	}
 
 	Service struct {
@@ -55,14 +102,28 @@ type (
 		repo     Repository
 		conf     *core.Config
 		mailSvc  core.EmailService
+		smsSvc   core.SMSService
+		auditLog core.AuditLog
+		sessions core.SessionRevoker
+		policy   core.EmailPolicy
 		ordering []core.DBOrdering // default
+		requests *core.RateLimiter // verification emails per User
 		//log *log.Logger
 	}
 )
 
 var _ ServiceInterface = (*Service)(nil)
 
-func NewService(db core.DB, repo Repository, mailSvc core.EmailService, conf *core.Config) *Service {
+func NewService(
+	db core.DB,
+	repo Repository,
+	mailSvc core.EmailService,
+	smsSvc core.SMSService,
+	auditLog core.AuditLog,
+	sessions core.SessionRevoker,
+	emailPolicy core.EmailPolicy,
+	conf *core.Config,
+) *Service {
 	secretKey = conf.SecretKey
 	passwordResetTimeout = conf.PasswordResetTimeout
 
@@ -71,8 +132,44 @@ func NewService(db core.DB, repo Repository, mailSvc core.EmailService, conf *co
 		repo:     repo,
 		conf:     conf,
 		mailSvc:  mailSvc,
+		smsSvc:   smsSvc,
+		auditLog: auditLog,
+		sessions: sessions,
+		policy:   emailPolicy,
 		ordering: []core.DBOrdering{{Field: "created_at"}},
+		requests: core.NewRateLimiter(maxVerificationRequests, time.Hour),
+	}
+}
+
+// audit records an action of actor changing a User from before to after, in the transaction of the change;
+// before is nil for a creation. Password changes are recorded, not their values.
+func (svc *Service) audit(
+	ctx context.Context,
+	tx core.DBExecutor,
+	actor core.AuditActor,
+	action string,
+	before interface{},
+	after User,
+	pwdChanged bool,
+) error {
+	changes, err := core.AuditDiff(before, after)
+	if err != nil {
+		return err
+	}
+	if pwdChanged {
+		changes["password"] = core.AuditChange{Before: core.AuditRedacted, After: core.AuditRedacted}
 	}
+	return svc.auditLog.Record(ctx, core.NewAuditEntry(actor, action, after.ID, changes), tx)
+}
+
+// updatePassword saves a User whose password changed, and revokes their sessions in the same transaction:
+// refresh tokens must not outlive the password they were issued for.
+func (svc *Service) updatePassword(ctx context.Context, tx core.DBExecutor, usr User) (User, error) {
+	usr, err := svc.repo.UpdateUser(ctx, usr, tx)
+	if err != nil {
+		return User{}, errors.Wrap(err, "updating password")
+	}
+	return usr, svc.sessions.RevokeUserSessions(ctx, usr.ID, tx)
 }
 
 func (svc *Service) CheckUniqueness(uname, email string, exclUsers ...User) error {
@@ -85,19 +182,45 @@ func (svc *Service) CheckUniqueness(uname, email string, exclUsers ...User) erro
 	return nil
 }
 
-func (svc *Service) Create(nu NewUser) (User, error) {
+func (svc *Service) Create(nu NewUser, actor core.AuditActor) (User, error) {
 	usr := User{
 		Name:     nu.Name,
 		Username: nu.Username,
 		Email:    nu.Email,
+		Phone:    nu.Phone,
 		Roles:    nu.Roles,
 	}
 	usr.SetActive(true)
+	if nu.EmailVerified {
+		usr.EmailVerifiedAt = time.Now().UTC()
+	}
 	if err := usr.SetPassword(nu.Password); err != nil {
 		return User{}, errors.Wrap(err, "hashing password")
 	}
-	usr, err := svc.repo.CreateUser(context.Background(), usr)
-	return usr, errors.Wrap(err, "creating user")
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
+	}
+	if usr, err = svc.repo.CreateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "creating user")
+	}
+	if err = svc.audit(ctx, tx, actor, core.AuditUserCreate, nil, usr, false); err != nil {
+		_ = tx.Rollback()
+		return User{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return User{}, errors.Wrap(err, "committing transaction")
+	}
+
+	if usr.Email != "" && !usr.IsEmailVerified() {
+		if err = svc.sendEmailVerificationMail(usr); err != nil {
+			return User{}, err
+		}
+	}
+	return usr, nil
 }
 
 func (svc *Service) Query(filter *QueryFilter, ordering []core.DBOrdering) ([]User, error) {
@@ -113,6 +236,11 @@ func (svc *Service) GetByID(id string) (User, error) {
 	return usr, errors.Wrap(err, "finding user by ID")
 }
 
+func (svc *Service) GetDeletedByID(id string) (User, error) {
+	usr, err := svc.repo.GetUser(context.Background(), GetFilter{ID: id, Deleted: true})
+	return usr, errors.Wrap(err, "finding deleted user by ID")
+}
+
 func (svc *Service) GetByUsername(uname string) (User, error) {
 	usr, err := svc.repo.GetUser(context.Background(), GetFilter{Username: core.CleanString(uname, true /* lower */)})
 	return usr, errors.Wrap(err, "finding user by username")
@@ -129,22 +257,249 @@ func (svc *Service) GetByUsernameOrEmail(uname string) (User, error) {
 	return usr, errors.Wrap(err, "finding user by username or email")
 }
 
-func (svc *Service) Update(id string, uu UpdateUser) (User, error) {
-	usr := User{
-		ID:       id,
-		Name:     uu.Name,
-		Username: uu.Username,
-		Email:    uu.Email,
-		IsActive: uu.IsActive,
-		Roles:    uu.Roles,
+func (svc *Service) Update(id string, uu UpdateUser, actor core.AuditActor) (User, error) {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
+	}
+
+	// start from the stored User: the columns uu does not cover are kept (avatar, verification, logins...)
+	before, err := svc.repo.GetUser(ctx, GetFilter{ID: id}, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "finding user by ID")
+	}
+	usr := before
+	emailChanged := uu.Email != usr.Email
+
+	usr.Name = uu.Name
+	usr.Username = uu.Username
+	usr.Email = uu.Email
+	usr.Phone = uu.Phone
+	if uu.IsActive != nil {
+		usr.IsActive = uu.IsActive
+		if !*uu.IsActive {
+			usr.InvitedAt = time.Time{} // revoking any pending invitation
+		}
+	}
+	if uu.Roles != nil {
+		usr.Roles = uu.Roles
+	}
+	if emailChanged {
+		usr.EmailVerifiedAt = time.Time{}
 	}
 	if uu.Password != "" {
-		if err := usr.SetPassword(uu.Password); err != nil {
+		if err = usr.SetPassword(uu.Password); err != nil {
+			_ = tx.Rollback()
 			return User{}, errors.Wrap(err, "hashing password")
 		}
 	}
-	usr, err := svc.repo.UpdateUser(context.Background(), usr)
-	return usr, errors.Wrap(err, "updating user")
+	if usr, err = svc.repo.UpdateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "updating user")
+	}
+	// deactivated Users, or those whose password was reset, are logged out
+	if uu.Password != "" || (uu.IsActive != nil && !*uu.IsActive) {
+		if err = svc.sessions.RevokeUserSessions(ctx, usr.ID, tx); err != nil {
+			_ = tx.Rollback()
+			return User{}, err
+		}
+	}
+	if err = svc.audit(ctx, tx, actor, core.AuditUserUpdate, before, usr, uu.Password != ""); err != nil {
+		_ = tx.Rollback()
+		return User{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return User{}, errors.Wrap(err, "committing transaction")
+	}
+
+	if emailChanged && usr.Email != "" {
+		if err = svc.sendEmailVerificationMail(usr); err != nil {
+			return User{}, err
+		}
+	}
+	return usr, nil
+}
+
+func (svc *Service) UpdateProfile(usr User, up UpdateProfile) (User, error) {
+	usr.Name = up.Name
+	usr.Phone = up.Phone
+	if up.Password != "" {
+		if usr.CheckPassword(up.CurrentPassword) != nil {
+			return User{}, core.NewValidationError(nil, core.FieldError{Field: "current_password", Error: "invalid password"})
+		}
+		if err := usr.SetPassword(up.Password); err != nil {
+			return User{}, errors.Wrap(err, "hashing password")
+		}
+		usr.MustChangePassword = false
+	}
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
+	}
+	if up.Password != "" {
+		// other devices are logged out; the current one logs in again once its access token expires
+		usr, err = svc.updatePassword(ctx, tx, usr)
+	} else {
+		usr, err = svc.repo.UpdateUser(ctx, usr, tx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "updating profile")
+	}
+	if err = tx.Commit(); err != nil {
+		return User{}, errors.Wrap(err, "committing transaction")
+	}
+
+	if up.Email != "" {
+		if err = svc.sendEmailChangeMail(usr, up.Email); err != nil {
+			return User{}, err
+		}
+	}
+	return usr, nil
+}
+
+// sendEmailChangeMail sends a link confirming the new email of a User to that address.
+func (svc *Service) sendEmailChangeMail(usr User, email string) error {
+	token, err := MakeEmailChangeToken(usr, email)
+	if err != nil {
+		return err
+	}
+	svc.mailSvc.SendMessages(
+		&core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: email}},
+			Subject:      "Email Change",
+			TemplateName: "email-change",
+			TemplateData: map[string]interface{}{
+				"User":  usr,
+				"Email": email,
+				"ConfirmPath": fmt.Sprintf(
+					"/email-change/%s/%s?%s", EncodeUID(usr), token, url.Values{"email": {email}}.Encode()),
+			},
+			Conf: svc.conf,
+		},
+	)
+	return nil
+}
+
+func (svc *Service) ConfirmEmailChange(ec EmailChange) (User, error) {
+	uid, err := DecodeUID(ec.UID)
+	if err != nil {
+		return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+	}
+	usr, err := svc.GetByID(uid)
+	if err != nil {
+		if errors.Cause(err) == ErrNotFound {
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+		}
+		return User{}, errors.Wrap(err, "finding user by ID")
+	}
+	if err = verifyEmailChangeToken(usr, ec.Email, ec.Token); err != nil {
+		switch err {
+		case errInvalidToken, errTokenExpired:
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "token", Error: "invalid value"})
+		default:
+			return User{}, errors.Wrap(err, "verifying token")
+		}
+	}
+	// the address might have been taken since the request
+	if err = svc.CheckUniqueness("", ec.Email, usr); err != nil {
+		return User{}, err
+	}
+
+	usr.Email = ec.Email
+	usr.EmailVerifiedAt = time.Now().UTC() // the link was opened from the new address
+	usr, err = svc.repo.UpdateUser(context.Background(), usr)
+	return usr, errors.Wrap(err, "updating email")
+}
+
+func (svc *Service) RequestEmailVerification(usr User) error {
+	switch {
+	case usr.Email == "":
+		return ErrNoEmail
+	case usr.IsEmailVerified():
+		return ErrEmailVerified
+	case !svc.requests.Allow(usr.ID):
+		return ErrTooManyRequests
+	}
+	return svc.sendEmailVerificationMail(usr)
+}
+
+func (svc *Service) MayEmail(usr User) (bool, error) {
+	if usr.Email == "" {
+		return false, nil
+	}
+	if usr.IsEmailVerified() {
+		return true, nil
+	}
+	required, err := svc.policy.RequiresVerifiedEmail(usr.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "checking email verification policy")
+	}
+	return !required, nil
+}
+
+// sendEmailVerificationMail sends a link verifying the email of a User to that address.
+func (svc *Service) sendEmailVerificationMail(usr User) error {
+	token, err := MakeEmailVerificationToken(usr)
+	if err != nil {
+		return err
+	}
+	svc.mailSvc.SendMessages(
+		&core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      "Email Verification",
+			TemplateName: "email-verification",
+			TemplateData: map[string]interface{}{
+				"User":       usr,
+				"AppName":    svc.conf.AppName,
+				"VerifyPath": fmt.Sprintf("/email-verify/%s/%s", EncodeUID(usr), token)},
+			Conf: svc.conf,
+		},
+	)
+	return nil
+}
+
+func (svc *Service) VerifyEmail(ev EmailVerification) (User, error) {
+	uid, err := DecodeUID(ev.UID)
+	if err != nil {
+		return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+	}
+	usr, err := svc.GetByID(uid)
+	if err != nil {
+		if errors.Cause(err) == ErrNotFound {
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+		}
+		return User{}, errors.Wrap(err, "finding user by ID")
+	}
+	if err = verifyEmailVerificationToken(usr, ev.Token); err != nil {
+		switch err {
+		case errInvalidToken, errTokenExpired:
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "token", Error: "invalid value"})
+		default:
+			return User{}, errors.Wrap(err, "verifying token")
+		}
+	}
+	if usr.IsEmailVerified() { // links stay valid until they expire; opening one again is harmless
+		return usr, nil
+	}
+
+	usr.EmailVerifiedAt = time.Now().UTC()
+	usr, err = svc.repo.UpdateUser(context.Background(), usr)
+	return usr, errors.Wrap(err, "verifying email")
+}
+
+func (svc *Service) SetAvatar(id, avatar string) (User, error) {
+	usr, err := svc.GetByID(id)
+	if err != nil {
+		return User{}, err
+	}
+	usr.Avatar = avatar
+	usr, err = svc.repo.UpdateUser(context.Background(), usr)
+	return usr, errors.Wrap(err, "updating avatar")
 }
 
 func (svc *Service) SetLastLogin(usr User) (User, error) {
@@ -164,9 +519,16 @@ func (svc *Service) RequestPasswordReset(email string) error {
 }
 
 func (svc *Service) sendPasswordResetMail(usr User) {
+	if ok, err := svc.MayEmail(usr); !ok {
+		if err != nil {
+			log.Printf("%+v", err) // todo: logger
+		}
+		return
+	}
 	token, err := MakeToken(usr)
 	if err != nil {
-		log.Fatal(err) // todo: logger
+		log.Printf("%+v", errors.Wrap(err, "making password reset token")) // todo: logger
+		return
 	}
 	svc.mailSvc.SendMessages(
 		&core.EmailMessage{
@@ -181,39 +543,317 @@ func (svc *Service) sendPasswordResetMail(usr User) {
 	)
 }
 
+func (svc *Service) RequestPasswordResetSMS(phone string) error {
+	usrs, err := svc.repo.QueryUsers(context.Background(), &QueryFilter{Phone: phone}, svc.ordering)
+	if err != nil {
+		return errors.Wrap(err, "querying users by phone")
+	}
+	if len(usrs) == 0 {
+		return ErrNotFound
+	}
+	// do not wait for it; avoid giving clues to attackers
+	go svc.sendPasswordResetSMS(usrs)
+	return nil
+}
+
+// sendPasswordResetSMS texts the password reset links of Users sharing a phone.
+// Users do not belong to a School: the default sender ID is used and no School quota applies.
+func (svc *Service) sendPasswordResetSMS(usrs []User) {
+	for _, usr := range usrs {
+		// the login texted may be an unverified address; the same gate as password reset emails applies
+		if usr.Email != "" {
+			if ok, err := svc.MayEmail(usr); !ok {
+				if err != nil {
+					log.Printf("%+v", err) // todo: logger
+				}
+				continue
+			}
+		}
+		token, err := MakeToken(usr)
+		if err != nil {
+			log.Printf("%+v", errors.Wrap(err, "making password reset token")) // todo: logger
+			continue
+		}
+		login := usr.Username
+		if login == "" {
+			login = usr.Email
+		}
+		err = svc.smsSvc.Send(core.SMSMessage{
+			To: usr.Phone,
+			Body: fmt.Sprintf(
+				"%s: reset the password of %s at %s/password-reset/%s/%s",
+				svc.conf.AppName, login, svc.conf.FrontendBaseURL, EncodeUID(usr), token),
+		})
+		if err != nil {
+			log.Printf("%+v", errors.Wrap(err, "sending password reset sms")) // todo: logger
+		}
+	}
+}
+
 func (svc *Service) ResetPassword(rp ResetUserPassword) error {
-	uid, err := decodeUID(rp.UID)
+	usr, err := svc.checkToken(rp)
+	if err != nil {
+		return err
+	}
+
+	if err = usr.SetPassword(rp.Password); err != nil {
+		return errors.Wrap(err, "hashing password")
+	}
+	usr.MustChangePassword = false
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	if _, err = svc.updatePassword(ctx, tx, usr); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// checkToken returns the User identified by the UID of rp when its token is valid.
+func (svc *Service) checkToken(rp ResetUserPassword) (User, error) {
+	uid, err := DecodeUID(rp.UID)
 	if err != nil {
-		return core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+		return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
 	}
 	usr, err := svc.GetByID(uid)
 	if err != nil {
 		if errors.Cause(err) == ErrNotFound {
-			return core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "uid", Error: "invalid value"})
 		}
-		return errors.Wrap(err, "finding user by ID")
+		return User{}, errors.Wrap(err, "finding user by ID")
 	}
 	if err := verifyToken(usr, rp.Token); err != nil {
 		switch err {
 		case errInvalidToken, errTokenExpired:
-			return core.NewValidationError(err, core.FieldError{Field: "token", Error: "invalid value"})
+			return User{}, core.NewValidationError(err, core.FieldError{Field: "token", Error: "invalid value"})
 		default:
-			return errors.Wrap(err, "verifying token")
+			return User{}, errors.Wrap(err, "verifying token")
 		}
 	}
+	return usr, nil
+}
 
-	if err := usr.SetPassword(rp.Password); err != nil {
-		return errors.Wrap(err, "hashing password")
+func (svc *Service) Invite(iu InviteUser, actor core.AuditActor) (User, error) {
+	usr := User{
+		Name:      iu.Name,
+		Email:     iu.Email,
+		Phone:     iu.Phone,
+		Roles:     iu.Roles,
+		InvitedAt: time.Now().UTC(),
 	}
-	if _, err := svc.repo.UpdateUser(context.Background(), usr); err != nil {
-		return errors.Wrap(err, "updating password")
+	usr.SetActive(false)
+	// an unguessable password until the User sets theirs; it also keys the signup token
+	pwd := make([]byte, 32)
+	if _, err := rand.Read(pwd); err != nil {
+		return User{}, errors.Wrap(err, "generating password")
+	}
+	if err := usr.SetPassword(base64.RawURLEncoding.EncodeToString(pwd)); err != nil {
+		return User{}, errors.Wrap(err, "hashing password")
+	}
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
 	}
+	if usr, err = svc.repo.CreateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "creating user")
+	}
+	if err = svc.audit(ctx, tx, actor, core.AuditUserInvite, nil, usr, false); err != nil {
+		_ = tx.Rollback()
+		return User{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return User{}, errors.Wrap(err, "committing transaction")
+	}
+	return usr, svc.ResendInvite(usr)
+}
+
+func (svc *Service) ResendInvite(usr User) error {
+	if !usr.IsInvited() {
+		return ErrNotInvited
+	}
+	token, err := MakeToken(usr)
+	if err != nil {
+		return err
+	}
+	svc.mailSvc.SendMessages(
+		&core.EmailMessage{
+			To:           []mail.Address{{Name: usr.Name, Address: usr.Email}},
+			Subject:      "Invitation to " + svc.conf.AppName,
+			TemplateName: "invitation",
+			TemplateData: map[string]interface{}{
+				"User":       usr,
+				"AppName":    svc.conf.AppName,
+				"SignupPath": fmt.Sprintf("/signup/%s/%s", EncodeUID(usr), token)},
+			Conf: svc.conf,
+		},
+	)
 	return nil
 }
 
-func (svc *Service) Delete(ids ...string) error {
-	if _, err := svc.repo.DeleteUsersByID(context.Background(), ids); err != nil {
+func (svc *Service) AcceptInvite(rp ResetUserPassword) (User, error) {
+	usr, err := svc.checkToken(rp)
+	if err != nil {
+		return User{}, err
+	}
+	// password reset tokens are alike: they must not reactivate deactivated Users
+	if !usr.IsInvited() {
+		return User{}, core.NewValidationError(ErrNotInvited, core.FieldError{Field: "token", Error: "invalid value"})
+	}
+
+	if err = usr.SetPassword(rp.Password); err != nil {
+		return User{}, errors.Wrap(err, "hashing password")
+	}
+	usr.SetActive(true)
+	usr.InvitedAt = time.Time{}
+	usr.EmailVerifiedAt = time.Now().UTC() // the signup link was sent to their address
+	usr, err = svc.repo.UpdateUser(context.Background(), usr)
+	return usr, errors.Wrap(err, "activating user")
+}
+
+func (svc *Service) SetTemporaryPasswords(actor core.AuditActor, ids ...string) ([]TemporaryPassword, error) {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting transaction")
+	}
+
+	tps := make([]TemporaryPassword, 0, len(ids))
+	for _, id := range ids {
+		tp, err := svc.setTemporaryPassword(ctx, tx, actor, id)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		tps = append(tps, tp)
+	}
+	return tps, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) setTemporaryPassword(
+	ctx context.Context,
+	tx core.DBExecutor,
+	actor core.AuditActor,
+	id string,
+) (TemporaryPassword, error) {
+	before, err := svc.repo.GetUser(ctx, GetFilter{ID: id}, tx)
+	if err != nil {
+		return TemporaryPassword{}, errors.Wrap(err, "finding user by ID")
+	}
+	pwd, err := newTemporaryPassword()
+	if err != nil {
+		return TemporaryPassword{}, err
+	}
+	usr := before
+	if err = usr.SetPassword(pwd); err != nil {
+		return TemporaryPassword{}, errors.Wrap(err, "hashing password")
+	}
+	usr.MustChangePassword = true
+	if usr, err = svc.updatePassword(ctx, tx, usr); err != nil {
+		return TemporaryPassword{}, err
+	}
+	if err = svc.audit(ctx, tx, actor, core.AuditUserTempPassword, before, usr, true); err != nil {
+		return TemporaryPassword{}, err
+	}
+	return TemporaryPassword{User: usr, Password: pwd}, nil
+}
+
+func (svc *Service) ChangeTemporaryPassword(id string, np NewPassword) (User, error) {
+	usr, err := svc.GetByID(id)
+	if err != nil {
+		return User{}, err
+	}
+	if !usr.MustChangePassword {
+		return User{}, ErrNoTempPwd
+	}
+	if usr.CheckPassword(np.Password) == nil {
+		return User{}, core.NewValidationError(nil, core.FieldError{Field: "password", Error: "password must differ from the temporary one"})
+	}
+
+	if err = usr.SetPassword(np.Password); err != nil {
+		return User{}, errors.Wrap(err, "hashing password")
+	}
+	usr.MustChangePassword = false
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
+	}
+	if usr, err = svc.updatePassword(ctx, tx, usr); err != nil {
+		_ = tx.Rollback()
+		return User{}, err
+	}
+	return usr, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) Delete(actor core.AuditActor, ids ...string) error {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+
+	// the Users not found, or already deleted, are skipped
+	usrs := make([]User, 0, len(ids))
+	for _, id := range ids {
+		usr, err := svc.repo.GetUser(ctx, GetFilter{ID: id}, tx)
+		if err != nil {
+			if errors.Cause(err) == ErrNotFound {
+				continue
+			}
+			_ = tx.Rollback()
+			return errors.Wrap(err, "finding user by ID")
+		}
+		usrs = append(usrs, usr)
+	}
+	now := time.Now().UTC()
+	if _, err = svc.repo.SoftDeleteUsersByID(ctx, ids, now, tx); err != nil {
+		_ = tx.Rollback()
 		return errors.Wrap(err, "deleting users")
 	}
-	return nil
+	for _, usr := range usrs {
+		deleted := usr
+		deleted.DeletedAt = now
+		if err = svc.sessions.RevokeUserSessions(ctx, usr.ID, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err = svc.audit(ctx, tx, actor, core.AuditUserDelete, usr, deleted, false); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) Restore(id string, actor core.AuditActor) (User, error) {
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, errors.Wrap(err, "starting transaction")
+	}
+
+	before, err := svc.repo.GetUser(ctx, GetFilter{ID: id, Deleted: true}, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "finding deleted user by ID")
+	}
+	usr := before
+	usr.DeletedAt = time.Time{}
+	if usr, err = svc.repo.UpdateUser(ctx, usr, tx); err != nil {
+		_ = tx.Rollback()
+		return User{}, errors.Wrap(err, "restoring user")
+	}
+	if err = svc.audit(ctx, tx, actor, core.AuditUserRestore, before, usr, false); err != nil {
+		_ = tx.Rollback()
+		return User{}, err
+	}
+	return usr, errors.Wrap(tx.Commit(), "committing transaction")
 }
@@ -1,6 +1,9 @@
 package user
 
 import (
+	"context"
+	"time"
+
 	"github.com/trezcool/masomo/core"
 )
 
@@ -8,14 +11,28 @@ type serviceMock struct {
 	Service
 }
 
-func NewServiceMock(db core.DB, repo Repository, mailSvc core.EmailService, conf *core.Config) *serviceMock {
+func NewServiceMock(
+	db core.DB,
+	repo Repository,
+	mailSvc core.EmailService,
+	smsSvc core.SMSService,
+	auditLog core.AuditLog,
+	sessions core.SessionRevoker,
+	emailPolicy core.EmailPolicy,
+	conf *core.Config,
+) *serviceMock {
 	return &serviceMock{
 		Service: Service{
 			db:       db,
 			repo:     repo,
 			conf:     conf,
 			mailSvc:  mailSvc,
+			smsSvc:   smsSvc,
+			auditLog: auditLog,
+			sessions: sessions,
+			policy:   emailPolicy,
 			ordering: []core.DBOrdering{{Field: "created_at"}},
+			requests: core.NewRateLimiter(maxVerificationRequests, time.Hour),
 		},
 	}
 }
@@ -29,3 +46,16 @@ func (svc *serviceMock) RequestPasswordReset(email string) error {
 	svc.sendPasswordResetMail(usr)
 	return nil
 }
+
+func (svc *serviceMock) RequestPasswordResetSMS(phone string) error {
+	usrs, err := svc.repo.QueryUsers(context.Background(), &QueryFilter{Phone: phone}, svc.ordering)
+	if err != nil {
+		return err
+	}
+	if len(usrs) == 0 {
+		return ErrNotFound
+	}
+	// run synchronously
+	svc.sendPasswordResetSMS(usrs)
+	return nil
+}
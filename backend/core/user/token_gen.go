@@ -7,6 +7,7 @@ import (
 	"crypto/subtle"
 	"encoding/base32"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"strconv"
@@ -16,6 +17,14 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	purposeLogin       = "login:"
+	purposeLoginCode   = "login-code:"
+	purposeEmailChange = "email-change:"
+	purposeEmailVerify = "email-verify:"
+	loginCodeDigits    = 6
+)
+
 var (
 	salt    = []byte("masomo.backend.core.user.token_gen")
 	NowFunc = time.Now // mockable
@@ -30,8 +39,8 @@ func EncodeUID(usr User) string {
 	return base64.RawURLEncoding.EncodeToString([]byte(usr.ID))
 }
 
-// decodeUID base64 decodes given UID
-func decodeUID(uid string) (string, error) {
+// DecodeUID base64 decodes given UID
+func DecodeUID(uid string) (string, error) {
 	idBytes, err := base64.RawURLEncoding.DecodeString(uid)
 	if err != nil {
 		return "", err
@@ -41,33 +50,109 @@ func decodeUID(uid string) (string, error) {
 
 // MakeToken generates a password reset token for a given User.
 func MakeToken(usr User) (string, error) {
-	token, err := _makeTokenWithTimestamp(usr, _numDaysSince2001(NowFunc()))
+	token, err := _makeTokenWithTimestamp(usr, _numDaysSince2001(NowFunc()), "")
 	return token, errors.Wrap(err, "generating token")
 }
 
-// verifyToken checks that a password reset token for a given User is valid.
-func verifyToken(usr User, token string) error {
-	if token == "" {
-		return errInvalidToken
+// MakeEmailChangeToken generates a token confirming the change of the email of a given User to a new address.
+// It expires like password reset tokens, and once the email of the User changes.
+func MakeEmailChangeToken(usr User, email string) (string, error) {
+	token, err := _makeTokenWithTimestamp(usr, _numDaysSince2001(NowFunc()), emailChangePurpose(usr, email))
+	return token, errors.Wrap(err, "generating email change token")
+}
+
+// MakeEmailVerificationToken generates a token verifying the email of a given User.
+// It expires like password reset tokens, and once the email of the User changes; unlike them, it survives logins.
+func MakeEmailVerificationToken(usr User) (string, error) {
+	token, err := _makeTokenWithTimestamp(usr, _numDaysSince2001(NowFunc()), purposeEmailVerify+usr.Email)
+	return token, errors.Wrap(err, "generating email verification token")
+}
+
+// MakeLoginToken generates a passwordless login token for a given User; see VerifyLoginToken.
+func MakeLoginToken(usr User) (string, error) {
+	token, err := _makeTokenWithTimestamp(usr, _numMinutesSince2001(NowFunc()), purposeLogin)
+	return token, errors.Wrap(err, "generating login token")
+}
+
+// VerifyLoginToken reports whether a passwordless login token for a given User was issued less than timeout ago.
+// Like password reset tokens, it is invalidated by the next login of the User: setting User.LastLogin uses it.
+func VerifyLoginToken(usr User, token string, timeout time.Duration) bool {
+	ts, ok := _parseTimestamp(token)
+	if !ok {
+		return false
 	}
+	newToken, err := _makeTokenWithTimestamp(usr, ts, purposeLogin)
+	if err != nil || subtle.ConstantTimeCompare([]byte(newToken), []byte(token)) == 0 {
+		return false
+	}
+	age := _numMinutesSince2001(NowFunc()) - ts
+	return age >= 0 && age <= int(timeout.Minutes())
+}
 
-	parts := strings.SplitN(token, "-", 2)
-	if len(parts) < 2 {
-		return errInvalidToken
+// MakeLoginCode generates a 6-digit passwordless login code for a given User; see VerifyLoginCode.
+func MakeLoginCode(usr User) (string, error) {
+	code, err := _makeCode(usr, _numMinutesSince2001(NowFunc()))
+	return code, errors.Wrap(err, "generating login code")
+}
+
+// VerifyLoginCode reports whether a passwordless login code for a given User was issued less than timeout ago,
+// and after notBefore when set. Codes are invalidated like login tokens; being short, their attempts must be limited
+// by the caller, which invalidates the codes issued so far with notBefore once the attempts run out.
+func VerifyLoginCode(usr User, code string, timeout time.Duration, notBefore time.Time) bool {
+	if len(code) != loginCodeDigits {
+		return false
+	}
+	now := _numMinutesSince2001(NowFunc())
+	oldest := now - int(timeout.Minutes())
+	if !notBefore.IsZero() {
+		// codes are issued per minute: those of the minute of notBefore are rejected too
+		if nb := _numMinutesSince2001(notBefore) + 1; nb > oldest {
+			oldest = nb
+		}
 	}
-	tsB32 := parts[0]
+	for ts := now; ts >= oldest; ts-- {
+		want, err := _makeCode(usr, ts)
+		if err != nil {
+			return false
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return true
+		}
+	}
+	return false
+}
 
-	data, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(tsB32)
-	if err != nil {
+// verifyToken checks that a password reset token for a given User is valid.
+func verifyToken(usr User, token string) error {
+	return verifyTokenWithPurpose(usr, token, "")
+}
+
+// verifyEmailChangeToken checks that an email change token for a given User & address is valid.
+func verifyEmailChangeToken(usr User, email, token string) error {
+	return verifyTokenWithPurpose(usr, token, emailChangePurpose(usr, email))
+}
+
+// verifyEmailVerificationToken checks that an email verification token for a given User is valid.
+func verifyEmailVerificationToken(usr User, token string) error {
+	return verifyTokenWithPurpose(usr, token, purposeEmailVerify+usr.Email)
+}
+
+func emailChangePurpose(usr User, email string) string {
+	return purposeEmailChange + usr.Email + ">" + email
+}
+
+func verifyTokenWithPurpose(usr User, token, purpose string) error {
+	if token == "" {
 		return errInvalidToken
 	}
-	ts, err := strconv.Atoi(string(data))
-	if err != nil {
+
+	ts, ok := _parseTimestamp(token)
+	if !ok {
 		return errInvalidToken
 	}
 
 	// check that token has not been tampered with
-	newToken, err := _makeTokenWithTimestamp(usr, ts)
+	newToken, err := _makeTokenWithTimestamp(usr, ts, purpose)
 	if err != nil {
 		return errors.Wrap(err, "generating token")
 	}
@@ -82,32 +167,80 @@ func verifyToken(usr User, token string) error {
 	return nil
 }
 
-func _makeTokenWithTimestamp(usr User, ts int) (string, error) {
+// _parseTimestamp returns the timestamp of a token
+func _parseTimestamp(token string) (int, bool) {
+	parts := strings.SplitN(token, "-", 2)
+	if len(parts) < 2 {
+		return 0, false
+	}
+	data, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	ts, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, false
+	}
+	return ts, true
+}
+
+func _makeTokenWithTimestamp(usr User, ts int, purpose string) (string, error) {
 	tsB32 := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte(strconv.Itoa(ts)))
-	sig, err := _sign(_hashValue(usr, ts))
+	sig, err := _sign(_hashValue(usr, ts, purpose))
 	if err != nil {
 		return "", errors.Wrap(err, "generating signature")
 	}
 	return fmt.Sprintf("%s-%s", tsB32, sig), nil
 }
 
+// _makeCode returns the numeric code of a User at a timestamp; truncated like HOTP codes (RFC 4226)
+func _makeCode(usr User, ts int) (string, error) {
+	mac, err := _mac(_hashValue(usr, ts, purposeLoginCode))
+	if err != nil {
+		return "", errors.Wrap(err, "generating signature")
+	}
+	offset := mac[len(mac)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(mac[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", loginCodeDigits, bin%uint32(math.Pow10(loginCodeDigits))), nil
+}
+
 func _numDaysSince2001(t time.Time) int {
 	ref := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
 	return int(math.Ceil(t.Sub(ref).Hours() / 24))
 }
 
+func _numMinutesSince2001(t time.Time) int {
+	ref := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(ref).Minutes())
+}
+
 func _sign(val []byte) (string, error) {
+	mac, err := _mac(val)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(mac), nil
+}
+
+func _mac(val []byte) ([]byte, error) {
 	key := sha256.Sum256(append(salt, secretKey...))
 	h := hmac.New(sha256.New, key[:])
 	if _, err := h.Write(val); err != nil {
-		return "", errors.Wrap(err, "hashing")
+		return nil, errors.Wrap(err, "hashing")
 	}
-	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)), nil
+	return h.Sum(nil), nil
 }
 
-func _hashValue(usr User, ts int) []byte {
+// _hashValue returns the value signed in the tokens of a User; the purpose keeps tokens from being used for another.
+func _hashValue(usr User, ts int, purpose string) []byte {
 	var val bytes.Buffer
+	val.WriteString(purpose)
 	val.WriteString(usr.ID)
+	if strings.HasPrefix(purpose, purposeEmailVerify) {
+		// verification links are mailed on signup: logging in, or setting a password, must not invalidate them
+		val.WriteString(strconv.Itoa(ts))
+		return val.Bytes()
+	}
 	val.Write(usr.PasswordHash)
 	if !usr.LastLogin.IsZero() {
 		val.WriteString(usr.LastLogin.String())
@@ -57,3 +57,178 @@ func TestMakeVerifyToken(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeVerifyLoginToken(t *testing.T) {
+	now := time.Now()
+	usr := User{ID: uuid.New().String(), Username: "t", LastLogin: now}
+	_ = usr.SetPassword("pwd")
+	timeout := 15 * time.Minute
+
+	token, err := MakeLoginToken(usr)
+	if err != nil {
+		t.Fatalf("MakeLoginToken(): %v", err)
+	}
+	resetToken, err := MakeToken(usr)
+	if err != nil {
+		t.Fatalf("MakeToken(): %v", err)
+	}
+	NowFunc = func() time.Time { return now.Add(-timeout - 2*time.Minute) }
+	expiredToken, err := MakeLoginToken(usr)
+	if err != nil {
+		t.Fatalf("MakeLoginToken(): %v", err)
+	}
+	NowFunc = time.Now // reset
+
+	loggedIn := usr
+	loggedIn.LastLogin = now.Add(time.Second)
+
+	tests := []struct {
+		name  string
+		usr   User
+		token string
+		want  bool
+	}{
+		{name: "no token", usr: usr},
+		{name: "invalid token", usr: usr, token: "HE4TS-sigsig-sig"},
+		{name: "password reset token", usr: usr, token: resetToken},
+		{name: "expired token", usr: usr, token: expiredToken},
+		{name: "used token", usr: loggedIn, token: token},
+		{name: "valid token", usr: usr, token: token, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyLoginToken(tt.usr, tt.token, timeout); got != tt.want {
+				t.Errorf("VerifyLoginToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeVerifyLoginCode(t *testing.T) {
+	now := time.Now()
+	usr := User{ID: uuid.New().String(), Username: "t", LastLogin: now}
+	_ = usr.SetPassword("pwd")
+	timeout := 15 * time.Minute
+
+	code, err := MakeLoginCode(usr)
+	if err != nil {
+		t.Fatalf("MakeLoginCode(): %v", err)
+	}
+	if len(code) != loginCodeDigits {
+		t.Fatalf("MakeLoginCode() = %q, want %d digits", code, loginCodeDigits)
+	}
+	NowFunc = func() time.Time { return now.Add(-timeout + time.Minute) }
+	oldCode, err := MakeLoginCode(usr)
+	if err != nil {
+		t.Fatalf("MakeLoginCode(): %v", err)
+	}
+	NowFunc = func() time.Time { return now.Add(-timeout - 2*time.Minute) }
+	expiredCode, err := MakeLoginCode(usr)
+	if err != nil {
+		t.Fatalf("MakeLoginCode(): %v", err)
+	}
+	NowFunc = time.Now // reset
+
+	loggedIn := usr
+	loggedIn.LastLogin = now.Add(time.Second)
+
+	tests := []struct {
+		name      string
+		usr       User
+		code      string
+		notBefore time.Time
+		want      bool
+	}{
+		{name: "no code", usr: usr},
+		{name: "short code", usr: usr, code: code[:5]},
+		{name: "expired code", usr: usr, code: expiredCode},
+		{name: "used code", usr: loggedIn, code: code},
+		{name: "old code", usr: usr, code: oldCode, want: true},
+		{name: "valid code", usr: usr, code: code, want: true},
+		{name: "invalidated code", usr: usr, code: code, notBefore: now},
+		{name: "code issued after invalidation", usr: usr, code: code, notBefore: now.Add(-2 * time.Minute), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyLoginCode(tt.usr, tt.code, timeout, tt.notBefore); got != tt.want {
+				t.Errorf("VerifyLoginCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeVerifyEmailChangeToken(t *testing.T) {
+	usr := User{ID: uuid.New().String(), Username: "t", Email: "old@test.test", LastLogin: time.Now()}
+	_ = usr.SetPassword("pwd")
+
+	token, err := MakeEmailChangeToken(usr, "new@test.test")
+	if err != nil {
+		t.Fatalf("MakeEmailChangeToken(): %v", err)
+	}
+	resetToken, err := MakeToken(usr)
+	if err != nil {
+		t.Fatalf("MakeToken(): %v", err)
+	}
+	changed := usr
+	changed.Email = "other@test.test"
+
+	tests := []struct {
+		name    string
+		usr     User
+		email   string
+		token   string
+		wantErr error
+	}{
+		{name: "no token", usr: usr, email: "new@test.test", wantErr: errInvalidToken},
+		{name: "password reset token", usr: usr, email: "new@test.test", token: resetToken, wantErr: errInvalidToken},
+		{name: "another email", usr: usr, email: "evil@test.test", token: token, wantErr: errInvalidToken},
+		{name: "email changed since", usr: changed, email: "new@test.test", token: token, wantErr: errInvalidToken},
+		{name: "valid token", usr: usr, email: "new@test.test", token: token},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyEmailChangeToken(tt.usr, tt.email, tt.token); err != tt.wantErr {
+				t.Errorf("verifyEmailChangeToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMakeVerifyEmailVerificationToken(t *testing.T) {
+	usr := User{ID: uuid.New().String(), Username: "t", Email: "me@test.test"}
+	_ = usr.SetPassword("pwd")
+
+	token, err := MakeEmailVerificationToken(usr)
+	if err != nil {
+		t.Fatalf("MakeEmailVerificationToken(): %v", err)
+	}
+	resetToken, err := MakeToken(usr)
+	if err != nil {
+		t.Fatalf("MakeToken(): %v", err)
+	}
+	loggedIn := usr
+	loggedIn.LastLogin = time.Now()
+	_ = loggedIn.SetPassword("new-pwd")
+	changed := usr
+	changed.Email = "other@test.test"
+
+	tests := []struct {
+		name    string
+		usr     User
+		token   string
+		wantErr error
+	}{
+		{name: "no token", usr: usr, wantErr: errInvalidToken},
+		{name: "password reset token", usr: usr, token: resetToken, wantErr: errInvalidToken},
+		{name: "email changed since", usr: changed, token: token, wantErr: errInvalidToken},
+		{name: "valid token", usr: usr, token: token},
+		{name: "logged in & password set since", usr: loggedIn, token: token},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyEmailVerificationToken(tt.usr, tt.token); err != tt.wantErr {
+				t.Errorf("verifyEmailVerificationToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -57,6 +57,8 @@ func InitValidators(validate *validator.Validate, translator ut.Translator) {
 	validate.RegisterStructValidation(userStructValidation, NewUser{})
 	validate.RegisterStructValidation(userStructValidation, UpdateUser{})
 	validate.RegisterStructValidation(userStructValidation, ResetUserPassword{})
+	validate.RegisterStructValidation(userStructValidation, NewPassword{})
+	validate.RegisterStructValidation(userStructValidation, UpdateProfile{})
 	core.RegisterCustomTranslation(validate, translator, usernameOrEmailTag, usernameOrEmailText)
 	core.RegisterCustomTranslation(validate, translator, pwdMinLenTag, pwdMinLenText)
 	core.RegisterCustomTranslation(validate, translator, pwdNoSpaceTag, pwdNoSpaceText)
@@ -112,7 +114,8 @@ func allRolesValidation(fl validator.FieldLevel) bool {
 	return false
 }
 
-// userStructValidation does struct level validation on NewUser, UpdateUser and ResetUserPassword structs.
+// userStructValidation does struct level validation on NewUser, UpdateUser, ResetUserPassword, NewPassword
+// and UpdateProfile structs.
 func userStructValidation(sl validator.StructLevel) {
 	switch usr := sl.Current().Interface().(type) {
 	case NewUser:
@@ -124,6 +127,12 @@ func userStructValidation(sl validator.StructLevel) {
 		}
 	case ResetUserPassword:
 		validatePassword(usr.Password, "", "", "", sl)
+	case NewPassword:
+		validatePassword(usr.Password, "", "", "", sl)
+	case UpdateProfile:
+		if usr.Password != "" {
+			validatePassword(usr.Password, usr.Name, "", usr.Email, sl)
+		}
 	}
 }
 
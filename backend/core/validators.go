@@ -16,9 +16,13 @@ var (
 	alphaNumUnderText  = "only alphanumeric characters and underscores are allowed"
 	alphaNumUnderRegex = regexp.MustCompile(`^[\w\s]+$`)
 
-	requiredTag     = "required"
-	requiredWithTag = "required_with"
-	requiredText    = "this field is required"
+	e164Tag  = "e164"
+	e164Text = "must be a phone number in international format; eg. +243810000000"
+
+	requiredTag        = "required"
+	requiredWithTag    = "required_with"
+	requiredWithoutTag = "required_without"
+	requiredText       = "this field is required"
 )
 
 // InitValidators instantiates the validator for use.
@@ -40,6 +44,8 @@ func InitValidators(validate *validator.Validate, translator ut.Translator) {
 
 	RegisterCustomTranslation(validate, translator, requiredTag, requiredText, true)
 	RegisterCustomTranslation(validate, translator, requiredWithTag, requiredText, true)
+	RegisterCustomTranslation(validate, translator, requiredWithoutTag, requiredText, true)
+	RegisterCustomTranslation(validate, translator, e164Tag, e164Text, true)
 }
 
 // RegisterCustomTranslation registers a custom translation for the specified validation tag.
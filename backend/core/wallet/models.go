@@ -0,0 +1,317 @@
+package wallet
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// Account kinds
+const (
+	AccountSchool  = "school"  // issues the points allocated to Course budgets; its balance is negative
+	AccountCourse  = "course"  // budget of a Course, spent on the points earned by its Students
+	AccountStudent = "student" // wallet of a Student
+)
+
+// Transaction kinds
+const (
+	TxAllocation = "allocation" // School -> Course
+	TxEarning    = "earning"    // Course -> Student
+)
+
+// Rule events
+const (
+	EventQuiz = "quiz" // a counted Attempt of a virtual Coursework is submitted
+	EventMark = "mark" // official Marks are entered or imported
+)
+
+// Award scopes
+const (
+	ScopeClass      = "class"
+	ScopeYearLevel  = "year_level"
+	ScopeDepartment = "department"
+	ScopeSchool     = "school"
+)
+
+const (
+	// MinFinalAverage is the percentage in the final Term a Student needs to be awarded.
+	MinFinalAverage = 65.0
+	// DefaultTopStudents is the number of Students awarded per Class.
+	DefaultTopStudents = 3
+)
+
+// Account holds the points of a School, a Course or a Student for an academic year.
+// Its balance is the sum of the amounts of its Entries.
+type Account struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Kind      string    `json:"kind"`
+	OwnerID   string    `json:"owner_id"` // School, Course or Student
+	Year      int       `json:"year"`     // academic year start; eg. 2026 for 2026-2027
+	Balance   int       `json:"balance"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+	UpdatedAt time.Time `json:"updated_at"` // UTC
+}
+
+// Transaction moves points between Accounts: its Entries sum to zero.
+type Transaction struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Year      int       `json:"year"`
+	Kind      string    `json:"kind"`
+	CourseID  string    `json:"course_id,omitempty"`
+	RuleID    string    `json:"rule_id,omitempty"`
+	Reference string    `json:"-"` // unique; dedupes earnings
+	Memo      string    `json:"memo"`
+	AuthorID  string    `json:"author_id,omitempty"`
+	Entries   []Entry   `json:"entries"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+}
+
+// Entry credits (amount > 0) or debits (amount < 0) an Account.
+type Entry struct {
+	ID        string    `json:"id"` // UUID
+	AccountID string    `json:"account_id"`
+	Amount    int       `json:"amount"`
+	CreatedAt time.Time `json:"created_at"` // UTC
+}
+
+// Balanced reports whether the Entries of a Transaction sum to zero.
+func (t Transaction) Balanced() bool {
+	if len(t.Entries) < 2 {
+		return false
+	}
+	var sum int
+	for _, e := range t.Entries {
+		if e.Amount == 0 {
+			return false
+		}
+		sum += e.Amount
+	}
+	return sum == 0
+}
+
+// transfer returns a Transaction moving points from an Account to another.
+func transfer(kind string, from, to Account, points int) Transaction {
+	return Transaction{
+		SchoolID: from.SchoolID,
+		Year:     from.Year,
+		Kind:     kind,
+		Entries: []Entry{
+			{AccountID: from.ID, Amount: -points},
+			{AccountID: to.ID, Amount: points},
+		},
+	}
+}
+
+// Budget is the state of the budget of a Course for an academic year.
+type Budget struct {
+	CourseID  string `json:"course_id"`
+	Year      int    `json:"year"`
+	Allocated int    `json:"allocated"`
+	Spent     int    `json:"spent"`
+	Balance   int    `json:"balance"`
+}
+
+// Rule is an earning criterion set by a Teacher: Students scoring at least MinPercent earn Points,
+// once per Coursework (quiz) or Assessment (mark).
+type Rule struct {
+	ID         string    `json:"id"` // UUID
+	CourseID   string    `json:"course_id"`
+	Year       int       `json:"year"`
+	Name       string    `json:"name"`
+	Event      string    `json:"event"`
+	TargetID   string    `json:"target_id,omitempty"` // Coursework or Assessment; all of the Course when empty
+	MinPercent float64   `json:"min_percent"`
+	Points     int       `json:"points"`
+	CreatedAt  time.Time `json:"created_at"` // UTC
+	UpdatedAt  time.Time `json:"updated_at"` // UTC
+}
+
+// Matches reports whether a score of a Student on targetID meets a Rule.
+func (r Rule) Matches(event, targetID string, score, maxScore float64) bool {
+	if r.Event != event || (r.TargetID != "" && r.TargetID != targetID) || maxScore <= 0 {
+		return false
+	}
+	return score/maxScore*100 >= r.MinPercent
+}
+
+// reference returns the reference of the earning of a Rule by a Student on targetID.
+func (r Rule) reference(targetID, studentID string) string {
+	return "rule:" + r.ID + ":" + targetID + ":" + studentID
+}
+
+// NewRule contains information needed to create a new Rule.
+type NewRule struct {
+	Name       string  `json:"name" validate:"required,max=100"`
+	Event      string  `json:"event" validate:"required,oneof=quiz mark"`
+	TargetID   string  `json:"target_id" validate:"omitempty,uuid"`
+	MinPercent float64 `json:"min_percent" validate:"min=0,max=100"`
+	Points     int     `json:"points" validate:"min=1"`
+}
+
+func (nr *NewRule) Validate(validate *validator.Validate) error {
+	nr.Name = core.CleanString(nr.Name)
+	return validate.Struct(nr)
+}
+
+// Allocation contains the points allocated by an admin to the budget of a Course; negative to take some back.
+type Allocation struct {
+	Points int    `json:"points" validate:"required"`
+	Memo   string `json:"memo" validate:"max=254"`
+}
+
+func (a *Allocation) Validate(validate *validator.Validate) error {
+	a.Memo = core.CleanString(a.Memo)
+	return validate.Struct(a)
+}
+
+// Standing is the rank of a Student in a leaderboard.
+type Standing struct {
+	StudentID string `json:"student_id"`
+	ClassID   string `json:"class_id"`
+	Points    int    `json:"points"`
+	Rank      int    `json:"rank"`
+}
+
+// rankStandings sorts Standings by points and sets their competition rank ("1224").
+func rankStandings(ss []Standing) {
+	sort.SliceStable(ss, func(i, j int) bool {
+		if ss[i].Points != ss[j].Points {
+			return ss[i].Points > ss[j].Points
+		}
+		return ss[i].StudentID < ss[j].StudentID
+	})
+	for i := range ss {
+		if i > 0 && ss[i].Points == ss[i-1].Points {
+			ss[i].Rank = ss[i-1].Rank
+		} else {
+			ss[i].Rank = i + 1
+		}
+	}
+}
+
+// LeaderboardFilter selects the Students of a leaderboard; zero values match all.
+type LeaderboardFilter struct {
+	SchoolID     string `query:"-"`
+	Year         int    `query:"year" validate:"required,min=2000"`
+	ClassID      string `query:"class_id" validate:"omitempty,uuid"`
+	YearLevel    int    `query:"year_level" validate:"min=0"`
+	DepartmentID string `query:"department_id" validate:"omitempty,uuid"`
+	Limit        int    `query:"limit" validate:"min=0"`
+}
+
+// Award is the year-end reward of one of the richest Students of a scope among those passing the final.
+type Award struct {
+	ID        string    `json:"id"` // UUID
+	SchoolID  string    `json:"school_id"`
+	Year      int       `json:"year"`
+	Scope     string    `json:"scope"`
+	ScopeID   string    `json:"scope_id"` // Class, YearLevel, Department or School
+	Rank      int       `json:"rank"`
+	StudentID string    `json:"student_id"`
+	ClassID   string    `json:"class_id"`
+	Points    int       `json:"points"`
+	Average   float64   `json:"average"`    // in the final
+	CreatedAt time.Time `json:"created_at"` // UTC
+}
+
+// Candidate is a Student competing for the Awards of a year.
+type Candidate struct {
+	StudentID    string
+	ClassID      string
+	YearLevel    int
+	DepartmentID string
+	Points       int
+	Average      *float64 // in the final; nil when not marked
+}
+
+// ComputeAwards returns the Awards of a School for a year: the `top` richest Students of each Class
+// among those with at least MinFinalAverage in the final, then the best of each YearLevel, Department
+// and of the School. Ties are broken by the final average.
+func ComputeAwards(schoolID string, year int, candidates []Candidate, top int) []Award {
+	eligible := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Points > 0 && c.Average != nil && *c.Average >= MinFinalAverage {
+			eligible = append(eligible, c)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		a, b := eligible[i], eligible[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if *a.Average != *b.Average {
+			return *a.Average > *b.Average
+		}
+		return a.StudentID < b.StudentID
+	})
+
+	var awards []Award
+	award := func(scope, scopeID string, rank int, c Candidate) {
+		awards = append(awards, Award{
+			SchoolID:  schoolID,
+			Year:      year,
+			Scope:     scope,
+			ScopeID:   scopeID,
+			Rank:      rank,
+			StudentID: c.StudentID,
+			ClassID:   c.ClassID,
+			Points:    c.Points,
+			Average:   *c.Average,
+		})
+	}
+
+	// eligible is sorted: the first Candidates seen in a scope are its best
+	perClass := make(map[string]int)
+	bestLevels := make(map[int]bool)
+	bestDepts := make(map[string]bool)
+	for i, c := range eligible {
+		if perClass[c.ClassID] < top {
+			perClass[c.ClassID]++
+			award(ScopeClass, c.ClassID, perClass[c.ClassID], c)
+		}
+		if !bestLevels[c.YearLevel] {
+			bestLevels[c.YearLevel] = true
+			award(ScopeYearLevel, strconv.Itoa(c.YearLevel), 1, c)
+		}
+		if c.DepartmentID != "" && !bestDepts[c.DepartmentID] {
+			bestDepts[c.DepartmentID] = true
+			award(ScopeDepartment, c.DepartmentID, 1, c)
+		}
+		if i == 0 {
+			award(ScopeSchool, schoolID, 1, c)
+		}
+	}
+	return awards
+}
+
+// Wallet is the Account of a Student for a year, with its Transactions.
+type Wallet struct {
+	Account      Account       `json:"account"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+type AccountFilter struct {
+	SchoolID string
+	Kind     string
+	OwnerIDs []string
+	Year     int
+}
+
+type TransactionFilter struct {
+	AccountID string
+	CourseID  string
+	Kind      string
+	Limit     int
+}
+
+type RuleFilter struct {
+	CourseID string
+	Year     int
+	Event    string
+}
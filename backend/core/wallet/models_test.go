@@ -0,0 +1,122 @@
+package wallet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransaction_Balanced(t *testing.T) {
+	from, to := Account{ID: "a"}, Account{ID: "b"}
+	tests := []struct {
+		name string
+		t    Transaction
+		want bool
+	}{
+		{name: "transfer", t: transfer(TxEarning, from, to, 10), want: true},
+		{name: "no entries", t: Transaction{}, want: false},
+		{name: "single entry", t: Transaction{Entries: []Entry{{Amount: 10}}}, want: false},
+		{name: "zero amounts", t: transfer(TxEarning, from, to, 0), want: false},
+		{name: "unbalanced", t: Transaction{Entries: []Entry{{Amount: -10}, {Amount: 5}}}, want: false},
+		{name: "split", t: Transaction{Entries: []Entry{{Amount: -10}, {Amount: 5}, {Amount: 5}}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.Balanced(); got != tt.want {
+				t.Errorf("Balanced() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	r := Rule{Event: EventMark, MinPercent: 80}
+	targeted := Rule{Event: EventQuiz, TargetID: "cw1", MinPercent: 50}
+	tests := []struct {
+		name     string
+		r        Rule
+		event    string
+		targetID string
+		score    float64
+		max      float64
+		want     bool
+	}{
+		{name: "above", r: r, event: EventMark, targetID: "a1", score: 18, max: 20, want: true},
+		{name: "exactly", r: r, event: EventMark, targetID: "a1", score: 16, max: 20, want: true},
+		{name: "below", r: r, event: EventMark, targetID: "a1", score: 15, max: 20, want: false},
+		{name: "other event", r: r, event: EventQuiz, targetID: "a1", score: 20, max: 20, want: false},
+		{name: "no max score", r: r, event: EventMark, targetID: "a1", score: 0, max: 0, want: false},
+		{name: "target", r: targeted, event: EventQuiz, targetID: "cw1", score: 5, max: 10, want: true},
+		{name: "other target", r: targeted, event: EventQuiz, targetID: "cw2", score: 10, max: 10, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Matches(tt.event, tt.targetID, tt.score, tt.max); got != tt.want {
+				t.Errorf("Matches() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rankStandings(t *testing.T) {
+	ss := []Standing{
+		{StudentID: "d", Points: 5},
+		{StudentID: "b", Points: 10},
+		{StudentID: "c", Points: 20},
+		{StudentID: "a", Points: 10},
+	}
+	rankStandings(ss)
+	want := []Standing{
+		{StudentID: "c", Points: 20, Rank: 1},
+		{StudentID: "a", Points: 10, Rank: 2},
+		{StudentID: "b", Points: 10, Rank: 2},
+		{StudentID: "d", Points: 5, Rank: 4},
+	}
+	if !reflect.DeepEqual(ss, want) {
+		t.Errorf("rankStandings() = %+v; want %+v", ss, want)
+	}
+}
+
+func TestComputeAwards(t *testing.T) {
+	avg := func(f float64) *float64 { return &f }
+	candidates := []Candidate{
+		{StudentID: "s1", ClassID: "6A", YearLevel: 6, DepartmentID: "sci", Points: 50, Average: avg(70)},
+		{StudentID: "s2", ClassID: "6A", YearLevel: 6, DepartmentID: "sci", Points: 80, Average: avg(60)}, // failed the final
+		{StudentID: "s3", ClassID: "6A", YearLevel: 6, DepartmentID: "sci", Points: 50, Average: avg(90)},
+		{StudentID: "s4", ClassID: "6A", YearLevel: 6, DepartmentID: "sci", Points: 0, Average: avg(95)}, // no points
+		{StudentID: "s5", ClassID: "6B", YearLevel: 6, Points: 40, Average: avg(65)},
+		{StudentID: "s6", ClassID: "5A", YearLevel: 5, Points: 100, Average: avg(80)},
+		{StudentID: "s7", ClassID: "5A", YearLevel: 5, Points: 30, Average: nil}, // not marked
+	}
+
+	type award struct {
+		scope, scopeID string
+		rank           int
+		studentID      string
+	}
+	want := []award{
+		{ScopeClass, "5A", 1, "s6"},
+		{ScopeYearLevel, "5", 1, "s6"},
+		{ScopeSchool, "sch", 1, "s6"},
+		{ScopeClass, "6A", 1, "s3"}, // tie broken by average
+		{ScopeYearLevel, "6", 1, "s3"},
+		{ScopeDepartment, "sci", 1, "s3"},
+		{ScopeClass, "6A", 2, "s1"},
+		{ScopeClass, "6B", 1, "s5"},
+	}
+
+	awards := ComputeAwards("sch", 2026, candidates, 2)
+	got := make([]award, 0, len(awards))
+	for _, a := range awards {
+		if a.SchoolID != "sch" || a.Year != 2026 {
+			t.Errorf("award = %+v; want school sch, year 2026", a)
+		}
+		got = append(got, award{a.Scope, a.ScopeID, a.Rank, a.StudentID})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ComputeAwards() = %+v; want %+v", got, want)
+	}
+
+	if awards = ComputeAwards("sch", 2026, candidates, 1); len(awards) != 7 {
+		t.Errorf("ComputeAwards(top 1): got %d awards, want 7", len(awards))
+	}
+}
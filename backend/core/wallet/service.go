@@ -0,0 +1,485 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/core/school"
+)
+
+var (
+	// errors
+	ErrRuleNotFound       = errors.New("rule not found")
+	ErrInsufficientBudget = errors.New("insufficient budget")
+	ErrUnbalanced         = errors.New("unbalanced transaction")
+	errInvalidValue       = "invalid value"
+)
+
+type (
+	// a sql.Tx is optionally passed to methods as core.DBExecutor for Transaction control only
+	Repository interface {
+		// LockAccount returns the Account of the kind of an owner for a year, created if needed,
+		// locked until the end of the sql.Tx passed.
+		LockAccount(ctx context.Context, a Account, exec ...core.DBExecutor) (Account, error)
+		// QueryAccounts returns the Accounts matching f.
+		QueryAccounts(ctx context.Context, f AccountFilter, exec ...core.DBExecutor) ([]Account, error)
+		// CreateTransaction saves a Transaction and updates the balance of its Accounts; it returns false,
+		// without error, when a Transaction with the same Reference exists.
+		CreateTransaction(ctx context.Context, t Transaction, exec ...core.DBExecutor) (Transaction, bool, error)
+		// QueryTransactions returns the Transactions matching f with their Entries, most recent first.
+		QueryTransactions(ctx context.Context, f TransactionFilter, exec ...core.DBExecutor) ([]Transaction, error)
+
+		CreateRule(ctx context.Context, r Rule, exec ...core.DBExecutor) (Rule, error)
+		QueryRules(ctx context.Context, f RuleFilter, exec ...core.DBExecutor) ([]Rule, error)
+		GetRule(ctx context.Context, id string, exec ...core.DBExecutor) (Rule, error)
+		DeleteRule(ctx context.Context, id string, exec ...core.DBExecutor) error
+
+		// ReplaceAwards replaces the Awards of a School for a year; a sql.Tx should be passed.
+		ReplaceAwards(ctx context.Context, schoolID string, year int, awards []Award, exec ...core.DBExecutor) ([]Award, error)
+		QueryAwards(ctx context.Context, schoolID string, year int, exec ...core.DBExecutor) ([]Award, error)
+	}
+
+	ServiceInterface interface {
+		// Allocate moves points from the School to the budget of a Course for the year of its Class.
+		Allocate(crs school.Course, authorID string, a Allocation) (Budget, error)
+		Budget(crs school.Course) (Budget, error)
+
+		CreateRule(crs school.Course, nr NewRule) (Rule, error)
+		// QueryRules returns the Rules of a Course for the year of its Class.
+		QueryRules(crs school.Course) ([]Rule, error)
+		GetRule(id string) (Rule, error)
+		DeleteRule(id string) error
+
+		// EvaluateAttempt rewards the Student of a submitted Attempt meeting the quiz Rules of its Course,
+		// within the budget of the Course; it returns the earnings.
+		EvaluateAttempt(att coursework.Attempt) ([]Transaction, error)
+		// EvaluateMarks rewards the Students whose Marks of an Assessment meet the mark Rules of its Course,
+		// within the budget of the Course; it returns the earnings.
+		EvaluateMarks(a gradebook.Assessment, marks []gradebook.Mark) ([]Transaction, error)
+
+		// Wallet returns the Account of a Student for a year, with its Transactions.
+		Wallet(studentID string, year int) (Wallet, error)
+		// Leaderboard ranks the Students of the Classes matching f by points.
+		Leaderboard(f LeaderboardFilter) ([]Standing, error)
+
+		// ComputeAwards computes and saves the year-end Awards of a School; see ComputeAwards.
+		ComputeAwards(schoolID string, year int, finalTermID string, top int) ([]Award, error)
+		QueryAwards(schoolID string, year int) ([]Award, error)
+	}
+
+	Service struct {
+		db            core.DB
+		repo          Repository
+		schoolSvc     school.ServiceInterface
+		courseworkSvc coursework.ServiceInterface
+		gradebookSvc  gradebook.ServiceInterface
+		logger        core.Logger
+	}
+)
+
+var _ ServiceInterface = (*Service)(nil)
+
+func NewService(
+	db core.DB,
+	repo Repository,
+	schoolSvc school.ServiceInterface,
+	courseworkSvc coursework.ServiceInterface,
+	gradebookSvc gradebook.ServiceInterface,
+	logger core.Logger,
+) *Service {
+	return &Service{
+		db:            db,
+		repo:          repo,
+		schoolSvc:     schoolSvc,
+		courseworkSvc: courseworkSvc,
+		gradebookSvc:  gradebookSvc,
+		logger:        logger,
+	}
+}
+
+// post saves a balanced Transaction, all or none; it returns false when already posted (same Reference).
+// Debited Course budgets cannot go below zero.
+func (svc *Service) post(ctx context.Context, t Transaction, accounts ...Account) (Transaction, bool, error) {
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Transaction{}, false, errors.Wrap(err, "starting transaction")
+	}
+
+	// lock the Accounts, in a consistent order, so that concurrent postings see up-to-date balances
+	byID := make(map[string]Account, len(accounts))
+	for i, a := range accounts {
+		if a, err = svc.repo.LockAccount(ctx, a, tx); err != nil {
+			_ = tx.Rollback()
+			return Transaction{}, false, errors.Wrap(err, "locking account")
+		}
+		t.Entries[i].AccountID = a.ID
+		byID[a.ID] = a
+	}
+	if !t.Balanced() {
+		_ = tx.Rollback()
+		return Transaction{}, false, ErrUnbalanced
+	}
+	for _, e := range t.Entries {
+		if a := byID[e.AccountID]; a.Kind == AccountCourse && a.Balance+e.Amount < 0 {
+			_ = tx.Rollback()
+			return Transaction{}, false, ErrInsufficientBudget
+		}
+	}
+
+	t, created, err := svc.repo.CreateTransaction(ctx, t, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Transaction{}, false, errors.Wrap(err, "creating transaction")
+	}
+	return t, created, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// courseClass returns the Class of a Course: its year is the year of the budget and Rules of the Course.
+func (svc *Service) courseClass(crs school.Course) (school.Class, error) {
+	return svc.schoolSvc.GetClass(crs.ClassID)
+}
+
+func (svc *Service) Allocate(crs school.Course, authorID string, a Allocation) (Budget, error) {
+	cls, err := svc.courseClass(crs)
+	if err != nil {
+		return Budget{}, err
+	}
+	from := Account{SchoolID: cls.SchoolID, Kind: AccountSchool, OwnerID: cls.SchoolID, Year: cls.Year}
+	to := Account{SchoolID: cls.SchoolID, Kind: AccountCourse, OwnerID: crs.ID, Year: cls.Year}
+	t := transfer(TxAllocation, from, to, a.Points)
+	t.CourseID, t.Memo, t.AuthorID = crs.ID, a.Memo, authorID
+
+	if _, _, err = svc.post(context.Background(), t, from, to); err != nil {
+		if err == ErrInsufficientBudget {
+			return Budget{}, core.NewValidationError(err, core.FieldError{Field: "points", Error: "exceeds the budget left"})
+		}
+		return Budget{}, err
+	}
+	return svc.Budget(crs)
+}
+
+func (svc *Service) Budget(crs school.Course) (Budget, error) {
+	ctx := context.Background()
+	cls, err := svc.courseClass(crs)
+	if err != nil {
+		return Budget{}, err
+	}
+	b := Budget{CourseID: crs.ID, Year: cls.Year}
+
+	accounts, err := svc.repo.QueryAccounts(ctx, AccountFilter{Kind: AccountCourse, OwnerIDs: []string{crs.ID}, Year: cls.Year})
+	if err != nil {
+		return Budget{}, errors.Wrap(err, "querying accounts")
+	}
+	if len(accounts) == 0 {
+		return b, nil
+	}
+	b.Balance = accounts[0].Balance
+
+	ts, err := svc.repo.QueryTransactions(ctx, TransactionFilter{AccountID: accounts[0].ID, Kind: TxAllocation})
+	if err != nil {
+		return Budget{}, errors.Wrap(err, "querying transactions")
+	}
+	for _, t := range ts {
+		for _, e := range t.Entries {
+			if e.AccountID == accounts[0].ID {
+				b.Allocated += e.Amount
+			}
+		}
+	}
+	b.Spent = b.Allocated - b.Balance
+	return b, nil
+}
+
+func (svc *Service) CreateRule(crs school.Course, nr NewRule) (Rule, error) {
+	cls, err := svc.courseClass(crs)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	// the target must be a virtual Coursework (quiz) or an official Assessment (mark) of the Course
+	if nr.TargetID != "" {
+		var valid bool
+		switch nr.Event {
+		case EventQuiz:
+			cw, err := svc.courseworkSvc.GetByID(nr.TargetID)
+			if err != nil && errors.Cause(err) != coursework.ErrCourseworkNotFound {
+				return Rule{}, err
+			}
+			valid = err == nil && cw.CourseID == crs.ID && cw.IsVirtual()
+		case EventMark:
+			a, err := svc.gradebookSvc.GetAssessment(nr.TargetID)
+			if err != nil && errors.Cause(err) != gradebook.ErrAssessmentNotFound {
+				return Rule{}, err
+			}
+			if err == nil && a.Kind() == gradebook.KindOfficial {
+				cat, err := svc.gradebookSvc.GetCategory(a.CategoryID)
+				if err != nil {
+					return Rule{}, err
+				}
+				valid = cat.CourseID == crs.ID
+			}
+		}
+		if !valid {
+			return Rule{}, core.NewValidationError(nil, core.FieldError{Field: "target_id", Error: errInvalidValue})
+		}
+	}
+
+	r, err := svc.repo.CreateRule(context.Background(), Rule{
+		CourseID:   crs.ID,
+		Year:       cls.Year,
+		Name:       nr.Name,
+		Event:      nr.Event,
+		TargetID:   nr.TargetID,
+		MinPercent: nr.MinPercent,
+		Points:     nr.Points,
+	})
+	return r, errors.Wrap(err, "creating rule")
+}
+
+func (svc *Service) QueryRules(crs school.Course) ([]Rule, error) {
+	cls, err := svc.courseClass(crs)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := svc.repo.QueryRules(context.Background(), RuleFilter{CourseID: crs.ID, Year: cls.Year})
+	return rs, errors.Wrap(err, "querying rules")
+}
+
+func (svc *Service) GetRule(id string) (Rule, error) {
+	r, err := svc.repo.GetRule(context.Background(), id)
+	return r, errors.Wrap(err, "finding rule by ID")
+}
+
+func (svc *Service) DeleteRule(id string) error {
+	return errors.Wrap(svc.repo.DeleteRule(context.Background(), id), "deleting rule")
+}
+
+func (svc *Service) EvaluateAttempt(att coursework.Attempt) ([]Transaction, error) {
+	if !att.IsSubmitted() || !att.Counted {
+		return nil, nil
+	}
+	cw, err := svc.courseworkSvc.GetByID(att.CourseworkID)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := svc.schoolSvc.GetCourse(cw.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	return svc.evaluate(crs, EventQuiz, cw.ID, []gradebook.Mark{{StudentID: att.StudentID, Score: att.Score}}, att.MaxScore)
+}
+
+func (svc *Service) EvaluateMarks(a gradebook.Assessment, marks []gradebook.Mark) ([]Transaction, error) {
+	if a.Kind() != gradebook.KindOfficial || len(marks) == 0 {
+		return nil, nil
+	}
+	cat, err := svc.gradebookSvc.GetCategory(a.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := svc.schoolSvc.GetCourse(cat.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	return svc.evaluate(crs, EventMark, a.ID, marks, a.MaxScore)
+}
+
+// evaluate pays the Students whose scores on targetID meet the Rules of a Course from its budget;
+// once the budget is spent, earnings are skipped.
+func (svc *Service) evaluate(crs school.Course, event, targetID string, marks []gradebook.Mark, maxScore float64) ([]Transaction, error) {
+	ctx := context.Background()
+	cls, err := svc.courseClass(crs)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := svc.repo.QueryRules(ctx, RuleFilter{CourseID: crs.ID, Year: cls.Year, Event: event})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying rules")
+	}
+
+	var earnings []Transaction
+	budget := Account{SchoolID: cls.SchoolID, Kind: AccountCourse, OwnerID: crs.ID, Year: cls.Year}
+	for _, r := range rules {
+		for _, m := range marks {
+			if !r.Matches(event, targetID, m.Score, maxScore) {
+				continue
+			}
+			student := Account{SchoolID: cls.SchoolID, Kind: AccountStudent, OwnerID: m.StudentID, Year: cls.Year}
+			t := transfer(TxEarning, budget, student, r.Points)
+			t.CourseID, t.RuleID, t.Memo = crs.ID, r.ID, r.Name
+			t.Reference = r.reference(targetID, m.StudentID)
+
+			t, created, err := svc.post(ctx, t, budget, student)
+			if err == ErrInsufficientBudget {
+				svc.logger.Info(fmt.Sprintf("budget of course %s spent: rule %s skipped", crs.ID, r.ID))
+				return earnings, nil
+			}
+			if err != nil {
+				return earnings, err
+			}
+			if created {
+				earnings = append(earnings, t)
+			}
+		}
+	}
+	return earnings, nil
+}
+
+func (svc *Service) Wallet(studentID string, year int) (Wallet, error) {
+	ctx := context.Background()
+	w := Wallet{Account: Account{Kind: AccountStudent, OwnerID: studentID, Year: year}, Transactions: []Transaction{}}
+
+	accounts, err := svc.repo.QueryAccounts(ctx, AccountFilter{Kind: AccountStudent, OwnerIDs: []string{studentID}, Year: year})
+	if err != nil {
+		return Wallet{}, errors.Wrap(err, "querying accounts")
+	}
+	if len(accounts) == 0 {
+		return w, nil
+	}
+	w.Account = accounts[0]
+	if w.Transactions, err = svc.repo.QueryTransactions(ctx, TransactionFilter{AccountID: w.Account.ID}); err != nil {
+		return Wallet{}, errors.Wrap(err, "querying transactions")
+	}
+	return w, nil
+}
+
+// students returns the Classes matching f, and their Students with the Class they belong to.
+func (svc *Service) students(f LeaderboardFilter) ([]school.Class, map[string]string, error) {
+	var classes []school.Class
+	if f.ClassID != "" {
+		cls, err := svc.schoolSvc.GetClass(f.ClassID)
+		if err != nil {
+			return nil, nil, err
+		}
+		classes = []school.Class{cls}
+	} else {
+		all, err := svc.schoolSvc.QueryClasses(school.ClassFilter{SchoolID: f.SchoolID, DepartmentID: f.DepartmentID, Year: f.Year})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, cls := range all {
+			if f.YearLevel == 0 || cls.YearLevel == f.YearLevel {
+				classes = append(classes, cls)
+			}
+		}
+	}
+
+	classByStudent := make(map[string]string)
+	for _, cls := range classes {
+		ids, err := svc.schoolSvc.StudentIDs(cls.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, id := range ids {
+			classByStudent[id] = cls.ID
+		}
+	}
+	return classes, classByStudent, nil
+}
+
+// balances returns the points of Students for a year.
+func (svc *Service) balances(studentIDs []string, year int) (map[string]int, error) {
+	points := make(map[string]int, len(studentIDs))
+	if len(studentIDs) == 0 {
+		return points, nil
+	}
+	accounts, err := svc.repo.QueryAccounts(context.Background(), AccountFilter{Kind: AccountStudent, OwnerIDs: studentIDs, Year: year})
+	if err != nil {
+		return nil, errors.Wrap(err, "querying accounts")
+	}
+	for _, a := range accounts {
+		points[a.OwnerID] = a.Balance
+	}
+	return points, nil
+}
+
+func (svc *Service) Leaderboard(f LeaderboardFilter) ([]Standing, error) {
+	_, classByStudent, err := svc.students(f)
+	if err != nil {
+		return nil, err
+	}
+	studentIDs := make([]string, 0, len(classByStudent))
+	for id := range classByStudent {
+		studentIDs = append(studentIDs, id)
+	}
+	points, err := svc.balances(studentIDs, f.Year)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]Standing, 0, len(studentIDs))
+	for _, id := range studentIDs {
+		standings = append(standings, Standing{StudentID: id, ClassID: classByStudent[id], Points: points[id]})
+	}
+	rankStandings(standings)
+	if f.Limit > 0 && len(standings) > f.Limit {
+		standings = standings[:f.Limit]
+	}
+	return standings, nil
+}
+
+func (svc *Service) ComputeAwards(schoolID string, year int, finalTermID string, top int) ([]Award, error) {
+	term, err := svc.schoolSvc.GetTerm(finalTermID)
+	if err != nil {
+		return nil, err
+	}
+	if term.SchoolID != schoolID {
+		return nil, core.NewValidationError(nil, core.FieldError{Field: "term", Error: errInvalidValue})
+	}
+	if top < 1 {
+		top = DefaultTopStudents
+	}
+
+	classes, classByStudent, err := svc.students(LeaderboardFilter{SchoolID: schoolID, Year: year})
+	if err != nil {
+		return nil, err
+	}
+	studentIDs := make([]string, 0, len(classByStudent))
+	for id := range classByStudent {
+		studentIDs = append(studentIDs, id)
+	}
+	points, err := svc.balances(studentIDs, year)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Candidate
+	for _, cls := range classes {
+		reports, err := svc.gradebookSvc.ClassReports(cls, finalTermID, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, rep := range reports {
+			candidates = append(candidates, Candidate{
+				StudentID:    rep.StudentID,
+				ClassID:      cls.ID,
+				YearLevel:    cls.YearLevel,
+				DepartmentID: cls.DepartmentID,
+				Points:       points[rep.StudentID],
+				Average:      rep.Average,
+			})
+		}
+	}
+
+	ctx := context.Background()
+	tx, err := svc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting transaction")
+	}
+	awards, err := svc.repo.ReplaceAwards(ctx, schoolID, year, ComputeAwards(schoolID, year, candidates, top), tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, errors.Wrap(err, "saving awards")
+	}
+	return awards, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+func (svc *Service) QueryAwards(schoolID string, year int) ([]Award, error) {
+	awards, err := svc.repo.QueryAwards(context.Background(), schoolID, year)
+	return awards, errors.Wrap(err, "querying awards")
+}
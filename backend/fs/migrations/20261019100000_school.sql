@@ -0,0 +1,61 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE school (
+    id              UUID            NOT NULL,
+    name            VARCHAR(254)    NOT NULL,
+    is_active       BOOL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE department (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    name            VARCHAR(254)    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE class (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    department_id   UUID            REFERENCES department (id) ON DELETE SET NULL,
+    name            VARCHAR(100)    NOT NULL,
+    year_level      INT             NOT NULL, -- eg. 1 for "1ère secondaire"
+    year            INT             NOT NULL, -- academic year start, eg. 2026 for 2026-2027
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE class_student (
+    class_id        UUID            NOT NULL REFERENCES class (id) ON DELETE CASCADE,
+    student_id      UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (class_id, student_id)
+);
+
+CREATE TABLE course (
+    id              UUID            NOT NULL,
+    class_id        UUID            NOT NULL REFERENCES class (id) ON DELETE CASCADE,
+    teacher_id      UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    name            VARCHAR(100)    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE course;
+DROP TABLE class_student;
+DROP TABLE class;
+DROP TABLE department;
+DROP TABLE school;
@@ -0,0 +1,54 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE question (
+    id              UUID                NOT NULL,
+    course_id       UUID                NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    kind            VARCHAR(20)         NOT NULL, -- single | multiple
+    prompt          TEXT                NOT NULL,
+    options         JSONB               NOT NULL, -- [{id, text, correct}]
+    points          DOUBLE PRECISION    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE coursework (
+    id              UUID            NOT NULL,
+    course_id       UUID            NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    kind            VARCHAR(20)     NOT NULL, -- tutorial | assignment
+    title           VARCHAR(254)    NOT NULL,
+    instructions    TEXT,
+    question_ids    UUID[],
+    max_attempts    INT             NOT NULL DEFAULT 0, -- 0: unlimited
+    starts_at       TIMESTAMP,
+    due_at          TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE attempt (
+    id              UUID                NOT NULL,
+    coursework_id   UUID                NOT NULL REFERENCES coursework (id) ON DELETE CASCADE,
+    student_id      UUID                NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    seed            BIGINT              NOT NULL, -- randomizes questions & options order; kept for audits
+    questions       JSONB               NOT NULL, -- [question] as of the start; edits of the question bank do not change the paper
+    answers         JSONB,                        -- {question_id: [option_id]}
+    score           DOUBLE PRECISION,
+    max_score       DOUBLE PRECISION,
+    counted         BOOL,                         -- false if submitted after the due date of an assignment
+    started_at      TIMESTAMP,
+    submitted_at    TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX attempt_coursework_student_idx ON attempt (coursework_id, student_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE attempt;
+DROP TABLE coursework;
+DROP TABLE question;
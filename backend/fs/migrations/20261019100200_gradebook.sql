@@ -0,0 +1,61 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE term (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    name            VARCHAR(100)    NOT NULL,
+    starts_on       DATE            NOT NULL,
+    ends_on         DATE            NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+-- last publication of the marks of the class: Students see their scores as of then
+ALTER TABLE class ADD COLUMN marks_published_until TIMESTAMP;
+
+CREATE TABLE mark_category (
+    id              UUID                NOT NULL,
+    course_id       UUID                NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    term_id         UUID                NOT NULL REFERENCES term (id) ON DELETE CASCADE,
+    name            VARCHAR(100)        NOT NULL,
+    weight          DOUBLE PRECISION    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX mark_category_course_term_name_idx ON mark_category (course_id, term_id, name);
+
+CREATE TABLE assessment (
+    id              UUID                NOT NULL,
+    category_id     UUID                NOT NULL REFERENCES mark_category (id) ON DELETE CASCADE,
+    coursework_id   UUID                REFERENCES coursework (id) ON DELETE SET NULL, -- virtual marks
+    title           VARCHAR(254)        NOT NULL,
+    max_score       DOUBLE PRECISION    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE mark (
+    assessment_id   UUID                NOT NULL REFERENCES assessment (id) ON DELETE CASCADE,
+    student_id      UUID                NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    score           DOUBLE PRECISION    NOT NULL,
+    published_score DOUBLE PRECISION,               -- score at the last publication; the one Students see
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (assessment_id, student_id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE mark;
+DROP TABLE assessment;
+DROP TABLE mark_category;
+ALTER TABLE class DROP COLUMN marks_published_until;
+DROP TABLE term;
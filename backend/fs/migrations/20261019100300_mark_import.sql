@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE mark_import (
+    id              UUID            NOT NULL,
+    assessment_id   UUID            NOT NULL REFERENCES assessment (id) ON DELETE CASCADE,
+    author_id       UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    filename        VARCHAR(254)    NOT NULL,
+    status          VARCHAR(20)     NOT NULL, -- pending | applied | undone
+    entries         JSONB           NOT NULL, -- [{line, student, student_id, status, score, previous}]
+    applied_at      TIMESTAMP,
+    undone_at       TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE mark_import;
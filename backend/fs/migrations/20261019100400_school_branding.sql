@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE school ADD COLUMN motto VARCHAR(254);
+ALTER TABLE school ADD COLUMN logo VARCHAR(254); -- media name
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE school DROP COLUMN logo;
+ALTER TABLE school DROP COLUMN motto;
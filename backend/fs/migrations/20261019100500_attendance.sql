@@ -0,0 +1,40 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE attendance_session (
+    id              UUID            NOT NULL,
+    class_id        UUID            NOT NULL REFERENCES class (id) ON DELETE CASCADE,
+    course_id       UUID            REFERENCES course (id) ON DELETE CASCADE, -- NULL for daily class sessions
+    date            DATE            NOT NULL,
+    taken_by_id     UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX attendance_session_class_date_idx ON attendance_session (class_id, date) WHERE course_id IS NULL;
+CREATE UNIQUE INDEX attendance_session_course_date_idx ON attendance_session (course_id, date) WHERE course_id IS NOT NULL;
+
+CREATE TABLE attendance_record (
+    session_id      UUID            NOT NULL REFERENCES attendance_session (id) ON DELETE CASCADE,
+    student_id      UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    status          VARCHAR(10)     NOT NULL,
+    note            VARCHAR(254)    NOT NULL DEFAULT '',
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (session_id, student_id)
+);
+
+CREATE INDEX attendance_record_student_idx ON attendance_record (student_id);
+
+-- absence alerts are emailed to the contact address when a Student reaches the threshold; disabled when 0
+ALTER TABLE school ADD COLUMN absence_threshold INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE school ADD COLUMN attendance_contact VARCHAR(254);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE school DROP COLUMN attendance_contact;
+ALTER TABLE school DROP COLUMN absence_threshold;
+DROP TABLE attendance_record;
+DROP TABLE attendance_session;
@@ -0,0 +1,52 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE announcement (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    department_id   UUID            REFERENCES department (id) ON DELETE CASCADE,
+    class_id        UUID            REFERENCES class (id) ON DELETE CASCADE,
+    author_id       UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    title           VARCHAR(254)    NOT NULL,
+    body            TEXT            NOT NULL,
+    email_digest    BOOLEAN         NOT NULL DEFAULT FALSE,
+    publish_at      TIMESTAMP       NOT NULL,
+    expires_at      TIMESTAMP,
+    published_at    TIMESTAMP,      -- set by the scheduler once fanned out to recipients
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX announcement_due_idx ON announcement (publish_at) WHERE published_at IS NULL;
+
+CREATE TABLE announcement_attachment (
+    id              UUID            NOT NULL,
+    announcement_id UUID            NOT NULL REFERENCES announcement (id) ON DELETE CASCADE,
+    name            VARCHAR(254)    NOT NULL, -- media name
+    filename        VARCHAR(254)    NOT NULL,
+    content_type    VARCHAR(100)    NOT NULL,
+    size            BIGINT          NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE announcement_recipient (
+    announcement_id UUID            NOT NULL REFERENCES announcement (id) ON DELETE CASCADE,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    read_at         TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (announcement_id, user_id)
+);
+
+CREATE INDEX announcement_recipient_user_idx ON announcement_recipient (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE announcement_recipient;
+DROP TABLE announcement_attachment;
+DROP TABLE announcement;
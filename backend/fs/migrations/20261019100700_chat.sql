@@ -0,0 +1,70 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE chat_room (
+    id              UUID            NOT NULL,
+    course_id       UUID            NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    name            VARCHAR(100)    NOT NULL,
+    kind            VARCHAR(10)     NOT NULL, -- general | topic | group
+    created_by_id   UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX chat_room_general_idx ON chat_room (course_id) WHERE kind = 'general';
+
+-- Students of group rooms; general and topic rooms are open to all Course members
+CREATE TABLE chat_room_member (
+    room_id         UUID            NOT NULL REFERENCES chat_room (id) ON DELETE CASCADE,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (room_id, user_id)
+);
+
+CREATE TABLE chat_message (
+    id              UUID            NOT NULL,
+    room_id         UUID            NOT NULL REFERENCES chat_room (id) ON DELETE CASCADE,
+    sender_id       UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    body            TEXT            NOT NULL,
+    deleted_at      TIMESTAMP,
+    deleted_by_id   UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP       NOT NULL,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX chat_message_room_idx ON chat_message (room_id, created_at DESC, id DESC);
+
+-- last time a User read a room; unread messages are the ones sent after
+CREATE TABLE chat_read (
+    room_id         UUID            NOT NULL REFERENCES chat_room (id) ON DELETE CASCADE,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    last_read_at    TIMESTAMP       NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (room_id, user_id)
+);
+
+CREATE TABLE chat_mute (
+    course_id       UUID            NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    until           TIMESTAMP,      -- muted indefinitely when NULL
+    muted_by_id     UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (course_id, user_id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE chat_mute;
+DROP TABLE chat_read;
+DROP TABLE chat_message;
+DROP TABLE chat_room_member;
+DROP TABLE chat_room;
@@ -0,0 +1,49 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE notification (
+    id              UUID            NOT NULL,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    event           VARCHAR(50)     NOT NULL,
+    title           VARCHAR(254)    NOT NULL,
+    body            TEXT            NOT NULL,
+    link            VARCHAR(254)    NOT NULL DEFAULT '', -- frontend path
+    read_at         TIMESTAMP,
+    created_at      TIMESTAMP       NOT NULL,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX notification_user_idx ON notification (user_id, created_at DESC);
+
+-- channels chosen by a User for an event; defaults apply when missing
+CREATE TABLE notification_preference (
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    event           VARCHAR(50)     NOT NULL,
+    in_app          BOOLEAN         NOT NULL,
+    email           BOOLEAN         NOT NULL,
+    push            BOOLEAN         NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (user_id, event)
+);
+
+-- Web Push subscriptions of the browsers of a User
+CREATE TABLE push_subscription (
+    id              UUID            NOT NULL,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    endpoint        TEXT            NOT NULL UNIQUE,
+    p256dh          VARCHAR(254)    NOT NULL,
+    auth            VARCHAR(254)    NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE push_subscription;
+DROP TABLE notification_preference;
+DROP TABLE notification;
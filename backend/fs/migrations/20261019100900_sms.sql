@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE "user" ADD COLUMN phone VARCHAR(20); -- E.164; not unique, eg. siblings using a parent's phone
+CREATE INDEX user_phone_idx ON "user" (phone);
+
+ALTER TABLE school ADD COLUMN sms_sender_id VARCHAR(11); -- alphanumeric sender ID
+ALTER TABLE school ADD COLUMN sms_monthly_quota INTEGER NOT NULL DEFAULT 0; -- SMS disabled when 0
+
+ALTER TABLE announcement ADD COLUMN send_sms BOOL NOT NULL DEFAULT FALSE;
+
+-- number of SMS sent on behalf of a School in a month
+CREATE TABLE sms_usage (
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    month           DATE            NOT NULL, -- first day of the month
+    sent            INTEGER         NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (school_id, month)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE sms_usage;
+ALTER TABLE announcement DROP COLUMN send_sms;
+ALTER TABLE school DROP COLUMN sms_monthly_quota;
+ALTER TABLE school DROP COLUMN sms_sender_id;
+DROP INDEX user_phone_idx;
+ALTER TABLE "user" DROP COLUMN phone;
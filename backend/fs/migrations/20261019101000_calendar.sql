@@ -0,0 +1,36 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- School-wide events, or events of a Course when course_id is set
+CREATE TABLE calendar_event (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    course_id       UUID            REFERENCES course (id) ON DELETE CASCADE,
+    kind            VARCHAR(20)     NOT NULL, -- holiday | exam | event
+    title           VARCHAR(254)    NOT NULL,
+    description     TEXT            NOT NULL DEFAULT '',
+    all_day         BOOLEAN         NOT NULL,
+    starts_at       TIMESTAMP       NOT NULL,
+    ends_at         TIMESTAMP       NOT NULL, -- last day of all-day events
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX calendar_event_school_idx ON calendar_event (school_id, starts_at);
+CREATE INDEX calendar_event_course_idx ON calendar_event (course_id, starts_at);
+
+-- iCalendar feed of a User; only the hash of its token is kept
+CREATE TABLE calendar_feed (
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    token_hash      VARCHAR(64)     NOT NULL UNIQUE, -- hex SHA-256
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (user_id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE calendar_feed;
+DROP TABLE calendar_event;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Schools without a subscription are on trial since their creation
+CREATE TABLE subscription (
+    school_id               UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    plan                    VARCHAR(10)     NOT NULL, -- trial | monthly | yearly
+    status                  VARCHAR(10)     NOT NULL, -- trialing | active | past_due | expired
+    owner_name              VARCHAR(254),
+    owner_email             VARCHAR(254),             -- receives receipts & payment warnings
+    payment_due_date        TIMESTAMP       NOT NULL,
+    payment_extension_date  TIMESTAMP       NOT NULL, -- the School is deactivated once reached
+    warnings_sent           INTEGER         NOT NULL DEFAULT 0,
+    last_warned_at          TIMESTAMP,
+    created_at              TIMESTAMP,
+    updated_at              TIMESTAMP,
+
+    PRIMARY KEY (school_id)
+);
+
+CREATE TABLE payment (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    period          VARCHAR(1)      NOT NULL, -- Y | M
+    amount          INTEGER         NOT NULL, -- in cents
+    currency        VARCHAR(3)      NOT NULL, -- ISO 4217
+    reference       VARCHAR(100),             -- eg. bank transfer or mobile money reference
+    paid_at         TIMESTAMP       NOT NULL,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX payment_school_idx ON payment (school_id, paid_at);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE payment;
+DROP TABLE subscription;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- background jobs run by the sidecar; claimed with SELECT ... FOR UPDATE SKIP LOCKED
+CREATE TABLE job (
+    id              UUID            NOT NULL,
+    name            VARCHAR(100)    NOT NULL,
+    key             VARCHAR(254)    UNIQUE,         -- dedupes the runs of a schedule across replicas
+    payload         JSONB,
+    status          VARCHAR(10)     NOT NULL,       -- pending | running | succeeded | failed | cancelled
+    attempts        INTEGER         NOT NULL DEFAULT 0,
+    max_attempts    INTEGER         NOT NULL,
+    run_at          TIMESTAMP       NOT NULL,       -- next attempt
+    locked_by       VARCHAR(100),                   -- worker running the job
+    locked_at       TIMESTAMP,
+    last_error      TEXT,
+    finished_at     TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX job_pending_idx ON job (run_at) WHERE status = 'pending';
+CREATE INDEX job_name_idx ON job (name, created_at);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE job;
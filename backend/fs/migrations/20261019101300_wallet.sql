@@ -0,0 +1,89 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Virtual Wallet: double-entry ledger of points, per academic year
+CREATE TABLE wallet_account (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    kind            VARCHAR(10)     NOT NULL, -- school (issues the points) | course (budget) | student (wallet)
+    owner_id        UUID            NOT NULL, -- School, Course or Student
+    year            INTEGER         NOT NULL, -- academic year start
+    balance         INTEGER         NOT NULL DEFAULT 0,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (kind, owner_id, year)
+);
+CREATE INDEX wallet_account_school_idx ON wallet_account (school_id, year, kind);
+
+CREATE TABLE wallet_transaction (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    year            INTEGER         NOT NULL,
+    kind            VARCHAR(10)     NOT NULL, -- allocation | earning
+    course_id       UUID            REFERENCES course (id) ON DELETE SET NULL,
+    rule_id         UUID,
+    reference       VARCHAR(254)    UNIQUE,   -- dedupes earnings: a rule rewards a Student once per target
+    memo            VARCHAR(254),
+    author_id       UUID            REFERENCES "user" (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX wallet_transaction_course_idx ON wallet_transaction (course_id, created_at);
+
+-- the amounts of the entries of a transaction sum to zero
+CREATE TABLE wallet_entry (
+    id              UUID            NOT NULL,
+    transaction_id  UUID            NOT NULL REFERENCES wallet_transaction (id) ON DELETE CASCADE,
+    account_id      UUID            NOT NULL REFERENCES wallet_account (id) ON DELETE CASCADE,
+    amount          INTEGER         NOT NULL, -- credit > 0 > debit
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX wallet_entry_transaction_idx ON wallet_entry (transaction_id);
+CREATE INDEX wallet_entry_account_idx ON wallet_entry (account_id, created_at);
+
+-- earning criteria set by Teachers
+CREATE TABLE wallet_rule (
+    id              UUID            NOT NULL,
+    course_id       UUID            NOT NULL REFERENCES course (id) ON DELETE CASCADE,
+    year            INTEGER         NOT NULL,
+    name            VARCHAR(100)    NOT NULL,
+    event           VARCHAR(10)     NOT NULL, -- quiz | mark
+    target_id       UUID,                     -- Coursework or Assessment; all of the Course when NULL
+    min_percent     DOUBLE PRECISION NOT NULL,
+    points          INTEGER         NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX wallet_rule_course_idx ON wallet_rule (course_id, year);
+
+-- year-end rewards of the richest Students among those passing the final
+CREATE TABLE wallet_award (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    year            INTEGER         NOT NULL,
+    scope           VARCHAR(10)     NOT NULL, -- class | year_level | department | school
+    scope_id        VARCHAR(100)    NOT NULL, -- Class, YearLevel, Department or School
+    rank            INTEGER         NOT NULL,
+    student_id      UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    class_id        UUID            NOT NULL REFERENCES class (id) ON DELETE CASCADE,
+    points          INTEGER         NOT NULL,
+    average         DOUBLE PRECISION NOT NULL, -- in the final
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX wallet_award_school_idx ON wallet_award (school_id, year);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE wallet_award;
+DROP TABLE wallet_rule;
+DROP TABLE wallet_entry;
+DROP TABLE wallet_transaction;
+DROP TABLE wallet_account;
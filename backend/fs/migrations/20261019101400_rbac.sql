@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Custom Role of a School: a named set of permissions applying to the resources of the School
+CREATE TABLE rbac_role (
+    id              UUID            NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    name            VARCHAR(100)    NOT NULL,
+    permissions     TEXT[]          NOT NULL DEFAULT '{}',
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (school_id, name)
+);
+
+CREATE TABLE rbac_role_member (
+    role_id         UUID            NOT NULL REFERENCES rbac_role (id) ON DELETE CASCADE,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (role_id, user_id)
+);
+
+CREATE INDEX rbac_role_member_user_idx ON rbac_role_member (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE rbac_role_member;
+DROP TABLE rbac_role;
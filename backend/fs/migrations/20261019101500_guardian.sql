@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Guardian of a Student: parents, relatives or legal guardians following the Student's schooling
+CREATE TABLE guardian_link (
+    guardian_id     UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    student_id      UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    relationship    VARCHAR(20)     NOT NULL,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (guardian_id, student_id)
+);
+
+CREATE INDEX guardian_link_student_idx ON guardian_link (student_id);
+
+ALTER TABLE "user" ADD COLUMN invited_at TIMESTAMP; -- until the invitation is accepted; cleared on deactivation
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE "user" DROP COLUMN invited_at;
+DROP TABLE guardian_link;
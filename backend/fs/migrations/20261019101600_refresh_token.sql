@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Refresh Token: an opaque credential exchanged once for a new access JWT & a new Refresh Token of the same family;
+-- only its hash is stored
+CREATE TABLE refresh_token (
+    id              UUID            NOT NULL,
+    family_id       UUID            NOT NULL,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    token_hash      VARCHAR(64)     NOT NULL,
+    expires_at      TIMESTAMP       NOT NULL,
+    used_at         TIMESTAMP,
+    revoked_at      TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (token_hash)
+);
+
+CREATE INDEX refresh_token_family_idx ON refresh_token (family_id);
+CREATE INDEX refresh_token_user_idx ON refresh_token (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE refresh_token;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- JWT Key: an asymmetric key signing the access JWTs, identified by its id (`kid`);
+-- retired keys still verify tokens during a grace period
+CREATE TABLE jwt_key (
+    id              UUID            NOT NULL,
+    algorithm       VARCHAR(10)     NOT NULL,
+    private_key     TEXT            NOT NULL,
+    retired_at      TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE jwt_key;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE school ADD COLUMN require_admin_2fa BOOLEAN NOT NULL DEFAULT FALSE; -- admins must enable 2FA
+
+-- Two Factor: the TOTP secret of a User; 2FA is enabled once the first code is verified
+CREATE TABLE two_factor (
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    secret          VARCHAR(64)     NOT NULL, -- base32
+    enabled_at      TIMESTAMP,
+    last_step       BIGINT          NOT NULL DEFAULT 0, -- time step of the last code used; codes are single-use
+    failed_attempts INTEGER         NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMP,
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (user_id)
+);
+
+-- Backup Code: single-use codes to log in without the authenticator app; hashed like passwords
+CREATE TABLE backup_code (
+    id              UUID            NOT NULL,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    code_hash       BYTEA           NOT NULL,
+    used_at         TIMESTAMP,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX backup_code_user_idx ON backup_code (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE backup_code;
+DROP TABLE two_factor;
+ALTER TABLE school DROP COLUMN require_admin_2fa;
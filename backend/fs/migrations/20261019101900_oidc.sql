@@ -0,0 +1,48 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- OIDC Login: a pending sign-in with an OpenID Connect provider, identified by its `state`; single-use
+CREATE TABLE oidc_login (
+    state           VARCHAR(64)     NOT NULL,
+    provider        VARCHAR(32)     NOT NULL,
+    code_verifier   VARCHAR(128)    NOT NULL, -- PKCE
+    nonce           VARCHAR(64)     NOT NULL,
+    expires_at      TIMESTAMP       NOT NULL,
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (state)
+);
+
+-- External Identity: the account of a User with an OpenID Connect provider
+CREATE TABLE external_identity (
+    id              UUID            NOT NULL,
+    user_id         UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    provider        VARCHAR(32)     NOT NULL,
+    subject         VARCHAR(255)    NOT NULL, -- `sub` claim; stable, unlike emails
+    email           VARCHAR(254)    NOT NULL DEFAULT '',
+    school_id       UUID            REFERENCES school (id) ON DELETE SET NULL, -- when provisioned by an email domain
+    created_at      TIMESTAMP,
+
+    PRIMARY KEY (id),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX external_identity_user_idx ON external_identity (user_id);
+
+-- School Email Domain: Users signing in with a verified email of the domain are provisioned for the school
+CREATE TABLE school_email_domain (
+    domain          VARCHAR(253)    NOT NULL,
+    school_id       UUID            NOT NULL REFERENCES school (id) ON DELETE CASCADE,
+    role            VARCHAR(50)     NOT NULL, -- of the provisioned Users
+    created_at      TIMESTAMP,
+    updated_at      TIMESTAMP,
+
+    PRIMARY KEY (domain)
+);
+
+CREATE INDEX school_email_domain_school_idx ON school_email_domain (school_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE school_email_domain;
+DROP TABLE external_identity;
+DROP TABLE oidc_login;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE school ADD COLUMN passwordless_login BOOLEAN NOT NULL DEFAULT FALSE; -- members may log in by email link or code
+
+CREATE TABLE passwordless_limit (
+    user_id                 UUID            NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+    requests                INT             NOT NULL DEFAULT 0, -- login links & codes sent in the current window
+    requests_reset_at       TIMESTAMPTZ     NOT NULL,
+    attempts                INT             NOT NULL DEFAULT 0, -- failed logins in the current window
+    attempts_reset_at       TIMESTAMPTZ     NOT NULL,
+    codes_invalid_before    TIMESTAMPTZ,                        -- codes issued before are rejected; set once attempts run out
+
+    PRIMARY KEY (user_id)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE passwordless_limit;
+ALTER TABLE school DROP COLUMN passwordless_login;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE "user" ADD COLUMN avatar TEXT NOT NULL DEFAULT ''; -- media name prefix of the avatar thumbnails
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE "user" DROP COLUMN avatar;
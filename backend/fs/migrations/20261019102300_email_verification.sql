@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE "user" ADD COLUMN email_verified_at TIMESTAMPTZ; -- reset when the email changes
+ALTER TABLE school ADD COLUMN require_verified_email BOOLEAN NOT NULL DEFAULT FALSE; -- before password resets & notifications are emailed
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE school DROP COLUMN require_verified_email;
+ALTER TABLE "user" DROP COLUMN email_verified_at;
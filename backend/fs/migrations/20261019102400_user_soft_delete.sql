@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE "user" ADD COLUMN deleted_at TIMESTAMPTZ; -- soft-deleted; restorable until erased
+ALTER TABLE "user" ADD COLUMN erased_at TIMESTAMPTZ; -- personal data anonymized; academic records are kept
+
+CREATE INDEX user_deleted_idx ON "user" (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX user_deleted_idx;
+ALTER TABLE "user" DROP COLUMN erased_at;
+ALTER TABLE "user" DROP COLUMN deleted_at;
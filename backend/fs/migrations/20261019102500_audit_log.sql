@@ -0,0 +1,59 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Audit Log: the administrative actions on Users, written in the transaction of the change; append-only,
+-- entries are only deleted by purge_audit_log once older than the retention period
+CREATE TABLE audit_log (
+    id              UUID            NOT NULL,
+    actor_id        UUID,                           -- no FK: kept as is; null for the admin CLI & the system
+    action          VARCHAR(50)     NOT NULL,       -- eg. user.update
+    target_id       VARCHAR(100)    NOT NULL,       -- ID of the changed object
+    changes         JSONB,                          -- {"field": {"before": ..., "after": ...}}
+    ip              VARCHAR(45),
+    user_agent      VARCHAR(254),
+    created_at      TIMESTAMP       NOT NULL,
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX audit_log_created_idx ON audit_log (created_at);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor_id, created_at);
+CREATE INDEX audit_log_target_idx ON audit_log (target_id, created_at);
+
+-- purge_audit_log deletes the entries created before the cutoff (UTC), which may not be in the future
+-- +goose StatementBegin
+CREATE FUNCTION purge_audit_log(cutoff TIMESTAMP) RETURNS INTEGER AS $$
+DECLARE
+    n INTEGER;
+BEGIN
+    IF cutoff > (now() AT TIME ZONE 'UTC') THEN
+        RAISE EXCEPTION 'audit log purge cutoff % is in the future', cutoff;
+    END IF;
+    PERFORM set_config('masomo.audit_purge_cutoff', cutoff::TEXT, true);
+    DELETE FROM audit_log WHERE created_at < cutoff;
+    GET DIAGNOSTICS n = ROW_COUNT;
+    PERFORM set_config('masomo.audit_purge_cutoff', '', true);
+    RETURN n;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- entries are never updated, and only deleted by purge_audit_log
+-- +goose StatementBegin
+CREATE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE'
+        AND coalesce(current_setting('masomo.audit_purge_cutoff', true), '') <> ''
+        AND OLD.created_at < current_setting('masomo.audit_purge_cutoff')::TIMESTAMP THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only: % refused', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE PROCEDURE audit_log_append_only();
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE audit_log;
+DROP FUNCTION audit_log_append_only();
+DROP FUNCTION purge_audit_log(TIMESTAMP);
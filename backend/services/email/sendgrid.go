@@ -1,10 +1,14 @@
 package emailsvc
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/mail"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sendgrid/sendgrid-go"
 	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
 
@@ -16,22 +20,44 @@ var (
 	endpoint = "/v3/mail/send"
 )
 
-type sendgridService struct {
-	key        string
-	from       *sgmail.Email
-	subjPrefix string
-	logger     core.Logger
-}
+type (
+	sendgridService struct {
+		key        string
+		from       *sgmail.Email
+		subjPrefix string
+		logger     core.Logger
+		queue      core.JobQueue // of the emails to retry; optional
+	}
+
+	// retryPayload is the payload of the core.JobSendEmail Jobs: a rendered email.
+	retryPayload struct {
+		To          []mail.Address
+		Cc          []mail.Address
+		Bcc         []mail.Address
+		Subject     string
+		TextContent string
+		HTMLContent string
+		Attachments []retryAttachment
+	}
+
+	retryAttachment struct {
+		Content     []byte
+		ContentType string
+		Filename    string
+	}
+)
 
 var _ core.EmailService = (*sendgridService)(nil)
 
-func NewSendgridService(conf *core.Config, logger core.Logger) *sendgridService {
+// NewSendgridService returns an EmailService queuing the emails that failed to send to queue, when not nil.
+func NewSendgridService(conf *core.Config, logger core.Logger, queue core.JobQueue) *sendgridService {
 	from := conf.DefaultFromEmail()
 	return &sendgridService{
 		key:        conf.SendgridApiKey,
 		from:       sgmail.NewEmail(from.Name, from.Address),
 		subjPrefix: "[" + conf.AppName + "] ",
 		logger:     logger,
+		queue:      queue,
 	}
 }
 
@@ -43,7 +69,10 @@ func (svc sendgridService) SendMessages(messages ...*core.EmailMessage) {
 				svc.logger.Error(fmt.Sprintf("rendering email: %v", err), err)
 			}
 			if msg.HasRecipients() && (msg.HasContent() || msg.HasAttachments()) {
-				svc.send(*msg)
+				if err := svc.send(*msg); err != nil {
+					svc.logger.Error(fmt.Sprintf("sending email: %v", err), err)
+					svc.queueRetry(*msg)
+				}
 			}
 		}()
 	}
@@ -92,17 +121,66 @@ func (svc sendgridService) getSGAttachment(at core.Attachment) *sgmail.Attachmen
 	}
 }
 
-func (svc sendgridService) send(msg core.EmailMessage) {
+func (svc sendgridService) send(msg core.EmailMessage) error {
 	req := sendgrid.GetRequest(svc.key, endpoint, host)
 	req.Method = http.MethodPost
 	req.Body = sgmail.GetRequestBody(svc.prepare(msg))
 
 	res, err := sendgrid.API(req)
 	if err != nil {
-		svc.logger.Error(fmt.Sprintf("sending email: %v", err), err)
+		return err
 	} else if res.StatusCode >= http.StatusBadRequest {
-		svc.logger.Error(fmt.Sprintf("sending email - status: %d - Body: %s", res.StatusCode, res.Body))
+		return errors.Errorf("status: %d - Body: %s", res.StatusCode, res.Body)
 	}
-	// todo: retries ??
 	// todo webhook to handle failed mails ??
+	return nil
+}
+
+// queueRetry queues a rendered email to be sent again by the sidecar.
+func (svc sendgridService) queueRetry(msg core.EmailMessage) {
+	if svc.queue == nil {
+		return
+	}
+	p := retryPayload{
+		To:          msg.To,
+		Cc:          msg.Cc,
+		Bcc:         msg.Bcc,
+		Subject:     msg.Subject,
+		TextContent: msg.TextContent,
+		HTMLContent: msg.HTMLContent,
+	}
+	for _, a := range msg.Attachments {
+		p.Attachments = append(p.Attachments, retryAttachment{
+			Content:     a.Content.Bytes(),
+			ContentType: a.ContentType,
+			Filename:    a.Filename,
+		})
+	}
+	if err := svc.queue.Enqueue(core.JobSendEmail, p, time.Now()); err != nil {
+		svc.logger.Error(fmt.Sprintf("queuing email retry: %v", err), err)
+	}
+}
+
+// Resend sends an email queued by a failed attempt; it is the handler of the core.JobSendEmail Jobs.
+func (svc sendgridService) Resend(payload json.RawMessage) error {
+	var p retryPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return errors.Wrap(err, "decoding email")
+	}
+	msg := core.EmailMessage{
+		To:          p.To,
+		Cc:          p.Cc,
+		Bcc:         p.Bcc,
+		Subject:     p.Subject,
+		TextContent: p.TextContent,
+		HTMLContent: p.HTMLContent,
+	}
+	for _, a := range p.Attachments {
+		msg.Attachments = append(msg.Attachments, core.Attachment{
+			Content:     bytes.NewBuffer(a.Content),
+			ContentType: a.ContentType,
+			Filename:    a.Filename,
+		})
+	}
+	return errors.Wrap(svc.send(msg), "sending email")
 }
@@ -0,0 +1,85 @@
+package mediasvc
+
+import (
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// fileSystemStorage stores media files under the MediaRoot directory.
+type fileSystemStorage struct {
+	root string
+}
+
+var _ core.MediaStorage = (*fileSystemStorage)(nil)
+
+func NewFileSystemStorage(conf *core.Config) *fileSystemStorage {
+	return &fileSystemStorage{root: conf.MediaRoot}
+}
+
+// path returns the file path of a media name, which cannot escape the root directory.
+func (s fileSystemStorage) path(name string) (string, error) {
+	name = path.Clean("/" + name)
+	if name == "/" || strings.Contains(name, "\x00") {
+		return "", errors.Errorf("invalid media name %q", name)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(name)), nil
+}
+
+func (s fileSystemStorage) Save(name string, r io.Reader) error {
+	fp, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(fp), 0o755); err != nil {
+		return errors.Wrap(err, "creating directory")
+	}
+
+	// write to a temporary file first so readers never see partial files
+	tmp, err := os.CreateTemp(filepath.Dir(fp), ".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file")
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "writing file")
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrap(err, "closing file")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), fp), "renaming file")
+}
+
+func (s fileSystemStorage) Delete(name string) error {
+	fp, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(os.RemoveAll(fp), "deleting file")
+}
+
+func (s fileSystemStorage) Open(name string) (io.ReadCloser, error) {
+	fp, err := s.path(name)
+	if err != nil {
+		return nil, core.ErrMediaNotFound
+	}
+	f, err := os.Open(fp)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, core.ErrMediaNotFound
+		}
+		return nil, errors.Wrap(err, "opening file")
+	}
+	if info, err := f.Stat(); err != nil || info.IsDir() {
+		_ = f.Close()
+		return nil, core.ErrMediaNotFound
+	}
+	return f, nil
+}
@@ -0,0 +1,374 @@
+package pdfsvc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+
+	"github.com/trezcool/masomo/core"
+)
+
+const (
+	fontFamily = "Helvetica"
+	fontSize   = 10.0
+	lineHeight = 5.0
+)
+
+var headingSizes = map[string]float64{"h1": 16, "h2": 13, "h3": 11}
+
+// htmlRenderer renders a basic subset of HTML to A4 PDF documents:
+//   - blocks: h1, h2, h3, p, div, hr, br, table (tr, th, td), img (data URIs only)
+//   - inline: b, strong, i, em
+//   - attributes: `align` on blocks and cells, `width` (percentage) on cells, `height` (mm) on images,
+//     `style="page-break-before: always"` on blocks
+//
+// Documents are rendered with core fonts, so characters outside of cp1252 are not supported.
+type htmlRenderer struct{}
+
+var _ core.PDFRenderer = (*htmlRenderer)(nil)
+
+func NewHTMLRenderer() *htmlRenderer {
+	return &htmlRenderer{}
+}
+
+func (r htmlRenderer) Render(w io.Writer, doc string) error {
+	root, err := html.Parse(strings.NewReader(doc))
+	if err != nil {
+		return errors.Wrap(err, "parsing html")
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+	pdf.SetFont(fontFamily, "", fontSize)
+
+	wr := &writer{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+	if body := findElement(root, "body"); body != nil {
+		wr.blocks(body)
+	}
+	if err = pdf.Error(); err != nil {
+		return errors.Wrap(err, "rendering pdf")
+	}
+	return errors.Wrap(pdf.Output(w), "writing pdf")
+}
+
+type writer struct {
+	pdf    *gofpdf.Fpdf
+	tr     func(string) string
+	images int
+}
+
+// run is a piece of text with its font style.
+type run struct {
+	text  string
+	style string
+}
+
+func (wr *writer) blocks(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.TextNode {
+			if text := collapseSpaces(c.Data); strings.TrimSpace(text) != "" {
+				wr.paragraph([]run{{text: text}}, "L", fontSize)
+			}
+			continue
+		}
+		if c.Type != html.ElementNode {
+			continue
+		}
+		if pageBreakBefore(c) {
+			if _, top, _, _ := wr.pdf.GetMargins(); wr.pdf.GetY() > top {
+				wr.pdf.AddPage()
+			}
+		}
+
+		switch tag := c.Data; tag {
+		case "h1", "h2", "h3":
+			wr.paragraph(wr.runs(c, "B"), attr(c, "align"), headingSizes[tag])
+			wr.pdf.Ln(2)
+		case "p":
+			wr.paragraph(wr.runs(c, ""), attr(c, "align"), fontSize)
+			wr.pdf.Ln(2)
+		case "br":
+			wr.pdf.Ln(lineHeight)
+		case "hr":
+			left, _, right, _ := wr.pdf.GetMargins()
+			pageW, _ := wr.pdf.GetPageSize()
+			y := wr.pdf.GetY() + 1
+			wr.pdf.Line(left, y, pageW-right, y)
+			wr.pdf.Ln(3)
+		case "img":
+			wr.image(c)
+		case "table":
+			wr.table(c)
+			wr.pdf.Ln(3)
+		default: // div, section, etc.
+			wr.blocks(c)
+		}
+	}
+}
+
+// runs returns the styled text of the inline content of a node.
+func (wr *writer) runs(n *html.Node, style string) []run {
+	var runs []run
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.TextNode:
+			runs = append(runs, run{text: collapseSpaces(c.Data), style: style})
+		case c.Type == html.ElementNode && (c.Data == "b" || c.Data == "strong"):
+			runs = append(runs, wr.runs(c, addStyle(style, "B"))...)
+		case c.Type == html.ElementNode && (c.Data == "i" || c.Data == "em"):
+			runs = append(runs, wr.runs(c, addStyle(style, "I"))...)
+		case c.Type == html.ElementNode && c.Data == "br":
+			runs = append(runs, run{text: "\n", style: style})
+		case c.Type == html.ElementNode:
+			runs = append(runs, wr.runs(c, style)...)
+		}
+	}
+	return runs
+}
+
+// paragraph writes styled runs; aligned paragraphs are written in a single style.
+func (wr *writer) paragraph(runs []run, align string, size float64) {
+	if len(runs) == 0 {
+		return
+	}
+	runs[0].text = strings.TrimLeft(runs[0].text, " ")
+	runs[len(runs)-1].text = strings.TrimRight(runs[len(runs)-1].text, " ")
+	lh := lineHeight * size / fontSize
+
+	if align := alignment(align); align != "L" {
+		var text strings.Builder
+		for _, r := range runs {
+			text.WriteString(r.text)
+		}
+		wr.pdf.SetFont(fontFamily, runs[0].style, size)
+		wr.pdf.MultiCell(0, lh, wr.tr(text.String()), "", align, false)
+	} else {
+		for _, r := range runs {
+			wr.pdf.SetFont(fontFamily, r.style, size)
+			wr.pdf.Write(lh, wr.tr(r.text))
+		}
+		wr.pdf.Ln(lh)
+	}
+	wr.pdf.SetFont(fontFamily, "", fontSize)
+}
+
+func (wr *writer) image(n *html.Node) {
+	src := attr(n, "src")
+	if !strings.HasPrefix(src, "data:image/") {
+		return
+	}
+	meta, data := src, ""
+	if i := strings.Index(src, ","); i >= 0 {
+		meta, data = src[:i], src[i+1:]
+	}
+	img, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return
+	}
+	imgType := strings.TrimSuffix(strings.TrimPrefix(meta, "data:image/"), ";base64")
+
+	wr.images++
+	name := fmt.Sprintf("img%d", wr.images)
+	opts := gofpdf.ImageOptions{ImageType: imgType, ReadDpi: true}
+	info := wr.pdf.RegisterImageOptionsReader(name, opts, bytes.NewReader(img))
+	if info == nil || wr.pdf.Err() {
+		wr.pdf.ClearError() // unsupported images are skipped
+		return
+	}
+
+	h, _ := strconv.ParseFloat(attr(n, "height"), 64)
+	if h <= 0 {
+		h = 20
+	}
+	w := info.Width() * h / info.Height()
+	x, _, _, _ := wr.pdf.GetMargins()
+	pageW, _ := wr.pdf.GetPageSize()
+	switch alignment(attr(n, "align")) {
+	case "C":
+		x = (pageW - w) / 2
+	case "R":
+		_, _, right, _ := wr.pdf.GetMargins()
+		x = pageW - right - w
+	}
+	wr.pdf.ImageOptions(name, x, wr.pdf.GetY(), w, h, true, opts, 0, "")
+	wr.pdf.Ln(2)
+}
+
+type cell struct {
+	text   string
+	style  string
+	align  string
+	header bool
+	width  float64 // percentage
+}
+
+func (wr *writer) table(n *html.Node) {
+	var rows [][]cell
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			if c.Data != "tr" {
+				walk(c) // thead, tbody, tfoot
+				continue
+			}
+			var row []cell
+			for td := c.FirstChild; td != nil; td = td.NextSibling {
+				if td.Type != html.ElementNode || (td.Data != "td" && td.Data != "th") {
+					continue
+				}
+				cl := cell{header: td.Data == "th", align: alignment(attr(td, "align"))}
+				if cl.header {
+					cl.style = "B"
+				}
+				var text strings.Builder
+				for _, r := range wr.runs(td, cl.style) {
+					text.WriteString(r.text)
+					if r.style != "" {
+						cl.style = r.style
+					}
+				}
+				cl.text = strings.TrimSpace(text.String())
+				cl.width, _ = strconv.ParseFloat(strings.TrimSuffix(attr(td, "width"), "%"), 64)
+				row = append(row, cl)
+			}
+			if len(row) > 0 {
+				rows = append(rows, row)
+			}
+		}
+	}
+	walk(n)
+	if len(rows) == 0 {
+		return
+	}
+
+	// column widths come from the first row; missing ones share the remaining space
+	left, _, right, bottom := wr.pdf.GetMargins()
+	pageW, pageH := wr.pdf.GetPageSize()
+	tableW := pageW - left - right
+	widths := make([]float64, len(rows[0]))
+	remaining, unset := 100.0, 0
+	for i, cl := range rows[0] {
+		widths[i] = cl.width
+		remaining -= cl.width
+		if cl.width <= 0 {
+			unset++
+		}
+	}
+	for i := range widths {
+		if widths[i] <= 0 {
+			widths[i] = remaining / float64(unset)
+		}
+		widths[i] = widths[i] * tableW / 100
+	}
+
+	wr.pdf.SetFillColor(235, 235, 235)
+	for _, row := range rows {
+		// row height fits the longest cell
+		lines := 1
+		for i, cl := range row {
+			if i >= len(widths) {
+				break
+			}
+			wr.pdf.SetFont(fontFamily, cl.style, fontSize)
+			if l := len(wr.pdf.SplitText(wr.tr(cl.text), widths[i]-2)); l > lines {
+				lines = l
+			}
+		}
+		h := float64(lines)*lineHeight + 2
+		if wr.pdf.GetY()+h > pageH-bottom {
+			wr.pdf.AddPage()
+		}
+
+		x, y := left, wr.pdf.GetY()
+		for i, cl := range row {
+			if i >= len(widths) {
+				break
+			}
+			style := "D"
+			if cl.header {
+				style = "FD"
+			}
+			wr.pdf.Rect(x, y, widths[i], h, style)
+			wr.pdf.SetFont(fontFamily, cl.style, fontSize)
+			wr.pdf.SetXY(x+1, y+1)
+			wr.pdf.MultiCell(widths[i]-2, lineHeight, wr.tr(cl.text), "", cl.align, false)
+			x += widths[i]
+		}
+		wr.pdf.SetXY(left, y+h)
+	}
+	wr.pdf.SetFont(fontFamily, "", fontSize)
+}
+
+func findElement(n *html.Node, tag string) *html.Node {
+	if n.Type == html.ElementNode && n.Data == tag {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func pageBreakBefore(n *html.Node) bool {
+	style := strings.ToLower(strings.Join(strings.Fields(attr(n, "style")), ""))
+	return strings.Contains(style, "page-break-before:always")
+}
+
+func alignment(align string) string {
+	switch strings.ToLower(align) {
+	case "center":
+		return "C"
+	case "right":
+		return "R"
+	default:
+		return "L"
+	}
+}
+
+func addStyle(style, s string) string {
+	if strings.Contains(style, s) {
+		return style
+	}
+	return style + s
+}
+
+// collapseSpaces collapses white space like browsers do, keeping the separation from adjacent inline elements.
+func collapseSpaces(s string) string {
+	collapsed := strings.Join(strings.Fields(s), " ")
+	if collapsed == "" {
+		if s != "" {
+			return " "
+		}
+		return ""
+	}
+	if strings.TrimLeft(s, " \t\r\n") != s {
+		collapsed = " " + collapsed
+	}
+	if strings.TrimRight(s, " \t\r\n") != s {
+		collapsed += " "
+	}
+	return collapsed
+}
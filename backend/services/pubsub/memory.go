@@ -0,0 +1,61 @@
+package pubsub
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var errClosed = errors.New("pubsub closed")
+
+// memoryPubSub broadcasts messages within the process only; eg. for tests and single replica deployments.
+type memoryPubSub struct {
+	mu     sync.RWMutex
+	subs   map[string][]chan []byte // {topic: subscriptions}
+	closed bool
+}
+
+var _ core.PubSub = (*memoryPubSub)(nil)
+
+func NewMemoryPubSub() *memoryPubSub {
+	return &memoryPubSub{subs: make(map[string][]chan []byte)}
+}
+
+func (ps *memoryPubSub) Publish(topic string, payload []byte) error {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	if ps.closed {
+		return errClosed
+	}
+	for _, ch := range ps.subs[topic] {
+		ch <- payload
+	}
+	return nil
+}
+
+func (ps *memoryPubSub) Subscribe(topic string) (<-chan []byte, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.closed {
+		return nil, errClosed
+	}
+	ch := make(chan []byte, 64)
+	ps.subs[topic] = append(ps.subs[topic], ch)
+	return ch, nil
+}
+
+func (ps *memoryPubSub) Close() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if !ps.closed {
+		ps.closed = true
+		for _, chans := range ps.subs {
+			for _, ch := range chans {
+				close(ch)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package pubsub
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// postgresPubSub broadcasts messages to all API replicas with Postgres LISTEN/NOTIFY.
+// Payloads must be smaller than 8000 bytes.
+type postgresPubSub struct {
+	db       core.DB
+	listener *pq.Listener
+	logger   core.Logger
+
+	mu     sync.Mutex
+	subs   map[string][]chan []byte // {topic: subscriptions}
+	closed bool
+	done   chan struct{}
+}
+
+var _ core.PubSub = (*postgresPubSub)(nil)
+
+// NewPostgresPubSub returns a PubSub publishing with db, and listening on a dedicated connection to dataSourceName.
+func NewPostgresPubSub(dataSourceName string, db core.DB, logger core.Logger) *postgresPubSub {
+	ps := &postgresPubSub{
+		db:     db,
+		logger: logger,
+		subs:   make(map[string][]chan []byte),
+		done:   make(chan struct{}),
+	}
+	ps.listener = pq.NewListener(dataSourceName, time.Second, time.Minute, ps.logEvent)
+	go ps.dispatch()
+	return ps
+}
+
+func (ps *postgresPubSub) logEvent(ev pq.ListenerEventType, err error) {
+	if err != nil {
+		ps.logger.Error(fmt.Sprintf("pubsub listener event %d: %v", ev, err), err)
+	}
+}
+
+// dispatch forwards notifications to the subscribers of their channel.
+func (ps *postgresPubSub) dispatch() {
+	for {
+		select {
+		case <-ps.done:
+			ps.mu.Lock()
+			for _, chans := range ps.subs {
+				for _, ch := range chans {
+					close(ch)
+				}
+			}
+			ps.mu.Unlock()
+			return
+		case n := <-ps.listener.Notify:
+			if n == nil { // reconnected: notifications may have been lost
+				continue
+			}
+			ps.mu.Lock()
+			chans := ps.subs[n.Channel]
+			ps.mu.Unlock()
+			for _, ch := range chans {
+				ch <- []byte(n.Extra)
+			}
+		}
+	}
+}
+
+func (ps *postgresPubSub) Publish(topic string, payload []byte) error {
+	_, err := ps.db.Exec("SELECT pg_notify($1, $2)", topic, string(payload))
+	return errors.Wrap(err, "notifying "+topic)
+}
+
+func (ps *postgresPubSub) Subscribe(topic string) (<-chan []byte, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.closed {
+		return nil, errClosed
+	}
+	if _, ok := ps.subs[topic]; !ok {
+		if err := ps.listener.Listen(topic); err != nil {
+			return nil, errors.Wrap(err, "listening to "+topic)
+		}
+	}
+	ch := make(chan []byte, 64)
+	ps.subs[topic] = append(ps.subs[topic], ch)
+	return ch, nil
+}
+
+// Close stops listening; the subscriptions are closed once the pending notification is dispatched.
+func (ps *postgresPubSub) Close() error {
+	ps.mu.Lock()
+	if ps.closed {
+		ps.mu.Unlock()
+		return nil
+	}
+	ps.closed = true
+	close(ps.done)
+	ps.mu.Unlock()
+	return errors.Wrap(ps.listener.Close(), "closing listener")
+}
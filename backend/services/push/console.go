@@ -0,0 +1,49 @@
+package pushsvc
+
+import (
+	"log"
+	"sync"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	SentMessages = make([]Message, 0)
+	mu           sync.Mutex
+)
+
+// Message is a push message sent by the console services.
+type Message struct {
+	Subscription core.PushSubscription
+	Payload      []byte
+}
+
+// consoleService prints push messages instead of sending them; eg. in DEV mode.
+type consoleService struct {
+	disableOutput bool
+}
+
+var _ core.PushService = (*consoleService)(nil)
+
+func NewConsoleService() *consoleService {
+	return &consoleService{}
+}
+
+// NewConsoleServiceMock returns a console service recording the push messages silently; for tests.
+func NewConsoleServiceMock() *consoleService {
+	return &consoleService{disableOutput: true}
+}
+
+func (svc consoleService) PublicKey() string {
+	return ""
+}
+
+func (svc consoleService) Send(sub core.PushSubscription, payload []byte) error {
+	if !svc.disableOutput {
+		log.Printf("Push to %s: %s\n", sub.Endpoint, payload)
+	}
+	mu.Lock()
+	SentMessages = append(SentMessages, Message{Subscription: sub, Payload: payload})
+	mu.Unlock()
+	return nil
+}
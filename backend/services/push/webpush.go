@@ -0,0 +1,63 @@
+package pushsvc
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SherClockHolmes/webpush-go"
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// pushTTL is how long push services keep undelivered messages; eg. while the device is offline.
+const pushTTL = 24 * time.Hour
+
+// webPushService sends Web Push messages signed with the VAPID keys of the app.
+type webPushService struct {
+	subscriber string
+	publicKey  string
+	privateKey string
+	client     *http.Client
+}
+
+var _ core.PushService = (*webPushService)(nil)
+
+func NewWebPushService(conf *core.Config) *webPushService {
+	return &webPushService{
+		subscriber: conf.DefaultFromEmail().Address,
+		publicKey:  conf.VAPIDPublicKey,
+		privateKey: conf.VAPIDPrivateKey,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (svc webPushService) PublicKey() string {
+	return svc.publicKey
+}
+
+func (svc webPushService) Send(sub core.PushSubscription, payload []byte) error {
+	resp, err := webpush.SendNotification(payload, &webpush.Subscription{
+		Endpoint: sub.Endpoint,
+		Keys:     webpush.Keys{Auth: sub.Auth, P256dh: sub.P256dh},
+	}, &webpush.Options{
+		HTTPClient:      svc.client,
+		Subscriber:      svc.subscriber,
+		TTL:             int(pushTTL.Seconds()),
+		VAPIDPublicKey:  svc.publicKey,
+		VAPIDPrivateKey: svc.privateKey,
+	})
+	if err != nil {
+		return errors.Wrap(err, "sending push message")
+	}
+	_ = resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return core.ErrPushSubscriptionGone
+	case resp.StatusCode >= 400:
+		return fmt.Errorf("push service responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package smssvc
+
+import (
+	"log"
+	"sync"
+
+	"github.com/trezcool/masomo/core"
+)
+
+var (
+	SentMessages = make([]core.SMSMessage, 0)
+	mu           sync.Mutex
+)
+
+// consoleService prints text messages instead of sending them; eg. in DEV mode.
+type consoleService struct {
+	defaultSender string
+	disableOutput bool
+}
+
+var _ core.SMSService = (*consoleService)(nil)
+
+func NewConsoleService(conf *core.Config) *consoleService {
+	return &consoleService{defaultSender: conf.SMSSenderID}
+}
+
+// NewConsoleServiceMock returns a console service recording the text messages silently; for tests.
+func NewConsoleServiceMock(conf *core.Config) *consoleService {
+	return &consoleService{defaultSender: conf.SMSSenderID, disableOutput: true}
+}
+
+func (svc consoleService) Send(msg core.SMSMessage) error {
+	if msg.From == "" {
+		msg.From = svc.defaultSender
+	}
+	if !svc.disableOutput {
+		log.Printf("SMS from %s to %s: %s\n", msg.From, msg.To, msg.Body)
+	}
+	mu.Lock()
+	SentMessages = append(SentMessages, msg)
+	mu.Unlock()
+	return nil
+}
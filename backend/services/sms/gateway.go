@@ -0,0 +1,58 @@
+package smssvc
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+)
+
+// gatewayService sends text messages through an HTTP gateway speaking the Twilio Messages API;
+// eg. Twilio itself, or a local stand-in implementing the same endpoint.
+type gatewayService struct {
+	endpoint      string
+	accountSID    string
+	authToken     string
+	defaultSender string
+	client        *http.Client
+}
+
+var _ core.SMSService = (*gatewayService)(nil)
+
+func NewGatewayService(conf *core.Config) *gatewayService {
+	return &gatewayService{
+		endpoint:      strings.TrimRight(conf.SMSGatewayURL, "/") + "/2010-04-01/Accounts/" + conf.SMSAccountSID + "/Messages.json",
+		accountSID:    conf.SMSAccountSID,
+		authToken:     conf.SMSAuthToken,
+		defaultSender: conf.SMSSenderID,
+		client:        &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (svc gatewayService) Send(msg core.SMSMessage) error {
+	if msg.From == "" {
+		msg.From = svc.defaultSender
+	}
+	form := url.Values{"To": {msg.To}, "From": {msg.From}, "Body": {msg.Body}}
+	req, err := http.NewRequest(http.MethodPost, svc.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(svc.accountSID, svc.authToken)
+
+	resp, err := svc.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending text message")
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("sms gateway responded %s", resp.Status)
+	}
+	return nil
+}
@@ -15,6 +15,10 @@ import (
 )
 
 func open(dbName string, admin bool, conf *core.Config) (*sql.DB, error) {
+	return sql.Open(conf.Database.Engine, dataSourceName(dbName, admin, conf))
+}
+
+func dataSourceName(dbName string, admin bool, conf *core.Config) string {
 	user := url.UserPassword(conf.Database.User, conf.Database.Password)
 	if admin && conf.Database.AdminUser != "" {
 		user = url.UserPassword(conf.Database.AdminUser, conf.Database.AdminPassword)
@@ -35,13 +39,18 @@ func open(dbName string, admin bool, conf *core.Config) (*sql.DB, error) {
 		Path:     dbName,
 		RawQuery: q.Encode(),
 	}
-	return sql.Open(conf.Database.Engine, u.String())
+	return u.String()
 }
 
 func Open(conf *core.Config) (*sql.DB, error) {
 	return open(conf.Database.Name, false, conf)
 }
 
+// DataSourceName returns the connection string of the app database; eg. for listening to notifications.
+func DataSourceName(conf *core.Config) string {
+	return dataSourceName(conf.Database.Name, false, conf)
+}
+
 // ping waits for the database to be ready. Waits 100ms longer between each attempt.
 func ping(db *sql.DB) error {
 	var err error
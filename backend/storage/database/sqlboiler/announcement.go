@@ -0,0 +1,330 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/announcement"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type AnnouncementRepository struct {
+	db core.DB
+}
+
+var _ announcement.Repository = (*AnnouncementRepository)(nil) // interface compliance check
+
+func NewAnnouncementRepository(db core.DB) *AnnouncementRepository {
+	return &AnnouncementRepository{db: db}
+}
+
+func (repo AnnouncementRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo AnnouncementRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ------------------------------------- Announcement -------------------------------------
+
+func (repo AnnouncementRepository) boilAnnouncement(a announcement.Announcement) *models.Announcement {
+	return &models.Announcement{
+		ID:           a.ID,
+		SchoolID:     a.SchoolID,
+		DepartmentID: null.NewString(a.DepartmentID, a.DepartmentID != ""),
+		ClassID:      null.NewString(a.ClassID, a.ClassID != ""),
+		AuthorID:     null.NewString(a.AuthorID, a.AuthorID != ""),
+		Title:        a.Title,
+		Body:         a.Body,
+		EmailDigest:  a.EmailDigest,
+		SendSMS:      a.SendSMS,
+		PublishAt:    a.PublishAt.UTC(),
+		ExpiresAt:    null.NewTime(a.ExpiresAt.UTC(), !a.ExpiresAt.IsZero()),
+		PublishedAt:  null.NewTime(a.PublishedAt.UTC(), !a.PublishedAt.IsZero()),
+		CreatedAt:    null.NewTime(a.CreatedAt.UTC(), !a.CreatedAt.IsZero()),
+		UpdatedAt:    null.NewTime(a.UpdatedAt.UTC(), !a.UpdatedAt.IsZero()),
+	}
+}
+
+func (repo AnnouncementRepository) unboilAnnouncement(a *models.Announcement) announcement.Announcement {
+	if a == nil {
+		return announcement.Announcement{}
+	}
+	ann := announcement.Announcement{
+		ID:           a.ID,
+		SchoolID:     a.SchoolID,
+		DepartmentID: a.DepartmentID.String,
+		ClassID:      a.ClassID.String,
+		AuthorID:     a.AuthorID.String,
+		Title:        a.Title,
+		Body:         a.Body,
+		EmailDigest:  a.EmailDigest,
+		SendSMS:      a.SendSMS,
+		PublishAt:    a.PublishAt,
+		ExpiresAt:    a.ExpiresAt.Time,
+		PublishedAt:  a.PublishedAt.Time,
+		Attachments:  []announcement.Attachment{},
+		CreatedAt:    a.CreatedAt.Time,
+		UpdatedAt:    a.UpdatedAt.Time,
+	}
+	if a.R != nil {
+		for _, att := range a.R.AnnouncementAttachments {
+			ann.Attachments = append(ann.Attachments, repo.unboilAttachment(att))
+		}
+	}
+	return ann
+}
+
+func (repo AnnouncementRepository) CreateAnnouncement(ctx context.Context, a announcement.Announcement, exec ...core.DBExecutor) (announcement.Announcement, error) {
+	a.ID = uuid.New().String()
+	m := repo.boilAnnouncement(a)
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return announcement.Announcement{}, errors.Wrap(err, "inserting announcement")
+	}
+	return repo.unboilAnnouncement(m), nil
+}
+
+func (repo AnnouncementRepository) QueryAnnouncements(
+	ctx context.Context,
+	filter announcement.Filter,
+	exec ...core.DBExecutor,
+) ([]announcement.Announcement, error) {
+	mods := []qm.QueryMod{
+		qm.Load(models.AnnouncementRels.AnnouncementAttachments, qm.OrderBy(models.AnnouncementAttachmentColumns.CreatedAt)),
+		qm.OrderBy(models.AnnouncementColumns.PublishAt + " DESC"),
+	}
+
+	if filter.SchoolID != "" {
+		mods = append(mods, models.AnnouncementWhere.SchoolID.EQ(filter.SchoolID))
+	}
+	if !filter.Due.IsZero() {
+		mods = append(mods,
+			models.AnnouncementWhere.PublishedAt.IsNull(),
+			models.AnnouncementWhere.PublishAt.LTE(filter.Due),
+			qm.Expr(
+				models.AnnouncementWhere.ExpiresAt.IsNull(),
+				qm.Or2(models.AnnouncementWhere.ExpiresAt.GT(null.TimeFrom(filter.Due))),
+			),
+		)
+	}
+
+	ms, err := models.Announcements(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying announcements")
+	}
+	as := make([]announcement.Announcement, 0, len(ms))
+	for _, m := range ms {
+		as = append(as, repo.unboilAnnouncement(m))
+	}
+	return as, nil
+}
+
+func (repo AnnouncementRepository) GetAnnouncement(ctx context.Context, id string, exec ...core.DBExecutor) (announcement.Announcement, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return announcement.Announcement{}, announcement.ErrAnnouncementNotFound
+	}
+	m, err := models.Announcements(
+		models.AnnouncementWhere.ID.EQ(id),
+		qm.Load(models.AnnouncementRels.AnnouncementAttachments, qm.OrderBy(models.AnnouncementAttachmentColumns.CreatedAt)),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return announcement.Announcement{}, repo.trapNoRowsErr(err, announcement.ErrAnnouncementNotFound, "finding announcement by ID")
+	}
+	return repo.unboilAnnouncement(m), nil
+}
+
+func (repo AnnouncementRepository) UpdateAnnouncement(ctx context.Context, a announcement.Announcement, exec ...core.DBExecutor) (announcement.Announcement, error) {
+	m := repo.boilAnnouncement(a)
+	if _, err := m.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return announcement.Announcement{}, errors.Wrap(err, "updating announcement")
+	}
+	updated := repo.unboilAnnouncement(m)
+	updated.Attachments = a.Attachments
+	return updated, nil
+}
+
+func (repo AnnouncementRepository) DeleteAnnouncement(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil
+	}
+	_, err := models.Announcements(models.AnnouncementWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting announcement")
+}
+
+// -------------------------------------- Attachment --------------------------------------
+
+func (repo AnnouncementRepository) unboilAttachment(att *models.AnnouncementAttachment) announcement.Attachment {
+	if att == nil {
+		return announcement.Attachment{}
+	}
+	return announcement.Attachment{
+		ID:             att.ID,
+		AnnouncementID: att.AnnouncementID,
+		Name:           att.Name,
+		Filename:       att.Filename,
+		ContentType:    att.ContentType,
+		Size:           att.Size,
+		CreatedAt:      att.CreatedAt.Time,
+		UpdatedAt:      att.UpdatedAt.Time,
+	}
+}
+
+func (repo AnnouncementRepository) CreateAttachment(ctx context.Context, att announcement.Attachment, exec ...core.DBExecutor) (announcement.Attachment, error) {
+	if att.ID == "" {
+		att.ID = uuid.New().String()
+	}
+	m := &models.AnnouncementAttachment{
+		ID:             att.ID,
+		AnnouncementID: att.AnnouncementID,
+		Name:           att.Name,
+		Filename:       att.Filename,
+		ContentType:    att.ContentType,
+		Size:           att.Size,
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return announcement.Attachment{}, errors.Wrap(err, "inserting attachment")
+	}
+	return repo.unboilAttachment(m), nil
+}
+
+// --------------------------------------- Delivery ---------------------------------------
+
+func (repo AnnouncementRepository) AddRecipients(ctx context.Context, announcementID string, userIDs []string, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	conflictCols := []string{models.AnnouncementRecipientColumns.AnnouncementID, models.AnnouncementRecipientColumns.UserID}
+	for _, id := range userIDs {
+		r := &models.AnnouncementRecipient{AnnouncementID: announcementID, UserID: id}
+		if err := r.Upsert(ctx, exe, false, conflictCols, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrap(err, "inserting recipient")
+		}
+	}
+	return nil
+}
+
+// deliveryMods returns the query mods selecting the published Announcements received by a User.
+func (repo AnnouncementRepository) deliveryMods(filter announcement.DeliveryFilter) []qm.QueryMod {
+	annCol := func(col string) string {
+		return fmt.Sprintf("%s.%s", models.TableNames.Announcement, col)
+	}
+	mods := []qm.QueryMod{
+		qm.InnerJoin(fmt.Sprintf("%s ON %s = %s.%s",
+			models.TableNames.Announcement,
+			annCol(models.AnnouncementColumns.ID),
+			models.TableNames.AnnouncementRecipient, models.AnnouncementRecipientColumns.AnnouncementID)),
+		models.AnnouncementRecipientWhere.UserID.EQ(filter.UserID),
+		models.AnnouncementWhere.PublishedAt.IsNotNull(),
+	}
+	if !filter.At.IsZero() {
+		mods = append(mods, qm.Expr(
+			models.AnnouncementWhere.ExpiresAt.IsNull(),
+			qm.Or2(models.AnnouncementWhere.ExpiresAt.GT(null.TimeFrom(filter.At))),
+		))
+	}
+	if filter.UnreadOnly {
+		mods = append(mods, models.AnnouncementRecipientWhere.ReadAt.IsNull())
+	}
+	return mods
+}
+
+func (repo AnnouncementRepository) unboilDelivery(r *models.AnnouncementRecipient) announcement.Delivery {
+	d := announcement.Delivery{ReadAt: r.ReadAt.Time}
+	if r.R != nil {
+		d.Announcement = repo.unboilAnnouncement(r.R.Announcement)
+	}
+	return d
+}
+
+func (repo AnnouncementRepository) QueryDeliveries(
+	ctx context.Context,
+	filter announcement.DeliveryFilter,
+	exec ...core.DBExecutor,
+) ([]announcement.Delivery, error) {
+	if _, err := uuid.Parse(filter.UserID); err != nil {
+		return []announcement.Delivery{}, nil
+	}
+	mods := append(repo.deliveryMods(filter),
+		qm.Select(models.TableNames.AnnouncementRecipient+".*"),
+		qm.Load(models.AnnouncementRecipientRels.Announcement+"."+models.AnnouncementRels.AnnouncementAttachments),
+		qm.OrderBy(fmt.Sprintf("%s.%s DESC", models.TableNames.Announcement, models.AnnouncementColumns.PublishedAt)),
+	)
+
+	rs, err := models.AnnouncementRecipients(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying deliveries")
+	}
+	ds := make([]announcement.Delivery, 0, len(rs))
+	for _, r := range rs {
+		ds = append(ds, repo.unboilDelivery(r))
+	}
+	return ds, nil
+}
+
+func (repo AnnouncementRepository) GetDelivery(
+	ctx context.Context,
+	announcementID, userID string,
+	exec ...core.DBExecutor,
+) (announcement.Delivery, error) {
+	if _, err := uuid.Parse(announcementID); err != nil {
+		return announcement.Delivery{}, announcement.ErrAnnouncementNotFound
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return announcement.Delivery{}, announcement.ErrAnnouncementNotFound
+	}
+	mods := append(repo.deliveryMods(announcement.DeliveryFilter{UserID: userID}),
+		qm.Select(models.TableNames.AnnouncementRecipient+".*"),
+		models.AnnouncementRecipientWhere.AnnouncementID.EQ(announcementID),
+		qm.Load(models.AnnouncementRecipientRels.Announcement+"."+models.AnnouncementRels.AnnouncementAttachments),
+	)
+
+	r, err := models.AnnouncementRecipients(mods...).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return announcement.Delivery{}, repo.trapNoRowsErr(err, announcement.ErrAnnouncementNotFound, "finding delivery")
+	}
+	return repo.unboilDelivery(r), nil
+}
+
+func (repo AnnouncementRepository) CountDeliveries(ctx context.Context, filter announcement.DeliveryFilter, exec ...core.DBExecutor) (int, error) {
+	if _, err := uuid.Parse(filter.UserID); err != nil {
+		return 0, nil
+	}
+	n, err := models.AnnouncementRecipients(repo.deliveryMods(filter)...).Count(ctx, repo.getExec(exec))
+	return int(n), errors.Wrap(err, "counting deliveries")
+}
+
+func (repo AnnouncementRepository) MarkRead(
+	ctx context.Context,
+	userID string,
+	at time.Time,
+	announcementIDs []string,
+	exec ...core.DBExecutor,
+) error {
+	mods := []qm.QueryMod{
+		models.AnnouncementRecipientWhere.UserID.EQ(userID),
+		models.AnnouncementRecipientWhere.ReadAt.IsNull(),
+	}
+	if len(announcementIDs) > 0 {
+		mods = append(mods, models.AnnouncementRecipientWhere.AnnouncementID.IN(announcementIDs))
+	}
+	_, err := models.AnnouncementRecipients(mods...).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.AnnouncementRecipientColumns.ReadAt:    null.TimeFrom(at.UTC()),
+		models.AnnouncementRecipientColumns.UpdatedAt: null.TimeFrom(time.Now().UTC()),
+	})
+	return errors.Wrap(err, "updating recipients")
+}
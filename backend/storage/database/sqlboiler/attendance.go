@@ -0,0 +1,204 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/attendance"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type AttendanceRepository struct {
+	db core.DB
+}
+
+var _ attendance.Repository = (*AttendanceRepository)(nil) // interface compliance check
+
+func NewAttendanceRepository(db core.DB) *AttendanceRepository {
+	return &AttendanceRepository{db: db}
+}
+
+func (repo AttendanceRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo AttendanceRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ---------------------------------------- Session ----------------------------------------
+
+func (repo AttendanceRepository) boilSession(sess attendance.Session) *models.AttendanceSession {
+	return &models.AttendanceSession{
+		ID:        sess.ID,
+		ClassID:   sess.ClassID,
+		CourseID:  null.NewString(sess.CourseID, sess.CourseID != ""),
+		Date:      sess.Date,
+		TakenByID: null.NewString(sess.TakenByID, sess.TakenByID != ""),
+		CreatedAt: null.NewTime(sess.CreatedAt.UTC(), !sess.CreatedAt.IsZero()),
+		UpdatedAt: null.NewTime(sess.UpdatedAt.UTC(), !sess.UpdatedAt.IsZero()),
+	}
+}
+
+func (repo AttendanceRepository) unboilSession(sess *models.AttendanceSession) attendance.Session {
+	if sess == nil {
+		return attendance.Session{}
+	}
+	return attendance.Session{
+		ID:        sess.ID,
+		ClassID:   sess.ClassID,
+		CourseID:  sess.CourseID.String,
+		Date:      sess.Date,
+		TakenByID: sess.TakenByID.String,
+		CreatedAt: sess.CreatedAt.Time,
+		UpdatedAt: sess.UpdatedAt.Time,
+	}
+}
+
+func (repo AttendanceRepository) CreateSession(ctx context.Context, sess attendance.Session, exec ...core.DBExecutor) (attendance.Session, error) {
+	sess.ID = uuid.New().String()
+	s := repo.boilSession(sess)
+	if err := s.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return attendance.Session{}, errors.Wrap(err, "inserting session")
+	}
+	return repo.unboilSession(s), nil
+}
+
+func (repo AttendanceRepository) GetSession(
+	ctx context.Context,
+	classID, courseID string,
+	date time.Time,
+	exec ...core.DBExecutor,
+) (attendance.Session, error) {
+	mods := []qm.QueryMod{
+		models.AttendanceSessionWhere.ClassID.EQ(classID),
+		models.AttendanceSessionWhere.Date.EQ(date),
+	}
+	if courseID != "" {
+		if _, err := uuid.Parse(courseID); err != nil {
+			return attendance.Session{}, attendance.ErrSessionNotFound
+		}
+		mods = append(mods, models.AttendanceSessionWhere.CourseID.EQ(null.StringFrom(courseID)))
+	} else {
+		mods = append(mods, models.AttendanceSessionWhere.CourseID.IsNull())
+	}
+
+	s, err := models.AttendanceSessions(mods...).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return attendance.Session{}, repo.trapNoRowsErr(err, attendance.ErrSessionNotFound, "finding session")
+	}
+	return repo.unboilSession(s), nil
+}
+
+func (repo AttendanceRepository) UpdateSession(ctx context.Context, sess attendance.Session, exec ...core.DBExecutor) (attendance.Session, error) {
+	s := repo.boilSession(sess)
+	if _, err := s.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return attendance.Session{}, errors.Wrap(err, "updating session")
+	}
+	return repo.unboilSession(s), nil
+}
+
+// ---------------------------------------- Record -----------------------------------------
+
+func (repo AttendanceRepository) unboilRecord(rec *models.AttendanceRecord) attendance.Record {
+	if rec == nil {
+		return attendance.Record{}
+	}
+	r := attendance.Record{
+		SessionID: rec.SessionID,
+		StudentID: rec.StudentID,
+		Status:    rec.Status,
+		Note:      rec.Note,
+		CreatedAt: rec.CreatedAt.Time,
+		UpdatedAt: rec.UpdatedAt.Time,
+	}
+	if rec.R != nil && rec.R.Session != nil {
+		r.Date = rec.R.Session.Date
+		r.CourseID = rec.R.Session.CourseID.String
+	}
+	return r
+}
+
+func (repo AttendanceRepository) SaveRecords(ctx context.Context, records []attendance.Record, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	conflictCols := []string{models.AttendanceRecordColumns.SessionID, models.AttendanceRecordColumns.StudentID}
+	updateCols := boil.Whitelist(
+		models.AttendanceRecordColumns.Status,
+		models.AttendanceRecordColumns.Note,
+		models.AttendanceRecordColumns.UpdatedAt,
+	)
+	for _, rec := range records {
+		r := &models.AttendanceRecord{SessionID: rec.SessionID, StudentID: rec.StudentID, Status: rec.Status, Note: rec.Note}
+		if err := r.Upsert(ctx, exe, true, conflictCols, updateCols, boil.Infer()); err != nil {
+			return errors.Wrap(err, "upserting record")
+		}
+	}
+	return nil
+}
+
+func (repo AttendanceRepository) QueryRecords(ctx context.Context, filter attendance.RecordFilter, exec ...core.DBExecutor) ([]attendance.Record, error) {
+	sessionCol := func(col string) string {
+		return fmt.Sprintf("%s.%s", models.TableNames.AttendanceSession, col)
+	}
+	mods := []qm.QueryMod{
+		qm.Select(models.TableNames.AttendanceRecord + ".*"),
+		qm.InnerJoin(fmt.Sprintf("%s ON %s = %s.%s",
+			models.TableNames.AttendanceSession,
+			sessionCol(models.AttendanceSessionColumns.ID),
+			models.TableNames.AttendanceRecord, models.AttendanceRecordColumns.SessionID)),
+		qm.Load(models.AttendanceRecordRels.Session),
+		qm.OrderBy(fmt.Sprintf("%s, %s.%s",
+			sessionCol(models.AttendanceSessionColumns.Date),
+			models.TableNames.AttendanceRecord, models.AttendanceRecordColumns.StudentID)),
+	}
+
+	if filter.SessionID != "" {
+		mods = append(mods, models.AttendanceRecordWhere.SessionID.EQ(filter.SessionID))
+	}
+	if filter.ClassID != "" {
+		mods = append(mods, qm.Where(sessionCol(models.AttendanceSessionColumns.ClassID)+" = ?", filter.ClassID))
+	}
+	if filter.CourseID != "" {
+		mods = append(mods, qm.Where(sessionCol(models.AttendanceSessionColumns.CourseID)+" = ?", filter.CourseID))
+	} else if filter.DailyOnly {
+		mods = append(mods, qm.Where(sessionCol(models.AttendanceSessionColumns.CourseID)+" IS NULL"))
+	}
+	if filter.StudentID != "" {
+		mods = append(mods, models.AttendanceRecordWhere.StudentID.EQ(filter.StudentID))
+	}
+	if filter.Status != "" {
+		mods = append(mods, models.AttendanceRecordWhere.Status.EQ(filter.Status))
+	}
+	if !filter.From.IsZero() {
+		mods = append(mods, qm.Where(sessionCol(models.AttendanceSessionColumns.Date)+" >= ?", filter.From))
+	}
+	if !filter.To.IsZero() {
+		mods = append(mods, qm.Where(sessionCol(models.AttendanceSessionColumns.Date)+" <= ?", filter.To))
+	}
+
+	rs, err := models.AttendanceRecords(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying records")
+	}
+	records := make([]attendance.Record, 0, len(rs))
+	for _, r := range rs {
+		records = append(records, repo.unboilRecord(r))
+	}
+	return records, nil
+}
@@ -0,0 +1,144 @@
+package boiledrepos
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/audit"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+const maxUserAgentLen = 254 // of the column
+
+// AuditRepository is append-only: entries are created, then only deleted once past their retention.
+type AuditRepository struct {
+	db core.DB
+}
+
+var _ audit.Repository = (*AuditRepository)(nil) // interface compliance check
+
+func NewAuditRepository(db core.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (repo AuditRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo AuditRepository) unboilEntry(m *models.AuditLog) (core.AuditEntry, error) {
+	e := core.AuditEntry{
+		ID:        m.ID,
+		ActorID:   m.ActorID.String,
+		Action:    m.Action,
+		TargetID:  m.TargetID,
+		IP:        m.IP.String,
+		UserAgent: m.UserAgent.String,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Changes.Valid {
+		if err := json.Unmarshal(m.Changes.JSON, &e.Changes); err != nil {
+			return core.AuditEntry{}, errors.Wrap(err, "decoding audit changes")
+		}
+	}
+	return e, nil
+}
+
+func (repo AuditRepository) CreateEntry(ctx context.Context, e core.AuditEntry, exec ...core.DBExecutor) (core.AuditEntry, error) {
+	var changes null.JSON
+	if len(e.Changes) > 0 {
+		data, err := json.Marshal(e.Changes)
+		if err != nil {
+			return core.AuditEntry{}, errors.Wrap(err, "encoding audit changes")
+		}
+		changes = null.JSONFrom(data)
+	}
+
+	ua := e.UserAgent
+	if len(ua) > maxUserAgentLen {
+		ua = ua[:maxUserAgentLen]
+	}
+
+	m := &models.AuditLog{
+		ID:        uuid.New().String(),
+		ActorID:   null.NewString(e.ActorID, e.ActorID != ""),
+		Action:    e.Action,
+		TargetID:  e.TargetID,
+		Changes:   changes,
+		IP:        null.NewString(e.IP, e.IP != ""),
+		UserAgent: null.NewString(ua, ua != ""),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return core.AuditEntry{}, errors.Wrap(err, "inserting audit entry")
+	}
+	return repo.unboilEntry(m)
+}
+
+func (repo AuditRepository) QueryEntries(ctx context.Context, f audit.Filter, exec ...core.DBExecutor) ([]core.AuditEntry, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.AuditLogColumns.CreatedAt + " DESC")}
+	if f.UserID != "" {
+		if _, err := uuid.Parse(f.UserID); err != nil {
+			return []core.AuditEntry{}, nil
+		}
+		mods = append(mods, qm.Expr(
+			models.AuditLogWhere.ActorID.EQ(null.StringFrom(f.UserID)),
+			qm.Or2(models.AuditLogWhere.TargetID.EQ(f.UserID)),
+		))
+	}
+	if f.ActorID != "" {
+		if _, err := uuid.Parse(f.ActorID); err != nil {
+			return []core.AuditEntry{}, nil
+		}
+		mods = append(mods, models.AuditLogWhere.ActorID.EQ(null.StringFrom(f.ActorID)))
+	}
+	if f.TargetID != "" {
+		mods = append(mods, models.AuditLogWhere.TargetID.EQ(f.TargetID))
+	}
+	if f.Action != "" {
+		mods = append(mods, models.AuditLogWhere.Action.EQ(f.Action))
+	}
+	if !f.Since.IsZero() {
+		mods = append(mods, models.AuditLogWhere.CreatedAt.GTE(f.Since.UTC()))
+	}
+	if !f.Until.IsZero() {
+		mods = append(mods, models.AuditLogWhere.CreatedAt.LT(f.Until.UTC()))
+	}
+	if f.Limit > 0 {
+		mods = append(mods, qm.Limit(f.Limit))
+	}
+	if f.Offset > 0 {
+		mods = append(mods, qm.Offset(f.Offset))
+	}
+
+	ms, err := models.AuditLogs(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying audit entries")
+	}
+	es := make([]core.AuditEntry, 0, len(ms))
+	for _, m := range ms {
+		e, err := repo.unboilEntry(m)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
+func (repo AuditRepository) DeleteEntriesBefore(ctx context.Context, t time.Time, exec ...core.DBExecutor) (int, error) {
+	// the table refuses any other deletion; see the audit_log migration
+	var n int
+	err := repo.getExec(exec).QueryRowContext(ctx, "SELECT purge_audit_log($1)", t.UTC()).Scan(&n)
+	return n, errors.Wrap(err, "deleting audit entries")
+}
@@ -0,0 +1,183 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/calendar"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type CalendarRepository struct {
+	db core.DB
+}
+
+var _ calendar.Repository = (*CalendarRepository)(nil) // interface compliance check
+
+func NewCalendarRepository(db core.DB) *CalendarRepository {
+	return &CalendarRepository{db: db}
+}
+
+func (repo CalendarRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo CalendarRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ---------------------------------------- Event ----------------------------------------
+
+func (repo CalendarRepository) boilEvent(e calendar.Event) *models.CalendarEvent {
+	return &models.CalendarEvent{
+		ID:          e.ID,
+		SchoolID:    e.SchoolID,
+		CourseID:    null.NewString(e.CourseID, e.CourseID != ""),
+		Kind:        e.Kind,
+		Title:       e.Title,
+		Description: e.Description,
+		AllDay:      e.AllDay,
+		StartsAt:    e.StartsAt.UTC(),
+		EndsAt:      e.EndsAt.UTC(),
+		CreatedAt:   null.NewTime(e.CreatedAt.UTC(), !e.CreatedAt.IsZero()),
+		UpdatedAt:   null.NewTime(e.UpdatedAt.UTC(), !e.UpdatedAt.IsZero()),
+	}
+}
+
+func (repo CalendarRepository) unboilEvent(e *models.CalendarEvent) calendar.Event {
+	if e == nil {
+		return calendar.Event{}
+	}
+	return calendar.Event{
+		ID:          e.ID,
+		SchoolID:    e.SchoolID,
+		CourseID:    e.CourseID.String,
+		Kind:        e.Kind,
+		Title:       e.Title,
+		Description: e.Description,
+		AllDay:      e.AllDay,
+		StartsAt:    e.StartsAt,
+		EndsAt:      e.EndsAt,
+		CreatedAt:   e.CreatedAt.Time,
+		UpdatedAt:   e.UpdatedAt.Time,
+	}
+}
+
+func (repo CalendarRepository) CreateEvent(ctx context.Context, e calendar.Event, exec ...core.DBExecutor) (calendar.Event, error) {
+	e.ID = uuid.New().String()
+	m := repo.boilEvent(e)
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return calendar.Event{}, errors.Wrap(err, "inserting event")
+	}
+	return repo.unboilEvent(m), nil
+}
+
+func (repo CalendarRepository) QueryEvents(ctx context.Context, filter calendar.Filter, exec ...core.DBExecutor) ([]calendar.Event, error) {
+	var scope []qm.QueryMod
+	if len(filter.SchoolIDs) > 0 {
+		scope = append(scope, qm.Or2(qm.Expr(
+			models.CalendarEventWhere.SchoolID.IN(filter.SchoolIDs),
+			models.CalendarEventWhere.CourseID.IsNull(),
+		)))
+	}
+	if len(filter.CourseIDs) > 0 {
+		ids := make([]interface{}, 0, len(filter.CourseIDs))
+		for _, id := range filter.CourseIDs {
+			ids = append(ids, id)
+		}
+		scope = append(scope, qm.Or2(qm.WhereIn(models.CalendarEventColumns.CourseID+" IN ?", ids...)))
+	}
+	if len(scope) == 0 {
+		return []calendar.Event{}, nil
+	}
+
+	mods := []qm.QueryMod{qm.Expr(scope...)}
+	if !filter.To.IsZero() {
+		mods = append(mods, models.CalendarEventWhere.StartsAt.LT(filter.To.UTC()))
+	}
+	if !filter.From.IsZero() {
+		// all-day Events end at the end of their last day
+		mods = append(mods, qm.Where(fmt.Sprintf(
+			"(CASE WHEN %s THEN %s + INTERVAL '1 day' ELSE %s END) >= ?",
+			models.CalendarEventColumns.AllDay, models.CalendarEventColumns.EndsAt, models.CalendarEventColumns.EndsAt,
+		), filter.From.UTC()))
+	}
+	mods = append(mods, qm.OrderBy(models.CalendarEventColumns.StartsAt+", "+models.CalendarEventColumns.ID))
+
+	ms, err := models.CalendarEvents(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying events")
+	}
+	events := make([]calendar.Event, 0, len(ms))
+	for _, m := range ms {
+		events = append(events, repo.unboilEvent(m))
+	}
+	return events, nil
+}
+
+func (repo CalendarRepository) GetEvent(ctx context.Context, id string, exec ...core.DBExecutor) (calendar.Event, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return calendar.Event{}, calendar.ErrEventNotFound
+	}
+	m, err := models.FindCalendarEvent(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return calendar.Event{}, repo.trapNoRowsErr(err, calendar.ErrEventNotFound, "finding event")
+	}
+	return repo.unboilEvent(m), nil
+}
+
+func (repo CalendarRepository) UpdateEvent(ctx context.Context, e calendar.Event, exec ...core.DBExecutor) (calendar.Event, error) {
+	m := repo.boilEvent(e)
+	if _, err := m.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return calendar.Event{}, errors.Wrap(err, "updating event")
+	}
+	return repo.unboilEvent(m), nil
+}
+
+func (repo CalendarRepository) DeleteEvent(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	_, err := models.CalendarEvents(models.CalendarEventWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting event")
+}
+
+// ---------------------------------------- Feed ----------------------------------------
+
+func (repo CalendarRepository) SaveFeed(ctx context.Context, userID, tokenHash string, exec ...core.DBExecutor) error {
+	m := &models.CalendarFeed{UserID: userID, TokenHash: tokenHash}
+	err := m.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.CalendarFeedColumns.UserID},
+		boil.Whitelist(models.CalendarFeedColumns.TokenHash, models.CalendarFeedColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	return errors.Wrap(err, "upserting feed")
+}
+
+func (repo CalendarRepository) GetFeedUserID(ctx context.Context, tokenHash string, exec ...core.DBExecutor) (string, error) {
+	m, err := models.CalendarFeeds(models.CalendarFeedWhere.TokenHash.EQ(tokenHash)).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return "", repo.trapNoRowsErr(err, calendar.ErrFeedNotFound, "finding feed")
+	}
+	return m.UserID, nil
+}
+
+func (repo CalendarRepository) DeleteFeed(ctx context.Context, userID string, exec ...core.DBExecutor) error {
+	_, err := models.CalendarFeeds(models.CalendarFeedWhere.UserID.EQ(userID)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting feed")
+}
@@ -0,0 +1,330 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/chat"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type ChatRepository struct {
+	db core.DB
+}
+
+var _ chat.Repository = (*ChatRepository)(nil) // interface compliance check
+
+func NewChatRepository(db core.DB) *ChatRepository {
+	return &ChatRepository{db: db}
+}
+
+func (repo ChatRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo ChatRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ----------------------------------------- Room -----------------------------------------
+
+func (repo ChatRepository) unboilRoom(r *models.ChatRoom) chat.Room {
+	if r == nil {
+		return chat.Room{}
+	}
+	room := chat.Room{
+		ID:          r.ID,
+		CourseID:    r.CourseID,
+		Name:        r.Name,
+		Kind:        r.Kind,
+		CreatedByID: r.CreatedByID.String,
+		CreatedAt:   r.CreatedAt.Time,
+		UpdatedAt:   r.UpdatedAt.Time,
+	}
+	if r.R != nil {
+		for _, m := range r.R.RoomChatRoomMembers {
+			room.MemberIDs = append(room.MemberIDs, m.UserID)
+		}
+	}
+	return room
+}
+
+func (repo ChatRepository) CreateRoom(ctx context.Context, r chat.Room, exec ...core.DBExecutor) (chat.Room, error) {
+	exe := repo.getExec(exec)
+	m := &models.ChatRoom{
+		ID:          uuid.New().String(),
+		CourseID:    r.CourseID,
+		Name:        r.Name,
+		Kind:        r.Kind,
+		CreatedByID: null.NewString(r.CreatedByID, r.CreatedByID != ""),
+	}
+	if err := m.Insert(ctx, exe, boil.Infer()); err != nil {
+		return chat.Room{}, errors.Wrap(err, "inserting room")
+	}
+	for _, id := range r.MemberIDs {
+		mem := &models.ChatRoomMember{RoomID: m.ID, UserID: id}
+		if err := mem.Insert(ctx, exe, boil.Infer()); err != nil {
+			return chat.Room{}, errors.Wrap(err, "inserting room member")
+		}
+	}
+	room := repo.unboilRoom(m)
+	room.MemberIDs = r.MemberIDs
+	return room, nil
+}
+
+func (repo ChatRepository) CreateGeneralRoom(ctx context.Context, courseID string, exec ...core.DBExecutor) (chat.Room, error) {
+	exe := repo.getExec(exec)
+	now := time.Now().UTC()
+	_, err := exe.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, course_id, name, kind, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (course_id) WHERE kind = '%s' DO NOTHING`,
+		models.TableNames.ChatRoom, chat.KindGeneral,
+	), uuid.New().String(), courseID, "General", chat.KindGeneral, now)
+	if err != nil {
+		return chat.Room{}, errors.Wrap(err, "inserting general room")
+	}
+
+	m, err := models.ChatRooms(
+		models.ChatRoomWhere.CourseID.EQ(courseID),
+		models.ChatRoomWhere.Kind.EQ(chat.KindGeneral),
+	).One(ctx, exe)
+	return repo.unboilRoom(m), errors.Wrap(err, "finding general room")
+}
+
+func (repo ChatRepository) QueryRooms(ctx context.Context, courseIDs []string, exec ...core.DBExecutor) ([]chat.Room, error) {
+	ms, err := models.ChatRooms(
+		models.ChatRoomWhere.CourseID.IN(courseIDs),
+		qm.Load(models.ChatRoomRels.RoomChatRoomMembers),
+		qm.OrderBy(models.ChatRoomColumns.CreatedAt),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying rooms")
+	}
+	rooms := make([]chat.Room, 0, len(ms))
+	for _, m := range ms {
+		rooms = append(rooms, repo.unboilRoom(m))
+	}
+	return rooms, nil
+}
+
+func (repo ChatRepository) GetRoom(ctx context.Context, id string, exec ...core.DBExecutor) (chat.Room, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return chat.Room{}, chat.ErrRoomNotFound
+	}
+	m, err := models.ChatRooms(
+		models.ChatRoomWhere.ID.EQ(id),
+		qm.Load(models.ChatRoomRels.RoomChatRoomMembers),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return chat.Room{}, repo.trapNoRowsErr(err, chat.ErrRoomNotFound, "finding room by ID")
+	}
+	return repo.unboilRoom(m), nil
+}
+
+// ---------------------------------------- Message ----------------------------------------
+
+func (repo ChatRepository) boilMessage(m chat.Message) *models.ChatMessage {
+	return &models.ChatMessage{
+		ID:          m.ID,
+		RoomID:      m.RoomID,
+		SenderID:    null.NewString(m.SenderID, m.SenderID != ""),
+		Body:        m.Body,
+		DeletedAt:   null.NewTime(m.DeletedAt.UTC(), !m.DeletedAt.IsZero()),
+		DeletedByID: null.NewString(m.DeletedByID, m.DeletedByID != ""),
+		CreatedAt:   m.CreatedAt.UTC(),
+		UpdatedAt:   null.NewTime(m.UpdatedAt.UTC(), !m.UpdatedAt.IsZero()),
+	}
+}
+
+func (repo ChatRepository) unboilMessage(m *models.ChatMessage) chat.Message {
+	if m == nil {
+		return chat.Message{}
+	}
+	return chat.Message{
+		ID:          m.ID,
+		RoomID:      m.RoomID,
+		SenderID:    m.SenderID.String,
+		Body:        m.Body,
+		DeletedAt:   m.DeletedAt.Time,
+		DeletedByID: m.DeletedByID.String,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt.Time,
+	}
+}
+
+func (repo ChatRepository) CreateMessage(ctx context.Context, m chat.Message, exec ...core.DBExecutor) (chat.Message, error) {
+	m.ID = uuid.New().String()
+	msg := repo.boilMessage(m)
+	if err := msg.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return chat.Message{}, errors.Wrap(err, "inserting message")
+	}
+	return repo.unboilMessage(msg), nil
+}
+
+func (repo ChatRepository) QueryMessages(ctx context.Context, filter chat.MessageFilter, exec ...core.DBExecutor) ([]chat.Message, error) {
+	mods := []qm.QueryMod{
+		models.ChatMessageWhere.RoomID.EQ(filter.RoomID),
+		qm.OrderBy(models.ChatMessageColumns.CreatedAt + " DESC, " + models.ChatMessageColumns.ID + " DESC"),
+	}
+	if filter.BeforeID != "" {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("(%s, %s) < (?, ?)", models.ChatMessageColumns.CreatedAt, models.ChatMessageColumns.ID),
+			filter.BeforeTime.UTC(), filter.BeforeID,
+		))
+	}
+	if filter.Limit > 0 {
+		mods = append(mods, qm.Limit(filter.Limit))
+	}
+
+	ms, err := models.ChatMessages(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying messages")
+	}
+	msgs := make([]chat.Message, 0, len(ms))
+	for _, m := range ms {
+		msgs = append(msgs, repo.unboilMessage(m))
+	}
+	return msgs, nil
+}
+
+func (repo ChatRepository) GetMessage(ctx context.Context, id string, exec ...core.DBExecutor) (chat.Message, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return chat.Message{}, chat.ErrMessageNotFound
+	}
+	m, err := models.FindChatMessage(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return chat.Message{}, repo.trapNoRowsErr(err, chat.ErrMessageNotFound, "finding message by ID")
+	}
+	return repo.unboilMessage(m), nil
+}
+
+func (repo ChatRepository) UpdateMessage(ctx context.Context, m chat.Message, exec ...core.DBExecutor) (chat.Message, error) {
+	msg := repo.boilMessage(m)
+	if _, err := msg.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return chat.Message{}, errors.Wrap(err, "updating message")
+	}
+	return repo.unboilMessage(msg), nil
+}
+
+// ----------------------------------------- Read -----------------------------------------
+
+func (repo ChatRepository) SetLastRead(ctx context.Context, roomID, userID string, at time.Time, exec ...core.DBExecutor) error {
+	r := &models.ChatRead{RoomID: roomID, UserID: userID, LastReadAt: at.UTC()}
+	err := r.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.ChatReadColumns.RoomID, models.ChatReadColumns.UserID},
+		boil.Whitelist(models.ChatReadColumns.LastReadAt, models.ChatReadColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	return errors.Wrap(err, "upserting read")
+}
+
+func (repo ChatRepository) CountUnread(ctx context.Context, userID string, roomIDs []string, exec ...core.DBExecutor) (map[string]int, error) {
+	counts := make(map[string]int, len(roomIDs))
+	if len(roomIDs) == 0 {
+		return counts, nil
+	}
+	rows, err := repo.getExec(exec).QueryContext(ctx, `
+		SELECT m.room_id, COUNT(*)
+		FROM chat_message m
+		LEFT JOIN chat_read r ON r.room_id = m.room_id AND r.user_id = $1
+		WHERE m.room_id = ANY($2)
+			AND m.deleted_at IS NULL
+			AND m.sender_id IS DISTINCT FROM $1
+			AND (r.last_read_at IS NULL OR m.created_at > r.last_read_at)
+		GROUP BY m.room_id`,
+		userID, pq.Array(roomIDs),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "counting unread messages")
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var roomID string
+		var n int
+		if err = rows.Scan(&roomID, &n); err != nil {
+			return nil, errors.Wrap(err, "scanning unread count")
+		}
+		counts[roomID] = n
+	}
+	return counts, errors.Wrap(rows.Err(), "iterating unread counts")
+}
+
+// ----------------------------------------- Mute -----------------------------------------
+
+func (repo ChatRepository) unboilMute(m *models.ChatMute) chat.Mute {
+	if m == nil {
+		return chat.Mute{}
+	}
+	return chat.Mute{
+		CourseID:  m.CourseID,
+		UserID:    m.UserID,
+		Until:     m.Until.Time,
+		MutedByID: m.MutedByID.String,
+		CreatedAt: m.CreatedAt.Time,
+		UpdatedAt: m.UpdatedAt.Time,
+	}
+}
+
+func (repo ChatRepository) SaveMute(ctx context.Context, m chat.Mute, exec ...core.DBExecutor) (chat.Mute, error) {
+	mute := &models.ChatMute{
+		CourseID:  m.CourseID,
+		UserID:    m.UserID,
+		Until:     null.NewTime(m.Until.UTC(), !m.Until.IsZero()),
+		MutedByID: null.NewString(m.MutedByID, m.MutedByID != ""),
+	}
+	err := mute.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.ChatMuteColumns.CourseID, models.ChatMuteColumns.UserID},
+		boil.Whitelist(models.ChatMuteColumns.Until, models.ChatMuteColumns.MutedByID, models.ChatMuteColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return chat.Mute{}, errors.Wrap(err, "upserting mute")
+	}
+	return repo.unboilMute(mute), nil
+}
+
+func (repo ChatRepository) GetMute(ctx context.Context, courseID, userID string, exec ...core.DBExecutor) (chat.Mute, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return chat.Mute{}, chat.ErrMuteNotFound
+	}
+	m, err := models.FindChatMute(ctx, repo.getExec(exec), courseID, userID)
+	if err != nil {
+		return chat.Mute{}, repo.trapNoRowsErr(err, chat.ErrMuteNotFound, "finding mute")
+	}
+	return repo.unboilMute(m), nil
+}
+
+func (repo ChatRepository) DeleteMute(ctx context.Context, courseID, userID string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil
+	}
+	_, err := models.ChatMutes(
+		models.ChatMuteWhere.CourseID.EQ(courseID),
+		models.ChatMuteWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting mute")
+}
@@ -0,0 +1,409 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/coursework"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type CourseworkRepository struct {
+	db core.DB
+}
+
+var _ coursework.Repository = (*CourseworkRepository)(nil) // interface compliance check
+
+func NewCourseworkRepository(db core.DB) *CourseworkRepository {
+	return &CourseworkRepository{db: db}
+}
+
+func (repo CourseworkRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo CourseworkRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// --------------------------------------- Question ---------------------------------------
+
+func (repo CourseworkRepository) boilQuestion(q coursework.Question) (*models.Question, error) {
+	opts, err := json.Marshal(q.Options)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling options")
+	}
+	return &models.Question{
+		ID:        q.ID,
+		CourseID:  q.CourseID,
+		Kind:      q.Kind,
+		Prompt:    q.Prompt,
+		Options:   types.JSON(opts),
+		Points:    q.Points,
+		CreatedAt: null.NewTime(q.CreatedAt.UTC(), !q.CreatedAt.IsZero()),
+		UpdatedAt: null.NewTime(q.UpdatedAt.UTC(), !q.UpdatedAt.IsZero()),
+	}, nil
+}
+
+func (repo CourseworkRepository) unboilQuestion(q *models.Question) (coursework.Question, error) {
+	if q == nil {
+		return coursework.Question{}, nil
+	}
+	var opts []coursework.Option
+	if err := q.Options.Unmarshal(&opts); err != nil {
+		return coursework.Question{}, errors.Wrap(err, "unmarshalling options")
+	}
+	return coursework.Question{
+		ID:        q.ID,
+		CourseID:  q.CourseID,
+		Kind:      q.Kind,
+		Prompt:    q.Prompt,
+		Options:   opts,
+		Points:    q.Points,
+		CreatedAt: q.CreatedAt.Time,
+		UpdatedAt: q.UpdatedAt.Time,
+	}, nil
+}
+
+func (repo CourseworkRepository) CreateQuestion(ctx context.Context, q coursework.Question, exec ...core.DBExecutor) (coursework.Question, error) {
+	q.ID = uuid.New().String()
+	bq, err := repo.boilQuestion(q)
+	if err != nil {
+		return coursework.Question{}, err
+	}
+	if err = bq.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Question{}, errors.Wrap(err, "inserting question")
+	}
+	return repo.unboilQuestion(bq)
+}
+
+func (repo CourseworkRepository) QueryQuestions(ctx context.Context, filter coursework.QuestionFilter, exec ...core.DBExecutor) ([]coursework.Question, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.QuestionColumns.CreatedAt)}
+
+	if filter.CourseID != "" {
+		mods = append(mods, models.QuestionWhere.CourseID.EQ(filter.CourseID))
+	}
+	if filter.IDs != nil {
+		ids := make([]string, 0, len(filter.IDs))
+		for _, id := range filter.IDs {
+			if _, err := uuid.Parse(id); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return []coursework.Question{}, nil
+		}
+		mods = append(mods, models.QuestionWhere.ID.IN(ids))
+	}
+
+	bqs, err := models.Questions(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying questions")
+	}
+	questions := make([]coursework.Question, 0, len(bqs))
+	for _, bq := range bqs {
+		q, err := repo.unboilQuestion(bq)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	return questions, nil
+}
+
+func (repo CourseworkRepository) GetQuestion(ctx context.Context, id string, exec ...core.DBExecutor) (coursework.Question, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return coursework.Question{}, coursework.ErrQuestionNotFound
+	}
+	bq, err := models.FindQuestion(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return coursework.Question{}, repo.trapNoRowsErr(err, coursework.ErrQuestionNotFound, "finding question by ID")
+	}
+	return repo.unboilQuestion(bq)
+}
+
+func (repo CourseworkRepository) UpdateQuestion(ctx context.Context, q coursework.Question, exec ...core.DBExecutor) (coursework.Question, error) {
+	bq, err := repo.boilQuestion(q)
+	if err != nil {
+		return coursework.Question{}, err
+	}
+	if _, err = bq.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Question{}, errors.Wrap(err, "updating question")
+	}
+	return repo.unboilQuestion(bq)
+}
+
+func (repo CourseworkRepository) DeleteQuestion(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil
+	}
+	_, err := models.Questions(models.QuestionWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting question")
+}
+
+// -------------------------------------- Coursework --------------------------------------
+
+func (repo CourseworkRepository) boilCoursework(cw coursework.Coursework) *models.Coursework {
+	return &models.Coursework{
+		ID:           cw.ID,
+		CourseID:     cw.CourseID,
+		Kind:         cw.Kind,
+		Title:        cw.Title,
+		Instructions: null.NewString(cw.Instructions, cw.Instructions != ""),
+		QuestionIds:  types.StringArray(cw.QuestionIDs),
+		MaxAttempts:  cw.MaxAttempts,
+		StartsAt:     null.NewTime(cw.StartsAt.UTC(), !cw.StartsAt.IsZero()),
+		DueAt:        null.NewTime(cw.DueAt.UTC(), !cw.DueAt.IsZero()),
+		CreatedAt:    null.NewTime(cw.CreatedAt.UTC(), !cw.CreatedAt.IsZero()),
+		UpdatedAt:    null.NewTime(cw.UpdatedAt.UTC(), !cw.UpdatedAt.IsZero()),
+	}
+}
+
+func (repo CourseworkRepository) unboilCoursework(cw *models.Coursework) coursework.Coursework {
+	if cw == nil {
+		return coursework.Coursework{}
+	}
+	return coursework.Coursework{
+		ID:           cw.ID,
+		CourseID:     cw.CourseID,
+		Kind:         cw.Kind,
+		Title:        cw.Title,
+		Instructions: cw.Instructions.String,
+		QuestionIDs:  []string(cw.QuestionIds),
+		MaxAttempts:  cw.MaxAttempts,
+		StartsAt:     cw.StartsAt.Time,
+		DueAt:        cw.DueAt.Time,
+		CreatedAt:    cw.CreatedAt.Time,
+		UpdatedAt:    cw.UpdatedAt.Time,
+	}
+}
+
+func (repo CourseworkRepository) CreateCoursework(ctx context.Context, cw coursework.Coursework, exec ...core.DBExecutor) (coursework.Coursework, error) {
+	cw.ID = uuid.New().String()
+	bcw := repo.boilCoursework(cw)
+	if err := bcw.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Coursework{}, errors.Wrap(err, "inserting coursework")
+	}
+	return repo.unboilCoursework(bcw), nil
+}
+
+func (repo CourseworkRepository) QueryCoursework(ctx context.Context, filter coursework.QueryFilter, exec ...core.DBExecutor) ([]coursework.Coursework, error) {
+	mods := []qm.QueryMod{qm.OrderBy(fmt.Sprintf("%s NULLS LAST, %s", models.CourseworkColumns.DueAt, models.CourseworkColumns.CreatedAt))}
+
+	if filter.CourseID != "" {
+		mods = append(mods, models.CourseworkWhere.CourseID.EQ(filter.CourseID))
+	}
+	if filter.Kind != "" {
+		mods = append(mods, models.CourseworkWhere.Kind.EQ(filter.Kind))
+	}
+	if !filter.DueFrom.IsZero() {
+		mods = append(mods, models.CourseworkWhere.DueAt.GTE(null.TimeFrom(filter.DueFrom.UTC())))
+	}
+	if !filter.DueTo.IsZero() {
+		mods = append(mods, models.CourseworkWhere.DueAt.LTE(null.TimeFrom(filter.DueTo.UTC())))
+	}
+
+	bcws, err := models.Courseworks(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying coursework")
+	}
+	cws := make([]coursework.Coursework, 0, len(bcws))
+	for _, bcw := range bcws {
+		cws = append(cws, repo.unboilCoursework(bcw))
+	}
+	return cws, nil
+}
+
+func (repo CourseworkRepository) GetCoursework(ctx context.Context, id string, exec ...core.DBExecutor) (coursework.Coursework, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return coursework.Coursework{}, coursework.ErrCourseworkNotFound
+	}
+	bcw, err := models.FindCoursework(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return coursework.Coursework{}, repo.trapNoRowsErr(err, coursework.ErrCourseworkNotFound, "finding coursework by ID")
+	}
+	return repo.unboilCoursework(bcw), nil
+}
+
+func (repo CourseworkRepository) LockCoursework(ctx context.Context, id string, exec ...core.DBExecutor) (coursework.Coursework, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return coursework.Coursework{}, coursework.ErrCourseworkNotFound
+	}
+	bcw, err := models.Courseworks(models.CourseworkWhere.ID.EQ(id), qm.For("UPDATE")).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return coursework.Coursework{}, repo.trapNoRowsErr(err, coursework.ErrCourseworkNotFound, "locking coursework")
+	}
+	return repo.unboilCoursework(bcw), nil
+}
+
+func (repo CourseworkRepository) UpdateCoursework(ctx context.Context, cw coursework.Coursework, exec ...core.DBExecutor) (coursework.Coursework, error) {
+	bcw := repo.boilCoursework(cw)
+	if _, err := bcw.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Coursework{}, errors.Wrap(err, "updating coursework")
+	}
+	return repo.unboilCoursework(bcw), nil
+}
+
+func (repo CourseworkRepository) DeleteCoursework(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil
+	}
+	_, err := models.Courseworks(models.CourseworkWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting coursework")
+}
+
+// ---------------------------------------- Attempt ----------------------------------------
+
+func (repo CourseworkRepository) boilAttempt(att coursework.Attempt) (*models.Attempt, error) {
+	a := &models.Attempt{
+		ID:           att.ID,
+		CourseworkID: att.CourseworkID,
+		StudentID:    att.StudentID,
+		Seed:         att.Seed,
+		StartedAt:    null.NewTime(att.StartedAt.UTC(), !att.StartedAt.IsZero()),
+		SubmittedAt:  null.NewTime(att.SubmittedAt.UTC(), !att.SubmittedAt.IsZero()),
+	}
+	qs, err := json.Marshal(att.Questions)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling questions")
+	}
+	a.Questions = qs
+	if att.IsSubmitted() {
+		answers, err := json.Marshal(att.Answers)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshalling answers")
+		}
+		a.Answers = null.JSONFrom(answers)
+		a.Score = null.Float64From(att.Score)
+		a.MaxScore = null.Float64From(att.MaxScore)
+		a.Counted = null.BoolFrom(att.Counted)
+	}
+	return a, nil
+}
+
+func (repo CourseworkRepository) unboilAttempt(a *models.Attempt) (coursework.Attempt, error) {
+	if a == nil {
+		return coursework.Attempt{}, nil
+	}
+	att := coursework.Attempt{
+		ID:           a.ID,
+		CourseworkID: a.CourseworkID,
+		StudentID:    a.StudentID,
+		Seed:         a.Seed,
+		Score:        a.Score.Float64,
+		MaxScore:     a.MaxScore.Float64,
+		Counted:      a.Counted.Bool,
+		StartedAt:    a.StartedAt.Time,
+		SubmittedAt:  a.SubmittedAt.Time,
+	}
+	if err := a.Questions.Unmarshal(&att.Questions); err != nil {
+		return coursework.Attempt{}, errors.Wrap(err, "unmarshalling questions")
+	}
+	if a.Answers.Valid {
+		if err := a.Answers.Unmarshal(&att.Answers); err != nil {
+			return coursework.Attempt{}, errors.Wrap(err, "unmarshalling answers")
+		}
+	}
+	return att, nil
+}
+
+func (repo CourseworkRepository) attemptMods(filter coursework.AttemptFilter) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if filter.CourseworkID != "" {
+		mods = append(mods, models.AttemptWhere.CourseworkID.EQ(filter.CourseworkID))
+	}
+	if filter.StudentID != "" {
+		mods = append(mods, models.AttemptWhere.StudentID.EQ(filter.StudentID))
+	}
+	if filter.SubmittedOnly {
+		mods = append(mods, models.AttemptWhere.SubmittedAt.IsNotNull())
+	}
+	return mods
+}
+
+func (repo CourseworkRepository) CreateAttempt(ctx context.Context, att coursework.Attempt, exec ...core.DBExecutor) (coursework.Attempt, error) {
+	att.ID = uuid.New().String()
+	a, err := repo.boilAttempt(att)
+	if err != nil {
+		return coursework.Attempt{}, err
+	}
+	if err = a.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Attempt{}, errors.Wrap(err, "inserting attempt")
+	}
+	return repo.unboilAttempt(a)
+}
+
+func (repo CourseworkRepository) QueryAttempts(ctx context.Context, filter coursework.AttemptFilter, exec ...core.DBExecutor) ([]coursework.Attempt, error) {
+	mods := append(repo.attemptMods(filter), qm.OrderBy(models.AttemptColumns.StartedAt))
+	as, err := models.Attempts(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying attempts")
+	}
+	atts := make([]coursework.Attempt, 0, len(as))
+	for _, a := range as {
+		att, err := repo.unboilAttempt(a)
+		if err != nil {
+			return nil, err
+		}
+		atts = append(atts, att)
+	}
+	return atts, nil
+}
+
+func (repo CourseworkRepository) CountAttempts(ctx context.Context, filter coursework.AttemptFilter, exec ...core.DBExecutor) (int, error) {
+	cnt, err := models.Attempts(repo.attemptMods(filter)...).Count(ctx, repo.getExec(exec))
+	if err != nil {
+		return 0, errors.Wrap(err, "counting attempts")
+	}
+	return int(cnt), nil
+}
+
+func (repo CourseworkRepository) GetAttempt(ctx context.Context, id string, exec ...core.DBExecutor) (coursework.Attempt, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return coursework.Attempt{}, coursework.ErrAttemptNotFound
+	}
+	a, err := models.FindAttempt(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return coursework.Attempt{}, repo.trapNoRowsErr(err, coursework.ErrAttemptNotFound, "finding attempt by ID")
+	}
+	return repo.unboilAttempt(a)
+}
+
+func (repo CourseworkRepository) LockAttempt(ctx context.Context, id string, exec ...core.DBExecutor) (coursework.Attempt, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return coursework.Attempt{}, coursework.ErrAttemptNotFound
+	}
+	a, err := models.Attempts(models.AttemptWhere.ID.EQ(id), qm.For("UPDATE")).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return coursework.Attempt{}, repo.trapNoRowsErr(err, coursework.ErrAttemptNotFound, "locking attempt")
+	}
+	return repo.unboilAttempt(a)
+}
+
+func (repo CourseworkRepository) UpdateAttempt(ctx context.Context, att coursework.Attempt, exec ...core.DBExecutor) (coursework.Attempt, error) {
+	a, err := repo.boilAttempt(att)
+	if err != nil {
+		return coursework.Attempt{}, err
+	}
+	if _, err = a.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return coursework.Attempt{}, errors.Wrap(err, "updating attempt")
+	}
+	return repo.unboilAttempt(a)
+}
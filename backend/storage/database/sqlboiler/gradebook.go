@@ -0,0 +1,377 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/gradebook"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type GradebookRepository struct {
+	db core.DB
+}
+
+var _ gradebook.Repository = (*GradebookRepository)(nil) // interface compliance check
+
+func NewGradebookRepository(db core.DB) *GradebookRepository {
+	return &GradebookRepository{db: db}
+}
+
+func (repo GradebookRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo GradebookRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// --------------------------------------- Category ---------------------------------------
+
+func (repo GradebookRepository) unboilCategory(cat *models.MarkCategory) gradebook.Category {
+	if cat == nil {
+		return gradebook.Category{}
+	}
+	return gradebook.Category{
+		ID:        cat.ID,
+		CourseID:  cat.CourseID,
+		TermID:    cat.TermID,
+		Name:      cat.Name,
+		Weight:    cat.Weight,
+		CreatedAt: cat.CreatedAt.Time,
+		UpdatedAt: cat.UpdatedAt.Time,
+	}
+}
+
+func (repo GradebookRepository) CreateCategory(ctx context.Context, cat gradebook.Category, exec ...core.DBExecutor) (gradebook.Category, error) {
+	c := &models.MarkCategory{
+		ID:       uuid.New().String(),
+		CourseID: cat.CourseID,
+		TermID:   cat.TermID,
+		Name:     cat.Name,
+		Weight:   cat.Weight,
+	}
+	if err := c.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return gradebook.Category{}, errors.Wrap(err, "inserting category")
+	}
+	return repo.unboilCategory(c), nil
+}
+
+func (repo GradebookRepository) QueryCategories(ctx context.Context, filter gradebook.CategoryFilter, exec ...core.DBExecutor) ([]gradebook.Category, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.MarkCategoryColumns.Name)}
+
+	if filter.CourseIDs != nil {
+		mods = append(mods, models.MarkCategoryWhere.CourseID.IN(filter.CourseIDs))
+	}
+	if filter.TermID != "" {
+		mods = append(mods, models.MarkCategoryWhere.TermID.EQ(filter.TermID))
+	}
+
+	cs, err := models.MarkCategories(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying categories")
+	}
+	cats := make([]gradebook.Category, 0, len(cs))
+	for _, c := range cs {
+		cats = append(cats, repo.unboilCategory(c))
+	}
+	return cats, nil
+}
+
+func (repo GradebookRepository) GetCategory(ctx context.Context, id string, exec ...core.DBExecutor) (gradebook.Category, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return gradebook.Category{}, gradebook.ErrCategoryNotFound
+	}
+	c, err := models.FindMarkCategory(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return gradebook.Category{}, repo.trapNoRowsErr(err, gradebook.ErrCategoryNotFound, "finding category by ID")
+	}
+	return repo.unboilCategory(c), nil
+}
+
+func (repo GradebookRepository) DeleteCategory(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil
+	}
+	_, err := models.MarkCategories(models.MarkCategoryWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting category")
+}
+
+// -------------------------------------- Assessment --------------------------------------
+
+func (repo GradebookRepository) unboilAssessment(a *models.Assessment) gradebook.Assessment {
+	if a == nil {
+		return gradebook.Assessment{}
+	}
+	return gradebook.Assessment{
+		ID:           a.ID,
+		CategoryID:   a.CategoryID,
+		CourseworkID: a.CourseworkID.String,
+		Title:        a.Title,
+		MaxScore:     a.MaxScore,
+		CreatedAt:    a.CreatedAt.Time,
+		UpdatedAt:    a.UpdatedAt.Time,
+	}
+}
+
+func (repo GradebookRepository) CreateAssessment(ctx context.Context, assmt gradebook.Assessment, exec ...core.DBExecutor) (gradebook.Assessment, error) {
+	a := &models.Assessment{
+		ID:           uuid.New().String(),
+		CategoryID:   assmt.CategoryID,
+		CourseworkID: null.NewString(assmt.CourseworkID, assmt.CourseworkID != ""),
+		Title:        assmt.Title,
+		MaxScore:     assmt.MaxScore,
+	}
+	if err := a.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return gradebook.Assessment{}, errors.Wrap(err, "inserting assessment")
+	}
+	return repo.unboilAssessment(a), nil
+}
+
+func (repo GradebookRepository) QueryAssessments(ctx context.Context, filter gradebook.AssessmentFilter, exec ...core.DBExecutor) ([]gradebook.Assessment, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.AssessmentColumns.CreatedAt)}
+
+	if filter.CategoryID != "" {
+		mods = append(mods, models.AssessmentWhere.CategoryID.EQ(filter.CategoryID))
+	}
+	if filter.CourseworkID != "" {
+		mods = append(mods, models.AssessmentWhere.CourseworkID.EQ(null.StringFrom(filter.CourseworkID)))
+	}
+	if filter.CourseIDs != nil || filter.TermID != "" {
+		catMods := []qm.QueryMod{qm.Select(models.MarkCategoryColumns.ID)}
+		if filter.CourseIDs != nil {
+			catMods = append(catMods, models.MarkCategoryWhere.CourseID.IN(filter.CourseIDs))
+		}
+		if filter.TermID != "" {
+			catMods = append(catMods, models.MarkCategoryWhere.TermID.EQ(filter.TermID))
+		}
+		cs, err := models.MarkCategories(catMods...).All(ctx, repo.getExec(exec))
+		if err != nil {
+			return nil, errors.Wrap(err, "querying categories")
+		}
+		if len(cs) == 0 {
+			return []gradebook.Assessment{}, nil
+		}
+		catIDs := make([]string, 0, len(cs))
+		for _, c := range cs {
+			catIDs = append(catIDs, c.ID)
+		}
+		mods = append(mods, models.AssessmentWhere.CategoryID.IN(catIDs))
+	}
+
+	as, err := models.Assessments(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying assessments")
+	}
+	assessments := make([]gradebook.Assessment, 0, len(as))
+	for _, a := range as {
+		assessments = append(assessments, repo.unboilAssessment(a))
+	}
+	return assessments, nil
+}
+
+func (repo GradebookRepository) GetAssessment(ctx context.Context, id string, exec ...core.DBExecutor) (gradebook.Assessment, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return gradebook.Assessment{}, gradebook.ErrAssessmentNotFound
+	}
+	a, err := models.FindAssessment(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return gradebook.Assessment{}, repo.trapNoRowsErr(err, gradebook.ErrAssessmentNotFound, "finding assessment by ID")
+	}
+	return repo.unboilAssessment(a), nil
+}
+
+func (repo GradebookRepository) DeleteAssessment(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil
+	}
+	_, err := models.Assessments(models.AssessmentWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting assessment")
+}
+
+// ----------------------------------------- Mark -----------------------------------------
+
+func (repo GradebookRepository) unboilMark(m *models.Mark) gradebook.Mark {
+	if m == nil {
+		return gradebook.Mark{}
+	}
+	return gradebook.Mark{
+		AssessmentID:   m.AssessmentID,
+		StudentID:      m.StudentID,
+		Score:          m.Score,
+		PublishedScore: m.PublishedScore.Ptr(),
+		CreatedAt:      m.CreatedAt.Time,
+		UpdatedAt:      m.UpdatedAt.Time,
+	}
+}
+
+func (repo GradebookRepository) SaveMarks(ctx context.Context, marks []gradebook.Mark, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	conflictCols := []string{models.MarkColumns.AssessmentID, models.MarkColumns.StudentID}
+	updateCols := boil.Whitelist(models.MarkColumns.Score, models.MarkColumns.UpdatedAt)
+	for _, mark := range marks {
+		m := &models.Mark{AssessmentID: mark.AssessmentID, StudentID: mark.StudentID, Score: mark.Score}
+		if err := m.Upsert(ctx, exe, true, conflictCols, updateCols, boil.Infer()); err != nil {
+			return errors.Wrap(err, "upserting mark")
+		}
+	}
+	return nil
+}
+
+func (repo GradebookRepository) DeleteMarks(ctx context.Context, assessmentID string, studentIDs []string, exec ...core.DBExecutor) error {
+	_, err := models.Marks(
+		models.MarkWhere.AssessmentID.EQ(assessmentID),
+		models.MarkWhere.StudentID.IN(studentIDs),
+	).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting marks")
+}
+
+func (repo GradebookRepository) QueryMarks(ctx context.Context, filter gradebook.MarkFilter, exec ...core.DBExecutor) ([]gradebook.Mark, error) {
+	mods := []qm.QueryMod{qm.OrderBy(fmt.Sprintf("%s, %s", models.MarkColumns.AssessmentID, models.MarkColumns.StudentID))}
+
+	if filter.AssessmentIDs != nil {
+		mods = append(mods, models.MarkWhere.AssessmentID.IN(filter.AssessmentIDs))
+	}
+	if filter.StudentID != "" {
+		mods = append(mods, models.MarkWhere.StudentID.EQ(filter.StudentID))
+	}
+
+	ms, err := models.Marks(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying marks")
+	}
+	marks := make([]gradebook.Mark, 0, len(ms))
+	for _, m := range ms {
+		marks = append(marks, repo.unboilMark(m))
+	}
+	return marks, nil
+}
+
+// -------------------------------------- MarkImport --------------------------------------
+
+func (repo GradebookRepository) unboilImport(mi *models.MarkImport) (gradebook.MarkImport, error) {
+	if mi == nil {
+		return gradebook.MarkImport{}, nil
+	}
+	var entries []gradebook.ImportEntry
+	if err := json.Unmarshal(mi.Entries, &entries); err != nil {
+		return gradebook.MarkImport{}, errors.Wrap(err, "unmarshalling entries")
+	}
+	return gradebook.MarkImport{
+		ID:           mi.ID,
+		AssessmentID: mi.AssessmentID,
+		AuthorID:     mi.AuthorID.String,
+		Filename:     mi.Filename,
+		Status:       mi.Status,
+		Entries:      entries,
+		AppliedAt:    mi.AppliedAt.Time,
+		UndoneAt:     mi.UndoneAt.Time,
+		CreatedAt:    mi.CreatedAt.Time,
+		UpdatedAt:    mi.UpdatedAt.Time,
+	}, nil
+}
+
+func (repo GradebookRepository) boilImport(imp gradebook.MarkImport) (*models.MarkImport, error) {
+	if imp.Entries == nil {
+		imp.Entries = []gradebook.ImportEntry{}
+	}
+	entries, err := json.Marshal(imp.Entries)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling entries")
+	}
+	return &models.MarkImport{
+		ID:           imp.ID,
+		AssessmentID: imp.AssessmentID,
+		AuthorID:     null.NewString(imp.AuthorID, imp.AuthorID != ""),
+		Filename:     imp.Filename,
+		Status:       imp.Status,
+		Entries:      types.JSON(entries),
+		AppliedAt:    null.NewTime(imp.AppliedAt.UTC(), !imp.AppliedAt.IsZero()),
+		UndoneAt:     null.NewTime(imp.UndoneAt.UTC(), !imp.UndoneAt.IsZero()),
+		CreatedAt:    null.NewTime(imp.CreatedAt.UTC(), !imp.CreatedAt.IsZero()),
+	}, nil
+}
+
+func (repo GradebookRepository) CreateImport(ctx context.Context, imp gradebook.MarkImport, exec ...core.DBExecutor) (gradebook.MarkImport, error) {
+	imp.ID = uuid.New().String()
+	mi, err := repo.boilImport(imp)
+	if err != nil {
+		return gradebook.MarkImport{}, err
+	}
+	if err = mi.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return gradebook.MarkImport{}, errors.Wrap(err, "inserting import")
+	}
+	return repo.unboilImport(mi)
+}
+
+func (repo GradebookRepository) QueryImports(ctx context.Context, assessmentID string, exec ...core.DBExecutor) ([]gradebook.MarkImport, error) {
+	if _, err := uuid.Parse(assessmentID); err != nil {
+		return []gradebook.MarkImport{}, nil
+	}
+	mis, err := models.MarkImports(
+		models.MarkImportWhere.AssessmentID.EQ(assessmentID),
+		qm.OrderBy(models.MarkImportColumns.CreatedAt+" DESC"),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying imports")
+	}
+	imps := make([]gradebook.MarkImport, 0, len(mis))
+	for _, mi := range mis {
+		imp, err := repo.unboilImport(mi)
+		if err != nil {
+			return nil, err
+		}
+		imps = append(imps, imp)
+	}
+	return imps, nil
+}
+
+func (repo GradebookRepository) GetImport(ctx context.Context, id string, exec ...core.DBExecutor) (gradebook.MarkImport, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return gradebook.MarkImport{}, gradebook.ErrImportNotFound
+	}
+	mi, err := models.FindMarkImport(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return gradebook.MarkImport{}, repo.trapNoRowsErr(err, gradebook.ErrImportNotFound, "finding import by ID")
+	}
+	return repo.unboilImport(mi)
+}
+
+func (repo GradebookRepository) LockImport(ctx context.Context, id string, exec ...core.DBExecutor) (gradebook.MarkImport, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return gradebook.MarkImport{}, gradebook.ErrImportNotFound
+	}
+	mi, err := models.MarkImports(models.MarkImportWhere.ID.EQ(id), qm.For("UPDATE")).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return gradebook.MarkImport{}, repo.trapNoRowsErr(err, gradebook.ErrImportNotFound, "locking import")
+	}
+	return repo.unboilImport(mi)
+}
+
+func (repo GradebookRepository) UpdateImport(ctx context.Context, imp gradebook.MarkImport, exec ...core.DBExecutor) (gradebook.MarkImport, error) {
+	mi, err := repo.boilImport(imp)
+	if err != nil {
+		return gradebook.MarkImport{}, err
+	}
+	if _, err = mi.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return gradebook.MarkImport{}, errors.Wrap(err, "updating import")
+	}
+	return repo.unboilImport(mi)
+}
@@ -0,0 +1,103 @@
+package boiledrepos
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/guardian"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type GuardianRepository struct {
+	db core.DB
+}
+
+var _ guardian.Repository = (*GuardianRepository)(nil) // interface compliance check
+
+func NewGuardianRepository(db core.DB) *GuardianRepository {
+	return &GuardianRepository{db: db}
+}
+
+func (repo GuardianRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo GuardianRepository) unboilLink(l *models.GuardianLink) guardian.Link {
+	if l == nil {
+		return guardian.Link{}
+	}
+	return guardian.Link{
+		GuardianID:   l.GuardianID,
+		StudentID:    l.StudentID,
+		Relationship: l.Relationship,
+		CreatedAt:    l.CreatedAt.Time,
+		UpdatedAt:    l.UpdatedAt.Time,
+	}
+}
+
+func (repo GuardianRepository) SaveLink(ctx context.Context, l guardian.Link, exec ...core.DBExecutor) (guardian.Link, error) {
+	m := &models.GuardianLink{
+		GuardianID:   l.GuardianID,
+		StudentID:    l.StudentID,
+		Relationship: l.Relationship,
+	}
+	err := m.Upsert(
+		ctx, repo.getExec(exec), true,
+		[]string{models.GuardianLinkColumns.GuardianID, models.GuardianLinkColumns.StudentID},
+		boil.Whitelist(models.GuardianLinkColumns.Relationship, models.GuardianLinkColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return guardian.Link{}, errors.Wrap(err, "upserting guardian link")
+	}
+	return repo.unboilLink(m), nil
+}
+
+func (repo GuardianRepository) QueryLinks(ctx context.Context, guardianID, studentID string, exec ...core.DBExecutor) ([]guardian.Link, error) {
+	for _, id := range []string{guardianID, studentID} {
+		if _, err := uuid.Parse(id); id != "" && err != nil {
+			return []guardian.Link{}, nil
+		}
+	}
+	mods := []qm.QueryMod{qm.OrderBy(models.GuardianLinkColumns.CreatedAt)}
+	if guardianID != "" {
+		mods = append(mods, models.GuardianLinkWhere.GuardianID.EQ(guardianID))
+	}
+	if studentID != "" {
+		mods = append(mods, models.GuardianLinkWhere.StudentID.EQ(studentID))
+	}
+	ms, err := models.GuardianLinks(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying guardian links")
+	}
+	ls := make([]guardian.Link, 0, len(ms))
+	for _, m := range ms {
+		ls = append(ls, repo.unboilLink(m))
+	}
+	return ls, nil
+}
+
+func (repo GuardianRepository) DeleteLink(ctx context.Context, guardianID, studentID string, exec ...core.DBExecutor) error {
+	if _, err := uuid.Parse(guardianID); err != nil {
+		return guardian.ErrLinkNotFound
+	}
+	n, err := models.GuardianLinks(
+		models.GuardianLinkWhere.GuardianID.EQ(guardianID),
+		models.GuardianLinkWhere.StudentID.EQ(studentID),
+	).DeleteAll(ctx, repo.getExec(exec))
+	if err != nil {
+		return errors.Wrap(err, "deleting guardian link")
+	}
+	if n == 0 {
+		return guardian.ErrLinkNotFound
+	}
+	return nil
+}
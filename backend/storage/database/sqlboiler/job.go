@@ -0,0 +1,190 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/job"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type JobRepository struct {
+	db core.DB
+}
+
+var _ job.Repository = (*JobRepository)(nil) // interface compliance check
+
+func NewJobRepository(db core.DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+func (repo JobRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo JobRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+func (repo JobRepository) unboilJob(j *models.Job) job.Job {
+	if j == nil {
+		return job.Job{}
+	}
+	return job.Job{
+		ID:          j.ID,
+		Name:        j.Name,
+		Key:         j.Key.String,
+		Payload:     []byte(j.Payload.JSON),
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       j.RunAt,
+		LockedBy:    j.LockedBy.String,
+		LockedAt:    j.LockedAt.Time,
+		LastError:   j.LastError.String,
+		FinishedAt:  j.FinishedAt.Time,
+		CreatedAt:   j.CreatedAt.Time,
+		UpdatedAt:   j.UpdatedAt.Time,
+	}
+}
+
+func (repo JobRepository) CreateJob(ctx context.Context, j job.Job, exec ...core.DBExecutor) (job.Job, bool, error) {
+	exe := repo.getExec(exec)
+	now := time.Now().UTC()
+	if j.ID == "" {
+		j.ID = uuid.New().String()
+	}
+	res, err := exe.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, name, key, payload, status, attempts, max_attempts, run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8, $8)
+		ON CONFLICT (key) DO NOTHING`,
+		models.TableNames.Job,
+	),
+		j.ID,
+		j.Name,
+		null.NewString(j.Key, j.Key != ""),
+		null.NewJSON(j.Payload, len(j.Payload) > 0),
+		j.Status,
+		j.MaxAttempts,
+		j.RunAt.UTC(),
+		now,
+	)
+	if err != nil {
+		return job.Job{}, false, errors.Wrap(err, "inserting job")
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return job.Job{}, false, errors.Wrap(err, "inserting job")
+	}
+	j, err = repo.GetJob(ctx, j.ID, exe)
+	return j, err == nil, err
+}
+
+func (repo JobRepository) GetJob(ctx context.Context, id string, exec ...core.DBExecutor) (job.Job, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return job.Job{}, job.ErrJobNotFound
+	}
+	j, err := models.FindJob(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return job.Job{}, repo.trapNoRowsErr(err, job.ErrJobNotFound, "finding job")
+	}
+	return repo.unboilJob(j), nil
+}
+
+func (repo JobRepository) QueryJobs(ctx context.Context, f job.Filter, exec ...core.DBExecutor) ([]job.Job, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.JobColumns.CreatedAt + " DESC")}
+	if f.Name != "" {
+		mods = append(mods, models.JobWhere.Name.EQ(f.Name))
+	}
+	if len(f.Statuses) > 0 {
+		mods = append(mods, models.JobWhere.Status.IN(f.Statuses))
+	}
+	if f.Limit > 0 {
+		mods = append(mods, qm.Limit(f.Limit))
+	}
+	ms, err := models.Jobs(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying jobs")
+	}
+	js := make([]job.Job, 0, len(ms))
+	for _, m := range ms {
+		js = append(js, repo.unboilJob(m))
+	}
+	return js, nil
+}
+
+func (repo JobRepository) ClaimJob(
+	ctx context.Context,
+	worker string,
+	names []string,
+	t time.Time,
+	exec ...core.DBExecutor,
+) (job.Job, error) {
+	exe := repo.getExec(exec)
+	var id string
+	err := exe.QueryRowContext(ctx, `
+		UPDATE job SET status = $1, attempts = attempts + 1, locked_by = $2, locked_at = $3, updated_at = $3
+		WHERE id = (
+			SELECT id FROM job
+			WHERE status = $4 AND run_at <= $3 AND name = ANY($5)
+			ORDER BY run_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id`,
+		job.StatusRunning, worker, t.UTC(), job.StatusPending, pq.Array(names),
+	).Scan(&id)
+	if err != nil {
+		return job.Job{}, repo.trapNoRowsErr(err, job.ErrNoJobDue, "claiming job")
+	}
+	return repo.GetJob(ctx, id, exe)
+}
+
+func (repo JobRepository) UpdateJob(ctx context.Context, j job.Job, exec ...core.DBExecutor) (job.Job, error) {
+	exe := repo.getExec(exec)
+	m, err := models.FindJob(ctx, exe, j.ID)
+	if err != nil {
+		return job.Job{}, repo.trapNoRowsErr(err, job.ErrJobNotFound, "finding job")
+	}
+	m.Status = j.Status
+	m.Attempts = j.Attempts
+	m.RunAt = j.RunAt.UTC()
+	m.LockedBy = null.NewString(j.LockedBy, j.LockedBy != "")
+	m.LockedAt = null.NewTime(j.LockedAt.UTC(), !j.LockedAt.IsZero())
+	m.LastError = null.NewString(j.LastError, j.LastError != "")
+	m.FinishedAt = null.NewTime(j.FinishedAt.UTC(), !j.FinishedAt.IsZero())
+	if _, err = m.Update(ctx, exe, boil.Infer()); err != nil {
+		return job.Job{}, errors.Wrap(err, "updating job")
+	}
+	return repo.unboilJob(m), nil
+}
+
+func (repo JobRepository) ReleaseStaleJobs(ctx context.Context, t time.Time, exec ...core.DBExecutor) (int, error) {
+	n, err := models.Jobs(
+		models.JobWhere.Status.EQ(job.StatusRunning),
+		models.JobWhere.LockedAt.LT(null.TimeFrom(t.UTC())),
+	).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.JobColumns.Status:    job.StatusPending,
+		models.JobColumns.LockedBy:  nil,
+		models.JobColumns.LockedAt:  nil,
+		models.JobColumns.UpdatedAt: time.Now().UTC(),
+		models.JobColumns.LastError: "worker timed out",
+	})
+	return int(n), errors.Wrap(err, "releasing stale jobs")
+}
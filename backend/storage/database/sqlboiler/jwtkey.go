@@ -0,0 +1,106 @@
+package boiledrepos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/jwtkey"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type JWTKeyRepository struct {
+	db core.DB
+}
+
+var _ jwtkey.Repository = (*JWTKeyRepository)(nil) // interface compliance check
+
+func NewJWTKeyRepository(db core.DB) *JWTKeyRepository {
+	return &JWTKeyRepository{db: db}
+}
+
+func (repo JWTKeyRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo JWTKeyRepository) unboilKey(k *models.JWTKey) (jwtkey.Key, error) {
+	if k == nil {
+		return jwtkey.Key{}, nil
+	}
+	pk, err := jwtkey.DecodePrivateKey(k.PrivateKey)
+	if err != nil {
+		return jwtkey.Key{}, errors.Wrapf(err, "decoding key %s", k.ID)
+	}
+	return jwtkey.Key{
+		ID:         k.ID,
+		Algorithm:  k.Algorithm,
+		PrivateKey: pk,
+		RetiredAt:  k.RetiredAt.Time,
+		CreatedAt:  k.CreatedAt.Time,
+		UpdatedAt:  k.UpdatedAt.Time,
+	}, nil
+}
+
+func (repo JWTKeyRepository) CreateKey(ctx context.Context, k jwtkey.Key, exec ...core.DBExecutor) (jwtkey.Key, error) {
+	pem, err := jwtkey.EncodePrivateKey(k.PrivateKey)
+	if err != nil {
+		return jwtkey.Key{}, errors.Wrap(err, "encoding key")
+	}
+	m := &models.JWTKey{
+		ID:         uuid.New().String(),
+		Algorithm:  k.Algorithm,
+		PrivateKey: pem,
+	}
+	if err = m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return jwtkey.Key{}, errors.Wrap(err, "inserting key")
+	}
+	k.ID = m.ID
+	k.CreatedAt = m.CreatedAt.Time
+	k.UpdatedAt = m.UpdatedAt.Time
+	return k, nil
+}
+
+func (repo JWTKeyRepository) QueryKeys(ctx context.Context, retiredSince time.Time, exec ...core.DBExecutor) ([]jwtkey.Key, error) {
+	ms, err := models.JWTKeys(
+		models.JWTKeyWhere.RetiredAt.IsNull(),
+		qm.Or2(models.JWTKeyWhere.RetiredAt.GT(null.TimeFrom(retiredSince))),
+		qm.OrderBy(models.JWTKeyColumns.CreatedAt+" DESC"),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying keys")
+	}
+	ks := make([]jwtkey.Key, 0, len(ms))
+	for _, m := range ms {
+		k, err := repo.unboilKey(m)
+		if err != nil {
+			return nil, err
+		}
+		ks = append(ks, k)
+	}
+	return ks, nil
+}
+
+func (repo JWTKeyRepository) RetireKeys(ctx context.Context, at time.Time, exec ...core.DBExecutor) error {
+	_, err := models.JWTKeys(models.JWTKeyWhere.RetiredAt.IsNull()).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.JWTKeyColumns.RetiredAt: null.TimeFrom(at),
+		models.JWTKeyColumns.UpdatedAt: null.TimeFrom(at),
+	})
+	return errors.Wrap(err, "retiring keys")
+}
+
+func (repo JWTKeyRepository) DeleteKeys(ctx context.Context, retiredBefore time.Time, exec ...core.DBExecutor) (int, error) {
+	n, err := models.JWTKeys(models.JWTKeyWhere.RetiredAt.LT(null.TimeFrom(retiredBefore))).DeleteAll(ctx, repo.getExec(exec))
+	if err != nil {
+		return 0, errors.Wrap(err, "deleting keys")
+	}
+	return int(n), nil
+}
@@ -0,0 +1,986 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AnnouncementAttachment is an object representing the database table.
+type AnnouncementAttachment struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AnnouncementID string    `boil:"announcement_id" json:"announcement_id" toml:"announcement_id" yaml:"announcement_id"`
+	Name           string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Filename       string    `boil:"filename" json:"filename" toml:"filename" yaml:"filename"`
+	ContentType    string    `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
+	Size           int64     `boil:"size" json:"size" toml:"size" yaml:"size"`
+	CreatedAt      null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *announcementAttachmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L announcementAttachmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AnnouncementAttachmentColumns = struct {
+	ID             string
+	AnnouncementID string
+	Name           string
+	Filename       string
+	ContentType    string
+	Size           string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	AnnouncementID: "announcement_id",
+	Name:           "name",
+	Filename:       "filename",
+	ContentType:    "content_type",
+	Size:           "size",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AnnouncementAttachmentWhere = struct {
+	ID             whereHelperstring
+	AnnouncementID whereHelperstring
+	Name           whereHelperstring
+	Filename       whereHelperstring
+	ContentType    whereHelperstring
+	Size           whereHelperint64
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperstring{field: "\"announcement_attachment\".\"id\""},
+	AnnouncementID: whereHelperstring{field: "\"announcement_attachment\".\"announcement_id\""},
+	Name:           whereHelperstring{field: "\"announcement_attachment\".\"name\""},
+	Filename:       whereHelperstring{field: "\"announcement_attachment\".\"filename\""},
+	ContentType:    whereHelperstring{field: "\"announcement_attachment\".\"content_type\""},
+	Size:           whereHelperint64{field: "\"announcement_attachment\".\"size\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"announcement_attachment\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"announcement_attachment\".\"updated_at\""},
+}
+
+// AnnouncementAttachmentRels is where relationship names are stored.
+var AnnouncementAttachmentRels = struct {
+	Announcement string
+}{
+	Announcement: "Announcement",
+}
+
+// announcementAttachmentR is where relationships are stored.
+type announcementAttachmentR struct {
+	Announcement *Announcement `boil:"Announcement" json:"Announcement" toml:"Announcement" yaml:"Announcement"`
+}
+
+// NewStruct creates a new relationship struct
+func (*announcementAttachmentR) NewStruct() *announcementAttachmentR {
+	return &announcementAttachmentR{}
+}
+
+// announcementAttachmentL is where Load methods for each relationship are stored.
+type announcementAttachmentL struct{}
+
+var (
+	announcementAttachmentAllColumns            = []string{"id", "announcement_id", "name", "filename", "content_type", "size", "created_at", "updated_at"}
+	announcementAttachmentColumnsWithoutDefault = []string{"id", "announcement_id", "name", "filename", "content_type", "size", "created_at", "updated_at"}
+	announcementAttachmentColumnsWithDefault    = []string{}
+	announcementAttachmentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AnnouncementAttachmentSlice is an alias for a slice of pointers to AnnouncementAttachment.
+	// This should generally be used opposed to []AnnouncementAttachment.
+	AnnouncementAttachmentSlice []*AnnouncementAttachment
+
+	announcementAttachmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	announcementAttachmentType                 = reflect.TypeOf(&AnnouncementAttachment{})
+	announcementAttachmentMapping              = queries.MakeStructMapping(announcementAttachmentType)
+	announcementAttachmentPrimaryKeyMapping, _ = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, announcementAttachmentPrimaryKeyColumns)
+	announcementAttachmentInsertCacheMut       sync.RWMutex
+	announcementAttachmentInsertCache          = make(map[string]insertCache)
+	announcementAttachmentUpdateCacheMut       sync.RWMutex
+	announcementAttachmentUpdateCache          = make(map[string]updateCache)
+	announcementAttachmentUpsertCacheMut       sync.RWMutex
+	announcementAttachmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single announcementAttachment record from the query using the global executor.
+func (q announcementAttachmentQuery) OneG(ctx context.Context) (*AnnouncementAttachment, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single announcementAttachment record from the query.
+func (q announcementAttachmentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AnnouncementAttachment, error) {
+	o := &AnnouncementAttachment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for announcement_attachment")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AnnouncementAttachment records from the query using the global executor.
+func (q announcementAttachmentQuery) AllG(ctx context.Context) (AnnouncementAttachmentSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AnnouncementAttachment records from the query.
+func (q announcementAttachmentQuery) All(ctx context.Context, exec boil.ContextExecutor) (AnnouncementAttachmentSlice, error) {
+	var o []*AnnouncementAttachment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AnnouncementAttachment slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AnnouncementAttachment records in the query, and panics on error.
+func (q announcementAttachmentQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AnnouncementAttachment records in the query.
+func (q announcementAttachmentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count announcement_attachment rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q announcementAttachmentQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q announcementAttachmentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if announcement_attachment exists")
+	}
+
+	return count > 0, nil
+}
+
+// Announcement pointed to by the foreign key.
+func (o *AnnouncementAttachment) Announcement(mods ...qm.QueryMod) announcementQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AnnouncementID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Announcements(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement\"")
+
+	return query
+}
+
+// LoadAnnouncement allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (announcementAttachmentL) LoadAnnouncement(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnnouncementAttachment interface{}, mods queries.Applicator) error {
+	var slice []*AnnouncementAttachment
+	var object *AnnouncementAttachment
+
+	if singular {
+		object = maybeAnnouncementAttachment.(*AnnouncementAttachment)
+	} else {
+		slice = *maybeAnnouncementAttachment.(*[]*AnnouncementAttachment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &announcementAttachmentR{}
+		}
+		args = append(args, object.AnnouncementID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &announcementAttachmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AnnouncementID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AnnouncementID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement`),
+		qm.WhereIn(`announcement.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Announcement")
+	}
+
+	var resultSlice []*Announcement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Announcement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for announcement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Announcement = foreign
+		if foreign.R == nil {
+			foreign.R = &announcementR{}
+		}
+		foreign.R.AnnouncementAttachments = append(foreign.R.AnnouncementAttachments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AnnouncementID == foreign.ID {
+				local.R.Announcement = foreign
+				if foreign.R == nil {
+					foreign.R = &announcementR{}
+				}
+				foreign.R.AnnouncementAttachments = append(foreign.R.AnnouncementAttachments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAnnouncementG of the announcementAttachment to the related item.
+// Sets o.R.Announcement to related.
+// Adds o to related.R.AnnouncementAttachments.
+// Uses the global database handle.
+func (o *AnnouncementAttachment) SetAnnouncementG(ctx context.Context, insert bool, related *Announcement) error {
+	return o.SetAnnouncement(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAnnouncement of the announcementAttachment to the related item.
+// Sets o.R.Announcement to related.
+// Adds o to related.R.AnnouncementAttachments.
+func (o *AnnouncementAttachment) SetAnnouncement(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Announcement) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"announcement_attachment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"announcement_id"}),
+		strmangle.WhereClause("\"", "\"", 2, announcementAttachmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AnnouncementID = related.ID
+	if o.R == nil {
+		o.R = &announcementAttachmentR{
+			Announcement: related,
+		}
+	} else {
+		o.R.Announcement = related
+	}
+
+	if related.R == nil {
+		related.R = &announcementR{
+			AnnouncementAttachments: AnnouncementAttachmentSlice{o},
+		}
+	} else {
+		related.R.AnnouncementAttachments = append(related.R.AnnouncementAttachments, o)
+	}
+
+	return nil
+}
+
+// AnnouncementAttachments retrieves all the records using an executor.
+func AnnouncementAttachments(mods ...qm.QueryMod) announcementAttachmentQuery {
+	mods = append(mods, qm.From("\"announcement_attachment\""))
+	return announcementAttachmentQuery{NewQuery(mods...)}
+}
+
+// FindAnnouncementAttachmentG retrieves a single record by ID.
+func FindAnnouncementAttachmentG(ctx context.Context, iD string, selectCols ...string) (*AnnouncementAttachment, error) {
+	return FindAnnouncementAttachment(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAnnouncementAttachment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAnnouncementAttachment(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AnnouncementAttachment, error) {
+	announcementAttachmentObj := &AnnouncementAttachment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"announcement_attachment\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, announcementAttachmentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from announcement_attachment")
+	}
+
+	return announcementAttachmentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AnnouncementAttachment) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AnnouncementAttachment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no announcement_attachment provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(announcementAttachmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	announcementAttachmentInsertCacheMut.RLock()
+	cache, cached := announcementAttachmentInsertCache[key]
+	announcementAttachmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			announcementAttachmentAllColumns,
+			announcementAttachmentColumnsWithDefault,
+			announcementAttachmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"announcement_attachment\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"announcement_attachment\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into announcement_attachment")
+	}
+
+	if !cached {
+		announcementAttachmentInsertCacheMut.Lock()
+		announcementAttachmentInsertCache[key] = cache
+		announcementAttachmentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AnnouncementAttachment record using the global executor.
+// See Update for more documentation.
+func (o *AnnouncementAttachment) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AnnouncementAttachment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AnnouncementAttachment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	announcementAttachmentUpdateCacheMut.RLock()
+	cache, cached := announcementAttachmentUpdateCache[key]
+	announcementAttachmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			announcementAttachmentAllColumns,
+			announcementAttachmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update announcement_attachment, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"announcement_attachment\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, announcementAttachmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, append(wl, announcementAttachmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update announcement_attachment row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for announcement_attachment")
+	}
+
+	if !cached {
+		announcementAttachmentUpdateCacheMut.Lock()
+		announcementAttachmentUpdateCache[key] = cache
+		announcementAttachmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q announcementAttachmentQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q announcementAttachmentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for announcement_attachment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for announcement_attachment")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AnnouncementAttachmentSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AnnouncementAttachmentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementAttachmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"announcement_attachment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, announcementAttachmentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in announcementAttachment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all announcementAttachment")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AnnouncementAttachment) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AnnouncementAttachment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no announcement_attachment provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(announcementAttachmentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	announcementAttachmentUpsertCacheMut.RLock()
+	cache, cached := announcementAttachmentUpsertCache[key]
+	announcementAttachmentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			announcementAttachmentAllColumns,
+			announcementAttachmentColumnsWithDefault,
+			announcementAttachmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			announcementAttachmentAllColumns,
+			announcementAttachmentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert announcement_attachment, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(announcementAttachmentPrimaryKeyColumns))
+			copy(conflict, announcementAttachmentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"announcement_attachment\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(announcementAttachmentType, announcementAttachmentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert announcement_attachment")
+	}
+
+	if !cached {
+		announcementAttachmentUpsertCacheMut.Lock()
+		announcementAttachmentUpsertCache[key] = cache
+		announcementAttachmentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AnnouncementAttachment record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AnnouncementAttachment) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single AnnouncementAttachment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AnnouncementAttachment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AnnouncementAttachment provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), announcementAttachmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"announcement_attachment\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from announcement_attachment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for announcement_attachment")
+	}
+
+	return rowsAff, nil
+}
+
+func (q announcementAttachmentQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q announcementAttachmentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no announcementAttachmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from announcement_attachment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for announcement_attachment")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AnnouncementAttachmentSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AnnouncementAttachmentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementAttachmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"announcement_attachment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, announcementAttachmentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from announcementAttachment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for announcement_attachment")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AnnouncementAttachment) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no AnnouncementAttachment provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AnnouncementAttachment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAnnouncementAttachment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AnnouncementAttachmentSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AnnouncementAttachmentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AnnouncementAttachmentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AnnouncementAttachmentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementAttachmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"announcement_attachment\".* FROM \"announcement_attachment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, announcementAttachmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AnnouncementAttachmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AnnouncementAttachmentExistsG checks if the AnnouncementAttachment row exists.
+func AnnouncementAttachmentExistsG(ctx context.Context, iD string) (bool, error) {
+	return AnnouncementAttachmentExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AnnouncementAttachmentExists checks if the AnnouncementAttachment row exists.
+func AnnouncementAttachmentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"announcement_attachment\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if announcement_attachment exists")
+	}
+
+	return exists, nil
+}
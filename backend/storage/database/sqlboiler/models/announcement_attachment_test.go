@@ -0,0 +1,700 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAnnouncementAttachments(t *testing.T) {
+	t.Parallel()
+
+	query := AnnouncementAttachments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAnnouncementAttachmentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AnnouncementAttachments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementAttachmentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AnnouncementAttachmentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AnnouncementAttachment exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AnnouncementAttachmentExists to return true, but got false.")
+	}
+}
+
+func testAnnouncementAttachmentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	announcementAttachmentFound, err := FindAnnouncementAttachment(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if announcementAttachmentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAnnouncementAttachmentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AnnouncementAttachments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementAttachmentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AnnouncementAttachments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAnnouncementAttachmentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	announcementAttachmentOne := &AnnouncementAttachment{}
+	announcementAttachmentTwo := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, announcementAttachmentOne, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementAttachmentTwo, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementAttachmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementAttachmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AnnouncementAttachments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAnnouncementAttachmentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	announcementAttachmentOne := &AnnouncementAttachment{}
+	announcementAttachmentTwo := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, announcementAttachmentOne, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementAttachmentTwo, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementAttachmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementAttachmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(announcementAttachmentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementAttachmentToOneAnnouncementUsingAnnouncement(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AnnouncementAttachment
+	var foreign Announcement
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AnnouncementID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Announcement().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementAttachmentSlice{&local}
+	if err = local.L.LoadAnnouncement(ctx, tx, false, (*[]*AnnouncementAttachment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Announcement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Announcement = nil
+	if err = local.L.LoadAnnouncement(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Announcement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementAttachmentToOneSetOpAnnouncementUsingAnnouncement(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AnnouncementAttachment
+	var b, c Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementAttachmentDBTypes, false, strmangle.SetComplement(announcementAttachmentPrimaryKeyColumns, announcementAttachmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Announcement{&b, &c} {
+		err = a.SetAnnouncement(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Announcement != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AnnouncementAttachments[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AnnouncementID != x.ID {
+			t.Error("foreign key was wrong value", a.AnnouncementID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AnnouncementID))
+		reflect.Indirect(reflect.ValueOf(&a.AnnouncementID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AnnouncementID != x.ID {
+			t.Error("foreign key was wrong value", a.AnnouncementID, x.ID)
+		}
+	}
+}
+
+func testAnnouncementAttachmentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementAttachmentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementAttachmentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementAttachmentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AnnouncementAttachments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	announcementAttachmentDBTypes = map[string]string{`ID`: `uuid`, `AnnouncementID`: `uuid`, `Name`: `character varying`, `Filename`: `character varying`, `ContentType`: `character varying`, `Size`: `bigint`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                             = bytes.MinRead
+)
+
+func testAnnouncementAttachmentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(announcementAttachmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(announcementAttachmentAllColumns) == len(announcementAttachmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAnnouncementAttachmentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementAttachmentAllColumns) == len(announcementAttachmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementAttachment{}
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementAttachmentDBTypes, true, announcementAttachmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(announcementAttachmentAllColumns, announcementAttachmentPrimaryKeyColumns) {
+		fields = announcementAttachmentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			announcementAttachmentAllColumns,
+			announcementAttachmentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AnnouncementAttachmentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAnnouncementAttachmentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementAttachmentAllColumns) == len(announcementAttachmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AnnouncementAttachment{}
+	if err = randomize.Struct(seed, &o, announcementAttachmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AnnouncementAttachment: %s", err)
+	}
+
+	count, err := AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, announcementAttachmentDBTypes, false, announcementAttachmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementAttachment struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AnnouncementAttachment: %s", err)
+	}
+
+	count, err = AnnouncementAttachments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
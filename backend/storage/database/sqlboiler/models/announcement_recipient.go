@@ -0,0 +1,1116 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AnnouncementRecipient is an object representing the database table.
+type AnnouncementRecipient struct {
+	AnnouncementID string    `boil:"announcement_id" json:"announcement_id" toml:"announcement_id" yaml:"announcement_id"`
+	UserID         string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ReadAt         null.Time `boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	CreatedAt      null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *announcementRecipientR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L announcementRecipientL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AnnouncementRecipientColumns = struct {
+	AnnouncementID string
+	UserID         string
+	ReadAt         string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	AnnouncementID: "announcement_id",
+	UserID:         "user_id",
+	ReadAt:         "read_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+// Generated where
+
+var AnnouncementRecipientWhere = struct {
+	AnnouncementID whereHelperstring
+	UserID         whereHelperstring
+	ReadAt         whereHelpernull_Time
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	AnnouncementID: whereHelperstring{field: "\"announcement_recipient\".\"announcement_id\""},
+	UserID:         whereHelperstring{field: "\"announcement_recipient\".\"user_id\""},
+	ReadAt:         whereHelpernull_Time{field: "\"announcement_recipient\".\"read_at\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"announcement_recipient\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"announcement_recipient\".\"updated_at\""},
+}
+
+// AnnouncementRecipientRels is where relationship names are stored.
+var AnnouncementRecipientRels = struct {
+	Announcement string
+	User         string
+}{
+	Announcement: "Announcement",
+	User:         "User",
+}
+
+// announcementRecipientR is where relationships are stored.
+type announcementRecipientR struct {
+	Announcement *Announcement `boil:"Announcement" json:"Announcement" toml:"Announcement" yaml:"Announcement"`
+	User         *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*announcementRecipientR) NewStruct() *announcementRecipientR {
+	return &announcementRecipientR{}
+}
+
+// announcementRecipientL is where Load methods for each relationship are stored.
+type announcementRecipientL struct{}
+
+var (
+	announcementRecipientAllColumns            = []string{"announcement_id", "user_id", "read_at", "created_at", "updated_at"}
+	announcementRecipientColumnsWithoutDefault = []string{"announcement_id", "user_id", "read_at", "created_at", "updated_at"}
+	announcementRecipientColumnsWithDefault    = []string{}
+	announcementRecipientPrimaryKeyColumns     = []string{"announcement_id", "user_id"}
+)
+
+type (
+	// AnnouncementRecipientSlice is an alias for a slice of pointers to AnnouncementRecipient.
+	// This should generally be used opposed to []AnnouncementRecipient.
+	AnnouncementRecipientSlice []*AnnouncementRecipient
+
+	announcementRecipientQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	announcementRecipientType                 = reflect.TypeOf(&AnnouncementRecipient{})
+	announcementRecipientMapping              = queries.MakeStructMapping(announcementRecipientType)
+	announcementRecipientPrimaryKeyMapping, _ = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, announcementRecipientPrimaryKeyColumns)
+	announcementRecipientInsertCacheMut       sync.RWMutex
+	announcementRecipientInsertCache          = make(map[string]insertCache)
+	announcementRecipientUpdateCacheMut       sync.RWMutex
+	announcementRecipientUpdateCache          = make(map[string]updateCache)
+	announcementRecipientUpsertCacheMut       sync.RWMutex
+	announcementRecipientUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single announcementRecipient record from the query using the global executor.
+func (q announcementRecipientQuery) OneG(ctx context.Context) (*AnnouncementRecipient, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single announcementRecipient record from the query.
+func (q announcementRecipientQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AnnouncementRecipient, error) {
+	o := &AnnouncementRecipient{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for announcement_recipient")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AnnouncementRecipient records from the query using the global executor.
+func (q announcementRecipientQuery) AllG(ctx context.Context) (AnnouncementRecipientSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AnnouncementRecipient records from the query.
+func (q announcementRecipientQuery) All(ctx context.Context, exec boil.ContextExecutor) (AnnouncementRecipientSlice, error) {
+	var o []*AnnouncementRecipient
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AnnouncementRecipient slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AnnouncementRecipient records in the query, and panics on error.
+func (q announcementRecipientQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AnnouncementRecipient records in the query.
+func (q announcementRecipientQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count announcement_recipient rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q announcementRecipientQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q announcementRecipientQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if announcement_recipient exists")
+	}
+
+	return count > 0, nil
+}
+
+// Announcement pointed to by the foreign key.
+func (o *AnnouncementRecipient) Announcement(mods ...qm.QueryMod) announcementQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AnnouncementID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Announcements(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *AnnouncementRecipient) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadAnnouncement allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (announcementRecipientL) LoadAnnouncement(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnnouncementRecipient interface{}, mods queries.Applicator) error {
+	var slice []*AnnouncementRecipient
+	var object *AnnouncementRecipient
+
+	if singular {
+		object = maybeAnnouncementRecipient.(*AnnouncementRecipient)
+	} else {
+		slice = *maybeAnnouncementRecipient.(*[]*AnnouncementRecipient)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &announcementRecipientR{}
+		}
+		args = append(args, object.AnnouncementID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &announcementRecipientR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AnnouncementID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AnnouncementID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement`),
+		qm.WhereIn(`announcement.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Announcement")
+	}
+
+	var resultSlice []*Announcement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Announcement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for announcement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Announcement = foreign
+		if foreign.R == nil {
+			foreign.R = &announcementR{}
+		}
+		foreign.R.AnnouncementRecipients = append(foreign.R.AnnouncementRecipients, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AnnouncementID == foreign.ID {
+				local.R.Announcement = foreign
+				if foreign.R == nil {
+					foreign.R = &announcementR{}
+				}
+				foreign.R.AnnouncementRecipients = append(foreign.R.AnnouncementRecipients, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (announcementRecipientL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAnnouncementRecipient interface{}, mods queries.Applicator) error {
+	var slice []*AnnouncementRecipient
+	var object *AnnouncementRecipient
+
+	if singular {
+		object = maybeAnnouncementRecipient.(*AnnouncementRecipient)
+	} else {
+		slice = *maybeAnnouncementRecipient.(*[]*AnnouncementRecipient)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &announcementRecipientR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &announcementRecipientR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AnnouncementRecipients = append(foreign.R.AnnouncementRecipients, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AnnouncementRecipients = append(foreign.R.AnnouncementRecipients, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAnnouncementG of the announcementRecipient to the related item.
+// Sets o.R.Announcement to related.
+// Adds o to related.R.AnnouncementRecipients.
+// Uses the global database handle.
+func (o *AnnouncementRecipient) SetAnnouncementG(ctx context.Context, insert bool, related *Announcement) error {
+	return o.SetAnnouncement(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAnnouncement of the announcementRecipient to the related item.
+// Sets o.R.Announcement to related.
+// Adds o to related.R.AnnouncementRecipients.
+func (o *AnnouncementRecipient) SetAnnouncement(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Announcement) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"announcement_recipient\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"announcement_id"}),
+		strmangle.WhereClause("\"", "\"", 2, announcementRecipientPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AnnouncementID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AnnouncementID = related.ID
+	if o.R == nil {
+		o.R = &announcementRecipientR{
+			Announcement: related,
+		}
+	} else {
+		o.R.Announcement = related
+	}
+
+	if related.R == nil {
+		related.R = &announcementR{
+			AnnouncementRecipients: AnnouncementRecipientSlice{o},
+		}
+	} else {
+		related.R.AnnouncementRecipients = append(related.R.AnnouncementRecipients, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the announcementRecipient to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.AnnouncementRecipients.
+// Uses the global database handle.
+func (o *AnnouncementRecipient) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the announcementRecipient to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.AnnouncementRecipients.
+func (o *AnnouncementRecipient) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"announcement_recipient\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, announcementRecipientPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AnnouncementID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &announcementRecipientR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AnnouncementRecipients: AnnouncementRecipientSlice{o},
+		}
+	} else {
+		related.R.AnnouncementRecipients = append(related.R.AnnouncementRecipients, o)
+	}
+
+	return nil
+}
+
+// AnnouncementRecipients retrieves all the records using an executor.
+func AnnouncementRecipients(mods ...qm.QueryMod) announcementRecipientQuery {
+	mods = append(mods, qm.From("\"announcement_recipient\""))
+	return announcementRecipientQuery{NewQuery(mods...)}
+}
+
+// FindAnnouncementRecipientG retrieves a single record by ID.
+func FindAnnouncementRecipientG(ctx context.Context, announcementID string, userID string, selectCols ...string) (*AnnouncementRecipient, error) {
+	return FindAnnouncementRecipient(ctx, boil.GetContextDB(), announcementID, userID, selectCols...)
+}
+
+// FindAnnouncementRecipient retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAnnouncementRecipient(ctx context.Context, exec boil.ContextExecutor, announcementID string, userID string, selectCols ...string) (*AnnouncementRecipient, error) {
+	announcementRecipientObj := &AnnouncementRecipient{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"announcement_recipient\" where \"announcement_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, announcementID, userID)
+
+	err := q.Bind(ctx, exec, announcementRecipientObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from announcement_recipient")
+	}
+
+	return announcementRecipientObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AnnouncementRecipient) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AnnouncementRecipient) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no announcement_recipient provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(announcementRecipientColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	announcementRecipientInsertCacheMut.RLock()
+	cache, cached := announcementRecipientInsertCache[key]
+	announcementRecipientInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			announcementRecipientAllColumns,
+			announcementRecipientColumnsWithDefault,
+			announcementRecipientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"announcement_recipient\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"announcement_recipient\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into announcement_recipient")
+	}
+
+	if !cached {
+		announcementRecipientInsertCacheMut.Lock()
+		announcementRecipientInsertCache[key] = cache
+		announcementRecipientInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AnnouncementRecipient record using the global executor.
+// See Update for more documentation.
+func (o *AnnouncementRecipient) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AnnouncementRecipient.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AnnouncementRecipient) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	announcementRecipientUpdateCacheMut.RLock()
+	cache, cached := announcementRecipientUpdateCache[key]
+	announcementRecipientUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			announcementRecipientAllColumns,
+			announcementRecipientPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update announcement_recipient, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"announcement_recipient\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, announcementRecipientPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, append(wl, announcementRecipientPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update announcement_recipient row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for announcement_recipient")
+	}
+
+	if !cached {
+		announcementRecipientUpdateCacheMut.Lock()
+		announcementRecipientUpdateCache[key] = cache
+		announcementRecipientUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q announcementRecipientQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q announcementRecipientQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for announcement_recipient")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for announcement_recipient")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AnnouncementRecipientSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AnnouncementRecipientSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementRecipientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"announcement_recipient\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, announcementRecipientPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in announcementRecipient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all announcementRecipient")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AnnouncementRecipient) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AnnouncementRecipient) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no announcement_recipient provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(announcementRecipientColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	announcementRecipientUpsertCacheMut.RLock()
+	cache, cached := announcementRecipientUpsertCache[key]
+	announcementRecipientUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			announcementRecipientAllColumns,
+			announcementRecipientColumnsWithDefault,
+			announcementRecipientColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			announcementRecipientAllColumns,
+			announcementRecipientPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert announcement_recipient, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(announcementRecipientPrimaryKeyColumns))
+			copy(conflict, announcementRecipientPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"announcement_recipient\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(announcementRecipientType, announcementRecipientMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert announcement_recipient")
+	}
+
+	if !cached {
+		announcementRecipientUpsertCacheMut.Lock()
+		announcementRecipientUpsertCache[key] = cache
+		announcementRecipientUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AnnouncementRecipient record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AnnouncementRecipient) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single AnnouncementRecipient record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AnnouncementRecipient) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AnnouncementRecipient provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), announcementRecipientPrimaryKeyMapping)
+	sql := "DELETE FROM \"announcement_recipient\" WHERE \"announcement_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from announcement_recipient")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for announcement_recipient")
+	}
+
+	return rowsAff, nil
+}
+
+func (q announcementRecipientQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q announcementRecipientQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no announcementRecipientQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from announcement_recipient")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for announcement_recipient")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AnnouncementRecipientSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AnnouncementRecipientSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementRecipientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"announcement_recipient\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, announcementRecipientPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from announcementRecipient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for announcement_recipient")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AnnouncementRecipient) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no AnnouncementRecipient provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AnnouncementRecipient) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAnnouncementRecipient(ctx, exec, o.AnnouncementID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AnnouncementRecipientSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AnnouncementRecipientSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AnnouncementRecipientSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AnnouncementRecipientSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), announcementRecipientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"announcement_recipient\".* FROM \"announcement_recipient\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, announcementRecipientPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AnnouncementRecipientSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AnnouncementRecipientExistsG checks if the AnnouncementRecipient row exists.
+func AnnouncementRecipientExistsG(ctx context.Context, announcementID string, userID string) (bool, error) {
+	return AnnouncementRecipientExists(ctx, boil.GetContextDB(), announcementID, userID)
+}
+
+// AnnouncementRecipientExists checks if the AnnouncementRecipient row exists.
+func AnnouncementRecipientExists(ctx context.Context, exec boil.ContextExecutor, announcementID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"announcement_recipient\" where \"announcement_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, announcementID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, announcementID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if announcement_recipient exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAnnouncementRecipients(t *testing.T) {
+	t.Parallel()
+
+	query := AnnouncementRecipients()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAnnouncementRecipientsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementRecipientsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AnnouncementRecipients().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementRecipientsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementRecipientSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementRecipientsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AnnouncementRecipientExists(ctx, tx, o.AnnouncementID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if AnnouncementRecipient exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AnnouncementRecipientExists to return true, but got false.")
+	}
+}
+
+func testAnnouncementRecipientsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	announcementRecipientFound, err := FindAnnouncementRecipient(ctx, tx, o.AnnouncementID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if announcementRecipientFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAnnouncementRecipientsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AnnouncementRecipients().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementRecipientsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AnnouncementRecipients().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAnnouncementRecipientsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	announcementRecipientOne := &AnnouncementRecipient{}
+	announcementRecipientTwo := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, announcementRecipientOne, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementRecipientTwo, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementRecipientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementRecipientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AnnouncementRecipients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAnnouncementRecipientsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	announcementRecipientOne := &AnnouncementRecipient{}
+	announcementRecipientTwo := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, announcementRecipientOne, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementRecipientTwo, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementRecipientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementRecipientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAnnouncementRecipientsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementRecipientsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(announcementRecipientColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementRecipientToOneAnnouncementUsingAnnouncement(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AnnouncementRecipient
+	var foreign Announcement
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AnnouncementID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Announcement().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementRecipientSlice{&local}
+	if err = local.L.LoadAnnouncement(ctx, tx, false, (*[]*AnnouncementRecipient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Announcement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Announcement = nil
+	if err = local.L.LoadAnnouncement(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Announcement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementRecipientToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AnnouncementRecipient
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementRecipientSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*AnnouncementRecipient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementRecipientToOneSetOpAnnouncementUsingAnnouncement(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AnnouncementRecipient
+	var b, c Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementRecipientDBTypes, false, strmangle.SetComplement(announcementRecipientPrimaryKeyColumns, announcementRecipientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Announcement{&b, &c} {
+		err = a.SetAnnouncement(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Announcement != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AnnouncementRecipients[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AnnouncementID != x.ID {
+			t.Error("foreign key was wrong value", a.AnnouncementID)
+		}
+
+		if exists, err := AnnouncementRecipientExists(ctx, tx, a.AnnouncementID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testAnnouncementRecipientToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AnnouncementRecipient
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementRecipientDBTypes, false, strmangle.SetComplement(announcementRecipientPrimaryKeyColumns, announcementRecipientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AnnouncementRecipients[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := AnnouncementRecipientExists(ctx, tx, a.AnnouncementID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testAnnouncementRecipientsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementRecipientsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementRecipientSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementRecipientsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AnnouncementRecipients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	announcementRecipientDBTypes = map[string]string{`AnnouncementID`: `uuid`, `UserID`: `uuid`, `ReadAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                            = bytes.MinRead
+)
+
+func testAnnouncementRecipientsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(announcementRecipientPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(announcementRecipientAllColumns) == len(announcementRecipientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAnnouncementRecipientsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementRecipientAllColumns) == len(announcementRecipientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AnnouncementRecipient{}
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementRecipientDBTypes, true, announcementRecipientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(announcementRecipientAllColumns, announcementRecipientPrimaryKeyColumns) {
+		fields = announcementRecipientAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			announcementRecipientAllColumns,
+			announcementRecipientPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AnnouncementRecipientSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAnnouncementRecipientsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementRecipientAllColumns) == len(announcementRecipientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AnnouncementRecipient{}
+	if err = randomize.Struct(seed, &o, announcementRecipientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AnnouncementRecipient: %s", err)
+	}
+
+	count, err := AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, announcementRecipientDBTypes, false, announcementRecipientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AnnouncementRecipient struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AnnouncementRecipient: %s", err)
+	}
+
+	count, err = AnnouncementRecipients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
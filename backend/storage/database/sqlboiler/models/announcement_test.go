@@ -0,0 +1,1486 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAnnouncements(t *testing.T) {
+	t.Parallel()
+
+	query := Announcements()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAnnouncementsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Announcements().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAnnouncementsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AnnouncementExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Announcement exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AnnouncementExists to return true, but got false.")
+	}
+}
+
+func testAnnouncementsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	announcementFound, err := FindAnnouncement(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if announcementFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAnnouncementsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Announcements().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Announcements().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAnnouncementsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	announcementOne := &Announcement{}
+	announcementTwo := &Announcement{}
+	if err = randomize.Struct(seed, announcementOne, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementTwo, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Announcements().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAnnouncementsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	announcementOne := &Announcement{}
+	announcementTwo := &Announcement{}
+	if err = randomize.Struct(seed, announcementOne, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err = randomize.Struct(seed, announcementTwo, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = announcementOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = announcementTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAnnouncementsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(announcementColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAnnouncementToManyAnnouncementAttachments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c AnnouncementAttachment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, announcementAttachmentDBTypes, false, announcementAttachmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AnnouncementID = a.ID
+	c.AnnouncementID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AnnouncementAttachments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AnnouncementID == b.AnnouncementID {
+			bFound = true
+		}
+		if v.AnnouncementID == c.AnnouncementID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AnnouncementSlice{&a}
+	if err = a.L.LoadAnnouncementAttachments(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AnnouncementAttachments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AnnouncementAttachments = nil
+	if err = a.L.LoadAnnouncementAttachments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AnnouncementAttachments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAnnouncementToManyAnnouncementRecipients(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c AnnouncementRecipient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, announcementRecipientDBTypes, false, announcementRecipientColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AnnouncementID = a.ID
+	c.AnnouncementID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AnnouncementRecipients().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AnnouncementID == b.AnnouncementID {
+			bFound = true
+		}
+		if v.AnnouncementID == c.AnnouncementID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AnnouncementSlice{&a}
+	if err = a.L.LoadAnnouncementRecipients(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AnnouncementRecipients); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AnnouncementRecipients = nil
+	if err = a.L.LoadAnnouncementRecipients(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AnnouncementRecipients); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAnnouncementToManyAddOpAnnouncementAttachments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c, d, e AnnouncementAttachment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AnnouncementAttachment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, announcementAttachmentDBTypes, false, strmangle.SetComplement(announcementAttachmentPrimaryKeyColumns, announcementAttachmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AnnouncementAttachment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAnnouncementAttachments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AnnouncementID {
+			t.Error("foreign key was wrong value", a.ID, first.AnnouncementID)
+		}
+		if a.ID != second.AnnouncementID {
+			t.Error("foreign key was wrong value", a.ID, second.AnnouncementID)
+		}
+
+		if first.R.Announcement != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Announcement != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AnnouncementAttachments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AnnouncementAttachments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AnnouncementAttachments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testAnnouncementToManyAddOpAnnouncementRecipients(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c, d, e AnnouncementRecipient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AnnouncementRecipient{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, announcementRecipientDBTypes, false, strmangle.SetComplement(announcementRecipientPrimaryKeyColumns, announcementRecipientColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AnnouncementRecipient{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAnnouncementRecipients(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AnnouncementID {
+			t.Error("foreign key was wrong value", a.ID, first.AnnouncementID)
+		}
+		if a.ID != second.AnnouncementID {
+			t.Error("foreign key was wrong value", a.ID, second.AnnouncementID)
+		}
+
+		if first.R.Announcement != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Announcement != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AnnouncementRecipients[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AnnouncementRecipients[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AnnouncementRecipients().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testAnnouncementToOneUserUsingAuthor(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Announcement
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AuthorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Author().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementSlice{&local}
+	if err = local.L.LoadAuthor(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Author == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Author = nil
+	if err = local.L.LoadAuthor(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Author == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementToOneClassUsingClass(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Announcement
+	var foreign Class
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, classDBTypes, false, classColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Class struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ClassID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Class().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementSlice{&local}
+	if err = local.L.LoadClass(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Class = nil
+	if err = local.L.LoadClass(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementToOneDepartmentUsingDepartment(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Announcement
+	var foreign Department
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.DepartmentID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Department().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementSlice{&local}
+	if err = local.L.LoadDepartment(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Department == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Department = nil
+	if err = local.L.LoadDepartment(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Department == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Announcement
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SchoolID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AnnouncementSlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*Announcement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAnnouncementToOneSetOpUserUsingAuthor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetAuthor(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Author != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AuthorAnnouncements[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AuthorID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AuthorID))
+		reflect.Indirect(reflect.ValueOf(&a.AuthorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AuthorID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorID, x.ID)
+		}
+	}
+}
+
+func testAnnouncementToOneRemoveOpUserUsingAuthor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAuthor(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAuthor(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Author().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Author != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AuthorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AuthorAnnouncements) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAnnouncementToOneSetOpClassUsingClass(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Class{&b, &c} {
+		err = a.SetClass(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Class != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Announcements[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ClassID, x.ID) {
+			t.Error("foreign key was wrong value", a.ClassID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ClassID))
+		reflect.Indirect(reflect.ValueOf(&a.ClassID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ClassID, x.ID) {
+			t.Error("foreign key was wrong value", a.ClassID, x.ID)
+		}
+	}
+}
+
+func testAnnouncementToOneRemoveOpClassUsingClass(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetClass(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveClass(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Class().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Class != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ClassID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Announcements) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAnnouncementToOneSetOpDepartmentUsingDepartment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c Department
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Department{&b, &c} {
+		err = a.SetDepartment(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Department != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Announcements[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.DepartmentID, x.ID) {
+			t.Error("foreign key was wrong value", a.DepartmentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DepartmentID))
+		reflect.Indirect(reflect.ValueOf(&a.DepartmentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.DepartmentID, x.ID) {
+			t.Error("foreign key was wrong value", a.DepartmentID, x.ID)
+		}
+	}
+}
+
+func testAnnouncementToOneRemoveOpDepartmentUsingDepartment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b Department
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetDepartment(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveDepartment(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Department().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Department != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.DepartmentID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Announcements) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAnnouncementToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Announcement
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Announcements[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testAnnouncementsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AnnouncementSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAnnouncementsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Announcements().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	announcementDBTypes = map[string]string{`ID`: `uuid`, `SchoolID`: `uuid`, `DepartmentID`: `uuid`, `ClassID`: `uuid`, `AuthorID`: `uuid`, `Title`: `character varying`, `Body`: `text`, `EmailDigest`: `boolean`, `PublishAt`: `timestamp without time zone`, `ExpiresAt`: `timestamp without time zone`, `PublishedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`, `SendSMS`: `boolean`}
+	_                   = bytes.MinRead
+)
+
+func testAnnouncementsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(announcementPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(announcementAllColumns) == len(announcementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAnnouncementsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementAllColumns) == len(announcementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Announcement{}
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, announcementDBTypes, true, announcementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(announcementAllColumns, announcementPrimaryKeyColumns) {
+		fields = announcementAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			announcementAllColumns,
+			announcementPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AnnouncementSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAnnouncementsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(announcementAllColumns) == len(announcementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Announcement{}
+	if err = randomize.Struct(seed, &o, announcementDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Announcement: %s", err)
+	}
+
+	count, err := Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, announcementDBTypes, false, announcementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Announcement struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Announcement: %s", err)
+	}
+
+	count, err = Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1554 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Assessment is an object representing the database table.
+type Assessment struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CategoryID   string      `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	CourseworkID null.String `boil:"coursework_id" json:"coursework_id,omitempty" toml:"coursework_id" yaml:"coursework_id,omitempty"`
+	Title        string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	MaxScore     float64     `boil:"max_score" json:"max_score" toml:"max_score" yaml:"max_score"`
+	CreatedAt    null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt    null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *assessmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assessmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssessmentColumns = struct {
+	ID           string
+	CategoryID   string
+	CourseworkID string
+	Title        string
+	MaxScore     string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	CategoryID:   "category_id",
+	CourseworkID: "coursework_id",
+	Title:        "title",
+	MaxScore:     "max_score",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AssessmentWhere = struct {
+	ID           whereHelperstring
+	CategoryID   whereHelperstring
+	CourseworkID whereHelpernull_String
+	Title        whereHelperstring
+	MaxScore     whereHelperfloat64
+	CreatedAt    whereHelpernull_Time
+	UpdatedAt    whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"assessment\".\"id\""},
+	CategoryID:   whereHelperstring{field: "\"assessment\".\"category_id\""},
+	CourseworkID: whereHelpernull_String{field: "\"assessment\".\"coursework_id\""},
+	Title:        whereHelperstring{field: "\"assessment\".\"title\""},
+	MaxScore:     whereHelperfloat64{field: "\"assessment\".\"max_score\""},
+	CreatedAt:    whereHelpernull_Time{field: "\"assessment\".\"created_at\""},
+	UpdatedAt:    whereHelpernull_Time{field: "\"assessment\".\"updated_at\""},
+}
+
+// AssessmentRels is where relationship names are stored.
+var AssessmentRels = struct {
+	Category    string
+	Coursework  string
+	Marks       string
+	MarkImports string
+}{
+	Category:    "Category",
+	Coursework:  "Coursework",
+	Marks:       "Marks",
+	MarkImports: "MarkImports",
+}
+
+// assessmentR is where relationships are stored.
+type assessmentR struct {
+	Category    *MarkCategory   `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	Coursework  *Coursework     `boil:"Coursework" json:"Coursework" toml:"Coursework" yaml:"Coursework"`
+	Marks       MarkSlice       `boil:"Marks" json:"Marks" toml:"Marks" yaml:"Marks"`
+	MarkImports MarkImportSlice `boil:"MarkImports" json:"MarkImports" toml:"MarkImports" yaml:"MarkImports"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assessmentR) NewStruct() *assessmentR {
+	return &assessmentR{}
+}
+
+// assessmentL is where Load methods for each relationship are stored.
+type assessmentL struct{}
+
+var (
+	assessmentAllColumns            = []string{"id", "category_id", "coursework_id", "title", "max_score", "created_at", "updated_at"}
+	assessmentColumnsWithoutDefault = []string{"id", "category_id", "coursework_id", "title", "max_score", "created_at", "updated_at"}
+	assessmentColumnsWithDefault    = []string{}
+	assessmentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AssessmentSlice is an alias for a slice of pointers to Assessment.
+	// This should generally be used opposed to []Assessment.
+	AssessmentSlice []*Assessment
+
+	assessmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assessmentType                 = reflect.TypeOf(&Assessment{})
+	assessmentMapping              = queries.MakeStructMapping(assessmentType)
+	assessmentPrimaryKeyMapping, _ = queries.BindMapping(assessmentType, assessmentMapping, assessmentPrimaryKeyColumns)
+	assessmentInsertCacheMut       sync.RWMutex
+	assessmentInsertCache          = make(map[string]insertCache)
+	assessmentUpdateCacheMut       sync.RWMutex
+	assessmentUpdateCache          = make(map[string]updateCache)
+	assessmentUpsertCacheMut       sync.RWMutex
+	assessmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single assessment record from the query using the global executor.
+func (q assessmentQuery) OneG(ctx context.Context) (*Assessment, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single assessment record from the query.
+func (q assessmentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Assessment, error) {
+	o := &Assessment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for assessment")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Assessment records from the query using the global executor.
+func (q assessmentQuery) AllG(ctx context.Context) (AssessmentSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Assessment records from the query.
+func (q assessmentQuery) All(ctx context.Context, exec boil.ContextExecutor) (AssessmentSlice, error) {
+	var o []*Assessment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Assessment slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Assessment records in the query, and panics on error.
+func (q assessmentQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Assessment records in the query.
+func (q assessmentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count assessment rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q assessmentQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q assessmentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if assessment exists")
+	}
+
+	return count > 0, nil
+}
+
+// Category pointed to by the foreign key.
+func (o *Assessment) Category(mods ...qm.QueryMod) markCategoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := MarkCategories(queryMods...)
+	queries.SetFrom(query.Query, "\"mark_category\"")
+
+	return query
+}
+
+// Coursework pointed to by the foreign key.
+func (o *Assessment) Coursework(mods ...qm.QueryMod) courseworkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseworkID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courseworks(queryMods...)
+	queries.SetFrom(query.Query, "\"coursework\"")
+
+	return query
+}
+
+// Marks retrieves all the mark's Marks with an executor.
+func (o *Assessment) Marks(mods ...qm.QueryMod) markQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mark\".\"assessment_id\"=?", o.ID),
+	)
+
+	query := Marks(queryMods...)
+	queries.SetFrom(query.Query, "\"mark\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"mark\".*"})
+	}
+
+	return query
+}
+
+// MarkImports retrieves all the mark_import's MarkImports with an executor.
+func (o *Assessment) MarkImports(mods ...qm.QueryMod) markImportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mark_import\".\"assessment_id\"=?", o.ID),
+	)
+
+	query := MarkImports(queryMods...)
+	queries.SetFrom(query.Query, "\"mark_import\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"mark_import\".*"})
+	}
+
+	return query
+}
+
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assessmentL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAssessment interface{}, mods queries.Applicator) error {
+	var slice []*Assessment
+	var object *Assessment
+
+	if singular {
+		object = maybeAssessment.(*Assessment)
+	} else {
+		slice = *maybeAssessment.(*[]*Assessment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assessmentR{}
+		}
+		args = append(args, object.CategoryID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assessmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CategoryID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CategoryID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark_category`),
+		qm.WhereIn(`mark_category.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MarkCategory")
+	}
+
+	var resultSlice []*MarkCategory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MarkCategory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for mark_category")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark_category")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &markCategoryR{}
+		}
+		foreign.R.CategoryAssessments = append(foreign.R.CategoryAssessments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CategoryID == foreign.ID {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &markCategoryR{}
+				}
+				foreign.R.CategoryAssessments = append(foreign.R.CategoryAssessments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCoursework allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assessmentL) LoadCoursework(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAssessment interface{}, mods queries.Applicator) error {
+	var slice []*Assessment
+	var object *Assessment
+
+	if singular {
+		object = maybeAssessment.(*Assessment)
+	} else {
+		slice = *maybeAssessment.(*[]*Assessment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assessmentR{}
+		}
+		if !queries.IsNil(object.CourseworkID) {
+			args = append(args, object.CourseworkID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assessmentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CourseworkID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CourseworkID) {
+				args = append(args, obj.CourseworkID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`coursework`),
+		qm.WhereIn(`coursework.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Coursework")
+	}
+
+	var resultSlice []*Coursework
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Coursework")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for coursework")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coursework")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Coursework = foreign
+		if foreign.R == nil {
+			foreign.R = &courseworkR{}
+		}
+		foreign.R.Assessments = append(foreign.R.Assessments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CourseworkID, foreign.ID) {
+				local.R.Coursework = foreign
+				if foreign.R == nil {
+					foreign.R = &courseworkR{}
+				}
+				foreign.R.Assessments = append(foreign.R.Assessments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMarks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (assessmentL) LoadMarks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAssessment interface{}, mods queries.Applicator) error {
+	var slice []*Assessment
+	var object *Assessment
+
+	if singular {
+		object = maybeAssessment.(*Assessment)
+	} else {
+		slice = *maybeAssessment.(*[]*Assessment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assessmentR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assessmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark`),
+		qm.WhereIn(`mark.assessment_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mark")
+	}
+
+	var resultSlice []*Mark
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mark")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mark")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark")
+	}
+
+	if singular {
+		object.R.Marks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &markR{}
+			}
+			foreign.R.Assessment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AssessmentID {
+				local.R.Marks = append(local.R.Marks, foreign)
+				if foreign.R == nil {
+					foreign.R = &markR{}
+				}
+				foreign.R.Assessment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMarkImports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (assessmentL) LoadMarkImports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAssessment interface{}, mods queries.Applicator) error {
+	var slice []*Assessment
+	var object *Assessment
+
+	if singular {
+		object = maybeAssessment.(*Assessment)
+	} else {
+		slice = *maybeAssessment.(*[]*Assessment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assessmentR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assessmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark_import`),
+		qm.WhereIn(`mark_import.assessment_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mark_import")
+	}
+
+	var resultSlice []*MarkImport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mark_import")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mark_import")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark_import")
+	}
+
+	if singular {
+		object.R.MarkImports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &markImportR{}
+			}
+			foreign.R.Assessment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AssessmentID {
+				local.R.MarkImports = append(local.R.MarkImports, foreign)
+				if foreign.R == nil {
+					foreign.R = &markImportR{}
+				}
+				foreign.R.Assessment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCategoryG of the assessment to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryAssessments.
+// Uses the global database handle.
+func (o *Assessment) SetCategoryG(ctx context.Context, insert bool, related *MarkCategory) error {
+	return o.SetCategory(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCategory of the assessment to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryAssessments.
+func (o *Assessment) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MarkCategory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"assessment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assessmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CategoryID = related.ID
+	if o.R == nil {
+		o.R = &assessmentR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &markCategoryR{
+			CategoryAssessments: AssessmentSlice{o},
+		}
+	} else {
+		related.R.CategoryAssessments = append(related.R.CategoryAssessments, o)
+	}
+
+	return nil
+}
+
+// SetCourseworkG of the assessment to the related item.
+// Sets o.R.Coursework to related.
+// Adds o to related.R.Assessments.
+// Uses the global database handle.
+func (o *Assessment) SetCourseworkG(ctx context.Context, insert bool, related *Coursework) error {
+	return o.SetCoursework(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCoursework of the assessment to the related item.
+// Sets o.R.Coursework to related.
+// Adds o to related.R.Assessments.
+func (o *Assessment) SetCoursework(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Coursework) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"assessment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"coursework_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assessmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CourseworkID, related.ID)
+	if o.R == nil {
+		o.R = &assessmentR{
+			Coursework: related,
+		}
+	} else {
+		o.R.Coursework = related
+	}
+
+	if related.R == nil {
+		related.R = &courseworkR{
+			Assessments: AssessmentSlice{o},
+		}
+	} else {
+		related.R.Assessments = append(related.R.Assessments, o)
+	}
+
+	return nil
+}
+
+// RemoveCourseworkG relationship.
+// Sets o.R.Coursework to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Assessment) RemoveCourseworkG(ctx context.Context, related *Coursework) error {
+	return o.RemoveCoursework(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveCoursework relationship.
+// Sets o.R.Coursework to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Assessment) RemoveCoursework(ctx context.Context, exec boil.ContextExecutor, related *Coursework) error {
+	var err error
+
+	queries.SetScanner(&o.CourseworkID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("coursework_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Coursework = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Assessments {
+		if queries.Equal(o.CourseworkID, ri.CourseworkID) {
+			continue
+		}
+
+		ln := len(related.R.Assessments)
+		if ln > 1 && i < ln-1 {
+			related.R.Assessments[i] = related.R.Assessments[ln-1]
+		}
+		related.R.Assessments = related.R.Assessments[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddMarksG adds the given related objects to the existing relationships
+// of the assessment, optionally inserting them as new records.
+// Appends related to o.R.Marks.
+// Sets related.R.Assessment appropriately.
+// Uses the global database handle.
+func (o *Assessment) AddMarksG(ctx context.Context, insert bool, related ...*Mark) error {
+	return o.AddMarks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMarks adds the given related objects to the existing relationships
+// of the assessment, optionally inserting them as new records.
+// Appends related to o.R.Marks.
+// Sets related.R.Assessment appropriately.
+func (o *Assessment) AddMarks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mark) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AssessmentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mark\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assessment_id"}),
+				strmangle.WhereClause("\"", "\"", 2, markPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AssessmentID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AssessmentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &assessmentR{
+			Marks: related,
+		}
+	} else {
+		o.R.Marks = append(o.R.Marks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &markR{
+				Assessment: o,
+			}
+		} else {
+			rel.R.Assessment = o
+		}
+	}
+	return nil
+}
+
+// AddMarkImportsG adds the given related objects to the existing relationships
+// of the assessment, optionally inserting them as new records.
+// Appends related to o.R.MarkImports.
+// Sets related.R.Assessment appropriately.
+// Uses the global database handle.
+func (o *Assessment) AddMarkImportsG(ctx context.Context, insert bool, related ...*MarkImport) error {
+	return o.AddMarkImports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMarkImports adds the given related objects to the existing relationships
+// of the assessment, optionally inserting them as new records.
+// Appends related to o.R.MarkImports.
+// Sets related.R.Assessment appropriately.
+func (o *Assessment) AddMarkImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MarkImport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AssessmentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mark_import\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assessment_id"}),
+				strmangle.WhereClause("\"", "\"", 2, markImportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AssessmentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &assessmentR{
+			MarkImports: related,
+		}
+	} else {
+		o.R.MarkImports = append(o.R.MarkImports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &markImportR{
+				Assessment: o,
+			}
+		} else {
+			rel.R.Assessment = o
+		}
+	}
+	return nil
+}
+
+// Assessments retrieves all the records using an executor.
+func Assessments(mods ...qm.QueryMod) assessmentQuery {
+	mods = append(mods, qm.From("\"assessment\""))
+	return assessmentQuery{NewQuery(mods...)}
+}
+
+// FindAssessmentG retrieves a single record by ID.
+func FindAssessmentG(ctx context.Context, iD string, selectCols ...string) (*Assessment, error) {
+	return FindAssessment(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAssessment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssessment(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Assessment, error) {
+	assessmentObj := &Assessment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"assessment\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, assessmentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from assessment")
+	}
+
+	return assessmentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Assessment) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Assessment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no assessment provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assessmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assessmentInsertCacheMut.RLock()
+	cache, cached := assessmentInsertCache[key]
+	assessmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assessmentAllColumns,
+			assessmentColumnsWithDefault,
+			assessmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assessmentType, assessmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assessmentType, assessmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"assessment\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"assessment\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into assessment")
+	}
+
+	if !cached {
+		assessmentInsertCacheMut.Lock()
+		assessmentInsertCache[key] = cache
+		assessmentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Assessment record using the global executor.
+// See Update for more documentation.
+func (o *Assessment) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Assessment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Assessment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	assessmentUpdateCacheMut.RLock()
+	cache, cached := assessmentUpdateCache[key]
+	assessmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assessmentAllColumns,
+			assessmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update assessment, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"assessment\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assessmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assessmentType, assessmentMapping, append(wl, assessmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update assessment row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for assessment")
+	}
+
+	if !cached {
+		assessmentUpdateCacheMut.Lock()
+		assessmentUpdateCache[key] = cache
+		assessmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q assessmentQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assessmentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for assessment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for assessment")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AssessmentSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssessmentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assessmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"assessment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assessmentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in assessment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all assessment")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Assessment) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Assessment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no assessment provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assessmentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assessmentUpsertCacheMut.RLock()
+	cache, cached := assessmentUpsertCache[key]
+	assessmentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assessmentAllColumns,
+			assessmentColumnsWithDefault,
+			assessmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			assessmentAllColumns,
+			assessmentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert assessment, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assessmentPrimaryKeyColumns))
+			copy(conflict, assessmentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"assessment\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assessmentType, assessmentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assessmentType, assessmentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert assessment")
+	}
+
+	if !cached {
+		assessmentUpsertCacheMut.Lock()
+		assessmentUpsertCache[key] = cache
+		assessmentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Assessment record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Assessment) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Assessment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Assessment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Assessment provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assessmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"assessment\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from assessment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for assessment")
+	}
+
+	return rowsAff, nil
+}
+
+func (q assessmentQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q assessmentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no assessmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from assessment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for assessment")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AssessmentSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssessmentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assessmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"assessment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assessmentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from assessment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for assessment")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Assessment) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Assessment provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Assessment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAssessment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssessmentSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AssessmentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssessmentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssessmentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assessmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"assessment\".* FROM \"assessment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assessmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AssessmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssessmentExistsG checks if the Assessment row exists.
+func AssessmentExistsG(ctx context.Context, iD string) (bool, error) {
+	return AssessmentExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AssessmentExists checks if the Assessment row exists.
+func AssessmentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"assessment\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if assessment exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1165 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAssessments(t *testing.T) {
+	t.Parallel()
+
+	query := Assessments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAssessmentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAssessmentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Assessments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAssessmentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AssessmentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAssessmentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AssessmentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Assessment exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AssessmentExists to return true, but got false.")
+	}
+}
+
+func testAssessmentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	assessmentFound, err := FindAssessment(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if assessmentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAssessmentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Assessments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAssessmentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Assessments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAssessmentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	assessmentOne := &Assessment{}
+	assessmentTwo := &Assessment{}
+	if err = randomize.Struct(seed, assessmentOne, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, assessmentTwo, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = assessmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = assessmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Assessments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAssessmentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	assessmentOne := &Assessment{}
+	assessmentTwo := &Assessment{}
+	if err = randomize.Struct(seed, assessmentOne, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, assessmentTwo, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = assessmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = assessmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAssessmentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAssessmentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(assessmentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAssessmentToManyMarks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c Mark
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AssessmentID = a.ID
+	c.AssessmentID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Marks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AssessmentID == b.AssessmentID {
+			bFound = true
+		}
+		if v.AssessmentID == c.AssessmentID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AssessmentSlice{&a}
+	if err = a.L.LoadMarks(ctx, tx, false, (*[]*Assessment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Marks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Marks = nil
+	if err = a.L.LoadMarks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Marks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAssessmentToManyMarkImports(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c MarkImport
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.AssessmentID = a.ID
+	c.AssessmentID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MarkImports().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.AssessmentID == b.AssessmentID {
+			bFound = true
+		}
+		if v.AssessmentID == c.AssessmentID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AssessmentSlice{&a}
+	if err = a.L.LoadMarkImports(ctx, tx, false, (*[]*Assessment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MarkImports); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MarkImports = nil
+	if err = a.L.LoadMarkImports(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MarkImports); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAssessmentToManyAddOpMarks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c, d, e Mark
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Mark{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, markDBTypes, false, strmangle.SetComplement(markPrimaryKeyColumns, markColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Mark{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMarks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AssessmentID {
+			t.Error("foreign key was wrong value", a.ID, first.AssessmentID)
+		}
+		if a.ID != second.AssessmentID {
+			t.Error("foreign key was wrong value", a.ID, second.AssessmentID)
+		}
+
+		if first.R.Assessment != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Assessment != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Marks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Marks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Marks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testAssessmentToManyAddOpMarkImports(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c, d, e MarkImport
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MarkImport{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, markImportDBTypes, false, strmangle.SetComplement(markImportPrimaryKeyColumns, markImportColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MarkImport{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMarkImports(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.AssessmentID {
+			t.Error("foreign key was wrong value", a.ID, first.AssessmentID)
+		}
+		if a.ID != second.AssessmentID {
+			t.Error("foreign key was wrong value", a.ID, second.AssessmentID)
+		}
+
+		if first.R.Assessment != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Assessment != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MarkImports[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MarkImports[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MarkImports().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testAssessmentToOneMarkCategoryUsingCategory(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Assessment
+	var foreign MarkCategory
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CategoryID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Category().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AssessmentSlice{&local}
+	if err = local.L.LoadCategory(ctx, tx, false, (*[]*Assessment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Category = nil
+	if err = local.L.LoadCategory(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAssessmentToOneCourseworkUsingCoursework(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Assessment
+	var foreign Coursework
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CourseworkID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Coursework().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AssessmentSlice{&local}
+	if err = local.L.LoadCoursework(ctx, tx, false, (*[]*Assessment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Coursework == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Coursework = nil
+	if err = local.L.LoadCoursework(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Coursework == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAssessmentToOneSetOpMarkCategoryUsingCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c MarkCategory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*MarkCategory{&b, &c} {
+		err = a.SetCategory(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Category != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CategoryAssessments[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CategoryID != x.ID {
+			t.Error("foreign key was wrong value", a.CategoryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CategoryID))
+		reflect.Indirect(reflect.ValueOf(&a.CategoryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CategoryID != x.ID {
+			t.Error("foreign key was wrong value", a.CategoryID, x.ID)
+		}
+	}
+}
+func testAssessmentToOneSetOpCourseworkUsingCoursework(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b, c Coursework
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Coursework{&b, &c} {
+		err = a.SetCoursework(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Coursework != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Assessments[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CourseworkID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseworkID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseworkID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseworkID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CourseworkID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseworkID, x.ID)
+		}
+	}
+}
+
+func testAssessmentToOneRemoveOpCourseworkUsingCoursework(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Assessment
+	var b Coursework
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCoursework(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCoursework(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Coursework().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Coursework != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CourseworkID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Assessments) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAssessmentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAssessmentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AssessmentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAssessmentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Assessments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	assessmentDBTypes = map[string]string{`ID`: `uuid`, `CategoryID`: `uuid`, `CourseworkID`: `uuid`, `Title`: `character varying`, `MaxScore`: `double precision`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testAssessmentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(assessmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(assessmentAllColumns) == len(assessmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAssessmentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(assessmentAllColumns) == len(assessmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Assessment{}
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, assessmentDBTypes, true, assessmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(assessmentAllColumns, assessmentPrimaryKeyColumns) {
+		fields = assessmentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			assessmentAllColumns,
+			assessmentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AssessmentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAssessmentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(assessmentAllColumns) == len(assessmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Assessment{}
+	if err = randomize.Struct(seed, &o, assessmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Assessment: %s", err)
+	}
+
+	count, err := Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, assessmentDBTypes, false, assessmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Assessment: %s", err)
+	}
+
+	count, err = Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
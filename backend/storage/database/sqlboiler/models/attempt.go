@@ -0,0 +1,1213 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Attempt is an object representing the database table.
+type Attempt struct {
+	ID           string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CourseworkID string       `boil:"coursework_id" json:"coursework_id" toml:"coursework_id" yaml:"coursework_id"`
+	StudentID    string       `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	Seed         int64        `boil:"seed" json:"seed" toml:"seed" yaml:"seed"`
+	Questions    types.JSON   `boil:"questions" json:"questions" toml:"questions" yaml:"questions"`
+	Answers      null.JSON    `boil:"answers" json:"answers,omitempty" toml:"answers" yaml:"answers,omitempty"`
+	Score        null.Float64 `boil:"score" json:"score,omitempty" toml:"score" yaml:"score,omitempty"`
+	MaxScore     null.Float64 `boil:"max_score" json:"max_score,omitempty" toml:"max_score" yaml:"max_score,omitempty"`
+	Counted      null.Bool    `boil:"counted" json:"counted,omitempty" toml:"counted" yaml:"counted,omitempty"`
+	StartedAt    null.Time    `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	SubmittedAt  null.Time    `boil:"submitted_at" json:"submitted_at,omitempty" toml:"submitted_at" yaml:"submitted_at,omitempty"`
+
+	R *attemptR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L attemptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AttemptColumns = struct {
+	ID           string
+	CourseworkID string
+	StudentID    string
+	Seed         string
+	Questions    string
+	Answers      string
+	Score        string
+	MaxScore     string
+	Counted      string
+	StartedAt    string
+	SubmittedAt  string
+}{
+	ID:           "id",
+	CourseworkID: "coursework_id",
+	StudentID:    "student_id",
+	Seed:         "seed",
+	Questions:    "questions",
+	Answers:      "answers",
+	Score:        "score",
+	MaxScore:     "max_score",
+	Counted:      "counted",
+	StartedAt:    "started_at",
+	SubmittedAt:  "submitted_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Bool struct{ field string }
+
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AttemptWhere = struct {
+	ID           whereHelperstring
+	CourseworkID whereHelperstring
+	StudentID    whereHelperstring
+	Seed         whereHelperint64
+	Questions    whereHelpertypes_JSON
+	Answers      whereHelpernull_JSON
+	Score        whereHelpernull_Float64
+	MaxScore     whereHelpernull_Float64
+	Counted      whereHelpernull_Bool
+	StartedAt    whereHelpernull_Time
+	SubmittedAt  whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"attempt\".\"id\""},
+	CourseworkID: whereHelperstring{field: "\"attempt\".\"coursework_id\""},
+	StudentID:    whereHelperstring{field: "\"attempt\".\"student_id\""},
+	Seed:         whereHelperint64{field: "\"attempt\".\"seed\""},
+	Questions:    whereHelpertypes_JSON{field: "\"attempt\".\"questions\""},
+	Answers:      whereHelpernull_JSON{field: "\"attempt\".\"answers\""},
+	Score:        whereHelpernull_Float64{field: "\"attempt\".\"score\""},
+	MaxScore:     whereHelpernull_Float64{field: "\"attempt\".\"max_score\""},
+	Counted:      whereHelpernull_Bool{field: "\"attempt\".\"counted\""},
+	StartedAt:    whereHelpernull_Time{field: "\"attempt\".\"started_at\""},
+	SubmittedAt:  whereHelpernull_Time{field: "\"attempt\".\"submitted_at\""},
+}
+
+// AttemptRels is where relationship names are stored.
+var AttemptRels = struct {
+	Coursework string
+	Student    string
+}{
+	Coursework: "Coursework",
+	Student:    "Student",
+}
+
+// attemptR is where relationships are stored.
+type attemptR struct {
+	Coursework *Coursework `boil:"Coursework" json:"Coursework" toml:"Coursework" yaml:"Coursework"`
+	Student    *User       `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*attemptR) NewStruct() *attemptR {
+	return &attemptR{}
+}
+
+// attemptL is where Load methods for each relationship are stored.
+type attemptL struct{}
+
+var (
+	attemptAllColumns            = []string{"id", "coursework_id", "student_id", "seed", "questions", "answers", "score", "max_score", "counted", "started_at", "submitted_at"}
+	attemptColumnsWithoutDefault = []string{"id", "coursework_id", "student_id", "seed", "questions", "answers", "score", "max_score", "counted", "started_at", "submitted_at"}
+	attemptColumnsWithDefault    = []string{}
+	attemptPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AttemptSlice is an alias for a slice of pointers to Attempt.
+	// This should generally be used opposed to []Attempt.
+	AttemptSlice []*Attempt
+
+	attemptQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	attemptType                 = reflect.TypeOf(&Attempt{})
+	attemptMapping              = queries.MakeStructMapping(attemptType)
+	attemptPrimaryKeyMapping, _ = queries.BindMapping(attemptType, attemptMapping, attemptPrimaryKeyColumns)
+	attemptInsertCacheMut       sync.RWMutex
+	attemptInsertCache          = make(map[string]insertCache)
+	attemptUpdateCacheMut       sync.RWMutex
+	attemptUpdateCache          = make(map[string]updateCache)
+	attemptUpsertCacheMut       sync.RWMutex
+	attemptUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single attempt record from the query using the global executor.
+func (q attemptQuery) OneG(ctx context.Context) (*Attempt, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single attempt record from the query.
+func (q attemptQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Attempt, error) {
+	o := &Attempt{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for attempt")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Attempt records from the query using the global executor.
+func (q attemptQuery) AllG(ctx context.Context) (AttemptSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Attempt records from the query.
+func (q attemptQuery) All(ctx context.Context, exec boil.ContextExecutor) (AttemptSlice, error) {
+	var o []*Attempt
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Attempt slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Attempt records in the query, and panics on error.
+func (q attemptQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Attempt records in the query.
+func (q attemptQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count attempt rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q attemptQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q attemptQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if attempt exists")
+	}
+
+	return count > 0, nil
+}
+
+// Coursework pointed to by the foreign key.
+func (o *Attempt) Coursework(mods ...qm.QueryMod) courseworkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseworkID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courseworks(queryMods...)
+	queries.SetFrom(query.Query, "\"coursework\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *Attempt) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadCoursework allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attemptL) LoadCoursework(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttempt interface{}, mods queries.Applicator) error {
+	var slice []*Attempt
+	var object *Attempt
+
+	if singular {
+		object = maybeAttempt.(*Attempt)
+	} else {
+		slice = *maybeAttempt.(*[]*Attempt)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attemptR{}
+		}
+		args = append(args, object.CourseworkID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attemptR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseworkID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseworkID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`coursework`),
+		qm.WhereIn(`coursework.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Coursework")
+	}
+
+	var resultSlice []*Coursework
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Coursework")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for coursework")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coursework")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Coursework = foreign
+		if foreign.R == nil {
+			foreign.R = &courseworkR{}
+		}
+		foreign.R.Attempts = append(foreign.R.Attempts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseworkID == foreign.ID {
+				local.R.Coursework = foreign
+				if foreign.R == nil {
+					foreign.R = &courseworkR{}
+				}
+				foreign.R.Attempts = append(foreign.R.Attempts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attemptL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttempt interface{}, mods queries.Applicator) error {
+	var slice []*Attempt
+	var object *Attempt
+
+	if singular {
+		object = maybeAttempt.(*Attempt)
+	} else {
+		slice = *maybeAttempt.(*[]*Attempt)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attemptR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attemptR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentAttempts = append(foreign.R.StudentAttempts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentAttempts = append(foreign.R.StudentAttempts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseworkG of the attempt to the related item.
+// Sets o.R.Coursework to related.
+// Adds o to related.R.Attempts.
+// Uses the global database handle.
+func (o *Attempt) SetCourseworkG(ctx context.Context, insert bool, related *Coursework) error {
+	return o.SetCoursework(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCoursework of the attempt to the related item.
+// Sets o.R.Coursework to related.
+// Adds o to related.R.Attempts.
+func (o *Attempt) SetCoursework(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Coursework) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attempt\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"coursework_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attemptPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseworkID = related.ID
+	if o.R == nil {
+		o.R = &attemptR{
+			Coursework: related,
+		}
+	} else {
+		o.R.Coursework = related
+	}
+
+	if related.R == nil {
+		related.R = &courseworkR{
+			Attempts: AttemptSlice{o},
+		}
+	} else {
+		related.R.Attempts = append(related.R.Attempts, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the attempt to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentAttempts.
+// Uses the global database handle.
+func (o *Attempt) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the attempt to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentAttempts.
+func (o *Attempt) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attempt\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attemptPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &attemptR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentAttempts: AttemptSlice{o},
+		}
+	} else {
+		related.R.StudentAttempts = append(related.R.StudentAttempts, o)
+	}
+
+	return nil
+}
+
+// Attempts retrieves all the records using an executor.
+func Attempts(mods ...qm.QueryMod) attemptQuery {
+	mods = append(mods, qm.From("\"attempt\""))
+	return attemptQuery{NewQuery(mods...)}
+}
+
+// FindAttemptG retrieves a single record by ID.
+func FindAttemptG(ctx context.Context, iD string, selectCols ...string) (*Attempt, error) {
+	return FindAttempt(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAttempt retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAttempt(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Attempt, error) {
+	attemptObj := &Attempt{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"attempt\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, attemptObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from attempt")
+	}
+
+	return attemptObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Attempt) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Attempt) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attempt provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(attemptColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	attemptInsertCacheMut.RLock()
+	cache, cached := attemptInsertCache[key]
+	attemptInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			attemptAllColumns,
+			attemptColumnsWithDefault,
+			attemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(attemptType, attemptMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(attemptType, attemptMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"attempt\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"attempt\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into attempt")
+	}
+
+	if !cached {
+		attemptInsertCacheMut.Lock()
+		attemptInsertCache[key] = cache
+		attemptInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Attempt record using the global executor.
+// See Update for more documentation.
+func (o *Attempt) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Attempt.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Attempt) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	attemptUpdateCacheMut.RLock()
+	cache, cached := attemptUpdateCache[key]
+	attemptUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			attemptAllColumns,
+			attemptPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update attempt, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"attempt\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, attemptPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(attemptType, attemptMapping, append(wl, attemptPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update attempt row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for attempt")
+	}
+
+	if !cached {
+		attemptUpdateCacheMut.Lock()
+		attemptUpdateCache[key] = cache
+		attemptUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q attemptQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q attemptQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for attempt")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for attempt")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AttemptSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AttemptSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"attempt\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, attemptPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in attempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all attempt")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Attempt) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Attempt) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attempt provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attemptColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	attemptUpsertCacheMut.RLock()
+	cache, cached := attemptUpsertCache[key]
+	attemptUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			attemptAllColumns,
+			attemptColumnsWithDefault,
+			attemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			attemptAllColumns,
+			attemptPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert attempt, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(attemptPrimaryKeyColumns))
+			copy(conflict, attemptPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"attempt\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(attemptType, attemptMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(attemptType, attemptMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert attempt")
+	}
+
+	if !cached {
+		attemptUpsertCacheMut.Lock()
+		attemptUpsertCache[key] = cache
+		attemptUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Attempt record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Attempt) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Attempt record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Attempt) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Attempt provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attemptPrimaryKeyMapping)
+	sql := "DELETE FROM \"attempt\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from attempt")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for attempt")
+	}
+
+	return rowsAff, nil
+}
+
+func (q attemptQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q attemptQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no attemptQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attempt")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attempt")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AttemptSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AttemptSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"attempt\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attemptPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attempt")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Attempt) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Attempt provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Attempt) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAttempt(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttemptSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AttemptSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttemptSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AttemptSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"attempt\".* FROM \"attempt\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attemptPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AttemptSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AttemptExistsG checks if the Attempt row exists.
+func AttemptExistsG(ctx context.Context, iD string) (bool, error) {
+	return AttemptExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AttemptExists checks if the Attempt row exists.
+func AttemptExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"attempt\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if attempt exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,808 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAttempts(t *testing.T) {
+	t.Parallel()
+
+	query := Attempts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAttemptsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttemptsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Attempts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttemptsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttemptSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttemptsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AttemptExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Attempt exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AttemptExists to return true, but got false.")
+	}
+}
+
+func testAttemptsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	attemptFound, err := FindAttempt(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if attemptFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAttemptsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Attempts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttemptsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Attempts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAttemptsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	attemptOne := &Attempt{}
+	attemptTwo := &Attempt{}
+	if err = randomize.Struct(seed, attemptOne, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attemptTwo, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attemptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attemptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Attempts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAttemptsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	attemptOne := &Attempt{}
+	attemptTwo := &Attempt{}
+	if err = randomize.Struct(seed, attemptOne, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attemptTwo, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attemptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attemptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAttemptsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttemptsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(attemptColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttemptToOneCourseworkUsingCoursework(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Attempt
+	var foreign Coursework
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CourseworkID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Coursework().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttemptSlice{&local}
+	if err = local.L.LoadCoursework(ctx, tx, false, (*[]*Attempt)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Coursework == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Coursework = nil
+	if err = local.L.LoadCoursework(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Coursework == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttemptToOneUserUsingStudent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Attempt
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.StudentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Student().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttemptSlice{&local}
+	if err = local.L.LoadStudent(ctx, tx, false, (*[]*Attempt)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Student = nil
+	if err = local.L.LoadStudent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttemptToOneSetOpCourseworkUsingCoursework(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Attempt
+	var b, c Coursework
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attemptDBTypes, false, strmangle.SetComplement(attemptPrimaryKeyColumns, attemptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Coursework{&b, &c} {
+		err = a.SetCoursework(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Coursework != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Attempts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CourseworkID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseworkID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseworkID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseworkID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CourseworkID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseworkID, x.ID)
+		}
+	}
+}
+func testAttemptToOneSetOpUserUsingStudent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Attempt
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attemptDBTypes, false, strmangle.SetComplement(attemptPrimaryKeyColumns, attemptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetStudent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Student != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.StudentAttempts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.StudentID != x.ID {
+			t.Error("foreign key was wrong value", a.StudentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.StudentID))
+		reflect.Indirect(reflect.ValueOf(&a.StudentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.StudentID != x.ID {
+			t.Error("foreign key was wrong value", a.StudentID, x.ID)
+		}
+	}
+}
+
+func testAttemptsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttemptsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttemptSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttemptsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Attempts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	attemptDBTypes = map[string]string{`ID`: `uuid`, `CourseworkID`: `uuid`, `StudentID`: `uuid`, `Seed`: `bigint`, `Questions`: `jsonb`, `Answers`: `jsonb`, `Score`: `double precision`, `MaxScore`: `double precision`, `Counted`: `boolean`, `StartedAt`: `timestamp without time zone`, `SubmittedAt`: `timestamp without time zone`}
+	_              = bytes.MinRead
+)
+
+func testAttemptsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(attemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(attemptAllColumns) == len(attemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAttemptsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(attemptAllColumns) == len(attemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Attempt{}
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attemptDBTypes, true, attemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(attemptAllColumns, attemptPrimaryKeyColumns) {
+		fields = attemptAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			attemptAllColumns,
+			attemptPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AttemptSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAttemptsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(attemptAllColumns) == len(attemptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Attempt{}
+	if err = randomize.Struct(seed, &o, attemptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Attempt: %s", err)
+	}
+
+	count, err := Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, attemptDBTypes, false, attemptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Attempt struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Attempt: %s", err)
+	}
+
+	count, err = Attempts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
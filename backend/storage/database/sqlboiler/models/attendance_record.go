@@ -0,0 +1,1121 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AttendanceRecord is an object representing the database table.
+type AttendanceRecord struct {
+	SessionID string    `boil:"session_id" json:"session_id" toml:"session_id" yaml:"session_id"`
+	StudentID string    `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	Status    string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Note      string    `boil:"note" json:"note" toml:"note" yaml:"note"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *attendanceRecordR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L attendanceRecordL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AttendanceRecordColumns = struct {
+	SessionID string
+	StudentID string
+	Status    string
+	Note      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	SessionID: "session_id",
+	StudentID: "student_id",
+	Status:    "status",
+	Note:      "note",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var AttendanceRecordWhere = struct {
+	SessionID whereHelperstring
+	StudentID whereHelperstring
+	Status    whereHelperstring
+	Note      whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	SessionID: whereHelperstring{field: "\"attendance_record\".\"session_id\""},
+	StudentID: whereHelperstring{field: "\"attendance_record\".\"student_id\""},
+	Status:    whereHelperstring{field: "\"attendance_record\".\"status\""},
+	Note:      whereHelperstring{field: "\"attendance_record\".\"note\""},
+	CreatedAt: whereHelpernull_Time{field: "\"attendance_record\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"attendance_record\".\"updated_at\""},
+}
+
+// AttendanceRecordRels is where relationship names are stored.
+var AttendanceRecordRels = struct {
+	Session string
+	Student string
+}{
+	Session: "Session",
+	Student: "Student",
+}
+
+// attendanceRecordR is where relationships are stored.
+type attendanceRecordR struct {
+	Session *AttendanceSession `boil:"Session" json:"Session" toml:"Session" yaml:"Session"`
+	Student *User              `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*attendanceRecordR) NewStruct() *attendanceRecordR {
+	return &attendanceRecordR{}
+}
+
+// attendanceRecordL is where Load methods for each relationship are stored.
+type attendanceRecordL struct{}
+
+var (
+	attendanceRecordAllColumns            = []string{"session_id", "student_id", "status", "note", "created_at", "updated_at"}
+	attendanceRecordColumnsWithoutDefault = []string{"session_id", "student_id", "status", "created_at", "updated_at"}
+	attendanceRecordColumnsWithDefault    = []string{"note"}
+	attendanceRecordPrimaryKeyColumns     = []string{"session_id", "student_id"}
+)
+
+type (
+	// AttendanceRecordSlice is an alias for a slice of pointers to AttendanceRecord.
+	// This should generally be used opposed to []AttendanceRecord.
+	AttendanceRecordSlice []*AttendanceRecord
+
+	attendanceRecordQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	attendanceRecordType                 = reflect.TypeOf(&AttendanceRecord{})
+	attendanceRecordMapping              = queries.MakeStructMapping(attendanceRecordType)
+	attendanceRecordPrimaryKeyMapping, _ = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, attendanceRecordPrimaryKeyColumns)
+	attendanceRecordInsertCacheMut       sync.RWMutex
+	attendanceRecordInsertCache          = make(map[string]insertCache)
+	attendanceRecordUpdateCacheMut       sync.RWMutex
+	attendanceRecordUpdateCache          = make(map[string]updateCache)
+	attendanceRecordUpsertCacheMut       sync.RWMutex
+	attendanceRecordUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single attendanceRecord record from the query using the global executor.
+func (q attendanceRecordQuery) OneG(ctx context.Context) (*AttendanceRecord, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single attendanceRecord record from the query.
+func (q attendanceRecordQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AttendanceRecord, error) {
+	o := &AttendanceRecord{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for attendance_record")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AttendanceRecord records from the query using the global executor.
+func (q attendanceRecordQuery) AllG(ctx context.Context) (AttendanceRecordSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AttendanceRecord records from the query.
+func (q attendanceRecordQuery) All(ctx context.Context, exec boil.ContextExecutor) (AttendanceRecordSlice, error) {
+	var o []*AttendanceRecord
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AttendanceRecord slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AttendanceRecord records in the query, and panics on error.
+func (q attendanceRecordQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AttendanceRecord records in the query.
+func (q attendanceRecordQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count attendance_record rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q attendanceRecordQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q attendanceRecordQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if attendance_record exists")
+	}
+
+	return count > 0, nil
+}
+
+// Session pointed to by the foreign key.
+func (o *AttendanceRecord) Session(mods ...qm.QueryMod) attendanceSessionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SessionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AttendanceSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_session\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *AttendanceRecord) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadSession allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attendanceRecordL) LoadSession(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceRecord interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceRecord
+	var object *AttendanceRecord
+
+	if singular {
+		object = maybeAttendanceRecord.(*AttendanceRecord)
+	} else {
+		slice = *maybeAttendanceRecord.(*[]*AttendanceRecord)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceRecordR{}
+		}
+		args = append(args, object.SessionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceRecordR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SessionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SessionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_session`),
+		qm.WhereIn(`attendance_session.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AttendanceSession")
+	}
+
+	var resultSlice []*AttendanceSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AttendanceSession")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for attendance_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_session")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Session = foreign
+		if foreign.R == nil {
+			foreign.R = &attendanceSessionR{}
+		}
+		foreign.R.SessionAttendanceRecords = append(foreign.R.SessionAttendanceRecords, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SessionID == foreign.ID {
+				local.R.Session = foreign
+				if foreign.R == nil {
+					foreign.R = &attendanceSessionR{}
+				}
+				foreign.R.SessionAttendanceRecords = append(foreign.R.SessionAttendanceRecords, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attendanceRecordL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceRecord interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceRecord
+	var object *AttendanceRecord
+
+	if singular {
+		object = maybeAttendanceRecord.(*AttendanceRecord)
+	} else {
+		slice = *maybeAttendanceRecord.(*[]*AttendanceRecord)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceRecordR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceRecordR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentAttendanceRecords = append(foreign.R.StudentAttendanceRecords, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentAttendanceRecords = append(foreign.R.StudentAttendanceRecords, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSessionG of the attendanceRecord to the related item.
+// Sets o.R.Session to related.
+// Adds o to related.R.SessionAttendanceRecords.
+// Uses the global database handle.
+func (o *AttendanceRecord) SetSessionG(ctx context.Context, insert bool, related *AttendanceSession) error {
+	return o.SetSession(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSession of the attendanceRecord to the related item.
+// Sets o.R.Session to related.
+// Adds o to related.R.SessionAttendanceRecords.
+func (o *AttendanceRecord) SetSession(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AttendanceSession) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attendance_record\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"session_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attendanceRecordPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SessionID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SessionID = related.ID
+	if o.R == nil {
+		o.R = &attendanceRecordR{
+			Session: related,
+		}
+	} else {
+		o.R.Session = related
+	}
+
+	if related.R == nil {
+		related.R = &attendanceSessionR{
+			SessionAttendanceRecords: AttendanceRecordSlice{o},
+		}
+	} else {
+		related.R.SessionAttendanceRecords = append(related.R.SessionAttendanceRecords, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the attendanceRecord to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentAttendanceRecords.
+// Uses the global database handle.
+func (o *AttendanceRecord) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the attendanceRecord to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentAttendanceRecords.
+func (o *AttendanceRecord) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attendance_record\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attendanceRecordPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SessionID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &attendanceRecordR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentAttendanceRecords: AttendanceRecordSlice{o},
+		}
+	} else {
+		related.R.StudentAttendanceRecords = append(related.R.StudentAttendanceRecords, o)
+	}
+
+	return nil
+}
+
+// AttendanceRecords retrieves all the records using an executor.
+func AttendanceRecords(mods ...qm.QueryMod) attendanceRecordQuery {
+	mods = append(mods, qm.From("\"attendance_record\""))
+	return attendanceRecordQuery{NewQuery(mods...)}
+}
+
+// FindAttendanceRecordG retrieves a single record by ID.
+func FindAttendanceRecordG(ctx context.Context, sessionID string, studentID string, selectCols ...string) (*AttendanceRecord, error) {
+	return FindAttendanceRecord(ctx, boil.GetContextDB(), sessionID, studentID, selectCols...)
+}
+
+// FindAttendanceRecord retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAttendanceRecord(ctx context.Context, exec boil.ContextExecutor, sessionID string, studentID string, selectCols ...string) (*AttendanceRecord, error) {
+	attendanceRecordObj := &AttendanceRecord{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"attendance_record\" where \"session_id\"=$1 AND \"student_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, sessionID, studentID)
+
+	err := q.Bind(ctx, exec, attendanceRecordObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from attendance_record")
+	}
+
+	return attendanceRecordObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AttendanceRecord) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AttendanceRecord) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attendance_record provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attendanceRecordColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	attendanceRecordInsertCacheMut.RLock()
+	cache, cached := attendanceRecordInsertCache[key]
+	attendanceRecordInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			attendanceRecordAllColumns,
+			attendanceRecordColumnsWithDefault,
+			attendanceRecordColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"attendance_record\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"attendance_record\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into attendance_record")
+	}
+
+	if !cached {
+		attendanceRecordInsertCacheMut.Lock()
+		attendanceRecordInsertCache[key] = cache
+		attendanceRecordInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AttendanceRecord record using the global executor.
+// See Update for more documentation.
+func (o *AttendanceRecord) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AttendanceRecord.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AttendanceRecord) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	attendanceRecordUpdateCacheMut.RLock()
+	cache, cached := attendanceRecordUpdateCache[key]
+	attendanceRecordUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			attendanceRecordAllColumns,
+			attendanceRecordPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update attendance_record, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"attendance_record\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, attendanceRecordPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, append(wl, attendanceRecordPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update attendance_record row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for attendance_record")
+	}
+
+	if !cached {
+		attendanceRecordUpdateCacheMut.Lock()
+		attendanceRecordUpdateCache[key] = cache
+		attendanceRecordUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q attendanceRecordQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q attendanceRecordQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for attendance_record")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for attendance_record")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AttendanceRecordSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AttendanceRecordSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"attendance_record\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, attendanceRecordPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in attendanceRecord slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all attendanceRecord")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AttendanceRecord) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AttendanceRecord) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attendance_record provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attendanceRecordColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	attendanceRecordUpsertCacheMut.RLock()
+	cache, cached := attendanceRecordUpsertCache[key]
+	attendanceRecordUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			attendanceRecordAllColumns,
+			attendanceRecordColumnsWithDefault,
+			attendanceRecordColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			attendanceRecordAllColumns,
+			attendanceRecordPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert attendance_record, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(attendanceRecordPrimaryKeyColumns))
+			copy(conflict, attendanceRecordPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"attendance_record\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(attendanceRecordType, attendanceRecordMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert attendance_record")
+	}
+
+	if !cached {
+		attendanceRecordUpsertCacheMut.Lock()
+		attendanceRecordUpsertCache[key] = cache
+		attendanceRecordUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AttendanceRecord record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AttendanceRecord) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single AttendanceRecord record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AttendanceRecord) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AttendanceRecord provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attendanceRecordPrimaryKeyMapping)
+	sql := "DELETE FROM \"attendance_record\" WHERE \"session_id\"=$1 AND \"student_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from attendance_record")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for attendance_record")
+	}
+
+	return rowsAff, nil
+}
+
+func (q attendanceRecordQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q attendanceRecordQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no attendanceRecordQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attendance_record")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attendance_record")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AttendanceRecordSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AttendanceRecordSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"attendance_record\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attendanceRecordPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attendanceRecord slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attendance_record")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AttendanceRecord) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no AttendanceRecord provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AttendanceRecord) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAttendanceRecord(ctx, exec, o.SessionID, o.StudentID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttendanceRecordSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AttendanceRecordSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttendanceRecordSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AttendanceRecordSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"attendance_record\".* FROM \"attendance_record\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attendanceRecordPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AttendanceRecordSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AttendanceRecordExistsG checks if the AttendanceRecord row exists.
+func AttendanceRecordExistsG(ctx context.Context, sessionID string, studentID string) (bool, error) {
+	return AttendanceRecordExists(ctx, boil.GetContextDB(), sessionID, studentID)
+}
+
+// AttendanceRecordExists checks if the AttendanceRecord row exists.
+func AttendanceRecordExists(ctx context.Context, exec boil.ContextExecutor, sessionID string, studentID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"attendance_record\" where \"session_id\"=$1 AND \"student_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, sessionID, studentID)
+	}
+	row := exec.QueryRowContext(ctx, sql, sessionID, studentID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if attendance_record exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAttendanceRecords(t *testing.T) {
+	t.Parallel()
+
+	query := AttendanceRecords()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAttendanceRecordsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceRecordsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AttendanceRecords().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceRecordsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttendanceRecordSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceRecordsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AttendanceRecordExists(ctx, tx, o.SessionID, o.StudentID)
+	if err != nil {
+		t.Errorf("Unable to check if AttendanceRecord exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AttendanceRecordExists to return true, but got false.")
+	}
+}
+
+func testAttendanceRecordsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	attendanceRecordFound, err := FindAttendanceRecord(ctx, tx, o.SessionID, o.StudentID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if attendanceRecordFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAttendanceRecordsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AttendanceRecords().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceRecordsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AttendanceRecords().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAttendanceRecordsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	attendanceRecordOne := &AttendanceRecord{}
+	attendanceRecordTwo := &AttendanceRecord{}
+	if err = randomize.Struct(seed, attendanceRecordOne, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attendanceRecordTwo, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attendanceRecordOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attendanceRecordTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AttendanceRecords().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAttendanceRecordsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	attendanceRecordOne := &AttendanceRecord{}
+	attendanceRecordTwo := &AttendanceRecord{}
+	if err = randomize.Struct(seed, attendanceRecordOne, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attendanceRecordTwo, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attendanceRecordOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attendanceRecordTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAttendanceRecordsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttendanceRecordsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(attendanceRecordColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttendanceRecordToOneAttendanceSessionUsingSession(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AttendanceRecord
+	var foreign AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SessionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Session().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttendanceRecordSlice{&local}
+	if err = local.L.LoadSession(ctx, tx, false, (*[]*AttendanceRecord)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Session == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Session = nil
+	if err = local.L.LoadSession(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Session == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttendanceRecordToOneUserUsingStudent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AttendanceRecord
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.StudentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Student().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttendanceRecordSlice{&local}
+	if err = local.L.LoadStudent(ctx, tx, false, (*[]*AttendanceRecord)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Student = nil
+	if err = local.L.LoadStudent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttendanceRecordToOneSetOpAttendanceSessionUsingSession(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceRecord
+	var b, c AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceRecordDBTypes, false, strmangle.SetComplement(attendanceRecordPrimaryKeyColumns, attendanceRecordColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*AttendanceSession{&b, &c} {
+		err = a.SetSession(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Session != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SessionAttendanceRecords[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SessionID != x.ID {
+			t.Error("foreign key was wrong value", a.SessionID)
+		}
+
+		if exists, err := AttendanceRecordExists(ctx, tx, a.SessionID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testAttendanceRecordToOneSetOpUserUsingStudent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceRecord
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceRecordDBTypes, false, strmangle.SetComplement(attendanceRecordPrimaryKeyColumns, attendanceRecordColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetStudent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Student != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.StudentAttendanceRecords[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.StudentID != x.ID {
+			t.Error("foreign key was wrong value", a.StudentID)
+		}
+
+		if exists, err := AttendanceRecordExists(ctx, tx, a.SessionID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testAttendanceRecordsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceRecordsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttendanceRecordSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceRecordsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AttendanceRecords().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	attendanceRecordDBTypes = map[string]string{`SessionID`: `uuid`, `StudentID`: `uuid`, `Status`: `character varying`, `Note`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                       = bytes.MinRead
+)
+
+func testAttendanceRecordsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(attendanceRecordPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(attendanceRecordAllColumns) == len(attendanceRecordPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAttendanceRecordsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(attendanceRecordAllColumns) == len(attendanceRecordPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceRecord{}
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attendanceRecordDBTypes, true, attendanceRecordPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(attendanceRecordAllColumns, attendanceRecordPrimaryKeyColumns) {
+		fields = attendanceRecordAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			attendanceRecordAllColumns,
+			attendanceRecordPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AttendanceRecordSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAttendanceRecordsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(attendanceRecordAllColumns) == len(attendanceRecordPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AttendanceRecord{}
+	if err = randomize.Struct(seed, &o, attendanceRecordDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AttendanceRecord: %s", err)
+	}
+
+	count, err := AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, attendanceRecordDBTypes, false, attendanceRecordPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceRecord struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AttendanceRecord: %s", err)
+	}
+
+	count, err = AttendanceRecords().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1561 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AttendanceSession is an object representing the database table.
+type AttendanceSession struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ClassID   string      `boil:"class_id" json:"class_id" toml:"class_id" yaml:"class_id"`
+	CourseID  null.String `boil:"course_id" json:"course_id,omitempty" toml:"course_id" yaml:"course_id,omitempty"`
+	Date      time.Time   `boil:"date" json:"date" toml:"date" yaml:"date"`
+	TakenByID null.String `boil:"taken_by_id" json:"taken_by_id,omitempty" toml:"taken_by_id" yaml:"taken_by_id,omitempty"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *attendanceSessionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L attendanceSessionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AttendanceSessionColumns = struct {
+	ID        string
+	ClassID   string
+	CourseID  string
+	Date      string
+	TakenByID string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	ClassID:   "class_id",
+	CourseID:  "course_id",
+	Date:      "date",
+	TakenByID: "taken_by_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var AttendanceSessionWhere = struct {
+	ID        whereHelperstring
+	ClassID   whereHelperstring
+	CourseID  whereHelpernull_String
+	Date      whereHelpertime_Time
+	TakenByID whereHelpernull_String
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"attendance_session\".\"id\""},
+	ClassID:   whereHelperstring{field: "\"attendance_session\".\"class_id\""},
+	CourseID:  whereHelpernull_String{field: "\"attendance_session\".\"course_id\""},
+	Date:      whereHelpertime_Time{field: "\"attendance_session\".\"date\""},
+	TakenByID: whereHelpernull_String{field: "\"attendance_session\".\"taken_by_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"attendance_session\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"attendance_session\".\"updated_at\""},
+}
+
+// AttendanceSessionRels is where relationship names are stored.
+var AttendanceSessionRels = struct {
+	Class                    string
+	Course                   string
+	TakenBy                  string
+	SessionAttendanceRecords string
+}{
+	Class:                    "Class",
+	Course:                   "Course",
+	TakenBy:                  "TakenBy",
+	SessionAttendanceRecords: "SessionAttendanceRecords",
+}
+
+// attendanceSessionR is where relationships are stored.
+type attendanceSessionR struct {
+	Class                    *Class                `boil:"Class" json:"Class" toml:"Class" yaml:"Class"`
+	Course                   *Course               `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	TakenBy                  *User                 `boil:"TakenBy" json:"TakenBy" toml:"TakenBy" yaml:"TakenBy"`
+	SessionAttendanceRecords AttendanceRecordSlice `boil:"SessionAttendanceRecords" json:"SessionAttendanceRecords" toml:"SessionAttendanceRecords" yaml:"SessionAttendanceRecords"`
+}
+
+// NewStruct creates a new relationship struct
+func (*attendanceSessionR) NewStruct() *attendanceSessionR {
+	return &attendanceSessionR{}
+}
+
+// attendanceSessionL is where Load methods for each relationship are stored.
+type attendanceSessionL struct{}
+
+var (
+	attendanceSessionAllColumns            = []string{"id", "class_id", "course_id", "date", "taken_by_id", "created_at", "updated_at"}
+	attendanceSessionColumnsWithoutDefault = []string{"id", "class_id", "course_id", "date", "taken_by_id", "created_at", "updated_at"}
+	attendanceSessionColumnsWithDefault    = []string{}
+	attendanceSessionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AttendanceSessionSlice is an alias for a slice of pointers to AttendanceSession.
+	// This should generally be used opposed to []AttendanceSession.
+	AttendanceSessionSlice []*AttendanceSession
+
+	attendanceSessionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	attendanceSessionType                 = reflect.TypeOf(&AttendanceSession{})
+	attendanceSessionMapping              = queries.MakeStructMapping(attendanceSessionType)
+	attendanceSessionPrimaryKeyMapping, _ = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, attendanceSessionPrimaryKeyColumns)
+	attendanceSessionInsertCacheMut       sync.RWMutex
+	attendanceSessionInsertCache          = make(map[string]insertCache)
+	attendanceSessionUpdateCacheMut       sync.RWMutex
+	attendanceSessionUpdateCache          = make(map[string]updateCache)
+	attendanceSessionUpsertCacheMut       sync.RWMutex
+	attendanceSessionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single attendanceSession record from the query using the global executor.
+func (q attendanceSessionQuery) OneG(ctx context.Context) (*AttendanceSession, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single attendanceSession record from the query.
+func (q attendanceSessionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AttendanceSession, error) {
+	o := &AttendanceSession{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for attendance_session")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AttendanceSession records from the query using the global executor.
+func (q attendanceSessionQuery) AllG(ctx context.Context) (AttendanceSessionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AttendanceSession records from the query.
+func (q attendanceSessionQuery) All(ctx context.Context, exec boil.ContextExecutor) (AttendanceSessionSlice, error) {
+	var o []*AttendanceSession
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AttendanceSession slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AttendanceSession records in the query, and panics on error.
+func (q attendanceSessionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AttendanceSession records in the query.
+func (q attendanceSessionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count attendance_session rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q attendanceSessionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q attendanceSessionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if attendance_session exists")
+	}
+
+	return count > 0, nil
+}
+
+// Class pointed to by the foreign key.
+func (o *AttendanceSession) Class(mods ...qm.QueryMod) classQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClassID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Classes(queryMods...)
+	queries.SetFrom(query.Query, "\"class\"")
+
+	return query
+}
+
+// Course pointed to by the foreign key.
+func (o *AttendanceSession) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// TakenBy pointed to by the foreign key.
+func (o *AttendanceSession) TakenBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TakenByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// SessionAttendanceRecords retrieves all the attendance_record's AttendanceRecords with an executor via session_id column.
+func (o *AttendanceSession) SessionAttendanceRecords(mods ...qm.QueryMod) attendanceRecordQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attendance_record\".\"session_id\"=?", o.ID),
+	)
+
+	query := AttendanceRecords(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_record\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attendance_record\".*"})
+	}
+
+	return query
+}
+
+// LoadClass allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attendanceSessionL) LoadClass(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceSession interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceSession
+	var object *AttendanceSession
+
+	if singular {
+		object = maybeAttendanceSession.(*AttendanceSession)
+	} else {
+		slice = *maybeAttendanceSession.(*[]*AttendanceSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceSessionR{}
+		}
+		args = append(args, object.ClassID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ClassID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClassID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class`),
+		qm.WhereIn(`class.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Class")
+	}
+
+	var resultSlice []*Class
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Class")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Class = foreign
+		if foreign.R == nil {
+			foreign.R = &classR{}
+		}
+		foreign.R.AttendanceSessions = append(foreign.R.AttendanceSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ClassID == foreign.ID {
+				local.R.Class = foreign
+				if foreign.R == nil {
+					foreign.R = &classR{}
+				}
+				foreign.R.AttendanceSessions = append(foreign.R.AttendanceSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attendanceSessionL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceSession interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceSession
+	var object *AttendanceSession
+
+	if singular {
+		object = maybeAttendanceSession.(*AttendanceSession)
+	} else {
+		slice = *maybeAttendanceSession.(*[]*AttendanceSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceSessionR{}
+		}
+		if !queries.IsNil(object.CourseID) {
+			args = append(args, object.CourseID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceSessionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CourseID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CourseID) {
+				args = append(args, obj.CourseID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.AttendanceSessions = append(foreign.R.AttendanceSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CourseID, foreign.ID) {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.AttendanceSessions = append(foreign.R.AttendanceSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTakenBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attendanceSessionL) LoadTakenBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceSession interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceSession
+	var object *AttendanceSession
+
+	if singular {
+		object = maybeAttendanceSession.(*AttendanceSession)
+	} else {
+		slice = *maybeAttendanceSession.(*[]*AttendanceSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceSessionR{}
+		}
+		if !queries.IsNil(object.TakenByID) {
+			args = append(args, object.TakenByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceSessionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.TakenByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.TakenByID) {
+				args = append(args, obj.TakenByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TakenBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TakenByAttendanceSessions = append(foreign.R.TakenByAttendanceSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TakenByID, foreign.ID) {
+				local.R.TakenBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TakenByAttendanceSessions = append(foreign.R.TakenByAttendanceSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessionAttendanceRecords allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (attendanceSessionL) LoadSessionAttendanceRecords(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttendanceSession interface{}, mods queries.Applicator) error {
+	var slice []*AttendanceSession
+	var object *AttendanceSession
+
+	if singular {
+		object = maybeAttendanceSession.(*AttendanceSession)
+	} else {
+		slice = *maybeAttendanceSession.(*[]*AttendanceSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attendanceSessionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attendanceSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_record`),
+		qm.WhereIn(`attendance_record.session_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attendance_record")
+	}
+
+	var resultSlice []*AttendanceRecord
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attendance_record")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attendance_record")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_record")
+	}
+
+	if singular {
+		object.R.SessionAttendanceRecords = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attendanceRecordR{}
+			}
+			foreign.R.Session = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SessionID {
+				local.R.SessionAttendanceRecords = append(local.R.SessionAttendanceRecords, foreign)
+				if foreign.R == nil {
+					foreign.R = &attendanceRecordR{}
+				}
+				foreign.R.Session = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClassG of the attendanceSession to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.AttendanceSessions.
+// Uses the global database handle.
+func (o *AttendanceSession) SetClassG(ctx context.Context, insert bool, related *Class) error {
+	return o.SetClass(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetClass of the attendanceSession to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.AttendanceSessions.
+func (o *AttendanceSession) SetClass(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Class) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attendance_session\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ClassID = related.ID
+	if o.R == nil {
+		o.R = &attendanceSessionR{
+			Class: related,
+		}
+	} else {
+		o.R.Class = related
+	}
+
+	if related.R == nil {
+		related.R = &classR{
+			AttendanceSessions: AttendanceSessionSlice{o},
+		}
+	} else {
+		related.R.AttendanceSessions = append(related.R.AttendanceSessions, o)
+	}
+
+	return nil
+}
+
+// SetCourseG of the attendanceSession to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.AttendanceSessions.
+// Uses the global database handle.
+func (o *AttendanceSession) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the attendanceSession to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.AttendanceSessions.
+func (o *AttendanceSession) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attendance_session\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CourseID, related.ID)
+	if o.R == nil {
+		o.R = &attendanceSessionR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			AttendanceSessions: AttendanceSessionSlice{o},
+		}
+	} else {
+		related.R.AttendanceSessions = append(related.R.AttendanceSessions, o)
+	}
+
+	return nil
+}
+
+// RemoveCourseG relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *AttendanceSession) RemoveCourseG(ctx context.Context, related *Course) error {
+	return o.RemoveCourse(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveCourse relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AttendanceSession) RemoveCourse(ctx context.Context, exec boil.ContextExecutor, related *Course) error {
+	var err error
+
+	queries.SetScanner(&o.CourseID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Course = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AttendanceSessions {
+		if queries.Equal(o.CourseID, ri.CourseID) {
+			continue
+		}
+
+		ln := len(related.R.AttendanceSessions)
+		if ln > 1 && i < ln-1 {
+			related.R.AttendanceSessions[i] = related.R.AttendanceSessions[ln-1]
+		}
+		related.R.AttendanceSessions = related.R.AttendanceSessions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTakenByG of the attendanceSession to the related item.
+// Sets o.R.TakenBy to related.
+// Adds o to related.R.TakenByAttendanceSessions.
+// Uses the global database handle.
+func (o *AttendanceSession) SetTakenByG(ctx context.Context, insert bool, related *User) error {
+	return o.SetTakenBy(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTakenBy of the attendanceSession to the related item.
+// Sets o.R.TakenBy to related.
+// Adds o to related.R.TakenByAttendanceSessions.
+func (o *AttendanceSession) SetTakenBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attendance_session\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"taken_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TakenByID, related.ID)
+	if o.R == nil {
+		o.R = &attendanceSessionR{
+			TakenBy: related,
+		}
+	} else {
+		o.R.TakenBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TakenByAttendanceSessions: AttendanceSessionSlice{o},
+		}
+	} else {
+		related.R.TakenByAttendanceSessions = append(related.R.TakenByAttendanceSessions, o)
+	}
+
+	return nil
+}
+
+// RemoveTakenByG relationship.
+// Sets o.R.TakenBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *AttendanceSession) RemoveTakenByG(ctx context.Context, related *User) error {
+	return o.RemoveTakenBy(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTakenBy relationship.
+// Sets o.R.TakenBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AttendanceSession) RemoveTakenBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.TakenByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("taken_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TakenBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TakenByAttendanceSessions {
+		if queries.Equal(o.TakenByID, ri.TakenByID) {
+			continue
+		}
+
+		ln := len(related.R.TakenByAttendanceSessions)
+		if ln > 1 && i < ln-1 {
+			related.R.TakenByAttendanceSessions[i] = related.R.TakenByAttendanceSessions[ln-1]
+		}
+		related.R.TakenByAttendanceSessions = related.R.TakenByAttendanceSessions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddSessionAttendanceRecordsG adds the given related objects to the existing relationships
+// of the attendance_session, optionally inserting them as new records.
+// Appends related to o.R.SessionAttendanceRecords.
+// Sets related.R.Session appropriately.
+// Uses the global database handle.
+func (o *AttendanceSession) AddSessionAttendanceRecordsG(ctx context.Context, insert bool, related ...*AttendanceRecord) error {
+	return o.AddSessionAttendanceRecords(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSessionAttendanceRecords adds the given related objects to the existing relationships
+// of the attendance_session, optionally inserting them as new records.
+// Appends related to o.R.SessionAttendanceRecords.
+// Sets related.R.Session appropriately.
+func (o *AttendanceSession) AddSessionAttendanceRecords(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceRecord) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SessionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attendance_record\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"session_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attendanceRecordPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SessionID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SessionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &attendanceSessionR{
+			SessionAttendanceRecords: related,
+		}
+	} else {
+		o.R.SessionAttendanceRecords = append(o.R.SessionAttendanceRecords, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attendanceRecordR{
+				Session: o,
+			}
+		} else {
+			rel.R.Session = o
+		}
+	}
+	return nil
+}
+
+// AttendanceSessions retrieves all the records using an executor.
+func AttendanceSessions(mods ...qm.QueryMod) attendanceSessionQuery {
+	mods = append(mods, qm.From("\"attendance_session\""))
+	return attendanceSessionQuery{NewQuery(mods...)}
+}
+
+// FindAttendanceSessionG retrieves a single record by ID.
+func FindAttendanceSessionG(ctx context.Context, iD string, selectCols ...string) (*AttendanceSession, error) {
+	return FindAttendanceSession(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAttendanceSession retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAttendanceSession(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AttendanceSession, error) {
+	attendanceSessionObj := &AttendanceSession{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"attendance_session\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, attendanceSessionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from attendance_session")
+	}
+
+	return attendanceSessionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AttendanceSession) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AttendanceSession) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attendance_session provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attendanceSessionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	attendanceSessionInsertCacheMut.RLock()
+	cache, cached := attendanceSessionInsertCache[key]
+	attendanceSessionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			attendanceSessionAllColumns,
+			attendanceSessionColumnsWithDefault,
+			attendanceSessionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"attendance_session\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"attendance_session\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into attendance_session")
+	}
+
+	if !cached {
+		attendanceSessionInsertCacheMut.Lock()
+		attendanceSessionInsertCache[key] = cache
+		attendanceSessionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AttendanceSession record using the global executor.
+// See Update for more documentation.
+func (o *AttendanceSession) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AttendanceSession.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AttendanceSession) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	attendanceSessionUpdateCacheMut.RLock()
+	cache, cached := attendanceSessionUpdateCache[key]
+	attendanceSessionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			attendanceSessionAllColumns,
+			attendanceSessionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update attendance_session, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"attendance_session\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, attendanceSessionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, append(wl, attendanceSessionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update attendance_session row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for attendance_session")
+	}
+
+	if !cached {
+		attendanceSessionUpdateCacheMut.Lock()
+		attendanceSessionUpdateCache[key] = cache
+		attendanceSessionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q attendanceSessionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q attendanceSessionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for attendance_session")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for attendance_session")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AttendanceSessionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AttendanceSessionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"attendance_session\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, attendanceSessionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in attendanceSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all attendanceSession")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AttendanceSession) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AttendanceSession) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attendance_session provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attendanceSessionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	attendanceSessionUpsertCacheMut.RLock()
+	cache, cached := attendanceSessionUpsertCache[key]
+	attendanceSessionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			attendanceSessionAllColumns,
+			attendanceSessionColumnsWithDefault,
+			attendanceSessionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			attendanceSessionAllColumns,
+			attendanceSessionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert attendance_session, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(attendanceSessionPrimaryKeyColumns))
+			copy(conflict, attendanceSessionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"attendance_session\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(attendanceSessionType, attendanceSessionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert attendance_session")
+	}
+
+	if !cached {
+		attendanceSessionUpsertCacheMut.Lock()
+		attendanceSessionUpsertCache[key] = cache
+		attendanceSessionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AttendanceSession record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AttendanceSession) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single AttendanceSession record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AttendanceSession) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AttendanceSession provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attendanceSessionPrimaryKeyMapping)
+	sql := "DELETE FROM \"attendance_session\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from attendance_session")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for attendance_session")
+	}
+
+	return rowsAff, nil
+}
+
+func (q attendanceSessionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q attendanceSessionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no attendanceSessionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attendance_session")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attendance_session")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AttendanceSessionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AttendanceSessionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"attendance_session\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attendanceSessionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attendanceSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attendance_session")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AttendanceSession) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no AttendanceSession provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AttendanceSession) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAttendanceSession(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttendanceSessionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty AttendanceSessionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttendanceSessionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AttendanceSessionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attendanceSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"attendance_session\".* FROM \"attendance_session\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attendanceSessionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AttendanceSessionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AttendanceSessionExistsG checks if the AttendanceSession row exists.
+func AttendanceSessionExistsG(ctx context.Context, iD string) (bool, error) {
+	return AttendanceSessionExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AttendanceSessionExists checks if the AttendanceSession row exists.
+func AttendanceSessionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"attendance_session\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if attendance_session exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1172 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAttendanceSessions(t *testing.T) {
+	t.Parallel()
+
+	query := AttendanceSessions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAttendanceSessionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceSessionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AttendanceSessions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceSessionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttendanceSessionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAttendanceSessionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AttendanceSessionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AttendanceSession exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AttendanceSessionExists to return true, but got false.")
+	}
+}
+
+func testAttendanceSessionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	attendanceSessionFound, err := FindAttendanceSession(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if attendanceSessionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAttendanceSessionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AttendanceSessions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceSessionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AttendanceSessions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAttendanceSessionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	attendanceSessionOne := &AttendanceSession{}
+	attendanceSessionTwo := &AttendanceSession{}
+	if err = randomize.Struct(seed, attendanceSessionOne, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attendanceSessionTwo, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attendanceSessionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attendanceSessionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AttendanceSessions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAttendanceSessionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	attendanceSessionOne := &AttendanceSession{}
+	attendanceSessionTwo := &AttendanceSession{}
+	if err = randomize.Struct(seed, attendanceSessionOne, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+	if err = randomize.Struct(seed, attendanceSessionTwo, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = attendanceSessionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = attendanceSessionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testAttendanceSessionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttendanceSessionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(attendanceSessionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAttendanceSessionToManySessionAttendanceRecords(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b, c AttendanceRecord
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attendanceRecordDBTypes, false, attendanceRecordColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SessionID = a.ID
+	c.SessionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SessionAttendanceRecords().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SessionID == b.SessionID {
+			bFound = true
+		}
+		if v.SessionID == c.SessionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AttendanceSessionSlice{&a}
+	if err = a.L.LoadSessionAttendanceRecords(ctx, tx, false, (*[]*AttendanceSession)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SessionAttendanceRecords); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SessionAttendanceRecords = nil
+	if err = a.L.LoadSessionAttendanceRecords(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SessionAttendanceRecords); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAttendanceSessionToManyAddOpSessionAttendanceRecords(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b, c, d, e AttendanceRecord
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AttendanceRecord{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attendanceRecordDBTypes, false, strmangle.SetComplement(attendanceRecordPrimaryKeyColumns, attendanceRecordColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AttendanceRecord{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSessionAttendanceRecords(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SessionID {
+			t.Error("foreign key was wrong value", a.ID, first.SessionID)
+		}
+		if a.ID != second.SessionID {
+			t.Error("foreign key was wrong value", a.ID, second.SessionID)
+		}
+
+		if first.R.Session != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Session != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SessionAttendanceRecords[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SessionAttendanceRecords[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SessionAttendanceRecords().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testAttendanceSessionToOneClassUsingClass(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AttendanceSession
+	var foreign Class
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, classDBTypes, false, classColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Class struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ClassID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Class().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttendanceSessionSlice{&local}
+	if err = local.L.LoadClass(ctx, tx, false, (*[]*AttendanceSession)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Class = nil
+	if err = local.L.LoadClass(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttendanceSessionToOneCourseUsingCourse(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AttendanceSession
+	var foreign Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CourseID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Course().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttendanceSessionSlice{&local}
+	if err = local.L.LoadCourse(ctx, tx, false, (*[]*AttendanceSession)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Course = nil
+	if err = local.L.LoadCourse(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttendanceSessionToOneUserUsingTakenBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AttendanceSession
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TakenByID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TakenBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AttendanceSessionSlice{&local}
+	if err = local.L.LoadTakenBy(ctx, tx, false, (*[]*AttendanceSession)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TakenBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TakenBy = nil
+	if err = local.L.LoadTakenBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TakenBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAttendanceSessionToOneSetOpClassUsingClass(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b, c Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Class{&b, &c} {
+		err = a.SetClass(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Class != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AttendanceSessions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ClassID != x.ID {
+			t.Error("foreign key was wrong value", a.ClassID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ClassID))
+		reflect.Indirect(reflect.ValueOf(&a.ClassID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ClassID != x.ID {
+			t.Error("foreign key was wrong value", a.ClassID, x.ID)
+		}
+	}
+}
+func testAttendanceSessionToOneSetOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b, c Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Course{&b, &c} {
+		err = a.SetCourse(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Course != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AttendanceSessions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CourseID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CourseID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseID, x.ID)
+		}
+	}
+}
+
+func testAttendanceSessionToOneRemoveOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCourse(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCourse(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Course().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Course != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CourseID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AttendanceSessions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAttendanceSessionToOneSetOpUserUsingTakenBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetTakenBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TakenBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TakenByAttendanceSessions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TakenByID, x.ID) {
+			t.Error("foreign key was wrong value", a.TakenByID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TakenByID))
+		reflect.Indirect(reflect.ValueOf(&a.TakenByID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TakenByID, x.ID) {
+			t.Error("foreign key was wrong value", a.TakenByID, x.ID)
+		}
+	}
+}
+
+func testAttendanceSessionToOneRemoveOpUserUsingTakenBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AttendanceSession
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTakenBy(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTakenBy(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.TakenBy().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.TakenBy != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TakenByID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TakenByAttendanceSessions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAttendanceSessionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceSessionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AttendanceSessionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAttendanceSessionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AttendanceSessions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	attendanceSessionDBTypes = map[string]string{`ID`: `uuid`, `ClassID`: `uuid`, `CourseID`: `uuid`, `Date`: `date`, `TakenByID`: `uuid`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                        = bytes.MinRead
+)
+
+func testAttendanceSessionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(attendanceSessionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(attendanceSessionAllColumns) == len(attendanceSessionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAttendanceSessionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(attendanceSessionAllColumns) == len(attendanceSessionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AttendanceSession{}
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, attendanceSessionDBTypes, true, attendanceSessionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(attendanceSessionAllColumns, attendanceSessionPrimaryKeyColumns) {
+		fields = attendanceSessionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			attendanceSessionAllColumns,
+			attendanceSessionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AttendanceSessionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAttendanceSessionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(attendanceSessionAllColumns) == len(attendanceSessionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AttendanceSession{}
+	if err = randomize.Struct(seed, &o, attendanceSessionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AttendanceSession: %s", err)
+	}
+
+	count, err := AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, attendanceSessionDBTypes, false, attendanceSessionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AttendanceSession struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AttendanceSession: %s", err)
+	}
+
+	count, err = AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -12,73 +12,914 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("Announcements", testAnnouncements)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachments)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipients)
+	t.Run("Assessments", testAssessments)
+	t.Run("Attempts", testAttempts)
+	t.Run("AttendanceRecords", testAttendanceRecords)
+	t.Run("AttendanceSessions", testAttendanceSessions)
+	t.Run("AuditLogs", testAuditLogs)
+	t.Run("BackupCodes", testBackupCodes)
+	t.Run("CalendarEvents", testCalendarEvents)
+	t.Run("CalendarFeeds", testCalendarFeeds)
+	t.Run("ChatMessages", testChatMessages)
+	t.Run("ChatMutes", testChatMutes)
+	t.Run("ChatReads", testChatReads)
+	t.Run("ChatRooms", testChatRooms)
+	t.Run("ChatRoomMembers", testChatRoomMembers)
+	t.Run("Classes", testClasses)
+	t.Run("ClassStudents", testClassStudents)
+	t.Run("Courses", testCourses)
+	t.Run("Courseworks", testCourseworks)
+	t.Run("Departments", testDepartments)
+	t.Run("ExternalIdentities", testExternalIdentities)
+	t.Run("GuardianLinks", testGuardianLinks)
+	t.Run("Jobs", testJobs)
+	t.Run("JWTKeys", testJWTKeys)
+	t.Run("Marks", testMarks)
+	t.Run("MarkCategories", testMarkCategories)
+	t.Run("MarkImports", testMarkImports)
+	t.Run("Notifications", testNotifications)
+	t.Run("NotificationPreferences", testNotificationPreferences)
+	t.Run("OidcLogins", testOidcLogins)
+	t.Run("PasswordlessLimits", testPasswordlessLimits)
+	t.Run("Payments", testPayments)
+	t.Run("PushSubscriptions", testPushSubscriptions)
+	t.Run("Questions", testQuestions)
+	t.Run("RbacRoles", testRbacRoles)
+	t.Run("RbacRoleMembers", testRbacRoleMembers)
+	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("Schools", testSchools)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomains)
+	t.Run("SMSUsages", testSMSUsages)
+	t.Run("Subscriptions", testSubscriptions)
+	t.Run("Terms", testTerms)
+	t.Run("TwoFactors", testTwoFactors)
 	t.Run("Users", testUsers)
+	t.Run("WalletAccounts", testWalletAccounts)
+	t.Run("WalletAwards", testWalletAwards)
+	t.Run("WalletEntries", testWalletEntries)
+	t.Run("WalletRules", testWalletRules)
+	t.Run("WalletTransactions", testWalletTransactions)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsDelete)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsDelete)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsDelete)
+	t.Run("Assessments", testAssessmentsDelete)
+	t.Run("Attempts", testAttemptsDelete)
+	t.Run("AttendanceRecords", testAttendanceRecordsDelete)
+	t.Run("AttendanceSessions", testAttendanceSessionsDelete)
+	t.Run("AuditLogs", testAuditLogsDelete)
+	t.Run("BackupCodes", testBackupCodesDelete)
+	t.Run("CalendarEvents", testCalendarEventsDelete)
+	t.Run("CalendarFeeds", testCalendarFeedsDelete)
+	t.Run("ChatMessages", testChatMessagesDelete)
+	t.Run("ChatMutes", testChatMutesDelete)
+	t.Run("ChatReads", testChatReadsDelete)
+	t.Run("ChatRooms", testChatRoomsDelete)
+	t.Run("ChatRoomMembers", testChatRoomMembersDelete)
+	t.Run("Classes", testClassesDelete)
+	t.Run("ClassStudents", testClassStudentsDelete)
+	t.Run("Courses", testCoursesDelete)
+	t.Run("Courseworks", testCourseworksDelete)
+	t.Run("Departments", testDepartmentsDelete)
+	t.Run("ExternalIdentities", testExternalIdentitiesDelete)
+	t.Run("GuardianLinks", testGuardianLinksDelete)
+	t.Run("Jobs", testJobsDelete)
+	t.Run("JWTKeys", testJWTKeysDelete)
+	t.Run("Marks", testMarksDelete)
+	t.Run("MarkCategories", testMarkCategoriesDelete)
+	t.Run("MarkImports", testMarkImportsDelete)
+	t.Run("Notifications", testNotificationsDelete)
+	t.Run("NotificationPreferences", testNotificationPreferencesDelete)
+	t.Run("OidcLogins", testOidcLoginsDelete)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsDelete)
+	t.Run("Payments", testPaymentsDelete)
+	t.Run("PushSubscriptions", testPushSubscriptionsDelete)
+	t.Run("Questions", testQuestionsDelete)
+	t.Run("RbacRoles", testRbacRolesDelete)
+	t.Run("RbacRoleMembers", testRbacRoleMembersDelete)
+	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("Schools", testSchoolsDelete)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsDelete)
+	t.Run("SMSUsages", testSMSUsagesDelete)
+	t.Run("Subscriptions", testSubscriptionsDelete)
+	t.Run("Terms", testTermsDelete)
+	t.Run("TwoFactors", testTwoFactorsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WalletAccounts", testWalletAccountsDelete)
+	t.Run("WalletAwards", testWalletAwardsDelete)
+	t.Run("WalletEntries", testWalletEntriesDelete)
+	t.Run("WalletRules", testWalletRulesDelete)
+	t.Run("WalletTransactions", testWalletTransactionsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsQueryDeleteAll)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsQueryDeleteAll)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsQueryDeleteAll)
+	t.Run("Assessments", testAssessmentsQueryDeleteAll)
+	t.Run("Attempts", testAttemptsQueryDeleteAll)
+	t.Run("AttendanceRecords", testAttendanceRecordsQueryDeleteAll)
+	t.Run("AttendanceSessions", testAttendanceSessionsQueryDeleteAll)
+	t.Run("AuditLogs", testAuditLogsQueryDeleteAll)
+	t.Run("BackupCodes", testBackupCodesQueryDeleteAll)
+	t.Run("CalendarEvents", testCalendarEventsQueryDeleteAll)
+	t.Run("CalendarFeeds", testCalendarFeedsQueryDeleteAll)
+	t.Run("ChatMessages", testChatMessagesQueryDeleteAll)
+	t.Run("ChatMutes", testChatMutesQueryDeleteAll)
+	t.Run("ChatReads", testChatReadsQueryDeleteAll)
+	t.Run("ChatRooms", testChatRoomsQueryDeleteAll)
+	t.Run("ChatRoomMembers", testChatRoomMembersQueryDeleteAll)
+	t.Run("Classes", testClassesQueryDeleteAll)
+	t.Run("ClassStudents", testClassStudentsQueryDeleteAll)
+	t.Run("Courses", testCoursesQueryDeleteAll)
+	t.Run("Courseworks", testCourseworksQueryDeleteAll)
+	t.Run("Departments", testDepartmentsQueryDeleteAll)
+	t.Run("ExternalIdentities", testExternalIdentitiesQueryDeleteAll)
+	t.Run("GuardianLinks", testGuardianLinksQueryDeleteAll)
+	t.Run("Jobs", testJobsQueryDeleteAll)
+	t.Run("JWTKeys", testJWTKeysQueryDeleteAll)
+	t.Run("Marks", testMarksQueryDeleteAll)
+	t.Run("MarkCategories", testMarkCategoriesQueryDeleteAll)
+	t.Run("MarkImports", testMarkImportsQueryDeleteAll)
+	t.Run("Notifications", testNotificationsQueryDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesQueryDeleteAll)
+	t.Run("OidcLogins", testOidcLoginsQueryDeleteAll)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsQueryDeleteAll)
+	t.Run("Payments", testPaymentsQueryDeleteAll)
+	t.Run("PushSubscriptions", testPushSubscriptionsQueryDeleteAll)
+	t.Run("Questions", testQuestionsQueryDeleteAll)
+	t.Run("RbacRoles", testRbacRolesQueryDeleteAll)
+	t.Run("RbacRoleMembers", testRbacRoleMembersQueryDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("Schools", testSchoolsQueryDeleteAll)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsQueryDeleteAll)
+	t.Run("SMSUsages", testSMSUsagesQueryDeleteAll)
+	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
+	t.Run("Terms", testTermsQueryDeleteAll)
+	t.Run("TwoFactors", testTwoFactorsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WalletAccounts", testWalletAccountsQueryDeleteAll)
+	t.Run("WalletAwards", testWalletAwardsQueryDeleteAll)
+	t.Run("WalletEntries", testWalletEntriesQueryDeleteAll)
+	t.Run("WalletRules", testWalletRulesQueryDeleteAll)
+	t.Run("WalletTransactions", testWalletTransactionsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsSliceDeleteAll)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsSliceDeleteAll)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsSliceDeleteAll)
+	t.Run("Assessments", testAssessmentsSliceDeleteAll)
+	t.Run("Attempts", testAttemptsSliceDeleteAll)
+	t.Run("AttendanceRecords", testAttendanceRecordsSliceDeleteAll)
+	t.Run("AttendanceSessions", testAttendanceSessionsSliceDeleteAll)
+	t.Run("AuditLogs", testAuditLogsSliceDeleteAll)
+	t.Run("BackupCodes", testBackupCodesSliceDeleteAll)
+	t.Run("CalendarEvents", testCalendarEventsSliceDeleteAll)
+	t.Run("CalendarFeeds", testCalendarFeedsSliceDeleteAll)
+	t.Run("ChatMessages", testChatMessagesSliceDeleteAll)
+	t.Run("ChatMutes", testChatMutesSliceDeleteAll)
+	t.Run("ChatReads", testChatReadsSliceDeleteAll)
+	t.Run("ChatRooms", testChatRoomsSliceDeleteAll)
+	t.Run("ChatRoomMembers", testChatRoomMembersSliceDeleteAll)
+	t.Run("Classes", testClassesSliceDeleteAll)
+	t.Run("ClassStudents", testClassStudentsSliceDeleteAll)
+	t.Run("Courses", testCoursesSliceDeleteAll)
+	t.Run("Courseworks", testCourseworksSliceDeleteAll)
+	t.Run("Departments", testDepartmentsSliceDeleteAll)
+	t.Run("ExternalIdentities", testExternalIdentitiesSliceDeleteAll)
+	t.Run("GuardianLinks", testGuardianLinksSliceDeleteAll)
+	t.Run("Jobs", testJobsSliceDeleteAll)
+	t.Run("JWTKeys", testJWTKeysSliceDeleteAll)
+	t.Run("Marks", testMarksSliceDeleteAll)
+	t.Run("MarkCategories", testMarkCategoriesSliceDeleteAll)
+	t.Run("MarkImports", testMarkImportsSliceDeleteAll)
+	t.Run("Notifications", testNotificationsSliceDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceDeleteAll)
+	t.Run("OidcLogins", testOidcLoginsSliceDeleteAll)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsSliceDeleteAll)
+	t.Run("Payments", testPaymentsSliceDeleteAll)
+	t.Run("PushSubscriptions", testPushSubscriptionsSliceDeleteAll)
+	t.Run("Questions", testQuestionsSliceDeleteAll)
+	t.Run("RbacRoles", testRbacRolesSliceDeleteAll)
+	t.Run("RbacRoleMembers", testRbacRoleMembersSliceDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("Schools", testSchoolsSliceDeleteAll)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsSliceDeleteAll)
+	t.Run("SMSUsages", testSMSUsagesSliceDeleteAll)
+	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
+	t.Run("Terms", testTermsSliceDeleteAll)
+	t.Run("TwoFactors", testTwoFactorsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WalletAccounts", testWalletAccountsSliceDeleteAll)
+	t.Run("WalletAwards", testWalletAwardsSliceDeleteAll)
+	t.Run("WalletEntries", testWalletEntriesSliceDeleteAll)
+	t.Run("WalletRules", testWalletRulesSliceDeleteAll)
+	t.Run("WalletTransactions", testWalletTransactionsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsExists)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsExists)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsExists)
+	t.Run("Assessments", testAssessmentsExists)
+	t.Run("Attempts", testAttemptsExists)
+	t.Run("AttendanceRecords", testAttendanceRecordsExists)
+	t.Run("AttendanceSessions", testAttendanceSessionsExists)
+	t.Run("AuditLogs", testAuditLogsExists)
+	t.Run("BackupCodes", testBackupCodesExists)
+	t.Run("CalendarEvents", testCalendarEventsExists)
+	t.Run("CalendarFeeds", testCalendarFeedsExists)
+	t.Run("ChatMessages", testChatMessagesExists)
+	t.Run("ChatMutes", testChatMutesExists)
+	t.Run("ChatReads", testChatReadsExists)
+	t.Run("ChatRooms", testChatRoomsExists)
+	t.Run("ChatRoomMembers", testChatRoomMembersExists)
+	t.Run("Classes", testClassesExists)
+	t.Run("ClassStudents", testClassStudentsExists)
+	t.Run("Courses", testCoursesExists)
+	t.Run("Courseworks", testCourseworksExists)
+	t.Run("Departments", testDepartmentsExists)
+	t.Run("ExternalIdentities", testExternalIdentitiesExists)
+	t.Run("GuardianLinks", testGuardianLinksExists)
+	t.Run("Jobs", testJobsExists)
+	t.Run("JWTKeys", testJWTKeysExists)
+	t.Run("Marks", testMarksExists)
+	t.Run("MarkCategories", testMarkCategoriesExists)
+	t.Run("MarkImports", testMarkImportsExists)
+	t.Run("Notifications", testNotificationsExists)
+	t.Run("NotificationPreferences", testNotificationPreferencesExists)
+	t.Run("OidcLogins", testOidcLoginsExists)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsExists)
+	t.Run("Payments", testPaymentsExists)
+	t.Run("PushSubscriptions", testPushSubscriptionsExists)
+	t.Run("Questions", testQuestionsExists)
+	t.Run("RbacRoles", testRbacRolesExists)
+	t.Run("RbacRoleMembers", testRbacRoleMembersExists)
+	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("Schools", testSchoolsExists)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsExists)
+	t.Run("SMSUsages", testSMSUsagesExists)
+	t.Run("Subscriptions", testSubscriptionsExists)
+	t.Run("Terms", testTermsExists)
+	t.Run("TwoFactors", testTwoFactorsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WalletAccounts", testWalletAccountsExists)
+	t.Run("WalletAwards", testWalletAwardsExists)
+	t.Run("WalletEntries", testWalletEntriesExists)
+	t.Run("WalletRules", testWalletRulesExists)
+	t.Run("WalletTransactions", testWalletTransactionsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsFind)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsFind)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsFind)
+	t.Run("Assessments", testAssessmentsFind)
+	t.Run("Attempts", testAttemptsFind)
+	t.Run("AttendanceRecords", testAttendanceRecordsFind)
+	t.Run("AttendanceSessions", testAttendanceSessionsFind)
+	t.Run("AuditLogs", testAuditLogsFind)
+	t.Run("BackupCodes", testBackupCodesFind)
+	t.Run("CalendarEvents", testCalendarEventsFind)
+	t.Run("CalendarFeeds", testCalendarFeedsFind)
+	t.Run("ChatMessages", testChatMessagesFind)
+	t.Run("ChatMutes", testChatMutesFind)
+	t.Run("ChatReads", testChatReadsFind)
+	t.Run("ChatRooms", testChatRoomsFind)
+	t.Run("ChatRoomMembers", testChatRoomMembersFind)
+	t.Run("Classes", testClassesFind)
+	t.Run("ClassStudents", testClassStudentsFind)
+	t.Run("Courses", testCoursesFind)
+	t.Run("Courseworks", testCourseworksFind)
+	t.Run("Departments", testDepartmentsFind)
+	t.Run("ExternalIdentities", testExternalIdentitiesFind)
+	t.Run("GuardianLinks", testGuardianLinksFind)
+	t.Run("Jobs", testJobsFind)
+	t.Run("JWTKeys", testJWTKeysFind)
+	t.Run("Marks", testMarksFind)
+	t.Run("MarkCategories", testMarkCategoriesFind)
+	t.Run("MarkImports", testMarkImportsFind)
+	t.Run("Notifications", testNotificationsFind)
+	t.Run("NotificationPreferences", testNotificationPreferencesFind)
+	t.Run("OidcLogins", testOidcLoginsFind)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsFind)
+	t.Run("Payments", testPaymentsFind)
+	t.Run("PushSubscriptions", testPushSubscriptionsFind)
+	t.Run("Questions", testQuestionsFind)
+	t.Run("RbacRoles", testRbacRolesFind)
+	t.Run("RbacRoleMembers", testRbacRoleMembersFind)
+	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("Schools", testSchoolsFind)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsFind)
+	t.Run("SMSUsages", testSMSUsagesFind)
+	t.Run("Subscriptions", testSubscriptionsFind)
+	t.Run("Terms", testTermsFind)
+	t.Run("TwoFactors", testTwoFactorsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WalletAccounts", testWalletAccountsFind)
+	t.Run("WalletAwards", testWalletAwardsFind)
+	t.Run("WalletEntries", testWalletEntriesFind)
+	t.Run("WalletRules", testWalletRulesFind)
+	t.Run("WalletTransactions", testWalletTransactionsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsBind)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsBind)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsBind)
+	t.Run("Assessments", testAssessmentsBind)
+	t.Run("Attempts", testAttemptsBind)
+	t.Run("AttendanceRecords", testAttendanceRecordsBind)
+	t.Run("AttendanceSessions", testAttendanceSessionsBind)
+	t.Run("AuditLogs", testAuditLogsBind)
+	t.Run("BackupCodes", testBackupCodesBind)
+	t.Run("CalendarEvents", testCalendarEventsBind)
+	t.Run("CalendarFeeds", testCalendarFeedsBind)
+	t.Run("ChatMessages", testChatMessagesBind)
+	t.Run("ChatMutes", testChatMutesBind)
+	t.Run("ChatReads", testChatReadsBind)
+	t.Run("ChatRooms", testChatRoomsBind)
+	t.Run("ChatRoomMembers", testChatRoomMembersBind)
+	t.Run("Classes", testClassesBind)
+	t.Run("ClassStudents", testClassStudentsBind)
+	t.Run("Courses", testCoursesBind)
+	t.Run("Courseworks", testCourseworksBind)
+	t.Run("Departments", testDepartmentsBind)
+	t.Run("ExternalIdentities", testExternalIdentitiesBind)
+	t.Run("GuardianLinks", testGuardianLinksBind)
+	t.Run("Jobs", testJobsBind)
+	t.Run("JWTKeys", testJWTKeysBind)
+	t.Run("Marks", testMarksBind)
+	t.Run("MarkCategories", testMarkCategoriesBind)
+	t.Run("MarkImports", testMarkImportsBind)
+	t.Run("Notifications", testNotificationsBind)
+	t.Run("NotificationPreferences", testNotificationPreferencesBind)
+	t.Run("OidcLogins", testOidcLoginsBind)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsBind)
+	t.Run("Payments", testPaymentsBind)
+	t.Run("PushSubscriptions", testPushSubscriptionsBind)
+	t.Run("Questions", testQuestionsBind)
+	t.Run("RbacRoles", testRbacRolesBind)
+	t.Run("RbacRoleMembers", testRbacRoleMembersBind)
+	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("Schools", testSchoolsBind)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsBind)
+	t.Run("SMSUsages", testSMSUsagesBind)
+	t.Run("Subscriptions", testSubscriptionsBind)
+	t.Run("Terms", testTermsBind)
+	t.Run("TwoFactors", testTwoFactorsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WalletAccounts", testWalletAccountsBind)
+	t.Run("WalletAwards", testWalletAwardsBind)
+	t.Run("WalletEntries", testWalletEntriesBind)
+	t.Run("WalletRules", testWalletRulesBind)
+	t.Run("WalletTransactions", testWalletTransactionsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsOne)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsOne)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsOne)
+	t.Run("Assessments", testAssessmentsOne)
+	t.Run("Attempts", testAttemptsOne)
+	t.Run("AttendanceRecords", testAttendanceRecordsOne)
+	t.Run("AttendanceSessions", testAttendanceSessionsOne)
+	t.Run("AuditLogs", testAuditLogsOne)
+	t.Run("BackupCodes", testBackupCodesOne)
+	t.Run("CalendarEvents", testCalendarEventsOne)
+	t.Run("CalendarFeeds", testCalendarFeedsOne)
+	t.Run("ChatMessages", testChatMessagesOne)
+	t.Run("ChatMutes", testChatMutesOne)
+	t.Run("ChatReads", testChatReadsOne)
+	t.Run("ChatRooms", testChatRoomsOne)
+	t.Run("ChatRoomMembers", testChatRoomMembersOne)
+	t.Run("Classes", testClassesOne)
+	t.Run("ClassStudents", testClassStudentsOne)
+	t.Run("Courses", testCoursesOne)
+	t.Run("Courseworks", testCourseworksOne)
+	t.Run("Departments", testDepartmentsOne)
+	t.Run("ExternalIdentities", testExternalIdentitiesOne)
+	t.Run("GuardianLinks", testGuardianLinksOne)
+	t.Run("Jobs", testJobsOne)
+	t.Run("JWTKeys", testJWTKeysOne)
+	t.Run("Marks", testMarksOne)
+	t.Run("MarkCategories", testMarkCategoriesOne)
+	t.Run("MarkImports", testMarkImportsOne)
+	t.Run("Notifications", testNotificationsOne)
+	t.Run("NotificationPreferences", testNotificationPreferencesOne)
+	t.Run("OidcLogins", testOidcLoginsOne)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsOne)
+	t.Run("Payments", testPaymentsOne)
+	t.Run("PushSubscriptions", testPushSubscriptionsOne)
+	t.Run("Questions", testQuestionsOne)
+	t.Run("RbacRoles", testRbacRolesOne)
+	t.Run("RbacRoleMembers", testRbacRoleMembersOne)
+	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("Schools", testSchoolsOne)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsOne)
+	t.Run("SMSUsages", testSMSUsagesOne)
+	t.Run("Subscriptions", testSubscriptionsOne)
+	t.Run("Terms", testTermsOne)
+	t.Run("TwoFactors", testTwoFactorsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WalletAccounts", testWalletAccountsOne)
+	t.Run("WalletAwards", testWalletAwardsOne)
+	t.Run("WalletEntries", testWalletEntriesOne)
+	t.Run("WalletRules", testWalletRulesOne)
+	t.Run("WalletTransactions", testWalletTransactionsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsAll)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsAll)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsAll)
+	t.Run("Assessments", testAssessmentsAll)
+	t.Run("Attempts", testAttemptsAll)
+	t.Run("AttendanceRecords", testAttendanceRecordsAll)
+	t.Run("AttendanceSessions", testAttendanceSessionsAll)
+	t.Run("AuditLogs", testAuditLogsAll)
+	t.Run("BackupCodes", testBackupCodesAll)
+	t.Run("CalendarEvents", testCalendarEventsAll)
+	t.Run("CalendarFeeds", testCalendarFeedsAll)
+	t.Run("ChatMessages", testChatMessagesAll)
+	t.Run("ChatMutes", testChatMutesAll)
+	t.Run("ChatReads", testChatReadsAll)
+	t.Run("ChatRooms", testChatRoomsAll)
+	t.Run("ChatRoomMembers", testChatRoomMembersAll)
+	t.Run("Classes", testClassesAll)
+	t.Run("ClassStudents", testClassStudentsAll)
+	t.Run("Courses", testCoursesAll)
+	t.Run("Courseworks", testCourseworksAll)
+	t.Run("Departments", testDepartmentsAll)
+	t.Run("ExternalIdentities", testExternalIdentitiesAll)
+	t.Run("GuardianLinks", testGuardianLinksAll)
+	t.Run("Jobs", testJobsAll)
+	t.Run("JWTKeys", testJWTKeysAll)
+	t.Run("Marks", testMarksAll)
+	t.Run("MarkCategories", testMarkCategoriesAll)
+	t.Run("MarkImports", testMarkImportsAll)
+	t.Run("Notifications", testNotificationsAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesAll)
+	t.Run("OidcLogins", testOidcLoginsAll)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsAll)
+	t.Run("Payments", testPaymentsAll)
+	t.Run("PushSubscriptions", testPushSubscriptionsAll)
+	t.Run("Questions", testQuestionsAll)
+	t.Run("RbacRoles", testRbacRolesAll)
+	t.Run("RbacRoleMembers", testRbacRoleMembersAll)
+	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("Schools", testSchoolsAll)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsAll)
+	t.Run("SMSUsages", testSMSUsagesAll)
+	t.Run("Subscriptions", testSubscriptionsAll)
+	t.Run("Terms", testTermsAll)
+	t.Run("TwoFactors", testTwoFactorsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WalletAccounts", testWalletAccountsAll)
+	t.Run("WalletAwards", testWalletAwardsAll)
+	t.Run("WalletEntries", testWalletEntriesAll)
+	t.Run("WalletRules", testWalletRulesAll)
+	t.Run("WalletTransactions", testWalletTransactionsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsCount)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsCount)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsCount)
+	t.Run("Assessments", testAssessmentsCount)
+	t.Run("Attempts", testAttemptsCount)
+	t.Run("AttendanceRecords", testAttendanceRecordsCount)
+	t.Run("AttendanceSessions", testAttendanceSessionsCount)
+	t.Run("AuditLogs", testAuditLogsCount)
+	t.Run("BackupCodes", testBackupCodesCount)
+	t.Run("CalendarEvents", testCalendarEventsCount)
+	t.Run("CalendarFeeds", testCalendarFeedsCount)
+	t.Run("ChatMessages", testChatMessagesCount)
+	t.Run("ChatMutes", testChatMutesCount)
+	t.Run("ChatReads", testChatReadsCount)
+	t.Run("ChatRooms", testChatRoomsCount)
+	t.Run("ChatRoomMembers", testChatRoomMembersCount)
+	t.Run("Classes", testClassesCount)
+	t.Run("ClassStudents", testClassStudentsCount)
+	t.Run("Courses", testCoursesCount)
+	t.Run("Courseworks", testCourseworksCount)
+	t.Run("Departments", testDepartmentsCount)
+	t.Run("ExternalIdentities", testExternalIdentitiesCount)
+	t.Run("GuardianLinks", testGuardianLinksCount)
+	t.Run("Jobs", testJobsCount)
+	t.Run("JWTKeys", testJWTKeysCount)
+	t.Run("Marks", testMarksCount)
+	t.Run("MarkCategories", testMarkCategoriesCount)
+	t.Run("MarkImports", testMarkImportsCount)
+	t.Run("Notifications", testNotificationsCount)
+	t.Run("NotificationPreferences", testNotificationPreferencesCount)
+	t.Run("OidcLogins", testOidcLoginsCount)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsCount)
+	t.Run("Payments", testPaymentsCount)
+	t.Run("PushSubscriptions", testPushSubscriptionsCount)
+	t.Run("Questions", testQuestionsCount)
+	t.Run("RbacRoles", testRbacRolesCount)
+	t.Run("RbacRoleMembers", testRbacRoleMembersCount)
+	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("Schools", testSchoolsCount)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsCount)
+	t.Run("SMSUsages", testSMSUsagesCount)
+	t.Run("Subscriptions", testSubscriptionsCount)
+	t.Run("Terms", testTermsCount)
+	t.Run("TwoFactors", testTwoFactorsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WalletAccounts", testWalletAccountsCount)
+	t.Run("WalletAwards", testWalletAwardsCount)
+	t.Run("WalletEntries", testWalletEntriesCount)
+	t.Run("WalletRules", testWalletRulesCount)
+	t.Run("WalletTransactions", testWalletTransactionsCount)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsInsert)
+	t.Run("Announcements", testAnnouncementsInsertWhitelist)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsInsert)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsInsertWhitelist)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsInsert)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsInsertWhitelist)
+	t.Run("Assessments", testAssessmentsInsert)
+	t.Run("Assessments", testAssessmentsInsertWhitelist)
+	t.Run("Attempts", testAttemptsInsert)
+	t.Run("Attempts", testAttemptsInsertWhitelist)
+	t.Run("AttendanceRecords", testAttendanceRecordsInsert)
+	t.Run("AttendanceRecords", testAttendanceRecordsInsertWhitelist)
+	t.Run("AttendanceSessions", testAttendanceSessionsInsert)
+	t.Run("AttendanceSessions", testAttendanceSessionsInsertWhitelist)
+	t.Run("AuditLogs", testAuditLogsInsert)
+	t.Run("AuditLogs", testAuditLogsInsertWhitelist)
+	t.Run("BackupCodes", testBackupCodesInsert)
+	t.Run("BackupCodes", testBackupCodesInsertWhitelist)
+	t.Run("CalendarEvents", testCalendarEventsInsert)
+	t.Run("CalendarEvents", testCalendarEventsInsertWhitelist)
+	t.Run("CalendarFeeds", testCalendarFeedsInsert)
+	t.Run("CalendarFeeds", testCalendarFeedsInsertWhitelist)
+	t.Run("ChatMessages", testChatMessagesInsert)
+	t.Run("ChatMessages", testChatMessagesInsertWhitelist)
+	t.Run("ChatMutes", testChatMutesInsert)
+	t.Run("ChatMutes", testChatMutesInsertWhitelist)
+	t.Run("ChatReads", testChatReadsInsert)
+	t.Run("ChatReads", testChatReadsInsertWhitelist)
+	t.Run("ChatRooms", testChatRoomsInsert)
+	t.Run("ChatRooms", testChatRoomsInsertWhitelist)
+	t.Run("ChatRoomMembers", testChatRoomMembersInsert)
+	t.Run("ChatRoomMembers", testChatRoomMembersInsertWhitelist)
+	t.Run("Classes", testClassesInsert)
+	t.Run("Classes", testClassesInsertWhitelist)
+	t.Run("ClassStudents", testClassStudentsInsert)
+	t.Run("ClassStudents", testClassStudentsInsertWhitelist)
+	t.Run("Courses", testCoursesInsert)
+	t.Run("Courses", testCoursesInsertWhitelist)
+	t.Run("Courseworks", testCourseworksInsert)
+	t.Run("Courseworks", testCourseworksInsertWhitelist)
+	t.Run("Departments", testDepartmentsInsert)
+	t.Run("Departments", testDepartmentsInsertWhitelist)
+	t.Run("ExternalIdentities", testExternalIdentitiesInsert)
+	t.Run("ExternalIdentities", testExternalIdentitiesInsertWhitelist)
+	t.Run("GuardianLinks", testGuardianLinksInsert)
+	t.Run("GuardianLinks", testGuardianLinksInsertWhitelist)
+	t.Run("Jobs", testJobsInsert)
+	t.Run("Jobs", testJobsInsertWhitelist)
+	t.Run("JWTKeys", testJWTKeysInsert)
+	t.Run("JWTKeys", testJWTKeysInsertWhitelist)
+	t.Run("Marks", testMarksInsert)
+	t.Run("Marks", testMarksInsertWhitelist)
+	t.Run("MarkCategories", testMarkCategoriesInsert)
+	t.Run("MarkCategories", testMarkCategoriesInsertWhitelist)
+	t.Run("MarkImports", testMarkImportsInsert)
+	t.Run("MarkImports", testMarkImportsInsertWhitelist)
+	t.Run("Notifications", testNotificationsInsert)
+	t.Run("Notifications", testNotificationsInsertWhitelist)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsert)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsertWhitelist)
+	t.Run("OidcLogins", testOidcLoginsInsert)
+	t.Run("OidcLogins", testOidcLoginsInsertWhitelist)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsInsert)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsInsertWhitelist)
+	t.Run("Payments", testPaymentsInsert)
+	t.Run("Payments", testPaymentsInsertWhitelist)
+	t.Run("PushSubscriptions", testPushSubscriptionsInsert)
+	t.Run("PushSubscriptions", testPushSubscriptionsInsertWhitelist)
+	t.Run("Questions", testQuestionsInsert)
+	t.Run("Questions", testQuestionsInsertWhitelist)
+	t.Run("RbacRoles", testRbacRolesInsert)
+	t.Run("RbacRoles", testRbacRolesInsertWhitelist)
+	t.Run("RbacRoleMembers", testRbacRoleMembersInsert)
+	t.Run("RbacRoleMembers", testRbacRoleMembersInsertWhitelist)
+	t.Run("RefreshTokens", testRefreshTokensInsert)
+	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("Schools", testSchoolsInsert)
+	t.Run("Schools", testSchoolsInsertWhitelist)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsInsert)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsInsertWhitelist)
+	t.Run("SMSUsages", testSMSUsagesInsert)
+	t.Run("SMSUsages", testSMSUsagesInsertWhitelist)
+	t.Run("Subscriptions", testSubscriptionsInsert)
+	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
+	t.Run("Terms", testTermsInsert)
+	t.Run("Terms", testTermsInsertWhitelist)
+	t.Run("TwoFactors", testTwoFactorsInsert)
+	t.Run("TwoFactors", testTwoFactorsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WalletAccounts", testWalletAccountsInsert)
+	t.Run("WalletAccounts", testWalletAccountsInsertWhitelist)
+	t.Run("WalletAwards", testWalletAwardsInsert)
+	t.Run("WalletAwards", testWalletAwardsInsertWhitelist)
+	t.Run("WalletEntries", testWalletEntriesInsert)
+	t.Run("WalletEntries", testWalletEntriesInsertWhitelist)
+	t.Run("WalletRules", testWalletRulesInsert)
+	t.Run("WalletRules", testWalletRulesInsertWhitelist)
+	t.Run("WalletTransactions", testWalletTransactionsInsert)
+	t.Run("WalletTransactions", testWalletTransactionsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("AnnouncementToUserUsingAuthor", testAnnouncementToOneUserUsingAuthor)
+	t.Run("AnnouncementToClassUsingClass", testAnnouncementToOneClassUsingClass)
+	t.Run("AnnouncementToDepartmentUsingDepartment", testAnnouncementToOneDepartmentUsingDepartment)
+	t.Run("AnnouncementToSchoolUsingSchool", testAnnouncementToOneSchoolUsingSchool)
+	t.Run("AnnouncementAttachmentToAnnouncementUsingAnnouncement", testAnnouncementAttachmentToOneAnnouncementUsingAnnouncement)
+	t.Run("AnnouncementRecipientToAnnouncementUsingAnnouncement", testAnnouncementRecipientToOneAnnouncementUsingAnnouncement)
+	t.Run("AnnouncementRecipientToUserUsingUser", testAnnouncementRecipientToOneUserUsingUser)
+	t.Run("AssessmentToMarkCategoryUsingCategory", testAssessmentToOneMarkCategoryUsingCategory)
+	t.Run("AssessmentToCourseworkUsingCoursework", testAssessmentToOneCourseworkUsingCoursework)
+	t.Run("AttemptToCourseworkUsingCoursework", testAttemptToOneCourseworkUsingCoursework)
+	t.Run("AttemptToUserUsingStudent", testAttemptToOneUserUsingStudent)
+	t.Run("AttendanceRecordToAttendanceSessionUsingSession", testAttendanceRecordToOneAttendanceSessionUsingSession)
+	t.Run("AttendanceRecordToUserUsingStudent", testAttendanceRecordToOneUserUsingStudent)
+	t.Run("AttendanceSessionToClassUsingClass", testAttendanceSessionToOneClassUsingClass)
+	t.Run("AttendanceSessionToCourseUsingCourse", testAttendanceSessionToOneCourseUsingCourse)
+	t.Run("AttendanceSessionToUserUsingTakenBy", testAttendanceSessionToOneUserUsingTakenBy)
+	t.Run("BackupCodeToUserUsingUser", testBackupCodeToOneUserUsingUser)
+	t.Run("CalendarEventToCourseUsingCourse", testCalendarEventToOneCourseUsingCourse)
+	t.Run("CalendarEventToSchoolUsingSchool", testCalendarEventToOneSchoolUsingSchool)
+	t.Run("CalendarFeedToUserUsingUser", testCalendarFeedToOneUserUsingUser)
+	t.Run("ChatMessageToUserUsingDeletedBy", testChatMessageToOneUserUsingDeletedBy)
+	t.Run("ChatMessageToChatRoomUsingRoom", testChatMessageToOneChatRoomUsingRoom)
+	t.Run("ChatMessageToUserUsingSender", testChatMessageToOneUserUsingSender)
+	t.Run("ChatMuteToCourseUsingCourse", testChatMuteToOneCourseUsingCourse)
+	t.Run("ChatMuteToUserUsingMutedBy", testChatMuteToOneUserUsingMutedBy)
+	t.Run("ChatMuteToUserUsingUser", testChatMuteToOneUserUsingUser)
+	t.Run("ChatReadToChatRoomUsingRoom", testChatReadToOneChatRoomUsingRoom)
+	t.Run("ChatReadToUserUsingUser", testChatReadToOneUserUsingUser)
+	t.Run("ChatRoomToCourseUsingCourse", testChatRoomToOneCourseUsingCourse)
+	t.Run("ChatRoomToUserUsingCreatedBy", testChatRoomToOneUserUsingCreatedBy)
+	t.Run("ChatRoomMemberToChatRoomUsingRoom", testChatRoomMemberToOneChatRoomUsingRoom)
+	t.Run("ChatRoomMemberToUserUsingUser", testChatRoomMemberToOneUserUsingUser)
+	t.Run("ClassToDepartmentUsingDepartment", testClassToOneDepartmentUsingDepartment)
+	t.Run("ClassToSchoolUsingSchool", testClassToOneSchoolUsingSchool)
+	t.Run("ClassStudentToClassUsingClass", testClassStudentToOneClassUsingClass)
+	t.Run("ClassStudentToUserUsingStudent", testClassStudentToOneUserUsingStudent)
+	t.Run("CourseToClassUsingClass", testCourseToOneClassUsingClass)
+	t.Run("CourseToUserUsingTeacher", testCourseToOneUserUsingTeacher)
+	t.Run("CourseworkToCourseUsingCourse", testCourseworkToOneCourseUsingCourse)
+	t.Run("DepartmentToSchoolUsingSchool", testDepartmentToOneSchoolUsingSchool)
+	t.Run("ExternalIdentityToSchoolUsingSchool", testExternalIdentityToOneSchoolUsingSchool)
+	t.Run("ExternalIdentityToUserUsingUser", testExternalIdentityToOneUserUsingUser)
+	t.Run("GuardianLinkToUserUsingGuardian", testGuardianLinkToOneUserUsingGuardian)
+	t.Run("GuardianLinkToUserUsingStudent", testGuardianLinkToOneUserUsingStudent)
+	t.Run("MarkToAssessmentUsingAssessment", testMarkToOneAssessmentUsingAssessment)
+	t.Run("MarkToUserUsingStudent", testMarkToOneUserUsingStudent)
+	t.Run("MarkCategoryToCourseUsingCourse", testMarkCategoryToOneCourseUsingCourse)
+	t.Run("MarkCategoryToTermUsingTerm", testMarkCategoryToOneTermUsingTerm)
+	t.Run("MarkImportToAssessmentUsingAssessment", testMarkImportToOneAssessmentUsingAssessment)
+	t.Run("MarkImportToUserUsingAuthor", testMarkImportToOneUserUsingAuthor)
+	t.Run("NotificationToUserUsingUser", testNotificationToOneUserUsingUser)
+	t.Run("NotificationPreferenceToUserUsingUser", testNotificationPreferenceToOneUserUsingUser)
+	t.Run("PasswordlessLimitToUserUsingUser", testPasswordlessLimitToOneUserUsingUser)
+	t.Run("PaymentToSchoolUsingSchool", testPaymentToOneSchoolUsingSchool)
+	t.Run("PushSubscriptionToUserUsingUser", testPushSubscriptionToOneUserUsingUser)
+	t.Run("QuestionToCourseUsingCourse", testQuestionToOneCourseUsingCourse)
+	t.Run("RbacRoleToSchoolUsingSchool", testRbacRoleToOneSchoolUsingSchool)
+	t.Run("RbacRoleMemberToRbacRoleUsingRole", testRbacRoleMemberToOneRbacRoleUsingRole)
+	t.Run("RbacRoleMemberToUserUsingUser", testRbacRoleMemberToOneUserUsingUser)
+	t.Run("RefreshTokenToUserUsingUser", testRefreshTokenToOneUserUsingUser)
+	t.Run("SchoolEmailDomainToSchoolUsingSchool", testSchoolEmailDomainToOneSchoolUsingSchool)
+	t.Run("SMSUsageToSchoolUsingSchool", testSMSUsageToOneSchoolUsingSchool)
+	t.Run("SubscriptionToSchoolUsingSchool", testSubscriptionToOneSchoolUsingSchool)
+	t.Run("TermToSchoolUsingSchool", testTermToOneSchoolUsingSchool)
+	t.Run("TwoFactorToUserUsingUser", testTwoFactorToOneUserUsingUser)
+	t.Run("WalletAccountToSchoolUsingSchool", testWalletAccountToOneSchoolUsingSchool)
+	t.Run("WalletAwardToClassUsingClass", testWalletAwardToOneClassUsingClass)
+	t.Run("WalletAwardToSchoolUsingSchool", testWalletAwardToOneSchoolUsingSchool)
+	t.Run("WalletAwardToUserUsingStudent", testWalletAwardToOneUserUsingStudent)
+	t.Run("WalletEntryToWalletAccountUsingAccount", testWalletEntryToOneWalletAccountUsingAccount)
+	t.Run("WalletEntryToWalletTransactionUsingTransaction", testWalletEntryToOneWalletTransactionUsingTransaction)
+	t.Run("WalletRuleToCourseUsingCourse", testWalletRuleToOneCourseUsingCourse)
+	t.Run("WalletTransactionToUserUsingAuthor", testWalletTransactionToOneUserUsingAuthor)
+	t.Run("WalletTransactionToCourseUsingCourse", testWalletTransactionToOneCourseUsingCourse)
+	t.Run("WalletTransactionToSchoolUsingSchool", testWalletTransactionToOneSchoolUsingSchool)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("CourseToChatRoomUsingChatRoom", testCourseOneToOneChatRoomUsingChatRoom)
+	t.Run("SchoolToSubscriptionUsingSubscription", testSchoolOneToOneSubscriptionUsingSubscription)
+	t.Run("UserToCalendarFeedUsingCalendarFeed", testUserOneToOneCalendarFeedUsingCalendarFeed)
+	t.Run("UserToPasswordlessLimitUsingPasswordlessLimit", testUserOneToOnePasswordlessLimitUsingPasswordlessLimit)
+	t.Run("UserToTwoFactorUsingTwoFactor", testUserOneToOneTwoFactorUsingTwoFactor)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("AnnouncementToAnnouncementAttachments", testAnnouncementToManyAnnouncementAttachments)
+	t.Run("AnnouncementToAnnouncementRecipients", testAnnouncementToManyAnnouncementRecipients)
+	t.Run("AssessmentToMarks", testAssessmentToManyMarks)
+	t.Run("AssessmentToMarkImports", testAssessmentToManyMarkImports)
+	t.Run("AttendanceSessionToSessionAttendanceRecords", testAttendanceSessionToManySessionAttendanceRecords)
+	t.Run("ChatRoomToRoomChatMessages", testChatRoomToManyRoomChatMessages)
+	t.Run("ChatRoomToRoomChatReads", testChatRoomToManyRoomChatReads)
+	t.Run("ChatRoomToRoomChatRoomMembers", testChatRoomToManyRoomChatRoomMembers)
+	t.Run("ClassToAnnouncements", testClassToManyAnnouncements)
+	t.Run("ClassToAttendanceSessions", testClassToManyAttendanceSessions)
+	t.Run("ClassToClassStudents", testClassToManyClassStudents)
+	t.Run("ClassToCourses", testClassToManyCourses)
+	t.Run("ClassToWalletAwards", testClassToManyWalletAwards)
+	t.Run("CourseToAttendanceSessions", testCourseToManyAttendanceSessions)
+	t.Run("CourseToCalendarEvents", testCourseToManyCalendarEvents)
+	t.Run("CourseToChatMutes", testCourseToManyChatMutes)
+	t.Run("CourseToCourseworks", testCourseToManyCourseworks)
+	t.Run("CourseToMarkCategories", testCourseToManyMarkCategories)
+	t.Run("CourseToQuestions", testCourseToManyQuestions)
+	t.Run("CourseToWalletRules", testCourseToManyWalletRules)
+	t.Run("CourseToWalletTransactions", testCourseToManyWalletTransactions)
+	t.Run("CourseworkToAssessments", testCourseworkToManyAssessments)
+	t.Run("CourseworkToAttempts", testCourseworkToManyAttempts)
+	t.Run("DepartmentToAnnouncements", testDepartmentToManyAnnouncements)
+	t.Run("DepartmentToClasses", testDepartmentToManyClasses)
+	t.Run("MarkCategoryToCategoryAssessments", testMarkCategoryToManyCategoryAssessments)
+	t.Run("RbacRoleToRoleRbacRoleMembers", testRbacRoleToManyRoleRbacRoleMembers)
+	t.Run("SchoolToAnnouncements", testSchoolToManyAnnouncements)
+	t.Run("SchoolToCalendarEvents", testSchoolToManyCalendarEvents)
+	t.Run("SchoolToClasses", testSchoolToManyClasses)
+	t.Run("SchoolToDepartments", testSchoolToManyDepartments)
+	t.Run("SchoolToExternalIdentities", testSchoolToManyExternalIdentities)
+	t.Run("SchoolToPayments", testSchoolToManyPayments)
+	t.Run("SchoolToRbacRoles", testSchoolToManyRbacRoles)
+	t.Run("SchoolToSchoolEmailDomains", testSchoolToManySchoolEmailDomains)
+	t.Run("SchoolToSMSUsages", testSchoolToManySMSUsages)
+	t.Run("SchoolToTerms", testSchoolToManyTerms)
+	t.Run("SchoolToWalletAccounts", testSchoolToManyWalletAccounts)
+	t.Run("SchoolToWalletAwards", testSchoolToManyWalletAwards)
+	t.Run("SchoolToWalletTransactions", testSchoolToManyWalletTransactions)
+	t.Run("TermToMarkCategories", testTermToManyMarkCategories)
+	t.Run("UserToAuthorAnnouncements", testUserToManyAuthorAnnouncements)
+	t.Run("UserToAnnouncementRecipients", testUserToManyAnnouncementRecipients)
+	t.Run("UserToStudentAttempts", testUserToManyStudentAttempts)
+	t.Run("UserToStudentAttendanceRecords", testUserToManyStudentAttendanceRecords)
+	t.Run("UserToTakenByAttendanceSessions", testUserToManyTakenByAttendanceSessions)
+	t.Run("UserToBackupCodes", testUserToManyBackupCodes)
+	t.Run("UserToDeletedByChatMessages", testUserToManyDeletedByChatMessages)
+	t.Run("UserToSenderChatMessages", testUserToManySenderChatMessages)
+	t.Run("UserToMutedByChatMutes", testUserToManyMutedByChatMutes)
+	t.Run("UserToChatMutes", testUserToManyChatMutes)
+	t.Run("UserToChatReads", testUserToManyChatReads)
+	t.Run("UserToCreatedByChatRooms", testUserToManyCreatedByChatRooms)
+	t.Run("UserToChatRoomMembers", testUserToManyChatRoomMembers)
+	t.Run("UserToStudentClassStudents", testUserToManyStudentClassStudents)
+	t.Run("UserToTeacherCourses", testUserToManyTeacherCourses)
+	t.Run("UserToExternalIdentities", testUserToManyExternalIdentities)
+	t.Run("UserToGuardianGuardianLinks", testUserToManyGuardianGuardianLinks)
+	t.Run("UserToStudentGuardianLinks", testUserToManyStudentGuardianLinks)
+	t.Run("UserToStudentMarks", testUserToManyStudentMarks)
+	t.Run("UserToAuthorMarkImports", testUserToManyAuthorMarkImports)
+	t.Run("UserToNotifications", testUserToManyNotifications)
+	t.Run("UserToNotificationPreferences", testUserToManyNotificationPreferences)
+	t.Run("UserToPushSubscriptions", testUserToManyPushSubscriptions)
+	t.Run("UserToRbacRoleMembers", testUserToManyRbacRoleMembers)
+	t.Run("UserToRefreshTokens", testUserToManyRefreshTokens)
+	t.Run("UserToStudentWalletAwards", testUserToManyStudentWalletAwards)
+	t.Run("UserToAuthorWalletTransactions", testUserToManyAuthorWalletTransactions)
+	t.Run("WalletAccountToAccountWalletEntries", testWalletAccountToManyAccountWalletEntries)
+	t.Run("WalletTransactionToTransactionWalletEntries", testWalletTransactionToManyTransactionWalletEntries)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("AnnouncementToUserUsingAuthorAnnouncements", testAnnouncementToOneSetOpUserUsingAuthor)
+	t.Run("AnnouncementToClassUsingAnnouncements", testAnnouncementToOneSetOpClassUsingClass)
+	t.Run("AnnouncementToDepartmentUsingAnnouncements", testAnnouncementToOneSetOpDepartmentUsingDepartment)
+	t.Run("AnnouncementToSchoolUsingAnnouncements", testAnnouncementToOneSetOpSchoolUsingSchool)
+	t.Run("AnnouncementAttachmentToAnnouncementUsingAnnouncementAttachments", testAnnouncementAttachmentToOneSetOpAnnouncementUsingAnnouncement)
+	t.Run("AnnouncementRecipientToAnnouncementUsingAnnouncementRecipients", testAnnouncementRecipientToOneSetOpAnnouncementUsingAnnouncement)
+	t.Run("AnnouncementRecipientToUserUsingAnnouncementRecipients", testAnnouncementRecipientToOneSetOpUserUsingUser)
+	t.Run("AssessmentToMarkCategoryUsingCategoryAssessments", testAssessmentToOneSetOpMarkCategoryUsingCategory)
+	t.Run("AssessmentToCourseworkUsingAssessments", testAssessmentToOneSetOpCourseworkUsingCoursework)
+	t.Run("AttemptToCourseworkUsingAttempts", testAttemptToOneSetOpCourseworkUsingCoursework)
+	t.Run("AttemptToUserUsingStudentAttempts", testAttemptToOneSetOpUserUsingStudent)
+	t.Run("AttendanceRecordToAttendanceSessionUsingSessionAttendanceRecords", testAttendanceRecordToOneSetOpAttendanceSessionUsingSession)
+	t.Run("AttendanceRecordToUserUsingStudentAttendanceRecords", testAttendanceRecordToOneSetOpUserUsingStudent)
+	t.Run("AttendanceSessionToClassUsingAttendanceSessions", testAttendanceSessionToOneSetOpClassUsingClass)
+	t.Run("AttendanceSessionToCourseUsingAttendanceSessions", testAttendanceSessionToOneSetOpCourseUsingCourse)
+	t.Run("AttendanceSessionToUserUsingTakenByAttendanceSessions", testAttendanceSessionToOneSetOpUserUsingTakenBy)
+	t.Run("BackupCodeToUserUsingBackupCodes", testBackupCodeToOneSetOpUserUsingUser)
+	t.Run("CalendarEventToCourseUsingCalendarEvents", testCalendarEventToOneSetOpCourseUsingCourse)
+	t.Run("CalendarEventToSchoolUsingCalendarEvents", testCalendarEventToOneSetOpSchoolUsingSchool)
+	t.Run("CalendarFeedToUserUsingCalendarFeed", testCalendarFeedToOneSetOpUserUsingUser)
+	t.Run("ChatMessageToUserUsingDeletedByChatMessages", testChatMessageToOneSetOpUserUsingDeletedBy)
+	t.Run("ChatMessageToChatRoomUsingRoomChatMessages", testChatMessageToOneSetOpChatRoomUsingRoom)
+	t.Run("ChatMessageToUserUsingSenderChatMessages", testChatMessageToOneSetOpUserUsingSender)
+	t.Run("ChatMuteToCourseUsingChatMutes", testChatMuteToOneSetOpCourseUsingCourse)
+	t.Run("ChatMuteToUserUsingMutedByChatMutes", testChatMuteToOneSetOpUserUsingMutedBy)
+	t.Run("ChatMuteToUserUsingChatMutes", testChatMuteToOneSetOpUserUsingUser)
+	t.Run("ChatReadToChatRoomUsingRoomChatReads", testChatReadToOneSetOpChatRoomUsingRoom)
+	t.Run("ChatReadToUserUsingChatReads", testChatReadToOneSetOpUserUsingUser)
+	t.Run("ChatRoomToCourseUsingChatRoom", testChatRoomToOneSetOpCourseUsingCourse)
+	t.Run("ChatRoomToUserUsingCreatedByChatRooms", testChatRoomToOneSetOpUserUsingCreatedBy)
+	t.Run("ChatRoomMemberToChatRoomUsingRoomChatRoomMembers", testChatRoomMemberToOneSetOpChatRoomUsingRoom)
+	t.Run("ChatRoomMemberToUserUsingChatRoomMembers", testChatRoomMemberToOneSetOpUserUsingUser)
+	t.Run("ClassToDepartmentUsingClasses", testClassToOneSetOpDepartmentUsingDepartment)
+	t.Run("ClassToSchoolUsingClasses", testClassToOneSetOpSchoolUsingSchool)
+	t.Run("ClassStudentToClassUsingClassStudents", testClassStudentToOneSetOpClassUsingClass)
+	t.Run("ClassStudentToUserUsingStudentClassStudents", testClassStudentToOneSetOpUserUsingStudent)
+	t.Run("CourseToClassUsingCourses", testCourseToOneSetOpClassUsingClass)
+	t.Run("CourseToUserUsingTeacherCourses", testCourseToOneSetOpUserUsingTeacher)
+	t.Run("CourseworkToCourseUsingCourseworks", testCourseworkToOneSetOpCourseUsingCourse)
+	t.Run("DepartmentToSchoolUsingDepartments", testDepartmentToOneSetOpSchoolUsingSchool)
+	t.Run("ExternalIdentityToSchoolUsingExternalIdentities", testExternalIdentityToOneSetOpSchoolUsingSchool)
+	t.Run("ExternalIdentityToUserUsingExternalIdentities", testExternalIdentityToOneSetOpUserUsingUser)
+	t.Run("GuardianLinkToUserUsingGuardianGuardianLinks", testGuardianLinkToOneSetOpUserUsingGuardian)
+	t.Run("GuardianLinkToUserUsingStudentGuardianLinks", testGuardianLinkToOneSetOpUserUsingStudent)
+	t.Run("MarkToAssessmentUsingMarks", testMarkToOneSetOpAssessmentUsingAssessment)
+	t.Run("MarkToUserUsingStudentMarks", testMarkToOneSetOpUserUsingStudent)
+	t.Run("MarkCategoryToCourseUsingMarkCategories", testMarkCategoryToOneSetOpCourseUsingCourse)
+	t.Run("MarkCategoryToTermUsingMarkCategories", testMarkCategoryToOneSetOpTermUsingTerm)
+	t.Run("MarkImportToAssessmentUsingMarkImports", testMarkImportToOneSetOpAssessmentUsingAssessment)
+	t.Run("MarkImportToUserUsingAuthorMarkImports", testMarkImportToOneSetOpUserUsingAuthor)
+	t.Run("NotificationToUserUsingNotifications", testNotificationToOneSetOpUserUsingUser)
+	t.Run("NotificationPreferenceToUserUsingNotificationPreferences", testNotificationPreferenceToOneSetOpUserUsingUser)
+	t.Run("PasswordlessLimitToUserUsingPasswordlessLimit", testPasswordlessLimitToOneSetOpUserUsingUser)
+	t.Run("PaymentToSchoolUsingPayments", testPaymentToOneSetOpSchoolUsingSchool)
+	t.Run("PushSubscriptionToUserUsingPushSubscriptions", testPushSubscriptionToOneSetOpUserUsingUser)
+	t.Run("QuestionToCourseUsingQuestions", testQuestionToOneSetOpCourseUsingCourse)
+	t.Run("RbacRoleToSchoolUsingRbacRoles", testRbacRoleToOneSetOpSchoolUsingSchool)
+	t.Run("RbacRoleMemberToRbacRoleUsingRoleRbacRoleMembers", testRbacRoleMemberToOneSetOpRbacRoleUsingRole)
+	t.Run("RbacRoleMemberToUserUsingRbacRoleMembers", testRbacRoleMemberToOneSetOpUserUsingUser)
+	t.Run("RefreshTokenToUserUsingRefreshTokens", testRefreshTokenToOneSetOpUserUsingUser)
+	t.Run("SchoolEmailDomainToSchoolUsingSchoolEmailDomains", testSchoolEmailDomainToOneSetOpSchoolUsingSchool)
+	t.Run("SMSUsageToSchoolUsingSMSUsages", testSMSUsageToOneSetOpSchoolUsingSchool)
+	t.Run("SubscriptionToSchoolUsingSubscription", testSubscriptionToOneSetOpSchoolUsingSchool)
+	t.Run("TermToSchoolUsingTerms", testTermToOneSetOpSchoolUsingSchool)
+	t.Run("TwoFactorToUserUsingTwoFactor", testTwoFactorToOneSetOpUserUsingUser)
+	t.Run("WalletAccountToSchoolUsingWalletAccounts", testWalletAccountToOneSetOpSchoolUsingSchool)
+	t.Run("WalletAwardToClassUsingWalletAwards", testWalletAwardToOneSetOpClassUsingClass)
+	t.Run("WalletAwardToSchoolUsingWalletAwards", testWalletAwardToOneSetOpSchoolUsingSchool)
+	t.Run("WalletAwardToUserUsingStudentWalletAwards", testWalletAwardToOneSetOpUserUsingStudent)
+	t.Run("WalletEntryToWalletAccountUsingAccountWalletEntries", testWalletEntryToOneSetOpWalletAccountUsingAccount)
+	t.Run("WalletEntryToWalletTransactionUsingTransactionWalletEntries", testWalletEntryToOneSetOpWalletTransactionUsingTransaction)
+	t.Run("WalletRuleToCourseUsingWalletRules", testWalletRuleToOneSetOpCourseUsingCourse)
+	t.Run("WalletTransactionToUserUsingAuthorWalletTransactions", testWalletTransactionToOneSetOpUserUsingAuthor)
+	t.Run("WalletTransactionToCourseUsingWalletTransactions", testWalletTransactionToOneSetOpCourseUsingCourse)
+	t.Run("WalletTransactionToSchoolUsingWalletTransactions", testWalletTransactionToOneSetOpSchoolUsingSchool)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("AnnouncementToUserUsingAuthorAnnouncements", testAnnouncementToOneRemoveOpUserUsingAuthor)
+	t.Run("AnnouncementToClassUsingAnnouncements", testAnnouncementToOneRemoveOpClassUsingClass)
+	t.Run("AnnouncementToDepartmentUsingAnnouncements", testAnnouncementToOneRemoveOpDepartmentUsingDepartment)
+	t.Run("AssessmentToCourseworkUsingAssessments", testAssessmentToOneRemoveOpCourseworkUsingCoursework)
+	t.Run("AttendanceSessionToCourseUsingAttendanceSessions", testAttendanceSessionToOneRemoveOpCourseUsingCourse)
+	t.Run("AttendanceSessionToUserUsingTakenByAttendanceSessions", testAttendanceSessionToOneRemoveOpUserUsingTakenBy)
+	t.Run("CalendarEventToCourseUsingCalendarEvents", testCalendarEventToOneRemoveOpCourseUsingCourse)
+	t.Run("ChatMessageToUserUsingDeletedByChatMessages", testChatMessageToOneRemoveOpUserUsingDeletedBy)
+	t.Run("ChatMessageToUserUsingSenderChatMessages", testChatMessageToOneRemoveOpUserUsingSender)
+	t.Run("ChatMuteToUserUsingMutedByChatMutes", testChatMuteToOneRemoveOpUserUsingMutedBy)
+	t.Run("ChatRoomToUserUsingCreatedByChatRooms", testChatRoomToOneRemoveOpUserUsingCreatedBy)
+	t.Run("ClassToDepartmentUsingClasses", testClassToOneRemoveOpDepartmentUsingDepartment)
+	t.Run("CourseToUserUsingTeacherCourses", testCourseToOneRemoveOpUserUsingTeacher)
+	t.Run("ExternalIdentityToSchoolUsingExternalIdentities", testExternalIdentityToOneRemoveOpSchoolUsingSchool)
+	t.Run("MarkImportToUserUsingAuthorMarkImports", testMarkImportToOneRemoveOpUserUsingAuthor)
+	t.Run("WalletTransactionToUserUsingAuthorWalletTransactions", testWalletTransactionToOneRemoveOpUserUsingAuthor)
+	t.Run("WalletTransactionToCourseUsingWalletTransactions", testWalletTransactionToOneRemoveOpCourseUsingCourse)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("CourseToChatRoomUsingChatRoom", testCourseOneToOneSetOpChatRoomUsingChatRoom)
+	t.Run("SchoolToSubscriptionUsingSubscription", testSchoolOneToOneSetOpSubscriptionUsingSubscription)
+	t.Run("UserToCalendarFeedUsingCalendarFeed", testUserOneToOneSetOpCalendarFeedUsingCalendarFeed)
+	t.Run("UserToPasswordlessLimitUsingPasswordlessLimit", testUserOneToOneSetOpPasswordlessLimitUsingPasswordlessLimit)
+	t.Run("UserToTwoFactorUsingTwoFactor", testUserOneToOneSetOpTwoFactorUsingTwoFactor)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -86,32 +927,384 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("AnnouncementToAnnouncementAttachments", testAnnouncementToManyAddOpAnnouncementAttachments)
+	t.Run("AnnouncementToAnnouncementRecipients", testAnnouncementToManyAddOpAnnouncementRecipients)
+	t.Run("AssessmentToMarks", testAssessmentToManyAddOpMarks)
+	t.Run("AssessmentToMarkImports", testAssessmentToManyAddOpMarkImports)
+	t.Run("AttendanceSessionToSessionAttendanceRecords", testAttendanceSessionToManyAddOpSessionAttendanceRecords)
+	t.Run("ChatRoomToRoomChatMessages", testChatRoomToManyAddOpRoomChatMessages)
+	t.Run("ChatRoomToRoomChatReads", testChatRoomToManyAddOpRoomChatReads)
+	t.Run("ChatRoomToRoomChatRoomMembers", testChatRoomToManyAddOpRoomChatRoomMembers)
+	t.Run("ClassToAnnouncements", testClassToManyAddOpAnnouncements)
+	t.Run("ClassToAttendanceSessions", testClassToManyAddOpAttendanceSessions)
+	t.Run("ClassToClassStudents", testClassToManyAddOpClassStudents)
+	t.Run("ClassToCourses", testClassToManyAddOpCourses)
+	t.Run("ClassToWalletAwards", testClassToManyAddOpWalletAwards)
+	t.Run("CourseToAttendanceSessions", testCourseToManyAddOpAttendanceSessions)
+	t.Run("CourseToCalendarEvents", testCourseToManyAddOpCalendarEvents)
+	t.Run("CourseToChatMutes", testCourseToManyAddOpChatMutes)
+	t.Run("CourseToCourseworks", testCourseToManyAddOpCourseworks)
+	t.Run("CourseToMarkCategories", testCourseToManyAddOpMarkCategories)
+	t.Run("CourseToQuestions", testCourseToManyAddOpQuestions)
+	t.Run("CourseToWalletRules", testCourseToManyAddOpWalletRules)
+	t.Run("CourseToWalletTransactions", testCourseToManyAddOpWalletTransactions)
+	t.Run("CourseworkToAssessments", testCourseworkToManyAddOpAssessments)
+	t.Run("CourseworkToAttempts", testCourseworkToManyAddOpAttempts)
+	t.Run("DepartmentToAnnouncements", testDepartmentToManyAddOpAnnouncements)
+	t.Run("DepartmentToClasses", testDepartmentToManyAddOpClasses)
+	t.Run("MarkCategoryToCategoryAssessments", testMarkCategoryToManyAddOpCategoryAssessments)
+	t.Run("RbacRoleToRoleRbacRoleMembers", testRbacRoleToManyAddOpRoleRbacRoleMembers)
+	t.Run("SchoolToAnnouncements", testSchoolToManyAddOpAnnouncements)
+	t.Run("SchoolToCalendarEvents", testSchoolToManyAddOpCalendarEvents)
+	t.Run("SchoolToClasses", testSchoolToManyAddOpClasses)
+	t.Run("SchoolToDepartments", testSchoolToManyAddOpDepartments)
+	t.Run("SchoolToExternalIdentities", testSchoolToManyAddOpExternalIdentities)
+	t.Run("SchoolToPayments", testSchoolToManyAddOpPayments)
+	t.Run("SchoolToRbacRoles", testSchoolToManyAddOpRbacRoles)
+	t.Run("SchoolToSchoolEmailDomains", testSchoolToManyAddOpSchoolEmailDomains)
+	t.Run("SchoolToSMSUsages", testSchoolToManyAddOpSMSUsages)
+	t.Run("SchoolToTerms", testSchoolToManyAddOpTerms)
+	t.Run("SchoolToWalletAccounts", testSchoolToManyAddOpWalletAccounts)
+	t.Run("SchoolToWalletAwards", testSchoolToManyAddOpWalletAwards)
+	t.Run("SchoolToWalletTransactions", testSchoolToManyAddOpWalletTransactions)
+	t.Run("TermToMarkCategories", testTermToManyAddOpMarkCategories)
+	t.Run("UserToAuthorAnnouncements", testUserToManyAddOpAuthorAnnouncements)
+	t.Run("UserToAnnouncementRecipients", testUserToManyAddOpAnnouncementRecipients)
+	t.Run("UserToStudentAttempts", testUserToManyAddOpStudentAttempts)
+	t.Run("UserToStudentAttendanceRecords", testUserToManyAddOpStudentAttendanceRecords)
+	t.Run("UserToTakenByAttendanceSessions", testUserToManyAddOpTakenByAttendanceSessions)
+	t.Run("UserToBackupCodes", testUserToManyAddOpBackupCodes)
+	t.Run("UserToDeletedByChatMessages", testUserToManyAddOpDeletedByChatMessages)
+	t.Run("UserToSenderChatMessages", testUserToManyAddOpSenderChatMessages)
+	t.Run("UserToMutedByChatMutes", testUserToManyAddOpMutedByChatMutes)
+	t.Run("UserToChatMutes", testUserToManyAddOpChatMutes)
+	t.Run("UserToChatReads", testUserToManyAddOpChatReads)
+	t.Run("UserToCreatedByChatRooms", testUserToManyAddOpCreatedByChatRooms)
+	t.Run("UserToChatRoomMembers", testUserToManyAddOpChatRoomMembers)
+	t.Run("UserToStudentClassStudents", testUserToManyAddOpStudentClassStudents)
+	t.Run("UserToTeacherCourses", testUserToManyAddOpTeacherCourses)
+	t.Run("UserToExternalIdentities", testUserToManyAddOpExternalIdentities)
+	t.Run("UserToGuardianGuardianLinks", testUserToManyAddOpGuardianGuardianLinks)
+	t.Run("UserToStudentGuardianLinks", testUserToManyAddOpStudentGuardianLinks)
+	t.Run("UserToStudentMarks", testUserToManyAddOpStudentMarks)
+	t.Run("UserToAuthorMarkImports", testUserToManyAddOpAuthorMarkImports)
+	t.Run("UserToNotifications", testUserToManyAddOpNotifications)
+	t.Run("UserToNotificationPreferences", testUserToManyAddOpNotificationPreferences)
+	t.Run("UserToPushSubscriptions", testUserToManyAddOpPushSubscriptions)
+	t.Run("UserToRbacRoleMembers", testUserToManyAddOpRbacRoleMembers)
+	t.Run("UserToRefreshTokens", testUserToManyAddOpRefreshTokens)
+	t.Run("UserToStudentWalletAwards", testUserToManyAddOpStudentWalletAwards)
+	t.Run("UserToAuthorWalletTransactions", testUserToManyAddOpAuthorWalletTransactions)
+	t.Run("WalletAccountToAccountWalletEntries", testWalletAccountToManyAddOpAccountWalletEntries)
+	t.Run("WalletTransactionToTransactionWalletEntries", testWalletTransactionToManyAddOpTransactionWalletEntries)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("ClassToAnnouncements", testClassToManySetOpAnnouncements)
+	t.Run("CourseToAttendanceSessions", testCourseToManySetOpAttendanceSessions)
+	t.Run("CourseToCalendarEvents", testCourseToManySetOpCalendarEvents)
+	t.Run("CourseToWalletTransactions", testCourseToManySetOpWalletTransactions)
+	t.Run("CourseworkToAssessments", testCourseworkToManySetOpAssessments)
+	t.Run("DepartmentToAnnouncements", testDepartmentToManySetOpAnnouncements)
+	t.Run("DepartmentToClasses", testDepartmentToManySetOpClasses)
+	t.Run("SchoolToExternalIdentities", testSchoolToManySetOpExternalIdentities)
+	t.Run("UserToAuthorAnnouncements", testUserToManySetOpAuthorAnnouncements)
+	t.Run("UserToTakenByAttendanceSessions", testUserToManySetOpTakenByAttendanceSessions)
+	t.Run("UserToDeletedByChatMessages", testUserToManySetOpDeletedByChatMessages)
+	t.Run("UserToSenderChatMessages", testUserToManySetOpSenderChatMessages)
+	t.Run("UserToMutedByChatMutes", testUserToManySetOpMutedByChatMutes)
+	t.Run("UserToCreatedByChatRooms", testUserToManySetOpCreatedByChatRooms)
+	t.Run("UserToTeacherCourses", testUserToManySetOpTeacherCourses)
+	t.Run("UserToAuthorMarkImports", testUserToManySetOpAuthorMarkImports)
+	t.Run("UserToAuthorWalletTransactions", testUserToManySetOpAuthorWalletTransactions)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("ClassToAnnouncements", testClassToManyRemoveOpAnnouncements)
+	t.Run("CourseToAttendanceSessions", testCourseToManyRemoveOpAttendanceSessions)
+	t.Run("CourseToCalendarEvents", testCourseToManyRemoveOpCalendarEvents)
+	t.Run("CourseToWalletTransactions", testCourseToManyRemoveOpWalletTransactions)
+	t.Run("CourseworkToAssessments", testCourseworkToManyRemoveOpAssessments)
+	t.Run("DepartmentToAnnouncements", testDepartmentToManyRemoveOpAnnouncements)
+	t.Run("DepartmentToClasses", testDepartmentToManyRemoveOpClasses)
+	t.Run("SchoolToExternalIdentities", testSchoolToManyRemoveOpExternalIdentities)
+	t.Run("UserToAuthorAnnouncements", testUserToManyRemoveOpAuthorAnnouncements)
+	t.Run("UserToTakenByAttendanceSessions", testUserToManyRemoveOpTakenByAttendanceSessions)
+	t.Run("UserToDeletedByChatMessages", testUserToManyRemoveOpDeletedByChatMessages)
+	t.Run("UserToSenderChatMessages", testUserToManyRemoveOpSenderChatMessages)
+	t.Run("UserToMutedByChatMutes", testUserToManyRemoveOpMutedByChatMutes)
+	t.Run("UserToCreatedByChatRooms", testUserToManyRemoveOpCreatedByChatRooms)
+	t.Run("UserToTeacherCourses", testUserToManyRemoveOpTeacherCourses)
+	t.Run("UserToAuthorMarkImports", testUserToManyRemoveOpAuthorMarkImports)
+	t.Run("UserToAuthorWalletTransactions", testUserToManyRemoveOpAuthorWalletTransactions)
+}
 
 func TestReload(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsReload)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsReload)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsReload)
+	t.Run("Assessments", testAssessmentsReload)
+	t.Run("Attempts", testAttemptsReload)
+	t.Run("AttendanceRecords", testAttendanceRecordsReload)
+	t.Run("AttendanceSessions", testAttendanceSessionsReload)
+	t.Run("AuditLogs", testAuditLogsReload)
+	t.Run("BackupCodes", testBackupCodesReload)
+	t.Run("CalendarEvents", testCalendarEventsReload)
+	t.Run("CalendarFeeds", testCalendarFeedsReload)
+	t.Run("ChatMessages", testChatMessagesReload)
+	t.Run("ChatMutes", testChatMutesReload)
+	t.Run("ChatReads", testChatReadsReload)
+	t.Run("ChatRooms", testChatRoomsReload)
+	t.Run("ChatRoomMembers", testChatRoomMembersReload)
+	t.Run("Classes", testClassesReload)
+	t.Run("ClassStudents", testClassStudentsReload)
+	t.Run("Courses", testCoursesReload)
+	t.Run("Courseworks", testCourseworksReload)
+	t.Run("Departments", testDepartmentsReload)
+	t.Run("ExternalIdentities", testExternalIdentitiesReload)
+	t.Run("GuardianLinks", testGuardianLinksReload)
+	t.Run("Jobs", testJobsReload)
+	t.Run("JWTKeys", testJWTKeysReload)
+	t.Run("Marks", testMarksReload)
+	t.Run("MarkCategories", testMarkCategoriesReload)
+	t.Run("MarkImports", testMarkImportsReload)
+	t.Run("Notifications", testNotificationsReload)
+	t.Run("NotificationPreferences", testNotificationPreferencesReload)
+	t.Run("OidcLogins", testOidcLoginsReload)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsReload)
+	t.Run("Payments", testPaymentsReload)
+	t.Run("PushSubscriptions", testPushSubscriptionsReload)
+	t.Run("Questions", testQuestionsReload)
+	t.Run("RbacRoles", testRbacRolesReload)
+	t.Run("RbacRoleMembers", testRbacRoleMembersReload)
+	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("Schools", testSchoolsReload)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsReload)
+	t.Run("SMSUsages", testSMSUsagesReload)
+	t.Run("Subscriptions", testSubscriptionsReload)
+	t.Run("Terms", testTermsReload)
+	t.Run("TwoFactors", testTwoFactorsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WalletAccounts", testWalletAccountsReload)
+	t.Run("WalletAwards", testWalletAwardsReload)
+	t.Run("WalletEntries", testWalletEntriesReload)
+	t.Run("WalletRules", testWalletRulesReload)
+	t.Run("WalletTransactions", testWalletTransactionsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsReloadAll)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsReloadAll)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsReloadAll)
+	t.Run("Assessments", testAssessmentsReloadAll)
+	t.Run("Attempts", testAttemptsReloadAll)
+	t.Run("AttendanceRecords", testAttendanceRecordsReloadAll)
+	t.Run("AttendanceSessions", testAttendanceSessionsReloadAll)
+	t.Run("AuditLogs", testAuditLogsReloadAll)
+	t.Run("BackupCodes", testBackupCodesReloadAll)
+	t.Run("CalendarEvents", testCalendarEventsReloadAll)
+	t.Run("CalendarFeeds", testCalendarFeedsReloadAll)
+	t.Run("ChatMessages", testChatMessagesReloadAll)
+	t.Run("ChatMutes", testChatMutesReloadAll)
+	t.Run("ChatReads", testChatReadsReloadAll)
+	t.Run("ChatRooms", testChatRoomsReloadAll)
+	t.Run("ChatRoomMembers", testChatRoomMembersReloadAll)
+	t.Run("Classes", testClassesReloadAll)
+	t.Run("ClassStudents", testClassStudentsReloadAll)
+	t.Run("Courses", testCoursesReloadAll)
+	t.Run("Courseworks", testCourseworksReloadAll)
+	t.Run("Departments", testDepartmentsReloadAll)
+	t.Run("ExternalIdentities", testExternalIdentitiesReloadAll)
+	t.Run("GuardianLinks", testGuardianLinksReloadAll)
+	t.Run("Jobs", testJobsReloadAll)
+	t.Run("JWTKeys", testJWTKeysReloadAll)
+	t.Run("Marks", testMarksReloadAll)
+	t.Run("MarkCategories", testMarkCategoriesReloadAll)
+	t.Run("MarkImports", testMarkImportsReloadAll)
+	t.Run("Notifications", testNotificationsReloadAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesReloadAll)
+	t.Run("OidcLogins", testOidcLoginsReloadAll)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsReloadAll)
+	t.Run("Payments", testPaymentsReloadAll)
+	t.Run("PushSubscriptions", testPushSubscriptionsReloadAll)
+	t.Run("Questions", testQuestionsReloadAll)
+	t.Run("RbacRoles", testRbacRolesReloadAll)
+	t.Run("RbacRoleMembers", testRbacRoleMembersReloadAll)
+	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("Schools", testSchoolsReloadAll)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsReloadAll)
+	t.Run("SMSUsages", testSMSUsagesReloadAll)
+	t.Run("Subscriptions", testSubscriptionsReloadAll)
+	t.Run("Terms", testTermsReloadAll)
+	t.Run("TwoFactors", testTwoFactorsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WalletAccounts", testWalletAccountsReloadAll)
+	t.Run("WalletAwards", testWalletAwardsReloadAll)
+	t.Run("WalletEntries", testWalletEntriesReloadAll)
+	t.Run("WalletRules", testWalletRulesReloadAll)
+	t.Run("WalletTransactions", testWalletTransactionsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsSelect)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsSelect)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsSelect)
+	t.Run("Assessments", testAssessmentsSelect)
+	t.Run("Attempts", testAttemptsSelect)
+	t.Run("AttendanceRecords", testAttendanceRecordsSelect)
+	t.Run("AttendanceSessions", testAttendanceSessionsSelect)
+	t.Run("AuditLogs", testAuditLogsSelect)
+	t.Run("BackupCodes", testBackupCodesSelect)
+	t.Run("CalendarEvents", testCalendarEventsSelect)
+	t.Run("CalendarFeeds", testCalendarFeedsSelect)
+	t.Run("ChatMessages", testChatMessagesSelect)
+	t.Run("ChatMutes", testChatMutesSelect)
+	t.Run("ChatReads", testChatReadsSelect)
+	t.Run("ChatRooms", testChatRoomsSelect)
+	t.Run("ChatRoomMembers", testChatRoomMembersSelect)
+	t.Run("Classes", testClassesSelect)
+	t.Run("ClassStudents", testClassStudentsSelect)
+	t.Run("Courses", testCoursesSelect)
+	t.Run("Courseworks", testCourseworksSelect)
+	t.Run("Departments", testDepartmentsSelect)
+	t.Run("ExternalIdentities", testExternalIdentitiesSelect)
+	t.Run("GuardianLinks", testGuardianLinksSelect)
+	t.Run("Jobs", testJobsSelect)
+	t.Run("JWTKeys", testJWTKeysSelect)
+	t.Run("Marks", testMarksSelect)
+	t.Run("MarkCategories", testMarkCategoriesSelect)
+	t.Run("MarkImports", testMarkImportsSelect)
+	t.Run("Notifications", testNotificationsSelect)
+	t.Run("NotificationPreferences", testNotificationPreferencesSelect)
+	t.Run("OidcLogins", testOidcLoginsSelect)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsSelect)
+	t.Run("Payments", testPaymentsSelect)
+	t.Run("PushSubscriptions", testPushSubscriptionsSelect)
+	t.Run("Questions", testQuestionsSelect)
+	t.Run("RbacRoles", testRbacRolesSelect)
+	t.Run("RbacRoleMembers", testRbacRoleMembersSelect)
+	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("Schools", testSchoolsSelect)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsSelect)
+	t.Run("SMSUsages", testSMSUsagesSelect)
+	t.Run("Subscriptions", testSubscriptionsSelect)
+	t.Run("Terms", testTermsSelect)
+	t.Run("TwoFactors", testTwoFactorsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WalletAccounts", testWalletAccountsSelect)
+	t.Run("WalletAwards", testWalletAwardsSelect)
+	t.Run("WalletEntries", testWalletEntriesSelect)
+	t.Run("WalletRules", testWalletRulesSelect)
+	t.Run("WalletTransactions", testWalletTransactionsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsUpdate)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsUpdate)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsUpdate)
+	t.Run("Assessments", testAssessmentsUpdate)
+	t.Run("Attempts", testAttemptsUpdate)
+	t.Run("AttendanceRecords", testAttendanceRecordsUpdate)
+	t.Run("AttendanceSessions", testAttendanceSessionsUpdate)
+	t.Run("AuditLogs", testAuditLogsUpdate)
+	t.Run("BackupCodes", testBackupCodesUpdate)
+	t.Run("CalendarEvents", testCalendarEventsUpdate)
+	t.Run("CalendarFeeds", testCalendarFeedsUpdate)
+	t.Run("ChatMessages", testChatMessagesUpdate)
+	t.Run("ChatMutes", testChatMutesUpdate)
+	t.Run("ChatReads", testChatReadsUpdate)
+	t.Run("ChatRooms", testChatRoomsUpdate)
+	t.Run("ChatRoomMembers", testChatRoomMembersUpdate)
+	t.Run("Classes", testClassesUpdate)
+	t.Run("ClassStudents", testClassStudentsUpdate)
+	t.Run("Courses", testCoursesUpdate)
+	t.Run("Courseworks", testCourseworksUpdate)
+	t.Run("Departments", testDepartmentsUpdate)
+	t.Run("ExternalIdentities", testExternalIdentitiesUpdate)
+	t.Run("GuardianLinks", testGuardianLinksUpdate)
+	t.Run("Jobs", testJobsUpdate)
+	t.Run("JWTKeys", testJWTKeysUpdate)
+	t.Run("Marks", testMarksUpdate)
+	t.Run("MarkCategories", testMarkCategoriesUpdate)
+	t.Run("MarkImports", testMarkImportsUpdate)
+	t.Run("Notifications", testNotificationsUpdate)
+	t.Run("NotificationPreferences", testNotificationPreferencesUpdate)
+	t.Run("OidcLogins", testOidcLoginsUpdate)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsUpdate)
+	t.Run("Payments", testPaymentsUpdate)
+	t.Run("PushSubscriptions", testPushSubscriptionsUpdate)
+	t.Run("Questions", testQuestionsUpdate)
+	t.Run("RbacRoles", testRbacRolesUpdate)
+	t.Run("RbacRoleMembers", testRbacRoleMembersUpdate)
+	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("Schools", testSchoolsUpdate)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsUpdate)
+	t.Run("SMSUsages", testSMSUsagesUpdate)
+	t.Run("Subscriptions", testSubscriptionsUpdate)
+	t.Run("Terms", testTermsUpdate)
+	t.Run("TwoFactors", testTwoFactorsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WalletAccounts", testWalletAccountsUpdate)
+	t.Run("WalletAwards", testWalletAwardsUpdate)
+	t.Run("WalletEntries", testWalletEntriesUpdate)
+	t.Run("WalletRules", testWalletRulesUpdate)
+	t.Run("WalletTransactions", testWalletTransactionsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsSliceUpdateAll)
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsSliceUpdateAll)
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsSliceUpdateAll)
+	t.Run("Assessments", testAssessmentsSliceUpdateAll)
+	t.Run("Attempts", testAttemptsSliceUpdateAll)
+	t.Run("AttendanceRecords", testAttendanceRecordsSliceUpdateAll)
+	t.Run("AttendanceSessions", testAttendanceSessionsSliceUpdateAll)
+	t.Run("AuditLogs", testAuditLogsSliceUpdateAll)
+	t.Run("BackupCodes", testBackupCodesSliceUpdateAll)
+	t.Run("CalendarEvents", testCalendarEventsSliceUpdateAll)
+	t.Run("CalendarFeeds", testCalendarFeedsSliceUpdateAll)
+	t.Run("ChatMessages", testChatMessagesSliceUpdateAll)
+	t.Run("ChatMutes", testChatMutesSliceUpdateAll)
+	t.Run("ChatReads", testChatReadsSliceUpdateAll)
+	t.Run("ChatRooms", testChatRoomsSliceUpdateAll)
+	t.Run("ChatRoomMembers", testChatRoomMembersSliceUpdateAll)
+	t.Run("Classes", testClassesSliceUpdateAll)
+	t.Run("ClassStudents", testClassStudentsSliceUpdateAll)
+	t.Run("Courses", testCoursesSliceUpdateAll)
+	t.Run("Courseworks", testCourseworksSliceUpdateAll)
+	t.Run("Departments", testDepartmentsSliceUpdateAll)
+	t.Run("ExternalIdentities", testExternalIdentitiesSliceUpdateAll)
+	t.Run("GuardianLinks", testGuardianLinksSliceUpdateAll)
+	t.Run("Jobs", testJobsSliceUpdateAll)
+	t.Run("JWTKeys", testJWTKeysSliceUpdateAll)
+	t.Run("Marks", testMarksSliceUpdateAll)
+	t.Run("MarkCategories", testMarkCategoriesSliceUpdateAll)
+	t.Run("MarkImports", testMarkImportsSliceUpdateAll)
+	t.Run("Notifications", testNotificationsSliceUpdateAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceUpdateAll)
+	t.Run("OidcLogins", testOidcLoginsSliceUpdateAll)
+	t.Run("PasswordlessLimits", testPasswordlessLimitsSliceUpdateAll)
+	t.Run("Payments", testPaymentsSliceUpdateAll)
+	t.Run("PushSubscriptions", testPushSubscriptionsSliceUpdateAll)
+	t.Run("Questions", testQuestionsSliceUpdateAll)
+	t.Run("RbacRoles", testRbacRolesSliceUpdateAll)
+	t.Run("RbacRoleMembers", testRbacRoleMembersSliceUpdateAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("Schools", testSchoolsSliceUpdateAll)
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsSliceUpdateAll)
+	t.Run("SMSUsages", testSMSUsagesSliceUpdateAll)
+	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
+	t.Run("Terms", testTermsSliceUpdateAll)
+	t.Run("TwoFactors", testTwoFactorsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WalletAccounts", testWalletAccountsSliceUpdateAll)
+	t.Run("WalletAwards", testWalletAwardsSliceUpdateAll)
+	t.Run("WalletEntries", testWalletEntriesSliceUpdateAll)
+	t.Run("WalletRules", testWalletRulesSliceUpdateAll)
+	t.Run("WalletTransactions", testWalletTransactionsSliceUpdateAll)
 }
@@ -4,7 +4,105 @@
 package models
 
 var TableNames = struct {
-	User string
+	Announcement           string
+	AnnouncementAttachment string
+	AnnouncementRecipient  string
+	Assessment             string
+	Attempt                string
+	AttendanceRecord       string
+	AttendanceSession      string
+	AuditLog               string
+	BackupCode             string
+	CalendarEvent          string
+	CalendarFeed           string
+	ChatMessage            string
+	ChatMute               string
+	ChatRead               string
+	ChatRoom               string
+	ChatRoomMember         string
+	Class                  string
+	ClassStudent           string
+	Course                 string
+	Coursework             string
+	Department             string
+	ExternalIdentity       string
+	GuardianLink           string
+	Job                    string
+	JWTKey                 string
+	Mark                   string
+	MarkCategory           string
+	MarkImport             string
+	Notification           string
+	NotificationPreference string
+	OidcLogin              string
+	PasswordlessLimit      string
+	Payment                string
+	PushSubscription       string
+	Question               string
+	RbacRole               string
+	RbacRoleMember         string
+	RefreshToken           string
+	School                 string
+	SchoolEmailDomain      string
+	SMSUsage               string
+	Subscription           string
+	Term                   string
+	TwoFactor              string
+	User                   string
+	WalletAccount          string
+	WalletAward            string
+	WalletEntry            string
+	WalletRule             string
+	WalletTransaction      string
 }{
-	User: "user",
+	Announcement:           "announcement",
+	AnnouncementAttachment: "announcement_attachment",
+	AnnouncementRecipient:  "announcement_recipient",
+	Assessment:             "assessment",
+	Attempt:                "attempt",
+	AttendanceRecord:       "attendance_record",
+	AttendanceSession:      "attendance_session",
+	AuditLog:               "audit_log",
+	BackupCode:             "backup_code",
+	CalendarEvent:          "calendar_event",
+	CalendarFeed:           "calendar_feed",
+	ChatMessage:            "chat_message",
+	ChatMute:               "chat_mute",
+	ChatRead:               "chat_read",
+	ChatRoom:               "chat_room",
+	ChatRoomMember:         "chat_room_member",
+	Class:                  "class",
+	ClassStudent:           "class_student",
+	Course:                 "course",
+	Coursework:             "coursework",
+	Department:             "department",
+	ExternalIdentity:       "external_identity",
+	GuardianLink:           "guardian_link",
+	Job:                    "job",
+	JWTKey:                 "jwt_key",
+	Mark:                   "mark",
+	MarkCategory:           "mark_category",
+	MarkImport:             "mark_import",
+	Notification:           "notification",
+	NotificationPreference: "notification_preference",
+	OidcLogin:              "oidc_login",
+	PasswordlessLimit:      "passwordless_limit",
+	Payment:                "payment",
+	PushSubscription:       "push_subscription",
+	Question:               "question",
+	RbacRole:               "rbac_role",
+	RbacRoleMember:         "rbac_role_member",
+	RefreshToken:           "refresh_token",
+	School:                 "school",
+	SchoolEmailDomain:      "school_email_domain",
+	SMSUsage:               "sms_usage",
+	Subscription:           "subscription",
+	Term:                   "term",
+	TwoFactor:              "two_factor",
+	User:                   "user",
+	WalletAccount:          "wallet_account",
+	WalletAward:            "wallet_award",
+	WalletEntry:            "wallet_entry",
+	WalletRule:             "wallet_rule",
+	WalletTransaction:      "wallet_transaction",
 }
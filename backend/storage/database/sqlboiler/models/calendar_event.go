@@ -0,0 +1,1191 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CalendarEvent is an object representing the database table.
+type CalendarEvent struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID    string      `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	CourseID    null.String `boil:"course_id" json:"course_id,omitempty" toml:"course_id" yaml:"course_id,omitempty"`
+	Kind        string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Title       string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Description string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	AllDay      bool        `boil:"all_day" json:"all_day" toml:"all_day" yaml:"all_day"`
+	StartsAt    time.Time   `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt      time.Time   `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	CreatedAt   null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *calendarEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L calendarEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CalendarEventColumns = struct {
+	ID          string
+	SchoolID    string
+	CourseID    string
+	Kind        string
+	Title       string
+	Description string
+	AllDay      string
+	StartsAt    string
+	EndsAt      string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	SchoolID:    "school_id",
+	CourseID:    "course_id",
+	Kind:        "kind",
+	Title:       "title",
+	Description: "description",
+	AllDay:      "all_day",
+	StartsAt:    "starts_at",
+	EndsAt:      "ends_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+// Generated where
+
+var CalendarEventWhere = struct {
+	ID          whereHelperstring
+	SchoolID    whereHelperstring
+	CourseID    whereHelpernull_String
+	Kind        whereHelperstring
+	Title       whereHelperstring
+	Description whereHelperstring
+	AllDay      whereHelperbool
+	StartsAt    whereHelpertime_Time
+	EndsAt      whereHelpertime_Time
+	CreatedAt   whereHelpernull_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"calendar_event\".\"id\""},
+	SchoolID:    whereHelperstring{field: "\"calendar_event\".\"school_id\""},
+	CourseID:    whereHelpernull_String{field: "\"calendar_event\".\"course_id\""},
+	Kind:        whereHelperstring{field: "\"calendar_event\".\"kind\""},
+	Title:       whereHelperstring{field: "\"calendar_event\".\"title\""},
+	Description: whereHelperstring{field: "\"calendar_event\".\"description\""},
+	AllDay:      whereHelperbool{field: "\"calendar_event\".\"all_day\""},
+	StartsAt:    whereHelpertime_Time{field: "\"calendar_event\".\"starts_at\""},
+	EndsAt:      whereHelpertime_Time{field: "\"calendar_event\".\"ends_at\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"calendar_event\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"calendar_event\".\"updated_at\""},
+}
+
+// CalendarEventRels is where relationship names are stored.
+var CalendarEventRels = struct {
+	Course string
+	School string
+}{
+	Course: "Course",
+	School: "School",
+}
+
+// calendarEventR is where relationships are stored.
+type calendarEventR struct {
+	Course *Course `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	School *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+}
+
+// NewStruct creates a new relationship struct
+func (*calendarEventR) NewStruct() *calendarEventR {
+	return &calendarEventR{}
+}
+
+// calendarEventL is where Load methods for each relationship are stored.
+type calendarEventL struct{}
+
+var (
+	calendarEventAllColumns            = []string{"id", "school_id", "course_id", "kind", "title", "description", "all_day", "starts_at", "ends_at", "created_at", "updated_at"}
+	calendarEventColumnsWithoutDefault = []string{"id", "school_id", "course_id", "kind", "title", "all_day", "starts_at", "ends_at", "created_at", "updated_at"}
+	calendarEventColumnsWithDefault    = []string{"description"}
+	calendarEventPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CalendarEventSlice is an alias for a slice of pointers to CalendarEvent.
+	// This should generally be used opposed to []CalendarEvent.
+	CalendarEventSlice []*CalendarEvent
+
+	calendarEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	calendarEventType                 = reflect.TypeOf(&CalendarEvent{})
+	calendarEventMapping              = queries.MakeStructMapping(calendarEventType)
+	calendarEventPrimaryKeyMapping, _ = queries.BindMapping(calendarEventType, calendarEventMapping, calendarEventPrimaryKeyColumns)
+	calendarEventInsertCacheMut       sync.RWMutex
+	calendarEventInsertCache          = make(map[string]insertCache)
+	calendarEventUpdateCacheMut       sync.RWMutex
+	calendarEventUpdateCache          = make(map[string]updateCache)
+	calendarEventUpsertCacheMut       sync.RWMutex
+	calendarEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single calendarEvent record from the query using the global executor.
+func (q calendarEventQuery) OneG(ctx context.Context) (*CalendarEvent, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single calendarEvent record from the query.
+func (q calendarEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CalendarEvent, error) {
+	o := &CalendarEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for calendar_event")
+	}
+
+	return o, nil
+}
+
+// AllG returns all CalendarEvent records from the query using the global executor.
+func (q calendarEventQuery) AllG(ctx context.Context) (CalendarEventSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all CalendarEvent records from the query.
+func (q calendarEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (CalendarEventSlice, error) {
+	var o []*CalendarEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CalendarEvent slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all CalendarEvent records in the query, and panics on error.
+func (q calendarEventQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all CalendarEvent records in the query.
+func (q calendarEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count calendar_event rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q calendarEventQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q calendarEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if calendar_event exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *CalendarEvent) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// School pointed to by the foreign key.
+func (o *CalendarEvent) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (calendarEventL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCalendarEvent interface{}, mods queries.Applicator) error {
+	var slice []*CalendarEvent
+	var object *CalendarEvent
+
+	if singular {
+		object = maybeCalendarEvent.(*CalendarEvent)
+	} else {
+		slice = *maybeCalendarEvent.(*[]*CalendarEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &calendarEventR{}
+		}
+		if !queries.IsNil(object.CourseID) {
+			args = append(args, object.CourseID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &calendarEventR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CourseID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CourseID) {
+				args = append(args, obj.CourseID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.CalendarEvents = append(foreign.R.CalendarEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CourseID, foreign.ID) {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.CalendarEvents = append(foreign.R.CalendarEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (calendarEventL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCalendarEvent interface{}, mods queries.Applicator) error {
+	var slice []*CalendarEvent
+	var object *CalendarEvent
+
+	if singular {
+		object = maybeCalendarEvent.(*CalendarEvent)
+	} else {
+		slice = *maybeCalendarEvent.(*[]*CalendarEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &calendarEventR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &calendarEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.CalendarEvents = append(foreign.R.CalendarEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.CalendarEvents = append(foreign.R.CalendarEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the calendarEvent to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.CalendarEvents.
+// Uses the global database handle.
+func (o *CalendarEvent) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the calendarEvent to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.CalendarEvents.
+func (o *CalendarEvent) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"calendar_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, calendarEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CourseID, related.ID)
+	if o.R == nil {
+		o.R = &calendarEventR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			CalendarEvents: CalendarEventSlice{o},
+		}
+	} else {
+		related.R.CalendarEvents = append(related.R.CalendarEvents, o)
+	}
+
+	return nil
+}
+
+// RemoveCourseG relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *CalendarEvent) RemoveCourseG(ctx context.Context, related *Course) error {
+	return o.RemoveCourse(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveCourse relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *CalendarEvent) RemoveCourse(ctx context.Context, exec boil.ContextExecutor, related *Course) error {
+	var err error
+
+	queries.SetScanner(&o.CourseID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Course = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CalendarEvents {
+		if queries.Equal(o.CourseID, ri.CourseID) {
+			continue
+		}
+
+		ln := len(related.R.CalendarEvents)
+		if ln > 1 && i < ln-1 {
+			related.R.CalendarEvents[i] = related.R.CalendarEvents[ln-1]
+		}
+		related.R.CalendarEvents = related.R.CalendarEvents[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSchoolG of the calendarEvent to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.CalendarEvents.
+// Uses the global database handle.
+func (o *CalendarEvent) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the calendarEvent to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.CalendarEvents.
+func (o *CalendarEvent) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"calendar_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, calendarEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &calendarEventR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			CalendarEvents: CalendarEventSlice{o},
+		}
+	} else {
+		related.R.CalendarEvents = append(related.R.CalendarEvents, o)
+	}
+
+	return nil
+}
+
+// CalendarEvents retrieves all the records using an executor.
+func CalendarEvents(mods ...qm.QueryMod) calendarEventQuery {
+	mods = append(mods, qm.From("\"calendar_event\""))
+	return calendarEventQuery{NewQuery(mods...)}
+}
+
+// FindCalendarEventG retrieves a single record by ID.
+func FindCalendarEventG(ctx context.Context, iD string, selectCols ...string) (*CalendarEvent, error) {
+	return FindCalendarEvent(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindCalendarEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCalendarEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*CalendarEvent, error) {
+	calendarEventObj := &CalendarEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"calendar_event\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, calendarEventObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from calendar_event")
+	}
+
+	return calendarEventObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *CalendarEvent) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CalendarEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no calendar_event provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(calendarEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	calendarEventInsertCacheMut.RLock()
+	cache, cached := calendarEventInsertCache[key]
+	calendarEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			calendarEventAllColumns,
+			calendarEventColumnsWithDefault,
+			calendarEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(calendarEventType, calendarEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(calendarEventType, calendarEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"calendar_event\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"calendar_event\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into calendar_event")
+	}
+
+	if !cached {
+		calendarEventInsertCacheMut.Lock()
+		calendarEventInsertCache[key] = cache
+		calendarEventInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single CalendarEvent record using the global executor.
+// See Update for more documentation.
+func (o *CalendarEvent) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the CalendarEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CalendarEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	calendarEventUpdateCacheMut.RLock()
+	cache, cached := calendarEventUpdateCache[key]
+	calendarEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			calendarEventAllColumns,
+			calendarEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update calendar_event, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"calendar_event\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, calendarEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(calendarEventType, calendarEventMapping, append(wl, calendarEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update calendar_event row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for calendar_event")
+	}
+
+	if !cached {
+		calendarEventUpdateCacheMut.Lock()
+		calendarEventUpdateCache[key] = cache
+		calendarEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q calendarEventQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q calendarEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for calendar_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for calendar_event")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CalendarEventSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CalendarEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), calendarEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"calendar_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, calendarEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in calendarEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all calendarEvent")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *CalendarEvent) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CalendarEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no calendar_event provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(calendarEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	calendarEventUpsertCacheMut.RLock()
+	cache, cached := calendarEventUpsertCache[key]
+	calendarEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			calendarEventAllColumns,
+			calendarEventColumnsWithDefault,
+			calendarEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			calendarEventAllColumns,
+			calendarEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert calendar_event, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(calendarEventPrimaryKeyColumns))
+			copy(conflict, calendarEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"calendar_event\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(calendarEventType, calendarEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(calendarEventType, calendarEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert calendar_event")
+	}
+
+	if !cached {
+		calendarEventUpsertCacheMut.Lock()
+		calendarEventUpsertCache[key] = cache
+		calendarEventUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single CalendarEvent record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *CalendarEvent) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single CalendarEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CalendarEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CalendarEvent provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), calendarEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"calendar_event\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from calendar_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for calendar_event")
+	}
+
+	return rowsAff, nil
+}
+
+func (q calendarEventQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q calendarEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no calendarEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from calendar_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for calendar_event")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CalendarEventSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CalendarEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), calendarEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"calendar_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, calendarEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from calendarEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for calendar_event")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *CalendarEvent) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no CalendarEvent provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CalendarEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCalendarEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CalendarEventSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty CalendarEventSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CalendarEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CalendarEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), calendarEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"calendar_event\".* FROM \"calendar_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, calendarEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CalendarEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CalendarEventExistsG checks if the CalendarEvent row exists.
+func CalendarEventExistsG(ctx context.Context, iD string) (bool, error) {
+	return CalendarEventExists(ctx, boil.GetContextDB(), iD)
+}
+
+// CalendarEventExists checks if the CalendarEvent row exists.
+func CalendarEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"calendar_event\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if calendar_event exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,860 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCalendarEvents(t *testing.T) {
+	t.Parallel()
+
+	query := CalendarEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCalendarEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CalendarEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CalendarEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CalendarEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CalendarEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CalendarEventExists to return true, but got false.")
+	}
+}
+
+func testCalendarEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	calendarEventFound, err := FindCalendarEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if calendarEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCalendarEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CalendarEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CalendarEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCalendarEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	calendarEventOne := &CalendarEvent{}
+	calendarEventTwo := &CalendarEvent{}
+	if err = randomize.Struct(seed, calendarEventOne, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, calendarEventTwo, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = calendarEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = calendarEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CalendarEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCalendarEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	calendarEventOne := &CalendarEvent{}
+	calendarEventTwo := &CalendarEvent{}
+	if err = randomize.Struct(seed, calendarEventOne, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, calendarEventTwo, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = calendarEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = calendarEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testCalendarEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCalendarEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(calendarEventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCalendarEventToOneCourseUsingCourse(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CalendarEvent
+	var foreign Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CourseID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Course().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CalendarEventSlice{&local}
+	if err = local.L.LoadCourse(ctx, tx, false, (*[]*CalendarEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Course = nil
+	if err = local.L.LoadCourse(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCalendarEventToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CalendarEvent
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SchoolID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CalendarEventSlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*CalendarEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCalendarEventToOneSetOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CalendarEvent
+	var b, c Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Course{&b, &c} {
+		err = a.SetCourse(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Course != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CalendarEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CourseID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CourseID, x.ID) {
+			t.Error("foreign key was wrong value", a.CourseID, x.ID)
+		}
+	}
+}
+
+func testCalendarEventToOneRemoveOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CalendarEvent
+	var b Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCourse(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCourse(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Course().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Course != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CourseID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CalendarEvents) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCalendarEventToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CalendarEvent
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CalendarEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testCalendarEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CalendarEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CalendarEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	calendarEventDBTypes = map[string]string{`ID`: `uuid`, `SchoolID`: `uuid`, `CourseID`: `uuid`, `Kind`: `character varying`, `Title`: `character varying`, `Description`: `text`, `AllDay`: `boolean`, `StartsAt`: `timestamp without time zone`, `EndsAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testCalendarEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(calendarEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(calendarEventAllColumns) == len(calendarEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCalendarEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(calendarEventAllColumns) == len(calendarEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarEvent{}
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, calendarEventDBTypes, true, calendarEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(calendarEventAllColumns, calendarEventPrimaryKeyColumns) {
+		fields = calendarEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			calendarEventAllColumns,
+			calendarEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CalendarEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCalendarEventsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(calendarEventAllColumns) == len(calendarEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CalendarEvent{}
+	if err = randomize.Struct(seed, &o, calendarEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CalendarEvent: %s", err)
+	}
+
+	count, err := CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, calendarEventDBTypes, false, calendarEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CalendarEvent: %s", err)
+	}
+
+	count, err = CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
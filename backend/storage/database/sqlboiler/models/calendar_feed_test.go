@@ -0,0 +1,696 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCalendarFeeds(t *testing.T) {
+	t.Parallel()
+
+	query := CalendarFeeds()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCalendarFeedsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarFeedsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CalendarFeeds().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarFeedsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CalendarFeedSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCalendarFeedsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CalendarFeedExists(ctx, tx, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if CalendarFeed exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CalendarFeedExists to return true, but got false.")
+	}
+}
+
+func testCalendarFeedsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	calendarFeedFound, err := FindCalendarFeed(ctx, tx, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if calendarFeedFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCalendarFeedsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CalendarFeeds().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarFeedsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CalendarFeeds().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCalendarFeedsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	calendarFeedOne := &CalendarFeed{}
+	calendarFeedTwo := &CalendarFeed{}
+	if err = randomize.Struct(seed, calendarFeedOne, calendarFeedDBTypes, false, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+	if err = randomize.Struct(seed, calendarFeedTwo, calendarFeedDBTypes, false, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = calendarFeedOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = calendarFeedTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CalendarFeeds().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCalendarFeedsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	calendarFeedOne := &CalendarFeed{}
+	calendarFeedTwo := &CalendarFeed{}
+	if err = randomize.Struct(seed, calendarFeedOne, calendarFeedDBTypes, false, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+	if err = randomize.Struct(seed, calendarFeedTwo, calendarFeedDBTypes, false, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = calendarFeedOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = calendarFeedTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testCalendarFeedsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCalendarFeedsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(calendarFeedColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCalendarFeedToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CalendarFeed
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, calendarFeedDBTypes, false, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CalendarFeedSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*CalendarFeed)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCalendarFeedToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CalendarFeed
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, calendarFeedDBTypes, false, strmangle.SetComplement(calendarFeedPrimaryKeyColumns, calendarFeedColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CalendarFeed != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := CalendarFeedExists(ctx, tx, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testCalendarFeedsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarFeedsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CalendarFeedSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCalendarFeedsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CalendarFeeds().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	calendarFeedDBTypes = map[string]string{`UserID`: `uuid`, `TokenHash`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                   = bytes.MinRead
+)
+
+func testCalendarFeedsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(calendarFeedPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(calendarFeedAllColumns) == len(calendarFeedPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCalendarFeedsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(calendarFeedAllColumns) == len(calendarFeedPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CalendarFeed{}
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, calendarFeedDBTypes, true, calendarFeedPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(calendarFeedAllColumns, calendarFeedPrimaryKeyColumns) {
+		fields = calendarFeedAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			calendarFeedAllColumns,
+			calendarFeedPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CalendarFeedSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCalendarFeedsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(calendarFeedAllColumns) == len(calendarFeedPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CalendarFeed{}
+	if err = randomize.Struct(seed, &o, calendarFeedDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CalendarFeed: %s", err)
+	}
+
+	count, err := CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, calendarFeedDBTypes, false, calendarFeedPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CalendarFeed struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CalendarFeed: %s", err)
+	}
+
+	count, err = CalendarFeeds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
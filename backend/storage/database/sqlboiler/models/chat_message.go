@@ -0,0 +1,1389 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatMessage is an object representing the database table.
+type ChatMessage struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RoomID      string      `boil:"room_id" json:"room_id" toml:"room_id" yaml:"room_id"`
+	SenderID    null.String `boil:"sender_id" json:"sender_id,omitempty" toml:"sender_id" yaml:"sender_id,omitempty"`
+	Body        string      `boil:"body" json:"body" toml:"body" yaml:"body"`
+	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedByID null.String `boil:"deleted_by_id" json:"deleted_by_id,omitempty" toml:"deleted_by_id" yaml:"deleted_by_id,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *chatMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatMessageColumns = struct {
+	ID          string
+	RoomID      string
+	SenderID    string
+	Body        string
+	DeletedAt   string
+	DeletedByID string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	RoomID:      "room_id",
+	SenderID:    "sender_id",
+	Body:        "body",
+	DeletedAt:   "deleted_at",
+	DeletedByID: "deleted_by_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+// Generated where
+
+var ChatMessageWhere = struct {
+	ID          whereHelperstring
+	RoomID      whereHelperstring
+	SenderID    whereHelpernull_String
+	Body        whereHelperstring
+	DeletedAt   whereHelpernull_Time
+	DeletedByID whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"chat_message\".\"id\""},
+	RoomID:      whereHelperstring{field: "\"chat_message\".\"room_id\""},
+	SenderID:    whereHelpernull_String{field: "\"chat_message\".\"sender_id\""},
+	Body:        whereHelperstring{field: "\"chat_message\".\"body\""},
+	DeletedAt:   whereHelpernull_Time{field: "\"chat_message\".\"deleted_at\""},
+	DeletedByID: whereHelpernull_String{field: "\"chat_message\".\"deleted_by_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"chat_message\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"chat_message\".\"updated_at\""},
+}
+
+// ChatMessageRels is where relationship names are stored.
+var ChatMessageRels = struct {
+	DeletedBy string
+	Room      string
+	Sender    string
+}{
+	DeletedBy: "DeletedBy",
+	Room:      "Room",
+	Sender:    "Sender",
+}
+
+// chatMessageR is where relationships are stored.
+type chatMessageR struct {
+	DeletedBy *User     `boil:"DeletedBy" json:"DeletedBy" toml:"DeletedBy" yaml:"DeletedBy"`
+	Room      *ChatRoom `boil:"Room" json:"Room" toml:"Room" yaml:"Room"`
+	Sender    *User     `boil:"Sender" json:"Sender" toml:"Sender" yaml:"Sender"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatMessageR) NewStruct() *chatMessageR {
+	return &chatMessageR{}
+}
+
+// chatMessageL is where Load methods for each relationship are stored.
+type chatMessageL struct{}
+
+var (
+	chatMessageAllColumns            = []string{"id", "room_id", "sender_id", "body", "deleted_at", "deleted_by_id", "created_at", "updated_at"}
+	chatMessageColumnsWithoutDefault = []string{"id", "room_id", "sender_id", "body", "deleted_at", "deleted_by_id", "created_at", "updated_at"}
+	chatMessageColumnsWithDefault    = []string{}
+	chatMessagePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ChatMessageSlice is an alias for a slice of pointers to ChatMessage.
+	// This should generally be used opposed to []ChatMessage.
+	ChatMessageSlice []*ChatMessage
+
+	chatMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatMessageType                 = reflect.TypeOf(&ChatMessage{})
+	chatMessageMapping              = queries.MakeStructMapping(chatMessageType)
+	chatMessagePrimaryKeyMapping, _ = queries.BindMapping(chatMessageType, chatMessageMapping, chatMessagePrimaryKeyColumns)
+	chatMessageInsertCacheMut       sync.RWMutex
+	chatMessageInsertCache          = make(map[string]insertCache)
+	chatMessageUpdateCacheMut       sync.RWMutex
+	chatMessageUpdateCache          = make(map[string]updateCache)
+	chatMessageUpsertCacheMut       sync.RWMutex
+	chatMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single chatMessage record from the query using the global executor.
+func (q chatMessageQuery) OneG(ctx context.Context) (*ChatMessage, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatMessage record from the query.
+func (q chatMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatMessage, error) {
+	o := &ChatMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_message")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatMessage records from the query using the global executor.
+func (q chatMessageQuery) AllG(ctx context.Context) (ChatMessageSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatMessage records from the query.
+func (q chatMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatMessageSlice, error) {
+	var o []*ChatMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatMessage slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatMessage records in the query, and panics on error.
+func (q chatMessageQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatMessage records in the query.
+func (q chatMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_message rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q chatMessageQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_message exists")
+	}
+
+	return count > 0, nil
+}
+
+// DeletedBy pointed to by the foreign key.
+func (o *ChatMessage) DeletedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeletedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// Room pointed to by the foreign key.
+func (o *ChatMessage) Room(mods ...qm.QueryMod) chatRoomQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoomID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ChatRooms(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room\"")
+
+	return query
+}
+
+// Sender pointed to by the foreign key.
+func (o *ChatMessage) Sender(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SenderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadDeletedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMessageL) LoadDeletedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMessage interface{}, mods queries.Applicator) error {
+	var slice []*ChatMessage
+	var object *ChatMessage
+
+	if singular {
+		object = maybeChatMessage.(*ChatMessage)
+	} else {
+		slice = *maybeChatMessage.(*[]*ChatMessage)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMessageR{}
+		}
+		if !queries.IsNil(object.DeletedByID) {
+			args = append(args, object.DeletedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMessageR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.DeletedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.DeletedByID) {
+				args = append(args, obj.DeletedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DeletedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.DeletedByChatMessages = append(foreign.R.DeletedByChatMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DeletedByID, foreign.ID) {
+				local.R.DeletedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.DeletedByChatMessages = append(foreign.R.DeletedByChatMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoom allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMessageL) LoadRoom(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMessage interface{}, mods queries.Applicator) error {
+	var slice []*ChatMessage
+	var object *ChatMessage
+
+	if singular {
+		object = maybeChatMessage.(*ChatMessage)
+	} else {
+		slice = *maybeChatMessage.(*[]*ChatMessage)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMessageR{}
+		}
+		args = append(args, object.RoomID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMessageR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RoomID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RoomID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room`),
+		qm.WhereIn(`chat_room.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ChatRoom")
+	}
+
+	var resultSlice []*ChatRoom
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ChatRoom")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chat_room")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Room = foreign
+		if foreign.R == nil {
+			foreign.R = &chatRoomR{}
+		}
+		foreign.R.RoomChatMessages = append(foreign.R.RoomChatMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoomID == foreign.ID {
+				local.R.Room = foreign
+				if foreign.R == nil {
+					foreign.R = &chatRoomR{}
+				}
+				foreign.R.RoomChatMessages = append(foreign.R.RoomChatMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSender allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMessageL) LoadSender(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMessage interface{}, mods queries.Applicator) error {
+	var slice []*ChatMessage
+	var object *ChatMessage
+
+	if singular {
+		object = maybeChatMessage.(*ChatMessage)
+	} else {
+		slice = *maybeChatMessage.(*[]*ChatMessage)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMessageR{}
+		}
+		if !queries.IsNil(object.SenderID) {
+			args = append(args, object.SenderID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMessageR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SenderID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SenderID) {
+				args = append(args, obj.SenderID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Sender = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SenderChatMessages = append(foreign.R.SenderChatMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SenderID, foreign.ID) {
+				local.R.Sender = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SenderChatMessages = append(foreign.R.SenderChatMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDeletedByG of the chatMessage to the related item.
+// Sets o.R.DeletedBy to related.
+// Adds o to related.R.DeletedByChatMessages.
+// Uses the global database handle.
+func (o *ChatMessage) SetDeletedByG(ctx context.Context, insert bool, related *User) error {
+	return o.SetDeletedBy(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetDeletedBy of the chatMessage to the related item.
+// Sets o.R.DeletedBy to related.
+// Adds o to related.R.DeletedByChatMessages.
+func (o *ChatMessage) SetDeletedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_message\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DeletedByID, related.ID)
+	if o.R == nil {
+		o.R = &chatMessageR{
+			DeletedBy: related,
+		}
+	} else {
+		o.R.DeletedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			DeletedByChatMessages: ChatMessageSlice{o},
+		}
+	} else {
+		related.R.DeletedByChatMessages = append(related.R.DeletedByChatMessages, o)
+	}
+
+	return nil
+}
+
+// RemoveDeletedByG relationship.
+// Sets o.R.DeletedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ChatMessage) RemoveDeletedByG(ctx context.Context, related *User) error {
+	return o.RemoveDeletedBy(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveDeletedBy relationship.
+// Sets o.R.DeletedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ChatMessage) RemoveDeletedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.DeletedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("deleted_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DeletedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DeletedByChatMessages {
+		if queries.Equal(o.DeletedByID, ri.DeletedByID) {
+			continue
+		}
+
+		ln := len(related.R.DeletedByChatMessages)
+		if ln > 1 && i < ln-1 {
+			related.R.DeletedByChatMessages[i] = related.R.DeletedByChatMessages[ln-1]
+		}
+		related.R.DeletedByChatMessages = related.R.DeletedByChatMessages[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRoomG of the chatMessage to the related item.
+// Sets o.R.Room to related.
+// Adds o to related.R.RoomChatMessages.
+// Uses the global database handle.
+func (o *ChatMessage) SetRoomG(ctx context.Context, insert bool, related *ChatRoom) error {
+	return o.SetRoom(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetRoom of the chatMessage to the related item.
+// Sets o.R.Room to related.
+// Adds o to related.R.RoomChatMessages.
+func (o *ChatMessage) SetRoom(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChatRoom) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_message\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoomID = related.ID
+	if o.R == nil {
+		o.R = &chatMessageR{
+			Room: related,
+		}
+	} else {
+		o.R.Room = related
+	}
+
+	if related.R == nil {
+		related.R = &chatRoomR{
+			RoomChatMessages: ChatMessageSlice{o},
+		}
+	} else {
+		related.R.RoomChatMessages = append(related.R.RoomChatMessages, o)
+	}
+
+	return nil
+}
+
+// SetSenderG of the chatMessage to the related item.
+// Sets o.R.Sender to related.
+// Adds o to related.R.SenderChatMessages.
+// Uses the global database handle.
+func (o *ChatMessage) SetSenderG(ctx context.Context, insert bool, related *User) error {
+	return o.SetSender(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSender of the chatMessage to the related item.
+// Sets o.R.Sender to related.
+// Adds o to related.R.SenderChatMessages.
+func (o *ChatMessage) SetSender(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_message\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SenderID, related.ID)
+	if o.R == nil {
+		o.R = &chatMessageR{
+			Sender: related,
+		}
+	} else {
+		o.R.Sender = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SenderChatMessages: ChatMessageSlice{o},
+		}
+	} else {
+		related.R.SenderChatMessages = append(related.R.SenderChatMessages, o)
+	}
+
+	return nil
+}
+
+// RemoveSenderG relationship.
+// Sets o.R.Sender to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ChatMessage) RemoveSenderG(ctx context.Context, related *User) error {
+	return o.RemoveSender(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveSender relationship.
+// Sets o.R.Sender to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ChatMessage) RemoveSender(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.SenderID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("sender_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Sender = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SenderChatMessages {
+		if queries.Equal(o.SenderID, ri.SenderID) {
+			continue
+		}
+
+		ln := len(related.R.SenderChatMessages)
+		if ln > 1 && i < ln-1 {
+			related.R.SenderChatMessages[i] = related.R.SenderChatMessages[ln-1]
+		}
+		related.R.SenderChatMessages = related.R.SenderChatMessages[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ChatMessages retrieves all the records using an executor.
+func ChatMessages(mods ...qm.QueryMod) chatMessageQuery {
+	mods = append(mods, qm.From("\"chat_message\""))
+	return chatMessageQuery{NewQuery(mods...)}
+}
+
+// FindChatMessageG retrieves a single record by ID.
+func FindChatMessageG(ctx context.Context, iD string, selectCols ...string) (*ChatMessage, error) {
+	return FindChatMessage(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatMessage(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatMessage, error) {
+	chatMessageObj := &ChatMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_message\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatMessageObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_message")
+	}
+
+	return chatMessageObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatMessage) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_message provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatMessageInsertCacheMut.RLock()
+	cache, cached := chatMessageInsertCache[key]
+	chatMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatMessageAllColumns,
+			chatMessageColumnsWithDefault,
+			chatMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatMessageType, chatMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatMessageType, chatMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_message\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_message\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_message")
+	}
+
+	if !cached {
+		chatMessageInsertCacheMut.Lock()
+		chatMessageInsertCache[key] = cache
+		chatMessageInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChatMessage record using the global executor.
+// See Update for more documentation.
+func (o *ChatMessage) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	chatMessageUpdateCacheMut.RLock()
+	cache, cached := chatMessageUpdateCache[key]
+	chatMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatMessageAllColumns,
+			chatMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_message, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_message\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatMessageType, chatMessageMapping, append(wl, chatMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_message row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_message")
+	}
+
+	if !cached {
+		chatMessageUpdateCacheMut.Lock()
+		chatMessageUpdateCache[key] = cache
+		chatMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatMessageQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_message")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_message")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatMessageSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_message\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatMessage")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatMessage) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_message provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatMessageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatMessageUpsertCacheMut.RLock()
+	cache, cached := chatMessageUpsertCache[key]
+	chatMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatMessageAllColumns,
+			chatMessageColumnsWithDefault,
+			chatMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			chatMessageAllColumns,
+			chatMessagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_message, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatMessagePrimaryKeyColumns))
+			copy(conflict, chatMessagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_message\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatMessageType, chatMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatMessageType, chatMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_message")
+	}
+
+	if !cached {
+		chatMessageUpsertCacheMut.Lock()
+		chatMessageUpsertCache[key] = cache
+		chatMessageUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChatMessage record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatMessage) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatMessage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatMessage provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatMessagePrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_message\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_message")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_message")
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatMessageQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatMessageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_message")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_message")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatMessageSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_message\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatMessagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_message")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatMessage) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatMessage provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatMessageSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatMessageSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_message\".* FROM \"chat_message\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatMessagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatMessageExistsG checks if the ChatMessage row exists.
+func ChatMessageExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatMessageExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatMessageExists checks if the ChatMessage row exists.
+func ChatMessageExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_message\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_message exists")
+	}
+
+	return exists, nil
+}
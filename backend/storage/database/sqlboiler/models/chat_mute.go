@@ -0,0 +1,1334 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatMute is an object representing the database table.
+type ChatMute struct {
+	CourseID  string      `boil:"course_id" json:"course_id" toml:"course_id" yaml:"course_id"`
+	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Until     null.Time   `boil:"until" json:"until,omitempty" toml:"until" yaml:"until,omitempty"`
+	MutedByID null.String `boil:"muted_by_id" json:"muted_by_id,omitempty" toml:"muted_by_id" yaml:"muted_by_id,omitempty"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *chatMuteR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatMuteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatMuteColumns = struct {
+	CourseID  string
+	UserID    string
+	Until     string
+	MutedByID string
+	CreatedAt string
+	UpdatedAt string
+}{
+	CourseID:  "course_id",
+	UserID:    "user_id",
+	Until:     "until",
+	MutedByID: "muted_by_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var ChatMuteWhere = struct {
+	CourseID  whereHelperstring
+	UserID    whereHelperstring
+	Until     whereHelpernull_Time
+	MutedByID whereHelpernull_String
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	CourseID:  whereHelperstring{field: "\"chat_mute\".\"course_id\""},
+	UserID:    whereHelperstring{field: "\"chat_mute\".\"user_id\""},
+	Until:     whereHelpernull_Time{field: "\"chat_mute\".\"until\""},
+	MutedByID: whereHelpernull_String{field: "\"chat_mute\".\"muted_by_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"chat_mute\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"chat_mute\".\"updated_at\""},
+}
+
+// ChatMuteRels is where relationship names are stored.
+var ChatMuteRels = struct {
+	Course  string
+	MutedBy string
+	User    string
+}{
+	Course:  "Course",
+	MutedBy: "MutedBy",
+	User:    "User",
+}
+
+// chatMuteR is where relationships are stored.
+type chatMuteR struct {
+	Course  *Course `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	MutedBy *User   `boil:"MutedBy" json:"MutedBy" toml:"MutedBy" yaml:"MutedBy"`
+	User    *User   `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatMuteR) NewStruct() *chatMuteR {
+	return &chatMuteR{}
+}
+
+// chatMuteL is where Load methods for each relationship are stored.
+type chatMuteL struct{}
+
+var (
+	chatMuteAllColumns            = []string{"course_id", "user_id", "until", "muted_by_id", "created_at", "updated_at"}
+	chatMuteColumnsWithoutDefault = []string{"course_id", "user_id", "until", "muted_by_id", "created_at", "updated_at"}
+	chatMuteColumnsWithDefault    = []string{}
+	chatMutePrimaryKeyColumns     = []string{"course_id", "user_id"}
+)
+
+type (
+	// ChatMuteSlice is an alias for a slice of pointers to ChatMute.
+	// This should generally be used opposed to []ChatMute.
+	ChatMuteSlice []*ChatMute
+
+	chatMuteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatMuteType                 = reflect.TypeOf(&ChatMute{})
+	chatMuteMapping              = queries.MakeStructMapping(chatMuteType)
+	chatMutePrimaryKeyMapping, _ = queries.BindMapping(chatMuteType, chatMuteMapping, chatMutePrimaryKeyColumns)
+	chatMuteInsertCacheMut       sync.RWMutex
+	chatMuteInsertCache          = make(map[string]insertCache)
+	chatMuteUpdateCacheMut       sync.RWMutex
+	chatMuteUpdateCache          = make(map[string]updateCache)
+	chatMuteUpsertCacheMut       sync.RWMutex
+	chatMuteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single chatMute record from the query using the global executor.
+func (q chatMuteQuery) OneG(ctx context.Context) (*ChatMute, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatMute record from the query.
+func (q chatMuteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatMute, error) {
+	o := &ChatMute{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_mute")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatMute records from the query using the global executor.
+func (q chatMuteQuery) AllG(ctx context.Context) (ChatMuteSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatMute records from the query.
+func (q chatMuteQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatMuteSlice, error) {
+	var o []*ChatMute
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatMute slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatMute records in the query, and panics on error.
+func (q chatMuteQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatMute records in the query.
+func (q chatMuteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_mute rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q chatMuteQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatMuteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_mute exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *ChatMute) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// MutedBy pointed to by the foreign key.
+func (o *ChatMute) MutedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MutedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *ChatMute) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMuteL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMute interface{}, mods queries.Applicator) error {
+	var slice []*ChatMute
+	var object *ChatMute
+
+	if singular {
+		object = maybeChatMute.(*ChatMute)
+	} else {
+		slice = *maybeChatMute.(*[]*ChatMute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMuteR{}
+		}
+		args = append(args, object.CourseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMuteR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.ChatMutes = append(foreign.R.ChatMutes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseID == foreign.ID {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.ChatMutes = append(foreign.R.ChatMutes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMutedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMuteL) LoadMutedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMute interface{}, mods queries.Applicator) error {
+	var slice []*ChatMute
+	var object *ChatMute
+
+	if singular {
+		object = maybeChatMute.(*ChatMute)
+	} else {
+		slice = *maybeChatMute.(*[]*ChatMute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMuteR{}
+		}
+		if !queries.IsNil(object.MutedByID) {
+			args = append(args, object.MutedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMuteR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.MutedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.MutedByID) {
+				args = append(args, obj.MutedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.MutedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MutedByChatMutes = append(foreign.R.MutedByChatMutes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MutedByID, foreign.ID) {
+				local.R.MutedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MutedByChatMutes = append(foreign.R.MutedByChatMutes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatMuteL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatMute interface{}, mods queries.Applicator) error {
+	var slice []*ChatMute
+	var object *ChatMute
+
+	if singular {
+		object = maybeChatMute.(*ChatMute)
+	} else {
+		slice = *maybeChatMute.(*[]*ChatMute)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatMuteR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatMuteR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChatMutes = append(foreign.R.ChatMutes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChatMutes = append(foreign.R.ChatMutes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the chatMute to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.ChatMutes.
+// Uses the global database handle.
+func (o *ChatMute) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the chatMute to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.ChatMutes.
+func (o *ChatMute) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_mute\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CourseID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseID = related.ID
+	if o.R == nil {
+		o.R = &chatMuteR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			ChatMutes: ChatMuteSlice{o},
+		}
+	} else {
+		related.R.ChatMutes = append(related.R.ChatMutes, o)
+	}
+
+	return nil
+}
+
+// SetMutedByG of the chatMute to the related item.
+// Sets o.R.MutedBy to related.
+// Adds o to related.R.MutedByChatMutes.
+// Uses the global database handle.
+func (o *ChatMute) SetMutedByG(ctx context.Context, insert bool, related *User) error {
+	return o.SetMutedBy(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetMutedBy of the chatMute to the related item.
+// Sets o.R.MutedBy to related.
+// Adds o to related.R.MutedByChatMutes.
+func (o *ChatMute) SetMutedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_mute\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"muted_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CourseID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MutedByID, related.ID)
+	if o.R == nil {
+		o.R = &chatMuteR{
+			MutedBy: related,
+		}
+	} else {
+		o.R.MutedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MutedByChatMutes: ChatMuteSlice{o},
+		}
+	} else {
+		related.R.MutedByChatMutes = append(related.R.MutedByChatMutes, o)
+	}
+
+	return nil
+}
+
+// RemoveMutedByG relationship.
+// Sets o.R.MutedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ChatMute) RemoveMutedByG(ctx context.Context, related *User) error {
+	return o.RemoveMutedBy(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveMutedBy relationship.
+// Sets o.R.MutedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ChatMute) RemoveMutedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.MutedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("muted_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MutedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MutedByChatMutes {
+		if queries.Equal(o.MutedByID, ri.MutedByID) {
+			continue
+		}
+
+		ln := len(related.R.MutedByChatMutes)
+		if ln > 1 && i < ln-1 {
+			related.R.MutedByChatMutes[i] = related.R.MutedByChatMutes[ln-1]
+		}
+		related.R.MutedByChatMutes = related.R.MutedByChatMutes[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserG of the chatMute to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatMutes.
+// Uses the global database handle.
+func (o *ChatMute) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the chatMute to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatMutes.
+func (o *ChatMute) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_mute\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CourseID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &chatMuteR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChatMutes: ChatMuteSlice{o},
+		}
+	} else {
+		related.R.ChatMutes = append(related.R.ChatMutes, o)
+	}
+
+	return nil
+}
+
+// ChatMutes retrieves all the records using an executor.
+func ChatMutes(mods ...qm.QueryMod) chatMuteQuery {
+	mods = append(mods, qm.From("\"chat_mute\""))
+	return chatMuteQuery{NewQuery(mods...)}
+}
+
+// FindChatMuteG retrieves a single record by ID.
+func FindChatMuteG(ctx context.Context, courseID string, userID string, selectCols ...string) (*ChatMute, error) {
+	return FindChatMute(ctx, boil.GetContextDB(), courseID, userID, selectCols...)
+}
+
+// FindChatMute retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatMute(ctx context.Context, exec boil.ContextExecutor, courseID string, userID string, selectCols ...string) (*ChatMute, error) {
+	chatMuteObj := &ChatMute{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_mute\" where \"course_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, courseID, userID)
+
+	err := q.Bind(ctx, exec, chatMuteObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_mute")
+	}
+
+	return chatMuteObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatMute) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatMute) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_mute provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatMuteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatMuteInsertCacheMut.RLock()
+	cache, cached := chatMuteInsertCache[key]
+	chatMuteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatMuteAllColumns,
+			chatMuteColumnsWithDefault,
+			chatMuteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatMuteType, chatMuteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatMuteType, chatMuteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_mute\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_mute\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_mute")
+	}
+
+	if !cached {
+		chatMuteInsertCacheMut.Lock()
+		chatMuteInsertCache[key] = cache
+		chatMuteInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChatMute record using the global executor.
+// See Update for more documentation.
+func (o *ChatMute) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatMute.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatMute) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	chatMuteUpdateCacheMut.RLock()
+	cache, cached := chatMuteUpdateCache[key]
+	chatMuteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatMuteAllColumns,
+			chatMutePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_mute, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_mute\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatMutePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatMuteType, chatMuteMapping, append(wl, chatMutePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_mute row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_mute")
+	}
+
+	if !cached {
+		chatMuteUpdateCacheMut.Lock()
+		chatMuteUpdateCache[key] = cache
+		chatMuteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatMuteQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatMuteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_mute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_mute")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatMuteSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatMuteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_mute\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatMutePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatMute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatMute")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatMute) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatMute) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_mute provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatMuteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatMuteUpsertCacheMut.RLock()
+	cache, cached := chatMuteUpsertCache[key]
+	chatMuteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatMuteAllColumns,
+			chatMuteColumnsWithDefault,
+			chatMuteColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			chatMuteAllColumns,
+			chatMutePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_mute, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatMutePrimaryKeyColumns))
+			copy(conflict, chatMutePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_mute\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatMuteType, chatMuteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatMuteType, chatMuteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_mute")
+	}
+
+	if !cached {
+		chatMuteUpsertCacheMut.Lock()
+		chatMuteUpsertCache[key] = cache
+		chatMuteUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChatMute record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatMute) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatMute record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatMute) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatMute provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatMutePrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_mute\" WHERE \"course_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_mute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_mute")
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatMuteQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatMuteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatMuteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_mute")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_mute")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatMuteSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatMuteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_mute\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatMutePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatMute slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_mute")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatMute) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatMute provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatMute) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatMute(ctx, exec, o.CourseID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatMuteSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatMuteSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatMuteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatMuteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatMutePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_mute\".* FROM \"chat_mute\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatMutePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatMuteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatMuteExistsG checks if the ChatMute row exists.
+func ChatMuteExistsG(ctx context.Context, courseID string, userID string) (bool, error) {
+	return ChatMuteExists(ctx, boil.GetContextDB(), courseID, userID)
+}
+
+// ChatMuteExists checks if the ChatMute row exists.
+func ChatMuteExists(ctx context.Context, exec boil.ContextExecutor, courseID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_mute\" where \"course_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, courseID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, courseID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_mute exists")
+	}
+
+	return exists, nil
+}
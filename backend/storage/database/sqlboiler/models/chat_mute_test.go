@@ -0,0 +1,960 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testChatMutes(t *testing.T) {
+	t.Parallel()
+
+	query := ChatMutes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testChatMutesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatMutesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ChatMutes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatMutesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatMuteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatMutesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ChatMuteExists(ctx, tx, o.CourseID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if ChatMute exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ChatMuteExists to return true, but got false.")
+	}
+}
+
+func testChatMutesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	chatMuteFound, err := FindChatMute(ctx, tx, o.CourseID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if chatMuteFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testChatMutesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ChatMutes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatMutesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ChatMutes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testChatMutesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	chatMuteOne := &ChatMute{}
+	chatMuteTwo := &ChatMute{}
+	if err = randomize.Struct(seed, chatMuteOne, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatMuteTwo, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatMuteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatMuteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChatMutes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testChatMutesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	chatMuteOne := &ChatMute{}
+	chatMuteTwo := &ChatMute{}
+	if err = randomize.Struct(seed, chatMuteOne, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatMuteTwo, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatMuteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatMuteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testChatMutesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatMutesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(chatMuteColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatMuteToOneCourseUsingCourse(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChatMute
+	var foreign Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CourseID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Course().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChatMuteSlice{&local}
+	if err = local.L.LoadCourse(ctx, tx, false, (*[]*ChatMute)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Course = nil
+	if err = local.L.LoadCourse(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testChatMuteToOneUserUsingMutedBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChatMute
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.MutedByID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.MutedBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChatMuteSlice{&local}
+	if err = local.L.LoadMutedBy(ctx, tx, false, (*[]*ChatMute)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MutedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.MutedBy = nil
+	if err = local.L.LoadMutedBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MutedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testChatMuteToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChatMute
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChatMuteSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ChatMute)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testChatMuteToOneSetOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatMute
+	var b, c Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatMuteDBTypes, false, strmangle.SetComplement(chatMutePrimaryKeyColumns, chatMuteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Course{&b, &c} {
+		err = a.SetCourse(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Course != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ChatMutes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CourseID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseID)
+		}
+
+		if exists, err := ChatMuteExists(ctx, tx, a.CourseID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testChatMuteToOneSetOpUserUsingMutedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatMute
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatMuteDBTypes, false, strmangle.SetComplement(chatMutePrimaryKeyColumns, chatMuteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetMutedBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.MutedBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MutedByChatMutes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.MutedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.MutedByID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MutedByID))
+		reflect.Indirect(reflect.ValueOf(&a.MutedByID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.MutedByID, x.ID) {
+			t.Error("foreign key was wrong value", a.MutedByID, x.ID)
+		}
+	}
+}
+
+func testChatMuteToOneRemoveOpUserUsingMutedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatMute
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatMuteDBTypes, false, strmangle.SetComplement(chatMutePrimaryKeyColumns, chatMuteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetMutedBy(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveMutedBy(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.MutedBy().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.MutedBy != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.MutedByID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.MutedByChatMutes) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testChatMuteToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatMute
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatMuteDBTypes, false, strmangle.SetComplement(chatMutePrimaryKeyColumns, chatMuteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ChatMutes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := ChatMuteExists(ctx, tx, a.CourseID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testChatMutesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatMutesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatMuteSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatMutesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChatMutes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	chatMuteDBTypes = map[string]string{`CourseID`: `uuid`, `UserID`: `uuid`, `Until`: `timestamp without time zone`, `MutedByID`: `uuid`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_               = bytes.MinRead
+)
+
+func testChatMutesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(chatMutePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(chatMuteAllColumns) == len(chatMutePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMutePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testChatMutesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(chatMuteAllColumns) == len(chatMutePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatMute{}
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMuteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatMuteDBTypes, true, chatMutePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(chatMuteAllColumns, chatMutePrimaryKeyColumns) {
+		fields = chatMuteAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			chatMuteAllColumns,
+			chatMutePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ChatMuteSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testChatMutesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(chatMuteAllColumns) == len(chatMutePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ChatMute{}
+	if err = randomize.Struct(seed, &o, chatMuteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChatMute: %s", err)
+	}
+
+	count, err := ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, chatMuteDBTypes, false, chatMutePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatMute struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChatMute: %s", err)
+	}
+
+	count, err = ChatMutes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testChatReads(t *testing.T) {
+	t.Parallel()
+
+	query := ChatReads()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testChatReadsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatReadsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ChatReads().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatReadsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatReadSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatReadsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ChatReadExists(ctx, tx, o.RoomID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if ChatRead exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ChatReadExists to return true, but got false.")
+	}
+}
+
+func testChatReadsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	chatReadFound, err := FindChatRead(ctx, tx, o.RoomID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if chatReadFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testChatReadsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ChatReads().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatReadsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ChatReads().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testChatReadsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	chatReadOne := &ChatRead{}
+	chatReadTwo := &ChatRead{}
+	if err = randomize.Struct(seed, chatReadOne, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatReadTwo, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatReadOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatReadTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChatReads().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testChatReadsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	chatReadOne := &ChatRead{}
+	chatReadTwo := &ChatRead{}
+	if err = randomize.Struct(seed, chatReadOne, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatReadTwo, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatReadOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatReadTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testChatReadsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatReadsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(chatReadColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatReadToOneChatRoomUsingRoom(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChatRead
+	var foreign ChatRoom
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, chatRoomDBTypes, false, chatRoomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRoom struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RoomID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Room().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChatReadSlice{&local}
+	if err = local.L.LoadRoom(ctx, tx, false, (*[]*ChatRead)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Room == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Room = nil
+	if err = local.L.LoadRoom(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Room == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testChatReadToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ChatRead
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatReadDBTypes, false, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ChatReadSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ChatRead)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testChatReadToOneSetOpChatRoomUsingRoom(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatRead
+	var b, c ChatRoom
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatReadDBTypes, false, strmangle.SetComplement(chatReadPrimaryKeyColumns, chatReadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, chatRoomDBTypes, false, strmangle.SetComplement(chatRoomPrimaryKeyColumns, chatRoomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chatRoomDBTypes, false, strmangle.SetComplement(chatRoomPrimaryKeyColumns, chatRoomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ChatRoom{&b, &c} {
+		err = a.SetRoom(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Room != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RoomChatReads[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RoomID != x.ID {
+			t.Error("foreign key was wrong value", a.RoomID)
+		}
+
+		if exists, err := ChatReadExists(ctx, tx, a.RoomID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testChatReadToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ChatRead
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatReadDBTypes, false, strmangle.SetComplement(chatReadPrimaryKeyColumns, chatReadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ChatReads[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := ChatReadExists(ctx, tx, a.RoomID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testChatReadsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatReadsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatReadSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatReadsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChatReads().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	chatReadDBTypes = map[string]string{`RoomID`: `uuid`, `UserID`: `uuid`, `LastReadAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_               = bytes.MinRead
+)
+
+func testChatReadsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(chatReadPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(chatReadAllColumns) == len(chatReadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testChatReadsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(chatReadAllColumns) == len(chatReadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChatRead{}
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatReadDBTypes, true, chatReadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(chatReadAllColumns, chatReadPrimaryKeyColumns) {
+		fields = chatReadAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			chatReadAllColumns,
+			chatReadPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ChatReadSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testChatReadsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(chatReadAllColumns) == len(chatReadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ChatRead{}
+	if err = randomize.Struct(seed, &o, chatReadDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChatRead: %s", err)
+	}
+
+	count, err := ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, chatReadDBTypes, false, chatReadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChatRead struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChatRead: %s", err)
+	}
+
+	count, err = ChatReads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
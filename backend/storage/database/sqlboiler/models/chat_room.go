@@ -0,0 +1,1702 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatRoom is an object representing the database table.
+type ChatRoom struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CourseID    string      `boil:"course_id" json:"course_id" toml:"course_id" yaml:"course_id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Kind        string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	CreatedByID null.String `boil:"created_by_id" json:"created_by_id,omitempty" toml:"created_by_id" yaml:"created_by_id,omitempty"`
+	CreatedAt   null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *chatRoomR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatRoomL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatRoomColumns = struct {
+	ID          string
+	CourseID    string
+	Name        string
+	Kind        string
+	CreatedByID string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	CourseID:    "course_id",
+	Name:        "name",
+	Kind:        "kind",
+	CreatedByID: "created_by_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+// Generated where
+
+var ChatRoomWhere = struct {
+	ID          whereHelperstring
+	CourseID    whereHelperstring
+	Name        whereHelperstring
+	Kind        whereHelperstring
+	CreatedByID whereHelpernull_String
+	CreatedAt   whereHelpernull_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"chat_room\".\"id\""},
+	CourseID:    whereHelperstring{field: "\"chat_room\".\"course_id\""},
+	Name:        whereHelperstring{field: "\"chat_room\".\"name\""},
+	Kind:        whereHelperstring{field: "\"chat_room\".\"kind\""},
+	CreatedByID: whereHelpernull_String{field: "\"chat_room\".\"created_by_id\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"chat_room\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"chat_room\".\"updated_at\""},
+}
+
+// ChatRoomRels is where relationship names are stored.
+var ChatRoomRels = struct {
+	Course              string
+	CreatedBy           string
+	RoomChatMessages    string
+	RoomChatReads       string
+	RoomChatRoomMembers string
+}{
+	Course:              "Course",
+	CreatedBy:           "CreatedBy",
+	RoomChatMessages:    "RoomChatMessages",
+	RoomChatReads:       "RoomChatReads",
+	RoomChatRoomMembers: "RoomChatRoomMembers",
+}
+
+// chatRoomR is where relationships are stored.
+type chatRoomR struct {
+	Course              *Course             `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	CreatedBy           *User               `boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	RoomChatMessages    ChatMessageSlice    `boil:"RoomChatMessages" json:"RoomChatMessages" toml:"RoomChatMessages" yaml:"RoomChatMessages"`
+	RoomChatReads       ChatReadSlice       `boil:"RoomChatReads" json:"RoomChatReads" toml:"RoomChatReads" yaml:"RoomChatReads"`
+	RoomChatRoomMembers ChatRoomMemberSlice `boil:"RoomChatRoomMembers" json:"RoomChatRoomMembers" toml:"RoomChatRoomMembers" yaml:"RoomChatRoomMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatRoomR) NewStruct() *chatRoomR {
+	return &chatRoomR{}
+}
+
+// chatRoomL is where Load methods for each relationship are stored.
+type chatRoomL struct{}
+
+var (
+	chatRoomAllColumns            = []string{"id", "course_id", "name", "kind", "created_by_id", "created_at", "updated_at"}
+	chatRoomColumnsWithoutDefault = []string{"id", "course_id", "name", "kind", "created_by_id", "created_at", "updated_at"}
+	chatRoomColumnsWithDefault    = []string{}
+	chatRoomPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ChatRoomSlice is an alias for a slice of pointers to ChatRoom.
+	// This should generally be used opposed to []ChatRoom.
+	ChatRoomSlice []*ChatRoom
+
+	chatRoomQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatRoomType                 = reflect.TypeOf(&ChatRoom{})
+	chatRoomMapping              = queries.MakeStructMapping(chatRoomType)
+	chatRoomPrimaryKeyMapping, _ = queries.BindMapping(chatRoomType, chatRoomMapping, chatRoomPrimaryKeyColumns)
+	chatRoomInsertCacheMut       sync.RWMutex
+	chatRoomInsertCache          = make(map[string]insertCache)
+	chatRoomUpdateCacheMut       sync.RWMutex
+	chatRoomUpdateCache          = make(map[string]updateCache)
+	chatRoomUpsertCacheMut       sync.RWMutex
+	chatRoomUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single chatRoom record from the query using the global executor.
+func (q chatRoomQuery) OneG(ctx context.Context) (*ChatRoom, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatRoom record from the query.
+func (q chatRoomQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatRoom, error) {
+	o := &ChatRoom{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_room")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatRoom records from the query using the global executor.
+func (q chatRoomQuery) AllG(ctx context.Context) (ChatRoomSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatRoom records from the query.
+func (q chatRoomQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatRoomSlice, error) {
+	var o []*ChatRoom
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatRoom slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatRoom records in the query, and panics on error.
+func (q chatRoomQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatRoom records in the query.
+func (q chatRoomQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_room rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q chatRoomQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatRoomQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_room exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *ChatRoom) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// CreatedBy pointed to by the foreign key.
+func (o *ChatRoom) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// RoomChatMessages retrieves all the chat_message's ChatMessages with an executor via room_id column.
+func (o *ChatRoom) RoomChatMessages(mods ...qm.QueryMod) chatMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_message\".\"room_id\"=?", o.ID),
+	)
+
+	query := ChatMessages(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_message\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_message\".*"})
+	}
+
+	return query
+}
+
+// RoomChatReads retrieves all the chat_read's ChatReads with an executor via room_id column.
+func (o *ChatRoom) RoomChatReads(mods ...qm.QueryMod) chatReadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_read\".\"room_id\"=?", o.ID),
+	)
+
+	query := ChatReads(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_read\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_read\".*"})
+	}
+
+	return query
+}
+
+// RoomChatRoomMembers retrieves all the chat_room_member's ChatRoomMembers with an executor via room_id column.
+func (o *ChatRoom) RoomChatRoomMembers(mods ...qm.QueryMod) chatRoomMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_room_member\".\"room_id\"=?", o.ID),
+	)
+
+	query := ChatRoomMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room_member\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_room_member\".*"})
+	}
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatRoomL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoom interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoom
+	var object *ChatRoom
+
+	if singular {
+		object = maybeChatRoom.(*ChatRoom)
+	} else {
+		slice = *maybeChatRoom.(*[]*ChatRoom)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomR{}
+		}
+		args = append(args, object.CourseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.ChatRoom = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseID == foreign.ID {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.ChatRoom = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatRoomL) LoadCreatedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoom interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoom
+	var object *ChatRoom
+
+	if singular {
+		object = maybeChatRoom.(*ChatRoom)
+	} else {
+		slice = *maybeChatRoom.(*[]*ChatRoom)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomR{}
+		}
+		if !queries.IsNil(object.CreatedByID) {
+			args = append(args, object.CreatedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CreatedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CreatedByID) {
+				args = append(args, obj.CreatedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByChatRooms = append(foreign.R.CreatedByChatRooms, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedByID, foreign.ID) {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByChatRooms = append(foreign.R.CreatedByChatRooms, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoomChatMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatRoomL) LoadRoomChatMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoom interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoom
+	var object *ChatRoom
+
+	if singular {
+		object = maybeChatRoom.(*ChatRoom)
+	} else {
+		slice = *maybeChatRoom.(*[]*ChatRoom)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_message`),
+		qm.WhereIn(`chat_message.room_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_message")
+	}
+
+	var resultSlice []*ChatMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_message")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_message")
+	}
+
+	if singular {
+		object.R.RoomChatMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMessageR{}
+			}
+			foreign.R.Room = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoomID {
+				local.R.RoomChatMessages = append(local.R.RoomChatMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMessageR{}
+				}
+				foreign.R.Room = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoomChatReads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatRoomL) LoadRoomChatReads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoom interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoom
+	var object *ChatRoom
+
+	if singular {
+		object = maybeChatRoom.(*ChatRoom)
+	} else {
+		slice = *maybeChatRoom.(*[]*ChatRoom)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_read`),
+		qm.WhereIn(`chat_read.room_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_read")
+	}
+
+	var resultSlice []*ChatRead
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_read")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_read")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_read")
+	}
+
+	if singular {
+		object.R.RoomChatReads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatReadR{}
+			}
+			foreign.R.Room = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoomID {
+				local.R.RoomChatReads = append(local.R.RoomChatReads, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatReadR{}
+				}
+				foreign.R.Room = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoomChatRoomMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatRoomL) LoadRoomChatRoomMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoom interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoom
+	var object *ChatRoom
+
+	if singular {
+		object = maybeChatRoom.(*ChatRoom)
+	} else {
+		slice = *maybeChatRoom.(*[]*ChatRoom)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room_member`),
+		qm.WhereIn(`chat_room_member.room_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_room_member")
+	}
+
+	var resultSlice []*ChatRoomMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_room_member")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_room_member")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room_member")
+	}
+
+	if singular {
+		object.R.RoomChatRoomMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatRoomMemberR{}
+			}
+			foreign.R.Room = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoomID {
+				local.R.RoomChatRoomMembers = append(local.R.RoomChatRoomMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatRoomMemberR{}
+				}
+				foreign.R.Room = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the chatRoom to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.ChatRoom.
+// Uses the global database handle.
+func (o *ChatRoom) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the chatRoom to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.ChatRoom.
+func (o *ChatRoom) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_room\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatRoomPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseID = related.ID
+	if o.R == nil {
+		o.R = &chatRoomR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			ChatRoom: o,
+		}
+	} else {
+		related.R.ChatRoom = o
+	}
+
+	return nil
+}
+
+// SetCreatedByG of the chatRoom to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByChatRooms.
+// Uses the global database handle.
+func (o *ChatRoom) SetCreatedByG(ctx context.Context, insert bool, related *User) error {
+	return o.SetCreatedBy(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCreatedBy of the chatRoom to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByChatRooms.
+func (o *ChatRoom) SetCreatedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_room\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatRoomPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedByID, related.ID)
+	if o.R == nil {
+		o.R = &chatRoomR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByChatRooms: ChatRoomSlice{o},
+		}
+	} else {
+		related.R.CreatedByChatRooms = append(related.R.CreatedByChatRooms, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByG relationship.
+// Sets o.R.CreatedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ChatRoom) RemoveCreatedByG(ctx context.Context, related *User) error {
+	return o.RemoveCreatedBy(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveCreatedBy relationship.
+// Sets o.R.CreatedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ChatRoom) RemoveCreatedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByChatRooms {
+		if queries.Equal(o.CreatedByID, ri.CreatedByID) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByChatRooms)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByChatRooms[i] = related.R.CreatedByChatRooms[ln-1]
+		}
+		related.R.CreatedByChatRooms = related.R.CreatedByChatRooms[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddRoomChatMessagesG adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatMessages.
+// Sets related.R.Room appropriately.
+// Uses the global database handle.
+func (o *ChatRoom) AddRoomChatMessagesG(ctx context.Context, insert bool, related ...*ChatMessage) error {
+	return o.AddRoomChatMessages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRoomChatMessages adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatMessages.
+// Sets related.R.Room appropriately.
+func (o *ChatRoom) AddRoomChatMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoomID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_message\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoomID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatRoomR{
+			RoomChatMessages: related,
+		}
+	} else {
+		o.R.RoomChatMessages = append(o.R.RoomChatMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMessageR{
+				Room: o,
+			}
+		} else {
+			rel.R.Room = o
+		}
+	}
+	return nil
+}
+
+// AddRoomChatReadsG adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatReads.
+// Sets related.R.Room appropriately.
+// Uses the global database handle.
+func (o *ChatRoom) AddRoomChatReadsG(ctx context.Context, insert bool, related ...*ChatRead) error {
+	return o.AddRoomChatReads(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRoomChatReads adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatReads.
+// Sets related.R.Room appropriately.
+func (o *ChatRoom) AddRoomChatReads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRead) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoomID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_read\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatReadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoomID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoomID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatRoomR{
+			RoomChatReads: related,
+		}
+	} else {
+		o.R.RoomChatReads = append(o.R.RoomChatReads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatReadR{
+				Room: o,
+			}
+		} else {
+			rel.R.Room = o
+		}
+	}
+	return nil
+}
+
+// AddRoomChatRoomMembersG adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatRoomMembers.
+// Sets related.R.Room appropriately.
+// Uses the global database handle.
+func (o *ChatRoom) AddRoomChatRoomMembersG(ctx context.Context, insert bool, related ...*ChatRoomMember) error {
+	return o.AddRoomChatRoomMembers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRoomChatRoomMembers adds the given related objects to the existing relationships
+// of the chat_room, optionally inserting them as new records.
+// Appends related to o.R.RoomChatRoomMembers.
+// Sets related.R.Room appropriately.
+func (o *ChatRoom) AddRoomChatRoomMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRoomMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoomID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_room_member\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatRoomMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoomID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoomID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatRoomR{
+			RoomChatRoomMembers: related,
+		}
+	} else {
+		o.R.RoomChatRoomMembers = append(o.R.RoomChatRoomMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatRoomMemberR{
+				Room: o,
+			}
+		} else {
+			rel.R.Room = o
+		}
+	}
+	return nil
+}
+
+// ChatRooms retrieves all the records using an executor.
+func ChatRooms(mods ...qm.QueryMod) chatRoomQuery {
+	mods = append(mods, qm.From("\"chat_room\""))
+	return chatRoomQuery{NewQuery(mods...)}
+}
+
+// FindChatRoomG retrieves a single record by ID.
+func FindChatRoomG(ctx context.Context, iD string, selectCols ...string) (*ChatRoom, error) {
+	return FindChatRoom(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatRoom retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatRoom(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatRoom, error) {
+	chatRoomObj := &ChatRoom{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_room\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatRoomObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_room")
+	}
+
+	return chatRoomObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatRoom) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatRoom) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_room provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatRoomColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatRoomInsertCacheMut.RLock()
+	cache, cached := chatRoomInsertCache[key]
+	chatRoomInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatRoomAllColumns,
+			chatRoomColumnsWithDefault,
+			chatRoomColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatRoomType, chatRoomMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatRoomType, chatRoomMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_room\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_room\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_room")
+	}
+
+	if !cached {
+		chatRoomInsertCacheMut.Lock()
+		chatRoomInsertCache[key] = cache
+		chatRoomInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChatRoom record using the global executor.
+// See Update for more documentation.
+func (o *ChatRoom) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatRoom.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatRoom) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	chatRoomUpdateCacheMut.RLock()
+	cache, cached := chatRoomUpdateCache[key]
+	chatRoomUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatRoomAllColumns,
+			chatRoomPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_room, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_room\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatRoomPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatRoomType, chatRoomMapping, append(wl, chatRoomPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_room row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_room")
+	}
+
+	if !cached {
+		chatRoomUpdateCacheMut.Lock()
+		chatRoomUpdateCache[key] = cache
+		chatRoomUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatRoomQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatRoomQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_room")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_room")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatRoomSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatRoomSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_room\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatRoomPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatRoom slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatRoom")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatRoom) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatRoom) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_room provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatRoomColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatRoomUpsertCacheMut.RLock()
+	cache, cached := chatRoomUpsertCache[key]
+	chatRoomUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatRoomAllColumns,
+			chatRoomColumnsWithDefault,
+			chatRoomColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			chatRoomAllColumns,
+			chatRoomPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_room, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatRoomPrimaryKeyColumns))
+			copy(conflict, chatRoomPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_room\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatRoomType, chatRoomMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatRoomType, chatRoomMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_room")
+	}
+
+	if !cached {
+		chatRoomUpsertCacheMut.Lock()
+		chatRoomUpsertCache[key] = cache
+		chatRoomUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChatRoom record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatRoom) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatRoom record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatRoom) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatRoom provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatRoomPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_room\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_room")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_room")
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatRoomQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatRoomQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatRoomQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_room")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_room")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatRoomSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatRoomSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_room\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatRoomPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatRoom slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_room")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatRoom) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatRoom provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatRoom) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatRoom(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatRoomSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatRoomSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatRoomSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatRoomSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_room\".* FROM \"chat_room\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatRoomPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatRoomSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatRoomExistsG checks if the ChatRoom row exists.
+func ChatRoomExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatRoomExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatRoomExists checks if the ChatRoom row exists.
+func ChatRoomExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_room\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_room exists")
+	}
+
+	return exists, nil
+}
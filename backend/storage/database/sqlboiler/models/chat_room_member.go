@@ -0,0 +1,1111 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatRoomMember is an object representing the database table.
+type ChatRoomMember struct {
+	RoomID    string    `boil:"room_id" json:"room_id" toml:"room_id" yaml:"room_id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *chatRoomMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatRoomMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatRoomMemberColumns = struct {
+	RoomID    string
+	UserID    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	RoomID:    "room_id",
+	UserID:    "user_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var ChatRoomMemberWhere = struct {
+	RoomID    whereHelperstring
+	UserID    whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	RoomID:    whereHelperstring{field: "\"chat_room_member\".\"room_id\""},
+	UserID:    whereHelperstring{field: "\"chat_room_member\".\"user_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"chat_room_member\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"chat_room_member\".\"updated_at\""},
+}
+
+// ChatRoomMemberRels is where relationship names are stored.
+var ChatRoomMemberRels = struct {
+	Room string
+	User string
+}{
+	Room: "Room",
+	User: "User",
+}
+
+// chatRoomMemberR is where relationships are stored.
+type chatRoomMemberR struct {
+	Room *ChatRoom `boil:"Room" json:"Room" toml:"Room" yaml:"Room"`
+	User *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatRoomMemberR) NewStruct() *chatRoomMemberR {
+	return &chatRoomMemberR{}
+}
+
+// chatRoomMemberL is where Load methods for each relationship are stored.
+type chatRoomMemberL struct{}
+
+var (
+	chatRoomMemberAllColumns            = []string{"room_id", "user_id", "created_at", "updated_at"}
+	chatRoomMemberColumnsWithoutDefault = []string{"room_id", "user_id", "created_at", "updated_at"}
+	chatRoomMemberColumnsWithDefault    = []string{}
+	chatRoomMemberPrimaryKeyColumns     = []string{"room_id", "user_id"}
+)
+
+type (
+	// ChatRoomMemberSlice is an alias for a slice of pointers to ChatRoomMember.
+	// This should generally be used opposed to []ChatRoomMember.
+	ChatRoomMemberSlice []*ChatRoomMember
+
+	chatRoomMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatRoomMemberType                 = reflect.TypeOf(&ChatRoomMember{})
+	chatRoomMemberMapping              = queries.MakeStructMapping(chatRoomMemberType)
+	chatRoomMemberPrimaryKeyMapping, _ = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, chatRoomMemberPrimaryKeyColumns)
+	chatRoomMemberInsertCacheMut       sync.RWMutex
+	chatRoomMemberInsertCache          = make(map[string]insertCache)
+	chatRoomMemberUpdateCacheMut       sync.RWMutex
+	chatRoomMemberUpdateCache          = make(map[string]updateCache)
+	chatRoomMemberUpsertCacheMut       sync.RWMutex
+	chatRoomMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single chatRoomMember record from the query using the global executor.
+func (q chatRoomMemberQuery) OneG(ctx context.Context) (*ChatRoomMember, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatRoomMember record from the query.
+func (q chatRoomMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatRoomMember, error) {
+	o := &ChatRoomMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_room_member")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatRoomMember records from the query using the global executor.
+func (q chatRoomMemberQuery) AllG(ctx context.Context) (ChatRoomMemberSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatRoomMember records from the query.
+func (q chatRoomMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatRoomMemberSlice, error) {
+	var o []*ChatRoomMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatRoomMember slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatRoomMember records in the query, and panics on error.
+func (q chatRoomMemberQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatRoomMember records in the query.
+func (q chatRoomMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_room_member rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q chatRoomMemberQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatRoomMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_room_member exists")
+	}
+
+	return count > 0, nil
+}
+
+// Room pointed to by the foreign key.
+func (o *ChatRoomMember) Room(mods ...qm.QueryMod) chatRoomQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoomID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ChatRooms(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *ChatRoomMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadRoom allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatRoomMemberL) LoadRoom(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoomMember interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoomMember
+	var object *ChatRoomMember
+
+	if singular {
+		object = maybeChatRoomMember.(*ChatRoomMember)
+	} else {
+		slice = *maybeChatRoomMember.(*[]*ChatRoomMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomMemberR{}
+		}
+		args = append(args, object.RoomID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RoomID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RoomID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room`),
+		qm.WhereIn(`chat_room.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ChatRoom")
+	}
+
+	var resultSlice []*ChatRoom
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ChatRoom")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chat_room")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Room = foreign
+		if foreign.R == nil {
+			foreign.R = &chatRoomR{}
+		}
+		foreign.R.RoomChatRoomMembers = append(foreign.R.RoomChatRoomMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoomID == foreign.ID {
+				local.R.Room = foreign
+				if foreign.R == nil {
+					foreign.R = &chatRoomR{}
+				}
+				foreign.R.RoomChatRoomMembers = append(foreign.R.RoomChatRoomMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatRoomMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatRoomMember interface{}, mods queries.Applicator) error {
+	var slice []*ChatRoomMember
+	var object *ChatRoomMember
+
+	if singular {
+		object = maybeChatRoomMember.(*ChatRoomMember)
+	} else {
+		slice = *maybeChatRoomMember.(*[]*ChatRoomMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatRoomMemberR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatRoomMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChatRoomMembers = append(foreign.R.ChatRoomMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChatRoomMembers = append(foreign.R.ChatRoomMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRoomG of the chatRoomMember to the related item.
+// Sets o.R.Room to related.
+// Adds o to related.R.RoomChatRoomMembers.
+// Uses the global database handle.
+func (o *ChatRoomMember) SetRoomG(ctx context.Context, insert bool, related *ChatRoom) error {
+	return o.SetRoom(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetRoom of the chatRoomMember to the related item.
+// Sets o.R.Room to related.
+// Adds o to related.R.RoomChatRoomMembers.
+func (o *ChatRoomMember) SetRoom(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChatRoom) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_room_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatRoomMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RoomID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoomID = related.ID
+	if o.R == nil {
+		o.R = &chatRoomMemberR{
+			Room: related,
+		}
+	} else {
+		o.R.Room = related
+	}
+
+	if related.R == nil {
+		related.R = &chatRoomR{
+			RoomChatRoomMembers: ChatRoomMemberSlice{o},
+		}
+	} else {
+		related.R.RoomChatRoomMembers = append(related.R.RoomChatRoomMembers, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the chatRoomMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatRoomMembers.
+// Uses the global database handle.
+func (o *ChatRoomMember) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the chatRoomMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatRoomMembers.
+func (o *ChatRoomMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_room_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatRoomMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RoomID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &chatRoomMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChatRoomMembers: ChatRoomMemberSlice{o},
+		}
+	} else {
+		related.R.ChatRoomMembers = append(related.R.ChatRoomMembers, o)
+	}
+
+	return nil
+}
+
+// ChatRoomMembers retrieves all the records using an executor.
+func ChatRoomMembers(mods ...qm.QueryMod) chatRoomMemberQuery {
+	mods = append(mods, qm.From("\"chat_room_member\""))
+	return chatRoomMemberQuery{NewQuery(mods...)}
+}
+
+// FindChatRoomMemberG retrieves a single record by ID.
+func FindChatRoomMemberG(ctx context.Context, roomID string, userID string, selectCols ...string) (*ChatRoomMember, error) {
+	return FindChatRoomMember(ctx, boil.GetContextDB(), roomID, userID, selectCols...)
+}
+
+// FindChatRoomMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatRoomMember(ctx context.Context, exec boil.ContextExecutor, roomID string, userID string, selectCols ...string) (*ChatRoomMember, error) {
+	chatRoomMemberObj := &ChatRoomMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_room_member\" where \"room_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, roomID, userID)
+
+	err := q.Bind(ctx, exec, chatRoomMemberObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_room_member")
+	}
+
+	return chatRoomMemberObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatRoomMember) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatRoomMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_room_member provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatRoomMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatRoomMemberInsertCacheMut.RLock()
+	cache, cached := chatRoomMemberInsertCache[key]
+	chatRoomMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatRoomMemberAllColumns,
+			chatRoomMemberColumnsWithDefault,
+			chatRoomMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_room_member\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_room_member\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_room_member")
+	}
+
+	if !cached {
+		chatRoomMemberInsertCacheMut.Lock()
+		chatRoomMemberInsertCache[key] = cache
+		chatRoomMemberInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ChatRoomMember record using the global executor.
+// See Update for more documentation.
+func (o *ChatRoomMember) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatRoomMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatRoomMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	chatRoomMemberUpdateCacheMut.RLock()
+	cache, cached := chatRoomMemberUpdateCache[key]
+	chatRoomMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatRoomMemberAllColumns,
+			chatRoomMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_room_member, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_room_member\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatRoomMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, append(wl, chatRoomMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_room_member row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_room_member")
+	}
+
+	if !cached {
+		chatRoomMemberUpdateCacheMut.Lock()
+		chatRoomMemberUpdateCache[key] = cache
+		chatRoomMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatRoomMemberQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatRoomMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_room_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_room_member")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatRoomMemberSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatRoomMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_room_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatRoomMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatRoomMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatRoomMember")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatRoomMember) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatRoomMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_room_member provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatRoomMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatRoomMemberUpsertCacheMut.RLock()
+	cache, cached := chatRoomMemberUpsertCache[key]
+	chatRoomMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatRoomMemberAllColumns,
+			chatRoomMemberColumnsWithDefault,
+			chatRoomMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			chatRoomMemberAllColumns,
+			chatRoomMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_room_member, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatRoomMemberPrimaryKeyColumns))
+			copy(conflict, chatRoomMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_room_member\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatRoomMemberType, chatRoomMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_room_member")
+	}
+
+	if !cached {
+		chatRoomMemberUpsertCacheMut.Lock()
+		chatRoomMemberUpsertCache[key] = cache
+		chatRoomMemberUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ChatRoomMember record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatRoomMember) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatRoomMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatRoomMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatRoomMember provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatRoomMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_room_member\" WHERE \"room_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_room_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_room_member")
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatRoomMemberQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatRoomMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatRoomMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_room_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_room_member")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatRoomMemberSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatRoomMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_room_member\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatRoomMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatRoomMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_room_member")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatRoomMember) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatRoomMember provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatRoomMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatRoomMember(ctx, exec, o.RoomID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatRoomMemberSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatRoomMemberSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatRoomMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatRoomMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatRoomMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_room_member\".* FROM \"chat_room_member\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatRoomMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatRoomMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatRoomMemberExistsG checks if the ChatRoomMember row exists.
+func ChatRoomMemberExistsG(ctx context.Context, roomID string, userID string) (bool, error) {
+	return ChatRoomMemberExists(ctx, boil.GetContextDB(), roomID, userID)
+}
+
+// ChatRoomMemberExists checks if the ChatRoomMember row exists.
+func ChatRoomMemberExists(ctx context.Context, exec boil.ContextExecutor, roomID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_room_member\" where \"room_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, roomID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, roomID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_room_member exists")
+	}
+
+	return exists, nil
+}
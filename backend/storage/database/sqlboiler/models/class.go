@@ -0,0 +1,2178 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Class is an object representing the database table.
+type Class struct {
+	ID                  string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID            string      `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	DepartmentID        null.String `boil:"department_id" json:"department_id,omitempty" toml:"department_id" yaml:"department_id,omitempty"`
+	Name                string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	YearLevel           int         `boil:"year_level" json:"year_level" toml:"year_level" yaml:"year_level"`
+	Year                int         `boil:"year" json:"year" toml:"year" yaml:"year"`
+	CreatedAt           null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt           null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	MarksPublishedUntil null.Time   `boil:"marks_published_until" json:"marks_published_until,omitempty" toml:"marks_published_until" yaml:"marks_published_until,omitempty"`
+
+	R *classR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L classL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClassColumns = struct {
+	ID                  string
+	SchoolID            string
+	DepartmentID        string
+	Name                string
+	YearLevel           string
+	Year                string
+	CreatedAt           string
+	UpdatedAt           string
+	MarksPublishedUntil string
+}{
+	ID:                  "id",
+	SchoolID:            "school_id",
+	DepartmentID:        "department_id",
+	Name:                "name",
+	YearLevel:           "year_level",
+	Year:                "year",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	MarksPublishedUntil: "marks_published_until",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ClassWhere = struct {
+	ID                  whereHelperstring
+	SchoolID            whereHelperstring
+	DepartmentID        whereHelpernull_String
+	Name                whereHelperstring
+	YearLevel           whereHelperint
+	Year                whereHelperint
+	CreatedAt           whereHelpernull_Time
+	UpdatedAt           whereHelpernull_Time
+	MarksPublishedUntil whereHelpernull_Time
+}{
+	ID:                  whereHelperstring{field: "\"class\".\"id\""},
+	SchoolID:            whereHelperstring{field: "\"class\".\"school_id\""},
+	DepartmentID:        whereHelpernull_String{field: "\"class\".\"department_id\""},
+	Name:                whereHelperstring{field: "\"class\".\"name\""},
+	YearLevel:           whereHelperint{field: "\"class\".\"year_level\""},
+	Year:                whereHelperint{field: "\"class\".\"year\""},
+	CreatedAt:           whereHelpernull_Time{field: "\"class\".\"created_at\""},
+	UpdatedAt:           whereHelpernull_Time{field: "\"class\".\"updated_at\""},
+	MarksPublishedUntil: whereHelpernull_Time{field: "\"class\".\"marks_published_until\""},
+}
+
+// ClassRels is where relationship names are stored.
+var ClassRels = struct {
+	Department         string
+	School             string
+	Announcements      string
+	AttendanceSessions string
+	ClassStudents      string
+	Courses            string
+	WalletAwards       string
+}{
+	Department:         "Department",
+	School:             "School",
+	Announcements:      "Announcements",
+	AttendanceSessions: "AttendanceSessions",
+	ClassStudents:      "ClassStudents",
+	Courses:            "Courses",
+	WalletAwards:       "WalletAwards",
+}
+
+// classR is where relationships are stored.
+type classR struct {
+	Department         *Department            `boil:"Department" json:"Department" toml:"Department" yaml:"Department"`
+	School             *School                `boil:"School" json:"School" toml:"School" yaml:"School"`
+	Announcements      AnnouncementSlice      `boil:"Announcements" json:"Announcements" toml:"Announcements" yaml:"Announcements"`
+	AttendanceSessions AttendanceSessionSlice `boil:"AttendanceSessions" json:"AttendanceSessions" toml:"AttendanceSessions" yaml:"AttendanceSessions"`
+	ClassStudents      ClassStudentSlice      `boil:"ClassStudents" json:"ClassStudents" toml:"ClassStudents" yaml:"ClassStudents"`
+	Courses            CourseSlice            `boil:"Courses" json:"Courses" toml:"Courses" yaml:"Courses"`
+	WalletAwards       WalletAwardSlice       `boil:"WalletAwards" json:"WalletAwards" toml:"WalletAwards" yaml:"WalletAwards"`
+}
+
+// NewStruct creates a new relationship struct
+func (*classR) NewStruct() *classR {
+	return &classR{}
+}
+
+// classL is where Load methods for each relationship are stored.
+type classL struct{}
+
+var (
+	classAllColumns            = []string{"id", "school_id", "department_id", "name", "year_level", "year", "created_at", "updated_at", "marks_published_until"}
+	classColumnsWithoutDefault = []string{"id", "school_id", "department_id", "name", "year_level", "year", "created_at", "updated_at", "marks_published_until"}
+	classColumnsWithDefault    = []string{}
+	classPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ClassSlice is an alias for a slice of pointers to Class.
+	// This should generally be used opposed to []Class.
+	ClassSlice []*Class
+
+	classQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	classType                 = reflect.TypeOf(&Class{})
+	classMapping              = queries.MakeStructMapping(classType)
+	classPrimaryKeyMapping, _ = queries.BindMapping(classType, classMapping, classPrimaryKeyColumns)
+	classInsertCacheMut       sync.RWMutex
+	classInsertCache          = make(map[string]insertCache)
+	classUpdateCacheMut       sync.RWMutex
+	classUpdateCache          = make(map[string]updateCache)
+	classUpsertCacheMut       sync.RWMutex
+	classUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single class record from the query using the global executor.
+func (q classQuery) OneG(ctx context.Context) (*Class, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single class record from the query.
+func (q classQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Class, error) {
+	o := &Class{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for class")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Class records from the query using the global executor.
+func (q classQuery) AllG(ctx context.Context) (ClassSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Class records from the query.
+func (q classQuery) All(ctx context.Context, exec boil.ContextExecutor) (ClassSlice, error) {
+	var o []*Class
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Class slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Class records in the query, and panics on error.
+func (q classQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Class records in the query.
+func (q classQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count class rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q classQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q classQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if class exists")
+	}
+
+	return count > 0, nil
+}
+
+// Department pointed to by the foreign key.
+func (o *Class) Department(mods ...qm.QueryMod) departmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DepartmentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Departments(queryMods...)
+	queries.SetFrom(query.Query, "\"department\"")
+
+	return query
+}
+
+// School pointed to by the foreign key.
+func (o *Class) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// Announcements retrieves all the announcement's Announcements with an executor.
+func (o *Class) Announcements(mods ...qm.QueryMod) announcementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"announcement\".\"class_id\"=?", o.ID),
+	)
+
+	query := Announcements(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"announcement\".*"})
+	}
+
+	return query
+}
+
+// AttendanceSessions retrieves all the attendance_session's AttendanceSessions with an executor.
+func (o *Class) AttendanceSessions(mods ...qm.QueryMod) attendanceSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attendance_session\".\"class_id\"=?", o.ID),
+	)
+
+	query := AttendanceSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attendance_session\".*"})
+	}
+
+	return query
+}
+
+// ClassStudents retrieves all the class_student's ClassStudents with an executor.
+func (o *Class) ClassStudents(mods ...qm.QueryMod) classStudentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"class_student\".\"class_id\"=?", o.ID),
+	)
+
+	query := ClassStudents(queryMods...)
+	queries.SetFrom(query.Query, "\"class_student\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class_student\".*"})
+	}
+
+	return query
+}
+
+// Courses retrieves all the course's Courses with an executor.
+func (o *Class) Courses(mods ...qm.QueryMod) courseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"course\".\"class_id\"=?", o.ID),
+	)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"course\".*"})
+	}
+
+	return query
+}
+
+// WalletAwards retrieves all the wallet_award's WalletAwards with an executor.
+func (o *Class) WalletAwards(mods ...qm.QueryMod) walletAwardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_award\".\"class_id\"=?", o.ID),
+	)
+
+	query := WalletAwards(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_award\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_award\".*"})
+	}
+
+	return query
+}
+
+// LoadDepartment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classL) LoadDepartment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		if !queries.IsNil(object.DepartmentID) {
+			args = append(args, object.DepartmentID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.DepartmentID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.DepartmentID) {
+				args = append(args, obj.DepartmentID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`department`),
+		qm.WhereIn(`department.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Department")
+	}
+
+	var resultSlice []*Department
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Department")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for department")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for department")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Department = foreign
+		if foreign.R == nil {
+			foreign.R = &departmentR{}
+		}
+		foreign.R.Classes = append(foreign.R.Classes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DepartmentID, foreign.ID) {
+				local.R.Department = foreign
+				if foreign.R == nil {
+					foreign.R = &departmentR{}
+				}
+				foreign.R.Classes = append(foreign.R.Classes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.Classes = append(foreign.R.Classes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.Classes = append(foreign.R.Classes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAnnouncements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (classL) LoadAnnouncements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement`),
+		qm.WhereIn(`announcement.class_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load announcement")
+	}
+
+	var resultSlice []*Announcement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice announcement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on announcement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement")
+	}
+
+	if singular {
+		object.R.Announcements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &announcementR{}
+			}
+			foreign.R.Class = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ClassID) {
+				local.R.Announcements = append(local.R.Announcements, foreign)
+				if foreign.R == nil {
+					foreign.R = &announcementR{}
+				}
+				foreign.R.Class = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAttendanceSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (classL) LoadAttendanceSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_session`),
+		qm.WhereIn(`attendance_session.class_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attendance_session")
+	}
+
+	var resultSlice []*AttendanceSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attendance_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attendance_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_session")
+	}
+
+	if singular {
+		object.R.AttendanceSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attendanceSessionR{}
+			}
+			foreign.R.Class = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ClassID {
+				local.R.AttendanceSessions = append(local.R.AttendanceSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &attendanceSessionR{}
+				}
+				foreign.R.Class = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClassStudents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (classL) LoadClassStudents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class_student`),
+		qm.WhereIn(`class_student.class_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class_student")
+	}
+
+	var resultSlice []*ClassStudent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class_student")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class_student")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_student")
+	}
+
+	if singular {
+		object.R.ClassStudents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classStudentR{}
+			}
+			foreign.R.Class = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ClassID {
+				local.R.ClassStudents = append(local.R.ClassStudents, foreign)
+				if foreign.R == nil {
+					foreign.R = &classStudentR{}
+				}
+				foreign.R.Class = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCourses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (classL) LoadCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.class_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if singular {
+		object.R.Courses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &courseR{}
+			}
+			foreign.R.Class = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ClassID {
+				local.R.Courses = append(local.R.Courses, foreign)
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.Class = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWalletAwards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (classL) LoadWalletAwards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClass interface{}, mods queries.Applicator) error {
+	var slice []*Class
+	var object *Class
+
+	if singular {
+		object = maybeClass.(*Class)
+	} else {
+		slice = *maybeClass.(*[]*Class)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_award`),
+		qm.WhereIn(`wallet_award.class_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_award")
+	}
+
+	var resultSlice []*WalletAward
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_award")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_award")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_award")
+	}
+
+	if singular {
+		object.R.WalletAwards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletAwardR{}
+			}
+			foreign.R.Class = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ClassID {
+				local.R.WalletAwards = append(local.R.WalletAwards, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletAwardR{}
+				}
+				foreign.R.Class = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDepartmentG of the class to the related item.
+// Sets o.R.Department to related.
+// Adds o to related.R.Classes.
+// Uses the global database handle.
+func (o *Class) SetDepartmentG(ctx context.Context, insert bool, related *Department) error {
+	return o.SetDepartment(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetDepartment of the class to the related item.
+// Sets o.R.Department to related.
+// Adds o to related.R.Classes.
+func (o *Class) SetDepartment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Department) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"department_id"}),
+		strmangle.WhereClause("\"", "\"", 2, classPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DepartmentID, related.ID)
+	if o.R == nil {
+		o.R = &classR{
+			Department: related,
+		}
+	} else {
+		o.R.Department = related
+	}
+
+	if related.R == nil {
+		related.R = &departmentR{
+			Classes: ClassSlice{o},
+		}
+	} else {
+		related.R.Classes = append(related.R.Classes, o)
+	}
+
+	return nil
+}
+
+// RemoveDepartmentG relationship.
+// Sets o.R.Department to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Class) RemoveDepartmentG(ctx context.Context, related *Department) error {
+	return o.RemoveDepartment(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveDepartment relationship.
+// Sets o.R.Department to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Class) RemoveDepartment(ctx context.Context, exec boil.ContextExecutor, related *Department) error {
+	var err error
+
+	queries.SetScanner(&o.DepartmentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("department_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Department = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Classes {
+		if queries.Equal(o.DepartmentID, ri.DepartmentID) {
+			continue
+		}
+
+		ln := len(related.R.Classes)
+		if ln > 1 && i < ln-1 {
+			related.R.Classes[i] = related.R.Classes[ln-1]
+		}
+		related.R.Classes = related.R.Classes[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSchoolG of the class to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Classes.
+// Uses the global database handle.
+func (o *Class) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the class to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Classes.
+func (o *Class) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, classPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &classR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			Classes: ClassSlice{o},
+		}
+	} else {
+		related.R.Classes = append(related.R.Classes, o)
+	}
+
+	return nil
+}
+
+// AddAnnouncementsG adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.Announcements.
+// Sets related.R.Class appropriately.
+// Uses the global database handle.
+func (o *Class) AddAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.AddAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAnnouncements adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.Announcements.
+// Sets related.R.Class appropriately.
+func (o *Class) AddAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ClassID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"announcement\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+				strmangle.WhereClause("\"", "\"", 2, announcementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ClassID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &classR{
+			Announcements: related,
+		}
+	} else {
+		o.R.Announcements = append(o.R.Announcements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &announcementR{
+				Class: o,
+			}
+		} else {
+			rel.R.Class = o
+		}
+	}
+	return nil
+}
+
+// SetAnnouncementsG removes all previously related items of the
+// class replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Class's Announcements accordingly.
+// Replaces o.R.Announcements with related.
+// Sets related.R.Class's Announcements accordingly.
+// Uses the global database handle.
+func (o *Class) SetAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.SetAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAnnouncements removes all previously related items of the
+// class replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Class's Announcements accordingly.
+// Replaces o.R.Announcements with related.
+// Sets related.R.Class's Announcements accordingly.
+func (o *Class) SetAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	query := "update \"announcement\" set \"class_id\" = null where \"class_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Announcements {
+			queries.SetScanner(&rel.ClassID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Class = nil
+		}
+
+		o.R.Announcements = nil
+	}
+	return o.AddAnnouncements(ctx, exec, insert, related...)
+}
+
+// RemoveAnnouncementsG relationships from objects passed in.
+// Removes related items from R.Announcements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Class.
+// Uses the global database handle.
+func (o *Class) RemoveAnnouncementsG(ctx context.Context, related ...*Announcement) error {
+	return o.RemoveAnnouncements(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAnnouncements relationships from objects passed in.
+// Removes related items from R.Announcements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Class.
+func (o *Class) RemoveAnnouncements(ctx context.Context, exec boil.ContextExecutor, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ClassID, nil)
+		if rel.R != nil {
+			rel.R.Class = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("class_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Announcements {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Announcements)
+			if ln > 1 && i < ln-1 {
+				o.R.Announcements[i] = o.R.Announcements[ln-1]
+			}
+			o.R.Announcements = o.R.Announcements[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAttendanceSessionsG adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.AttendanceSessions.
+// Sets related.R.Class appropriately.
+// Uses the global database handle.
+func (o *Class) AddAttendanceSessionsG(ctx context.Context, insert bool, related ...*AttendanceSession) error {
+	return o.AddAttendanceSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAttendanceSessions adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.AttendanceSessions.
+// Sets related.R.Class appropriately.
+func (o *Class) AddAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClassID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attendance_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClassID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &classR{
+			AttendanceSessions: related,
+		}
+	} else {
+		o.R.AttendanceSessions = append(o.R.AttendanceSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attendanceSessionR{
+				Class: o,
+			}
+		} else {
+			rel.R.Class = o
+		}
+	}
+	return nil
+}
+
+// AddClassStudentsG adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.ClassStudents.
+// Sets related.R.Class appropriately.
+// Uses the global database handle.
+func (o *Class) AddClassStudentsG(ctx context.Context, insert bool, related ...*ClassStudent) error {
+	return o.AddClassStudents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddClassStudents adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.ClassStudents.
+// Sets related.R.Class appropriately.
+func (o *Class) AddClassStudents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ClassStudent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClassID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"class_student\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+				strmangle.WhereClause("\"", "\"", 2, classStudentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ClassID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClassID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &classR{
+			ClassStudents: related,
+		}
+	} else {
+		o.R.ClassStudents = append(o.R.ClassStudents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &classStudentR{
+				Class: o,
+			}
+		} else {
+			rel.R.Class = o
+		}
+	}
+	return nil
+}
+
+// AddCoursesG adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.Courses.
+// Sets related.R.Class appropriately.
+// Uses the global database handle.
+func (o *Class) AddCoursesG(ctx context.Context, insert bool, related ...*Course) error {
+	return o.AddCourses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCourses adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.Courses.
+// Sets related.R.Class appropriately.
+func (o *Class) AddCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClassID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"course\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coursePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClassID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &classR{
+			Courses: related,
+		}
+	} else {
+		o.R.Courses = append(o.R.Courses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &courseR{
+				Class: o,
+			}
+		} else {
+			rel.R.Class = o
+		}
+	}
+	return nil
+}
+
+// AddWalletAwardsG adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.WalletAwards.
+// Sets related.R.Class appropriately.
+// Uses the global database handle.
+func (o *Class) AddWalletAwardsG(ctx context.Context, insert bool, related ...*WalletAward) error {
+	return o.AddWalletAwards(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWalletAwards adds the given related objects to the existing relationships
+// of the class, optionally inserting them as new records.
+// Appends related to o.R.WalletAwards.
+// Sets related.R.Class appropriately.
+func (o *Class) AddWalletAwards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletAward) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClassID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_award\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletAwardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClassID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &classR{
+			WalletAwards: related,
+		}
+	} else {
+		o.R.WalletAwards = append(o.R.WalletAwards, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletAwardR{
+				Class: o,
+			}
+		} else {
+			rel.R.Class = o
+		}
+	}
+	return nil
+}
+
+// Classes retrieves all the records using an executor.
+func Classes(mods ...qm.QueryMod) classQuery {
+	mods = append(mods, qm.From("\"class\""))
+	return classQuery{NewQuery(mods...)}
+}
+
+// FindClassG retrieves a single record by ID.
+func FindClassG(ctx context.Context, iD string, selectCols ...string) (*Class, error) {
+	return FindClass(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindClass retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClass(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Class, error) {
+	classObj := &Class{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"class\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, classObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from class")
+	}
+
+	return classObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Class) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Class) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no class provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	classInsertCacheMut.RLock()
+	cache, cached := classInsertCache[key]
+	classInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			classAllColumns,
+			classColumnsWithDefault,
+			classColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(classType, classMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(classType, classMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"class\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"class\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into class")
+	}
+
+	if !cached {
+		classInsertCacheMut.Lock()
+		classInsertCache[key] = cache
+		classInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Class record using the global executor.
+// See Update for more documentation.
+func (o *Class) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Class.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Class) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	classUpdateCacheMut.RLock()
+	cache, cached := classUpdateCache[key]
+	classUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			classAllColumns,
+			classPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update class, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"class\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, classPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(classType, classMapping, append(wl, classPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update class row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for class")
+	}
+
+	if !cached {
+		classUpdateCacheMut.Lock()
+		classUpdateCache[key] = cache
+		classUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q classQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q classQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for class")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for class")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ClassSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClassSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"class\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, classPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in class slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all class")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Class) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Class) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no class provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	classUpsertCacheMut.RLock()
+	cache, cached := classUpsertCache[key]
+	classUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			classAllColumns,
+			classColumnsWithDefault,
+			classColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			classAllColumns,
+			classPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert class, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(classPrimaryKeyColumns))
+			copy(conflict, classPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"class\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(classType, classMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(classType, classMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert class")
+	}
+
+	if !cached {
+		classUpsertCacheMut.Lock()
+		classUpsertCache[key] = cache
+		classUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Class record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Class) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Class record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Class) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Class provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), classPrimaryKeyMapping)
+	sql := "DELETE FROM \"class\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from class")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for class")
+	}
+
+	return rowsAff, nil
+}
+
+func (q classQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q classQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no classQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from class")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for class")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ClassSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClassSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"class\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from class slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for class")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Class) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Class provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Class) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindClass(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ClassSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClassSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"class\".* FROM \"class\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ClassSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClassExistsG checks if the Class row exists.
+func ClassExistsG(ctx context.Context, iD string) (bool, error) {
+	return ClassExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ClassExists checks if the Class row exists.
+func ClassExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"class\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if class exists")
+	}
+
+	return exists, nil
+}
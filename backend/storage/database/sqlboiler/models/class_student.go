@@ -0,0 +1,1096 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ClassStudent is an object representing the database table.
+type ClassStudent struct {
+	ClassID   string    `boil:"class_id" json:"class_id" toml:"class_id" yaml:"class_id"`
+	StudentID string    `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *classStudentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L classStudentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClassStudentColumns = struct {
+	ClassID   string
+	StudentID string
+	CreatedAt string
+}{
+	ClassID:   "class_id",
+	StudentID: "student_id",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var ClassStudentWhere = struct {
+	ClassID   whereHelperstring
+	StudentID whereHelperstring
+	CreatedAt whereHelpernull_Time
+}{
+	ClassID:   whereHelperstring{field: "\"class_student\".\"class_id\""},
+	StudentID: whereHelperstring{field: "\"class_student\".\"student_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"class_student\".\"created_at\""},
+}
+
+// ClassStudentRels is where relationship names are stored.
+var ClassStudentRels = struct {
+	Class   string
+	Student string
+}{
+	Class:   "Class",
+	Student: "Student",
+}
+
+// classStudentR is where relationships are stored.
+type classStudentR struct {
+	Class   *Class `boil:"Class" json:"Class" toml:"Class" yaml:"Class"`
+	Student *User  `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*classStudentR) NewStruct() *classStudentR {
+	return &classStudentR{}
+}
+
+// classStudentL is where Load methods for each relationship are stored.
+type classStudentL struct{}
+
+var (
+	classStudentAllColumns            = []string{"class_id", "student_id", "created_at"}
+	classStudentColumnsWithoutDefault = []string{"class_id", "student_id", "created_at"}
+	classStudentColumnsWithDefault    = []string{}
+	classStudentPrimaryKeyColumns     = []string{"class_id", "student_id"}
+)
+
+type (
+	// ClassStudentSlice is an alias for a slice of pointers to ClassStudent.
+	// This should generally be used opposed to []ClassStudent.
+	ClassStudentSlice []*ClassStudent
+
+	classStudentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	classStudentType                 = reflect.TypeOf(&ClassStudent{})
+	classStudentMapping              = queries.MakeStructMapping(classStudentType)
+	classStudentPrimaryKeyMapping, _ = queries.BindMapping(classStudentType, classStudentMapping, classStudentPrimaryKeyColumns)
+	classStudentInsertCacheMut       sync.RWMutex
+	classStudentInsertCache          = make(map[string]insertCache)
+	classStudentUpdateCacheMut       sync.RWMutex
+	classStudentUpdateCache          = make(map[string]updateCache)
+	classStudentUpsertCacheMut       sync.RWMutex
+	classStudentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single classStudent record from the query using the global executor.
+func (q classStudentQuery) OneG(ctx context.Context) (*ClassStudent, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single classStudent record from the query.
+func (q classStudentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ClassStudent, error) {
+	o := &ClassStudent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for class_student")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ClassStudent records from the query using the global executor.
+func (q classStudentQuery) AllG(ctx context.Context) (ClassStudentSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ClassStudent records from the query.
+func (q classStudentQuery) All(ctx context.Context, exec boil.ContextExecutor) (ClassStudentSlice, error) {
+	var o []*ClassStudent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ClassStudent slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ClassStudent records in the query, and panics on error.
+func (q classStudentQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ClassStudent records in the query.
+func (q classStudentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count class_student rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q classStudentQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q classStudentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if class_student exists")
+	}
+
+	return count > 0, nil
+}
+
+// Class pointed to by the foreign key.
+func (o *ClassStudent) Class(mods ...qm.QueryMod) classQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClassID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Classes(queryMods...)
+	queries.SetFrom(query.Query, "\"class\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *ClassStudent) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadClass allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classStudentL) LoadClass(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClassStudent interface{}, mods queries.Applicator) error {
+	var slice []*ClassStudent
+	var object *ClassStudent
+
+	if singular {
+		object = maybeClassStudent.(*ClassStudent)
+	} else {
+		slice = *maybeClassStudent.(*[]*ClassStudent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classStudentR{}
+		}
+		args = append(args, object.ClassID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classStudentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ClassID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClassID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class`),
+		qm.WhereIn(`class.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Class")
+	}
+
+	var resultSlice []*Class
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Class")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Class = foreign
+		if foreign.R == nil {
+			foreign.R = &classR{}
+		}
+		foreign.R.ClassStudents = append(foreign.R.ClassStudents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ClassID == foreign.ID {
+				local.R.Class = foreign
+				if foreign.R == nil {
+					foreign.R = &classR{}
+				}
+				foreign.R.ClassStudents = append(foreign.R.ClassStudents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classStudentL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClassStudent interface{}, mods queries.Applicator) error {
+	var slice []*ClassStudent
+	var object *ClassStudent
+
+	if singular {
+		object = maybeClassStudent.(*ClassStudent)
+	} else {
+		slice = *maybeClassStudent.(*[]*ClassStudent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classStudentR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classStudentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentClassStudents = append(foreign.R.StudentClassStudents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentClassStudents = append(foreign.R.StudentClassStudents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClassG of the classStudent to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.ClassStudents.
+// Uses the global database handle.
+func (o *ClassStudent) SetClassG(ctx context.Context, insert bool, related *Class) error {
+	return o.SetClass(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetClass of the classStudent to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.ClassStudents.
+func (o *ClassStudent) SetClass(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Class) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_student\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+		strmangle.WhereClause("\"", "\"", 2, classStudentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClassID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ClassID = related.ID
+	if o.R == nil {
+		o.R = &classStudentR{
+			Class: related,
+		}
+	} else {
+		o.R.Class = related
+	}
+
+	if related.R == nil {
+		related.R = &classR{
+			ClassStudents: ClassStudentSlice{o},
+		}
+	} else {
+		related.R.ClassStudents = append(related.R.ClassStudents, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the classStudent to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentClassStudents.
+// Uses the global database handle.
+func (o *ClassStudent) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the classStudent to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentClassStudents.
+func (o *ClassStudent) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_student\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, classStudentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClassID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &classStudentR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentClassStudents: ClassStudentSlice{o},
+		}
+	} else {
+		related.R.StudentClassStudents = append(related.R.StudentClassStudents, o)
+	}
+
+	return nil
+}
+
+// ClassStudents retrieves all the records using an executor.
+func ClassStudents(mods ...qm.QueryMod) classStudentQuery {
+	mods = append(mods, qm.From("\"class_student\""))
+	return classStudentQuery{NewQuery(mods...)}
+}
+
+// FindClassStudentG retrieves a single record by ID.
+func FindClassStudentG(ctx context.Context, classID string, studentID string, selectCols ...string) (*ClassStudent, error) {
+	return FindClassStudent(ctx, boil.GetContextDB(), classID, studentID, selectCols...)
+}
+
+// FindClassStudent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClassStudent(ctx context.Context, exec boil.ContextExecutor, classID string, studentID string, selectCols ...string) (*ClassStudent, error) {
+	classStudentObj := &ClassStudent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"class_student\" where \"class_id\"=$1 AND \"student_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, classID, studentID)
+
+	err := q.Bind(ctx, exec, classStudentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from class_student")
+	}
+
+	return classStudentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ClassStudent) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ClassStudent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no class_student provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classStudentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	classStudentInsertCacheMut.RLock()
+	cache, cached := classStudentInsertCache[key]
+	classStudentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			classStudentAllColumns,
+			classStudentColumnsWithDefault,
+			classStudentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(classStudentType, classStudentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(classStudentType, classStudentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"class_student\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"class_student\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into class_student")
+	}
+
+	if !cached {
+		classStudentInsertCacheMut.Lock()
+		classStudentInsertCache[key] = cache
+		classStudentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ClassStudent record using the global executor.
+// See Update for more documentation.
+func (o *ClassStudent) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ClassStudent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ClassStudent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	classStudentUpdateCacheMut.RLock()
+	cache, cached := classStudentUpdateCache[key]
+	classStudentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			classStudentAllColumns,
+			classStudentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update class_student, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"class_student\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, classStudentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(classStudentType, classStudentMapping, append(wl, classStudentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update class_student row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for class_student")
+	}
+
+	if !cached {
+		classStudentUpdateCacheMut.Lock()
+		classStudentUpdateCache[key] = cache
+		classStudentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q classStudentQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q classStudentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for class_student")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for class_student")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ClassStudentSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClassStudentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classStudentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"class_student\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, classStudentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in classStudent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all classStudent")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ClassStudent) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ClassStudent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no class_student provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classStudentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	classStudentUpsertCacheMut.RLock()
+	cache, cached := classStudentUpsertCache[key]
+	classStudentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			classStudentAllColumns,
+			classStudentColumnsWithDefault,
+			classStudentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			classStudentAllColumns,
+			classStudentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert class_student, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(classStudentPrimaryKeyColumns))
+			copy(conflict, classStudentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"class_student\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(classStudentType, classStudentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(classStudentType, classStudentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert class_student")
+	}
+
+	if !cached {
+		classStudentUpsertCacheMut.Lock()
+		classStudentUpsertCache[key] = cache
+		classStudentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ClassStudent record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ClassStudent) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ClassStudent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ClassStudent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ClassStudent provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), classStudentPrimaryKeyMapping)
+	sql := "DELETE FROM \"class_student\" WHERE \"class_id\"=$1 AND \"student_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from class_student")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for class_student")
+	}
+
+	return rowsAff, nil
+}
+
+func (q classStudentQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q classStudentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no classStudentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from class_student")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for class_student")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ClassStudentSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClassStudentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classStudentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"class_student\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classStudentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from classStudent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for class_student")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ClassStudent) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ClassStudent provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ClassStudent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindClassStudent(ctx, exec, o.ClassID, o.StudentID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassStudentSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ClassStudentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassStudentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClassStudentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classStudentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"class_student\".* FROM \"class_student\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classStudentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ClassStudentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClassStudentExistsG checks if the ClassStudent row exists.
+func ClassStudentExistsG(ctx context.Context, classID string, studentID string) (bool, error) {
+	return ClassStudentExists(ctx, boil.GetContextDB(), classID, studentID)
+}
+
+// ClassStudentExists checks if the ClassStudent row exists.
+func ClassStudentExists(ctx context.Context, exec boil.ContextExecutor, classID string, studentID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"class_student\" where \"class_id\"=$1 AND \"student_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, classID, studentID)
+	}
+	row := exec.QueryRowContext(ctx, sql, classID, studentID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if class_student exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,3018 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Course is an object representing the database table.
+type Course struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ClassID   string      `boil:"class_id" json:"class_id" toml:"class_id" yaml:"class_id"`
+	TeacherID null.String `boil:"teacher_id" json:"teacher_id,omitempty" toml:"teacher_id" yaml:"teacher_id,omitempty"`
+	Name      string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *courseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L courseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CourseColumns = struct {
+	ID        string
+	ClassID   string
+	TeacherID string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	ClassID:   "class_id",
+	TeacherID: "teacher_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var CourseWhere = struct {
+	ID        whereHelperstring
+	ClassID   whereHelperstring
+	TeacherID whereHelpernull_String
+	Name      whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"course\".\"id\""},
+	ClassID:   whereHelperstring{field: "\"course\".\"class_id\""},
+	TeacherID: whereHelpernull_String{field: "\"course\".\"teacher_id\""},
+	Name:      whereHelperstring{field: "\"course\".\"name\""},
+	CreatedAt: whereHelpernull_Time{field: "\"course\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"course\".\"updated_at\""},
+}
+
+// CourseRels is where relationship names are stored.
+var CourseRels = struct {
+	Class              string
+	Teacher            string
+	ChatRoom           string
+	AttendanceSessions string
+	CalendarEvents     string
+	ChatMutes          string
+	Courseworks        string
+	MarkCategories     string
+	Questions          string
+	WalletRules        string
+	WalletTransactions string
+}{
+	Class:              "Class",
+	Teacher:            "Teacher",
+	ChatRoom:           "ChatRoom",
+	AttendanceSessions: "AttendanceSessions",
+	CalendarEvents:     "CalendarEvents",
+	ChatMutes:          "ChatMutes",
+	Courseworks:        "Courseworks",
+	MarkCategories:     "MarkCategories",
+	Questions:          "Questions",
+	WalletRules:        "WalletRules",
+	WalletTransactions: "WalletTransactions",
+}
+
+// courseR is where relationships are stored.
+type courseR struct {
+	Class              *Class                 `boil:"Class" json:"Class" toml:"Class" yaml:"Class"`
+	Teacher            *User                  `boil:"Teacher" json:"Teacher" toml:"Teacher" yaml:"Teacher"`
+	ChatRoom           *ChatRoom              `boil:"ChatRoom" json:"ChatRoom" toml:"ChatRoom" yaml:"ChatRoom"`
+	AttendanceSessions AttendanceSessionSlice `boil:"AttendanceSessions" json:"AttendanceSessions" toml:"AttendanceSessions" yaml:"AttendanceSessions"`
+	CalendarEvents     CalendarEventSlice     `boil:"CalendarEvents" json:"CalendarEvents" toml:"CalendarEvents" yaml:"CalendarEvents"`
+	ChatMutes          ChatMuteSlice          `boil:"ChatMutes" json:"ChatMutes" toml:"ChatMutes" yaml:"ChatMutes"`
+	Courseworks        CourseworkSlice        `boil:"Courseworks" json:"Courseworks" toml:"Courseworks" yaml:"Courseworks"`
+	MarkCategories     MarkCategorySlice      `boil:"MarkCategories" json:"MarkCategories" toml:"MarkCategories" yaml:"MarkCategories"`
+	Questions          QuestionSlice          `boil:"Questions" json:"Questions" toml:"Questions" yaml:"Questions"`
+	WalletRules        WalletRuleSlice        `boil:"WalletRules" json:"WalletRules" toml:"WalletRules" yaml:"WalletRules"`
+	WalletTransactions WalletTransactionSlice `boil:"WalletTransactions" json:"WalletTransactions" toml:"WalletTransactions" yaml:"WalletTransactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*courseR) NewStruct() *courseR {
+	return &courseR{}
+}
+
+// courseL is where Load methods for each relationship are stored.
+type courseL struct{}
+
+var (
+	courseAllColumns            = []string{"id", "class_id", "teacher_id", "name", "created_at", "updated_at"}
+	courseColumnsWithoutDefault = []string{"id", "class_id", "teacher_id", "name", "created_at", "updated_at"}
+	courseColumnsWithDefault    = []string{}
+	coursePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CourseSlice is an alias for a slice of pointers to Course.
+	// This should generally be used opposed to []Course.
+	CourseSlice []*Course
+
+	courseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	courseType                 = reflect.TypeOf(&Course{})
+	courseMapping              = queries.MakeStructMapping(courseType)
+	coursePrimaryKeyMapping, _ = queries.BindMapping(courseType, courseMapping, coursePrimaryKeyColumns)
+	courseInsertCacheMut       sync.RWMutex
+	courseInsertCache          = make(map[string]insertCache)
+	courseUpdateCacheMut       sync.RWMutex
+	courseUpdateCache          = make(map[string]updateCache)
+	courseUpsertCacheMut       sync.RWMutex
+	courseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single course record from the query using the global executor.
+func (q courseQuery) OneG(ctx context.Context) (*Course, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single course record from the query.
+func (q courseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Course, error) {
+	o := &Course{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for course")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Course records from the query using the global executor.
+func (q courseQuery) AllG(ctx context.Context) (CourseSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Course records from the query.
+func (q courseQuery) All(ctx context.Context, exec boil.ContextExecutor) (CourseSlice, error) {
+	var o []*Course
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Course slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Course records in the query, and panics on error.
+func (q courseQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Course records in the query.
+func (q courseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count course rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q courseQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q courseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if course exists")
+	}
+
+	return count > 0, nil
+}
+
+// Class pointed to by the foreign key.
+func (o *Course) Class(mods ...qm.QueryMod) classQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClassID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Classes(queryMods...)
+	queries.SetFrom(query.Query, "\"class\"")
+
+	return query
+}
+
+// Teacher pointed to by the foreign key.
+func (o *Course) Teacher(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeacherID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// ChatRoom pointed to by the foreign key.
+func (o *Course) ChatRoom(mods ...qm.QueryMod) chatRoomQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"course_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ChatRooms(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room\"")
+
+	return query
+}
+
+// AttendanceSessions retrieves all the attendance_session's AttendanceSessions with an executor.
+func (o *Course) AttendanceSessions(mods ...qm.QueryMod) attendanceSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attendance_session\".\"course_id\"=?", o.ID),
+	)
+
+	query := AttendanceSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attendance_session\".*"})
+	}
+
+	return query
+}
+
+// CalendarEvents retrieves all the calendar_event's CalendarEvents with an executor.
+func (o *Course) CalendarEvents(mods ...qm.QueryMod) calendarEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"calendar_event\".\"course_id\"=?", o.ID),
+	)
+
+	query := CalendarEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"calendar_event\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"calendar_event\".*"})
+	}
+
+	return query
+}
+
+// ChatMutes retrieves all the chat_mute's ChatMutes with an executor.
+func (o *Course) ChatMutes(mods ...qm.QueryMod) chatMuteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_mute\".\"course_id\"=?", o.ID),
+	)
+
+	query := ChatMutes(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_mute\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_mute\".*"})
+	}
+
+	return query
+}
+
+// Courseworks retrieves all the coursework's Courseworks with an executor.
+func (o *Course) Courseworks(mods ...qm.QueryMod) courseworkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"coursework\".\"course_id\"=?", o.ID),
+	)
+
+	query := Courseworks(queryMods...)
+	queries.SetFrom(query.Query, "\"coursework\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"coursework\".*"})
+	}
+
+	return query
+}
+
+// MarkCategories retrieves all the mark_category's MarkCategories with an executor.
+func (o *Course) MarkCategories(mods ...qm.QueryMod) markCategoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mark_category\".\"course_id\"=?", o.ID),
+	)
+
+	query := MarkCategories(queryMods...)
+	queries.SetFrom(query.Query, "\"mark_category\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"mark_category\".*"})
+	}
+
+	return query
+}
+
+// Questions retrieves all the question's Questions with an executor.
+func (o *Course) Questions(mods ...qm.QueryMod) questionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"question\".\"course_id\"=?", o.ID),
+	)
+
+	query := Questions(queryMods...)
+	queries.SetFrom(query.Query, "\"question\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"question\".*"})
+	}
+
+	return query
+}
+
+// WalletRules retrieves all the wallet_rule's WalletRules with an executor.
+func (o *Course) WalletRules(mods ...qm.QueryMod) walletRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_rule\".\"course_id\"=?", o.ID),
+	)
+
+	query := WalletRules(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_rule\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_rule\".*"})
+	}
+
+	return query
+}
+
+// WalletTransactions retrieves all the wallet_transaction's WalletTransactions with an executor.
+func (o *Course) WalletTransactions(mods ...qm.QueryMod) walletTransactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_transaction\".\"course_id\"=?", o.ID),
+	)
+
+	query := WalletTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_transaction\".*"})
+	}
+
+	return query
+}
+
+// LoadClass allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (courseL) LoadClass(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ClassID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ClassID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClassID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class`),
+		qm.WhereIn(`class.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Class")
+	}
+
+	var resultSlice []*Class
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Class")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Class = foreign
+		if foreign.R == nil {
+			foreign.R = &classR{}
+		}
+		foreign.R.Courses = append(foreign.R.Courses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ClassID == foreign.ID {
+				local.R.Class = foreign
+				if foreign.R == nil {
+					foreign.R = &classR{}
+				}
+				foreign.R.Courses = append(foreign.R.Courses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacher allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (courseL) LoadTeacher(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		if !queries.IsNil(object.TeacherID) {
+			args = append(args, object.TeacherID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.TeacherID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.TeacherID) {
+				args = append(args, obj.TeacherID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Teacher = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TeacherCourses = append(foreign.R.TeacherCourses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TeacherID, foreign.ID) {
+				local.R.Teacher = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TeacherCourses = append(foreign.R.TeacherCourses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatRoom allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (courseL) LoadChatRoom(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room`),
+		qm.WhereIn(`chat_room.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ChatRoom")
+	}
+
+	var resultSlice []*ChatRoom
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ChatRoom")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chat_room")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChatRoom = foreign
+		if foreign.R == nil {
+			foreign.R = &chatRoomR{}
+		}
+		foreign.R.Course = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.CourseID {
+				local.R.ChatRoom = foreign
+				if foreign.R == nil {
+					foreign.R = &chatRoomR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAttendanceSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadAttendanceSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_session`),
+		qm.WhereIn(`attendance_session.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attendance_session")
+	}
+
+	var resultSlice []*AttendanceSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attendance_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attendance_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_session")
+	}
+
+	if singular {
+		object.R.AttendanceSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attendanceSessionR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CourseID) {
+				local.R.AttendanceSessions = append(local.R.AttendanceSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &attendanceSessionR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCalendarEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadCalendarEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`calendar_event`),
+		qm.WhereIn(`calendar_event.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load calendar_event")
+	}
+
+	var resultSlice []*CalendarEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice calendar_event")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on calendar_event")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for calendar_event")
+	}
+
+	if singular {
+		object.R.CalendarEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &calendarEventR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CourseID) {
+				local.R.CalendarEvents = append(local.R.CalendarEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &calendarEventR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatMutes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadChatMutes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_mute`),
+		qm.WhereIn(`chat_mute.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_mute")
+	}
+
+	var resultSlice []*ChatMute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_mute")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_mute")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_mute")
+	}
+
+	if singular {
+		object.R.ChatMutes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMuteR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseID {
+				local.R.ChatMutes = append(local.R.ChatMutes, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMuteR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCourseworks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadCourseworks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`coursework`),
+		qm.WhereIn(`coursework.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load coursework")
+	}
+
+	var resultSlice []*Coursework
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice coursework")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on coursework")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coursework")
+	}
+
+	if singular {
+		object.R.Courseworks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &courseworkR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseID {
+				local.R.Courseworks = append(local.R.Courseworks, foreign)
+				if foreign.R == nil {
+					foreign.R = &courseworkR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMarkCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadMarkCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark_category`),
+		qm.WhereIn(`mark_category.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mark_category")
+	}
+
+	var resultSlice []*MarkCategory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mark_category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mark_category")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark_category")
+	}
+
+	if singular {
+		object.R.MarkCategories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &markCategoryR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseID {
+				local.R.MarkCategories = append(local.R.MarkCategories, foreign)
+				if foreign.R == nil {
+					foreign.R = &markCategoryR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadQuestions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadQuestions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`question`),
+		qm.WhereIn(`question.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load question")
+	}
+
+	var resultSlice []*Question
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice question")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on question")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for question")
+	}
+
+	if singular {
+		object.R.Questions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &questionR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseID {
+				local.R.Questions = append(local.R.Questions, foreign)
+				if foreign.R == nil {
+					foreign.R = &questionR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWalletRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadWalletRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_rule`),
+		qm.WhereIn(`wallet_rule.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_rule")
+	}
+
+	var resultSlice []*WalletRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_rule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_rule")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_rule")
+	}
+
+	if singular {
+		object.R.WalletRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletRuleR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseID {
+				local.R.WalletRules = append(local.R.WalletRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletRuleR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWalletTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadWalletTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_transaction`),
+		qm.WhereIn(`wallet_transaction.course_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_transaction")
+	}
+
+	var resultSlice []*WalletTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_transaction")
+	}
+
+	if singular {
+		object.R.WalletTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletTransactionR{}
+			}
+			foreign.R.Course = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CourseID) {
+				local.R.WalletTransactions = append(local.R.WalletTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletTransactionR{}
+				}
+				foreign.R.Course = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClassG of the course to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.Courses.
+// Uses the global database handle.
+func (o *Course) SetClassG(ctx context.Context, insert bool, related *Class) error {
+	return o.SetClass(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetClass of the course to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.Courses.
+func (o *Course) SetClass(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Class) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"course\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coursePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ClassID = related.ID
+	if o.R == nil {
+		o.R = &courseR{
+			Class: related,
+		}
+	} else {
+		o.R.Class = related
+	}
+
+	if related.R == nil {
+		related.R = &classR{
+			Courses: CourseSlice{o},
+		}
+	} else {
+		related.R.Courses = append(related.R.Courses, o)
+	}
+
+	return nil
+}
+
+// SetTeacherG of the course to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherCourses.
+// Uses the global database handle.
+func (o *Course) SetTeacherG(ctx context.Context, insert bool, related *User) error {
+	return o.SetTeacher(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTeacher of the course to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherCourses.
+func (o *Course) SetTeacher(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"course\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"teacher_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coursePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TeacherID, related.ID)
+	if o.R == nil {
+		o.R = &courseR{
+			Teacher: related,
+		}
+	} else {
+		o.R.Teacher = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TeacherCourses: CourseSlice{o},
+		}
+	} else {
+		related.R.TeacherCourses = append(related.R.TeacherCourses, o)
+	}
+
+	return nil
+}
+
+// RemoveTeacherG relationship.
+// Sets o.R.Teacher to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Course) RemoveTeacherG(ctx context.Context, related *User) error {
+	return o.RemoveTeacher(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTeacher relationship.
+// Sets o.R.Teacher to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Course) RemoveTeacher(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.TeacherID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("teacher_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Teacher = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TeacherCourses {
+		if queries.Equal(o.TeacherID, ri.TeacherID) {
+			continue
+		}
+
+		ln := len(related.R.TeacherCourses)
+		if ln > 1 && i < ln-1 {
+			related.R.TeacherCourses[i] = related.R.TeacherCourses[ln-1]
+		}
+		related.R.TeacherCourses = related.R.TeacherCourses[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetChatRoomG of the course to the related item.
+// Sets o.R.ChatRoom to related.
+// Adds o to related.R.Course.
+// Uses the global database handle.
+func (o *Course) SetChatRoomG(ctx context.Context, insert bool, related *ChatRoom) error {
+	return o.SetChatRoom(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChatRoom of the course to the related item.
+// Sets o.R.ChatRoom to related.
+// Adds o to related.R.Course.
+func (o *Course) SetChatRoom(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChatRoom) error {
+	var err error
+
+	if insert {
+		related.CourseID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"chat_room\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+			strmangle.WhereClause("\"", "\"", 2, chatRoomPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.CourseID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			ChatRoom: related,
+		}
+	} else {
+		o.R.ChatRoom = related
+	}
+
+	if related.R == nil {
+		related.R = &chatRoomR{
+			Course: o,
+		}
+	} else {
+		related.R.Course = o
+	}
+	return nil
+}
+
+// AddAttendanceSessionsG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.AttendanceSessions.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddAttendanceSessionsG(ctx context.Context, insert bool, related ...*AttendanceSession) error {
+	return o.AddAttendanceSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAttendanceSessions adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.AttendanceSessions.
+// Sets related.R.Course appropriately.
+func (o *Course) AddAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CourseID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attendance_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CourseID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			AttendanceSessions: related,
+		}
+	} else {
+		o.R.AttendanceSessions = append(o.R.AttendanceSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attendanceSessionR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// SetAttendanceSessionsG removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's AttendanceSessions accordingly.
+// Replaces o.R.AttendanceSessions with related.
+// Sets related.R.Course's AttendanceSessions accordingly.
+// Uses the global database handle.
+func (o *Course) SetAttendanceSessionsG(ctx context.Context, insert bool, related ...*AttendanceSession) error {
+	return o.SetAttendanceSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAttendanceSessions removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's AttendanceSessions accordingly.
+// Replaces o.R.AttendanceSessions with related.
+// Sets related.R.Course's AttendanceSessions accordingly.
+func (o *Course) SetAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceSession) error {
+	query := "update \"attendance_session\" set \"course_id\" = null where \"course_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AttendanceSessions {
+			queries.SetScanner(&rel.CourseID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Course = nil
+		}
+
+		o.R.AttendanceSessions = nil
+	}
+	return o.AddAttendanceSessions(ctx, exec, insert, related...)
+}
+
+// RemoveAttendanceSessionsG relationships from objects passed in.
+// Removes related items from R.AttendanceSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+// Uses the global database handle.
+func (o *Course) RemoveAttendanceSessionsG(ctx context.Context, related ...*AttendanceSession) error {
+	return o.RemoveAttendanceSessions(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAttendanceSessions relationships from objects passed in.
+// Removes related items from R.AttendanceSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+func (o *Course) RemoveAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, related ...*AttendanceSession) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CourseID, nil)
+		if rel.R != nil {
+			rel.R.Course = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AttendanceSessions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AttendanceSessions)
+			if ln > 1 && i < ln-1 {
+				o.R.AttendanceSessions[i] = o.R.AttendanceSessions[ln-1]
+			}
+			o.R.AttendanceSessions = o.R.AttendanceSessions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCalendarEventsG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.CalendarEvents.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddCalendarEventsG(ctx context.Context, insert bool, related ...*CalendarEvent) error {
+	return o.AddCalendarEvents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCalendarEvents adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.CalendarEvents.
+// Sets related.R.Course appropriately.
+func (o *Course) AddCalendarEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CalendarEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CourseID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"calendar_event\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, calendarEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CourseID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			CalendarEvents: related,
+		}
+	} else {
+		o.R.CalendarEvents = append(o.R.CalendarEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &calendarEventR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// SetCalendarEventsG removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's CalendarEvents accordingly.
+// Replaces o.R.CalendarEvents with related.
+// Sets related.R.Course's CalendarEvents accordingly.
+// Uses the global database handle.
+func (o *Course) SetCalendarEventsG(ctx context.Context, insert bool, related ...*CalendarEvent) error {
+	return o.SetCalendarEvents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetCalendarEvents removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's CalendarEvents accordingly.
+// Replaces o.R.CalendarEvents with related.
+// Sets related.R.Course's CalendarEvents accordingly.
+func (o *Course) SetCalendarEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CalendarEvent) error {
+	query := "update \"calendar_event\" set \"course_id\" = null where \"course_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CalendarEvents {
+			queries.SetScanner(&rel.CourseID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Course = nil
+		}
+
+		o.R.CalendarEvents = nil
+	}
+	return o.AddCalendarEvents(ctx, exec, insert, related...)
+}
+
+// RemoveCalendarEventsG relationships from objects passed in.
+// Removes related items from R.CalendarEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+// Uses the global database handle.
+func (o *Course) RemoveCalendarEventsG(ctx context.Context, related ...*CalendarEvent) error {
+	return o.RemoveCalendarEvents(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveCalendarEvents relationships from objects passed in.
+// Removes related items from R.CalendarEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+func (o *Course) RemoveCalendarEvents(ctx context.Context, exec boil.ContextExecutor, related ...*CalendarEvent) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CourseID, nil)
+		if rel.R != nil {
+			rel.R.Course = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CalendarEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CalendarEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.CalendarEvents[i] = o.R.CalendarEvents[ln-1]
+			}
+			o.R.CalendarEvents = o.R.CalendarEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddChatMutesG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.ChatMutes.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddChatMutesG(ctx context.Context, insert bool, related ...*ChatMute) error {
+	return o.AddChatMutes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatMutes adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.ChatMutes.
+// Sets related.R.Course appropriately.
+func (o *Course) AddChatMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_mute\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CourseID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			ChatMutes: related,
+		}
+	} else {
+		o.R.ChatMutes = append(o.R.ChatMutes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMuteR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// AddCourseworksG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.Courseworks.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddCourseworksG(ctx context.Context, insert bool, related ...*Coursework) error {
+	return o.AddCourseworks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCourseworks adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.Courseworks.
+// Sets related.R.Course appropriately.
+func (o *Course) AddCourseworks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Coursework) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"coursework\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, courseworkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			Courseworks: related,
+		}
+	} else {
+		o.R.Courseworks = append(o.R.Courseworks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &courseworkR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// AddMarkCategoriesG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.MarkCategories.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddMarkCategoriesG(ctx context.Context, insert bool, related ...*MarkCategory) error {
+	return o.AddMarkCategories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMarkCategories adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.MarkCategories.
+// Sets related.R.Course appropriately.
+func (o *Course) AddMarkCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MarkCategory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mark_category\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, markCategoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			MarkCategories: related,
+		}
+	} else {
+		o.R.MarkCategories = append(o.R.MarkCategories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &markCategoryR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// AddQuestionsG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.Questions.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddQuestionsG(ctx context.Context, insert bool, related ...*Question) error {
+	return o.AddQuestions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddQuestions adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.Questions.
+// Sets related.R.Course appropriately.
+func (o *Course) AddQuestions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Question) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"question\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, questionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			Questions: related,
+		}
+	} else {
+		o.R.Questions = append(o.R.Questions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &questionR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// AddWalletRulesG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.WalletRules.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddWalletRulesG(ctx context.Context, insert bool, related ...*WalletRule) error {
+	return o.AddWalletRules(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWalletRules adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.WalletRules.
+// Sets related.R.Course appropriately.
+func (o *Course) AddWalletRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_rule\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			WalletRules: related,
+		}
+	} else {
+		o.R.WalletRules = append(o.R.WalletRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletRuleR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// AddWalletTransactionsG adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.WalletTransactions.
+// Sets related.R.Course appropriately.
+// Uses the global database handle.
+func (o *Course) AddWalletTransactionsG(ctx context.Context, insert bool, related ...*WalletTransaction) error {
+	return o.AddWalletTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWalletTransactions adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.WalletTransactions.
+// Sets related.R.Course appropriately.
+func (o *Course) AddWalletTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletTransaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CourseID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CourseID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseR{
+			WalletTransactions: related,
+		}
+	} else {
+		o.R.WalletTransactions = append(o.R.WalletTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletTransactionR{
+				Course: o,
+			}
+		} else {
+			rel.R.Course = o
+		}
+	}
+	return nil
+}
+
+// SetWalletTransactionsG removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's WalletTransactions accordingly.
+// Replaces o.R.WalletTransactions with related.
+// Sets related.R.Course's WalletTransactions accordingly.
+// Uses the global database handle.
+func (o *Course) SetWalletTransactionsG(ctx context.Context, insert bool, related ...*WalletTransaction) error {
+	return o.SetWalletTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetWalletTransactions removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Course's WalletTransactions accordingly.
+// Replaces o.R.WalletTransactions with related.
+// Sets related.R.Course's WalletTransactions accordingly.
+func (o *Course) SetWalletTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletTransaction) error {
+	query := "update \"wallet_transaction\" set \"course_id\" = null where \"course_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WalletTransactions {
+			queries.SetScanner(&rel.CourseID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Course = nil
+		}
+
+		o.R.WalletTransactions = nil
+	}
+	return o.AddWalletTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveWalletTransactionsG relationships from objects passed in.
+// Removes related items from R.WalletTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+// Uses the global database handle.
+func (o *Course) RemoveWalletTransactionsG(ctx context.Context, related ...*WalletTransaction) error {
+	return o.RemoveWalletTransactions(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveWalletTransactions relationships from objects passed in.
+// Removes related items from R.WalletTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Course.
+func (o *Course) RemoveWalletTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*WalletTransaction) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CourseID, nil)
+		if rel.R != nil {
+			rel.R.Course = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WalletTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WalletTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.WalletTransactions[i] = o.R.WalletTransactions[ln-1]
+			}
+			o.R.WalletTransactions = o.R.WalletTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Courses retrieves all the records using an executor.
+func Courses(mods ...qm.QueryMod) courseQuery {
+	mods = append(mods, qm.From("\"course\""))
+	return courseQuery{NewQuery(mods...)}
+}
+
+// FindCourseG retrieves a single record by ID.
+func FindCourseG(ctx context.Context, iD string, selectCols ...string) (*Course, error) {
+	return FindCourse(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindCourse retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCourse(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Course, error) {
+	courseObj := &Course{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"course\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, courseObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from course")
+	}
+
+	return courseObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Course) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Course) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no course provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(courseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	courseInsertCacheMut.RLock()
+	cache, cached := courseInsertCache[key]
+	courseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			courseAllColumns,
+			courseColumnsWithDefault,
+			courseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(courseType, courseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(courseType, courseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"course\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"course\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into course")
+	}
+
+	if !cached {
+		courseInsertCacheMut.Lock()
+		courseInsertCache[key] = cache
+		courseInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Course record using the global executor.
+// See Update for more documentation.
+func (o *Course) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Course.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Course) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	courseUpdateCacheMut.RLock()
+	cache, cached := courseUpdateCache[key]
+	courseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			courseAllColumns,
+			coursePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update course, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"course\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, coursePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(courseType, courseMapping, append(wl, coursePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update course row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for course")
+	}
+
+	if !cached {
+		courseUpdateCacheMut.Lock()
+		courseUpdateCache[key] = cache
+		courseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q courseQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q courseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for course")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for course")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CourseSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CourseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coursePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"course\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, coursePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in course slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all course")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Course) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Course) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no course provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(courseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	courseUpsertCacheMut.RLock()
+	cache, cached := courseUpsertCache[key]
+	courseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			courseAllColumns,
+			courseColumnsWithDefault,
+			courseColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			courseAllColumns,
+			coursePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert course, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(coursePrimaryKeyColumns))
+			copy(conflict, coursePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"course\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(courseType, courseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(courseType, courseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert course")
+	}
+
+	if !cached {
+		courseUpsertCacheMut.Lock()
+		courseUpsertCache[key] = cache
+		courseUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Course record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Course) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Course record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Course) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Course provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), coursePrimaryKeyMapping)
+	sql := "DELETE FROM \"course\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from course")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for course")
+	}
+
+	return rowsAff, nil
+}
+
+func (q courseQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q courseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no courseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from course")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for course")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CourseSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CourseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coursePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"course\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coursePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from course slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for course")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Course) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Course provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Course) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCourse(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CourseSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty CourseSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CourseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CourseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coursePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"course\".* FROM \"course\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coursePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CourseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CourseExistsG checks if the Course row exists.
+func CourseExistsG(ctx context.Context, iD string) (bool, error) {
+	return CourseExists(ctx, boil.GetContextDB(), iD)
+}
+
+// CourseExists checks if the Course row exists.
+func CourseExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"course\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if course exists")
+	}
+
+	return exists, nil
+}
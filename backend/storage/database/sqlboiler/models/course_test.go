@@ -0,0 +1,2721 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCourses(t *testing.T) {
+	t.Parallel()
+
+	query := Courses()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCoursesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCoursesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Courses().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCoursesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CourseSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCoursesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CourseExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Course exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CourseExists to return true, but got false.")
+	}
+}
+
+func testCoursesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	courseFound, err := FindCourse(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if courseFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCoursesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Courses().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCoursesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Courses().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCoursesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	courseOne := &Course{}
+	courseTwo := &Course{}
+	if err = randomize.Struct(seed, courseOne, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+	if err = randomize.Struct(seed, courseTwo, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = courseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = courseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Courses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCoursesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	courseOne := &Course{}
+	courseTwo := &Course{}
+	if err = randomize.Struct(seed, courseOne, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+	if err = randomize.Struct(seed, courseTwo, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = courseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = courseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testCoursesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCoursesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(courseColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCourseOneToOneChatRoomUsingChatRoom(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign ChatRoom
+	var local Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, chatRoomDBTypes, true, chatRoomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChatRoom struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.CourseID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ChatRoom().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.CourseID != foreign.CourseID {
+		t.Errorf("want: %v, got %v", foreign.CourseID, check.CourseID)
+	}
+
+	slice := CourseSlice{&local}
+	if err = local.L.LoadChatRoom(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChatRoom == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ChatRoom = nil
+	if err = local.L.LoadChatRoom(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChatRoom == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCourseOneToOneSetOpChatRoomUsingChatRoom(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c ChatRoom
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, chatRoomDBTypes, false, strmangle.SetComplement(chatRoomPrimaryKeyColumns, chatRoomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chatRoomDBTypes, false, strmangle.SetComplement(chatRoomPrimaryKeyColumns, chatRoomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ChatRoom{&b, &c} {
+		err = a.SetChatRoom(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ChatRoom != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Course != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.CourseID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.CourseID))
+		reflect.Indirect(reflect.ValueOf(&x.CourseID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ID != x.CourseID {
+			t.Error("foreign key was wrong value", a.ID, x.CourseID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testCourseToManyAttendanceSessions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attendanceSessionDBTypes, false, attendanceSessionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CourseID, a.ID)
+	queries.Assign(&c.CourseID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AttendanceSessions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CourseID, b.CourseID) {
+			bFound = true
+		}
+		if queries.Equal(v.CourseID, c.CourseID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadAttendanceSessions(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AttendanceSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AttendanceSessions = nil
+	if err = a.L.LoadAttendanceSessions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AttendanceSessions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyCalendarEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c CalendarEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, calendarEventDBTypes, false, calendarEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CourseID, a.ID)
+	queries.Assign(&c.CourseID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CalendarEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CourseID, b.CourseID) {
+			bFound = true
+		}
+		if queries.Equal(v.CourseID, c.CourseID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadCalendarEvents(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CalendarEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CalendarEvents = nil
+	if err = a.L.LoadCalendarEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CalendarEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyChatMutes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c ChatMute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, chatMuteDBTypes, false, chatMuteColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseID = a.ID
+	c.CourseID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ChatMutes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseID == b.CourseID {
+			bFound = true
+		}
+		if v.CourseID == c.CourseID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadChatMutes(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ChatMutes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ChatMutes = nil
+	if err = a.L.LoadChatMutes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ChatMutes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyCourseworks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c Coursework
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseID = a.ID
+	c.CourseID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Courseworks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseID == b.CourseID {
+			bFound = true
+		}
+		if v.CourseID == c.CourseID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadCourseworks(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Courseworks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Courseworks = nil
+	if err = a.L.LoadCourseworks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Courseworks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyMarkCategories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c MarkCategory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseID = a.ID
+	c.CourseID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MarkCategories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseID == b.CourseID {
+			bFound = true
+		}
+		if v.CourseID == c.CourseID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadMarkCategories(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MarkCategories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MarkCategories = nil
+	if err = a.L.LoadMarkCategories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MarkCategories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyQuestions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c Question
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, questionDBTypes, false, questionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, questionDBTypes, false, questionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseID = a.ID
+	c.CourseID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Questions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseID == b.CourseID {
+			bFound = true
+		}
+		if v.CourseID == c.CourseID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadQuestions(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Questions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Questions = nil
+	if err = a.L.LoadQuestions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Questions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyWalletRules(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c WalletRule
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, walletRuleDBTypes, false, walletRuleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletRuleDBTypes, false, walletRuleColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseID = a.ID
+	c.CourseID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WalletRules().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseID == b.CourseID {
+			bFound = true
+		}
+		if v.CourseID == c.CourseID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadWalletRules(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WalletRules); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WalletRules = nil
+	if err = a.L.LoadWalletRules(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WalletRules); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyWalletTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, walletTransactionDBTypes, false, walletTransactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletTransactionDBTypes, false, walletTransactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CourseID, a.ID)
+	queries.Assign(&c.CourseID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WalletTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CourseID, b.CourseID) {
+			bFound = true
+		}
+		if queries.Equal(v.CourseID, c.CourseID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseSlice{&a}
+	if err = a.L.LoadWalletTransactions(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WalletTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WalletTransactions = nil
+	if err = a.L.LoadWalletTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WalletTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseToManyAddOpAttendanceSessions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AttendanceSession{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AttendanceSession{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAttendanceSessions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if !queries.Equal(a.ID, second.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AttendanceSessions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AttendanceSessions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AttendanceSessions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCourseToManySetOpAttendanceSessions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AttendanceSession{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAttendanceSessions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAttendanceSessions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, d.CourseID)
+	}
+	if !queries.Equal(a.ID, e.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, e.CourseID)
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.AttendanceSessions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.AttendanceSessions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCourseToManyRemoveOpAttendanceSessions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e AttendanceSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AttendanceSession{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attendanceSessionDBTypes, false, strmangle.SetComplement(attendanceSessionPrimaryKeyColumns, attendanceSessionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAttendanceSessions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAttendanceSessions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AttendanceSessions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.AttendanceSessions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.AttendanceSessions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.AttendanceSessions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCourseToManyAddOpCalendarEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e CalendarEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CalendarEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CalendarEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCalendarEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if !queries.Equal(a.ID, second.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CalendarEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CalendarEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CalendarEvents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCourseToManySetOpCalendarEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e CalendarEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CalendarEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCalendarEvents(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCalendarEvents(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, d.CourseID)
+	}
+	if !queries.Equal(a.ID, e.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, e.CourseID)
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CalendarEvents[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CalendarEvents[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCourseToManyRemoveOpCalendarEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e CalendarEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CalendarEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, calendarEventDBTypes, false, strmangle.SetComplement(calendarEventPrimaryKeyColumns, calendarEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCalendarEvents(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCalendarEvents(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CalendarEvents().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CalendarEvents) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CalendarEvents[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CalendarEvents[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCourseToManyAddOpChatMutes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e ChatMute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ChatMute{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, chatMuteDBTypes, false, strmangle.SetComplement(chatMutePrimaryKeyColumns, chatMuteColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ChatMute{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddChatMutes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if a.ID != second.CourseID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ChatMutes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ChatMutes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ChatMutes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseToManyAddOpCourseworks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e Coursework
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Coursework{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Coursework{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCourseworks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if a.ID != second.CourseID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Courseworks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Courseworks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Courseworks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseToManyAddOpMarkCategories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e MarkCategory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MarkCategory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MarkCategory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMarkCategories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if a.ID != second.CourseID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MarkCategories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MarkCategories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MarkCategories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseToManyAddOpQuestions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e Question
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Question{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, questionDBTypes, false, strmangle.SetComplement(questionPrimaryKeyColumns, questionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Question{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddQuestions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if a.ID != second.CourseID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Questions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Questions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Questions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseToManyAddOpWalletRules(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e WalletRule
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WalletRule{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, walletRuleDBTypes, false, strmangle.SetComplement(walletRulePrimaryKeyColumns, walletRuleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WalletRule{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWalletRules(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if a.ID != second.CourseID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WalletRules[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WalletRules[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WalletRules().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseToManyAddOpWalletTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WalletTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, walletTransactionDBTypes, false, strmangle.SetComplement(walletTransactionPrimaryKeyColumns, walletTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WalletTransaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWalletTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, first.CourseID)
+		}
+		if !queries.Equal(a.ID, second.CourseID) {
+			t.Error("foreign key was wrong value", a.ID, second.CourseID)
+		}
+
+		if first.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Course != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WalletTransactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WalletTransactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WalletTransactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCourseToManySetOpWalletTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WalletTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, walletTransactionDBTypes, false, strmangle.SetComplement(walletTransactionPrimaryKeyColumns, walletTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetWalletTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.WalletTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetWalletTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.WalletTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, d.CourseID)
+	}
+	if !queries.Equal(a.ID, e.CourseID) {
+		t.Error("foreign key was wrong value", a.ID, e.CourseID)
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.WalletTransactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.WalletTransactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCourseToManyRemoveOpWalletTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c, d, e WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WalletTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, walletTransactionDBTypes, false, strmangle.SetComplement(walletTransactionPrimaryKeyColumns, walletTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddWalletTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.WalletTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveWalletTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.WalletTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Course != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Course != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.WalletTransactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.WalletTransactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.WalletTransactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCourseToOneClassUsingClass(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Course
+	var foreign Class
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, classDBTypes, false, classColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Class struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ClassID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Class().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CourseSlice{&local}
+	if err = local.L.LoadClass(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Class = nil
+	if err = local.L.LoadClass(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Class == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCourseToOneUserUsingTeacher(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Course
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TeacherID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Teacher().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CourseSlice{&local}
+	if err = local.L.LoadTeacher(ctx, tx, false, (*[]*Course)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Teacher == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Teacher = nil
+	if err = local.L.LoadTeacher(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Teacher == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCourseToOneSetOpClassUsingClass(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Class{&b, &c} {
+		err = a.SetClass(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Class != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Courses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ClassID != x.ID {
+			t.Error("foreign key was wrong value", a.ClassID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ClassID))
+		reflect.Indirect(reflect.ValueOf(&a.ClassID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ClassID != x.ID {
+			t.Error("foreign key was wrong value", a.ClassID, x.ID)
+		}
+	}
+}
+func testCourseToOneSetOpUserUsingTeacher(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetTeacher(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Teacher != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TeacherCourses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TeacherID, x.ID) {
+			t.Error("foreign key was wrong value", a.TeacherID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TeacherID))
+		reflect.Indirect(reflect.ValueOf(&a.TeacherID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TeacherID, x.ID) {
+			t.Error("foreign key was wrong value", a.TeacherID, x.ID)
+		}
+	}
+}
+
+func testCourseToOneRemoveOpUserUsingTeacher(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Course
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTeacher(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTeacher(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Teacher().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Teacher != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TeacherID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TeacherCourses) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCoursesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCoursesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CourseSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCoursesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Courses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	courseDBTypes = map[string]string{`ID`: `uuid`, `ClassID`: `uuid`, `TeacherID`: `uuid`, `Name`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_             = bytes.MinRead
+)
+
+func testCoursesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(coursePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(courseAllColumns) == len(coursePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, courseDBTypes, true, coursePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCoursesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(courseAllColumns) == len(coursePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Course{}
+	if err = randomize.Struct(seed, o, courseDBTypes, true, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, courseDBTypes, true, coursePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(courseAllColumns, coursePrimaryKeyColumns) {
+		fields = courseAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			courseAllColumns,
+			coursePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CourseSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCoursesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(courseAllColumns) == len(coursePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Course{}
+	if err = randomize.Struct(seed, &o, courseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Course: %s", err)
+	}
+
+	count, err := Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, courseDBTypes, false, coursePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Course: %s", err)
+	}
+
+	count, err = Courses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1447 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Coursework is an object representing the database table.
+type Coursework struct {
+	ID           string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CourseID     string            `boil:"course_id" json:"course_id" toml:"course_id" yaml:"course_id"`
+	Kind         string            `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Title        string            `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Instructions null.String       `boil:"instructions" json:"instructions,omitempty" toml:"instructions" yaml:"instructions,omitempty"`
+	QuestionIds  types.StringArray `boil:"question_ids" json:"question_ids,omitempty" toml:"question_ids" yaml:"question_ids,omitempty"`
+	MaxAttempts  int               `boil:"max_attempts" json:"max_attempts" toml:"max_attempts" yaml:"max_attempts"`
+	StartsAt     null.Time         `boil:"starts_at" json:"starts_at,omitempty" toml:"starts_at" yaml:"starts_at,omitempty"`
+	DueAt        null.Time         `boil:"due_at" json:"due_at,omitempty" toml:"due_at" yaml:"due_at,omitempty"`
+	CreatedAt    null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt    null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *courseworkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L courseworkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CourseworkColumns = struct {
+	ID           string
+	CourseID     string
+	Kind         string
+	Title        string
+	Instructions string
+	QuestionIds  string
+	MaxAttempts  string
+	StartsAt     string
+	DueAt        string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	CourseID:     "course_id",
+	Kind:         "kind",
+	Title:        "title",
+	Instructions: "instructions",
+	QuestionIds:  "question_ids",
+	MaxAttempts:  "max_attempts",
+	StartsAt:     "starts_at",
+	DueAt:        "due_at",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var CourseworkWhere = struct {
+	ID           whereHelperstring
+	CourseID     whereHelperstring
+	Kind         whereHelperstring
+	Title        whereHelperstring
+	Instructions whereHelpernull_String
+	QuestionIds  whereHelpertypes_StringArray
+	MaxAttempts  whereHelperint
+	StartsAt     whereHelpernull_Time
+	DueAt        whereHelpernull_Time
+	CreatedAt    whereHelpernull_Time
+	UpdatedAt    whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"coursework\".\"id\""},
+	CourseID:     whereHelperstring{field: "\"coursework\".\"course_id\""},
+	Kind:         whereHelperstring{field: "\"coursework\".\"kind\""},
+	Title:        whereHelperstring{field: "\"coursework\".\"title\""},
+	Instructions: whereHelpernull_String{field: "\"coursework\".\"instructions\""},
+	QuestionIds:  whereHelpertypes_StringArray{field: "\"coursework\".\"question_ids\""},
+	MaxAttempts:  whereHelperint{field: "\"coursework\".\"max_attempts\""},
+	StartsAt:     whereHelpernull_Time{field: "\"coursework\".\"starts_at\""},
+	DueAt:        whereHelpernull_Time{field: "\"coursework\".\"due_at\""},
+	CreatedAt:    whereHelpernull_Time{field: "\"coursework\".\"created_at\""},
+	UpdatedAt:    whereHelpernull_Time{field: "\"coursework\".\"updated_at\""},
+}
+
+// CourseworkRels is where relationship names are stored.
+var CourseworkRels = struct {
+	Course      string
+	Assessments string
+	Attempts    string
+}{
+	Course:      "Course",
+	Assessments: "Assessments",
+	Attempts:    "Attempts",
+}
+
+// courseworkR is where relationships are stored.
+type courseworkR struct {
+	Course      *Course         `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	Assessments AssessmentSlice `boil:"Assessments" json:"Assessments" toml:"Assessments" yaml:"Assessments"`
+	Attempts    AttemptSlice    `boil:"Attempts" json:"Attempts" toml:"Attempts" yaml:"Attempts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*courseworkR) NewStruct() *courseworkR {
+	return &courseworkR{}
+}
+
+// courseworkL is where Load methods for each relationship are stored.
+type courseworkL struct{}
+
+var (
+	courseworkAllColumns            = []string{"id", "course_id", "kind", "title", "instructions", "question_ids", "max_attempts", "starts_at", "due_at", "created_at", "updated_at"}
+	courseworkColumnsWithoutDefault = []string{"id", "course_id", "kind", "title", "instructions", "question_ids", "starts_at", "due_at", "created_at", "updated_at"}
+	courseworkColumnsWithDefault    = []string{"max_attempts"}
+	courseworkPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CourseworkSlice is an alias for a slice of pointers to Coursework.
+	// This should generally be used opposed to []Coursework.
+	CourseworkSlice []*Coursework
+
+	courseworkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	courseworkType                 = reflect.TypeOf(&Coursework{})
+	courseworkMapping              = queries.MakeStructMapping(courseworkType)
+	courseworkPrimaryKeyMapping, _ = queries.BindMapping(courseworkType, courseworkMapping, courseworkPrimaryKeyColumns)
+	courseworkInsertCacheMut       sync.RWMutex
+	courseworkInsertCache          = make(map[string]insertCache)
+	courseworkUpdateCacheMut       sync.RWMutex
+	courseworkUpdateCache          = make(map[string]updateCache)
+	courseworkUpsertCacheMut       sync.RWMutex
+	courseworkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single coursework record from the query using the global executor.
+func (q courseworkQuery) OneG(ctx context.Context) (*Coursework, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single coursework record from the query.
+func (q courseworkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Coursework, error) {
+	o := &Coursework{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for coursework")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Coursework records from the query using the global executor.
+func (q courseworkQuery) AllG(ctx context.Context) (CourseworkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Coursework records from the query.
+func (q courseworkQuery) All(ctx context.Context, exec boil.ContextExecutor) (CourseworkSlice, error) {
+	var o []*Coursework
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Coursework slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Coursework records in the query, and panics on error.
+func (q courseworkQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Coursework records in the query.
+func (q courseworkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count coursework rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q courseworkQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q courseworkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if coursework exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *Coursework) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// Assessments retrieves all the assessment's Assessments with an executor.
+func (o *Coursework) Assessments(mods ...qm.QueryMod) assessmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"assessment\".\"coursework_id\"=?", o.ID),
+	)
+
+	query := Assessments(queryMods...)
+	queries.SetFrom(query.Query, "\"assessment\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"assessment\".*"})
+	}
+
+	return query
+}
+
+// Attempts retrieves all the attempt's Attempts with an executor.
+func (o *Coursework) Attempts(mods ...qm.QueryMod) attemptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attempt\".\"coursework_id\"=?", o.ID),
+	)
+
+	query := Attempts(queryMods...)
+	queries.SetFrom(query.Query, "\"attempt\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attempt\".*"})
+	}
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (courseworkL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoursework interface{}, mods queries.Applicator) error {
+	var slice []*Coursework
+	var object *Coursework
+
+	if singular {
+		object = maybeCoursework.(*Coursework)
+	} else {
+		slice = *maybeCoursework.(*[]*Coursework)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseworkR{}
+		}
+		args = append(args, object.CourseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseworkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.Courseworks = append(foreign.R.Courseworks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseID == foreign.ID {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.Courseworks = append(foreign.R.Courseworks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssessments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseworkL) LoadAssessments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoursework interface{}, mods queries.Applicator) error {
+	var slice []*Coursework
+	var object *Coursework
+
+	if singular {
+		object = maybeCoursework.(*Coursework)
+	} else {
+		slice = *maybeCoursework.(*[]*Coursework)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseworkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseworkR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`assessment`),
+		qm.WhereIn(`assessment.coursework_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load assessment")
+	}
+
+	var resultSlice []*Assessment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice assessment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on assessment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assessment")
+	}
+
+	if singular {
+		object.R.Assessments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assessmentR{}
+			}
+			foreign.R.Coursework = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CourseworkID) {
+				local.R.Assessments = append(local.R.Assessments, foreign)
+				if foreign.R == nil {
+					foreign.R = &assessmentR{}
+				}
+				foreign.R.Coursework = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAttempts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseworkL) LoadAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoursework interface{}, mods queries.Applicator) error {
+	var slice []*Coursework
+	var object *Coursework
+
+	if singular {
+		object = maybeCoursework.(*Coursework)
+	} else {
+		slice = *maybeCoursework.(*[]*Coursework)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseworkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseworkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attempt`),
+		qm.WhereIn(`attempt.coursework_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attempt")
+	}
+
+	var resultSlice []*Attempt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attempt")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attempt")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attempt")
+	}
+
+	if singular {
+		object.R.Attempts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attemptR{}
+			}
+			foreign.R.Coursework = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CourseworkID {
+				local.R.Attempts = append(local.R.Attempts, foreign)
+				if foreign.R == nil {
+					foreign.R = &attemptR{}
+				}
+				foreign.R.Coursework = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the coursework to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.Courseworks.
+// Uses the global database handle.
+func (o *Coursework) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the coursework to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.Courseworks.
+func (o *Coursework) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coursework\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, courseworkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseID = related.ID
+	if o.R == nil {
+		o.R = &courseworkR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			Courseworks: CourseworkSlice{o},
+		}
+	} else {
+		related.R.Courseworks = append(related.R.Courseworks, o)
+	}
+
+	return nil
+}
+
+// AddAssessmentsG adds the given related objects to the existing relationships
+// of the coursework, optionally inserting them as new records.
+// Appends related to o.R.Assessments.
+// Sets related.R.Coursework appropriately.
+// Uses the global database handle.
+func (o *Coursework) AddAssessmentsG(ctx context.Context, insert bool, related ...*Assessment) error {
+	return o.AddAssessments(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAssessments adds the given related objects to the existing relationships
+// of the coursework, optionally inserting them as new records.
+// Appends related to o.R.Assessments.
+// Sets related.R.Coursework appropriately.
+func (o *Coursework) AddAssessments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Assessment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CourseworkID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"assessment\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"coursework_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assessmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CourseworkID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseworkR{
+			Assessments: related,
+		}
+	} else {
+		o.R.Assessments = append(o.R.Assessments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assessmentR{
+				Coursework: o,
+			}
+		} else {
+			rel.R.Coursework = o
+		}
+	}
+	return nil
+}
+
+// SetAssessmentsG removes all previously related items of the
+// coursework replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Coursework's Assessments accordingly.
+// Replaces o.R.Assessments with related.
+// Sets related.R.Coursework's Assessments accordingly.
+// Uses the global database handle.
+func (o *Coursework) SetAssessmentsG(ctx context.Context, insert bool, related ...*Assessment) error {
+	return o.SetAssessments(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAssessments removes all previously related items of the
+// coursework replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Coursework's Assessments accordingly.
+// Replaces o.R.Assessments with related.
+// Sets related.R.Coursework's Assessments accordingly.
+func (o *Coursework) SetAssessments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Assessment) error {
+	query := "update \"assessment\" set \"coursework_id\" = null where \"coursework_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Assessments {
+			queries.SetScanner(&rel.CourseworkID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Coursework = nil
+		}
+
+		o.R.Assessments = nil
+	}
+	return o.AddAssessments(ctx, exec, insert, related...)
+}
+
+// RemoveAssessmentsG relationships from objects passed in.
+// Removes related items from R.Assessments (uses pointer comparison, removal does not keep order)
+// Sets related.R.Coursework.
+// Uses the global database handle.
+func (o *Coursework) RemoveAssessmentsG(ctx context.Context, related ...*Assessment) error {
+	return o.RemoveAssessments(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAssessments relationships from objects passed in.
+// Removes related items from R.Assessments (uses pointer comparison, removal does not keep order)
+// Sets related.R.Coursework.
+func (o *Coursework) RemoveAssessments(ctx context.Context, exec boil.ContextExecutor, related ...*Assessment) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CourseworkID, nil)
+		if rel.R != nil {
+			rel.R.Coursework = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("coursework_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Assessments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Assessments)
+			if ln > 1 && i < ln-1 {
+				o.R.Assessments[i] = o.R.Assessments[ln-1]
+			}
+			o.R.Assessments = o.R.Assessments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAttemptsG adds the given related objects to the existing relationships
+// of the coursework, optionally inserting them as new records.
+// Appends related to o.R.Attempts.
+// Sets related.R.Coursework appropriately.
+// Uses the global database handle.
+func (o *Coursework) AddAttemptsG(ctx context.Context, insert bool, related ...*Attempt) error {
+	return o.AddAttempts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAttempts adds the given related objects to the existing relationships
+// of the coursework, optionally inserting them as new records.
+// Appends related to o.R.Attempts.
+// Sets related.R.Coursework appropriately.
+func (o *Coursework) AddAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attempt) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CourseworkID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attempt\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"coursework_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attemptPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CourseworkID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &courseworkR{
+			Attempts: related,
+		}
+	} else {
+		o.R.Attempts = append(o.R.Attempts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attemptR{
+				Coursework: o,
+			}
+		} else {
+			rel.R.Coursework = o
+		}
+	}
+	return nil
+}
+
+// Courseworks retrieves all the records using an executor.
+func Courseworks(mods ...qm.QueryMod) courseworkQuery {
+	mods = append(mods, qm.From("\"coursework\""))
+	return courseworkQuery{NewQuery(mods...)}
+}
+
+// FindCourseworkG retrieves a single record by ID.
+func FindCourseworkG(ctx context.Context, iD string, selectCols ...string) (*Coursework, error) {
+	return FindCoursework(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindCoursework retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCoursework(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Coursework, error) {
+	courseworkObj := &Coursework{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coursework\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, courseworkObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from coursework")
+	}
+
+	return courseworkObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Coursework) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Coursework) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no coursework provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(courseworkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	courseworkInsertCacheMut.RLock()
+	cache, cached := courseworkInsertCache[key]
+	courseworkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			courseworkAllColumns,
+			courseworkColumnsWithDefault,
+			courseworkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(courseworkType, courseworkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(courseworkType, courseworkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coursework\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coursework\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into coursework")
+	}
+
+	if !cached {
+		courseworkInsertCacheMut.Lock()
+		courseworkInsertCache[key] = cache
+		courseworkInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Coursework record using the global executor.
+// See Update for more documentation.
+func (o *Coursework) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Coursework.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Coursework) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	courseworkUpdateCacheMut.RLock()
+	cache, cached := courseworkUpdateCache[key]
+	courseworkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			courseworkAllColumns,
+			courseworkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update coursework, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coursework\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, courseworkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(courseworkType, courseworkMapping, append(wl, courseworkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update coursework row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for coursework")
+	}
+
+	if !cached {
+		courseworkUpdateCacheMut.Lock()
+		courseworkUpdateCache[key] = cache
+		courseworkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q courseworkQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q courseworkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for coursework")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for coursework")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CourseworkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CourseworkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), courseworkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coursework\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, courseworkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in coursework slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all coursework")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Coursework) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Coursework) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no coursework provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(courseworkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	courseworkUpsertCacheMut.RLock()
+	cache, cached := courseworkUpsertCache[key]
+	courseworkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			courseworkAllColumns,
+			courseworkColumnsWithDefault,
+			courseworkColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			courseworkAllColumns,
+			courseworkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert coursework, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(courseworkPrimaryKeyColumns))
+			copy(conflict, courseworkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coursework\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(courseworkType, courseworkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(courseworkType, courseworkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert coursework")
+	}
+
+	if !cached {
+		courseworkUpsertCacheMut.Lock()
+		courseworkUpsertCache[key] = cache
+		courseworkUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Coursework record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Coursework) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Coursework record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Coursework) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Coursework provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), courseworkPrimaryKeyMapping)
+	sql := "DELETE FROM \"coursework\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from coursework")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for coursework")
+	}
+
+	return rowsAff, nil
+}
+
+func (q courseworkQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q courseworkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no courseworkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from coursework")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for coursework")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CourseworkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CourseworkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), courseworkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coursework\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, courseworkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from coursework slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for coursework")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Coursework) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Coursework provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Coursework) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCoursework(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CourseworkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty CourseworkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CourseworkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CourseworkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), courseworkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coursework\".* FROM \"coursework\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, courseworkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CourseworkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CourseworkExistsG checks if the Coursework row exists.
+func CourseworkExistsG(ctx context.Context, iD string) (bool, error) {
+	return CourseworkExists(ctx, boil.GetContextDB(), iD)
+}
+
+// CourseworkExists checks if the Coursework row exists.
+func CourseworkExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coursework\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if coursework exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1181 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCourseworks(t *testing.T) {
+	t.Parallel()
+
+	query := Courseworks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCourseworksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCourseworksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Courseworks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCourseworksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CourseworkSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCourseworksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CourseworkExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Coursework exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CourseworkExists to return true, but got false.")
+	}
+}
+
+func testCourseworksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	courseworkFound, err := FindCoursework(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if courseworkFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCourseworksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Courseworks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCourseworksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Courseworks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCourseworksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	courseworkOne := &Coursework{}
+	courseworkTwo := &Coursework{}
+	if err = randomize.Struct(seed, courseworkOne, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+	if err = randomize.Struct(seed, courseworkTwo, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = courseworkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = courseworkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Courseworks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCourseworksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	courseworkOne := &Coursework{}
+	courseworkTwo := &Coursework{}
+	if err = randomize.Struct(seed, courseworkOne, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+	if err = randomize.Struct(seed, courseworkTwo, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = courseworkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = courseworkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testCourseworksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCourseworksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(courseworkColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCourseworkToManyAssessments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CourseworkID, a.ID)
+	queries.Assign(&c.CourseworkID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Assessments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CourseworkID, b.CourseworkID) {
+			bFound = true
+		}
+		if queries.Equal(v.CourseworkID, c.CourseworkID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseworkSlice{&a}
+	if err = a.L.LoadAssessments(ctx, tx, false, (*[]*Coursework)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Assessments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Assessments = nil
+	if err = a.L.LoadAssessments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Assessments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseworkToManyAttempts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c Attempt
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attemptDBTypes, false, attemptColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CourseworkID = a.ID
+	c.CourseworkID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Attempts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CourseworkID == b.CourseworkID {
+			bFound = true
+		}
+		if v.CourseworkID == c.CourseworkID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CourseworkSlice{&a}
+	if err = a.L.LoadAttempts(ctx, tx, false, (*[]*Coursework)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attempts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Attempts = nil
+	if err = a.L.LoadAttempts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attempts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCourseworkToManyAddOpAssessments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c, d, e Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Assessment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Assessment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAssessments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CourseworkID) {
+			t.Error("foreign key was wrong value", a.ID, first.CourseworkID)
+		}
+		if !queries.Equal(a.ID, second.CourseworkID) {
+			t.Error("foreign key was wrong value", a.ID, second.CourseworkID)
+		}
+
+		if first.R.Coursework != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Coursework != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Assessments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Assessments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Assessments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCourseworkToManySetOpAssessments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c, d, e Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Assessment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAssessments(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAssessments(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseworkID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseworkID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CourseworkID) {
+		t.Error("foreign key was wrong value", a.ID, d.CourseworkID)
+	}
+	if !queries.Equal(a.ID, e.CourseworkID) {
+		t.Error("foreign key was wrong value", a.ID, e.CourseworkID)
+	}
+
+	if b.R.Coursework != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Coursework != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Coursework != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Coursework != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Assessments[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Assessments[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCourseworkToManyRemoveOpAssessments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c, d, e Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Assessment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAssessments(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAssessments(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Assessments().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CourseworkID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CourseworkID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Coursework != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Coursework != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Coursework != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Coursework != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Assessments) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Assessments[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Assessments[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCourseworkToManyAddOpAttempts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c, d, e Attempt
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Attempt{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attemptDBTypes, false, strmangle.SetComplement(attemptPrimaryKeyColumns, attemptColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Attempt{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAttempts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CourseworkID {
+			t.Error("foreign key was wrong value", a.ID, first.CourseworkID)
+		}
+		if a.ID != second.CourseworkID {
+			t.Error("foreign key was wrong value", a.ID, second.CourseworkID)
+		}
+
+		if first.R.Coursework != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Coursework != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Attempts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Attempts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Attempts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testCourseworkToOneCourseUsingCourse(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Coursework
+	var foreign Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, courseworkDBTypes, false, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CourseID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Course().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CourseworkSlice{&local}
+	if err = local.L.LoadCourse(ctx, tx, false, (*[]*Coursework)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Course = nil
+	if err = local.L.LoadCourse(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCourseworkToOneSetOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Coursework
+	var b, c Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, courseworkDBTypes, false, strmangle.SetComplement(courseworkPrimaryKeyColumns, courseworkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Course{&b, &c} {
+		err = a.SetCourse(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Course != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Courseworks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CourseID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CourseID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseID, x.ID)
+		}
+	}
+}
+
+func testCourseworksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCourseworksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CourseworkSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCourseworksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Courseworks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	courseworkDBTypes = map[string]string{`ID`: `uuid`, `CourseID`: `uuid`, `Kind`: `character varying`, `Title`: `character varying`, `Instructions`: `text`, `QuestionIds`: `ARRAYuuid`, `MaxAttempts`: `integer`, `StartsAt`: `timestamp without time zone`, `DueAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testCourseworksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(courseworkPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(courseworkAllColumns) == len(courseworkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCourseworksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(courseworkAllColumns) == len(courseworkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Coursework{}
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, courseworkDBTypes, true, courseworkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(courseworkAllColumns, courseworkPrimaryKeyColumns) {
+		fields = courseworkAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			courseworkAllColumns,
+			courseworkPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CourseworkSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCourseworksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(courseworkAllColumns) == len(courseworkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Coursework{}
+	if err = randomize.Struct(seed, &o, courseworkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Coursework: %s", err)
+	}
+
+	count, err := Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, courseworkDBTypes, false, courseworkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Coursework struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Coursework: %s", err)
+	}
+
+	count, err = Courseworks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
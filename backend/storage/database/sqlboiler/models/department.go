@@ -0,0 +1,1480 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Department is an object representing the database table.
+type Department struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID  string    `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *departmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L departmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DepartmentColumns = struct {
+	ID        string
+	SchoolID  string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	SchoolID:  "school_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var DepartmentWhere = struct {
+	ID        whereHelperstring
+	SchoolID  whereHelperstring
+	Name      whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"department\".\"id\""},
+	SchoolID:  whereHelperstring{field: "\"department\".\"school_id\""},
+	Name:      whereHelperstring{field: "\"department\".\"name\""},
+	CreatedAt: whereHelpernull_Time{field: "\"department\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"department\".\"updated_at\""},
+}
+
+// DepartmentRels is where relationship names are stored.
+var DepartmentRels = struct {
+	School        string
+	Announcements string
+	Classes       string
+}{
+	School:        "School",
+	Announcements: "Announcements",
+	Classes:       "Classes",
+}
+
+// departmentR is where relationships are stored.
+type departmentR struct {
+	School        *School           `boil:"School" json:"School" toml:"School" yaml:"School"`
+	Announcements AnnouncementSlice `boil:"Announcements" json:"Announcements" toml:"Announcements" yaml:"Announcements"`
+	Classes       ClassSlice        `boil:"Classes" json:"Classes" toml:"Classes" yaml:"Classes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*departmentR) NewStruct() *departmentR {
+	return &departmentR{}
+}
+
+// departmentL is where Load methods for each relationship are stored.
+type departmentL struct{}
+
+var (
+	departmentAllColumns            = []string{"id", "school_id", "name", "created_at", "updated_at"}
+	departmentColumnsWithoutDefault = []string{"id", "school_id", "name", "created_at", "updated_at"}
+	departmentColumnsWithDefault    = []string{}
+	departmentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// DepartmentSlice is an alias for a slice of pointers to Department.
+	// This should generally be used opposed to []Department.
+	DepartmentSlice []*Department
+
+	departmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	departmentType                 = reflect.TypeOf(&Department{})
+	departmentMapping              = queries.MakeStructMapping(departmentType)
+	departmentPrimaryKeyMapping, _ = queries.BindMapping(departmentType, departmentMapping, departmentPrimaryKeyColumns)
+	departmentInsertCacheMut       sync.RWMutex
+	departmentInsertCache          = make(map[string]insertCache)
+	departmentUpdateCacheMut       sync.RWMutex
+	departmentUpdateCache          = make(map[string]updateCache)
+	departmentUpsertCacheMut       sync.RWMutex
+	departmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single department record from the query using the global executor.
+func (q departmentQuery) OneG(ctx context.Context) (*Department, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single department record from the query.
+func (q departmentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Department, error) {
+	o := &Department{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for department")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Department records from the query using the global executor.
+func (q departmentQuery) AllG(ctx context.Context) (DepartmentSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Department records from the query.
+func (q departmentQuery) All(ctx context.Context, exec boil.ContextExecutor) (DepartmentSlice, error) {
+	var o []*Department
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Department slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Department records in the query, and panics on error.
+func (q departmentQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Department records in the query.
+func (q departmentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count department rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q departmentQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q departmentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if department exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *Department) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// Announcements retrieves all the announcement's Announcements with an executor.
+func (o *Department) Announcements(mods ...qm.QueryMod) announcementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"announcement\".\"department_id\"=?", o.ID),
+	)
+
+	query := Announcements(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"announcement\".*"})
+	}
+
+	return query
+}
+
+// Classes retrieves all the class's Classes with an executor.
+func (o *Department) Classes(mods ...qm.QueryMod) classQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"class\".\"department_id\"=?", o.ID),
+	)
+
+	query := Classes(queryMods...)
+	queries.SetFrom(query.Query, "\"class\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class\".*"})
+	}
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (departmentL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDepartment interface{}, mods queries.Applicator) error {
+	var slice []*Department
+	var object *Department
+
+	if singular {
+		object = maybeDepartment.(*Department)
+	} else {
+		slice = *maybeDepartment.(*[]*Department)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &departmentR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &departmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.Departments = append(foreign.R.Departments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.Departments = append(foreign.R.Departments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAnnouncements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (departmentL) LoadAnnouncements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDepartment interface{}, mods queries.Applicator) error {
+	var slice []*Department
+	var object *Department
+
+	if singular {
+		object = maybeDepartment.(*Department)
+	} else {
+		slice = *maybeDepartment.(*[]*Department)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &departmentR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &departmentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement`),
+		qm.WhereIn(`announcement.department_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load announcement")
+	}
+
+	var resultSlice []*Announcement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice announcement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on announcement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement")
+	}
+
+	if singular {
+		object.R.Announcements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &announcementR{}
+			}
+			foreign.R.Department = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DepartmentID) {
+				local.R.Announcements = append(local.R.Announcements, foreign)
+				if foreign.R == nil {
+					foreign.R = &announcementR{}
+				}
+				foreign.R.Department = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClasses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (departmentL) LoadClasses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDepartment interface{}, mods queries.Applicator) error {
+	var slice []*Department
+	var object *Department
+
+	if singular {
+		object = maybeDepartment.(*Department)
+	} else {
+		slice = *maybeDepartment.(*[]*Department)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &departmentR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &departmentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class`),
+		qm.WhereIn(`class.department_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class")
+	}
+
+	var resultSlice []*Class
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class")
+	}
+
+	if singular {
+		object.R.Classes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classR{}
+			}
+			foreign.R.Department = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DepartmentID) {
+				local.R.Classes = append(local.R.Classes, foreign)
+				if foreign.R == nil {
+					foreign.R = &classR{}
+				}
+				foreign.R.Department = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the department to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Departments.
+// Uses the global database handle.
+func (o *Department) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the department to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Departments.
+func (o *Department) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"department\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, departmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &departmentR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			Departments: DepartmentSlice{o},
+		}
+	} else {
+		related.R.Departments = append(related.R.Departments, o)
+	}
+
+	return nil
+}
+
+// AddAnnouncementsG adds the given related objects to the existing relationships
+// of the department, optionally inserting them as new records.
+// Appends related to o.R.Announcements.
+// Sets related.R.Department appropriately.
+// Uses the global database handle.
+func (o *Department) AddAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.AddAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAnnouncements adds the given related objects to the existing relationships
+// of the department, optionally inserting them as new records.
+// Appends related to o.R.Announcements.
+// Sets related.R.Department appropriately.
+func (o *Department) AddAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DepartmentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"announcement\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"department_id"}),
+				strmangle.WhereClause("\"", "\"", 2, announcementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DepartmentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &departmentR{
+			Announcements: related,
+		}
+	} else {
+		o.R.Announcements = append(o.R.Announcements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &announcementR{
+				Department: o,
+			}
+		} else {
+			rel.R.Department = o
+		}
+	}
+	return nil
+}
+
+// SetAnnouncementsG removes all previously related items of the
+// department replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Department's Announcements accordingly.
+// Replaces o.R.Announcements with related.
+// Sets related.R.Department's Announcements accordingly.
+// Uses the global database handle.
+func (o *Department) SetAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.SetAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAnnouncements removes all previously related items of the
+// department replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Department's Announcements accordingly.
+// Replaces o.R.Announcements with related.
+// Sets related.R.Department's Announcements accordingly.
+func (o *Department) SetAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	query := "update \"announcement\" set \"department_id\" = null where \"department_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Announcements {
+			queries.SetScanner(&rel.DepartmentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Department = nil
+		}
+
+		o.R.Announcements = nil
+	}
+	return o.AddAnnouncements(ctx, exec, insert, related...)
+}
+
+// RemoveAnnouncementsG relationships from objects passed in.
+// Removes related items from R.Announcements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Department.
+// Uses the global database handle.
+func (o *Department) RemoveAnnouncementsG(ctx context.Context, related ...*Announcement) error {
+	return o.RemoveAnnouncements(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAnnouncements relationships from objects passed in.
+// Removes related items from R.Announcements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Department.
+func (o *Department) RemoveAnnouncements(ctx context.Context, exec boil.ContextExecutor, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DepartmentID, nil)
+		if rel.R != nil {
+			rel.R.Department = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("department_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Announcements {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Announcements)
+			if ln > 1 && i < ln-1 {
+				o.R.Announcements[i] = o.R.Announcements[ln-1]
+			}
+			o.R.Announcements = o.R.Announcements[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddClassesG adds the given related objects to the existing relationships
+// of the department, optionally inserting them as new records.
+// Appends related to o.R.Classes.
+// Sets related.R.Department appropriately.
+// Uses the global database handle.
+func (o *Department) AddClassesG(ctx context.Context, insert bool, related ...*Class) error {
+	return o.AddClasses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddClasses adds the given related objects to the existing relationships
+// of the department, optionally inserting them as new records.
+// Appends related to o.R.Classes.
+// Sets related.R.Department appropriately.
+func (o *Department) AddClasses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Class) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DepartmentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"class\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"department_id"}),
+				strmangle.WhereClause("\"", "\"", 2, classPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DepartmentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &departmentR{
+			Classes: related,
+		}
+	} else {
+		o.R.Classes = append(o.R.Classes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &classR{
+				Department: o,
+			}
+		} else {
+			rel.R.Department = o
+		}
+	}
+	return nil
+}
+
+// SetClassesG removes all previously related items of the
+// department replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Department's Classes accordingly.
+// Replaces o.R.Classes with related.
+// Sets related.R.Department's Classes accordingly.
+// Uses the global database handle.
+func (o *Department) SetClassesG(ctx context.Context, insert bool, related ...*Class) error {
+	return o.SetClasses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetClasses removes all previously related items of the
+// department replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Department's Classes accordingly.
+// Replaces o.R.Classes with related.
+// Sets related.R.Department's Classes accordingly.
+func (o *Department) SetClasses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Class) error {
+	query := "update \"class\" set \"department_id\" = null where \"department_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Classes {
+			queries.SetScanner(&rel.DepartmentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Department = nil
+		}
+
+		o.R.Classes = nil
+	}
+	return o.AddClasses(ctx, exec, insert, related...)
+}
+
+// RemoveClassesG relationships from objects passed in.
+// Removes related items from R.Classes (uses pointer comparison, removal does not keep order)
+// Sets related.R.Department.
+// Uses the global database handle.
+func (o *Department) RemoveClassesG(ctx context.Context, related ...*Class) error {
+	return o.RemoveClasses(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveClasses relationships from objects passed in.
+// Removes related items from R.Classes (uses pointer comparison, removal does not keep order)
+// Sets related.R.Department.
+func (o *Department) RemoveClasses(ctx context.Context, exec boil.ContextExecutor, related ...*Class) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DepartmentID, nil)
+		if rel.R != nil {
+			rel.R.Department = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("department_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Classes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Classes)
+			if ln > 1 && i < ln-1 {
+				o.R.Classes[i] = o.R.Classes[ln-1]
+			}
+			o.R.Classes = o.R.Classes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Departments retrieves all the records using an executor.
+func Departments(mods ...qm.QueryMod) departmentQuery {
+	mods = append(mods, qm.From("\"department\""))
+	return departmentQuery{NewQuery(mods...)}
+}
+
+// FindDepartmentG retrieves a single record by ID.
+func FindDepartmentG(ctx context.Context, iD string, selectCols ...string) (*Department, error) {
+	return FindDepartment(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindDepartment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDepartment(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Department, error) {
+	departmentObj := &Department{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"department\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, departmentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from department")
+	}
+
+	return departmentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Department) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Department) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no department provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(departmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	departmentInsertCacheMut.RLock()
+	cache, cached := departmentInsertCache[key]
+	departmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			departmentAllColumns,
+			departmentColumnsWithDefault,
+			departmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(departmentType, departmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(departmentType, departmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"department\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"department\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into department")
+	}
+
+	if !cached {
+		departmentInsertCacheMut.Lock()
+		departmentInsertCache[key] = cache
+		departmentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Department record using the global executor.
+// See Update for more documentation.
+func (o *Department) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Department.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Department) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	departmentUpdateCacheMut.RLock()
+	cache, cached := departmentUpdateCache[key]
+	departmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			departmentAllColumns,
+			departmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update department, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"department\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, departmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(departmentType, departmentMapping, append(wl, departmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update department row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for department")
+	}
+
+	if !cached {
+		departmentUpdateCacheMut.Lock()
+		departmentUpdateCache[key] = cache
+		departmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q departmentQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q departmentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for department")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for department")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DepartmentSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DepartmentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), departmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"department\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, departmentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in department slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all department")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Department) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Department) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no department provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(departmentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	departmentUpsertCacheMut.RLock()
+	cache, cached := departmentUpsertCache[key]
+	departmentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			departmentAllColumns,
+			departmentColumnsWithDefault,
+			departmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			departmentAllColumns,
+			departmentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert department, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(departmentPrimaryKeyColumns))
+			copy(conflict, departmentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"department\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(departmentType, departmentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(departmentType, departmentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert department")
+	}
+
+	if !cached {
+		departmentUpsertCacheMut.Lock()
+		departmentUpsertCache[key] = cache
+		departmentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Department record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Department) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Department record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Department) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Department provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), departmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"department\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from department")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for department")
+	}
+
+	return rowsAff, nil
+}
+
+func (q departmentQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q departmentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no departmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from department")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for department")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DepartmentSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DepartmentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), departmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"department\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, departmentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from department slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for department")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Department) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Department provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Department) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDepartment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DepartmentSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty DepartmentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DepartmentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DepartmentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), departmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"department\".* FROM \"department\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, departmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DepartmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DepartmentExistsG checks if the Department row exists.
+func DepartmentExistsG(ctx context.Context, iD string) (bool, error) {
+	return DepartmentExists(ctx, boil.GetContextDB(), iD)
+}
+
+// DepartmentExists checks if the Department row exists.
+func DepartmentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"department\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if department exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1356 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDepartments(t *testing.T) {
+	t.Parallel()
+
+	query := Departments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDepartmentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepartmentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Departments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepartmentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DepartmentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDepartmentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DepartmentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Department exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DepartmentExists to return true, but got false.")
+	}
+}
+
+func testDepartmentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	departmentFound, err := FindDepartment(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if departmentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDepartmentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Departments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepartmentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Departments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDepartmentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	departmentOne := &Department{}
+	departmentTwo := &Department{}
+	if err = randomize.Struct(seed, departmentOne, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+	if err = randomize.Struct(seed, departmentTwo, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = departmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = departmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Departments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDepartmentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	departmentOne := &Department{}
+	departmentTwo := &Department{}
+	if err = randomize.Struct(seed, departmentOne, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+	if err = randomize.Struct(seed, departmentTwo, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = departmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = departmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testDepartmentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDepartmentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(departmentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDepartmentToManyAnnouncements(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, announcementDBTypes, false, announcementColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DepartmentID, a.ID)
+	queries.Assign(&c.DepartmentID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Announcements().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DepartmentID, b.DepartmentID) {
+			bFound = true
+		}
+		if queries.Equal(v.DepartmentID, c.DepartmentID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DepartmentSlice{&a}
+	if err = a.L.LoadAnnouncements(ctx, tx, false, (*[]*Department)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Announcements); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Announcements = nil
+	if err = a.L.LoadAnnouncements(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Announcements); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testDepartmentToManyClasses(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, classDBTypes, false, classColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, classDBTypes, false, classColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DepartmentID, a.ID)
+	queries.Assign(&c.DepartmentID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Classes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DepartmentID, b.DepartmentID) {
+			bFound = true
+		}
+		if queries.Equal(v.DepartmentID, c.DepartmentID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DepartmentSlice{&a}
+	if err = a.L.LoadClasses(ctx, tx, false, (*[]*Department)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Classes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Classes = nil
+	if err = a.L.LoadClasses(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Classes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testDepartmentToManyAddOpAnnouncements(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Announcement{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Announcement{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAnnouncements(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DepartmentID) {
+			t.Error("foreign key was wrong value", a.ID, first.DepartmentID)
+		}
+		if !queries.Equal(a.ID, second.DepartmentID) {
+			t.Error("foreign key was wrong value", a.ID, second.DepartmentID)
+		}
+
+		if first.R.Department != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Department != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Announcements[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Announcements[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Announcements().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDepartmentToManySetOpAnnouncements(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Announcement{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAnnouncements(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAnnouncements(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DepartmentID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DepartmentID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DepartmentID) {
+		t.Error("foreign key was wrong value", a.ID, d.DepartmentID)
+	}
+	if !queries.Equal(a.ID, e.DepartmentID) {
+		t.Error("foreign key was wrong value", a.ID, e.DepartmentID)
+	}
+
+	if b.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Department != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Department != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Announcements[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Announcements[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDepartmentToManyRemoveOpAnnouncements(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Announcement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Announcement{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, announcementDBTypes, false, strmangle.SetComplement(announcementPrimaryKeyColumns, announcementColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAnnouncements(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAnnouncements(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Announcements().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DepartmentID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DepartmentID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Department != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Department != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Announcements) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Announcements[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Announcements[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDepartmentToManyAddOpClasses(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Class{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Class{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddClasses(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DepartmentID) {
+			t.Error("foreign key was wrong value", a.ID, first.DepartmentID)
+		}
+		if !queries.Equal(a.ID, second.DepartmentID) {
+			t.Error("foreign key was wrong value", a.ID, second.DepartmentID)
+		}
+
+		if first.R.Department != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Department != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Classes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Classes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Classes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDepartmentToManySetOpClasses(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Class{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetClasses(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Classes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetClasses(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Classes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DepartmentID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DepartmentID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DepartmentID) {
+		t.Error("foreign key was wrong value", a.ID, d.DepartmentID)
+	}
+	if !queries.Equal(a.ID, e.DepartmentID) {
+		t.Error("foreign key was wrong value", a.ID, e.DepartmentID)
+	}
+
+	if b.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Department != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Department != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Classes[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Classes[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDepartmentToManyRemoveOpClasses(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c, d, e Class
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Class{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, classDBTypes, false, strmangle.SetComplement(classPrimaryKeyColumns, classColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddClasses(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Classes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveClasses(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Classes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DepartmentID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DepartmentID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Department != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Department != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Department != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Classes) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Classes[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Classes[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDepartmentToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Department
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, departmentDBTypes, false, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SchoolID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DepartmentSlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*Department)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testDepartmentToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Department
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, departmentDBTypes, false, strmangle.SetComplement(departmentPrimaryKeyColumns, departmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Departments[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testDepartmentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepartmentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DepartmentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDepartmentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Departments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	departmentDBTypes = map[string]string{`ID`: `uuid`, `SchoolID`: `uuid`, `Name`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testDepartmentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(departmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(departmentAllColumns) == len(departmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDepartmentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(departmentAllColumns) == len(departmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Department{}
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, departmentDBTypes, true, departmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(departmentAllColumns, departmentPrimaryKeyColumns) {
+		fields = departmentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			departmentAllColumns,
+			departmentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DepartmentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDepartmentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(departmentAllColumns) == len(departmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Department{}
+	if err = randomize.Struct(seed, &o, departmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Department: %s", err)
+	}
+
+	count, err := Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, departmentDBTypes, false, departmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Department struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Department: %s", err)
+	}
+
+	count, err = Departments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
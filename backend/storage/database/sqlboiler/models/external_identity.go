@@ -0,0 +1,1161 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExternalIdentity is an object representing the database table.
+type ExternalIdentity struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Provider  string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	Subject   string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Email     string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	SchoolID  null.String `boil:"school_id" json:"school_id,omitempty" toml:"school_id" yaml:"school_id,omitempty"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *externalIdentityR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L externalIdentityL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExternalIdentityColumns = struct {
+	ID        string
+	UserID    string
+	Provider  string
+	Subject   string
+	Email     string
+	SchoolID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	Provider:  "provider",
+	Subject:   "subject",
+	Email:     "email",
+	SchoolID:  "school_id",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var ExternalIdentityWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	Provider  whereHelperstring
+	Subject   whereHelperstring
+	Email     whereHelperstring
+	SchoolID  whereHelpernull_String
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"external_identity\".\"id\""},
+	UserID:    whereHelperstring{field: "\"external_identity\".\"user_id\""},
+	Provider:  whereHelperstring{field: "\"external_identity\".\"provider\""},
+	Subject:   whereHelperstring{field: "\"external_identity\".\"subject\""},
+	Email:     whereHelperstring{field: "\"external_identity\".\"email\""},
+	SchoolID:  whereHelpernull_String{field: "\"external_identity\".\"school_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"external_identity\".\"created_at\""},
+}
+
+// ExternalIdentityRels is where relationship names are stored.
+var ExternalIdentityRels = struct {
+	School string
+	User   string
+}{
+	School: "School",
+	User:   "User",
+}
+
+// externalIdentityR is where relationships are stored.
+type externalIdentityR struct {
+	School *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+	User   *User   `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*externalIdentityR) NewStruct() *externalIdentityR {
+	return &externalIdentityR{}
+}
+
+// externalIdentityL is where Load methods for each relationship are stored.
+type externalIdentityL struct{}
+
+var (
+	externalIdentityAllColumns            = []string{"id", "user_id", "provider", "subject", "email", "school_id", "created_at"}
+	externalIdentityColumnsWithoutDefault = []string{"id", "user_id", "provider", "subject", "school_id", "created_at"}
+	externalIdentityColumnsWithDefault    = []string{"email"}
+	externalIdentityPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ExternalIdentitySlice is an alias for a slice of pointers to ExternalIdentity.
+	// This should generally be used opposed to []ExternalIdentity.
+	ExternalIdentitySlice []*ExternalIdentity
+
+	externalIdentityQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	externalIdentityType                 = reflect.TypeOf(&ExternalIdentity{})
+	externalIdentityMapping              = queries.MakeStructMapping(externalIdentityType)
+	externalIdentityPrimaryKeyMapping, _ = queries.BindMapping(externalIdentityType, externalIdentityMapping, externalIdentityPrimaryKeyColumns)
+	externalIdentityInsertCacheMut       sync.RWMutex
+	externalIdentityInsertCache          = make(map[string]insertCache)
+	externalIdentityUpdateCacheMut       sync.RWMutex
+	externalIdentityUpdateCache          = make(map[string]updateCache)
+	externalIdentityUpsertCacheMut       sync.RWMutex
+	externalIdentityUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single externalIdentity record from the query using the global executor.
+func (q externalIdentityQuery) OneG(ctx context.Context) (*ExternalIdentity, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single externalIdentity record from the query.
+func (q externalIdentityQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ExternalIdentity, error) {
+	o := &ExternalIdentity{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for external_identity")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ExternalIdentity records from the query using the global executor.
+func (q externalIdentityQuery) AllG(ctx context.Context) (ExternalIdentitySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ExternalIdentity records from the query.
+func (q externalIdentityQuery) All(ctx context.Context, exec boil.ContextExecutor) (ExternalIdentitySlice, error) {
+	var o []*ExternalIdentity
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ExternalIdentity slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ExternalIdentity records in the query, and panics on error.
+func (q externalIdentityQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ExternalIdentity records in the query.
+func (q externalIdentityQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count external_identity rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q externalIdentityQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q externalIdentityQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if external_identity exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *ExternalIdentity) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *ExternalIdentity) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (externalIdentityL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExternalIdentity interface{}, mods queries.Applicator) error {
+	var slice []*ExternalIdentity
+	var object *ExternalIdentity
+
+	if singular {
+		object = maybeExternalIdentity.(*ExternalIdentity)
+	} else {
+		slice = *maybeExternalIdentity.(*[]*ExternalIdentity)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &externalIdentityR{}
+		}
+		if !queries.IsNil(object.SchoolID) {
+			args = append(args, object.SchoolID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &externalIdentityR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SchoolID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SchoolID) {
+				args = append(args, obj.SchoolID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.ExternalIdentities = append(foreign.R.ExternalIdentities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SchoolID, foreign.ID) {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.ExternalIdentities = append(foreign.R.ExternalIdentities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (externalIdentityL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExternalIdentity interface{}, mods queries.Applicator) error {
+	var slice []*ExternalIdentity
+	var object *ExternalIdentity
+
+	if singular {
+		object = maybeExternalIdentity.(*ExternalIdentity)
+	} else {
+		slice = *maybeExternalIdentity.(*[]*ExternalIdentity)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &externalIdentityR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &externalIdentityR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ExternalIdentities = append(foreign.R.ExternalIdentities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ExternalIdentities = append(foreign.R.ExternalIdentities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the externalIdentity to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.ExternalIdentities.
+// Uses the global database handle.
+func (o *ExternalIdentity) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the externalIdentity to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.ExternalIdentities.
+func (o *ExternalIdentity) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"external_identity\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, externalIdentityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SchoolID, related.ID)
+	if o.R == nil {
+		o.R = &externalIdentityR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			ExternalIdentities: ExternalIdentitySlice{o},
+		}
+	} else {
+		related.R.ExternalIdentities = append(related.R.ExternalIdentities, o)
+	}
+
+	return nil
+}
+
+// RemoveSchoolG relationship.
+// Sets o.R.School to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ExternalIdentity) RemoveSchoolG(ctx context.Context, related *School) error {
+	return o.RemoveSchool(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveSchool relationship.
+// Sets o.R.School to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ExternalIdentity) RemoveSchool(ctx context.Context, exec boil.ContextExecutor, related *School) error {
+	var err error
+
+	queries.SetScanner(&o.SchoolID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("school_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.School = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ExternalIdentities {
+		if queries.Equal(o.SchoolID, ri.SchoolID) {
+			continue
+		}
+
+		ln := len(related.R.ExternalIdentities)
+		if ln > 1 && i < ln-1 {
+			related.R.ExternalIdentities[i] = related.R.ExternalIdentities[ln-1]
+		}
+		related.R.ExternalIdentities = related.R.ExternalIdentities[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserG of the externalIdentity to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ExternalIdentities.
+// Uses the global database handle.
+func (o *ExternalIdentity) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the externalIdentity to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ExternalIdentities.
+func (o *ExternalIdentity) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"external_identity\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, externalIdentityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &externalIdentityR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ExternalIdentities: ExternalIdentitySlice{o},
+		}
+	} else {
+		related.R.ExternalIdentities = append(related.R.ExternalIdentities, o)
+	}
+
+	return nil
+}
+
+// ExternalIdentities retrieves all the records using an executor.
+func ExternalIdentities(mods ...qm.QueryMod) externalIdentityQuery {
+	mods = append(mods, qm.From("\"external_identity\""))
+	return externalIdentityQuery{NewQuery(mods...)}
+}
+
+// FindExternalIdentityG retrieves a single record by ID.
+func FindExternalIdentityG(ctx context.Context, iD string, selectCols ...string) (*ExternalIdentity, error) {
+	return FindExternalIdentity(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindExternalIdentity retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExternalIdentity(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ExternalIdentity, error) {
+	externalIdentityObj := &ExternalIdentity{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"external_identity\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, externalIdentityObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from external_identity")
+	}
+
+	return externalIdentityObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ExternalIdentity) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExternalIdentity) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_identity provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIdentityColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	externalIdentityInsertCacheMut.RLock()
+	cache, cached := externalIdentityInsertCache[key]
+	externalIdentityInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			externalIdentityAllColumns,
+			externalIdentityColumnsWithDefault,
+			externalIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(externalIdentityType, externalIdentityMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(externalIdentityType, externalIdentityMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"external_identity\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"external_identity\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into external_identity")
+	}
+
+	if !cached {
+		externalIdentityInsertCacheMut.Lock()
+		externalIdentityInsertCache[key] = cache
+		externalIdentityInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ExternalIdentity record using the global executor.
+// See Update for more documentation.
+func (o *ExternalIdentity) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ExternalIdentity.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExternalIdentity) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	externalIdentityUpdateCacheMut.RLock()
+	cache, cached := externalIdentityUpdateCache[key]
+	externalIdentityUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			externalIdentityAllColumns,
+			externalIdentityPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update external_identity, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"external_identity\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, externalIdentityPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(externalIdentityType, externalIdentityMapping, append(wl, externalIdentityPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update external_identity row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for external_identity")
+	}
+
+	if !cached {
+		externalIdentityUpdateCacheMut.Lock()
+		externalIdentityUpdateCache[key] = cache
+		externalIdentityUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q externalIdentityQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q externalIdentityQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for external_identity")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for external_identity")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ExternalIdentitySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExternalIdentitySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"external_identity\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, externalIdentityPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in externalIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all externalIdentity")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ExternalIdentity) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExternalIdentity) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_identity provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIdentityColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	externalIdentityUpsertCacheMut.RLock()
+	cache, cached := externalIdentityUpsertCache[key]
+	externalIdentityUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			externalIdentityAllColumns,
+			externalIdentityColumnsWithDefault,
+			externalIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			externalIdentityAllColumns,
+			externalIdentityPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert external_identity, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(externalIdentityPrimaryKeyColumns))
+			copy(conflict, externalIdentityPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"external_identity\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(externalIdentityType, externalIdentityMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(externalIdentityType, externalIdentityMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert external_identity")
+	}
+
+	if !cached {
+		externalIdentityUpsertCacheMut.Lock()
+		externalIdentityUpsertCache[key] = cache
+		externalIdentityUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ExternalIdentity record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ExternalIdentity) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ExternalIdentity record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExternalIdentity) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ExternalIdentity provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), externalIdentityPrimaryKeyMapping)
+	sql := "DELETE FROM \"external_identity\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from external_identity")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for external_identity")
+	}
+
+	return rowsAff, nil
+}
+
+func (q externalIdentityQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q externalIdentityQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no externalIdentityQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from external_identity")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_identity")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ExternalIdentitySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExternalIdentitySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"external_identity\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIdentityPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from externalIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_identity")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ExternalIdentity) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ExternalIdentity provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExternalIdentity) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindExternalIdentity(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExternalIdentitySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ExternalIdentitySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExternalIdentitySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExternalIdentitySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"external_identity\".* FROM \"external_identity\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIdentityPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ExternalIdentitySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExternalIdentityExistsG checks if the ExternalIdentity row exists.
+func ExternalIdentityExistsG(ctx context.Context, iD string) (bool, error) {
+	return ExternalIdentityExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ExternalIdentityExists checks if the ExternalIdentity row exists.
+func ExternalIdentityExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"external_identity\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if external_identity exists")
+	}
+
+	return exists, nil
+}
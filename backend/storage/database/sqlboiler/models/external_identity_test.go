@@ -0,0 +1,860 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testExternalIdentities(t *testing.T) {
+	t.Parallel()
+
+	query := ExternalIdentities()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testExternalIdentitiesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdentitiesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ExternalIdentities().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdentitiesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIdentitySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdentitiesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ExternalIdentityExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ExternalIdentity exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ExternalIdentityExists to return true, but got false.")
+	}
+}
+
+func testExternalIdentitiesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	externalIdentityFound, err := FindExternalIdentity(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if externalIdentityFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testExternalIdentitiesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ExternalIdentities().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdentitiesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ExternalIdentities().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testExternalIdentitiesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	externalIdentityOne := &ExternalIdentity{}
+	externalIdentityTwo := &ExternalIdentity{}
+	if err = randomize.Struct(seed, externalIdentityOne, externalIdentityDBTypes, false, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIdentityTwo, externalIdentityDBTypes, false, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIdentityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIdentityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIdentities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testExternalIdentitiesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	externalIdentityOne := &ExternalIdentity{}
+	externalIdentityTwo := &ExternalIdentity{}
+	if err = randomize.Struct(seed, externalIdentityOne, externalIdentityDBTypes, false, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIdentityTwo, externalIdentityDBTypes, false, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIdentityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIdentityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testExternalIdentitiesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIdentitiesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(externalIdentityColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIdentityToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExternalIdentity
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SchoolID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ExternalIdentitySlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*ExternalIdentity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testExternalIdentityToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExternalIdentity
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, externalIdentityDBTypes, false, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ExternalIdentitySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ExternalIdentity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testExternalIdentityToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalIdentity
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIdentityDBTypes, false, strmangle.SetComplement(externalIdentityPrimaryKeyColumns, externalIdentityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExternalIdentities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SchoolID, x.ID) {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SchoolID, x.ID) {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testExternalIdentityToOneRemoveOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalIdentity
+	var b School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIdentityDBTypes, false, strmangle.SetComplement(externalIdentityPrimaryKeyColumns, externalIdentityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSchool(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSchool(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.School().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.School != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SchoolID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ExternalIdentities) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testExternalIdentityToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalIdentity
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIdentityDBTypes, false, strmangle.SetComplement(externalIdentityPrimaryKeyColumns, externalIdentityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExternalIdentities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testExternalIdentitiesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdentitiesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIdentitySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdentitiesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIdentities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	externalIdentityDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Provider`: `character varying`, `Subject`: `character varying`, `Email`: `character varying`, `SchoolID`: `uuid`, `CreatedAt`: `timestamp without time zone`}
+	_                       = bytes.MinRead
+)
+
+func testExternalIdentitiesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(externalIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(externalIdentityAllColumns) == len(externalIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testExternalIdentitiesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIdentityAllColumns) == len(externalIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdentity{}
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIdentityDBTypes, true, externalIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(externalIdentityAllColumns, externalIdentityPrimaryKeyColumns) {
+		fields = externalIdentityAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			externalIdentityAllColumns,
+			externalIdentityPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ExternalIdentitySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testExternalIdentitiesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIdentityAllColumns) == len(externalIdentityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ExternalIdentity{}
+	if err = randomize.Struct(seed, &o, externalIdentityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalIdentity: %s", err)
+	}
+
+	count, err := ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, externalIdentityDBTypes, false, externalIdentityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdentity struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalIdentity: %s", err)
+	}
+
+	count, err = ExternalIdentities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1116 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GuardianLink is an object representing the database table.
+type GuardianLink struct {
+	GuardianID   string    `boil:"guardian_id" json:"guardian_id" toml:"guardian_id" yaml:"guardian_id"`
+	StudentID    string    `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	Relationship string    `boil:"relationship" json:"relationship" toml:"relationship" yaml:"relationship"`
+	CreatedAt    null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt    null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *guardianLinkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L guardianLinkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GuardianLinkColumns = struct {
+	GuardianID   string
+	StudentID    string
+	Relationship string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	GuardianID:   "guardian_id",
+	StudentID:    "student_id",
+	Relationship: "relationship",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+// Generated where
+
+var GuardianLinkWhere = struct {
+	GuardianID   whereHelperstring
+	StudentID    whereHelperstring
+	Relationship whereHelperstring
+	CreatedAt    whereHelpernull_Time
+	UpdatedAt    whereHelpernull_Time
+}{
+	GuardianID:   whereHelperstring{field: "\"guardian_link\".\"guardian_id\""},
+	StudentID:    whereHelperstring{field: "\"guardian_link\".\"student_id\""},
+	Relationship: whereHelperstring{field: "\"guardian_link\".\"relationship\""},
+	CreatedAt:    whereHelpernull_Time{field: "\"guardian_link\".\"created_at\""},
+	UpdatedAt:    whereHelpernull_Time{field: "\"guardian_link\".\"updated_at\""},
+}
+
+// GuardianLinkRels is where relationship names are stored.
+var GuardianLinkRels = struct {
+	Guardian string
+	Student  string
+}{
+	Guardian: "Guardian",
+	Student:  "Student",
+}
+
+// guardianLinkR is where relationships are stored.
+type guardianLinkR struct {
+	Guardian *User `boil:"Guardian" json:"Guardian" toml:"Guardian" yaml:"Guardian"`
+	Student  *User `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*guardianLinkR) NewStruct() *guardianLinkR {
+	return &guardianLinkR{}
+}
+
+// guardianLinkL is where Load methods for each relationship are stored.
+type guardianLinkL struct{}
+
+var (
+	guardianLinkAllColumns            = []string{"guardian_id", "student_id", "relationship", "created_at", "updated_at"}
+	guardianLinkColumnsWithoutDefault = []string{"guardian_id", "student_id", "relationship", "created_at", "updated_at"}
+	guardianLinkColumnsWithDefault    = []string{}
+	guardianLinkPrimaryKeyColumns     = []string{"guardian_id", "student_id"}
+)
+
+type (
+	// GuardianLinkSlice is an alias for a slice of pointers to GuardianLink.
+	// This should generally be used opposed to []GuardianLink.
+	GuardianLinkSlice []*GuardianLink
+
+	guardianLinkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	guardianLinkType                 = reflect.TypeOf(&GuardianLink{})
+	guardianLinkMapping              = queries.MakeStructMapping(guardianLinkType)
+	guardianLinkPrimaryKeyMapping, _ = queries.BindMapping(guardianLinkType, guardianLinkMapping, guardianLinkPrimaryKeyColumns)
+	guardianLinkInsertCacheMut       sync.RWMutex
+	guardianLinkInsertCache          = make(map[string]insertCache)
+	guardianLinkUpdateCacheMut       sync.RWMutex
+	guardianLinkUpdateCache          = make(map[string]updateCache)
+	guardianLinkUpsertCacheMut       sync.RWMutex
+	guardianLinkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single guardianLink record from the query using the global executor.
+func (q guardianLinkQuery) OneG(ctx context.Context) (*GuardianLink, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single guardianLink record from the query.
+func (q guardianLinkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GuardianLink, error) {
+	o := &GuardianLink{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for guardian_link")
+	}
+
+	return o, nil
+}
+
+// AllG returns all GuardianLink records from the query using the global executor.
+func (q guardianLinkQuery) AllG(ctx context.Context) (GuardianLinkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all GuardianLink records from the query.
+func (q guardianLinkQuery) All(ctx context.Context, exec boil.ContextExecutor) (GuardianLinkSlice, error) {
+	var o []*GuardianLink
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to GuardianLink slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all GuardianLink records in the query, and panics on error.
+func (q guardianLinkQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all GuardianLink records in the query.
+func (q guardianLinkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count guardian_link rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q guardianLinkQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q guardianLinkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if guardian_link exists")
+	}
+
+	return count > 0, nil
+}
+
+// Guardian pointed to by the foreign key.
+func (o *GuardianLink) Guardian(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GuardianID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *GuardianLink) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadGuardian allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (guardianLinkL) LoadGuardian(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGuardianLink interface{}, mods queries.Applicator) error {
+	var slice []*GuardianLink
+	var object *GuardianLink
+
+	if singular {
+		object = maybeGuardianLink.(*GuardianLink)
+	} else {
+		slice = *maybeGuardianLink.(*[]*GuardianLink)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &guardianLinkR{}
+		}
+		args = append(args, object.GuardianID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &guardianLinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuardianID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuardianID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Guardian = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.GuardianGuardianLinks = append(foreign.R.GuardianGuardianLinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuardianID == foreign.ID {
+				local.R.Guardian = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.GuardianGuardianLinks = append(foreign.R.GuardianGuardianLinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (guardianLinkL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGuardianLink interface{}, mods queries.Applicator) error {
+	var slice []*GuardianLink
+	var object *GuardianLink
+
+	if singular {
+		object = maybeGuardianLink.(*GuardianLink)
+	} else {
+		slice = *maybeGuardianLink.(*[]*GuardianLink)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &guardianLinkR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &guardianLinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentGuardianLinks = append(foreign.R.StudentGuardianLinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentGuardianLinks = append(foreign.R.StudentGuardianLinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuardianG of the guardianLink to the related item.
+// Sets o.R.Guardian to related.
+// Adds o to related.R.GuardianGuardianLinks.
+// Uses the global database handle.
+func (o *GuardianLink) SetGuardianG(ctx context.Context, insert bool, related *User) error {
+	return o.SetGuardian(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetGuardian of the guardianLink to the related item.
+// Sets o.R.Guardian to related.
+// Adds o to related.R.GuardianGuardianLinks.
+func (o *GuardianLink) SetGuardian(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"guardian_link\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"guardian_id"}),
+		strmangle.WhereClause("\"", "\"", 2, guardianLinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.GuardianID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GuardianID = related.ID
+	if o.R == nil {
+		o.R = &guardianLinkR{
+			Guardian: related,
+		}
+	} else {
+		o.R.Guardian = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			GuardianGuardianLinks: GuardianLinkSlice{o},
+		}
+	} else {
+		related.R.GuardianGuardianLinks = append(related.R.GuardianGuardianLinks, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the guardianLink to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentGuardianLinks.
+// Uses the global database handle.
+func (o *GuardianLink) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the guardianLink to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentGuardianLinks.
+func (o *GuardianLink) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"guardian_link\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, guardianLinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.GuardianID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &guardianLinkR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentGuardianLinks: GuardianLinkSlice{o},
+		}
+	} else {
+		related.R.StudentGuardianLinks = append(related.R.StudentGuardianLinks, o)
+	}
+
+	return nil
+}
+
+// GuardianLinks retrieves all the records using an executor.
+func GuardianLinks(mods ...qm.QueryMod) guardianLinkQuery {
+	mods = append(mods, qm.From("\"guardian_link\""))
+	return guardianLinkQuery{NewQuery(mods...)}
+}
+
+// FindGuardianLinkG retrieves a single record by ID.
+func FindGuardianLinkG(ctx context.Context, guardianID string, studentID string, selectCols ...string) (*GuardianLink, error) {
+	return FindGuardianLink(ctx, boil.GetContextDB(), guardianID, studentID, selectCols...)
+}
+
+// FindGuardianLink retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGuardianLink(ctx context.Context, exec boil.ContextExecutor, guardianID string, studentID string, selectCols ...string) (*GuardianLink, error) {
+	guardianLinkObj := &GuardianLink{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"guardian_link\" where \"guardian_id\"=$1 AND \"student_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, guardianID, studentID)
+
+	err := q.Bind(ctx, exec, guardianLinkObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from guardian_link")
+	}
+
+	return guardianLinkObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *GuardianLink) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GuardianLink) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no guardian_link provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(guardianLinkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	guardianLinkInsertCacheMut.RLock()
+	cache, cached := guardianLinkInsertCache[key]
+	guardianLinkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			guardianLinkAllColumns,
+			guardianLinkColumnsWithDefault,
+			guardianLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(guardianLinkType, guardianLinkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(guardianLinkType, guardianLinkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"guardian_link\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"guardian_link\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into guardian_link")
+	}
+
+	if !cached {
+		guardianLinkInsertCacheMut.Lock()
+		guardianLinkInsertCache[key] = cache
+		guardianLinkInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single GuardianLink record using the global executor.
+// See Update for more documentation.
+func (o *GuardianLink) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the GuardianLink.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GuardianLink) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	guardianLinkUpdateCacheMut.RLock()
+	cache, cached := guardianLinkUpdateCache[key]
+	guardianLinkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			guardianLinkAllColumns,
+			guardianLinkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update guardian_link, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"guardian_link\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, guardianLinkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(guardianLinkType, guardianLinkMapping, append(wl, guardianLinkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update guardian_link row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for guardian_link")
+	}
+
+	if !cached {
+		guardianLinkUpdateCacheMut.Lock()
+		guardianLinkUpdateCache[key] = cache
+		guardianLinkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q guardianLinkQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q guardianLinkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for guardian_link")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for guardian_link")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GuardianLinkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GuardianLinkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), guardianLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"guardian_link\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, guardianLinkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in guardianLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all guardianLink")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *GuardianLink) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GuardianLink) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no guardian_link provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(guardianLinkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	guardianLinkUpsertCacheMut.RLock()
+	cache, cached := guardianLinkUpsertCache[key]
+	guardianLinkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			guardianLinkAllColumns,
+			guardianLinkColumnsWithDefault,
+			guardianLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			guardianLinkAllColumns,
+			guardianLinkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert guardian_link, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(guardianLinkPrimaryKeyColumns))
+			copy(conflict, guardianLinkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"guardian_link\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(guardianLinkType, guardianLinkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(guardianLinkType, guardianLinkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert guardian_link")
+	}
+
+	if !cached {
+		guardianLinkUpsertCacheMut.Lock()
+		guardianLinkUpsertCache[key] = cache
+		guardianLinkUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single GuardianLink record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *GuardianLink) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single GuardianLink record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GuardianLink) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no GuardianLink provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), guardianLinkPrimaryKeyMapping)
+	sql := "DELETE FROM \"guardian_link\" WHERE \"guardian_id\"=$1 AND \"student_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from guardian_link")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for guardian_link")
+	}
+
+	return rowsAff, nil
+}
+
+func (q guardianLinkQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q guardianLinkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no guardianLinkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from guardian_link")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for guardian_link")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GuardianLinkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GuardianLinkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), guardianLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"guardian_link\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, guardianLinkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from guardianLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for guardian_link")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *GuardianLink) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no GuardianLink provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GuardianLink) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGuardianLink(ctx, exec, o.GuardianID, o.StudentID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GuardianLinkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty GuardianLinkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GuardianLinkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GuardianLinkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), guardianLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"guardian_link\".* FROM \"guardian_link\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, guardianLinkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GuardianLinkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GuardianLinkExistsG checks if the GuardianLink row exists.
+func GuardianLinkExistsG(ctx context.Context, guardianID string, studentID string) (bool, error) {
+	return GuardianLinkExists(ctx, boil.GetContextDB(), guardianID, studentID)
+}
+
+// GuardianLinkExists checks if the GuardianLink row exists.
+func GuardianLinkExists(ctx context.Context, exec boil.ContextExecutor, guardianID string, studentID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"guardian_link\" where \"guardian_id\"=$1 AND \"student_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guardianID, studentID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guardianID, studentID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if guardian_link exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testGuardianLinks(t *testing.T) {
+	t.Parallel()
+
+	query := GuardianLinks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testGuardianLinksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGuardianLinksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := GuardianLinks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGuardianLinksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GuardianLinkSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGuardianLinksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := GuardianLinkExists(ctx, tx, o.GuardianID, o.StudentID)
+	if err != nil {
+		t.Errorf("Unable to check if GuardianLink exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected GuardianLinkExists to return true, but got false.")
+	}
+}
+
+func testGuardianLinksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	guardianLinkFound, err := FindGuardianLink(ctx, tx, o.GuardianID, o.StudentID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if guardianLinkFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testGuardianLinksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = GuardianLinks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGuardianLinksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := GuardianLinks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testGuardianLinksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	guardianLinkOne := &GuardianLink{}
+	guardianLinkTwo := &GuardianLink{}
+	if err = randomize.Struct(seed, guardianLinkOne, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+	if err = randomize.Struct(seed, guardianLinkTwo, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = guardianLinkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = guardianLinkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := GuardianLinks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testGuardianLinksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	guardianLinkOne := &GuardianLink{}
+	guardianLinkTwo := &GuardianLink{}
+	if err = randomize.Struct(seed, guardianLinkOne, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+	if err = randomize.Struct(seed, guardianLinkTwo, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = guardianLinkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = guardianLinkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testGuardianLinksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGuardianLinksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(guardianLinkColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGuardianLinkToOneUserUsingGuardian(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local GuardianLink
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.GuardianID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Guardian().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := GuardianLinkSlice{&local}
+	if err = local.L.LoadGuardian(ctx, tx, false, (*[]*GuardianLink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Guardian == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Guardian = nil
+	if err = local.L.LoadGuardian(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Guardian == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testGuardianLinkToOneUserUsingStudent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local GuardianLink
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, guardianLinkDBTypes, false, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.StudentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Student().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := GuardianLinkSlice{&local}
+	if err = local.L.LoadStudent(ctx, tx, false, (*[]*GuardianLink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Student = nil
+	if err = local.L.LoadStudent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testGuardianLinkToOneSetOpUserUsingGuardian(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a GuardianLink
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, guardianLinkDBTypes, false, strmangle.SetComplement(guardianLinkPrimaryKeyColumns, guardianLinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetGuardian(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Guardian != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.GuardianGuardianLinks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.GuardianID != x.ID {
+			t.Error("foreign key was wrong value", a.GuardianID)
+		}
+
+		if exists, err := GuardianLinkExists(ctx, tx, a.GuardianID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testGuardianLinkToOneSetOpUserUsingStudent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a GuardianLink
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, guardianLinkDBTypes, false, strmangle.SetComplement(guardianLinkPrimaryKeyColumns, guardianLinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetStudent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Student != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.StudentGuardianLinks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.StudentID != x.ID {
+			t.Error("foreign key was wrong value", a.StudentID)
+		}
+
+		if exists, err := GuardianLinkExists(ctx, tx, a.GuardianID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testGuardianLinksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGuardianLinksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GuardianLinkSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGuardianLinksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := GuardianLinks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	guardianLinkDBTypes = map[string]string{`GuardianID`: `uuid`, `StudentID`: `uuid`, `Relationship`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                   = bytes.MinRead
+)
+
+func testGuardianLinksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(guardianLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(guardianLinkAllColumns) == len(guardianLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testGuardianLinksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(guardianLinkAllColumns) == len(guardianLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GuardianLink{}
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, guardianLinkDBTypes, true, guardianLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(guardianLinkAllColumns, guardianLinkPrimaryKeyColumns) {
+		fields = guardianLinkAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			guardianLinkAllColumns,
+			guardianLinkPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := GuardianLinkSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testGuardianLinksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(guardianLinkAllColumns) == len(guardianLinkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := GuardianLink{}
+	if err = randomize.Struct(seed, &o, guardianLinkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert GuardianLink: %s", err)
+	}
+
+	count, err := GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, guardianLinkDBTypes, false, guardianLinkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GuardianLink struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert GuardianLink: %s", err)
+	}
+
+	count, err = GuardianLinks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
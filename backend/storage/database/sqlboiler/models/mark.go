@@ -0,0 +1,1121 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Mark is an object representing the database table.
+type Mark struct {
+	AssessmentID   string       `boil:"assessment_id" json:"assessment_id" toml:"assessment_id" yaml:"assessment_id"`
+	StudentID      string       `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	Score          float64      `boil:"score" json:"score" toml:"score" yaml:"score"`
+	PublishedScore null.Float64 `boil:"published_score" json:"published_score,omitempty" toml:"published_score" yaml:"published_score,omitempty"`
+	CreatedAt      null.Time    `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *markR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L markL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarkColumns = struct {
+	AssessmentID   string
+	StudentID      string
+	Score          string
+	PublishedScore string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	AssessmentID:   "assessment_id",
+	StudentID:      "student_id",
+	Score:          "score",
+	PublishedScore: "published_score",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+// Generated where
+
+var MarkWhere = struct {
+	AssessmentID   whereHelperstring
+	StudentID      whereHelperstring
+	Score          whereHelperfloat64
+	PublishedScore whereHelpernull_Float64
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	AssessmentID:   whereHelperstring{field: "\"mark\".\"assessment_id\""},
+	StudentID:      whereHelperstring{field: "\"mark\".\"student_id\""},
+	Score:          whereHelperfloat64{field: "\"mark\".\"score\""},
+	PublishedScore: whereHelpernull_Float64{field: "\"mark\".\"published_score\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"mark\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"mark\".\"updated_at\""},
+}
+
+// MarkRels is where relationship names are stored.
+var MarkRels = struct {
+	Assessment string
+	Student    string
+}{
+	Assessment: "Assessment",
+	Student:    "Student",
+}
+
+// markR is where relationships are stored.
+type markR struct {
+	Assessment *Assessment `boil:"Assessment" json:"Assessment" toml:"Assessment" yaml:"Assessment"`
+	Student    *User       `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*markR) NewStruct() *markR {
+	return &markR{}
+}
+
+// markL is where Load methods for each relationship are stored.
+type markL struct{}
+
+var (
+	markAllColumns            = []string{"assessment_id", "student_id", "score", "published_score", "created_at", "updated_at"}
+	markColumnsWithoutDefault = []string{"assessment_id", "student_id", "score", "published_score", "created_at", "updated_at"}
+	markColumnsWithDefault    = []string{}
+	markPrimaryKeyColumns     = []string{"assessment_id", "student_id"}
+)
+
+type (
+	// MarkSlice is an alias for a slice of pointers to Mark.
+	// This should generally be used opposed to []Mark.
+	MarkSlice []*Mark
+
+	markQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	markType                 = reflect.TypeOf(&Mark{})
+	markMapping              = queries.MakeStructMapping(markType)
+	markPrimaryKeyMapping, _ = queries.BindMapping(markType, markMapping, markPrimaryKeyColumns)
+	markInsertCacheMut       sync.RWMutex
+	markInsertCache          = make(map[string]insertCache)
+	markUpdateCacheMut       sync.RWMutex
+	markUpdateCache          = make(map[string]updateCache)
+	markUpsertCacheMut       sync.RWMutex
+	markUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single mark record from the query using the global executor.
+func (q markQuery) OneG(ctx context.Context) (*Mark, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single mark record from the query.
+func (q markQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Mark, error) {
+	o := &Mark{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mark")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Mark records from the query using the global executor.
+func (q markQuery) AllG(ctx context.Context) (MarkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Mark records from the query.
+func (q markQuery) All(ctx context.Context, exec boil.ContextExecutor) (MarkSlice, error) {
+	var o []*Mark
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Mark slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Mark records in the query, and panics on error.
+func (q markQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Mark records in the query.
+func (q markQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mark rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q markQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q markQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mark exists")
+	}
+
+	return count > 0, nil
+}
+
+// Assessment pointed to by the foreign key.
+func (o *Mark) Assessment(mods ...qm.QueryMod) assessmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssessmentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Assessments(queryMods...)
+	queries.SetFrom(query.Query, "\"assessment\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *Mark) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadAssessment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markL) LoadAssessment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMark interface{}, mods queries.Applicator) error {
+	var slice []*Mark
+	var object *Mark
+
+	if singular {
+		object = maybeMark.(*Mark)
+	} else {
+		slice = *maybeMark.(*[]*Mark)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markR{}
+		}
+		args = append(args, object.AssessmentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AssessmentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AssessmentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`assessment`),
+		qm.WhereIn(`assessment.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Assessment")
+	}
+
+	var resultSlice []*Assessment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Assessment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for assessment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assessment")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Assessment = foreign
+		if foreign.R == nil {
+			foreign.R = &assessmentR{}
+		}
+		foreign.R.Marks = append(foreign.R.Marks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AssessmentID == foreign.ID {
+				local.R.Assessment = foreign
+				if foreign.R == nil {
+					foreign.R = &assessmentR{}
+				}
+				foreign.R.Marks = append(foreign.R.Marks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMark interface{}, mods queries.Applicator) error {
+	var slice []*Mark
+	var object *Mark
+
+	if singular {
+		object = maybeMark.(*Mark)
+	} else {
+		slice = *maybeMark.(*[]*Mark)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentMarks = append(foreign.R.StudentMarks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentMarks = append(foreign.R.StudentMarks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAssessmentG of the mark to the related item.
+// Sets o.R.Assessment to related.
+// Adds o to related.R.Marks.
+// Uses the global database handle.
+func (o *Mark) SetAssessmentG(ctx context.Context, insert bool, related *Assessment) error {
+	return o.SetAssessment(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAssessment of the mark to the related item.
+// Sets o.R.Assessment to related.
+// Adds o to related.R.Marks.
+func (o *Mark) SetAssessment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Assessment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"assessment_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AssessmentID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AssessmentID = related.ID
+	if o.R == nil {
+		o.R = &markR{
+			Assessment: related,
+		}
+	} else {
+		o.R.Assessment = related
+	}
+
+	if related.R == nil {
+		related.R = &assessmentR{
+			Marks: MarkSlice{o},
+		}
+	} else {
+		related.R.Marks = append(related.R.Marks, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the mark to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentMarks.
+// Uses the global database handle.
+func (o *Mark) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the mark to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentMarks.
+func (o *Mark) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AssessmentID, o.StudentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &markR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentMarks: MarkSlice{o},
+		}
+	} else {
+		related.R.StudentMarks = append(related.R.StudentMarks, o)
+	}
+
+	return nil
+}
+
+// Marks retrieves all the records using an executor.
+func Marks(mods ...qm.QueryMod) markQuery {
+	mods = append(mods, qm.From("\"mark\""))
+	return markQuery{NewQuery(mods...)}
+}
+
+// FindMarkG retrieves a single record by ID.
+func FindMarkG(ctx context.Context, assessmentID string, studentID string, selectCols ...string) (*Mark, error) {
+	return FindMark(ctx, boil.GetContextDB(), assessmentID, studentID, selectCols...)
+}
+
+// FindMark retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMark(ctx context.Context, exec boil.ContextExecutor, assessmentID string, studentID string, selectCols ...string) (*Mark, error) {
+	markObj := &Mark{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mark\" where \"assessment_id\"=$1 AND \"student_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, assessmentID, studentID)
+
+	err := q.Bind(ctx, exec, markObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mark")
+	}
+
+	return markObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Mark) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Mark) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	markInsertCacheMut.RLock()
+	cache, cached := markInsertCache[key]
+	markInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			markAllColumns,
+			markColumnsWithDefault,
+			markColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(markType, markMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(markType, markMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mark\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mark\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mark")
+	}
+
+	if !cached {
+		markInsertCacheMut.Lock()
+		markInsertCache[key] = cache
+		markInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Mark record using the global executor.
+// See Update for more documentation.
+func (o *Mark) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Mark.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Mark) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	markUpdateCacheMut.RLock()
+	cache, cached := markUpdateCache[key]
+	markUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			markAllColumns,
+			markPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mark, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mark\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, markPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(markType, markMapping, append(wl, markPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mark row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mark")
+	}
+
+	if !cached {
+		markUpdateCacheMut.Lock()
+		markUpdateCache[key] = cache
+		markUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q markQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q markQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mark")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MarkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, markPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mark")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Mark) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Mark) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	markUpsertCacheMut.RLock()
+	cache, cached := markUpsertCache[key]
+	markUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			markAllColumns,
+			markColumnsWithDefault,
+			markColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			markAllColumns,
+			markPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert mark, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(markPrimaryKeyColumns))
+			copy(conflict, markPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mark\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(markType, markMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(markType, markMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert mark")
+	}
+
+	if !cached {
+		markUpsertCacheMut.Lock()
+		markUpsertCache[key] = cache
+		markUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Mark record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Mark) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Mark record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Mark) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Mark provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), markPrimaryKeyMapping)
+	sql := "DELETE FROM \"mark\" WHERE \"assessment_id\"=$1 AND \"student_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mark")
+	}
+
+	return rowsAff, nil
+}
+
+func (q markQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q markQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no markQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MarkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Mark) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Mark provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Mark) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMark(ctx, exec, o.AssessmentID, o.StudentID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty MarkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mark\".* FROM \"mark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MarkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarkExistsG checks if the Mark row exists.
+func MarkExistsG(ctx context.Context, assessmentID string, studentID string) (bool, error) {
+	return MarkExists(ctx, boil.GetContextDB(), assessmentID, studentID)
+}
+
+// MarkExists checks if the Mark row exists.
+func MarkExists(ctx context.Context, exec boil.ContextExecutor, assessmentID string, studentID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mark\" where \"assessment_id\"=$1 AND \"student_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, assessmentID, studentID)
+	}
+	row := exec.QueryRowContext(ctx, sql, assessmentID, studentID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mark exists")
+	}
+
+	return exists, nil
+}
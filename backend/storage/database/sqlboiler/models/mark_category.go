@@ -0,0 +1,1303 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MarkCategory is an object representing the database table.
+type MarkCategory struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CourseID  string    `boil:"course_id" json:"course_id" toml:"course_id" yaml:"course_id"`
+	TermID    string    `boil:"term_id" json:"term_id" toml:"term_id" yaml:"term_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Weight    float64   `boil:"weight" json:"weight" toml:"weight" yaml:"weight"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *markCategoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L markCategoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarkCategoryColumns = struct {
+	ID        string
+	CourseID  string
+	TermID    string
+	Name      string
+	Weight    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	CourseID:  "course_id",
+	TermID:    "term_id",
+	Name:      "name",
+	Weight:    "weight",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var MarkCategoryWhere = struct {
+	ID        whereHelperstring
+	CourseID  whereHelperstring
+	TermID    whereHelperstring
+	Name      whereHelperstring
+	Weight    whereHelperfloat64
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"mark_category\".\"id\""},
+	CourseID:  whereHelperstring{field: "\"mark_category\".\"course_id\""},
+	TermID:    whereHelperstring{field: "\"mark_category\".\"term_id\""},
+	Name:      whereHelperstring{field: "\"mark_category\".\"name\""},
+	Weight:    whereHelperfloat64{field: "\"mark_category\".\"weight\""},
+	CreatedAt: whereHelpernull_Time{field: "\"mark_category\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"mark_category\".\"updated_at\""},
+}
+
+// MarkCategoryRels is where relationship names are stored.
+var MarkCategoryRels = struct {
+	Course              string
+	Term                string
+	CategoryAssessments string
+}{
+	Course:              "Course",
+	Term:                "Term",
+	CategoryAssessments: "CategoryAssessments",
+}
+
+// markCategoryR is where relationships are stored.
+type markCategoryR struct {
+	Course              *Course         `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	Term                *Term           `boil:"Term" json:"Term" toml:"Term" yaml:"Term"`
+	CategoryAssessments AssessmentSlice `boil:"CategoryAssessments" json:"CategoryAssessments" toml:"CategoryAssessments" yaml:"CategoryAssessments"`
+}
+
+// NewStruct creates a new relationship struct
+func (*markCategoryR) NewStruct() *markCategoryR {
+	return &markCategoryR{}
+}
+
+// markCategoryL is where Load methods for each relationship are stored.
+type markCategoryL struct{}
+
+var (
+	markCategoryAllColumns            = []string{"id", "course_id", "term_id", "name", "weight", "created_at", "updated_at"}
+	markCategoryColumnsWithoutDefault = []string{"id", "course_id", "term_id", "name", "weight", "created_at", "updated_at"}
+	markCategoryColumnsWithDefault    = []string{}
+	markCategoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// MarkCategorySlice is an alias for a slice of pointers to MarkCategory.
+	// This should generally be used opposed to []MarkCategory.
+	MarkCategorySlice []*MarkCategory
+
+	markCategoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	markCategoryType                 = reflect.TypeOf(&MarkCategory{})
+	markCategoryMapping              = queries.MakeStructMapping(markCategoryType)
+	markCategoryPrimaryKeyMapping, _ = queries.BindMapping(markCategoryType, markCategoryMapping, markCategoryPrimaryKeyColumns)
+	markCategoryInsertCacheMut       sync.RWMutex
+	markCategoryInsertCache          = make(map[string]insertCache)
+	markCategoryUpdateCacheMut       sync.RWMutex
+	markCategoryUpdateCache          = make(map[string]updateCache)
+	markCategoryUpsertCacheMut       sync.RWMutex
+	markCategoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single markCategory record from the query using the global executor.
+func (q markCategoryQuery) OneG(ctx context.Context) (*MarkCategory, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single markCategory record from the query.
+func (q markCategoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MarkCategory, error) {
+	o := &MarkCategory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mark_category")
+	}
+
+	return o, nil
+}
+
+// AllG returns all MarkCategory records from the query using the global executor.
+func (q markCategoryQuery) AllG(ctx context.Context) (MarkCategorySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MarkCategory records from the query.
+func (q markCategoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (MarkCategorySlice, error) {
+	var o []*MarkCategory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MarkCategory slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MarkCategory records in the query, and panics on error.
+func (q markCategoryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MarkCategory records in the query.
+func (q markCategoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mark_category rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q markCategoryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q markCategoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mark_category exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *MarkCategory) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// Term pointed to by the foreign key.
+func (o *MarkCategory) Term(mods ...qm.QueryMod) termQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TermID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Terms(queryMods...)
+	queries.SetFrom(query.Query, "\"term\"")
+
+	return query
+}
+
+// CategoryAssessments retrieves all the assessment's Assessments with an executor via category_id column.
+func (o *MarkCategory) CategoryAssessments(mods ...qm.QueryMod) assessmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"assessment\".\"category_id\"=?", o.ID),
+	)
+
+	query := Assessments(queryMods...)
+	queries.SetFrom(query.Query, "\"assessment\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"assessment\".*"})
+	}
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markCategoryL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMarkCategory interface{}, mods queries.Applicator) error {
+	var slice []*MarkCategory
+	var object *MarkCategory
+
+	if singular {
+		object = maybeMarkCategory.(*MarkCategory)
+	} else {
+		slice = *maybeMarkCategory.(*[]*MarkCategory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markCategoryR{}
+		}
+		args = append(args, object.CourseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markCategoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.MarkCategories = append(foreign.R.MarkCategories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseID == foreign.ID {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.MarkCategories = append(foreign.R.MarkCategories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTerm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markCategoryL) LoadTerm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMarkCategory interface{}, mods queries.Applicator) error {
+	var slice []*MarkCategory
+	var object *MarkCategory
+
+	if singular {
+		object = maybeMarkCategory.(*MarkCategory)
+	} else {
+		slice = *maybeMarkCategory.(*[]*MarkCategory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markCategoryR{}
+		}
+		args = append(args, object.TermID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markCategoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TermID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TermID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`term`),
+		qm.WhereIn(`term.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Term")
+	}
+
+	var resultSlice []*Term
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Term")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for term")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for term")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Term = foreign
+		if foreign.R == nil {
+			foreign.R = &termR{}
+		}
+		foreign.R.MarkCategories = append(foreign.R.MarkCategories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TermID == foreign.ID {
+				local.R.Term = foreign
+				if foreign.R == nil {
+					foreign.R = &termR{}
+				}
+				foreign.R.MarkCategories = append(foreign.R.MarkCategories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategoryAssessments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (markCategoryL) LoadCategoryAssessments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMarkCategory interface{}, mods queries.Applicator) error {
+	var slice []*MarkCategory
+	var object *MarkCategory
+
+	if singular {
+		object = maybeMarkCategory.(*MarkCategory)
+	} else {
+		slice = *maybeMarkCategory.(*[]*MarkCategory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markCategoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markCategoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`assessment`),
+		qm.WhereIn(`assessment.category_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load assessment")
+	}
+
+	var resultSlice []*Assessment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice assessment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on assessment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assessment")
+	}
+
+	if singular {
+		object.R.CategoryAssessments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assessmentR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CategoryID {
+				local.R.CategoryAssessments = append(local.R.CategoryAssessments, foreign)
+				if foreign.R == nil {
+					foreign.R = &assessmentR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the markCategory to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.MarkCategories.
+// Uses the global database handle.
+func (o *MarkCategory) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the markCategory to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.MarkCategories.
+func (o *MarkCategory) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark_category\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markCategoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseID = related.ID
+	if o.R == nil {
+		o.R = &markCategoryR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			MarkCategories: MarkCategorySlice{o},
+		}
+	} else {
+		related.R.MarkCategories = append(related.R.MarkCategories, o)
+	}
+
+	return nil
+}
+
+// SetTermG of the markCategory to the related item.
+// Sets o.R.Term to related.
+// Adds o to related.R.MarkCategories.
+// Uses the global database handle.
+func (o *MarkCategory) SetTermG(ctx context.Context, insert bool, related *Term) error {
+	return o.SetTerm(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTerm of the markCategory to the related item.
+// Sets o.R.Term to related.
+// Adds o to related.R.MarkCategories.
+func (o *MarkCategory) SetTerm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Term) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark_category\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"term_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markCategoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TermID = related.ID
+	if o.R == nil {
+		o.R = &markCategoryR{
+			Term: related,
+		}
+	} else {
+		o.R.Term = related
+	}
+
+	if related.R == nil {
+		related.R = &termR{
+			MarkCategories: MarkCategorySlice{o},
+		}
+	} else {
+		related.R.MarkCategories = append(related.R.MarkCategories, o)
+	}
+
+	return nil
+}
+
+// AddCategoryAssessmentsG adds the given related objects to the existing relationships
+// of the mark_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryAssessments.
+// Sets related.R.Category appropriately.
+// Uses the global database handle.
+func (o *MarkCategory) AddCategoryAssessmentsG(ctx context.Context, insert bool, related ...*Assessment) error {
+	return o.AddCategoryAssessments(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCategoryAssessments adds the given related objects to the existing relationships
+// of the mark_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryAssessments.
+// Sets related.R.Category appropriately.
+func (o *MarkCategory) AddCategoryAssessments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Assessment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CategoryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"assessment\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assessmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CategoryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &markCategoryR{
+			CategoryAssessments: related,
+		}
+	} else {
+		o.R.CategoryAssessments = append(o.R.CategoryAssessments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assessmentR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
+// MarkCategories retrieves all the records using an executor.
+func MarkCategories(mods ...qm.QueryMod) markCategoryQuery {
+	mods = append(mods, qm.From("\"mark_category\""))
+	return markCategoryQuery{NewQuery(mods...)}
+}
+
+// FindMarkCategoryG retrieves a single record by ID.
+func FindMarkCategoryG(ctx context.Context, iD string, selectCols ...string) (*MarkCategory, error) {
+	return FindMarkCategory(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMarkCategory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMarkCategory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*MarkCategory, error) {
+	markCategoryObj := &MarkCategory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mark_category\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, markCategoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mark_category")
+	}
+
+	return markCategoryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MarkCategory) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MarkCategory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark_category provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markCategoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	markCategoryInsertCacheMut.RLock()
+	cache, cached := markCategoryInsertCache[key]
+	markCategoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			markCategoryAllColumns,
+			markCategoryColumnsWithDefault,
+			markCategoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(markCategoryType, markCategoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(markCategoryType, markCategoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mark_category\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mark_category\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mark_category")
+	}
+
+	if !cached {
+		markCategoryInsertCacheMut.Lock()
+		markCategoryInsertCache[key] = cache
+		markCategoryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single MarkCategory record using the global executor.
+// See Update for more documentation.
+func (o *MarkCategory) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MarkCategory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MarkCategory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	markCategoryUpdateCacheMut.RLock()
+	cache, cached := markCategoryUpdateCache[key]
+	markCategoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			markCategoryAllColumns,
+			markCategoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mark_category, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mark_category\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, markCategoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(markCategoryType, markCategoryMapping, append(wl, markCategoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mark_category row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mark_category")
+	}
+
+	if !cached {
+		markCategoryUpdateCacheMut.Lock()
+		markCategoryUpdateCache[key] = cache
+		markCategoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q markCategoryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q markCategoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mark_category")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mark_category")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MarkCategorySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarkCategorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markCategoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mark_category\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, markCategoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in markCategory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all markCategory")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MarkCategory) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MarkCategory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark_category provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markCategoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	markCategoryUpsertCacheMut.RLock()
+	cache, cached := markCategoryUpsertCache[key]
+	markCategoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			markCategoryAllColumns,
+			markCategoryColumnsWithDefault,
+			markCategoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			markCategoryAllColumns,
+			markCategoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert mark_category, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(markCategoryPrimaryKeyColumns))
+			copy(conflict, markCategoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mark_category\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(markCategoryType, markCategoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(markCategoryType, markCategoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert mark_category")
+	}
+
+	if !cached {
+		markCategoryUpsertCacheMut.Lock()
+		markCategoryUpsertCache[key] = cache
+		markCategoryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single MarkCategory record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MarkCategory) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MarkCategory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MarkCategory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MarkCategory provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), markCategoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"mark_category\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mark_category")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mark_category")
+	}
+
+	return rowsAff, nil
+}
+
+func (q markCategoryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q markCategoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no markCategoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mark_category")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark_category")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MarkCategorySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarkCategorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markCategoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mark_category\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markCategoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from markCategory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark_category")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MarkCategory) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no MarkCategory provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MarkCategory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMarkCategory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkCategorySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty MarkCategorySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkCategorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarkCategorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markCategoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mark_category\".* FROM \"mark_category\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markCategoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MarkCategorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarkCategoryExistsG checks if the MarkCategory row exists.
+func MarkCategoryExistsG(ctx context.Context, iD string) (bool, error) {
+	return MarkCategoryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MarkCategoryExists checks if the MarkCategory row exists.
+func MarkCategoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mark_category\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mark_category exists")
+	}
+
+	return exists, nil
+}
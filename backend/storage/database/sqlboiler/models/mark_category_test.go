@@ -0,0 +1,961 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMarkCategories(t *testing.T) {
+	t.Parallel()
+
+	query := MarkCategories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMarkCategoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkCategoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MarkCategories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkCategoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkCategorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkCategoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MarkCategoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MarkCategory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MarkCategoryExists to return true, but got false.")
+	}
+}
+
+func testMarkCategoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	markCategoryFound, err := FindMarkCategory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if markCategoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMarkCategoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MarkCategories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkCategoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MarkCategories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMarkCategoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	markCategoryOne := &MarkCategory{}
+	markCategoryTwo := &MarkCategory{}
+	if err = randomize.Struct(seed, markCategoryOne, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markCategoryTwo, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markCategoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markCategoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MarkCategories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMarkCategoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	markCategoryOne := &MarkCategory{}
+	markCategoryTwo := &MarkCategory{}
+	if err = randomize.Struct(seed, markCategoryOne, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markCategoryTwo, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markCategoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markCategoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testMarkCategoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarkCategoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(markCategoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarkCategoryToManyCategoryAssessments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkCategory
+	var b, c Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.CategoryID = a.ID
+	c.CategoryID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CategoryAssessments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.CategoryID == b.CategoryID {
+			bFound = true
+		}
+		if v.CategoryID == c.CategoryID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := MarkCategorySlice{&a}
+	if err = a.L.LoadCategoryAssessments(ctx, tx, false, (*[]*MarkCategory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CategoryAssessments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CategoryAssessments = nil
+	if err = a.L.LoadCategoryAssessments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CategoryAssessments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testMarkCategoryToManyAddOpCategoryAssessments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkCategory
+	var b, c, d, e Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Assessment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Assessment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCategoryAssessments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.CategoryID {
+			t.Error("foreign key was wrong value", a.ID, first.CategoryID)
+		}
+		if a.ID != second.CategoryID {
+			t.Error("foreign key was wrong value", a.ID, second.CategoryID)
+		}
+
+		if first.R.Category != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Category != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CategoryAssessments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CategoryAssessments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CategoryAssessments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testMarkCategoryToOneCourseUsingCourse(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MarkCategory
+	var foreign Course
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, courseDBTypes, false, courseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Course struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CourseID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Course().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkCategorySlice{&local}
+	if err = local.L.LoadCourse(ctx, tx, false, (*[]*MarkCategory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Course = nil
+	if err = local.L.LoadCourse(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Course == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkCategoryToOneTermUsingTerm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MarkCategory
+	var foreign Term
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markCategoryDBTypes, false, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, termDBTypes, false, termColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Term struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TermID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Term().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkCategorySlice{&local}
+	if err = local.L.LoadTerm(ctx, tx, false, (*[]*MarkCategory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Term == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Term = nil
+	if err = local.L.LoadTerm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Term == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkCategoryToOneSetOpCourseUsingCourse(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkCategory
+	var b, c Course
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, courseDBTypes, false, strmangle.SetComplement(coursePrimaryKeyColumns, courseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Course{&b, &c} {
+		err = a.SetCourse(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Course != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MarkCategories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CourseID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CourseID))
+		reflect.Indirect(reflect.ValueOf(&a.CourseID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CourseID != x.ID {
+			t.Error("foreign key was wrong value", a.CourseID, x.ID)
+		}
+	}
+}
+func testMarkCategoryToOneSetOpTermUsingTerm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkCategory
+	var b, c Term
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markCategoryDBTypes, false, strmangle.SetComplement(markCategoryPrimaryKeyColumns, markCategoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, termDBTypes, false, strmangle.SetComplement(termPrimaryKeyColumns, termColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, termDBTypes, false, strmangle.SetComplement(termPrimaryKeyColumns, termColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Term{&b, &c} {
+		err = a.SetTerm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Term != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MarkCategories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TermID != x.ID {
+			t.Error("foreign key was wrong value", a.TermID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TermID))
+		reflect.Indirect(reflect.ValueOf(&a.TermID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TermID != x.ID {
+			t.Error("foreign key was wrong value", a.TermID, x.ID)
+		}
+	}
+}
+
+func testMarkCategoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkCategoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkCategorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkCategoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MarkCategories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	markCategoryDBTypes = map[string]string{`ID`: `uuid`, `CourseID`: `uuid`, `TermID`: `uuid`, `Name`: `character varying`, `Weight`: `double precision`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                   = bytes.MinRead
+)
+
+func testMarkCategoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(markCategoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(markCategoryAllColumns) == len(markCategoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMarkCategoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(markCategoryAllColumns) == len(markCategoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkCategory{}
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markCategoryDBTypes, true, markCategoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(markCategoryAllColumns, markCategoryPrimaryKeyColumns) {
+		fields = markCategoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			markCategoryAllColumns,
+			markCategoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MarkCategorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMarkCategoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(markCategoryAllColumns) == len(markCategoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MarkCategory{}
+	if err = randomize.Struct(seed, &o, markCategoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MarkCategory: %s", err)
+	}
+
+	count, err := MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, markCategoryDBTypes, false, markCategoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkCategory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MarkCategory: %s", err)
+	}
+
+	count, err = MarkCategories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
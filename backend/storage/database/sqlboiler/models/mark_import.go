@@ -0,0 +1,1187 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// MarkImport is an object representing the database table.
+type MarkImport struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AssessmentID string      `boil:"assessment_id" json:"assessment_id" toml:"assessment_id" yaml:"assessment_id"`
+	AuthorID     null.String `boil:"author_id" json:"author_id,omitempty" toml:"author_id" yaml:"author_id,omitempty"`
+	Filename     string      `boil:"filename" json:"filename" toml:"filename" yaml:"filename"`
+	Status       string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Entries      types.JSON  `boil:"entries" json:"entries" toml:"entries" yaml:"entries"`
+	AppliedAt    null.Time   `boil:"applied_at" json:"applied_at,omitempty" toml:"applied_at" yaml:"applied_at,omitempty"`
+	UndoneAt     null.Time   `boil:"undone_at" json:"undone_at,omitempty" toml:"undone_at" yaml:"undone_at,omitempty"`
+	CreatedAt    null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt    null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *markImportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L markImportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarkImportColumns = struct {
+	ID           string
+	AssessmentID string
+	AuthorID     string
+	Filename     string
+	Status       string
+	Entries      string
+	AppliedAt    string
+	UndoneAt     string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	AssessmentID: "assessment_id",
+	AuthorID:     "author_id",
+	Filename:     "filename",
+	Status:       "status",
+	Entries:      "entries",
+	AppliedAt:    "applied_at",
+	UndoneAt:     "undone_at",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+// Generated where
+
+var MarkImportWhere = struct {
+	ID           whereHelperstring
+	AssessmentID whereHelperstring
+	AuthorID     whereHelpernull_String
+	Filename     whereHelperstring
+	Status       whereHelperstring
+	Entries      whereHelpertypes_JSON
+	AppliedAt    whereHelpernull_Time
+	UndoneAt     whereHelpernull_Time
+	CreatedAt    whereHelpernull_Time
+	UpdatedAt    whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"mark_import\".\"id\""},
+	AssessmentID: whereHelperstring{field: "\"mark_import\".\"assessment_id\""},
+	AuthorID:     whereHelpernull_String{field: "\"mark_import\".\"author_id\""},
+	Filename:     whereHelperstring{field: "\"mark_import\".\"filename\""},
+	Status:       whereHelperstring{field: "\"mark_import\".\"status\""},
+	Entries:      whereHelpertypes_JSON{field: "\"mark_import\".\"entries\""},
+	AppliedAt:    whereHelpernull_Time{field: "\"mark_import\".\"applied_at\""},
+	UndoneAt:     whereHelpernull_Time{field: "\"mark_import\".\"undone_at\""},
+	CreatedAt:    whereHelpernull_Time{field: "\"mark_import\".\"created_at\""},
+	UpdatedAt:    whereHelpernull_Time{field: "\"mark_import\".\"updated_at\""},
+}
+
+// MarkImportRels is where relationship names are stored.
+var MarkImportRels = struct {
+	Assessment string
+	Author     string
+}{
+	Assessment: "Assessment",
+	Author:     "Author",
+}
+
+// markImportR is where relationships are stored.
+type markImportR struct {
+	Assessment *Assessment `boil:"Assessment" json:"Assessment" toml:"Assessment" yaml:"Assessment"`
+	Author     *User       `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+}
+
+// NewStruct creates a new relationship struct
+func (*markImportR) NewStruct() *markImportR {
+	return &markImportR{}
+}
+
+// markImportL is where Load methods for each relationship are stored.
+type markImportL struct{}
+
+var (
+	markImportAllColumns            = []string{"id", "assessment_id", "author_id", "filename", "status", "entries", "applied_at", "undone_at", "created_at", "updated_at"}
+	markImportColumnsWithoutDefault = []string{"id", "assessment_id", "author_id", "filename", "status", "entries", "applied_at", "undone_at", "created_at", "updated_at"}
+	markImportColumnsWithDefault    = []string{}
+	markImportPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// MarkImportSlice is an alias for a slice of pointers to MarkImport.
+	// This should generally be used opposed to []MarkImport.
+	MarkImportSlice []*MarkImport
+
+	markImportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	markImportType                 = reflect.TypeOf(&MarkImport{})
+	markImportMapping              = queries.MakeStructMapping(markImportType)
+	markImportPrimaryKeyMapping, _ = queries.BindMapping(markImportType, markImportMapping, markImportPrimaryKeyColumns)
+	markImportInsertCacheMut       sync.RWMutex
+	markImportInsertCache          = make(map[string]insertCache)
+	markImportUpdateCacheMut       sync.RWMutex
+	markImportUpdateCache          = make(map[string]updateCache)
+	markImportUpsertCacheMut       sync.RWMutex
+	markImportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single markImport record from the query using the global executor.
+func (q markImportQuery) OneG(ctx context.Context) (*MarkImport, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single markImport record from the query.
+func (q markImportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MarkImport, error) {
+	o := &MarkImport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mark_import")
+	}
+
+	return o, nil
+}
+
+// AllG returns all MarkImport records from the query using the global executor.
+func (q markImportQuery) AllG(ctx context.Context) (MarkImportSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MarkImport records from the query.
+func (q markImportQuery) All(ctx context.Context, exec boil.ContextExecutor) (MarkImportSlice, error) {
+	var o []*MarkImport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MarkImport slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MarkImport records in the query, and panics on error.
+func (q markImportQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MarkImport records in the query.
+func (q markImportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mark_import rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q markImportQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q markImportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mark_import exists")
+	}
+
+	return count > 0, nil
+}
+
+// Assessment pointed to by the foreign key.
+func (o *MarkImport) Assessment(mods ...qm.QueryMod) assessmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssessmentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Assessments(queryMods...)
+	queries.SetFrom(query.Query, "\"assessment\"")
+
+	return query
+}
+
+// Author pointed to by the foreign key.
+func (o *MarkImport) Author(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadAssessment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markImportL) LoadAssessment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMarkImport interface{}, mods queries.Applicator) error {
+	var slice []*MarkImport
+	var object *MarkImport
+
+	if singular {
+		object = maybeMarkImport.(*MarkImport)
+	} else {
+		slice = *maybeMarkImport.(*[]*MarkImport)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markImportR{}
+		}
+		args = append(args, object.AssessmentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markImportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AssessmentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AssessmentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`assessment`),
+		qm.WhereIn(`assessment.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Assessment")
+	}
+
+	var resultSlice []*Assessment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Assessment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for assessment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assessment")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Assessment = foreign
+		if foreign.R == nil {
+			foreign.R = &assessmentR{}
+		}
+		foreign.R.MarkImports = append(foreign.R.MarkImports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AssessmentID == foreign.ID {
+				local.R.Assessment = foreign
+				if foreign.R == nil {
+					foreign.R = &assessmentR{}
+				}
+				foreign.R.MarkImports = append(foreign.R.MarkImports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (markImportL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMarkImport interface{}, mods queries.Applicator) error {
+	var slice []*MarkImport
+	var object *MarkImport
+
+	if singular {
+		object = maybeMarkImport.(*MarkImport)
+	} else {
+		slice = *maybeMarkImport.(*[]*MarkImport)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &markImportR{}
+		}
+		if !queries.IsNil(object.AuthorID) {
+			args = append(args, object.AuthorID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &markImportR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.AuthorID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.AuthorID) {
+				args = append(args, obj.AuthorID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AuthorMarkImports = append(foreign.R.AuthorMarkImports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AuthorID, foreign.ID) {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AuthorMarkImports = append(foreign.R.AuthorMarkImports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAssessmentG of the markImport to the related item.
+// Sets o.R.Assessment to related.
+// Adds o to related.R.MarkImports.
+// Uses the global database handle.
+func (o *MarkImport) SetAssessmentG(ctx context.Context, insert bool, related *Assessment) error {
+	return o.SetAssessment(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAssessment of the markImport to the related item.
+// Sets o.R.Assessment to related.
+// Adds o to related.R.MarkImports.
+func (o *MarkImport) SetAssessment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Assessment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark_import\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"assessment_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markImportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AssessmentID = related.ID
+	if o.R == nil {
+		o.R = &markImportR{
+			Assessment: related,
+		}
+	} else {
+		o.R.Assessment = related
+	}
+
+	if related.R == nil {
+		related.R = &assessmentR{
+			MarkImports: MarkImportSlice{o},
+		}
+	} else {
+		related.R.MarkImports = append(related.R.MarkImports, o)
+	}
+
+	return nil
+}
+
+// SetAuthorG of the markImport to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorMarkImports.
+// Uses the global database handle.
+func (o *MarkImport) SetAuthorG(ctx context.Context, insert bool, related *User) error {
+	return o.SetAuthor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAuthor of the markImport to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorMarkImports.
+func (o *MarkImport) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mark_import\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+		strmangle.WhereClause("\"", "\"", 2, markImportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AuthorID, related.ID)
+	if o.R == nil {
+		o.R = &markImportR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AuthorMarkImports: MarkImportSlice{o},
+		}
+	} else {
+		related.R.AuthorMarkImports = append(related.R.AuthorMarkImports, o)
+	}
+
+	return nil
+}
+
+// RemoveAuthorG relationship.
+// Sets o.R.Author to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *MarkImport) RemoveAuthorG(ctx context.Context, related *User) error {
+	return o.RemoveAuthor(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveAuthor relationship.
+// Sets o.R.Author to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *MarkImport) RemoveAuthor(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AuthorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("author_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Author = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AuthorMarkImports {
+		if queries.Equal(o.AuthorID, ri.AuthorID) {
+			continue
+		}
+
+		ln := len(related.R.AuthorMarkImports)
+		if ln > 1 && i < ln-1 {
+			related.R.AuthorMarkImports[i] = related.R.AuthorMarkImports[ln-1]
+		}
+		related.R.AuthorMarkImports = related.R.AuthorMarkImports[:ln-1]
+		break
+	}
+	return nil
+}
+
+// MarkImports retrieves all the records using an executor.
+func MarkImports(mods ...qm.QueryMod) markImportQuery {
+	mods = append(mods, qm.From("\"mark_import\""))
+	return markImportQuery{NewQuery(mods...)}
+}
+
+// FindMarkImportG retrieves a single record by ID.
+func FindMarkImportG(ctx context.Context, iD string, selectCols ...string) (*MarkImport, error) {
+	return FindMarkImport(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMarkImport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMarkImport(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*MarkImport, error) {
+	markImportObj := &MarkImport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mark_import\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, markImportObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mark_import")
+	}
+
+	return markImportObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MarkImport) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MarkImport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark_import provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markImportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	markImportInsertCacheMut.RLock()
+	cache, cached := markImportInsertCache[key]
+	markImportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			markImportAllColumns,
+			markImportColumnsWithDefault,
+			markImportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(markImportType, markImportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(markImportType, markImportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mark_import\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mark_import\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mark_import")
+	}
+
+	if !cached {
+		markImportInsertCacheMut.Lock()
+		markImportInsertCache[key] = cache
+		markImportInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single MarkImport record using the global executor.
+// See Update for more documentation.
+func (o *MarkImport) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MarkImport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MarkImport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	markImportUpdateCacheMut.RLock()
+	cache, cached := markImportUpdateCache[key]
+	markImportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			markImportAllColumns,
+			markImportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mark_import, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mark_import\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, markImportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(markImportType, markImportMapping, append(wl, markImportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mark_import row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mark_import")
+	}
+
+	if !cached {
+		markImportUpdateCacheMut.Lock()
+		markImportUpdateCache[key] = cache
+		markImportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q markImportQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q markImportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mark_import")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mark_import")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MarkImportSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarkImportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mark_import\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, markImportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in markImport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all markImport")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MarkImport) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MarkImport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mark_import provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(markImportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	markImportUpsertCacheMut.RLock()
+	cache, cached := markImportUpsertCache[key]
+	markImportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			markImportAllColumns,
+			markImportColumnsWithDefault,
+			markImportColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			markImportAllColumns,
+			markImportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert mark_import, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(markImportPrimaryKeyColumns))
+			copy(conflict, markImportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mark_import\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(markImportType, markImportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(markImportType, markImportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert mark_import")
+	}
+
+	if !cached {
+		markImportUpsertCacheMut.Lock()
+		markImportUpsertCache[key] = cache
+		markImportUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single MarkImport record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MarkImport) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MarkImport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MarkImport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MarkImport provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), markImportPrimaryKeyMapping)
+	sql := "DELETE FROM \"mark_import\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mark_import")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mark_import")
+	}
+
+	return rowsAff, nil
+}
+
+func (q markImportQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q markImportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no markImportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mark_import")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark_import")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MarkImportSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarkImportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mark_import\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markImportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from markImport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mark_import")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MarkImport) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no MarkImport provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MarkImport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMarkImport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkImportSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty MarkImportSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarkImportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarkImportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), markImportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mark_import\".* FROM \"mark_import\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, markImportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MarkImportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarkImportExistsG checks if the MarkImport row exists.
+func MarkImportExistsG(ctx context.Context, iD string) (bool, error) {
+	return MarkImportExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MarkImportExists checks if the MarkImport row exists.
+func MarkImportExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mark_import\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mark_import exists")
+	}
+
+	return exists, nil
+}
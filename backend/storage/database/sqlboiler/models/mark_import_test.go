@@ -0,0 +1,859 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMarkImports(t *testing.T) {
+	t.Parallel()
+
+	query := MarkImports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMarkImportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkImportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MarkImports().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkImportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkImportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarkImportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MarkImportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MarkImport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MarkImportExists to return true, but got false.")
+	}
+}
+
+func testMarkImportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	markImportFound, err := FindMarkImport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if markImportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMarkImportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MarkImports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkImportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MarkImports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMarkImportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	markImportOne := &MarkImport{}
+	markImportTwo := &MarkImport{}
+	if err = randomize.Struct(seed, markImportOne, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markImportTwo, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markImportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markImportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MarkImports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMarkImportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	markImportOne := &MarkImport{}
+	markImportTwo := &MarkImport{}
+	if err = randomize.Struct(seed, markImportOne, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markImportTwo, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markImportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markImportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testMarkImportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarkImportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(markImportColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarkImportToOneAssessmentUsingAssessment(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MarkImport
+	var foreign Assessment
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markImportDBTypes, false, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AssessmentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Assessment().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkImportSlice{&local}
+	if err = local.L.LoadAssessment(ctx, tx, false, (*[]*MarkImport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Assessment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Assessment = nil
+	if err = local.L.LoadAssessment(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Assessment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkImportToOneUserUsingAuthor(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MarkImport
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AuthorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Author().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkImportSlice{&local}
+	if err = local.L.LoadAuthor(ctx, tx, false, (*[]*MarkImport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Author == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Author = nil
+	if err = local.L.LoadAuthor(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Author == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkImportToOneSetOpAssessmentUsingAssessment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkImport
+	var b, c Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markImportDBTypes, false, strmangle.SetComplement(markImportPrimaryKeyColumns, markImportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Assessment{&b, &c} {
+		err = a.SetAssessment(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Assessment != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MarkImports[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AssessmentID != x.ID {
+			t.Error("foreign key was wrong value", a.AssessmentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AssessmentID))
+		reflect.Indirect(reflect.ValueOf(&a.AssessmentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AssessmentID != x.ID {
+			t.Error("foreign key was wrong value", a.AssessmentID, x.ID)
+		}
+	}
+}
+func testMarkImportToOneSetOpUserUsingAuthor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkImport
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markImportDBTypes, false, strmangle.SetComplement(markImportPrimaryKeyColumns, markImportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetAuthor(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Author != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AuthorMarkImports[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AuthorID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AuthorID))
+		reflect.Indirect(reflect.ValueOf(&a.AuthorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AuthorID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorID, x.ID)
+		}
+	}
+}
+
+func testMarkImportToOneRemoveOpUserUsingAuthor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MarkImport
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markImportDBTypes, false, strmangle.SetComplement(markImportPrimaryKeyColumns, markImportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAuthor(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAuthor(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Author().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Author != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AuthorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AuthorMarkImports) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testMarkImportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkImportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkImportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarkImportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MarkImports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	markImportDBTypes = map[string]string{`ID`: `uuid`, `AssessmentID`: `uuid`, `AuthorID`: `uuid`, `Filename`: `character varying`, `Status`: `character varying`, `Entries`: `jsonb`, `AppliedAt`: `timestamp without time zone`, `UndoneAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testMarkImportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(markImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(markImportAllColumns) == len(markImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMarkImportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(markImportAllColumns) == len(markImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MarkImport{}
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markImportDBTypes, true, markImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(markImportAllColumns, markImportPrimaryKeyColumns) {
+		fields = markImportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			markImportAllColumns,
+			markImportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MarkImportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMarkImportsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(markImportAllColumns) == len(markImportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MarkImport{}
+	if err = randomize.Struct(seed, &o, markImportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MarkImport: %s", err)
+	}
+
+	count, err := MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, markImportDBTypes, false, markImportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MarkImport struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MarkImport: %s", err)
+	}
+
+	count, err = MarkImports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
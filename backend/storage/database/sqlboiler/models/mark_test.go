@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMarks(t *testing.T) {
+	t.Parallel()
+
+	query := Marks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMarksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Marks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMarksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MarkExists(ctx, tx, o.AssessmentID, o.StudentID)
+	if err != nil {
+		t.Errorf("Unable to check if Mark exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MarkExists to return true, but got false.")
+	}
+}
+
+func testMarksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	markFound, err := FindMark(ctx, tx, o.AssessmentID, o.StudentID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if markFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMarksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Marks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Marks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMarksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	markOne := &Mark{}
+	markTwo := &Mark{}
+	if err = randomize.Struct(seed, markOne, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markTwo, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Marks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMarksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	markOne := &Mark{}
+	markTwo := &Mark{}
+	if err = randomize.Struct(seed, markOne, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+	if err = randomize.Struct(seed, markTwo, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = markOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = markTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testMarksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(markColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMarkToOneAssessmentUsingAssessment(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Mark
+	var foreign Assessment
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, assessmentDBTypes, false, assessmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Assessment struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AssessmentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Assessment().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkSlice{&local}
+	if err = local.L.LoadAssessment(ctx, tx, false, (*[]*Mark)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Assessment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Assessment = nil
+	if err = local.L.LoadAssessment(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Assessment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkToOneUserUsingStudent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Mark
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, markDBTypes, false, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.StudentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Student().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MarkSlice{&local}
+	if err = local.L.LoadStudent(ctx, tx, false, (*[]*Mark)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Student = nil
+	if err = local.L.LoadStudent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Student == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMarkToOneSetOpAssessmentUsingAssessment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Mark
+	var b, c Assessment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markDBTypes, false, strmangle.SetComplement(markPrimaryKeyColumns, markColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, assessmentDBTypes, false, strmangle.SetComplement(assessmentPrimaryKeyColumns, assessmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Assessment{&b, &c} {
+		err = a.SetAssessment(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Assessment != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Marks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AssessmentID != x.ID {
+			t.Error("foreign key was wrong value", a.AssessmentID)
+		}
+
+		if exists, err := MarkExists(ctx, tx, a.AssessmentID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testMarkToOneSetOpUserUsingStudent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Mark
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, markDBTypes, false, strmangle.SetComplement(markPrimaryKeyColumns, markColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetStudent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Student != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.StudentMarks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.StudentID != x.ID {
+			t.Error("foreign key was wrong value", a.StudentID)
+		}
+
+		if exists, err := MarkExists(ctx, tx, a.AssessmentID, a.StudentID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testMarksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MarkSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMarksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Marks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	markDBTypes = map[string]string{`AssessmentID`: `uuid`, `StudentID`: `uuid`, `Score`: `double precision`, `PublishedScore`: `double precision`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_           = bytes.MinRead
+)
+
+func testMarksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(markPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(markAllColumns) == len(markPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markDBTypes, true, markPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMarksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(markAllColumns) == len(markPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Mark{}
+	if err = randomize.Struct(seed, o, markDBTypes, true, markColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, markDBTypes, true, markPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(markAllColumns, markPrimaryKeyColumns) {
+		fields = markAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			markAllColumns,
+			markPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MarkSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMarksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(markAllColumns) == len(markPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Mark{}
+	if err = randomize.Struct(seed, &o, markDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Mark: %s", err)
+	}
+
+	count, err := Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, markDBTypes, false, markPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Mark struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Mark: %s", err)
+	}
+
+	count, err = Marks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,958 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NotificationPreference is an object representing the database table.
+type NotificationPreference struct {
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Event     string    `boil:"event" json:"event" toml:"event" yaml:"event"`
+	InApp     bool      `boil:"in_app" json:"in_app" toml:"in_app" yaml:"in_app"`
+	Email     bool      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Push      bool      `boil:"push" json:"push" toml:"push" yaml:"push"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *notificationPreferenceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationPreferenceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationPreferenceColumns = struct {
+	UserID    string
+	Event     string
+	InApp     string
+	Email     string
+	Push      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	UserID:    "user_id",
+	Event:     "event",
+	InApp:     "in_app",
+	Email:     "email",
+	Push:      "push",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var NotificationPreferenceWhere = struct {
+	UserID    whereHelperstring
+	Event     whereHelperstring
+	InApp     whereHelperbool
+	Email     whereHelperbool
+	Push      whereHelperbool
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	UserID:    whereHelperstring{field: "\"notification_preference\".\"user_id\""},
+	Event:     whereHelperstring{field: "\"notification_preference\".\"event\""},
+	InApp:     whereHelperbool{field: "\"notification_preference\".\"in_app\""},
+	Email:     whereHelperbool{field: "\"notification_preference\".\"email\""},
+	Push:      whereHelperbool{field: "\"notification_preference\".\"push\""},
+	CreatedAt: whereHelpernull_Time{field: "\"notification_preference\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"notification_preference\".\"updated_at\""},
+}
+
+// NotificationPreferenceRels is where relationship names are stored.
+var NotificationPreferenceRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// notificationPreferenceR is where relationships are stored.
+type notificationPreferenceR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationPreferenceR) NewStruct() *notificationPreferenceR {
+	return &notificationPreferenceR{}
+}
+
+// notificationPreferenceL is where Load methods for each relationship are stored.
+type notificationPreferenceL struct{}
+
+var (
+	notificationPreferenceAllColumns            = []string{"user_id", "event", "in_app", "email", "push", "created_at", "updated_at"}
+	notificationPreferenceColumnsWithoutDefault = []string{"user_id", "event", "in_app", "email", "push", "created_at", "updated_at"}
+	notificationPreferenceColumnsWithDefault    = []string{}
+	notificationPreferencePrimaryKeyColumns     = []string{"user_id", "event"}
+)
+
+type (
+	// NotificationPreferenceSlice is an alias for a slice of pointers to NotificationPreference.
+	// This should generally be used opposed to []NotificationPreference.
+	NotificationPreferenceSlice []*NotificationPreference
+
+	notificationPreferenceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationPreferenceType                 = reflect.TypeOf(&NotificationPreference{})
+	notificationPreferenceMapping              = queries.MakeStructMapping(notificationPreferenceType)
+	notificationPreferencePrimaryKeyMapping, _ = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, notificationPreferencePrimaryKeyColumns)
+	notificationPreferenceInsertCacheMut       sync.RWMutex
+	notificationPreferenceInsertCache          = make(map[string]insertCache)
+	notificationPreferenceUpdateCacheMut       sync.RWMutex
+	notificationPreferenceUpdateCache          = make(map[string]updateCache)
+	notificationPreferenceUpsertCacheMut       sync.RWMutex
+	notificationPreferenceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single notificationPreference record from the query using the global executor.
+func (q notificationPreferenceQuery) OneG(ctx context.Context) (*NotificationPreference, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single notificationPreference record from the query.
+func (q notificationPreferenceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*NotificationPreference, error) {
+	o := &NotificationPreference{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for notification_preference")
+	}
+
+	return o, nil
+}
+
+// AllG returns all NotificationPreference records from the query using the global executor.
+func (q notificationPreferenceQuery) AllG(ctx context.Context) (NotificationPreferenceSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all NotificationPreference records from the query.
+func (q notificationPreferenceQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationPreferenceSlice, error) {
+	var o []*NotificationPreference
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to NotificationPreference slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all NotificationPreference records in the query, and panics on error.
+func (q notificationPreferenceQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all NotificationPreference records in the query.
+func (q notificationPreferenceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count notification_preference rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q notificationPreferenceQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationPreferenceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if notification_preference exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *NotificationPreference) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationPreferenceL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationPreference interface{}, mods queries.Applicator) error {
+	var slice []*NotificationPreference
+	var object *NotificationPreference
+
+	if singular {
+		object = maybeNotificationPreference.(*NotificationPreference)
+	} else {
+		slice = *maybeNotificationPreference.(*[]*NotificationPreference)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &notificationPreferenceR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationPreferenceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.NotificationPreferences = append(foreign.R.NotificationPreferences, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.NotificationPreferences = append(foreign.R.NotificationPreferences, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the notificationPreference to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.NotificationPreferences.
+// Uses the global database handle.
+func (o *NotificationPreference) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the notificationPreference to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.NotificationPreferences.
+func (o *NotificationPreference) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"notification_preference\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Event}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &notificationPreferenceR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			NotificationPreferences: NotificationPreferenceSlice{o},
+		}
+	} else {
+		related.R.NotificationPreferences = append(related.R.NotificationPreferences, o)
+	}
+
+	return nil
+}
+
+// NotificationPreferences retrieves all the records using an executor.
+func NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	mods = append(mods, qm.From("\"notification_preference\""))
+	return notificationPreferenceQuery{NewQuery(mods...)}
+}
+
+// FindNotificationPreferenceG retrieves a single record by ID.
+func FindNotificationPreferenceG(ctx context.Context, userID string, event string, selectCols ...string) (*NotificationPreference, error) {
+	return FindNotificationPreference(ctx, boil.GetContextDB(), userID, event, selectCols...)
+}
+
+// FindNotificationPreference retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationPreference(ctx context.Context, exec boil.ContextExecutor, userID string, event string, selectCols ...string) (*NotificationPreference, error) {
+	notificationPreferenceObj := &NotificationPreference{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"notification_preference\" where \"user_id\"=$1 AND \"event\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, event)
+
+	err := q.Bind(ctx, exec, notificationPreferenceObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from notification_preference")
+	}
+
+	return notificationPreferenceObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *NotificationPreference) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationPreference) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no notification_preference provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationPreferenceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationPreferenceInsertCacheMut.RLock()
+	cache, cached := notificationPreferenceInsertCache[key]
+	notificationPreferenceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferenceColumnsWithDefault,
+			notificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"notification_preference\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"notification_preference\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into notification_preference")
+	}
+
+	if !cached {
+		notificationPreferenceInsertCacheMut.Lock()
+		notificationPreferenceInsertCache[key] = cache
+		notificationPreferenceInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single NotificationPreference record using the global executor.
+// See Update for more documentation.
+func (o *NotificationPreference) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the NotificationPreference.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationPreference) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	notificationPreferenceUpdateCacheMut.RLock()
+	cache, cached := notificationPreferenceUpdateCache[key]
+	notificationPreferenceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferencePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update notification_preference, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"notification_preference\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, notificationPreferencePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, append(wl, notificationPreferencePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update notification_preference row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for notification_preference")
+	}
+
+	if !cached {
+		notificationPreferenceUpdateCacheMut.Lock()
+		notificationPreferenceUpdateCache[key] = cache
+		notificationPreferenceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q notificationPreferenceQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationPreferenceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o NotificationPreferenceSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationPreferenceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"notification_preference\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, notificationPreferencePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in notificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all notificationPreference")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *NotificationPreference) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationPreference) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no notification_preference provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationPreferenceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationPreferenceUpsertCacheMut.RLock()
+	cache, cached := notificationPreferenceUpsertCache[key]
+	notificationPreferenceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferenceColumnsWithDefault,
+			notificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			notificationPreferenceAllColumns,
+			notificationPreferencePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert notification_preference, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(notificationPreferencePrimaryKeyColumns))
+			copy(conflict, notificationPreferencePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"notification_preference\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationPreferenceType, notificationPreferenceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert notification_preference")
+	}
+
+	if !cached {
+		notificationPreferenceUpsertCacheMut.Lock()
+		notificationPreferenceUpsertCache[key] = cache
+		notificationPreferenceUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single NotificationPreference record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *NotificationPreference) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single NotificationPreference record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationPreference) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no NotificationPreference provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationPreferencePrimaryKeyMapping)
+	sql := "DELETE FROM \"notification_preference\" WHERE \"user_id\"=$1 AND \"event\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+func (q notificationPreferenceQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationPreferenceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no notificationPreferenceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o NotificationPreferenceSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationPreferenceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"notification_preference\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, notificationPreferencePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *NotificationPreference) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no NotificationPreference provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationPreference) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotificationPreference(ctx, exec, o.UserID, o.Event)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationPreferenceSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty NotificationPreferenceSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationPreferenceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationPreferenceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"notification_preference\".* FROM \"notification_preference\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, notificationPreferencePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in NotificationPreferenceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationPreferenceExistsG checks if the NotificationPreference row exists.
+func NotificationPreferenceExistsG(ctx context.Context, userID string, event string) (bool, error) {
+	return NotificationPreferenceExists(ctx, boil.GetContextDB(), userID, event)
+}
+
+// NotificationPreferenceExists checks if the NotificationPreference row exists.
+func NotificationPreferenceExists(ctx context.Context, exec boil.ContextExecutor, userID string, event string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"notification_preference\" where \"user_id\"=$1 AND \"event\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, event)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, event)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if notification_preference exists")
+	}
+
+	return exists, nil
+}
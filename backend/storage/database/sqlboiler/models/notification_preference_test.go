@@ -0,0 +1,696 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	query := NotificationPreferences()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testNotificationPreferencesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationPreferencesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := NotificationPreferences().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationPreferencesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := NotificationPreferenceSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationPreferencesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := NotificationPreferenceExists(ctx, tx, o.UserID, o.Event)
+	if err != nil {
+		t.Errorf("Unable to check if NotificationPreference exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected NotificationPreferenceExists to return true, but got false.")
+	}
+}
+
+func testNotificationPreferencesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	notificationPreferenceFound, err := FindNotificationPreference(ctx, tx, o.UserID, o.Event)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if notificationPreferenceFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testNotificationPreferencesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = NotificationPreferences().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationPreferencesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := NotificationPreferences().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testNotificationPreferencesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	notificationPreferenceOne := &NotificationPreference{}
+	notificationPreferenceTwo := &NotificationPreference{}
+	if err = randomize.Struct(seed, notificationPreferenceOne, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+	if err = randomize.Struct(seed, notificationPreferenceTwo, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = notificationPreferenceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = notificationPreferenceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := NotificationPreferences().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testNotificationPreferencesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	notificationPreferenceOne := &NotificationPreference{}
+	notificationPreferenceTwo := &NotificationPreference{}
+	if err = randomize.Struct(seed, notificationPreferenceOne, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+	if err = randomize.Struct(seed, notificationPreferenceTwo, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = notificationPreferenceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = notificationPreferenceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testNotificationPreferencesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testNotificationPreferencesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(notificationPreferenceColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testNotificationPreferenceToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local NotificationPreference
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, notificationPreferenceDBTypes, false, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := NotificationPreferenceSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*NotificationPreference)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testNotificationPreferenceToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a NotificationPreference
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, notificationPreferenceDBTypes, false, strmangle.SetComplement(notificationPreferencePrimaryKeyColumns, notificationPreferenceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.NotificationPreferences[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := NotificationPreferenceExists(ctx, tx, a.UserID, a.Event); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testNotificationPreferencesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationPreferencesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := NotificationPreferenceSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationPreferencesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := NotificationPreferences().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	notificationPreferenceDBTypes = map[string]string{`UserID`: `uuid`, `Event`: `character varying`, `InApp`: `boolean`, `Email`: `boolean`, `Push`: `boolean`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                             = bytes.MinRead
+)
+
+func testNotificationPreferencesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(notificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(notificationPreferenceAllColumns) == len(notificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testNotificationPreferencesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(notificationPreferenceAllColumns) == len(notificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationPreference{}
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, notificationPreferenceDBTypes, true, notificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(notificationPreferenceAllColumns, notificationPreferencePrimaryKeyColumns) {
+		fields = notificationPreferenceAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			notificationPreferenceAllColumns,
+			notificationPreferencePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := NotificationPreferenceSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testNotificationPreferencesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(notificationPreferenceAllColumns) == len(notificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := NotificationPreference{}
+	if err = randomize.Struct(seed, &o, notificationPreferenceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert NotificationPreference: %s", err)
+	}
+
+	count, err := NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, notificationPreferenceDBTypes, false, notificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationPreference struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert NotificationPreference: %s", err)
+	}
+
+	count, err = NotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
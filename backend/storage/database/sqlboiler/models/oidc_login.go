@@ -0,0 +1,774 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OidcLogin is an object representing the database table.
+type OidcLogin struct {
+	State        string    `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Provider     string    `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	CodeVerifier string    `boil:"code_verifier" json:"code_verifier" toml:"code_verifier" yaml:"code_verifier"`
+	Nonce        string    `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	ExpiresAt    time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt    null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *oidcLoginR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcLoginL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcLoginColumns = struct {
+	State        string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    string
+	CreatedAt    string
+}{
+	State:        "state",
+	Provider:     "provider",
+	CodeVerifier: "code_verifier",
+	Nonce:        "nonce",
+	ExpiresAt:    "expires_at",
+	CreatedAt:    "created_at",
+}
+
+// Generated where
+
+var OidcLoginWhere = struct {
+	State        whereHelperstring
+	Provider     whereHelperstring
+	CodeVerifier whereHelperstring
+	Nonce        whereHelperstring
+	ExpiresAt    whereHelpertime_Time
+	CreatedAt    whereHelpernull_Time
+}{
+	State:        whereHelperstring{field: "\"oidc_login\".\"state\""},
+	Provider:     whereHelperstring{field: "\"oidc_login\".\"provider\""},
+	CodeVerifier: whereHelperstring{field: "\"oidc_login\".\"code_verifier\""},
+	Nonce:        whereHelperstring{field: "\"oidc_login\".\"nonce\""},
+	ExpiresAt:    whereHelpertime_Time{field: "\"oidc_login\".\"expires_at\""},
+	CreatedAt:    whereHelpernull_Time{field: "\"oidc_login\".\"created_at\""},
+}
+
+// OidcLoginRels is where relationship names are stored.
+var OidcLoginRels = struct {
+}{}
+
+// oidcLoginR is where relationships are stored.
+type oidcLoginR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcLoginR) NewStruct() *oidcLoginR {
+	return &oidcLoginR{}
+}
+
+// oidcLoginL is where Load methods for each relationship are stored.
+type oidcLoginL struct{}
+
+var (
+	oidcLoginAllColumns            = []string{"state", "provider", "code_verifier", "nonce", "expires_at", "created_at"}
+	oidcLoginColumnsWithoutDefault = []string{"state", "provider", "code_verifier", "nonce", "expires_at", "created_at"}
+	oidcLoginColumnsWithDefault    = []string{}
+	oidcLoginPrimaryKeyColumns     = []string{"state"}
+)
+
+type (
+	// OidcLoginSlice is an alias for a slice of pointers to OidcLogin.
+	// This should generally be used opposed to []OidcLogin.
+	OidcLoginSlice []*OidcLogin
+
+	oidcLoginQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcLoginType                 = reflect.TypeOf(&OidcLogin{})
+	oidcLoginMapping              = queries.MakeStructMapping(oidcLoginType)
+	oidcLoginPrimaryKeyMapping, _ = queries.BindMapping(oidcLoginType, oidcLoginMapping, oidcLoginPrimaryKeyColumns)
+	oidcLoginInsertCacheMut       sync.RWMutex
+	oidcLoginInsertCache          = make(map[string]insertCache)
+	oidcLoginUpdateCacheMut       sync.RWMutex
+	oidcLoginUpdateCache          = make(map[string]updateCache)
+	oidcLoginUpsertCacheMut       sync.RWMutex
+	oidcLoginUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single oidcLogin record from the query using the global executor.
+func (q oidcLoginQuery) OneG(ctx context.Context) (*OidcLogin, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single oidcLogin record from the query.
+func (q oidcLoginQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcLogin, error) {
+	o := &OidcLogin{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_login")
+	}
+
+	return o, nil
+}
+
+// AllG returns all OidcLogin records from the query using the global executor.
+func (q oidcLoginQuery) AllG(ctx context.Context) (OidcLoginSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all OidcLogin records from the query.
+func (q oidcLoginQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcLoginSlice, error) {
+	var o []*OidcLogin
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcLogin slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OidcLogin records in the query, and panics on error.
+func (q oidcLoginQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all OidcLogin records in the query.
+func (q oidcLoginQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_login rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q oidcLoginQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcLoginQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_login exists")
+	}
+
+	return count > 0, nil
+}
+
+// OidcLogins retrieves all the records using an executor.
+func OidcLogins(mods ...qm.QueryMod) oidcLoginQuery {
+	mods = append(mods, qm.From("\"oidc_login\""))
+	return oidcLoginQuery{NewQuery(mods...)}
+}
+
+// FindOidcLoginG retrieves a single record by ID.
+func FindOidcLoginG(ctx context.Context, state string, selectCols ...string) (*OidcLogin, error) {
+	return FindOidcLogin(ctx, boil.GetContextDB(), state, selectCols...)
+}
+
+// FindOidcLogin retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcLogin(ctx context.Context, exec boil.ContextExecutor, state string, selectCols ...string) (*OidcLogin, error) {
+	oidcLoginObj := &OidcLogin{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oidc_login\" where \"state\"=$1", sel,
+	)
+
+	q := queries.Raw(query, state)
+
+	err := q.Bind(ctx, exec, oidcLoginObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_login")
+	}
+
+	return oidcLoginObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OidcLogin) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcLogin) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_login provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcLoginInsertCacheMut.RLock()
+	cache, cached := oidcLoginInsertCache[key]
+	oidcLoginInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginColumnsWithDefault,
+			oidcLoginColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oidc_login\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oidc_login\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_login")
+	}
+
+	if !cached {
+		oidcLoginInsertCacheMut.Lock()
+		oidcLoginInsertCache[key] = cache
+		oidcLoginInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single OidcLogin record using the global executor.
+// See Update for more documentation.
+func (o *OidcLogin) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the OidcLogin.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcLogin) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	oidcLoginUpdateCacheMut.RLock()
+	cache, cached := oidcLoginUpdateCache[key]
+	oidcLoginUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_login, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oidc_login\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcLoginPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, append(wl, oidcLoginPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_login row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_login")
+	}
+
+	if !cached {
+		oidcLoginUpdateCacheMut.Lock()
+		oidcLoginUpdateCache[key] = cache
+		oidcLoginUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q oidcLoginQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcLoginQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_login")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_login")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OidcLoginSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcLoginSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oidc_login\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcLoginPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcLogin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcLogin")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OidcLogin) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcLogin) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_login provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcLoginUpsertCacheMut.RLock()
+	cache, cached := oidcLoginUpsertCache[key]
+	oidcLoginUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginColumnsWithDefault,
+			oidcLoginColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_login, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oidcLoginPrimaryKeyColumns))
+			copy(conflict, oidcLoginPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oidc_login\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_login")
+	}
+
+	if !cached {
+		oidcLoginUpsertCacheMut.Lock()
+		oidcLoginUpsertCache[key] = cache
+		oidcLoginUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single OidcLogin record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OidcLogin) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single OidcLogin record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcLogin) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcLogin provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcLoginPrimaryKeyMapping)
+	sql := "DELETE FROM \"oidc_login\" WHERE \"state\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_login")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_login")
+	}
+
+	return rowsAff, nil
+}
+
+func (q oidcLoginQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcLoginQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcLoginQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_login")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_login")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OidcLoginSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcLoginSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oidc_login\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcLogin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_login")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OidcLogin) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no OidcLogin provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcLogin) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcLogin(ctx, exec, o.State)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcLoginSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty OidcLoginSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcLoginSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcLoginSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oidc_login\".* FROM \"oidc_login\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcLoginSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcLoginExistsG checks if the OidcLogin row exists.
+func OidcLoginExistsG(ctx context.Context, state string) (bool, error) {
+	return OidcLoginExists(ctx, boil.GetContextDB(), state)
+}
+
+// OidcLoginExists checks if the OidcLogin row exists.
+func OidcLoginExists(ctx context.Context, exec boil.ContextExecutor, state string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oidc_login\" where \"state\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, state)
+	}
+	row := exec.QueryRowContext(ctx, sql, state)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_login exists")
+	}
+
+	return exists, nil
+}
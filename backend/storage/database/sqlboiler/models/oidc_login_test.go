@@ -0,0 +1,591 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOidcLogins(t *testing.T) {
+	t.Parallel()
+
+	query := OidcLogins()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOidcLoginsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcLoginsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OidcLogins().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcLoginsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcLoginSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOidcLoginsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OidcLoginExists(ctx, tx, o.State)
+	if err != nil {
+		t.Errorf("Unable to check if OidcLogin exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OidcLoginExists to return true, but got false.")
+	}
+}
+
+func testOidcLoginsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oidcLoginFound, err := FindOidcLogin(ctx, tx, o.State)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oidcLoginFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOidcLoginsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OidcLogins().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcLoginsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OidcLogins().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOidcLoginsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oidcLoginOne := &OidcLogin{}
+	oidcLoginTwo := &OidcLogin{}
+	if err = randomize.Struct(seed, oidcLoginOne, oidcLoginDBTypes, false, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcLoginTwo, oidcLoginDBTypes, false, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcLoginOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcLoginTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcLogins().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOidcLoginsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oidcLoginOne := &OidcLogin{}
+	oidcLoginTwo := &OidcLogin{}
+	if err = randomize.Struct(seed, oidcLoginOne, oidcLoginDBTypes, false, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oidcLoginTwo, oidcLoginDBTypes, false, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oidcLoginOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oidcLoginTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testOidcLoginsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcLoginsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oidcLoginColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOidcLoginsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcLoginsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OidcLoginSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOidcLoginsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OidcLogins().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oidcLoginDBTypes = map[string]string{`State`: `character varying`, `Provider`: `character varying`, `CodeVerifier`: `character varying`, `Nonce`: `character varying`, `ExpiresAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`}
+	_                = bytes.MinRead
+)
+
+func testOidcLoginsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oidcLoginPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oidcLoginAllColumns) == len(oidcLoginPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOidcLoginsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcLoginAllColumns) == len(oidcLoginPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OidcLogin{}
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oidcLoginDBTypes, true, oidcLoginPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oidcLoginAllColumns, oidcLoginPrimaryKeyColumns) {
+		fields = oidcLoginAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oidcLoginAllColumns,
+			oidcLoginPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OidcLoginSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOidcLoginsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oidcLoginAllColumns) == len(oidcLoginPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OidcLogin{}
+	if err = randomize.Struct(seed, &o, oidcLoginDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcLogin: %s", err)
+	}
+
+	count, err := OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oidcLoginDBTypes, false, oidcLoginPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OidcLogin struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OidcLogin: %s", err)
+	}
+
+	count, err = OidcLogins().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
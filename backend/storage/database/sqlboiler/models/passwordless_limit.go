@@ -0,0 +1,929 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PasswordlessLimit is an object representing the database table.
+type PasswordlessLimit struct {
+	UserID             string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Requests           int       `boil:"requests" json:"requests" toml:"requests" yaml:"requests"`
+	RequestsResetAt    time.Time `boil:"requests_reset_at" json:"requests_reset_at" toml:"requests_reset_at" yaml:"requests_reset_at"`
+	Attempts           int       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	AttemptsResetAt    time.Time `boil:"attempts_reset_at" json:"attempts_reset_at" toml:"attempts_reset_at" yaml:"attempts_reset_at"`
+	CodesInvalidBefore null.Time `boil:"codes_invalid_before" json:"codes_invalid_before,omitempty" toml:"codes_invalid_before" yaml:"codes_invalid_before,omitempty"`
+
+	R *passwordlessLimitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L passwordlessLimitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PasswordlessLimitColumns = struct {
+	UserID             string
+	Requests           string
+	RequestsResetAt    string
+	Attempts           string
+	AttemptsResetAt    string
+	CodesInvalidBefore string
+}{
+	UserID:             "user_id",
+	Requests:           "requests",
+	RequestsResetAt:    "requests_reset_at",
+	Attempts:           "attempts",
+	AttemptsResetAt:    "attempts_reset_at",
+	CodesInvalidBefore: "codes_invalid_before",
+}
+
+// Generated where
+
+var PasswordlessLimitWhere = struct {
+	UserID             whereHelperstring
+	Requests           whereHelperint
+	RequestsResetAt    whereHelpertime_Time
+	Attempts           whereHelperint
+	AttemptsResetAt    whereHelpertime_Time
+	CodesInvalidBefore whereHelpernull_Time
+}{
+	UserID:             whereHelperstring{field: "\"passwordless_limit\".\"user_id\""},
+	Requests:           whereHelperint{field: "\"passwordless_limit\".\"requests\""},
+	RequestsResetAt:    whereHelpertime_Time{field: "\"passwordless_limit\".\"requests_reset_at\""},
+	Attempts:           whereHelperint{field: "\"passwordless_limit\".\"attempts\""},
+	AttemptsResetAt:    whereHelpertime_Time{field: "\"passwordless_limit\".\"attempts_reset_at\""},
+	CodesInvalidBefore: whereHelpernull_Time{field: "\"passwordless_limit\".\"codes_invalid_before\""},
+}
+
+// PasswordlessLimitRels is where relationship names are stored.
+var PasswordlessLimitRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// passwordlessLimitR is where relationships are stored.
+type passwordlessLimitR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*passwordlessLimitR) NewStruct() *passwordlessLimitR {
+	return &passwordlessLimitR{}
+}
+
+// passwordlessLimitL is where Load methods for each relationship are stored.
+type passwordlessLimitL struct{}
+
+var (
+	passwordlessLimitAllColumns            = []string{"user_id", "requests", "requests_reset_at", "attempts", "attempts_reset_at", "codes_invalid_before"}
+	passwordlessLimitColumnsWithoutDefault = []string{"user_id", "requests_reset_at", "attempts_reset_at", "codes_invalid_before"}
+	passwordlessLimitColumnsWithDefault    = []string{"requests", "attempts"}
+	passwordlessLimitPrimaryKeyColumns     = []string{"user_id"}
+)
+
+type (
+	// PasswordlessLimitSlice is an alias for a slice of pointers to PasswordlessLimit.
+	// This should generally be used opposed to []PasswordlessLimit.
+	PasswordlessLimitSlice []*PasswordlessLimit
+
+	passwordlessLimitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	passwordlessLimitType                 = reflect.TypeOf(&PasswordlessLimit{})
+	passwordlessLimitMapping              = queries.MakeStructMapping(passwordlessLimitType)
+	passwordlessLimitPrimaryKeyMapping, _ = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, passwordlessLimitPrimaryKeyColumns)
+	passwordlessLimitInsertCacheMut       sync.RWMutex
+	passwordlessLimitInsertCache          = make(map[string]insertCache)
+	passwordlessLimitUpdateCacheMut       sync.RWMutex
+	passwordlessLimitUpdateCache          = make(map[string]updateCache)
+	passwordlessLimitUpsertCacheMut       sync.RWMutex
+	passwordlessLimitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single passwordlessLimit record from the query using the global executor.
+func (q passwordlessLimitQuery) OneG(ctx context.Context) (*PasswordlessLimit, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single passwordlessLimit record from the query.
+func (q passwordlessLimitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PasswordlessLimit, error) {
+	o := &PasswordlessLimit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for passwordless_limit")
+	}
+
+	return o, nil
+}
+
+// AllG returns all PasswordlessLimit records from the query using the global executor.
+func (q passwordlessLimitQuery) AllG(ctx context.Context) (PasswordlessLimitSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all PasswordlessLimit records from the query.
+func (q passwordlessLimitQuery) All(ctx context.Context, exec boil.ContextExecutor) (PasswordlessLimitSlice, error) {
+	var o []*PasswordlessLimit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PasswordlessLimit slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all PasswordlessLimit records in the query, and panics on error.
+func (q passwordlessLimitQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all PasswordlessLimit records in the query.
+func (q passwordlessLimitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count passwordless_limit rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q passwordlessLimitQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q passwordlessLimitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if passwordless_limit exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *PasswordlessLimit) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (passwordlessLimitL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePasswordlessLimit interface{}, mods queries.Applicator) error {
+	var slice []*PasswordlessLimit
+	var object *PasswordlessLimit
+
+	if singular {
+		object = maybePasswordlessLimit.(*PasswordlessLimit)
+	} else {
+		slice = *maybePasswordlessLimit.(*[]*PasswordlessLimit)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &passwordlessLimitR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &passwordlessLimitR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PasswordlessLimit = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PasswordlessLimit = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the passwordlessLimit to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PasswordlessLimit.
+// Uses the global database handle.
+func (o *PasswordlessLimit) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the passwordlessLimit to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PasswordlessLimit.
+func (o *PasswordlessLimit) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"passwordless_limit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, passwordlessLimitPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &passwordlessLimitR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PasswordlessLimit: o,
+		}
+	} else {
+		related.R.PasswordlessLimit = o
+	}
+
+	return nil
+}
+
+// PasswordlessLimits retrieves all the records using an executor.
+func PasswordlessLimits(mods ...qm.QueryMod) passwordlessLimitQuery {
+	mods = append(mods, qm.From("\"passwordless_limit\""))
+	return passwordlessLimitQuery{NewQuery(mods...)}
+}
+
+// FindPasswordlessLimitG retrieves a single record by ID.
+func FindPasswordlessLimitG(ctx context.Context, userID string, selectCols ...string) (*PasswordlessLimit, error) {
+	return FindPasswordlessLimit(ctx, boil.GetContextDB(), userID, selectCols...)
+}
+
+// FindPasswordlessLimit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPasswordlessLimit(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*PasswordlessLimit, error) {
+	passwordlessLimitObj := &PasswordlessLimit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"passwordless_limit\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, passwordlessLimitObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from passwordless_limit")
+	}
+
+	return passwordlessLimitObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *PasswordlessLimit) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PasswordlessLimit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no passwordless_limit provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(passwordlessLimitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	passwordlessLimitInsertCacheMut.RLock()
+	cache, cached := passwordlessLimitInsertCache[key]
+	passwordlessLimitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			passwordlessLimitAllColumns,
+			passwordlessLimitColumnsWithDefault,
+			passwordlessLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"passwordless_limit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"passwordless_limit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into passwordless_limit")
+	}
+
+	if !cached {
+		passwordlessLimitInsertCacheMut.Lock()
+		passwordlessLimitInsertCache[key] = cache
+		passwordlessLimitInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single PasswordlessLimit record using the global executor.
+// See Update for more documentation.
+func (o *PasswordlessLimit) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the PasswordlessLimit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PasswordlessLimit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	passwordlessLimitUpdateCacheMut.RLock()
+	cache, cached := passwordlessLimitUpdateCache[key]
+	passwordlessLimitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			passwordlessLimitAllColumns,
+			passwordlessLimitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update passwordless_limit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"passwordless_limit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, passwordlessLimitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, append(wl, passwordlessLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update passwordless_limit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for passwordless_limit")
+	}
+
+	if !cached {
+		passwordlessLimitUpdateCacheMut.Lock()
+		passwordlessLimitUpdateCache[key] = cache
+		passwordlessLimitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q passwordlessLimitQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q passwordlessLimitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for passwordless_limit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for passwordless_limit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PasswordlessLimitSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PasswordlessLimitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordlessLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"passwordless_limit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, passwordlessLimitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in passwordlessLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all passwordlessLimit")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *PasswordlessLimit) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PasswordlessLimit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no passwordless_limit provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(passwordlessLimitColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	passwordlessLimitUpsertCacheMut.RLock()
+	cache, cached := passwordlessLimitUpsertCache[key]
+	passwordlessLimitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			passwordlessLimitAllColumns,
+			passwordlessLimitColumnsWithDefault,
+			passwordlessLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			passwordlessLimitAllColumns,
+			passwordlessLimitPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert passwordless_limit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(passwordlessLimitPrimaryKeyColumns))
+			copy(conflict, passwordlessLimitPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"passwordless_limit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(passwordlessLimitType, passwordlessLimitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert passwordless_limit")
+	}
+
+	if !cached {
+		passwordlessLimitUpsertCacheMut.Lock()
+		passwordlessLimitUpsertCache[key] = cache
+		passwordlessLimitUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single PasswordlessLimit record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *PasswordlessLimit) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single PasswordlessLimit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PasswordlessLimit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PasswordlessLimit provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), passwordlessLimitPrimaryKeyMapping)
+	sql := "DELETE FROM \"passwordless_limit\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from passwordless_limit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for passwordless_limit")
+	}
+
+	return rowsAff, nil
+}
+
+func (q passwordlessLimitQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q passwordlessLimitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no passwordlessLimitQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from passwordless_limit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for passwordless_limit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PasswordlessLimitSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PasswordlessLimitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordlessLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"passwordless_limit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passwordlessLimitPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from passwordlessLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for passwordless_limit")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *PasswordlessLimit) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no PasswordlessLimit provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PasswordlessLimit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPasswordlessLimit(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PasswordlessLimitSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty PasswordlessLimitSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PasswordlessLimitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PasswordlessLimitSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), passwordlessLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"passwordless_limit\".* FROM \"passwordless_limit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, passwordlessLimitPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PasswordlessLimitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PasswordlessLimitExistsG checks if the PasswordlessLimit row exists.
+func PasswordlessLimitExistsG(ctx context.Context, userID string) (bool, error) {
+	return PasswordlessLimitExists(ctx, boil.GetContextDB(), userID)
+}
+
+// PasswordlessLimitExists checks if the PasswordlessLimit row exists.
+func PasswordlessLimitExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"passwordless_limit\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if passwordless_limit exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,696 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPasswordlessLimits(t *testing.T) {
+	t.Parallel()
+
+	query := PasswordlessLimits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPasswordlessLimitsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordlessLimitsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PasswordlessLimits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordlessLimitsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasswordlessLimitSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPasswordlessLimitsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PasswordlessLimitExists(ctx, tx, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if PasswordlessLimit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PasswordlessLimitExists to return true, but got false.")
+	}
+}
+
+func testPasswordlessLimitsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	passwordlessLimitFound, err := FindPasswordlessLimit(ctx, tx, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if passwordlessLimitFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPasswordlessLimitsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PasswordlessLimits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordlessLimitsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PasswordlessLimits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPasswordlessLimitsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	passwordlessLimitOne := &PasswordlessLimit{}
+	passwordlessLimitTwo := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, passwordlessLimitOne, passwordlessLimitDBTypes, false, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passwordlessLimitTwo, passwordlessLimitDBTypes, false, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passwordlessLimitOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passwordlessLimitTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasswordlessLimits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPasswordlessLimitsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	passwordlessLimitOne := &PasswordlessLimit{}
+	passwordlessLimitTwo := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, passwordlessLimitOne, passwordlessLimitDBTypes, false, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, passwordlessLimitTwo, passwordlessLimitDBTypes, false, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = passwordlessLimitOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = passwordlessLimitTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPasswordlessLimitsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasswordlessLimitsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(passwordlessLimitColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPasswordlessLimitToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PasswordlessLimit
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, passwordlessLimitDBTypes, false, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PasswordlessLimitSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PasswordlessLimit)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPasswordlessLimitToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PasswordlessLimit
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, passwordlessLimitDBTypes, false, strmangle.SetComplement(passwordlessLimitPrimaryKeyColumns, passwordlessLimitColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PasswordlessLimit != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := PasswordlessLimitExists(ctx, tx, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPasswordlessLimitsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordlessLimitsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PasswordlessLimitSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPasswordlessLimitsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PasswordlessLimits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	passwordlessLimitDBTypes = map[string]string{`UserID`: `uuid`, `Requests`: `integer`, `RequestsResetAt`: `timestamp with time zone`, `Attempts`: `integer`, `AttemptsResetAt`: `timestamp with time zone`, `CodesInvalidBefore`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testPasswordlessLimitsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(passwordlessLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(passwordlessLimitAllColumns) == len(passwordlessLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPasswordlessLimitsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(passwordlessLimitAllColumns) == len(passwordlessLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PasswordlessLimit{}
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, passwordlessLimitDBTypes, true, passwordlessLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(passwordlessLimitAllColumns, passwordlessLimitPrimaryKeyColumns) {
+		fields = passwordlessLimitAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			passwordlessLimitAllColumns,
+			passwordlessLimitPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PasswordlessLimitSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPasswordlessLimitsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(passwordlessLimitAllColumns) == len(passwordlessLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PasswordlessLimit{}
+	if err = randomize.Struct(seed, &o, passwordlessLimitDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasswordlessLimit: %s", err)
+	}
+
+	count, err := PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, passwordlessLimitDBTypes, false, passwordlessLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PasswordlessLimit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PasswordlessLimit: %s", err)
+	}
+
+	count, err = PasswordlessLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,953 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Payment is an object representing the database table.
+type Payment struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID  string      `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Period    string      `boil:"period" json:"period" toml:"period" yaml:"period"`
+	Amount    int         `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Currency  string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Reference null.String `boil:"reference" json:"reference,omitempty" toml:"reference" yaml:"reference,omitempty"`
+	PaidAt    time.Time   `boil:"paid_at" json:"paid_at" toml:"paid_at" yaml:"paid_at"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *paymentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L paymentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PaymentColumns = struct {
+	ID        string
+	SchoolID  string
+	Period    string
+	Amount    string
+	Currency  string
+	Reference string
+	PaidAt    string
+	CreatedAt string
+}{
+	ID:        "id",
+	SchoolID:  "school_id",
+	Period:    "period",
+	Amount:    "amount",
+	Currency:  "currency",
+	Reference: "reference",
+	PaidAt:    "paid_at",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var PaymentWhere = struct {
+	ID        whereHelperstring
+	SchoolID  whereHelperstring
+	Period    whereHelperstring
+	Amount    whereHelperint
+	Currency  whereHelperstring
+	Reference whereHelpernull_String
+	PaidAt    whereHelpertime_Time
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"payment\".\"id\""},
+	SchoolID:  whereHelperstring{field: "\"payment\".\"school_id\""},
+	Period:    whereHelperstring{field: "\"payment\".\"period\""},
+	Amount:    whereHelperint{field: "\"payment\".\"amount\""},
+	Currency:  whereHelperstring{field: "\"payment\".\"currency\""},
+	Reference: whereHelpernull_String{field: "\"payment\".\"reference\""},
+	PaidAt:    whereHelpertime_Time{field: "\"payment\".\"paid_at\""},
+	CreatedAt: whereHelpernull_Time{field: "\"payment\".\"created_at\""},
+}
+
+// PaymentRels is where relationship names are stored.
+var PaymentRels = struct {
+	School string
+}{
+	School: "School",
+}
+
+// paymentR is where relationships are stored.
+type paymentR struct {
+	School *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+}
+
+// NewStruct creates a new relationship struct
+func (*paymentR) NewStruct() *paymentR {
+	return &paymentR{}
+}
+
+// paymentL is where Load methods for each relationship are stored.
+type paymentL struct{}
+
+var (
+	paymentAllColumns            = []string{"id", "school_id", "period", "amount", "currency", "reference", "paid_at", "created_at"}
+	paymentColumnsWithoutDefault = []string{"id", "school_id", "period", "amount", "currency", "reference", "paid_at", "created_at"}
+	paymentColumnsWithDefault    = []string{}
+	paymentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PaymentSlice is an alias for a slice of pointers to Payment.
+	// This should generally be used opposed to []Payment.
+	PaymentSlice []*Payment
+
+	paymentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	paymentType                 = reflect.TypeOf(&Payment{})
+	paymentMapping              = queries.MakeStructMapping(paymentType)
+	paymentPrimaryKeyMapping, _ = queries.BindMapping(paymentType, paymentMapping, paymentPrimaryKeyColumns)
+	paymentInsertCacheMut       sync.RWMutex
+	paymentInsertCache          = make(map[string]insertCache)
+	paymentUpdateCacheMut       sync.RWMutex
+	paymentUpdateCache          = make(map[string]updateCache)
+	paymentUpsertCacheMut       sync.RWMutex
+	paymentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single payment record from the query using the global executor.
+func (q paymentQuery) OneG(ctx context.Context) (*Payment, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single payment record from the query.
+func (q paymentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Payment, error) {
+	o := &Payment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for payment")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Payment records from the query using the global executor.
+func (q paymentQuery) AllG(ctx context.Context) (PaymentSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Payment records from the query.
+func (q paymentQuery) All(ctx context.Context, exec boil.ContextExecutor) (PaymentSlice, error) {
+	var o []*Payment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Payment slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Payment records in the query, and panics on error.
+func (q paymentQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Payment records in the query.
+func (q paymentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count payment rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q paymentQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q paymentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if payment exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *Payment) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (paymentL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybePayment interface{}, mods queries.Applicator) error {
+	var slice []*Payment
+	var object *Payment
+
+	if singular {
+		object = maybePayment.(*Payment)
+	} else {
+		slice = *maybePayment.(*[]*Payment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &paymentR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &paymentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.Payments = append(foreign.R.Payments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.Payments = append(foreign.R.Payments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the payment to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Payments.
+// Uses the global database handle.
+func (o *Payment) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the payment to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Payments.
+func (o *Payment) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"payment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, paymentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &paymentR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			Payments: PaymentSlice{o},
+		}
+	} else {
+		related.R.Payments = append(related.R.Payments, o)
+	}
+
+	return nil
+}
+
+// Payments retrieves all the records using an executor.
+func Payments(mods ...qm.QueryMod) paymentQuery {
+	mods = append(mods, qm.From("\"payment\""))
+	return paymentQuery{NewQuery(mods...)}
+}
+
+// FindPaymentG retrieves a single record by ID.
+func FindPaymentG(ctx context.Context, iD string, selectCols ...string) (*Payment, error) {
+	return FindPayment(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindPayment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPayment(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Payment, error) {
+	paymentObj := &Payment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"payment\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, paymentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from payment")
+	}
+
+	return paymentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Payment) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Payment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no payment provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(paymentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	paymentInsertCacheMut.RLock()
+	cache, cached := paymentInsertCache[key]
+	paymentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			paymentAllColumns,
+			paymentColumnsWithDefault,
+			paymentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(paymentType, paymentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(paymentType, paymentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"payment\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"payment\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into payment")
+	}
+
+	if !cached {
+		paymentInsertCacheMut.Lock()
+		paymentInsertCache[key] = cache
+		paymentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Payment record using the global executor.
+// See Update for more documentation.
+func (o *Payment) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Payment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Payment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	paymentUpdateCacheMut.RLock()
+	cache, cached := paymentUpdateCache[key]
+	paymentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			paymentAllColumns,
+			paymentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update payment, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"payment\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, paymentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(paymentType, paymentMapping, append(wl, paymentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update payment row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for payment")
+	}
+
+	if !cached {
+		paymentUpdateCacheMut.Lock()
+		paymentUpdateCache[key] = cache
+		paymentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q paymentQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q paymentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for payment")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PaymentSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PaymentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), paymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"payment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, paymentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in payment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all payment")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Payment) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Payment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no payment provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(paymentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	paymentUpsertCacheMut.RLock()
+	cache, cached := paymentUpsertCache[key]
+	paymentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			paymentAllColumns,
+			paymentColumnsWithDefault,
+			paymentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			paymentAllColumns,
+			paymentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert payment, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(paymentPrimaryKeyColumns))
+			copy(conflict, paymentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"payment\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(paymentType, paymentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(paymentType, paymentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert payment")
+	}
+
+	if !cached {
+		paymentUpsertCacheMut.Lock()
+		paymentUpsertCache[key] = cache
+		paymentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Payment record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Payment) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Payment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Payment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Payment provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), paymentPrimaryKeyMapping)
+	sql := "DELETE FROM \"payment\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for payment")
+	}
+
+	return rowsAff, nil
+}
+
+func (q paymentQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q paymentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no paymentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for payment")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PaymentSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PaymentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), paymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"payment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, paymentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from payment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for payment")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Payment) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Payment provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Payment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPayment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PaymentSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty PaymentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PaymentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PaymentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), paymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"payment\".* FROM \"payment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, paymentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PaymentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PaymentExistsG checks if the Payment row exists.
+func PaymentExistsG(ctx context.Context, iD string) (bool, error) {
+	return PaymentExists(ctx, boil.GetContextDB(), iD)
+}
+
+// PaymentExists checks if the Payment row exists.
+func PaymentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"payment\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if payment exists")
+	}
+
+	return exists, nil
+}
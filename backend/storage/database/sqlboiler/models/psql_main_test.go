@@ -133,6 +133,11 @@ func (p *pgTester) setup() error {
 			fmt.Println(createCmdStderr.String())
 			return errors.Wrap(err, "failed to wait for psql command")
 		}
+
+		// the generated tests update & delete rows of every table, append-only ones included
+		if err = p.runCmd("", "psql", p.testDBName, "-c", "DROP TRIGGER audit_log_append_only ON audit_log"); err != nil {
+			return errors.Wrap(err, "failed to drop the audit_log append-only trigger")
+		}
 	}
 
 	return nil
@@ -6,5 +6,103 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("Announcements", testAnnouncementsUpsert)
+
+	t.Run("AnnouncementAttachments", testAnnouncementAttachmentsUpsert)
+
+	t.Run("AnnouncementRecipients", testAnnouncementRecipientsUpsert)
+
+	t.Run("Assessments", testAssessmentsUpsert)
+
+	t.Run("Attempts", testAttemptsUpsert)
+
+	t.Run("AttendanceRecords", testAttendanceRecordsUpsert)
+
+	t.Run("AttendanceSessions", testAttendanceSessionsUpsert)
+
+	t.Run("AuditLogs", testAuditLogsUpsert)
+
+	t.Run("BackupCodes", testBackupCodesUpsert)
+
+	t.Run("CalendarEvents", testCalendarEventsUpsert)
+
+	t.Run("CalendarFeeds", testCalendarFeedsUpsert)
+
+	t.Run("ChatMessages", testChatMessagesUpsert)
+
+	t.Run("ChatMutes", testChatMutesUpsert)
+
+	t.Run("ChatReads", testChatReadsUpsert)
+
+	t.Run("ChatRooms", testChatRoomsUpsert)
+
+	t.Run("ChatRoomMembers", testChatRoomMembersUpsert)
+
+	t.Run("Classes", testClassesUpsert)
+
+	t.Run("ClassStudents", testClassStudentsUpsert)
+
+	t.Run("Courses", testCoursesUpsert)
+
+	t.Run("Courseworks", testCourseworksUpsert)
+
+	t.Run("Departments", testDepartmentsUpsert)
+
+	t.Run("ExternalIdentities", testExternalIdentitiesUpsert)
+
+	t.Run("GuardianLinks", testGuardianLinksUpsert)
+
+	t.Run("Jobs", testJobsUpsert)
+
+	t.Run("JWTKeys", testJWTKeysUpsert)
+
+	t.Run("Marks", testMarksUpsert)
+
+	t.Run("MarkCategories", testMarkCategoriesUpsert)
+
+	t.Run("MarkImports", testMarkImportsUpsert)
+
+	t.Run("Notifications", testNotificationsUpsert)
+
+	t.Run("NotificationPreferences", testNotificationPreferencesUpsert)
+
+	t.Run("OidcLogins", testOidcLoginsUpsert)
+
+	t.Run("PasswordlessLimits", testPasswordlessLimitsUpsert)
+
+	t.Run("Payments", testPaymentsUpsert)
+
+	t.Run("PushSubscriptions", testPushSubscriptionsUpsert)
+
+	t.Run("Questions", testQuestionsUpsert)
+
+	t.Run("RbacRoles", testRbacRolesUpsert)
+
+	t.Run("RbacRoleMembers", testRbacRoleMembersUpsert)
+
+	t.Run("RefreshTokens", testRefreshTokensUpsert)
+
+	t.Run("Schools", testSchoolsUpsert)
+
+	t.Run("SchoolEmailDomains", testSchoolEmailDomainsUpsert)
+
+	t.Run("SMSUsages", testSMSUsagesUpsert)
+
+	t.Run("Subscriptions", testSubscriptionsUpsert)
+
+	t.Run("Terms", testTermsUpsert)
+
+	t.Run("TwoFactors", testTwoFactorsUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WalletAccounts", testWalletAccountsUpsert)
+
+	t.Run("WalletAwards", testWalletAwardsUpsert)
+
+	t.Run("WalletEntries", testWalletEntriesUpsert)
+
+	t.Run("WalletRules", testWalletRulesUpsert)
+
+	t.Run("WalletTransactions", testWalletTransactionsUpsert)
 }
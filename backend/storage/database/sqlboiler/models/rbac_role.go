@@ -0,0 +1,1131 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// RbacRole is an object representing the database table.
+type RbacRole struct {
+	ID          string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID    string            `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Name        string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Permissions types.StringArray `boil:"permissions" json:"permissions" toml:"permissions" yaml:"permissions"`
+	CreatedAt   null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *rbacRoleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rbacRoleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RbacRoleColumns = struct {
+	ID          string
+	SchoolID    string
+	Name        string
+	Permissions string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	SchoolID:    "school_id",
+	Name:        "name",
+	Permissions: "permissions",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+// Generated where
+
+var RbacRoleWhere = struct {
+	ID          whereHelperstring
+	SchoolID    whereHelperstring
+	Name        whereHelperstring
+	Permissions whereHelpertypes_StringArray
+	CreatedAt   whereHelpernull_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"rbac_role\".\"id\""},
+	SchoolID:    whereHelperstring{field: "\"rbac_role\".\"school_id\""},
+	Name:        whereHelperstring{field: "\"rbac_role\".\"name\""},
+	Permissions: whereHelpertypes_StringArray{field: "\"rbac_role\".\"permissions\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"rbac_role\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"rbac_role\".\"updated_at\""},
+}
+
+// RbacRoleRels is where relationship names are stored.
+var RbacRoleRels = struct {
+	School              string
+	RoleRbacRoleMembers string
+}{
+	School:              "School",
+	RoleRbacRoleMembers: "RoleRbacRoleMembers",
+}
+
+// rbacRoleR is where relationships are stored.
+type rbacRoleR struct {
+	School              *School             `boil:"School" json:"School" toml:"School" yaml:"School"`
+	RoleRbacRoleMembers RbacRoleMemberSlice `boil:"RoleRbacRoleMembers" json:"RoleRbacRoleMembers" toml:"RoleRbacRoleMembers" yaml:"RoleRbacRoleMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rbacRoleR) NewStruct() *rbacRoleR {
+	return &rbacRoleR{}
+}
+
+// rbacRoleL is where Load methods for each relationship are stored.
+type rbacRoleL struct{}
+
+var (
+	rbacRoleAllColumns            = []string{"id", "school_id", "name", "permissions", "created_at", "updated_at"}
+	rbacRoleColumnsWithoutDefault = []string{"id", "school_id", "name", "created_at", "updated_at"}
+	rbacRoleColumnsWithDefault    = []string{"permissions"}
+	rbacRolePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// RbacRoleSlice is an alias for a slice of pointers to RbacRole.
+	// This should generally be used opposed to []RbacRole.
+	RbacRoleSlice []*RbacRole
+
+	rbacRoleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rbacRoleType                 = reflect.TypeOf(&RbacRole{})
+	rbacRoleMapping              = queries.MakeStructMapping(rbacRoleType)
+	rbacRolePrimaryKeyMapping, _ = queries.BindMapping(rbacRoleType, rbacRoleMapping, rbacRolePrimaryKeyColumns)
+	rbacRoleInsertCacheMut       sync.RWMutex
+	rbacRoleInsertCache          = make(map[string]insertCache)
+	rbacRoleUpdateCacheMut       sync.RWMutex
+	rbacRoleUpdateCache          = make(map[string]updateCache)
+	rbacRoleUpsertCacheMut       sync.RWMutex
+	rbacRoleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single rbacRole record from the query using the global executor.
+func (q rbacRoleQuery) OneG(ctx context.Context) (*RbacRole, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single rbacRole record from the query.
+func (q rbacRoleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RbacRole, error) {
+	o := &RbacRole{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rbac_role")
+	}
+
+	return o, nil
+}
+
+// AllG returns all RbacRole records from the query using the global executor.
+func (q rbacRoleQuery) AllG(ctx context.Context) (RbacRoleSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RbacRole records from the query.
+func (q rbacRoleQuery) All(ctx context.Context, exec boil.ContextExecutor) (RbacRoleSlice, error) {
+	var o []*RbacRole
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RbacRole slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RbacRole records in the query, and panics on error.
+func (q rbacRoleQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RbacRole records in the query.
+func (q rbacRoleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rbac_role rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q rbacRoleQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rbacRoleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rbac_role exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *RbacRole) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// RoleRbacRoleMembers retrieves all the rbac_role_member's RbacRoleMembers with an executor via role_id column.
+func (o *RbacRole) RoleRbacRoleMembers(mods ...qm.QueryMod) rbacRoleMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rbac_role_member\".\"role_id\"=?", o.ID),
+	)
+
+	query := RbacRoleMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"rbac_role_member\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"rbac_role_member\".*"})
+	}
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rbacRoleL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRbacRole interface{}, mods queries.Applicator) error {
+	var slice []*RbacRole
+	var object *RbacRole
+
+	if singular {
+		object = maybeRbacRole.(*RbacRole)
+	} else {
+		slice = *maybeRbacRole.(*[]*RbacRole)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rbacRoleR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rbacRoleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.RbacRoles = append(foreign.R.RbacRoles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.RbacRoles = append(foreign.R.RbacRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoleRbacRoleMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (rbacRoleL) LoadRoleRbacRoleMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRbacRole interface{}, mods queries.Applicator) error {
+	var slice []*RbacRole
+	var object *RbacRole
+
+	if singular {
+		object = maybeRbacRole.(*RbacRole)
+	} else {
+		slice = *maybeRbacRole.(*[]*RbacRole)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rbacRoleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rbacRoleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rbac_role_member`),
+		qm.WhereIn(`rbac_role_member.role_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rbac_role_member")
+	}
+
+	var resultSlice []*RbacRoleMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rbac_role_member")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rbac_role_member")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rbac_role_member")
+	}
+
+	if singular {
+		object.R.RoleRbacRoleMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rbacRoleMemberR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RoleRbacRoleMembers = append(local.R.RoleRbacRoleMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &rbacRoleMemberR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the rbacRole to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.RbacRoles.
+// Uses the global database handle.
+func (o *RbacRole) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the rbacRole to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.RbacRoles.
+func (o *RbacRole) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rbac_role\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rbacRolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &rbacRoleR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			RbacRoles: RbacRoleSlice{o},
+		}
+	} else {
+		related.R.RbacRoles = append(related.R.RbacRoles, o)
+	}
+
+	return nil
+}
+
+// AddRoleRbacRoleMembersG adds the given related objects to the existing relationships
+// of the rbac_role, optionally inserting them as new records.
+// Appends related to o.R.RoleRbacRoleMembers.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *RbacRole) AddRoleRbacRoleMembersG(ctx context.Context, insert bool, related ...*RbacRoleMember) error {
+	return o.AddRoleRbacRoleMembers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRoleRbacRoleMembers adds the given related objects to the existing relationships
+// of the rbac_role, optionally inserting them as new records.
+// Appends related to o.R.RoleRbacRoleMembers.
+// Sets related.R.Role appropriately.
+func (o *RbacRole) AddRoleRbacRoleMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RbacRoleMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"rbac_role_member\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rbacRoleMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoleID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &rbacRoleR{
+			RoleRbacRoleMembers: related,
+		}
+	} else {
+		o.R.RoleRbacRoleMembers = append(o.R.RoleRbacRoleMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rbacRoleMemberR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// RbacRoles retrieves all the records using an executor.
+func RbacRoles(mods ...qm.QueryMod) rbacRoleQuery {
+	mods = append(mods, qm.From("\"rbac_role\""))
+	return rbacRoleQuery{NewQuery(mods...)}
+}
+
+// FindRbacRoleG retrieves a single record by ID.
+func FindRbacRoleG(ctx context.Context, iD string, selectCols ...string) (*RbacRole, error) {
+	return FindRbacRole(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindRbacRole retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRbacRole(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*RbacRole, error) {
+	rbacRoleObj := &RbacRole{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rbac_role\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, rbacRoleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rbac_role")
+	}
+
+	return rbacRoleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RbacRole) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RbacRole) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rbac_role provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rbacRoleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rbacRoleInsertCacheMut.RLock()
+	cache, cached := rbacRoleInsertCache[key]
+	rbacRoleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rbacRoleAllColumns,
+			rbacRoleColumnsWithDefault,
+			rbacRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rbacRoleType, rbacRoleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rbacRoleType, rbacRoleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rbac_role\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rbac_role\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rbac_role")
+	}
+
+	if !cached {
+		rbacRoleInsertCacheMut.Lock()
+		rbacRoleInsertCache[key] = cache
+		rbacRoleInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single RbacRole record using the global executor.
+// See Update for more documentation.
+func (o *RbacRole) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RbacRole.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RbacRole) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	rbacRoleUpdateCacheMut.RLock()
+	cache, cached := rbacRoleUpdateCache[key]
+	rbacRoleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rbacRoleAllColumns,
+			rbacRolePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rbac_role, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rbac_role\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rbacRolePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rbacRoleType, rbacRoleMapping, append(wl, rbacRolePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rbac_role row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rbac_role")
+	}
+
+	if !cached {
+		rbacRoleUpdateCacheMut.Lock()
+		rbacRoleUpdateCache[key] = cache
+		rbacRoleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rbacRoleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rbacRoleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rbac_role")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rbac_role")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RbacRoleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RbacRoleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rbac_role\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rbacRolePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rbacRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rbacRole")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RbacRole) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RbacRole) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rbac_role provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rbacRoleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rbacRoleUpsertCacheMut.RLock()
+	cache, cached := rbacRoleUpsertCache[key]
+	rbacRoleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rbacRoleAllColumns,
+			rbacRoleColumnsWithDefault,
+			rbacRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			rbacRoleAllColumns,
+			rbacRolePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rbac_role, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(rbacRolePrimaryKeyColumns))
+			copy(conflict, rbacRolePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rbac_role\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(rbacRoleType, rbacRoleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rbacRoleType, rbacRoleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rbac_role")
+	}
+
+	if !cached {
+		rbacRoleUpsertCacheMut.Lock()
+		rbacRoleUpsertCache[key] = cache
+		rbacRoleUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single RbacRole record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RbacRole) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RbacRole record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RbacRole) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RbacRole provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rbacRolePrimaryKeyMapping)
+	sql := "DELETE FROM \"rbac_role\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rbac_role")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rbac_role")
+	}
+
+	return rowsAff, nil
+}
+
+func (q rbacRoleQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q rbacRoleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rbacRoleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rbac_role")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rbac_role")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RbacRoleSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RbacRoleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rbac_role\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rbacRolePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rbacRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rbac_role")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RbacRole) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no RbacRole provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RbacRole) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRbacRole(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RbacRoleSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty RbacRoleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RbacRoleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RbacRoleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rbac_role\".* FROM \"rbac_role\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rbacRolePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RbacRoleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RbacRoleExistsG checks if the RbacRole row exists.
+func RbacRoleExistsG(ctx context.Context, iD string) (bool, error) {
+	return RbacRoleExists(ctx, boil.GetContextDB(), iD)
+}
+
+// RbacRoleExists checks if the RbacRole row exists.
+func RbacRoleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rbac_role\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rbac_role exists")
+	}
+
+	return exists, nil
+}
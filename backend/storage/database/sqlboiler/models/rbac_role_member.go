@@ -0,0 +1,1096 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RbacRoleMember is an object representing the database table.
+type RbacRoleMember struct {
+	RoleID    string    `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *rbacRoleMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rbacRoleMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RbacRoleMemberColumns = struct {
+	RoleID    string
+	UserID    string
+	CreatedAt string
+}{
+	RoleID:    "role_id",
+	UserID:    "user_id",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var RbacRoleMemberWhere = struct {
+	RoleID    whereHelperstring
+	UserID    whereHelperstring
+	CreatedAt whereHelpernull_Time
+}{
+	RoleID:    whereHelperstring{field: "\"rbac_role_member\".\"role_id\""},
+	UserID:    whereHelperstring{field: "\"rbac_role_member\".\"user_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"rbac_role_member\".\"created_at\""},
+}
+
+// RbacRoleMemberRels is where relationship names are stored.
+var RbacRoleMemberRels = struct {
+	Role string
+	User string
+}{
+	Role: "Role",
+	User: "User",
+}
+
+// rbacRoleMemberR is where relationships are stored.
+type rbacRoleMemberR struct {
+	Role *RbacRole `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	User *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rbacRoleMemberR) NewStruct() *rbacRoleMemberR {
+	return &rbacRoleMemberR{}
+}
+
+// rbacRoleMemberL is where Load methods for each relationship are stored.
+type rbacRoleMemberL struct{}
+
+var (
+	rbacRoleMemberAllColumns            = []string{"role_id", "user_id", "created_at"}
+	rbacRoleMemberColumnsWithoutDefault = []string{"role_id", "user_id", "created_at"}
+	rbacRoleMemberColumnsWithDefault    = []string{}
+	rbacRoleMemberPrimaryKeyColumns     = []string{"role_id", "user_id"}
+)
+
+type (
+	// RbacRoleMemberSlice is an alias for a slice of pointers to RbacRoleMember.
+	// This should generally be used opposed to []RbacRoleMember.
+	RbacRoleMemberSlice []*RbacRoleMember
+
+	rbacRoleMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rbacRoleMemberType                 = reflect.TypeOf(&RbacRoleMember{})
+	rbacRoleMemberMapping              = queries.MakeStructMapping(rbacRoleMemberType)
+	rbacRoleMemberPrimaryKeyMapping, _ = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, rbacRoleMemberPrimaryKeyColumns)
+	rbacRoleMemberInsertCacheMut       sync.RWMutex
+	rbacRoleMemberInsertCache          = make(map[string]insertCache)
+	rbacRoleMemberUpdateCacheMut       sync.RWMutex
+	rbacRoleMemberUpdateCache          = make(map[string]updateCache)
+	rbacRoleMemberUpsertCacheMut       sync.RWMutex
+	rbacRoleMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single rbacRoleMember record from the query using the global executor.
+func (q rbacRoleMemberQuery) OneG(ctx context.Context) (*RbacRoleMember, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single rbacRoleMember record from the query.
+func (q rbacRoleMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RbacRoleMember, error) {
+	o := &RbacRoleMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rbac_role_member")
+	}
+
+	return o, nil
+}
+
+// AllG returns all RbacRoleMember records from the query using the global executor.
+func (q rbacRoleMemberQuery) AllG(ctx context.Context) (RbacRoleMemberSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RbacRoleMember records from the query.
+func (q rbacRoleMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (RbacRoleMemberSlice, error) {
+	var o []*RbacRoleMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RbacRoleMember slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RbacRoleMember records in the query, and panics on error.
+func (q rbacRoleMemberQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RbacRoleMember records in the query.
+func (q rbacRoleMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rbac_role_member rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q rbacRoleMemberQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rbacRoleMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rbac_role_member exists")
+	}
+
+	return count > 0, nil
+}
+
+// Role pointed to by the foreign key.
+func (o *RbacRoleMember) Role(mods ...qm.QueryMod) rbacRoleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := RbacRoles(queryMods...)
+	queries.SetFrom(query.Query, "\"rbac_role\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *RbacRoleMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rbacRoleMemberL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRbacRoleMember interface{}, mods queries.Applicator) error {
+	var slice []*RbacRoleMember
+	var object *RbacRoleMember
+
+	if singular {
+		object = maybeRbacRoleMember.(*RbacRoleMember)
+	} else {
+		slice = *maybeRbacRoleMember.(*[]*RbacRoleMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rbacRoleMemberR{}
+		}
+		args = append(args, object.RoleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rbacRoleMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RoleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RoleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rbac_role`),
+		qm.WhereIn(`rbac_role.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load RbacRole")
+	}
+
+	var resultSlice []*RbacRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice RbacRole")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for rbac_role")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rbac_role")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Role = foreign
+		if foreign.R == nil {
+			foreign.R = &rbacRoleR{}
+		}
+		foreign.R.RoleRbacRoleMembers = append(foreign.R.RoleRbacRoleMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoleID == foreign.ID {
+				local.R.Role = foreign
+				if foreign.R == nil {
+					foreign.R = &rbacRoleR{}
+				}
+				foreign.R.RoleRbacRoleMembers = append(foreign.R.RoleRbacRoleMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rbacRoleMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRbacRoleMember interface{}, mods queries.Applicator) error {
+	var slice []*RbacRoleMember
+	var object *RbacRoleMember
+
+	if singular {
+		object = maybeRbacRoleMember.(*RbacRoleMember)
+	} else {
+		slice = *maybeRbacRoleMember.(*[]*RbacRoleMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rbacRoleMemberR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rbacRoleMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RbacRoleMembers = append(foreign.R.RbacRoleMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RbacRoleMembers = append(foreign.R.RbacRoleMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRoleG of the rbacRoleMember to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.RoleRbacRoleMembers.
+// Uses the global database handle.
+func (o *RbacRoleMember) SetRoleG(ctx context.Context, insert bool, related *RbacRole) error {
+	return o.SetRole(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetRole of the rbacRoleMember to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.RoleRbacRoleMembers.
+func (o *RbacRoleMember) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *RbacRole) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rbac_role_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rbacRoleMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RoleID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleID = related.ID
+	if o.R == nil {
+		o.R = &rbacRoleMemberR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &rbacRoleR{
+			RoleRbacRoleMembers: RbacRoleMemberSlice{o},
+		}
+	} else {
+		related.R.RoleRbacRoleMembers = append(related.R.RoleRbacRoleMembers, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the rbacRoleMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.RbacRoleMembers.
+// Uses the global database handle.
+func (o *RbacRoleMember) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the rbacRoleMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.RbacRoleMembers.
+func (o *RbacRoleMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rbac_role_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rbacRoleMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RoleID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &rbacRoleMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RbacRoleMembers: RbacRoleMemberSlice{o},
+		}
+	} else {
+		related.R.RbacRoleMembers = append(related.R.RbacRoleMembers, o)
+	}
+
+	return nil
+}
+
+// RbacRoleMembers retrieves all the records using an executor.
+func RbacRoleMembers(mods ...qm.QueryMod) rbacRoleMemberQuery {
+	mods = append(mods, qm.From("\"rbac_role_member\""))
+	return rbacRoleMemberQuery{NewQuery(mods...)}
+}
+
+// FindRbacRoleMemberG retrieves a single record by ID.
+func FindRbacRoleMemberG(ctx context.Context, roleID string, userID string, selectCols ...string) (*RbacRoleMember, error) {
+	return FindRbacRoleMember(ctx, boil.GetContextDB(), roleID, userID, selectCols...)
+}
+
+// FindRbacRoleMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRbacRoleMember(ctx context.Context, exec boil.ContextExecutor, roleID string, userID string, selectCols ...string) (*RbacRoleMember, error) {
+	rbacRoleMemberObj := &RbacRoleMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rbac_role_member\" where \"role_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, roleID, userID)
+
+	err := q.Bind(ctx, exec, rbacRoleMemberObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rbac_role_member")
+	}
+
+	return rbacRoleMemberObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RbacRoleMember) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RbacRoleMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rbac_role_member provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rbacRoleMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rbacRoleMemberInsertCacheMut.RLock()
+	cache, cached := rbacRoleMemberInsertCache[key]
+	rbacRoleMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rbacRoleMemberAllColumns,
+			rbacRoleMemberColumnsWithDefault,
+			rbacRoleMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rbac_role_member\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rbac_role_member\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rbac_role_member")
+	}
+
+	if !cached {
+		rbacRoleMemberInsertCacheMut.Lock()
+		rbacRoleMemberInsertCache[key] = cache
+		rbacRoleMemberInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single RbacRoleMember record using the global executor.
+// See Update for more documentation.
+func (o *RbacRoleMember) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RbacRoleMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RbacRoleMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	rbacRoleMemberUpdateCacheMut.RLock()
+	cache, cached := rbacRoleMemberUpdateCache[key]
+	rbacRoleMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rbacRoleMemberAllColumns,
+			rbacRoleMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rbac_role_member, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rbac_role_member\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rbacRoleMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, append(wl, rbacRoleMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rbac_role_member row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rbac_role_member")
+	}
+
+	if !cached {
+		rbacRoleMemberUpdateCacheMut.Lock()
+		rbacRoleMemberUpdateCache[key] = cache
+		rbacRoleMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rbacRoleMemberQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rbacRoleMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rbac_role_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rbac_role_member")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RbacRoleMemberSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RbacRoleMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRoleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rbac_role_member\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rbacRoleMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rbacRoleMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rbacRoleMember")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RbacRoleMember) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RbacRoleMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rbac_role_member provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rbacRoleMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rbacRoleMemberUpsertCacheMut.RLock()
+	cache, cached := rbacRoleMemberUpsertCache[key]
+	rbacRoleMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rbacRoleMemberAllColumns,
+			rbacRoleMemberColumnsWithDefault,
+			rbacRoleMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			rbacRoleMemberAllColumns,
+			rbacRoleMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rbac_role_member, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(rbacRoleMemberPrimaryKeyColumns))
+			copy(conflict, rbacRoleMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rbac_role_member\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rbacRoleMemberType, rbacRoleMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rbac_role_member")
+	}
+
+	if !cached {
+		rbacRoleMemberUpsertCacheMut.Lock()
+		rbacRoleMemberUpsertCache[key] = cache
+		rbacRoleMemberUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single RbacRoleMember record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RbacRoleMember) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RbacRoleMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RbacRoleMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RbacRoleMember provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rbacRoleMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"rbac_role_member\" WHERE \"role_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rbac_role_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rbac_role_member")
+	}
+
+	return rowsAff, nil
+}
+
+func (q rbacRoleMemberQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q rbacRoleMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rbacRoleMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rbac_role_member")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rbac_role_member")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RbacRoleMemberSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RbacRoleMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRoleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rbac_role_member\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rbacRoleMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rbacRoleMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rbac_role_member")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RbacRoleMember) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no RbacRoleMember provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RbacRoleMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRbacRoleMember(ctx, exec, o.RoleID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RbacRoleMemberSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty RbacRoleMemberSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RbacRoleMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RbacRoleMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rbacRoleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rbac_role_member\".* FROM \"rbac_role_member\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rbacRoleMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RbacRoleMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RbacRoleMemberExistsG checks if the RbacRoleMember row exists.
+func RbacRoleMemberExistsG(ctx context.Context, roleID string, userID string) (bool, error) {
+	return RbacRoleMemberExists(ctx, boil.GetContextDB(), roleID, userID)
+}
+
+// RbacRoleMemberExists checks if the RbacRoleMember row exists.
+func RbacRoleMemberExists(ctx context.Context, exec boil.ContextExecutor, roleID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rbac_role_member\" where \"role_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, roleID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, roleID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rbac_role_member exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRbacRoleMembers(t *testing.T) {
+	t.Parallel()
+
+	query := RbacRoleMembers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRbacRoleMembersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRoleMembersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RbacRoleMembers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRoleMembersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RbacRoleMemberSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRoleMembersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RbacRoleMemberExists(ctx, tx, o.RoleID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if RbacRoleMember exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RbacRoleMemberExists to return true, but got false.")
+	}
+}
+
+func testRbacRoleMembersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rbacRoleMemberFound, err := FindRbacRoleMember(ctx, tx, o.RoleID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rbacRoleMemberFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRbacRoleMembersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RbacRoleMembers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRoleMembersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RbacRoleMembers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRbacRoleMembersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rbacRoleMemberOne := &RbacRoleMember{}
+	rbacRoleMemberTwo := &RbacRoleMember{}
+	if err = randomize.Struct(seed, rbacRoleMemberOne, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rbacRoleMemberTwo, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rbacRoleMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rbacRoleMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RbacRoleMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRbacRoleMembersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rbacRoleMemberOne := &RbacRoleMember{}
+	rbacRoleMemberTwo := &RbacRoleMember{}
+	if err = randomize.Struct(seed, rbacRoleMemberOne, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rbacRoleMemberTwo, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rbacRoleMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rbacRoleMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testRbacRoleMembersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRbacRoleMembersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rbacRoleMemberColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRbacRoleMemberToOneRbacRoleUsingRole(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RbacRoleMember
+	var foreign RbacRole
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RoleID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Role().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RbacRoleMemberSlice{&local}
+	if err = local.L.LoadRole(ctx, tx, false, (*[]*RbacRoleMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Role == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Role = nil
+	if err = local.L.LoadRole(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Role == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRbacRoleMemberToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RbacRoleMember
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RbacRoleMemberSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*RbacRoleMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRbacRoleMemberToOneSetOpRbacRoleUsingRole(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RbacRoleMember
+	var b, c RbacRole
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rbacRoleMemberDBTypes, false, strmangle.SetComplement(rbacRoleMemberPrimaryKeyColumns, rbacRoleMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, rbacRoleDBTypes, false, strmangle.SetComplement(rbacRolePrimaryKeyColumns, rbacRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rbacRoleDBTypes, false, strmangle.SetComplement(rbacRolePrimaryKeyColumns, rbacRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*RbacRole{&b, &c} {
+		err = a.SetRole(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Role != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RoleRbacRoleMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RoleID != x.ID {
+			t.Error("foreign key was wrong value", a.RoleID)
+		}
+
+		if exists, err := RbacRoleMemberExists(ctx, tx, a.RoleID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testRbacRoleMemberToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RbacRoleMember
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rbacRoleMemberDBTypes, false, strmangle.SetComplement(rbacRoleMemberPrimaryKeyColumns, rbacRoleMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RbacRoleMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := RbacRoleMemberExists(ctx, tx, a.RoleID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testRbacRoleMembersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRoleMembersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RbacRoleMemberSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRoleMembersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RbacRoleMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rbacRoleMemberDBTypes = map[string]string{`RoleID`: `uuid`, `UserID`: `uuid`, `CreatedAt`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testRbacRoleMembersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rbacRoleMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rbacRoleMemberAllColumns) == len(rbacRoleMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRbacRoleMembersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rbacRoleMemberAllColumns) == len(rbacRoleMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRoleMember{}
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rbacRoleMemberDBTypes, true, rbacRoleMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rbacRoleMemberAllColumns, rbacRoleMemberPrimaryKeyColumns) {
+		fields = rbacRoleMemberAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rbacRoleMemberAllColumns,
+			rbacRoleMemberPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RbacRoleMemberSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRbacRoleMembersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rbacRoleMemberAllColumns) == len(rbacRoleMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RbacRoleMember{}
+	if err = randomize.Struct(seed, &o, rbacRoleMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RbacRoleMember: %s", err)
+	}
+
+	count, err := RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rbacRoleMemberDBTypes, false, rbacRoleMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRoleMember struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RbacRoleMember: %s", err)
+	}
+
+	count, err = RbacRoleMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,853 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRbacRoles(t *testing.T) {
+	t.Parallel()
+
+	query := RbacRoles()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRbacRolesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRolesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RbacRoles().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRolesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RbacRoleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRbacRolesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RbacRoleExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if RbacRole exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RbacRoleExists to return true, but got false.")
+	}
+}
+
+func testRbacRolesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rbacRoleFound, err := FindRbacRole(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rbacRoleFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRbacRolesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RbacRoles().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRolesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RbacRoles().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRbacRolesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rbacRoleOne := &RbacRole{}
+	rbacRoleTwo := &RbacRole{}
+	if err = randomize.Struct(seed, rbacRoleOne, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rbacRoleTwo, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rbacRoleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rbacRoleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RbacRoles().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRbacRolesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rbacRoleOne := &RbacRole{}
+	rbacRoleTwo := &RbacRole{}
+	if err = randomize.Struct(seed, rbacRoleOne, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rbacRoleTwo, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rbacRoleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rbacRoleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testRbacRolesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRbacRolesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rbacRoleColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRbacRoleToManyRoleRbacRoleMembers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RbacRole
+	var b, c RbacRoleMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rbacRoleMemberDBTypes, false, rbacRoleMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RoleID = a.ID
+	c.RoleID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RoleRbacRoleMembers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RoleID == b.RoleID {
+			bFound = true
+		}
+		if v.RoleID == c.RoleID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RbacRoleSlice{&a}
+	if err = a.L.LoadRoleRbacRoleMembers(ctx, tx, false, (*[]*RbacRole)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RoleRbacRoleMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RoleRbacRoleMembers = nil
+	if err = a.L.LoadRoleRbacRoleMembers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RoleRbacRoleMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRbacRoleToManyAddOpRoleRbacRoleMembers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RbacRole
+	var b, c, d, e RbacRoleMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rbacRoleDBTypes, false, strmangle.SetComplement(rbacRolePrimaryKeyColumns, rbacRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RbacRoleMember{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rbacRoleMemberDBTypes, false, strmangle.SetComplement(rbacRoleMemberPrimaryKeyColumns, rbacRoleMemberColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RbacRoleMember{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRoleRbacRoleMembers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RoleID {
+			t.Error("foreign key was wrong value", a.ID, first.RoleID)
+		}
+		if a.ID != second.RoleID {
+			t.Error("foreign key was wrong value", a.ID, second.RoleID)
+		}
+
+		if first.R.Role != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Role != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RoleRbacRoleMembers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RoleRbacRoleMembers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RoleRbacRoleMembers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testRbacRoleToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RbacRole
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rbacRoleDBTypes, false, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SchoolID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RbacRoleSlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*RbacRole)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRbacRoleToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RbacRole
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rbacRoleDBTypes, false, strmangle.SetComplement(rbacRolePrimaryKeyColumns, rbacRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RbacRoles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testRbacRolesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRolesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RbacRoleSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRbacRolesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RbacRoles().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rbacRoleDBTypes = map[string]string{`ID`: `uuid`, `SchoolID`: `uuid`, `Name`: `character varying`, `Permissions`: `ARRAYtext`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_               = bytes.MinRead
+)
+
+func testRbacRolesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rbacRolePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rbacRoleAllColumns) == len(rbacRolePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRolePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRbacRolesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rbacRoleAllColumns) == len(rbacRolePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RbacRole{}
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rbacRoleDBTypes, true, rbacRolePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rbacRoleAllColumns, rbacRolePrimaryKeyColumns) {
+		fields = rbacRoleAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rbacRoleAllColumns,
+			rbacRolePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RbacRoleSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRbacRolesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rbacRoleAllColumns) == len(rbacRolePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RbacRole{}
+	if err = randomize.Struct(seed, &o, rbacRoleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RbacRole: %s", err)
+	}
+
+	count, err := RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rbacRoleDBTypes, false, rbacRolePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RbacRole struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RbacRole: %s", err)
+	}
+
+	count, err = RbacRoles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,948 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SchoolEmailDomain is an object representing the database table.
+type SchoolEmailDomain struct {
+	Domain    string    `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	SchoolID  string    `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Role      string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *schoolEmailDomainR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L schoolEmailDomainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SchoolEmailDomainColumns = struct {
+	Domain    string
+	SchoolID  string
+	Role      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	Domain:    "domain",
+	SchoolID:  "school_id",
+	Role:      "role",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var SchoolEmailDomainWhere = struct {
+	Domain    whereHelperstring
+	SchoolID  whereHelperstring
+	Role      whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	Domain:    whereHelperstring{field: "\"school_email_domain\".\"domain\""},
+	SchoolID:  whereHelperstring{field: "\"school_email_domain\".\"school_id\""},
+	Role:      whereHelperstring{field: "\"school_email_domain\".\"role\""},
+	CreatedAt: whereHelpernull_Time{field: "\"school_email_domain\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"school_email_domain\".\"updated_at\""},
+}
+
+// SchoolEmailDomainRels is where relationship names are stored.
+var SchoolEmailDomainRels = struct {
+	School string
+}{
+	School: "School",
+}
+
+// schoolEmailDomainR is where relationships are stored.
+type schoolEmailDomainR struct {
+	School *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+}
+
+// NewStruct creates a new relationship struct
+func (*schoolEmailDomainR) NewStruct() *schoolEmailDomainR {
+	return &schoolEmailDomainR{}
+}
+
+// schoolEmailDomainL is where Load methods for each relationship are stored.
+type schoolEmailDomainL struct{}
+
+var (
+	schoolEmailDomainAllColumns            = []string{"domain", "school_id", "role", "created_at", "updated_at"}
+	schoolEmailDomainColumnsWithoutDefault = []string{"domain", "school_id", "role", "created_at", "updated_at"}
+	schoolEmailDomainColumnsWithDefault    = []string{}
+	schoolEmailDomainPrimaryKeyColumns     = []string{"domain"}
+)
+
+type (
+	// SchoolEmailDomainSlice is an alias for a slice of pointers to SchoolEmailDomain.
+	// This should generally be used opposed to []SchoolEmailDomain.
+	SchoolEmailDomainSlice []*SchoolEmailDomain
+
+	schoolEmailDomainQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	schoolEmailDomainType                 = reflect.TypeOf(&SchoolEmailDomain{})
+	schoolEmailDomainMapping              = queries.MakeStructMapping(schoolEmailDomainType)
+	schoolEmailDomainPrimaryKeyMapping, _ = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, schoolEmailDomainPrimaryKeyColumns)
+	schoolEmailDomainInsertCacheMut       sync.RWMutex
+	schoolEmailDomainInsertCache          = make(map[string]insertCache)
+	schoolEmailDomainUpdateCacheMut       sync.RWMutex
+	schoolEmailDomainUpdateCache          = make(map[string]updateCache)
+	schoolEmailDomainUpsertCacheMut       sync.RWMutex
+	schoolEmailDomainUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single schoolEmailDomain record from the query using the global executor.
+func (q schoolEmailDomainQuery) OneG(ctx context.Context) (*SchoolEmailDomain, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single schoolEmailDomain record from the query.
+func (q schoolEmailDomainQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SchoolEmailDomain, error) {
+	o := &SchoolEmailDomain{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for school_email_domain")
+	}
+
+	return o, nil
+}
+
+// AllG returns all SchoolEmailDomain records from the query using the global executor.
+func (q schoolEmailDomainQuery) AllG(ctx context.Context) (SchoolEmailDomainSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SchoolEmailDomain records from the query.
+func (q schoolEmailDomainQuery) All(ctx context.Context, exec boil.ContextExecutor) (SchoolEmailDomainSlice, error) {
+	var o []*SchoolEmailDomain
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SchoolEmailDomain slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SchoolEmailDomain records in the query, and panics on error.
+func (q schoolEmailDomainQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SchoolEmailDomain records in the query.
+func (q schoolEmailDomainQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count school_email_domain rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q schoolEmailDomainQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q schoolEmailDomainQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if school_email_domain exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *SchoolEmailDomain) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (schoolEmailDomainL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSchoolEmailDomain interface{}, mods queries.Applicator) error {
+	var slice []*SchoolEmailDomain
+	var object *SchoolEmailDomain
+
+	if singular {
+		object = maybeSchoolEmailDomain.(*SchoolEmailDomain)
+	} else {
+		slice = *maybeSchoolEmailDomain.(*[]*SchoolEmailDomain)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &schoolEmailDomainR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &schoolEmailDomainR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.SchoolEmailDomains = append(foreign.R.SchoolEmailDomains, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.SchoolEmailDomains = append(foreign.R.SchoolEmailDomains, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the schoolEmailDomain to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.SchoolEmailDomains.
+// Uses the global database handle.
+func (o *SchoolEmailDomain) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the schoolEmailDomain to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.SchoolEmailDomains.
+func (o *SchoolEmailDomain) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"school_email_domain\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, schoolEmailDomainPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Domain}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &schoolEmailDomainR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			SchoolEmailDomains: SchoolEmailDomainSlice{o},
+		}
+	} else {
+		related.R.SchoolEmailDomains = append(related.R.SchoolEmailDomains, o)
+	}
+
+	return nil
+}
+
+// SchoolEmailDomains retrieves all the records using an executor.
+func SchoolEmailDomains(mods ...qm.QueryMod) schoolEmailDomainQuery {
+	mods = append(mods, qm.From("\"school_email_domain\""))
+	return schoolEmailDomainQuery{NewQuery(mods...)}
+}
+
+// FindSchoolEmailDomainG retrieves a single record by ID.
+func FindSchoolEmailDomainG(ctx context.Context, domain string, selectCols ...string) (*SchoolEmailDomain, error) {
+	return FindSchoolEmailDomain(ctx, boil.GetContextDB(), domain, selectCols...)
+}
+
+// FindSchoolEmailDomain retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSchoolEmailDomain(ctx context.Context, exec boil.ContextExecutor, domain string, selectCols ...string) (*SchoolEmailDomain, error) {
+	schoolEmailDomainObj := &SchoolEmailDomain{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"school_email_domain\" where \"domain\"=$1", sel,
+	)
+
+	q := queries.Raw(query, domain)
+
+	err := q.Bind(ctx, exec, schoolEmailDomainObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from school_email_domain")
+	}
+
+	return schoolEmailDomainObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SchoolEmailDomain) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SchoolEmailDomain) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no school_email_domain provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(schoolEmailDomainColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	schoolEmailDomainInsertCacheMut.RLock()
+	cache, cached := schoolEmailDomainInsertCache[key]
+	schoolEmailDomainInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			schoolEmailDomainAllColumns,
+			schoolEmailDomainColumnsWithDefault,
+			schoolEmailDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"school_email_domain\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"school_email_domain\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into school_email_domain")
+	}
+
+	if !cached {
+		schoolEmailDomainInsertCacheMut.Lock()
+		schoolEmailDomainInsertCache[key] = cache
+		schoolEmailDomainInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single SchoolEmailDomain record using the global executor.
+// See Update for more documentation.
+func (o *SchoolEmailDomain) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SchoolEmailDomain.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SchoolEmailDomain) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	schoolEmailDomainUpdateCacheMut.RLock()
+	cache, cached := schoolEmailDomainUpdateCache[key]
+	schoolEmailDomainUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			schoolEmailDomainAllColumns,
+			schoolEmailDomainPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update school_email_domain, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"school_email_domain\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, schoolEmailDomainPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, append(wl, schoolEmailDomainPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update school_email_domain row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for school_email_domain")
+	}
+
+	if !cached {
+		schoolEmailDomainUpdateCacheMut.Lock()
+		schoolEmailDomainUpdateCache[key] = cache
+		schoolEmailDomainUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q schoolEmailDomainQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q schoolEmailDomainQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for school_email_domain")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for school_email_domain")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SchoolEmailDomainSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SchoolEmailDomainSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schoolEmailDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"school_email_domain\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, schoolEmailDomainPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in schoolEmailDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all schoolEmailDomain")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SchoolEmailDomain) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SchoolEmailDomain) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no school_email_domain provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(schoolEmailDomainColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	schoolEmailDomainUpsertCacheMut.RLock()
+	cache, cached := schoolEmailDomainUpsertCache[key]
+	schoolEmailDomainUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			schoolEmailDomainAllColumns,
+			schoolEmailDomainColumnsWithDefault,
+			schoolEmailDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			schoolEmailDomainAllColumns,
+			schoolEmailDomainPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert school_email_domain, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(schoolEmailDomainPrimaryKeyColumns))
+			copy(conflict, schoolEmailDomainPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"school_email_domain\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(schoolEmailDomainType, schoolEmailDomainMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert school_email_domain")
+	}
+
+	if !cached {
+		schoolEmailDomainUpsertCacheMut.Lock()
+		schoolEmailDomainUpsertCache[key] = cache
+		schoolEmailDomainUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single SchoolEmailDomain record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SchoolEmailDomain) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SchoolEmailDomain record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SchoolEmailDomain) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SchoolEmailDomain provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), schoolEmailDomainPrimaryKeyMapping)
+	sql := "DELETE FROM \"school_email_domain\" WHERE \"domain\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from school_email_domain")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for school_email_domain")
+	}
+
+	return rowsAff, nil
+}
+
+func (q schoolEmailDomainQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q schoolEmailDomainQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no schoolEmailDomainQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from school_email_domain")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for school_email_domain")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SchoolEmailDomainSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SchoolEmailDomainSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schoolEmailDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"school_email_domain\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schoolEmailDomainPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from schoolEmailDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for school_email_domain")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SchoolEmailDomain) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no SchoolEmailDomain provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SchoolEmailDomain) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSchoolEmailDomain(ctx, exec, o.Domain)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SchoolEmailDomainSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty SchoolEmailDomainSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SchoolEmailDomainSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SchoolEmailDomainSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schoolEmailDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"school_email_domain\".* FROM \"school_email_domain\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schoolEmailDomainPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SchoolEmailDomainSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SchoolEmailDomainExistsG checks if the SchoolEmailDomain row exists.
+func SchoolEmailDomainExistsG(ctx context.Context, domain string) (bool, error) {
+	return SchoolEmailDomainExists(ctx, boil.GetContextDB(), domain)
+}
+
+// SchoolEmailDomainExists checks if the SchoolEmailDomain row exists.
+func SchoolEmailDomainExists(ctx context.Context, exec boil.ContextExecutor, domain string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"school_email_domain\" where \"domain\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, domain)
+	}
+	row := exec.QueryRowContext(ctx, sql, domain)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if school_email_domain exists")
+	}
+
+	return exists, nil
+}
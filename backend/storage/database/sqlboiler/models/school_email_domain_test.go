@@ -0,0 +1,700 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSchoolEmailDomains(t *testing.T) {
+	t.Parallel()
+
+	query := SchoolEmailDomains()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSchoolEmailDomainsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchoolEmailDomainsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SchoolEmailDomains().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchoolEmailDomainsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SchoolEmailDomainSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchoolEmailDomainsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SchoolEmailDomainExists(ctx, tx, o.Domain)
+	if err != nil {
+		t.Errorf("Unable to check if SchoolEmailDomain exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SchoolEmailDomainExists to return true, but got false.")
+	}
+}
+
+func testSchoolEmailDomainsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	schoolEmailDomainFound, err := FindSchoolEmailDomain(ctx, tx, o.Domain)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if schoolEmailDomainFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSchoolEmailDomainsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SchoolEmailDomains().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchoolEmailDomainsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SchoolEmailDomains().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSchoolEmailDomainsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	schoolEmailDomainOne := &SchoolEmailDomain{}
+	schoolEmailDomainTwo := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, schoolEmailDomainOne, schoolEmailDomainDBTypes, false, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+	if err = randomize.Struct(seed, schoolEmailDomainTwo, schoolEmailDomainDBTypes, false, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = schoolEmailDomainOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = schoolEmailDomainTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SchoolEmailDomains().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSchoolEmailDomainsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	schoolEmailDomainOne := &SchoolEmailDomain{}
+	schoolEmailDomainTwo := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, schoolEmailDomainOne, schoolEmailDomainDBTypes, false, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+	if err = randomize.Struct(seed, schoolEmailDomainTwo, schoolEmailDomainDBTypes, false, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = schoolEmailDomainOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = schoolEmailDomainTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testSchoolEmailDomainsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSchoolEmailDomainsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(schoolEmailDomainColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSchoolEmailDomainToOneSchoolUsingSchool(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SchoolEmailDomain
+	var foreign School
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, schoolEmailDomainDBTypes, false, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, schoolDBTypes, false, schoolColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize School struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SchoolID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.School().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SchoolEmailDomainSlice{&local}
+	if err = local.L.LoadSchool(ctx, tx, false, (*[]*SchoolEmailDomain)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.School = nil
+	if err = local.L.LoadSchool(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.School == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSchoolEmailDomainToOneSetOpSchoolUsingSchool(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SchoolEmailDomain
+	var b, c School
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, schoolEmailDomainDBTypes, false, strmangle.SetComplement(schoolEmailDomainPrimaryKeyColumns, schoolEmailDomainColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, schoolDBTypes, false, strmangle.SetComplement(schoolPrimaryKeyColumns, schoolColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*School{&b, &c} {
+		err = a.SetSchool(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.School != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SchoolEmailDomains[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SchoolID))
+		reflect.Indirect(reflect.ValueOf(&a.SchoolID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.SchoolID != x.ID {
+			t.Error("foreign key was wrong value", a.SchoolID, x.ID)
+		}
+	}
+}
+
+func testSchoolEmailDomainsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchoolEmailDomainsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SchoolEmailDomainSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchoolEmailDomainsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SchoolEmailDomains().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	schoolEmailDomainDBTypes = map[string]string{`Domain`: `character varying`, `SchoolID`: `uuid`, `Role`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                        = bytes.MinRead
+)
+
+func testSchoolEmailDomainsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(schoolEmailDomainPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(schoolEmailDomainAllColumns) == len(schoolEmailDomainPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSchoolEmailDomainsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(schoolEmailDomainAllColumns) == len(schoolEmailDomainPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchoolEmailDomain{}
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, schoolEmailDomainDBTypes, true, schoolEmailDomainPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(schoolEmailDomainAllColumns, schoolEmailDomainPrimaryKeyColumns) {
+		fields = schoolEmailDomainAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			schoolEmailDomainAllColumns,
+			schoolEmailDomainPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SchoolEmailDomainSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSchoolEmailDomainsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(schoolEmailDomainAllColumns) == len(schoolEmailDomainPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SchoolEmailDomain{}
+	if err = randomize.Struct(seed, &o, schoolEmailDomainDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SchoolEmailDomain: %s", err)
+	}
+
+	count, err := SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, schoolEmailDomainDBTypes, false, schoolEmailDomainPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchoolEmailDomain struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SchoolEmailDomain: %s", err)
+	}
+
+	count, err = SchoolEmailDomains().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
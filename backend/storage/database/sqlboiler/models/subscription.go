@@ -0,0 +1,978 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Subscription is an object representing the database table.
+type Subscription struct {
+	SchoolID             string      `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Plan                 string      `boil:"plan" json:"plan" toml:"plan" yaml:"plan"`
+	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	OwnerName            null.String `boil:"owner_name" json:"owner_name,omitempty" toml:"owner_name" yaml:"owner_name,omitempty"`
+	OwnerEmail           null.String `boil:"owner_email" json:"owner_email,omitempty" toml:"owner_email" yaml:"owner_email,omitempty"`
+	PaymentDueDate       time.Time   `boil:"payment_due_date" json:"payment_due_date" toml:"payment_due_date" yaml:"payment_due_date"`
+	PaymentExtensionDate time.Time   `boil:"payment_extension_date" json:"payment_extension_date" toml:"payment_extension_date" yaml:"payment_extension_date"`
+	WarningsSent         int         `boil:"warnings_sent" json:"warnings_sent" toml:"warnings_sent" yaml:"warnings_sent"`
+	LastWarnedAt         null.Time   `boil:"last_warned_at" json:"last_warned_at,omitempty" toml:"last_warned_at" yaml:"last_warned_at,omitempty"`
+	CreatedAt            null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt            null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *subscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L subscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SubscriptionColumns = struct {
+	SchoolID             string
+	Plan                 string
+	Status               string
+	OwnerName            string
+	OwnerEmail           string
+	PaymentDueDate       string
+	PaymentExtensionDate string
+	WarningsSent         string
+	LastWarnedAt         string
+	CreatedAt            string
+	UpdatedAt            string
+}{
+	SchoolID:             "school_id",
+	Plan:                 "plan",
+	Status:               "status",
+	OwnerName:            "owner_name",
+	OwnerEmail:           "owner_email",
+	PaymentDueDate:       "payment_due_date",
+	PaymentExtensionDate: "payment_extension_date",
+	WarningsSent:         "warnings_sent",
+	LastWarnedAt:         "last_warned_at",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+}
+
+// Generated where
+
+var SubscriptionWhere = struct {
+	SchoolID             whereHelperstring
+	Plan                 whereHelperstring
+	Status               whereHelperstring
+	OwnerName            whereHelpernull_String
+	OwnerEmail           whereHelpernull_String
+	PaymentDueDate       whereHelpertime_Time
+	PaymentExtensionDate whereHelpertime_Time
+	WarningsSent         whereHelperint
+	LastWarnedAt         whereHelpernull_Time
+	CreatedAt            whereHelpernull_Time
+	UpdatedAt            whereHelpernull_Time
+}{
+	SchoolID:             whereHelperstring{field: "\"subscription\".\"school_id\""},
+	Plan:                 whereHelperstring{field: "\"subscription\".\"plan\""},
+	Status:               whereHelperstring{field: "\"subscription\".\"status\""},
+	OwnerName:            whereHelpernull_String{field: "\"subscription\".\"owner_name\""},
+	OwnerEmail:           whereHelpernull_String{field: "\"subscription\".\"owner_email\""},
+	PaymentDueDate:       whereHelpertime_Time{field: "\"subscription\".\"payment_due_date\""},
+	PaymentExtensionDate: whereHelpertime_Time{field: "\"subscription\".\"payment_extension_date\""},
+	WarningsSent:         whereHelperint{field: "\"subscription\".\"warnings_sent\""},
+	LastWarnedAt:         whereHelpernull_Time{field: "\"subscription\".\"last_warned_at\""},
+	CreatedAt:            whereHelpernull_Time{field: "\"subscription\".\"created_at\""},
+	UpdatedAt:            whereHelpernull_Time{field: "\"subscription\".\"updated_at\""},
+}
+
+// SubscriptionRels is where relationship names are stored.
+var SubscriptionRels = struct {
+	School string
+}{
+	School: "School",
+}
+
+// subscriptionR is where relationships are stored.
+type subscriptionR struct {
+	School *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+}
+
+// NewStruct creates a new relationship struct
+func (*subscriptionR) NewStruct() *subscriptionR {
+	return &subscriptionR{}
+}
+
+// subscriptionL is where Load methods for each relationship are stored.
+type subscriptionL struct{}
+
+var (
+	subscriptionAllColumns            = []string{"school_id", "plan", "status", "owner_name", "owner_email", "payment_due_date", "payment_extension_date", "warnings_sent", "last_warned_at", "created_at", "updated_at"}
+	subscriptionColumnsWithoutDefault = []string{"school_id", "plan", "status", "owner_name", "owner_email", "payment_due_date", "payment_extension_date", "last_warned_at", "created_at", "updated_at"}
+	subscriptionColumnsWithDefault    = []string{"warnings_sent"}
+	subscriptionPrimaryKeyColumns     = []string{"school_id"}
+)
+
+type (
+	// SubscriptionSlice is an alias for a slice of pointers to Subscription.
+	// This should generally be used opposed to []Subscription.
+	SubscriptionSlice []*Subscription
+
+	subscriptionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	subscriptionType                 = reflect.TypeOf(&Subscription{})
+	subscriptionMapping              = queries.MakeStructMapping(subscriptionType)
+	subscriptionPrimaryKeyMapping, _ = queries.BindMapping(subscriptionType, subscriptionMapping, subscriptionPrimaryKeyColumns)
+	subscriptionInsertCacheMut       sync.RWMutex
+	subscriptionInsertCache          = make(map[string]insertCache)
+	subscriptionUpdateCacheMut       sync.RWMutex
+	subscriptionUpdateCache          = make(map[string]updateCache)
+	subscriptionUpsertCacheMut       sync.RWMutex
+	subscriptionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single subscription record from the query using the global executor.
+func (q subscriptionQuery) OneG(ctx context.Context) (*Subscription, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single subscription record from the query.
+func (q subscriptionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Subscription, error) {
+	o := &Subscription{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for subscription")
+	}
+
+	return o, nil
+}
+
+// AllG returns all Subscription records from the query using the global executor.
+func (q subscriptionQuery) AllG(ctx context.Context) (SubscriptionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Subscription records from the query.
+func (q subscriptionQuery) All(ctx context.Context, exec boil.ContextExecutor) (SubscriptionSlice, error) {
+	var o []*Subscription
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Subscription slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Subscription records in the query, and panics on error.
+func (q subscriptionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Subscription records in the query.
+func (q subscriptionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count subscription rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q subscriptionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q subscriptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if subscription exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *Subscription) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (subscriptionL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscription interface{}, mods queries.Applicator) error {
+	var slice []*Subscription
+	var object *Subscription
+
+	if singular {
+		object = maybeSubscription.(*Subscription)
+	} else {
+		slice = *maybeSubscription.(*[]*Subscription)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &subscriptionR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriptionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.Subscription = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the subscription to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Subscription.
+// Uses the global database handle.
+func (o *Subscription) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the subscription to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.Subscription.
+func (o *Subscription) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"subscription\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, subscriptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SchoolID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &subscriptionR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			Subscription: o,
+		}
+	} else {
+		related.R.Subscription = o
+	}
+
+	return nil
+}
+
+// Subscriptions retrieves all the records using an executor.
+func Subscriptions(mods ...qm.QueryMod) subscriptionQuery {
+	mods = append(mods, qm.From("\"subscription\""))
+	return subscriptionQuery{NewQuery(mods...)}
+}
+
+// FindSubscriptionG retrieves a single record by ID.
+func FindSubscriptionG(ctx context.Context, schoolID string, selectCols ...string) (*Subscription, error) {
+	return FindSubscription(ctx, boil.GetContextDB(), schoolID, selectCols...)
+}
+
+// FindSubscription retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSubscription(ctx context.Context, exec boil.ContextExecutor, schoolID string, selectCols ...string) (*Subscription, error) {
+	subscriptionObj := &Subscription{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"subscription\" where \"school_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, schoolID)
+
+	err := q.Bind(ctx, exec, subscriptionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from subscription")
+	}
+
+	return subscriptionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Subscription) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Subscription) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscription provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriptionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	subscriptionInsertCacheMut.RLock()
+	cache, cached := subscriptionInsertCache[key]
+	subscriptionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			subscriptionAllColumns,
+			subscriptionColumnsWithDefault,
+			subscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(subscriptionType, subscriptionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(subscriptionType, subscriptionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"subscription\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"subscription\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into subscription")
+	}
+
+	if !cached {
+		subscriptionInsertCacheMut.Lock()
+		subscriptionInsertCache[key] = cache
+		subscriptionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single Subscription record using the global executor.
+// See Update for more documentation.
+func (o *Subscription) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Subscription.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Subscription) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	subscriptionUpdateCacheMut.RLock()
+	cache, cached := subscriptionUpdateCache[key]
+	subscriptionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			subscriptionAllColumns,
+			subscriptionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update subscription, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"subscription\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, subscriptionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(subscriptionType, subscriptionMapping, append(wl, subscriptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update subscription row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for subscription")
+	}
+
+	if !cached {
+		subscriptionUpdateCacheMut.Lock()
+		subscriptionUpdateCache[key] = cache
+		subscriptionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q subscriptionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q subscriptionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for subscription")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for subscription")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SubscriptionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SubscriptionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"subscription\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, subscriptionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in subscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all subscription")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Subscription) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Subscription) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subscription provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subscriptionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	subscriptionUpsertCacheMut.RLock()
+	cache, cached := subscriptionUpsertCache[key]
+	subscriptionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			subscriptionAllColumns,
+			subscriptionColumnsWithDefault,
+			subscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			subscriptionAllColumns,
+			subscriptionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert subscription, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(subscriptionPrimaryKeyColumns))
+			copy(conflict, subscriptionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"subscription\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(subscriptionType, subscriptionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(subscriptionType, subscriptionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert subscription")
+	}
+
+	if !cached {
+		subscriptionUpsertCacheMut.Lock()
+		subscriptionUpsertCache[key] = cache
+		subscriptionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single Subscription record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Subscription) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Subscription record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Subscription) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Subscription provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subscriptionPrimaryKeyMapping)
+	sql := "DELETE FROM \"subscription\" WHERE \"school_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from subscription")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for subscription")
+	}
+
+	return rowsAff, nil
+}
+
+func (q subscriptionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q subscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no subscriptionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscription")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscription")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SubscriptionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"subscription\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subscription")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Subscription) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Subscription provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Subscription) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSubscription(ctx, exec, o.SchoolID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SubscriptionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty SubscriptionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SubscriptionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"subscription\".* FROM \"subscription\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SubscriptionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SubscriptionExistsG checks if the Subscription row exists.
+func SubscriptionExistsG(ctx context.Context, schoolID string) (bool, error) {
+	return SubscriptionExists(ctx, boil.GetContextDB(), schoolID)
+}
+
+// SubscriptionExists checks if the Subscription row exists.
+func SubscriptionExists(ctx context.Context, exec boil.ContextExecutor, schoolID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"subscription\" where \"school_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, schoolID)
+	}
+	row := exec.QueryRowContext(ctx, sql, schoolID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if subscription exists")
+	}
+
+	return exists, nil
+}
@@ -25,141 +25,68 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           string            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name         null.String       `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
-	Username     null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
-	Email        null.String       `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
-	IsActive     null.Bool         `boil:"is_active" json:"is_active,omitempty" toml:"is_active" yaml:"is_active,omitempty"`
-	Roles        types.StringArray `boil:"roles" json:"roles,omitempty" toml:"roles" yaml:"roles,omitempty"`
-	PasswordHash null.Bytes        `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
-	CreatedAt    null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt    null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	LastLogin    null.Time         `boil:"last_login" json:"last_login,omitempty" toml:"last_login" yaml:"last_login,omitempty"`
+	ID                 string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name               null.String       `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	Username           null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
+	Email              null.String       `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	IsActive           null.Bool         `boil:"is_active" json:"is_active,omitempty" toml:"is_active" yaml:"is_active,omitempty"`
+	Roles              types.StringArray `boil:"roles" json:"roles,omitempty" toml:"roles" yaml:"roles,omitempty"`
+	PasswordHash       null.Bytes        `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
+	CreatedAt          null.Time         `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt          null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	LastLogin          null.Time         `boil:"last_login" json:"last_login,omitempty" toml:"last_login" yaml:"last_login,omitempty"`
+	Phone              null.String       `boil:"phone" json:"phone,omitempty" toml:"phone" yaml:"phone,omitempty"`
+	InvitedAt          null.Time         `boil:"invited_at" json:"invited_at,omitempty" toml:"invited_at" yaml:"invited_at,omitempty"`
+	MustChangePassword bool              `boil:"must_change_password" json:"must_change_password" toml:"must_change_password" yaml:"must_change_password"`
+	Avatar             string            `boil:"avatar" json:"avatar" toml:"avatar" yaml:"avatar"`
+	EmailVerifiedAt    null.Time         `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	DeletedAt          null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ErasedAt           null.Time         `boil:"erased_at" json:"erased_at,omitempty" toml:"erased_at" yaml:"erased_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID           string
-	Name         string
-	Username     string
-	Email        string
-	IsActive     string
-	Roles        string
-	PasswordHash string
-	CreatedAt    string
-	UpdatedAt    string
-	LastLogin    string
+	ID                 string
+	Name               string
+	Username           string
+	Email              string
+	IsActive           string
+	Roles              string
+	PasswordHash       string
+	CreatedAt          string
+	UpdatedAt          string
+	LastLogin          string
+	Phone              string
+	InvitedAt          string
+	MustChangePassword string
+	Avatar             string
+	EmailVerifiedAt    string
+	DeletedAt          string
+	ErasedAt           string
 }{
-	ID:           "id",
-	Name:         "name",
-	Username:     "username",
-	Email:        "email",
-	IsActive:     "is_active",
-	Roles:        "roles",
-	PasswordHash: "password_hash",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	LastLogin:    "last_login",
+	ID:                 "id",
+	Name:               "name",
+	Username:           "username",
+	Email:              "email",
+	IsActive:           "is_active",
+	Roles:              "roles",
+	PasswordHash:       "password_hash",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	LastLogin:          "last_login",
+	Phone:              "phone",
+	InvitedAt:          "invited_at",
+	MustChangePassword: "must_change_password",
+	Avatar:             "avatar",
+	EmailVerifiedAt:    "email_verified_at",
+	DeletedAt:          "deleted_at",
+	ErasedAt:           "erased_at",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Bool struct{ field string }
-
-func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpertypes_StringArray struct{ field string }
-
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
-	return qmhelper.WhereIsNotNull(w.field)
-}
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Bytes struct{ field string }
 
 func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
@@ -183,59 +110,141 @@ func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var UserWhere = struct {
-	ID           whereHelperstring
-	Name         whereHelpernull_String
-	Username     whereHelpernull_String
-	Email        whereHelpernull_String
-	IsActive     whereHelpernull_Bool
-	Roles        whereHelpertypes_StringArray
-	PasswordHash whereHelpernull_Bytes
-	CreatedAt    whereHelpernull_Time
-	UpdatedAt    whereHelpernull_Time
-	LastLogin    whereHelpernull_Time
+	ID                 whereHelperstring
+	Name               whereHelpernull_String
+	Username           whereHelpernull_String
+	Email              whereHelpernull_String
+	IsActive           whereHelpernull_Bool
+	Roles              whereHelpertypes_StringArray
+	PasswordHash       whereHelpernull_Bytes
+	CreatedAt          whereHelpernull_Time
+	UpdatedAt          whereHelpernull_Time
+	LastLogin          whereHelpernull_Time
+	Phone              whereHelpernull_String
+	InvitedAt          whereHelpernull_Time
+	MustChangePassword whereHelperbool
+	Avatar             whereHelperstring
+	EmailVerifiedAt    whereHelpernull_Time
+	DeletedAt          whereHelpernull_Time
+	ErasedAt           whereHelpernull_Time
 }{
-	ID:           whereHelperstring{field: "\"user\".\"id\""},
-	Name:         whereHelpernull_String{field: "\"user\".\"name\""},
-	Username:     whereHelpernull_String{field: "\"user\".\"username\""},
-	Email:        whereHelpernull_String{field: "\"user\".\"email\""},
-	IsActive:     whereHelpernull_Bool{field: "\"user\".\"is_active\""},
-	Roles:        whereHelpertypes_StringArray{field: "\"user\".\"roles\""},
-	PasswordHash: whereHelpernull_Bytes{field: "\"user\".\"password_hash\""},
-	CreatedAt:    whereHelpernull_Time{field: "\"user\".\"created_at\""},
-	UpdatedAt:    whereHelpernull_Time{field: "\"user\".\"updated_at\""},
-	LastLogin:    whereHelpernull_Time{field: "\"user\".\"last_login\""},
+	ID:                 whereHelperstring{field: "\"user\".\"id\""},
+	Name:               whereHelpernull_String{field: "\"user\".\"name\""},
+	Username:           whereHelpernull_String{field: "\"user\".\"username\""},
+	Email:              whereHelpernull_String{field: "\"user\".\"email\""},
+	IsActive:           whereHelpernull_Bool{field: "\"user\".\"is_active\""},
+	Roles:              whereHelpertypes_StringArray{field: "\"user\".\"roles\""},
+	PasswordHash:       whereHelpernull_Bytes{field: "\"user\".\"password_hash\""},
+	CreatedAt:          whereHelpernull_Time{field: "\"user\".\"created_at\""},
+	UpdatedAt:          whereHelpernull_Time{field: "\"user\".\"updated_at\""},
+	LastLogin:          whereHelpernull_Time{field: "\"user\".\"last_login\""},
+	Phone:              whereHelpernull_String{field: "\"user\".\"phone\""},
+	InvitedAt:          whereHelpernull_Time{field: "\"user\".\"invited_at\""},
+	MustChangePassword: whereHelperbool{field: "\"user\".\"must_change_password\""},
+	Avatar:             whereHelperstring{field: "\"user\".\"avatar\""},
+	EmailVerifiedAt:    whereHelpernull_Time{field: "\"user\".\"email_verified_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"user\".\"deleted_at\""},
+	ErasedAt:           whereHelpernull_Time{field: "\"user\".\"erased_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-}{}
+	CalendarFeed              string
+	PasswordlessLimit         string
+	TwoFactor                 string
+	AuthorAnnouncements       string
+	AnnouncementRecipients    string
+	StudentAttempts           string
+	StudentAttendanceRecords  string
+	TakenByAttendanceSessions string
+	BackupCodes               string
+	DeletedByChatMessages     string
+	SenderChatMessages        string
+	MutedByChatMutes          string
+	ChatMutes                 string
+	ChatReads                 string
+	CreatedByChatRooms        string
+	ChatRoomMembers           string
+	StudentClassStudents      string
+	TeacherCourses            string
+	ExternalIdentities        string
+	GuardianGuardianLinks     string
+	StudentGuardianLinks      string
+	StudentMarks              string
+	AuthorMarkImports         string
+	Notifications             string
+	NotificationPreferences   string
+	PushSubscriptions         string
+	RbacRoleMembers           string
+	RefreshTokens             string
+	StudentWalletAwards       string
+	AuthorWalletTransactions  string
+}{
+	CalendarFeed:              "CalendarFeed",
+	PasswordlessLimit:         "PasswordlessLimit",
+	TwoFactor:                 "TwoFactor",
+	AuthorAnnouncements:       "AuthorAnnouncements",
+	AnnouncementRecipients:    "AnnouncementRecipients",
+	StudentAttempts:           "StudentAttempts",
+	StudentAttendanceRecords:  "StudentAttendanceRecords",
+	TakenByAttendanceSessions: "TakenByAttendanceSessions",
+	BackupCodes:               "BackupCodes",
+	DeletedByChatMessages:     "DeletedByChatMessages",
+	SenderChatMessages:        "SenderChatMessages",
+	MutedByChatMutes:          "MutedByChatMutes",
+	ChatMutes:                 "ChatMutes",
+	ChatReads:                 "ChatReads",
+	CreatedByChatRooms:        "CreatedByChatRooms",
+	ChatRoomMembers:           "ChatRoomMembers",
+	StudentClassStudents:      "StudentClassStudents",
+	TeacherCourses:            "TeacherCourses",
+	ExternalIdentities:        "ExternalIdentities",
+	GuardianGuardianLinks:     "GuardianGuardianLinks",
+	StudentGuardianLinks:      "StudentGuardianLinks",
+	StudentMarks:              "StudentMarks",
+	AuthorMarkImports:         "AuthorMarkImports",
+	Notifications:             "Notifications",
+	NotificationPreferences:   "NotificationPreferences",
+	PushSubscriptions:         "PushSubscriptions",
+	RbacRoleMembers:           "RbacRoleMembers",
+	RefreshTokens:             "RefreshTokens",
+	StudentWalletAwards:       "StudentWalletAwards",
+	AuthorWalletTransactions:  "AuthorWalletTransactions",
+}
 
 // userR is where relationships are stored.
 type userR struct {
+	CalendarFeed              *CalendarFeed               `boil:"CalendarFeed" json:"CalendarFeed" toml:"CalendarFeed" yaml:"CalendarFeed"`
+	PasswordlessLimit         *PasswordlessLimit          `boil:"PasswordlessLimit" json:"PasswordlessLimit" toml:"PasswordlessLimit" yaml:"PasswordlessLimit"`
+	TwoFactor                 *TwoFactor                  `boil:"TwoFactor" json:"TwoFactor" toml:"TwoFactor" yaml:"TwoFactor"`
+	AuthorAnnouncements       AnnouncementSlice           `boil:"AuthorAnnouncements" json:"AuthorAnnouncements" toml:"AuthorAnnouncements" yaml:"AuthorAnnouncements"`
+	AnnouncementRecipients    AnnouncementRecipientSlice  `boil:"AnnouncementRecipients" json:"AnnouncementRecipients" toml:"AnnouncementRecipients" yaml:"AnnouncementRecipients"`
+	StudentAttempts           AttemptSlice                `boil:"StudentAttempts" json:"StudentAttempts" toml:"StudentAttempts" yaml:"StudentAttempts"`
+	StudentAttendanceRecords  AttendanceRecordSlice       `boil:"StudentAttendanceRecords" json:"StudentAttendanceRecords" toml:"StudentAttendanceRecords" yaml:"StudentAttendanceRecords"`
+	TakenByAttendanceSessions AttendanceSessionSlice      `boil:"TakenByAttendanceSessions" json:"TakenByAttendanceSessions" toml:"TakenByAttendanceSessions" yaml:"TakenByAttendanceSessions"`
+	BackupCodes               BackupCodeSlice             `boil:"BackupCodes" json:"BackupCodes" toml:"BackupCodes" yaml:"BackupCodes"`
+	DeletedByChatMessages     ChatMessageSlice            `boil:"DeletedByChatMessages" json:"DeletedByChatMessages" toml:"DeletedByChatMessages" yaml:"DeletedByChatMessages"`
+	SenderChatMessages        ChatMessageSlice            `boil:"SenderChatMessages" json:"SenderChatMessages" toml:"SenderChatMessages" yaml:"SenderChatMessages"`
+	MutedByChatMutes          ChatMuteSlice               `boil:"MutedByChatMutes" json:"MutedByChatMutes" toml:"MutedByChatMutes" yaml:"MutedByChatMutes"`
+	ChatMutes                 ChatMuteSlice               `boil:"ChatMutes" json:"ChatMutes" toml:"ChatMutes" yaml:"ChatMutes"`
+	ChatReads                 ChatReadSlice               `boil:"ChatReads" json:"ChatReads" toml:"ChatReads" yaml:"ChatReads"`
+	CreatedByChatRooms        ChatRoomSlice               `boil:"CreatedByChatRooms" json:"CreatedByChatRooms" toml:"CreatedByChatRooms" yaml:"CreatedByChatRooms"`
+	ChatRoomMembers           ChatRoomMemberSlice         `boil:"ChatRoomMembers" json:"ChatRoomMembers" toml:"ChatRoomMembers" yaml:"ChatRoomMembers"`
+	StudentClassStudents      ClassStudentSlice           `boil:"StudentClassStudents" json:"StudentClassStudents" toml:"StudentClassStudents" yaml:"StudentClassStudents"`
+	TeacherCourses            CourseSlice                 `boil:"TeacherCourses" json:"TeacherCourses" toml:"TeacherCourses" yaml:"TeacherCourses"`
+	ExternalIdentities        ExternalIdentitySlice       `boil:"ExternalIdentities" json:"ExternalIdentities" toml:"ExternalIdentities" yaml:"ExternalIdentities"`
+	GuardianGuardianLinks     GuardianLinkSlice           `boil:"GuardianGuardianLinks" json:"GuardianGuardianLinks" toml:"GuardianGuardianLinks" yaml:"GuardianGuardianLinks"`
+	StudentGuardianLinks      GuardianLinkSlice           `boil:"StudentGuardianLinks" json:"StudentGuardianLinks" toml:"StudentGuardianLinks" yaml:"StudentGuardianLinks"`
+	StudentMarks              MarkSlice                   `boil:"StudentMarks" json:"StudentMarks" toml:"StudentMarks" yaml:"StudentMarks"`
+	AuthorMarkImports         MarkImportSlice             `boil:"AuthorMarkImports" json:"AuthorMarkImports" toml:"AuthorMarkImports" yaml:"AuthorMarkImports"`
+	Notifications             NotificationSlice           `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	NotificationPreferences   NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	PushSubscriptions         PushSubscriptionSlice       `boil:"PushSubscriptions" json:"PushSubscriptions" toml:"PushSubscriptions" yaml:"PushSubscriptions"`
+	RbacRoleMembers           RbacRoleMemberSlice         `boil:"RbacRoleMembers" json:"RbacRoleMembers" toml:"RbacRoleMembers" yaml:"RbacRoleMembers"`
+	RefreshTokens             RefreshTokenSlice           `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	StudentWalletAwards       WalletAwardSlice            `boil:"StudentWalletAwards" json:"StudentWalletAwards" toml:"StudentWalletAwards" yaml:"StudentWalletAwards"`
+	AuthorWalletTransactions  WalletTransactionSlice      `boil:"AuthorWalletTransactions" json:"AuthorWalletTransactions" toml:"AuthorWalletTransactions" yaml:"AuthorWalletTransactions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -247,9 +256,9 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "username", "email", "is_active", "roles", "password_hash", "created_at", "updated_at", "last_login"}
-	userColumnsWithoutDefault = []string{"id", "name", "username", "email", "is_active", "roles", "password_hash", "created_at", "updated_at", "last_login"}
-	userColumnsWithDefault    = []string{}
+	userAllColumns            = []string{"id", "name", "username", "email", "is_active", "roles", "password_hash", "created_at", "updated_at", "last_login", "phone", "invited_at", "must_change_password", "avatar", "email_verified_at", "deleted_at", "erased_at"}
+	userColumnsWithoutDefault = []string{"id", "name", "username", "email", "is_active", "roles", "password_hash", "created_at", "updated_at", "last_login", "phone", "invited_at", "email_verified_at", "deleted_at", "erased_at"}
+	userColumnsWithDefault    = []string{"must_change_password", "avatar"}
 	userPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -364,6 +373,6003 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// CalendarFeed pointed to by the foreign key.
+func (o *User) CalendarFeed(mods ...qm.QueryMod) calendarFeedQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := CalendarFeeds(queryMods...)
+	queries.SetFrom(query.Query, "\"calendar_feed\"")
+
+	return query
+}
+
+// PasswordlessLimit pointed to by the foreign key.
+func (o *User) PasswordlessLimit(mods ...qm.QueryMod) passwordlessLimitQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := PasswordlessLimits(queryMods...)
+	queries.SetFrom(query.Query, "\"passwordless_limit\"")
+
+	return query
+}
+
+// TwoFactor pointed to by the foreign key.
+func (o *User) TwoFactor(mods ...qm.QueryMod) twoFactorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := TwoFactors(queryMods...)
+	queries.SetFrom(query.Query, "\"two_factor\"")
+
+	return query
+}
+
+// AuthorAnnouncements retrieves all the announcement's Announcements with an executor via author_id column.
+func (o *User) AuthorAnnouncements(mods ...qm.QueryMod) announcementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"announcement\".\"author_id\"=?", o.ID),
+	)
+
+	query := Announcements(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"announcement\".*"})
+	}
+
+	return query
+}
+
+// AnnouncementRecipients retrieves all the announcement_recipient's AnnouncementRecipients with an executor.
+func (o *User) AnnouncementRecipients(mods ...qm.QueryMod) announcementRecipientQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"announcement_recipient\".\"user_id\"=?", o.ID),
+	)
+
+	query := AnnouncementRecipients(queryMods...)
+	queries.SetFrom(query.Query, "\"announcement_recipient\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"announcement_recipient\".*"})
+	}
+
+	return query
+}
+
+// StudentAttempts retrieves all the attempt's Attempts with an executor via student_id column.
+func (o *User) StudentAttempts(mods ...qm.QueryMod) attemptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attempt\".\"student_id\"=?", o.ID),
+	)
+
+	query := Attempts(queryMods...)
+	queries.SetFrom(query.Query, "\"attempt\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attempt\".*"})
+	}
+
+	return query
+}
+
+// StudentAttendanceRecords retrieves all the attendance_record's AttendanceRecords with an executor via student_id column.
+func (o *User) StudentAttendanceRecords(mods ...qm.QueryMod) attendanceRecordQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attendance_record\".\"student_id\"=?", o.ID),
+	)
+
+	query := AttendanceRecords(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_record\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attendance_record\".*"})
+	}
+
+	return query
+}
+
+// TakenByAttendanceSessions retrieves all the attendance_session's AttendanceSessions with an executor via taken_by_id column.
+func (o *User) TakenByAttendanceSessions(mods ...qm.QueryMod) attendanceSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attendance_session\".\"taken_by_id\"=?", o.ID),
+	)
+
+	query := AttendanceSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance_session\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"attendance_session\".*"})
+	}
+
+	return query
+}
+
+// BackupCodes retrieves all the backup_code's BackupCodes with an executor.
+func (o *User) BackupCodes(mods ...qm.QueryMod) backupCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"backup_code\".\"user_id\"=?", o.ID),
+	)
+
+	query := BackupCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"backup_code\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"backup_code\".*"})
+	}
+
+	return query
+}
+
+// DeletedByChatMessages retrieves all the chat_message's ChatMessages with an executor via deleted_by_id column.
+func (o *User) DeletedByChatMessages(mods ...qm.QueryMod) chatMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_message\".\"deleted_by_id\"=?", o.ID),
+	)
+
+	query := ChatMessages(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_message\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_message\".*"})
+	}
+
+	return query
+}
+
+// SenderChatMessages retrieves all the chat_message's ChatMessages with an executor via sender_id column.
+func (o *User) SenderChatMessages(mods ...qm.QueryMod) chatMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_message\".\"sender_id\"=?", o.ID),
+	)
+
+	query := ChatMessages(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_message\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_message\".*"})
+	}
+
+	return query
+}
+
+// MutedByChatMutes retrieves all the chat_mute's ChatMutes with an executor via muted_by_id column.
+func (o *User) MutedByChatMutes(mods ...qm.QueryMod) chatMuteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_mute\".\"muted_by_id\"=?", o.ID),
+	)
+
+	query := ChatMutes(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_mute\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_mute\".*"})
+	}
+
+	return query
+}
+
+// ChatMutes retrieves all the chat_mute's ChatMutes with an executor.
+func (o *User) ChatMutes(mods ...qm.QueryMod) chatMuteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_mute\".\"user_id\"=?", o.ID),
+	)
+
+	query := ChatMutes(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_mute\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_mute\".*"})
+	}
+
+	return query
+}
+
+// ChatReads retrieves all the chat_read's ChatReads with an executor.
+func (o *User) ChatReads(mods ...qm.QueryMod) chatReadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_read\".\"user_id\"=?", o.ID),
+	)
+
+	query := ChatReads(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_read\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_read\".*"})
+	}
+
+	return query
+}
+
+// CreatedByChatRooms retrieves all the chat_room's ChatRooms with an executor via created_by_id column.
+func (o *User) CreatedByChatRooms(mods ...qm.QueryMod) chatRoomQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_room\".\"created_by_id\"=?", o.ID),
+	)
+
+	query := ChatRooms(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_room\".*"})
+	}
+
+	return query
+}
+
+// ChatRoomMembers retrieves all the chat_room_member's ChatRoomMembers with an executor.
+func (o *User) ChatRoomMembers(mods ...qm.QueryMod) chatRoomMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_room_member\".\"user_id\"=?", o.ID),
+	)
+
+	query := ChatRoomMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"chat_room_member\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat_room_member\".*"})
+	}
+
+	return query
+}
+
+// StudentClassStudents retrieves all the class_student's ClassStudents with an executor via student_id column.
+func (o *User) StudentClassStudents(mods ...qm.QueryMod) classStudentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"class_student\".\"student_id\"=?", o.ID),
+	)
+
+	query := ClassStudents(queryMods...)
+	queries.SetFrom(query.Query, "\"class_student\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class_student\".*"})
+	}
+
+	return query
+}
+
+// TeacherCourses retrieves all the course's Courses with an executor via teacher_id column.
+func (o *User) TeacherCourses(mods ...qm.QueryMod) courseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"course\".\"teacher_id\"=?", o.ID),
+	)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"course\".*"})
+	}
+
+	return query
+}
+
+// ExternalIdentities retrieves all the external_identity's ExternalIdentities with an executor.
+func (o *User) ExternalIdentities(mods ...qm.QueryMod) externalIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"external_identity\".\"user_id\"=?", o.ID),
+	)
+
+	query := ExternalIdentities(queryMods...)
+	queries.SetFrom(query.Query, "\"external_identity\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"external_identity\".*"})
+	}
+
+	return query
+}
+
+// GuardianGuardianLinks retrieves all the guardian_link's GuardianLinks with an executor via guardian_id column.
+func (o *User) GuardianGuardianLinks(mods ...qm.QueryMod) guardianLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"guardian_link\".\"guardian_id\"=?", o.ID),
+	)
+
+	query := GuardianLinks(queryMods...)
+	queries.SetFrom(query.Query, "\"guardian_link\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"guardian_link\".*"})
+	}
+
+	return query
+}
+
+// StudentGuardianLinks retrieves all the guardian_link's GuardianLinks with an executor via student_id column.
+func (o *User) StudentGuardianLinks(mods ...qm.QueryMod) guardianLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"guardian_link\".\"student_id\"=?", o.ID),
+	)
+
+	query := GuardianLinks(queryMods...)
+	queries.SetFrom(query.Query, "\"guardian_link\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"guardian_link\".*"})
+	}
+
+	return query
+}
+
+// StudentMarks retrieves all the mark's Marks with an executor via student_id column.
+func (o *User) StudentMarks(mods ...qm.QueryMod) markQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mark\".\"student_id\"=?", o.ID),
+	)
+
+	query := Marks(queryMods...)
+	queries.SetFrom(query.Query, "\"mark\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"mark\".*"})
+	}
+
+	return query
+}
+
+// AuthorMarkImports retrieves all the mark_import's MarkImports with an executor via author_id column.
+func (o *User) AuthorMarkImports(mods ...qm.QueryMod) markImportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mark_import\".\"author_id\"=?", o.ID),
+	)
+
+	query := MarkImports(queryMods...)
+	queries.SetFrom(query.Query, "\"mark_import\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"mark_import\".*"})
+	}
+
+	return query
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification\".\"user_id\"=?", o.ID),
+	)
+
+	query := Notifications(queryMods...)
+	queries.SetFrom(query.Query, "\"notification\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"notification\".*"})
+	}
+
+	return query
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_preference\".\"user_id\"=?", o.ID),
+	)
+
+	query := NotificationPreferences(queryMods...)
+	queries.SetFrom(query.Query, "\"notification_preference\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"notification_preference\".*"})
+	}
+
+	return query
+}
+
+// PushSubscriptions retrieves all the push_subscription's PushSubscriptions with an executor.
+func (o *User) PushSubscriptions(mods ...qm.QueryMod) pushSubscriptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_subscription\".\"user_id\"=?", o.ID),
+	)
+
+	query := PushSubscriptions(queryMods...)
+	queries.SetFrom(query.Query, "\"push_subscription\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"push_subscription\".*"})
+	}
+
+	return query
+}
+
+// RbacRoleMembers retrieves all the rbac_role_member's RbacRoleMembers with an executor.
+func (o *User) RbacRoleMembers(mods ...qm.QueryMod) rbacRoleMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rbac_role_member\".\"user_id\"=?", o.ID),
+	)
+
+	query := RbacRoleMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"rbac_role_member\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"rbac_role_member\".*"})
+	}
+
+	return query
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_token\".\"user_id\"=?", o.ID),
+	)
+
+	query := RefreshTokens(queryMods...)
+	queries.SetFrom(query.Query, "\"refresh_token\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"refresh_token\".*"})
+	}
+
+	return query
+}
+
+// StudentWalletAwards retrieves all the wallet_award's WalletAwards with an executor via student_id column.
+func (o *User) StudentWalletAwards(mods ...qm.QueryMod) walletAwardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_award\".\"student_id\"=?", o.ID),
+	)
+
+	query := WalletAwards(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_award\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_award\".*"})
+	}
+
+	return query
+}
+
+// AuthorWalletTransactions retrieves all the wallet_transaction's WalletTransactions with an executor via author_id column.
+func (o *User) AuthorWalletTransactions(mods ...qm.QueryMod) walletTransactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_transaction\".\"author_id\"=?", o.ID),
+	)
+
+	query := WalletTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_transaction\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_transaction\".*"})
+	}
+
+	return query
+}
+
+// LoadCalendarFeed allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadCalendarFeed(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`calendar_feed`),
+		qm.WhereIn(`calendar_feed.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load CalendarFeed")
+	}
+
+	var resultSlice []*CalendarFeed
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice CalendarFeed")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for calendar_feed")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for calendar_feed")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CalendarFeed = foreign
+		if foreign.R == nil {
+			foreign.R = &calendarFeedR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.CalendarFeed = foreign
+				if foreign.R == nil {
+					foreign.R = &calendarFeedR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordlessLimit allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadPasswordlessLimit(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`passwordless_limit`),
+		qm.WhereIn(`passwordless_limit.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PasswordlessLimit")
+	}
+
+	var resultSlice []*PasswordlessLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PasswordlessLimit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for passwordless_limit")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for passwordless_limit")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PasswordlessLimit = foreign
+		if foreign.R == nil {
+			foreign.R = &passwordlessLimitR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordlessLimit = foreign
+				if foreign.R == nil {
+					foreign.R = &passwordlessLimitR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTwoFactor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadTwoFactor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`two_factor`),
+		qm.WhereIn(`two_factor.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TwoFactor")
+	}
+
+	var resultSlice []*TwoFactor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TwoFactor")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for two_factor")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for two_factor")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TwoFactor = foreign
+		if foreign.R == nil {
+			foreign.R = &twoFactorR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.TwoFactor = foreign
+				if foreign.R == nil {
+					foreign.R = &twoFactorR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorAnnouncements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorAnnouncements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement`),
+		qm.WhereIn(`announcement.author_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load announcement")
+	}
+
+	var resultSlice []*Announcement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice announcement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on announcement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement")
+	}
+
+	if singular {
+		object.R.AuthorAnnouncements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &announcementR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AuthorID) {
+				local.R.AuthorAnnouncements = append(local.R.AuthorAnnouncements, foreign)
+				if foreign.R == nil {
+					foreign.R = &announcementR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAnnouncementRecipients allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAnnouncementRecipients(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`announcement_recipient`),
+		qm.WhereIn(`announcement_recipient.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load announcement_recipient")
+	}
+
+	var resultSlice []*AnnouncementRecipient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice announcement_recipient")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on announcement_recipient")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for announcement_recipient")
+	}
+
+	if singular {
+		object.R.AnnouncementRecipients = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &announcementRecipientR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.AnnouncementRecipients = append(local.R.AnnouncementRecipients, foreign)
+				if foreign.R == nil {
+					foreign.R = &announcementRecipientR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentAttempts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attempt`),
+		qm.WhereIn(`attempt.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attempt")
+	}
+
+	var resultSlice []*Attempt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attempt")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attempt")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attempt")
+	}
+
+	if singular {
+		object.R.StudentAttempts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attemptR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentAttempts = append(local.R.StudentAttempts, foreign)
+				if foreign.R == nil {
+					foreign.R = &attemptR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentAttendanceRecords allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentAttendanceRecords(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_record`),
+		qm.WhereIn(`attendance_record.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attendance_record")
+	}
+
+	var resultSlice []*AttendanceRecord
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attendance_record")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attendance_record")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_record")
+	}
+
+	if singular {
+		object.R.StudentAttendanceRecords = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attendanceRecordR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentAttendanceRecords = append(local.R.StudentAttendanceRecords, foreign)
+				if foreign.R == nil {
+					foreign.R = &attendanceRecordR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTakenByAttendanceSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTakenByAttendanceSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attendance_session`),
+		qm.WhereIn(`attendance_session.taken_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attendance_session")
+	}
+
+	var resultSlice []*AttendanceSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attendance_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attendance_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance_session")
+	}
+
+	if singular {
+		object.R.TakenByAttendanceSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attendanceSessionR{}
+			}
+			foreign.R.TakenBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TakenByID) {
+				local.R.TakenByAttendanceSessions = append(local.R.TakenByAttendanceSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &attendanceSessionR{}
+				}
+				foreign.R.TakenBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBackupCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBackupCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`backup_code`),
+		qm.WhereIn(`backup_code.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load backup_code")
+	}
+
+	var resultSlice []*BackupCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice backup_code")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on backup_code")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for backup_code")
+	}
+
+	if singular {
+		object.R.BackupCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &backupCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.BackupCodes = append(local.R.BackupCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &backupCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeletedByChatMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDeletedByChatMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_message`),
+		qm.WhereIn(`chat_message.deleted_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_message")
+	}
+
+	var resultSlice []*ChatMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_message")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_message")
+	}
+
+	if singular {
+		object.R.DeletedByChatMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMessageR{}
+			}
+			foreign.R.DeletedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DeletedByID) {
+				local.R.DeletedByChatMessages = append(local.R.DeletedByChatMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMessageR{}
+				}
+				foreign.R.DeletedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSenderChatMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSenderChatMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_message`),
+		qm.WhereIn(`chat_message.sender_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_message")
+	}
+
+	var resultSlice []*ChatMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_message")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_message")
+	}
+
+	if singular {
+		object.R.SenderChatMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMessageR{}
+			}
+			foreign.R.Sender = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SenderID) {
+				local.R.SenderChatMessages = append(local.R.SenderChatMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMessageR{}
+				}
+				foreign.R.Sender = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMutedByChatMutes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMutedByChatMutes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_mute`),
+		qm.WhereIn(`chat_mute.muted_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_mute")
+	}
+
+	var resultSlice []*ChatMute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_mute")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_mute")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_mute")
+	}
+
+	if singular {
+		object.R.MutedByChatMutes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMuteR{}
+			}
+			foreign.R.MutedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.MutedByID) {
+				local.R.MutedByChatMutes = append(local.R.MutedByChatMutes, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMuteR{}
+				}
+				foreign.R.MutedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatMutes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatMutes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_mute`),
+		qm.WhereIn(`chat_mute.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_mute")
+	}
+
+	var resultSlice []*ChatMute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_mute")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_mute")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_mute")
+	}
+
+	if singular {
+		object.R.ChatMutes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatMuteR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatMutes = append(local.R.ChatMutes, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatMuteR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatReads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatReads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_read`),
+		qm.WhereIn(`chat_read.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_read")
+	}
+
+	var resultSlice []*ChatRead
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_read")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_read")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_read")
+	}
+
+	if singular {
+		object.R.ChatReads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatReadR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatReads = append(local.R.ChatReads, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatReadR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByChatRooms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByChatRooms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room`),
+		qm.WhereIn(`chat_room.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_room")
+	}
+
+	var resultSlice []*ChatRoom
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_room")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_room")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room")
+	}
+
+	if singular {
+		object.R.CreatedByChatRooms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatRoomR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedByID) {
+				local.R.CreatedByChatRooms = append(local.R.CreatedByChatRooms, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatRoomR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatRoomMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatRoomMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_room_member`),
+		qm.WhereIn(`chat_room_member.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_room_member")
+	}
+
+	var resultSlice []*ChatRoomMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_room_member")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_room_member")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_room_member")
+	}
+
+	if singular {
+		object.R.ChatRoomMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatRoomMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatRoomMembers = append(local.R.ChatRoomMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatRoomMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentClassStudents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentClassStudents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class_student`),
+		qm.WhereIn(`class_student.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class_student")
+	}
+
+	var resultSlice []*ClassStudent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class_student")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class_student")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_student")
+	}
+
+	if singular {
+		object.R.StudentClassStudents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classStudentR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentClassStudents = append(local.R.StudentClassStudents, foreign)
+				if foreign.R == nil {
+					foreign.R = &classStudentR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacherCourses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTeacherCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.teacher_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if singular {
+		object.R.TeacherCourses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &courseR{}
+			}
+			foreign.R.Teacher = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TeacherID) {
+				local.R.TeacherCourses = append(local.R.TeacherCourses, foreign)
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.Teacher = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExternalIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadExternalIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`external_identity`),
+		qm.WhereIn(`external_identity.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load external_identity")
+	}
+
+	var resultSlice []*ExternalIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice external_identity")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on external_identity")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for external_identity")
+	}
+
+	if singular {
+		object.R.ExternalIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &externalIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ExternalIdentities = append(local.R.ExternalIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &externalIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGuardianGuardianLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadGuardianGuardianLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`guardian_link`),
+		qm.WhereIn(`guardian_link.guardian_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load guardian_link")
+	}
+
+	var resultSlice []*GuardianLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice guardian_link")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on guardian_link")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for guardian_link")
+	}
+
+	if singular {
+		object.R.GuardianGuardianLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &guardianLinkR{}
+			}
+			foreign.R.Guardian = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GuardianID {
+				local.R.GuardianGuardianLinks = append(local.R.GuardianGuardianLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &guardianLinkR{}
+				}
+				foreign.R.Guardian = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentGuardianLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentGuardianLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`guardian_link`),
+		qm.WhereIn(`guardian_link.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load guardian_link")
+	}
+
+	var resultSlice []*GuardianLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice guardian_link")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on guardian_link")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for guardian_link")
+	}
+
+	if singular {
+		object.R.StudentGuardianLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &guardianLinkR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentGuardianLinks = append(local.R.StudentGuardianLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &guardianLinkR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentMarks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentMarks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark`),
+		qm.WhereIn(`mark.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mark")
+	}
+
+	var resultSlice []*Mark
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mark")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mark")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark")
+	}
+
+	if singular {
+		object.R.StudentMarks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &markR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentMarks = append(local.R.StudentMarks, foreign)
+				if foreign.R == nil {
+					foreign.R = &markR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorMarkImports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorMarkImports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mark_import`),
+		qm.WhereIn(`mark_import.author_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mark_import")
+	}
+
+	var resultSlice []*MarkImport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mark_import")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mark_import")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mark_import")
+	}
+
+	if singular {
+		object.R.AuthorMarkImports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &markImportR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AuthorID) {
+				local.R.AuthorMarkImports = append(local.R.AuthorMarkImports, foreign)
+				if foreign.R == nil {
+					foreign.R = &markImportR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`notification`),
+		qm.WhereIn(`notification.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification")
+	}
+
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`notification_preference`),
+		qm.WhereIn(`notification_preference.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_preference")
+	}
+
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preference")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preference")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preference")
+	}
+
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushSubscriptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_subscription`),
+		qm.WhereIn(`push_subscription.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_subscription")
+	}
+
+	var resultSlice []*PushSubscription
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_subscription")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_subscription")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_subscription")
+	}
+
+	if singular {
+		object.R.PushSubscriptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushSubscriptionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushSubscriptions = append(local.R.PushSubscriptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushSubscriptionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRbacRoleMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRbacRoleMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rbac_role_member`),
+		qm.WhereIn(`rbac_role_member.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rbac_role_member")
+	}
+
+	var resultSlice []*RbacRoleMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rbac_role_member")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rbac_role_member")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rbac_role_member")
+	}
+
+	if singular {
+		object.R.RbacRoleMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rbacRoleMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RbacRoleMembers = append(local.R.RbacRoleMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &rbacRoleMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_token`),
+		qm.WhereIn(`refresh_token.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_token")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_token")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_token")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_token")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudentWalletAwards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadStudentWalletAwards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_award`),
+		qm.WhereIn(`wallet_award.student_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_award")
+	}
+
+	var resultSlice []*WalletAward
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_award")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_award")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_award")
+	}
+
+	if singular {
+		object.R.StudentWalletAwards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletAwardR{}
+			}
+			foreign.R.Student = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.StudentID {
+				local.R.StudentWalletAwards = append(local.R.StudentWalletAwards, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletAwardR{}
+				}
+				foreign.R.Student = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorWalletTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorWalletTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_transaction`),
+		qm.WhereIn(`wallet_transaction.author_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_transaction")
+	}
+
+	var resultSlice []*WalletTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_transaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_transaction")
+	}
+
+	if singular {
+		object.R.AuthorWalletTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletTransactionR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AuthorID) {
+				local.R.AuthorWalletTransactions = append(local.R.AuthorWalletTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletTransactionR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCalendarFeedG of the user to the related item.
+// Sets o.R.CalendarFeed to related.
+// Adds o to related.R.User.
+// Uses the global database handle.
+func (o *User) SetCalendarFeedG(ctx context.Context, insert bool, related *CalendarFeed) error {
+	return o.SetCalendarFeed(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCalendarFeed of the user to the related item.
+// Sets o.R.CalendarFeed to related.
+// Adds o to related.R.User.
+func (o *User) SetCalendarFeed(ctx context.Context, exec boil.ContextExecutor, insert bool, related *CalendarFeed) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"calendar_feed\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, calendarFeedPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CalendarFeed: related,
+		}
+	} else {
+		o.R.CalendarFeed = related
+	}
+
+	if related.R == nil {
+		related.R = &calendarFeedR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetPasswordlessLimitG of the user to the related item.
+// Sets o.R.PasswordlessLimit to related.
+// Adds o to related.R.User.
+// Uses the global database handle.
+func (o *User) SetPasswordlessLimitG(ctx context.Context, insert bool, related *PasswordlessLimit) error {
+	return o.SetPasswordlessLimit(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPasswordlessLimit of the user to the related item.
+// Sets o.R.PasswordlessLimit to related.
+// Adds o to related.R.User.
+func (o *User) SetPasswordlessLimit(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PasswordlessLimit) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"passwordless_limit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, passwordlessLimitPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordlessLimit: related,
+		}
+	} else {
+		o.R.PasswordlessLimit = related
+	}
+
+	if related.R == nil {
+		related.R = &passwordlessLimitR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetTwoFactorG of the user to the related item.
+// Sets o.R.TwoFactor to related.
+// Adds o to related.R.User.
+// Uses the global database handle.
+func (o *User) SetTwoFactorG(ctx context.Context, insert bool, related *TwoFactor) error {
+	return o.SetTwoFactor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTwoFactor of the user to the related item.
+// Sets o.R.TwoFactor to related.
+// Adds o to related.R.User.
+func (o *User) SetTwoFactor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TwoFactor) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"two_factor\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, twoFactorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TwoFactor: related,
+		}
+	} else {
+		o.R.TwoFactor = related
+	}
+
+	if related.R == nil {
+		related.R = &twoFactorR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddAuthorAnnouncementsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorAnnouncements.
+// Sets related.R.Author appropriately.
+// Uses the global database handle.
+func (o *User) AddAuthorAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.AddAuthorAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAuthorAnnouncements adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorAnnouncements.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AuthorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"announcement\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 2, announcementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AuthorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorAnnouncements: related,
+		}
+	} else {
+		o.R.AuthorAnnouncements = append(o.R.AuthorAnnouncements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &announcementR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
+// SetAuthorAnnouncementsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorAnnouncements accordingly.
+// Replaces o.R.AuthorAnnouncements with related.
+// Sets related.R.Author's AuthorAnnouncements accordingly.
+// Uses the global database handle.
+func (o *User) SetAuthorAnnouncementsG(ctx context.Context, insert bool, related ...*Announcement) error {
+	return o.SetAuthorAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAuthorAnnouncements removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorAnnouncements accordingly.
+// Replaces o.R.AuthorAnnouncements with related.
+// Sets related.R.Author's AuthorAnnouncements accordingly.
+func (o *User) SetAuthorAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Announcement) error {
+	query := "update \"announcement\" set \"author_id\" = null where \"author_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AuthorAnnouncements {
+			queries.SetScanner(&rel.AuthorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Author = nil
+		}
+
+		o.R.AuthorAnnouncements = nil
+	}
+	return o.AddAuthorAnnouncements(ctx, exec, insert, related...)
+}
+
+// RemoveAuthorAnnouncementsG relationships from objects passed in.
+// Removes related items from R.AuthorAnnouncements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+// Uses the global database handle.
+func (o *User) RemoveAuthorAnnouncementsG(ctx context.Context, related ...*Announcement) error {
+	return o.RemoveAuthorAnnouncements(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAuthorAnnouncements relationships from objects passed in.
+// Removes related items from R.AuthorAnnouncements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+func (o *User) RemoveAuthorAnnouncements(ctx context.Context, exec boil.ContextExecutor, related ...*Announcement) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AuthorID, nil)
+		if rel.R != nil {
+			rel.R.Author = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("author_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AuthorAnnouncements {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AuthorAnnouncements)
+			if ln > 1 && i < ln-1 {
+				o.R.AuthorAnnouncements[i] = o.R.AuthorAnnouncements[ln-1]
+			}
+			o.R.AuthorAnnouncements = o.R.AuthorAnnouncements[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAnnouncementRecipientsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AnnouncementRecipients.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddAnnouncementRecipientsG(ctx context.Context, insert bool, related ...*AnnouncementRecipient) error {
+	return o.AddAnnouncementRecipients(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAnnouncementRecipients adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AnnouncementRecipients.
+// Sets related.R.User appropriately.
+func (o *User) AddAnnouncementRecipients(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AnnouncementRecipient) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"announcement_recipient\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, announcementRecipientPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AnnouncementID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AnnouncementRecipients: related,
+		}
+	} else {
+		o.R.AnnouncementRecipients = append(o.R.AnnouncementRecipients, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &announcementRecipientR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddStudentAttemptsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentAttempts.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentAttemptsG(ctx context.Context, insert bool, related ...*Attempt) error {
+	return o.AddStudentAttempts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentAttempts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentAttempts.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attempt) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attempt\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attemptPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentAttempts: related,
+		}
+	} else {
+		o.R.StudentAttempts = append(o.R.StudentAttempts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attemptR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddStudentAttendanceRecordsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentAttendanceRecords.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentAttendanceRecordsG(ctx context.Context, insert bool, related ...*AttendanceRecord) error {
+	return o.AddStudentAttendanceRecords(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentAttendanceRecords adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentAttendanceRecords.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentAttendanceRecords(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceRecord) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attendance_record\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attendanceRecordPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SessionID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentAttendanceRecords: related,
+		}
+	} else {
+		o.R.StudentAttendanceRecords = append(o.R.StudentAttendanceRecords, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attendanceRecordR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddTakenByAttendanceSessionsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TakenByAttendanceSessions.
+// Sets related.R.TakenBy appropriately.
+// Uses the global database handle.
+func (o *User) AddTakenByAttendanceSessionsG(ctx context.Context, insert bool, related ...*AttendanceSession) error {
+	return o.AddTakenByAttendanceSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTakenByAttendanceSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TakenByAttendanceSessions.
+// Sets related.R.TakenBy appropriately.
+func (o *User) AddTakenByAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TakenByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attendance_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"taken_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attendanceSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TakenByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TakenByAttendanceSessions: related,
+		}
+	} else {
+		o.R.TakenByAttendanceSessions = append(o.R.TakenByAttendanceSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attendanceSessionR{
+				TakenBy: o,
+			}
+		} else {
+			rel.R.TakenBy = o
+		}
+	}
+	return nil
+}
+
+// SetTakenByAttendanceSessionsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TakenBy's TakenByAttendanceSessions accordingly.
+// Replaces o.R.TakenByAttendanceSessions with related.
+// Sets related.R.TakenBy's TakenByAttendanceSessions accordingly.
+// Uses the global database handle.
+func (o *User) SetTakenByAttendanceSessionsG(ctx context.Context, insert bool, related ...*AttendanceSession) error {
+	return o.SetTakenByAttendanceSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTakenByAttendanceSessions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TakenBy's TakenByAttendanceSessions accordingly.
+// Replaces o.R.TakenByAttendanceSessions with related.
+// Sets related.R.TakenBy's TakenByAttendanceSessions accordingly.
+func (o *User) SetTakenByAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttendanceSession) error {
+	query := "update \"attendance_session\" set \"taken_by_id\" = null where \"taken_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TakenByAttendanceSessions {
+			queries.SetScanner(&rel.TakenByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TakenBy = nil
+		}
+
+		o.R.TakenByAttendanceSessions = nil
+	}
+	return o.AddTakenByAttendanceSessions(ctx, exec, insert, related...)
+}
+
+// RemoveTakenByAttendanceSessionsG relationships from objects passed in.
+// Removes related items from R.TakenByAttendanceSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.TakenBy.
+// Uses the global database handle.
+func (o *User) RemoveTakenByAttendanceSessionsG(ctx context.Context, related ...*AttendanceSession) error {
+	return o.RemoveTakenByAttendanceSessions(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTakenByAttendanceSessions relationships from objects passed in.
+// Removes related items from R.TakenByAttendanceSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.TakenBy.
+func (o *User) RemoveTakenByAttendanceSessions(ctx context.Context, exec boil.ContextExecutor, related ...*AttendanceSession) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TakenByID, nil)
+		if rel.R != nil {
+			rel.R.TakenBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("taken_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TakenByAttendanceSessions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TakenByAttendanceSessions)
+			if ln > 1 && i < ln-1 {
+				o.R.TakenByAttendanceSessions[i] = o.R.TakenByAttendanceSessions[ln-1]
+			}
+			o.R.TakenByAttendanceSessions = o.R.TakenByAttendanceSessions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBackupCodesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BackupCodes.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddBackupCodesG(ctx context.Context, insert bool, related ...*BackupCode) error {
+	return o.AddBackupCodes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddBackupCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BackupCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddBackupCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BackupCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"backup_code\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, backupCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BackupCodes: related,
+		}
+	} else {
+		o.R.BackupCodes = append(o.R.BackupCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &backupCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddDeletedByChatMessagesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DeletedByChatMessages.
+// Sets related.R.DeletedBy appropriately.
+// Uses the global database handle.
+func (o *User) AddDeletedByChatMessagesG(ctx context.Context, insert bool, related ...*ChatMessage) error {
+	return o.AddDeletedByChatMessages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDeletedByChatMessages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DeletedByChatMessages.
+// Sets related.R.DeletedBy appropriately.
+func (o *User) AddDeletedByChatMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DeletedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_message\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DeletedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DeletedByChatMessages: related,
+		}
+	} else {
+		o.R.DeletedByChatMessages = append(o.R.DeletedByChatMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMessageR{
+				DeletedBy: o,
+			}
+		} else {
+			rel.R.DeletedBy = o
+		}
+	}
+	return nil
+}
+
+// SetDeletedByChatMessagesG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DeletedBy's DeletedByChatMessages accordingly.
+// Replaces o.R.DeletedByChatMessages with related.
+// Sets related.R.DeletedBy's DeletedByChatMessages accordingly.
+// Uses the global database handle.
+func (o *User) SetDeletedByChatMessagesG(ctx context.Context, insert bool, related ...*ChatMessage) error {
+	return o.SetDeletedByChatMessages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetDeletedByChatMessages removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DeletedBy's DeletedByChatMessages accordingly.
+// Replaces o.R.DeletedByChatMessages with related.
+// Sets related.R.DeletedBy's DeletedByChatMessages accordingly.
+func (o *User) SetDeletedByChatMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMessage) error {
+	query := "update \"chat_message\" set \"deleted_by_id\" = null where \"deleted_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DeletedByChatMessages {
+			queries.SetScanner(&rel.DeletedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DeletedBy = nil
+		}
+
+		o.R.DeletedByChatMessages = nil
+	}
+	return o.AddDeletedByChatMessages(ctx, exec, insert, related...)
+}
+
+// RemoveDeletedByChatMessagesG relationships from objects passed in.
+// Removes related items from R.DeletedByChatMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.DeletedBy.
+// Uses the global database handle.
+func (o *User) RemoveDeletedByChatMessagesG(ctx context.Context, related ...*ChatMessage) error {
+	return o.RemoveDeletedByChatMessages(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveDeletedByChatMessages relationships from objects passed in.
+// Removes related items from R.DeletedByChatMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.DeletedBy.
+func (o *User) RemoveDeletedByChatMessages(ctx context.Context, exec boil.ContextExecutor, related ...*ChatMessage) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DeletedByID, nil)
+		if rel.R != nil {
+			rel.R.DeletedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("deleted_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DeletedByChatMessages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DeletedByChatMessages)
+			if ln > 1 && i < ln-1 {
+				o.R.DeletedByChatMessages[i] = o.R.DeletedByChatMessages[ln-1]
+			}
+			o.R.DeletedByChatMessages = o.R.DeletedByChatMessages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSenderChatMessagesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SenderChatMessages.
+// Sets related.R.Sender appropriately.
+// Uses the global database handle.
+func (o *User) AddSenderChatMessagesG(ctx context.Context, insert bool, related ...*ChatMessage) error {
+	return o.AddSenderChatMessages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSenderChatMessages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SenderChatMessages.
+// Sets related.R.Sender appropriately.
+func (o *User) AddSenderChatMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SenderID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_message\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SenderID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SenderChatMessages: related,
+		}
+	} else {
+		o.R.SenderChatMessages = append(o.R.SenderChatMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMessageR{
+				Sender: o,
+			}
+		} else {
+			rel.R.Sender = o
+		}
+	}
+	return nil
+}
+
+// SetSenderChatMessagesG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Sender's SenderChatMessages accordingly.
+// Replaces o.R.SenderChatMessages with related.
+// Sets related.R.Sender's SenderChatMessages accordingly.
+// Uses the global database handle.
+func (o *User) SetSenderChatMessagesG(ctx context.Context, insert bool, related ...*ChatMessage) error {
+	return o.SetSenderChatMessages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetSenderChatMessages removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Sender's SenderChatMessages accordingly.
+// Replaces o.R.SenderChatMessages with related.
+// Sets related.R.Sender's SenderChatMessages accordingly.
+func (o *User) SetSenderChatMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMessage) error {
+	query := "update \"chat_message\" set \"sender_id\" = null where \"sender_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SenderChatMessages {
+			queries.SetScanner(&rel.SenderID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Sender = nil
+		}
+
+		o.R.SenderChatMessages = nil
+	}
+	return o.AddSenderChatMessages(ctx, exec, insert, related...)
+}
+
+// RemoveSenderChatMessagesG relationships from objects passed in.
+// Removes related items from R.SenderChatMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Sender.
+// Uses the global database handle.
+func (o *User) RemoveSenderChatMessagesG(ctx context.Context, related ...*ChatMessage) error {
+	return o.RemoveSenderChatMessages(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveSenderChatMessages relationships from objects passed in.
+// Removes related items from R.SenderChatMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Sender.
+func (o *User) RemoveSenderChatMessages(ctx context.Context, exec boil.ContextExecutor, related ...*ChatMessage) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SenderID, nil)
+		if rel.R != nil {
+			rel.R.Sender = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("sender_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SenderChatMessages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SenderChatMessages)
+			if ln > 1 && i < ln-1 {
+				o.R.SenderChatMessages[i] = o.R.SenderChatMessages[ln-1]
+			}
+			o.R.SenderChatMessages = o.R.SenderChatMessages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMutedByChatMutesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MutedByChatMutes.
+// Sets related.R.MutedBy appropriately.
+// Uses the global database handle.
+func (o *User) AddMutedByChatMutesG(ctx context.Context, insert bool, related ...*ChatMute) error {
+	return o.AddMutedByChatMutes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMutedByChatMutes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MutedByChatMutes.
+// Sets related.R.MutedBy appropriately.
+func (o *User) AddMutedByChatMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.MutedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_mute\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"muted_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CourseID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.MutedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MutedByChatMutes: related,
+		}
+	} else {
+		o.R.MutedByChatMutes = append(o.R.MutedByChatMutes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMuteR{
+				MutedBy: o,
+			}
+		} else {
+			rel.R.MutedBy = o
+		}
+	}
+	return nil
+}
+
+// SetMutedByChatMutesG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MutedBy's MutedByChatMutes accordingly.
+// Replaces o.R.MutedByChatMutes with related.
+// Sets related.R.MutedBy's MutedByChatMutes accordingly.
+// Uses the global database handle.
+func (o *User) SetMutedByChatMutesG(ctx context.Context, insert bool, related ...*ChatMute) error {
+	return o.SetMutedByChatMutes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetMutedByChatMutes removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MutedBy's MutedByChatMutes accordingly.
+// Replaces o.R.MutedByChatMutes with related.
+// Sets related.R.MutedBy's MutedByChatMutes accordingly.
+func (o *User) SetMutedByChatMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMute) error {
+	query := "update \"chat_mute\" set \"muted_by_id\" = null where \"muted_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.MutedByChatMutes {
+			queries.SetScanner(&rel.MutedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.MutedBy = nil
+		}
+
+		o.R.MutedByChatMutes = nil
+	}
+	return o.AddMutedByChatMutes(ctx, exec, insert, related...)
+}
+
+// RemoveMutedByChatMutesG relationships from objects passed in.
+// Removes related items from R.MutedByChatMutes (uses pointer comparison, removal does not keep order)
+// Sets related.R.MutedBy.
+// Uses the global database handle.
+func (o *User) RemoveMutedByChatMutesG(ctx context.Context, related ...*ChatMute) error {
+	return o.RemoveMutedByChatMutes(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveMutedByChatMutes relationships from objects passed in.
+// Removes related items from R.MutedByChatMutes (uses pointer comparison, removal does not keep order)
+// Sets related.R.MutedBy.
+func (o *User) RemoveMutedByChatMutes(ctx context.Context, exec boil.ContextExecutor, related ...*ChatMute) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.MutedByID, nil)
+		if rel.R != nil {
+			rel.R.MutedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("muted_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MutedByChatMutes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MutedByChatMutes)
+			if ln > 1 && i < ln-1 {
+				o.R.MutedByChatMutes[i] = o.R.MutedByChatMutes[ln-1]
+			}
+			o.R.MutedByChatMutes = o.R.MutedByChatMutes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddChatMutesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatMutes.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatMutesG(ctx context.Context, insert bool, related ...*ChatMute) error {
+	return o.AddChatMutes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatMutes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatMutes.
+// Sets related.R.User appropriately.
+func (o *User) AddChatMutes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatMute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_mute\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatMutePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CourseID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChatMutes: related,
+		}
+	} else {
+		o.R.ChatMutes = append(o.R.ChatMutes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatMuteR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddChatReadsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatReads.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatReadsG(ctx context.Context, insert bool, related ...*ChatRead) error {
+	return o.AddChatReads(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatReads adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatReads.
+// Sets related.R.User appropriately.
+func (o *User) AddChatReads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRead) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_read\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatReadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoomID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChatReads: related,
+		}
+	} else {
+		o.R.ChatReads = append(o.R.ChatReads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatReadR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByChatRoomsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByChatRooms.
+// Sets related.R.CreatedBy appropriately.
+// Uses the global database handle.
+func (o *User) AddCreatedByChatRoomsG(ctx context.Context, insert bool, related ...*ChatRoom) error {
+	return o.AddCreatedByChatRooms(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCreatedByChatRooms adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByChatRooms.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByChatRooms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRoom) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_room\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatRoomPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByChatRooms: related,
+		}
+	} else {
+		o.R.CreatedByChatRooms = append(o.R.CreatedByChatRooms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatRoomR{
+				CreatedBy: o,
+			}
+		} else {
+			rel.R.CreatedBy = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByChatRoomsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByChatRooms accordingly.
+// Replaces o.R.CreatedByChatRooms with related.
+// Sets related.R.CreatedBy's CreatedByChatRooms accordingly.
+// Uses the global database handle.
+func (o *User) SetCreatedByChatRoomsG(ctx context.Context, insert bool, related ...*ChatRoom) error {
+	return o.SetCreatedByChatRooms(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetCreatedByChatRooms removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByChatRooms accordingly.
+// Replaces o.R.CreatedByChatRooms with related.
+// Sets related.R.CreatedBy's CreatedByChatRooms accordingly.
+func (o *User) SetCreatedByChatRooms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRoom) error {
+	query := "update \"chat_room\" set \"created_by_id\" = null where \"created_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByChatRooms {
+			queries.SetScanner(&rel.CreatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedBy = nil
+		}
+
+		o.R.CreatedByChatRooms = nil
+	}
+	return o.AddCreatedByChatRooms(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByChatRoomsG relationships from objects passed in.
+// Removes related items from R.CreatedByChatRooms (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+// Uses the global database handle.
+func (o *User) RemoveCreatedByChatRoomsG(ctx context.Context, related ...*ChatRoom) error {
+	return o.RemoveCreatedByChatRooms(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveCreatedByChatRooms relationships from objects passed in.
+// Removes related items from R.CreatedByChatRooms (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+func (o *User) RemoveCreatedByChatRooms(ctx context.Context, exec boil.ContextExecutor, related ...*ChatRoom) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedByID, nil)
+		if rel.R != nil {
+			rel.R.CreatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByChatRooms {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByChatRooms)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByChatRooms[i] = o.R.CreatedByChatRooms[ln-1]
+			}
+			o.R.CreatedByChatRooms = o.R.CreatedByChatRooms[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddChatRoomMembersG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatRoomMembers.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatRoomMembersG(ctx context.Context, insert bool, related ...*ChatRoomMember) error {
+	return o.AddChatRoomMembers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatRoomMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatRoomMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddChatRoomMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatRoomMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_room_member\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatRoomMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoomID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChatRoomMembers: related,
+		}
+	} else {
+		o.R.ChatRoomMembers = append(o.R.ChatRoomMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatRoomMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddStudentClassStudentsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentClassStudents.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentClassStudentsG(ctx context.Context, insert bool, related ...*ClassStudent) error {
+	return o.AddStudentClassStudents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentClassStudents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentClassStudents.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentClassStudents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ClassStudent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"class_student\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, classStudentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ClassID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentClassStudents: related,
+		}
+	} else {
+		o.R.StudentClassStudents = append(o.R.StudentClassStudents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &classStudentR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddTeacherCoursesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TeacherCourses.
+// Sets related.R.Teacher appropriately.
+// Uses the global database handle.
+func (o *User) AddTeacherCoursesG(ctx context.Context, insert bool, related ...*Course) error {
+	return o.AddTeacherCourses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTeacherCourses adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TeacherCourses.
+// Sets related.R.Teacher appropriately.
+func (o *User) AddTeacherCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TeacherID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"course\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"teacher_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coursePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TeacherID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TeacherCourses: related,
+		}
+	} else {
+		o.R.TeacherCourses = append(o.R.TeacherCourses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &courseR{
+				Teacher: o,
+			}
+		} else {
+			rel.R.Teacher = o
+		}
+	}
+	return nil
+}
+
+// SetTeacherCoursesG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Teacher's TeacherCourses accordingly.
+// Replaces o.R.TeacherCourses with related.
+// Sets related.R.Teacher's TeacherCourses accordingly.
+// Uses the global database handle.
+func (o *User) SetTeacherCoursesG(ctx context.Context, insert bool, related ...*Course) error {
+	return o.SetTeacherCourses(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTeacherCourses removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Teacher's TeacherCourses accordingly.
+// Replaces o.R.TeacherCourses with related.
+// Sets related.R.Teacher's TeacherCourses accordingly.
+func (o *User) SetTeacherCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
+	query := "update \"course\" set \"teacher_id\" = null where \"teacher_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TeacherCourses {
+			queries.SetScanner(&rel.TeacherID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Teacher = nil
+		}
+
+		o.R.TeacherCourses = nil
+	}
+	return o.AddTeacherCourses(ctx, exec, insert, related...)
+}
+
+// RemoveTeacherCoursesG relationships from objects passed in.
+// Removes related items from R.TeacherCourses (uses pointer comparison, removal does not keep order)
+// Sets related.R.Teacher.
+// Uses the global database handle.
+func (o *User) RemoveTeacherCoursesG(ctx context.Context, related ...*Course) error {
+	return o.RemoveTeacherCourses(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTeacherCourses relationships from objects passed in.
+// Removes related items from R.TeacherCourses (uses pointer comparison, removal does not keep order)
+// Sets related.R.Teacher.
+func (o *User) RemoveTeacherCourses(ctx context.Context, exec boil.ContextExecutor, related ...*Course) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TeacherID, nil)
+		if rel.R != nil {
+			rel.R.Teacher = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("teacher_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TeacherCourses {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TeacherCourses)
+			if ln > 1 && i < ln-1 {
+				o.R.TeacherCourses[i] = o.R.TeacherCourses[ln-1]
+			}
+			o.R.TeacherCourses = o.R.TeacherCourses[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddExternalIdentitiesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ExternalIdentities.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddExternalIdentitiesG(ctx context.Context, insert bool, related ...*ExternalIdentity) error {
+	return o.AddExternalIdentities(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddExternalIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ExternalIdentities.
+// Sets related.R.User appropriately.
+func (o *User) AddExternalIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"external_identity\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, externalIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ExternalIdentities: related,
+		}
+	} else {
+		o.R.ExternalIdentities = append(o.R.ExternalIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &externalIdentityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddGuardianGuardianLinksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.GuardianGuardianLinks.
+// Sets related.R.Guardian appropriately.
+// Uses the global database handle.
+func (o *User) AddGuardianGuardianLinksG(ctx context.Context, insert bool, related ...*GuardianLink) error {
+	return o.AddGuardianGuardianLinks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddGuardianGuardianLinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.GuardianGuardianLinks.
+// Sets related.R.Guardian appropriately.
+func (o *User) AddGuardianGuardianLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GuardianLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GuardianID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"guardian_link\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"guardian_id"}),
+				strmangle.WhereClause("\"", "\"", 2, guardianLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.GuardianID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GuardianID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			GuardianGuardianLinks: related,
+		}
+	} else {
+		o.R.GuardianGuardianLinks = append(o.R.GuardianGuardianLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &guardianLinkR{
+				Guardian: o,
+			}
+		} else {
+			rel.R.Guardian = o
+		}
+	}
+	return nil
+}
+
+// AddStudentGuardianLinksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentGuardianLinks.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentGuardianLinksG(ctx context.Context, insert bool, related ...*GuardianLink) error {
+	return o.AddStudentGuardianLinks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentGuardianLinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentGuardianLinks.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentGuardianLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GuardianLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"guardian_link\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, guardianLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.GuardianID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentGuardianLinks: related,
+		}
+	} else {
+		o.R.StudentGuardianLinks = append(o.R.StudentGuardianLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &guardianLinkR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddStudentMarksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentMarks.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentMarksG(ctx context.Context, insert bool, related ...*Mark) error {
+	return o.AddStudentMarks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentMarks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentMarks.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentMarks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mark) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mark\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, markPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AssessmentID, rel.StudentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentMarks: related,
+		}
+	} else {
+		o.R.StudentMarks = append(o.R.StudentMarks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &markR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorMarkImportsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorMarkImports.
+// Sets related.R.Author appropriately.
+// Uses the global database handle.
+func (o *User) AddAuthorMarkImportsG(ctx context.Context, insert bool, related ...*MarkImport) error {
+	return o.AddAuthorMarkImports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAuthorMarkImports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorMarkImports.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorMarkImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MarkImport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AuthorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mark_import\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 2, markImportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AuthorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorMarkImports: related,
+		}
+	} else {
+		o.R.AuthorMarkImports = append(o.R.AuthorMarkImports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &markImportR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
+// SetAuthorMarkImportsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorMarkImports accordingly.
+// Replaces o.R.AuthorMarkImports with related.
+// Sets related.R.Author's AuthorMarkImports accordingly.
+// Uses the global database handle.
+func (o *User) SetAuthorMarkImportsG(ctx context.Context, insert bool, related ...*MarkImport) error {
+	return o.SetAuthorMarkImports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAuthorMarkImports removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorMarkImports accordingly.
+// Replaces o.R.AuthorMarkImports with related.
+// Sets related.R.Author's AuthorMarkImports accordingly.
+func (o *User) SetAuthorMarkImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MarkImport) error {
+	query := "update \"mark_import\" set \"author_id\" = null where \"author_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AuthorMarkImports {
+			queries.SetScanner(&rel.AuthorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Author = nil
+		}
+
+		o.R.AuthorMarkImports = nil
+	}
+	return o.AddAuthorMarkImports(ctx, exec, insert, related...)
+}
+
+// RemoveAuthorMarkImportsG relationships from objects passed in.
+// Removes related items from R.AuthorMarkImports (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+// Uses the global database handle.
+func (o *User) RemoveAuthorMarkImportsG(ctx context.Context, related ...*MarkImport) error {
+	return o.RemoveAuthorMarkImports(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAuthorMarkImports relationships from objects passed in.
+// Removes related items from R.AuthorMarkImports (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+func (o *User) RemoveAuthorMarkImports(ctx context.Context, exec boil.ContextExecutor, related ...*MarkImport) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AuthorID, nil)
+		if rel.R != nil {
+			rel.R.Author = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("author_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AuthorMarkImports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AuthorMarkImports)
+			if ln > 1 && i < ln-1 {
+				o.R.AuthorMarkImports[i] = o.R.AuthorMarkImports[ln-1]
+			}
+			o.R.AuthorMarkImports = o.R.AuthorMarkImports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddNotificationsG(ctx context.Context, insert bool, related ...*Notification) error {
+	return o.AddNotifications(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationPreferencesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddNotificationPreferencesG(ctx context.Context, insert bool, related ...*NotificationPreference) error {
+	return o.AddNotificationPreferences(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_preference\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Event}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPushSubscriptionsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushSubscriptions.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddPushSubscriptionsG(ctx context.Context, insert bool, related ...*PushSubscription) error {
+	return o.AddPushSubscriptions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPushSubscriptions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushSubscriptions.
+// Sets related.R.User appropriately.
+func (o *User) AddPushSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushSubscription) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_subscription\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushSubscriptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PushSubscriptions: related,
+		}
+	} else {
+		o.R.PushSubscriptions = append(o.R.PushSubscriptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushSubscriptionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRbacRoleMembersG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RbacRoleMembers.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddRbacRoleMembersG(ctx context.Context, insert bool, related ...*RbacRoleMember) error {
+	return o.AddRbacRoleMembers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRbacRoleMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RbacRoleMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddRbacRoleMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RbacRoleMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"rbac_role_member\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rbacRoleMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoleID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RbacRoleMembers: related,
+		}
+	} else {
+		o.R.RbacRoleMembers = append(o.R.RbacRoleMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rbacRoleMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRefreshTokensG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddRefreshTokensG(ctx context.Context, insert bool, related ...*RefreshToken) error {
+	return o.AddRefreshTokens(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_token\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddStudentWalletAwardsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentWalletAwards.
+// Sets related.R.Student appropriately.
+// Uses the global database handle.
+func (o *User) AddStudentWalletAwardsG(ctx context.Context, insert bool, related ...*WalletAward) error {
+	return o.AddStudentWalletAwards(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStudentWalletAwards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.StudentWalletAwards.
+// Sets related.R.Student appropriately.
+func (o *User) AddStudentWalletAwards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletAward) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.StudentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_award\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletAwardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.StudentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			StudentWalletAwards: related,
+		}
+	} else {
+		o.R.StudentWalletAwards = append(o.R.StudentWalletAwards, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletAwardR{
+				Student: o,
+			}
+		} else {
+			rel.R.Student = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorWalletTransactionsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorWalletTransactions.
+// Sets related.R.Author appropriately.
+// Uses the global database handle.
+func (o *User) AddAuthorWalletTransactionsG(ctx context.Context, insert bool, related ...*WalletTransaction) error {
+	return o.AddAuthorWalletTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAuthorWalletTransactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorWalletTransactions.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorWalletTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletTransaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AuthorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_transaction\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AuthorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorWalletTransactions: related,
+		}
+	} else {
+		o.R.AuthorWalletTransactions = append(o.R.AuthorWalletTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletTransactionR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
+// SetAuthorWalletTransactionsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorWalletTransactions accordingly.
+// Replaces o.R.AuthorWalletTransactions with related.
+// Sets related.R.Author's AuthorWalletTransactions accordingly.
+// Uses the global database handle.
+func (o *User) SetAuthorWalletTransactionsG(ctx context.Context, insert bool, related ...*WalletTransaction) error {
+	return o.SetAuthorWalletTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetAuthorWalletTransactions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Author's AuthorWalletTransactions accordingly.
+// Replaces o.R.AuthorWalletTransactions with related.
+// Sets related.R.Author's AuthorWalletTransactions accordingly.
+func (o *User) SetAuthorWalletTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletTransaction) error {
+	query := "update \"wallet_transaction\" set \"author_id\" = null where \"author_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AuthorWalletTransactions {
+			queries.SetScanner(&rel.AuthorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Author = nil
+		}
+
+		o.R.AuthorWalletTransactions = nil
+	}
+	return o.AddAuthorWalletTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveAuthorWalletTransactionsG relationships from objects passed in.
+// Removes related items from R.AuthorWalletTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+// Uses the global database handle.
+func (o *User) RemoveAuthorWalletTransactionsG(ctx context.Context, related ...*WalletTransaction) error {
+	return o.RemoveAuthorWalletTransactions(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveAuthorWalletTransactions relationships from objects passed in.
+// Removes related items from R.AuthorWalletTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Author.
+func (o *User) RemoveAuthorWalletTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*WalletTransaction) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AuthorID, nil)
+		if rel.R != nil {
+			rel.R.Author = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("author_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AuthorWalletTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AuthorWalletTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.AuthorWalletTransactions[i] = o.R.AuthorWalletTransactions[ln-1]
+			}
+			o.R.AuthorWalletTransactions = o.R.AuthorWalletTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"user\""))
@@ -0,0 +1,1140 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WalletAccount is an object representing the database table.
+type WalletAccount struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID  string    `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Kind      string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	OwnerID   string    `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	Year      int       `boil:"year" json:"year" toml:"year" yaml:"year"`
+	Balance   int       `boil:"balance" json:"balance" toml:"balance" yaml:"balance"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *walletAccountR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L walletAccountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WalletAccountColumns = struct {
+	ID        string
+	SchoolID  string
+	Kind      string
+	OwnerID   string
+	Year      string
+	Balance   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	SchoolID:  "school_id",
+	Kind:      "kind",
+	OwnerID:   "owner_id",
+	Year:      "year",
+	Balance:   "balance",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+// Generated where
+
+var WalletAccountWhere = struct {
+	ID        whereHelperstring
+	SchoolID  whereHelperstring
+	Kind      whereHelperstring
+	OwnerID   whereHelperstring
+	Year      whereHelperint
+	Balance   whereHelperint
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"wallet_account\".\"id\""},
+	SchoolID:  whereHelperstring{field: "\"wallet_account\".\"school_id\""},
+	Kind:      whereHelperstring{field: "\"wallet_account\".\"kind\""},
+	OwnerID:   whereHelperstring{field: "\"wallet_account\".\"owner_id\""},
+	Year:      whereHelperint{field: "\"wallet_account\".\"year\""},
+	Balance:   whereHelperint{field: "\"wallet_account\".\"balance\""},
+	CreatedAt: whereHelpernull_Time{field: "\"wallet_account\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"wallet_account\".\"updated_at\""},
+}
+
+// WalletAccountRels is where relationship names are stored.
+var WalletAccountRels = struct {
+	School               string
+	AccountWalletEntries string
+}{
+	School:               "School",
+	AccountWalletEntries: "AccountWalletEntries",
+}
+
+// walletAccountR is where relationships are stored.
+type walletAccountR struct {
+	School               *School          `boil:"School" json:"School" toml:"School" yaml:"School"`
+	AccountWalletEntries WalletEntrySlice `boil:"AccountWalletEntries" json:"AccountWalletEntries" toml:"AccountWalletEntries" yaml:"AccountWalletEntries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*walletAccountR) NewStruct() *walletAccountR {
+	return &walletAccountR{}
+}
+
+// walletAccountL is where Load methods for each relationship are stored.
+type walletAccountL struct{}
+
+var (
+	walletAccountAllColumns            = []string{"id", "school_id", "kind", "owner_id", "year", "balance", "created_at", "updated_at"}
+	walletAccountColumnsWithoutDefault = []string{"id", "school_id", "kind", "owner_id", "year", "created_at", "updated_at"}
+	walletAccountColumnsWithDefault    = []string{"balance"}
+	walletAccountPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WalletAccountSlice is an alias for a slice of pointers to WalletAccount.
+	// This should generally be used opposed to []WalletAccount.
+	WalletAccountSlice []*WalletAccount
+
+	walletAccountQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	walletAccountType                 = reflect.TypeOf(&WalletAccount{})
+	walletAccountMapping              = queries.MakeStructMapping(walletAccountType)
+	walletAccountPrimaryKeyMapping, _ = queries.BindMapping(walletAccountType, walletAccountMapping, walletAccountPrimaryKeyColumns)
+	walletAccountInsertCacheMut       sync.RWMutex
+	walletAccountInsertCache          = make(map[string]insertCache)
+	walletAccountUpdateCacheMut       sync.RWMutex
+	walletAccountUpdateCache          = make(map[string]updateCache)
+	walletAccountUpsertCacheMut       sync.RWMutex
+	walletAccountUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single walletAccount record from the query using the global executor.
+func (q walletAccountQuery) OneG(ctx context.Context) (*WalletAccount, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single walletAccount record from the query.
+func (q walletAccountQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WalletAccount, error) {
+	o := &WalletAccount{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for wallet_account")
+	}
+
+	return o, nil
+}
+
+// AllG returns all WalletAccount records from the query using the global executor.
+func (q walletAccountQuery) AllG(ctx context.Context) (WalletAccountSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WalletAccount records from the query.
+func (q walletAccountQuery) All(ctx context.Context, exec boil.ContextExecutor) (WalletAccountSlice, error) {
+	var o []*WalletAccount
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WalletAccount slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WalletAccount records in the query, and panics on error.
+func (q walletAccountQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WalletAccount records in the query.
+func (q walletAccountQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count wallet_account rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q walletAccountQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q walletAccountQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if wallet_account exists")
+	}
+
+	return count > 0, nil
+}
+
+// School pointed to by the foreign key.
+func (o *WalletAccount) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// AccountWalletEntries retrieves all the wallet_entry's WalletEntries with an executor via account_id column.
+func (o *WalletAccount) AccountWalletEntries(mods ...qm.QueryMod) walletEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_entry\".\"account_id\"=?", o.ID),
+	)
+
+	query := WalletEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_entry\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_entry\".*"})
+	}
+
+	return query
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletAccountL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletAccount interface{}, mods queries.Applicator) error {
+	var slice []*WalletAccount
+	var object *WalletAccount
+
+	if singular {
+		object = maybeWalletAccount.(*WalletAccount)
+	} else {
+		slice = *maybeWalletAccount.(*[]*WalletAccount)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletAccountR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletAccountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.WalletAccounts = append(foreign.R.WalletAccounts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.WalletAccounts = append(foreign.R.WalletAccounts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccountWalletEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (walletAccountL) LoadAccountWalletEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletAccount interface{}, mods queries.Applicator) error {
+	var slice []*WalletAccount
+	var object *WalletAccount
+
+	if singular {
+		object = maybeWalletAccount.(*WalletAccount)
+	} else {
+		slice = *maybeWalletAccount.(*[]*WalletAccount)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletAccountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletAccountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_entry`),
+		qm.WhereIn(`wallet_entry.account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_entry")
+	}
+
+	var resultSlice []*WalletEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_entry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_entry")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_entry")
+	}
+
+	if singular {
+		object.R.AccountWalletEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletEntryR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountWalletEntries = append(local.R.AccountWalletEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletEntryR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchoolG of the walletAccount to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletAccounts.
+// Uses the global database handle.
+func (o *WalletAccount) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the walletAccount to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletAccounts.
+func (o *WalletAccount) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletAccountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &walletAccountR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			WalletAccounts: WalletAccountSlice{o},
+		}
+	} else {
+		related.R.WalletAccounts = append(related.R.WalletAccounts, o)
+	}
+
+	return nil
+}
+
+// AddAccountWalletEntriesG adds the given related objects to the existing relationships
+// of the wallet_account, optionally inserting them as new records.
+// Appends related to o.R.AccountWalletEntries.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *WalletAccount) AddAccountWalletEntriesG(ctx context.Context, insert bool, related ...*WalletEntry) error {
+	return o.AddAccountWalletEntries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAccountWalletEntries adds the given related objects to the existing relationships
+// of the wallet_account, optionally inserting them as new records.
+// Appends related to o.R.AccountWalletEntries.
+// Sets related.R.Account appropriately.
+func (o *WalletAccount) AddAccountWalletEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_entry\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &walletAccountR{
+			AccountWalletEntries: related,
+		}
+	} else {
+		o.R.AccountWalletEntries = append(o.R.AccountWalletEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletEntryR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// WalletAccounts retrieves all the records using an executor.
+func WalletAccounts(mods ...qm.QueryMod) walletAccountQuery {
+	mods = append(mods, qm.From("\"wallet_account\""))
+	return walletAccountQuery{NewQuery(mods...)}
+}
+
+// FindWalletAccountG retrieves a single record by ID.
+func FindWalletAccountG(ctx context.Context, iD string, selectCols ...string) (*WalletAccount, error) {
+	return FindWalletAccount(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWalletAccount retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWalletAccount(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WalletAccount, error) {
+	walletAccountObj := &WalletAccount{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"wallet_account\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, walletAccountObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from wallet_account")
+	}
+
+	return walletAccountObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WalletAccount) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WalletAccount) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_account provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletAccountColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	walletAccountInsertCacheMut.RLock()
+	cache, cached := walletAccountInsertCache[key]
+	walletAccountInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			walletAccountAllColumns,
+			walletAccountColumnsWithDefault,
+			walletAccountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(walletAccountType, walletAccountMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(walletAccountType, walletAccountMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"wallet_account\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"wallet_account\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into wallet_account")
+	}
+
+	if !cached {
+		walletAccountInsertCacheMut.Lock()
+		walletAccountInsertCache[key] = cache
+		walletAccountInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single WalletAccount record using the global executor.
+// See Update for more documentation.
+func (o *WalletAccount) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WalletAccount.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WalletAccount) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	walletAccountUpdateCacheMut.RLock()
+	cache, cached := walletAccountUpdateCache[key]
+	walletAccountUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			walletAccountAllColumns,
+			walletAccountPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update wallet_account, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"wallet_account\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, walletAccountPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(walletAccountType, walletAccountMapping, append(wl, walletAccountPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update wallet_account row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for wallet_account")
+	}
+
+	if !cached {
+		walletAccountUpdateCacheMut.Lock()
+		walletAccountUpdateCache[key] = cache
+		walletAccountUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q walletAccountQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q walletAccountQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for wallet_account")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for wallet_account")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WalletAccountSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WalletAccountSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"wallet_account\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, walletAccountPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in walletAccount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all walletAccount")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WalletAccount) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WalletAccount) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_account provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletAccountColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	walletAccountUpsertCacheMut.RLock()
+	cache, cached := walletAccountUpsertCache[key]
+	walletAccountUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			walletAccountAllColumns,
+			walletAccountColumnsWithDefault,
+			walletAccountColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			walletAccountAllColumns,
+			walletAccountPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert wallet_account, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(walletAccountPrimaryKeyColumns))
+			copy(conflict, walletAccountPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"wallet_account\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(walletAccountType, walletAccountMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(walletAccountType, walletAccountMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert wallet_account")
+	}
+
+	if !cached {
+		walletAccountUpsertCacheMut.Lock()
+		walletAccountUpsertCache[key] = cache
+		walletAccountUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single WalletAccount record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WalletAccount) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WalletAccount record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WalletAccount) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WalletAccount provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), walletAccountPrimaryKeyMapping)
+	sql := "DELETE FROM \"wallet_account\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from wallet_account")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for wallet_account")
+	}
+
+	return rowsAff, nil
+}
+
+func (q walletAccountQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q walletAccountQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no walletAccountQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from wallet_account")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_account")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WalletAccountSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WalletAccountSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"wallet_account\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletAccountPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from walletAccount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_account")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WalletAccount) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WalletAccount provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WalletAccount) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWalletAccount(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletAccountSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WalletAccountSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletAccountSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WalletAccountSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"wallet_account\".* FROM \"wallet_account\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletAccountPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WalletAccountSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WalletAccountExistsG checks if the WalletAccount row exists.
+func WalletAccountExistsG(ctx context.Context, iD string) (bool, error) {
+	return WalletAccountExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WalletAccountExists checks if the WalletAccount row exists.
+func WalletAccountExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"wallet_account\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if wallet_account exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1304 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WalletAward is an object representing the database table.
+type WalletAward struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID  string    `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Year      int       `boil:"year" json:"year" toml:"year" yaml:"year"`
+	Scope     string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	ScopeID   string    `boil:"scope_id" json:"scope_id" toml:"scope_id" yaml:"scope_id"`
+	Rank      int       `boil:"rank" json:"rank" toml:"rank" yaml:"rank"`
+	StudentID string    `boil:"student_id" json:"student_id" toml:"student_id" yaml:"student_id"`
+	ClassID   string    `boil:"class_id" json:"class_id" toml:"class_id" yaml:"class_id"`
+	Points    int       `boil:"points" json:"points" toml:"points" yaml:"points"`
+	Average   float64   `boil:"average" json:"average" toml:"average" yaml:"average"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *walletAwardR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L walletAwardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WalletAwardColumns = struct {
+	ID        string
+	SchoolID  string
+	Year      string
+	Scope     string
+	ScopeID   string
+	Rank      string
+	StudentID string
+	ClassID   string
+	Points    string
+	Average   string
+	CreatedAt string
+}{
+	ID:        "id",
+	SchoolID:  "school_id",
+	Year:      "year",
+	Scope:     "scope",
+	ScopeID:   "scope_id",
+	Rank:      "rank",
+	StudentID: "student_id",
+	ClassID:   "class_id",
+	Points:    "points",
+	Average:   "average",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var WalletAwardWhere = struct {
+	ID        whereHelperstring
+	SchoolID  whereHelperstring
+	Year      whereHelperint
+	Scope     whereHelperstring
+	ScopeID   whereHelperstring
+	Rank      whereHelperint
+	StudentID whereHelperstring
+	ClassID   whereHelperstring
+	Points    whereHelperint
+	Average   whereHelperfloat64
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"wallet_award\".\"id\""},
+	SchoolID:  whereHelperstring{field: "\"wallet_award\".\"school_id\""},
+	Year:      whereHelperint{field: "\"wallet_award\".\"year\""},
+	Scope:     whereHelperstring{field: "\"wallet_award\".\"scope\""},
+	ScopeID:   whereHelperstring{field: "\"wallet_award\".\"scope_id\""},
+	Rank:      whereHelperint{field: "\"wallet_award\".\"rank\""},
+	StudentID: whereHelperstring{field: "\"wallet_award\".\"student_id\""},
+	ClassID:   whereHelperstring{field: "\"wallet_award\".\"class_id\""},
+	Points:    whereHelperint{field: "\"wallet_award\".\"points\""},
+	Average:   whereHelperfloat64{field: "\"wallet_award\".\"average\""},
+	CreatedAt: whereHelpernull_Time{field: "\"wallet_award\".\"created_at\""},
+}
+
+// WalletAwardRels is where relationship names are stored.
+var WalletAwardRels = struct {
+	Class   string
+	School  string
+	Student string
+}{
+	Class:   "Class",
+	School:  "School",
+	Student: "Student",
+}
+
+// walletAwardR is where relationships are stored.
+type walletAwardR struct {
+	Class   *Class  `boil:"Class" json:"Class" toml:"Class" yaml:"Class"`
+	School  *School `boil:"School" json:"School" toml:"School" yaml:"School"`
+	Student *User   `boil:"Student" json:"Student" toml:"Student" yaml:"Student"`
+}
+
+// NewStruct creates a new relationship struct
+func (*walletAwardR) NewStruct() *walletAwardR {
+	return &walletAwardR{}
+}
+
+// walletAwardL is where Load methods for each relationship are stored.
+type walletAwardL struct{}
+
+var (
+	walletAwardAllColumns            = []string{"id", "school_id", "year", "scope", "scope_id", "rank", "student_id", "class_id", "points", "average", "created_at"}
+	walletAwardColumnsWithoutDefault = []string{"id", "school_id", "year", "scope", "scope_id", "rank", "student_id", "class_id", "points", "average", "created_at"}
+	walletAwardColumnsWithDefault    = []string{}
+	walletAwardPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WalletAwardSlice is an alias for a slice of pointers to WalletAward.
+	// This should generally be used opposed to []WalletAward.
+	WalletAwardSlice []*WalletAward
+
+	walletAwardQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	walletAwardType                 = reflect.TypeOf(&WalletAward{})
+	walletAwardMapping              = queries.MakeStructMapping(walletAwardType)
+	walletAwardPrimaryKeyMapping, _ = queries.BindMapping(walletAwardType, walletAwardMapping, walletAwardPrimaryKeyColumns)
+	walletAwardInsertCacheMut       sync.RWMutex
+	walletAwardInsertCache          = make(map[string]insertCache)
+	walletAwardUpdateCacheMut       sync.RWMutex
+	walletAwardUpdateCache          = make(map[string]updateCache)
+	walletAwardUpsertCacheMut       sync.RWMutex
+	walletAwardUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single walletAward record from the query using the global executor.
+func (q walletAwardQuery) OneG(ctx context.Context) (*WalletAward, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single walletAward record from the query.
+func (q walletAwardQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WalletAward, error) {
+	o := &WalletAward{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for wallet_award")
+	}
+
+	return o, nil
+}
+
+// AllG returns all WalletAward records from the query using the global executor.
+func (q walletAwardQuery) AllG(ctx context.Context) (WalletAwardSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WalletAward records from the query.
+func (q walletAwardQuery) All(ctx context.Context, exec boil.ContextExecutor) (WalletAwardSlice, error) {
+	var o []*WalletAward
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WalletAward slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WalletAward records in the query, and panics on error.
+func (q walletAwardQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WalletAward records in the query.
+func (q walletAwardQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count wallet_award rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q walletAwardQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q walletAwardQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if wallet_award exists")
+	}
+
+	return count > 0, nil
+}
+
+// Class pointed to by the foreign key.
+func (o *WalletAward) Class(mods ...qm.QueryMod) classQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClassID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Classes(queryMods...)
+	queries.SetFrom(query.Query, "\"class\"")
+
+	return query
+}
+
+// School pointed to by the foreign key.
+func (o *WalletAward) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// Student pointed to by the foreign key.
+func (o *WalletAward) Student(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.StudentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// LoadClass allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletAwardL) LoadClass(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletAward interface{}, mods queries.Applicator) error {
+	var slice []*WalletAward
+	var object *WalletAward
+
+	if singular {
+		object = maybeWalletAward.(*WalletAward)
+	} else {
+		slice = *maybeWalletAward.(*[]*WalletAward)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletAwardR{}
+		}
+		args = append(args, object.ClassID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletAwardR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ClassID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ClassID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`class`),
+		qm.WhereIn(`class.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Class")
+	}
+
+	var resultSlice []*Class
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Class")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Class = foreign
+		if foreign.R == nil {
+			foreign.R = &classR{}
+		}
+		foreign.R.WalletAwards = append(foreign.R.WalletAwards, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ClassID == foreign.ID {
+				local.R.Class = foreign
+				if foreign.R == nil {
+					foreign.R = &classR{}
+				}
+				foreign.R.WalletAwards = append(foreign.R.WalletAwards, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletAwardL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletAward interface{}, mods queries.Applicator) error {
+	var slice []*WalletAward
+	var object *WalletAward
+
+	if singular {
+		object = maybeWalletAward.(*WalletAward)
+	} else {
+		slice = *maybeWalletAward.(*[]*WalletAward)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletAwardR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletAwardR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.WalletAwards = append(foreign.R.WalletAwards, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.WalletAwards = append(foreign.R.WalletAwards, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStudent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletAwardL) LoadStudent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletAward interface{}, mods queries.Applicator) error {
+	var slice []*WalletAward
+	var object *WalletAward
+
+	if singular {
+		object = maybeWalletAward.(*WalletAward)
+	} else {
+		slice = *maybeWalletAward.(*[]*WalletAward)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletAwardR{}
+		}
+		args = append(args, object.StudentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletAwardR{}
+			}
+
+			for _, a := range args {
+				if a == obj.StudentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.StudentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Student = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.StudentWalletAwards = append(foreign.R.StudentWalletAwards, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.StudentID == foreign.ID {
+				local.R.Student = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.StudentWalletAwards = append(foreign.R.StudentWalletAwards, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClassG of the walletAward to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.WalletAwards.
+// Uses the global database handle.
+func (o *WalletAward) SetClassG(ctx context.Context, insert bool, related *Class) error {
+	return o.SetClass(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetClass of the walletAward to the related item.
+// Sets o.R.Class to related.
+// Adds o to related.R.WalletAwards.
+func (o *WalletAward) SetClass(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Class) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_award\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"class_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletAwardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ClassID = related.ID
+	if o.R == nil {
+		o.R = &walletAwardR{
+			Class: related,
+		}
+	} else {
+		o.R.Class = related
+	}
+
+	if related.R == nil {
+		related.R = &classR{
+			WalletAwards: WalletAwardSlice{o},
+		}
+	} else {
+		related.R.WalletAwards = append(related.R.WalletAwards, o)
+	}
+
+	return nil
+}
+
+// SetSchoolG of the walletAward to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletAwards.
+// Uses the global database handle.
+func (o *WalletAward) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the walletAward to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletAwards.
+func (o *WalletAward) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_award\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletAwardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &walletAwardR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			WalletAwards: WalletAwardSlice{o},
+		}
+	} else {
+		related.R.WalletAwards = append(related.R.WalletAwards, o)
+	}
+
+	return nil
+}
+
+// SetStudentG of the walletAward to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentWalletAwards.
+// Uses the global database handle.
+func (o *WalletAward) SetStudentG(ctx context.Context, insert bool, related *User) error {
+	return o.SetStudent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStudent of the walletAward to the related item.
+// Sets o.R.Student to related.
+// Adds o to related.R.StudentWalletAwards.
+func (o *WalletAward) SetStudent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_award\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"student_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletAwardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.StudentID = related.ID
+	if o.R == nil {
+		o.R = &walletAwardR{
+			Student: related,
+		}
+	} else {
+		o.R.Student = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			StudentWalletAwards: WalletAwardSlice{o},
+		}
+	} else {
+		related.R.StudentWalletAwards = append(related.R.StudentWalletAwards, o)
+	}
+
+	return nil
+}
+
+// WalletAwards retrieves all the records using an executor.
+func WalletAwards(mods ...qm.QueryMod) walletAwardQuery {
+	mods = append(mods, qm.From("\"wallet_award\""))
+	return walletAwardQuery{NewQuery(mods...)}
+}
+
+// FindWalletAwardG retrieves a single record by ID.
+func FindWalletAwardG(ctx context.Context, iD string, selectCols ...string) (*WalletAward, error) {
+	return FindWalletAward(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWalletAward retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWalletAward(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WalletAward, error) {
+	walletAwardObj := &WalletAward{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"wallet_award\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, walletAwardObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from wallet_award")
+	}
+
+	return walletAwardObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WalletAward) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WalletAward) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_award provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletAwardColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	walletAwardInsertCacheMut.RLock()
+	cache, cached := walletAwardInsertCache[key]
+	walletAwardInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			walletAwardAllColumns,
+			walletAwardColumnsWithDefault,
+			walletAwardColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(walletAwardType, walletAwardMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(walletAwardType, walletAwardMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"wallet_award\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"wallet_award\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into wallet_award")
+	}
+
+	if !cached {
+		walletAwardInsertCacheMut.Lock()
+		walletAwardInsertCache[key] = cache
+		walletAwardInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single WalletAward record using the global executor.
+// See Update for more documentation.
+func (o *WalletAward) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WalletAward.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WalletAward) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	walletAwardUpdateCacheMut.RLock()
+	cache, cached := walletAwardUpdateCache[key]
+	walletAwardUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			walletAwardAllColumns,
+			walletAwardPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update wallet_award, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"wallet_award\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, walletAwardPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(walletAwardType, walletAwardMapping, append(wl, walletAwardPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update wallet_award row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for wallet_award")
+	}
+
+	if !cached {
+		walletAwardUpdateCacheMut.Lock()
+		walletAwardUpdateCache[key] = cache
+		walletAwardUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q walletAwardQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q walletAwardQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for wallet_award")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for wallet_award")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WalletAwardSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WalletAwardSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAwardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"wallet_award\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, walletAwardPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in walletAward slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all walletAward")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WalletAward) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WalletAward) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_award provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletAwardColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	walletAwardUpsertCacheMut.RLock()
+	cache, cached := walletAwardUpsertCache[key]
+	walletAwardUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			walletAwardAllColumns,
+			walletAwardColumnsWithDefault,
+			walletAwardColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			walletAwardAllColumns,
+			walletAwardPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert wallet_award, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(walletAwardPrimaryKeyColumns))
+			copy(conflict, walletAwardPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"wallet_award\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(walletAwardType, walletAwardMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(walletAwardType, walletAwardMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert wallet_award")
+	}
+
+	if !cached {
+		walletAwardUpsertCacheMut.Lock()
+		walletAwardUpsertCache[key] = cache
+		walletAwardUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single WalletAward record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WalletAward) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WalletAward record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WalletAward) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WalletAward provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), walletAwardPrimaryKeyMapping)
+	sql := "DELETE FROM \"wallet_award\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from wallet_award")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for wallet_award")
+	}
+
+	return rowsAff, nil
+}
+
+func (q walletAwardQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q walletAwardQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no walletAwardQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from wallet_award")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_award")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WalletAwardSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WalletAwardSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAwardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"wallet_award\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletAwardPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from walletAward slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_award")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WalletAward) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WalletAward provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WalletAward) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWalletAward(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletAwardSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WalletAwardSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletAwardSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WalletAwardSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletAwardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"wallet_award\".* FROM \"wallet_award\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletAwardPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WalletAwardSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WalletAwardExistsG checks if the WalletAward row exists.
+func WalletAwardExistsG(ctx context.Context, iD string) (bool, error) {
+	return WalletAwardExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WalletAwardExists checks if the WalletAward row exists.
+func WalletAwardExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"wallet_award\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if wallet_award exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1106 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WalletEntry is an object representing the database table.
+type WalletEntry struct {
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TransactionID string    `boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
+	AccountID     string    `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	Amount        int       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt     null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *walletEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L walletEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WalletEntryColumns = struct {
+	ID            string
+	TransactionID string
+	AccountID     string
+	Amount        string
+	CreatedAt     string
+}{
+	ID:            "id",
+	TransactionID: "transaction_id",
+	AccountID:     "account_id",
+	Amount:        "amount",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+var WalletEntryWhere = struct {
+	ID            whereHelperstring
+	TransactionID whereHelperstring
+	AccountID     whereHelperstring
+	Amount        whereHelperint
+	CreatedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperstring{field: "\"wallet_entry\".\"id\""},
+	TransactionID: whereHelperstring{field: "\"wallet_entry\".\"transaction_id\""},
+	AccountID:     whereHelperstring{field: "\"wallet_entry\".\"account_id\""},
+	Amount:        whereHelperint{field: "\"wallet_entry\".\"amount\""},
+	CreatedAt:     whereHelpernull_Time{field: "\"wallet_entry\".\"created_at\""},
+}
+
+// WalletEntryRels is where relationship names are stored.
+var WalletEntryRels = struct {
+	Account     string
+	Transaction string
+}{
+	Account:     "Account",
+	Transaction: "Transaction",
+}
+
+// walletEntryR is where relationships are stored.
+type walletEntryR struct {
+	Account     *WalletAccount     `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	Transaction *WalletTransaction `boil:"Transaction" json:"Transaction" toml:"Transaction" yaml:"Transaction"`
+}
+
+// NewStruct creates a new relationship struct
+func (*walletEntryR) NewStruct() *walletEntryR {
+	return &walletEntryR{}
+}
+
+// walletEntryL is where Load methods for each relationship are stored.
+type walletEntryL struct{}
+
+var (
+	walletEntryAllColumns            = []string{"id", "transaction_id", "account_id", "amount", "created_at"}
+	walletEntryColumnsWithoutDefault = []string{"id", "transaction_id", "account_id", "amount", "created_at"}
+	walletEntryColumnsWithDefault    = []string{}
+	walletEntryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WalletEntrySlice is an alias for a slice of pointers to WalletEntry.
+	// This should generally be used opposed to []WalletEntry.
+	WalletEntrySlice []*WalletEntry
+
+	walletEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	walletEntryType                 = reflect.TypeOf(&WalletEntry{})
+	walletEntryMapping              = queries.MakeStructMapping(walletEntryType)
+	walletEntryPrimaryKeyMapping, _ = queries.BindMapping(walletEntryType, walletEntryMapping, walletEntryPrimaryKeyColumns)
+	walletEntryInsertCacheMut       sync.RWMutex
+	walletEntryInsertCache          = make(map[string]insertCache)
+	walletEntryUpdateCacheMut       sync.RWMutex
+	walletEntryUpdateCache          = make(map[string]updateCache)
+	walletEntryUpsertCacheMut       sync.RWMutex
+	walletEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single walletEntry record from the query using the global executor.
+func (q walletEntryQuery) OneG(ctx context.Context) (*WalletEntry, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single walletEntry record from the query.
+func (q walletEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WalletEntry, error) {
+	o := &WalletEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for wallet_entry")
+	}
+
+	return o, nil
+}
+
+// AllG returns all WalletEntry records from the query using the global executor.
+func (q walletEntryQuery) AllG(ctx context.Context) (WalletEntrySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WalletEntry records from the query.
+func (q walletEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (WalletEntrySlice, error) {
+	var o []*WalletEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WalletEntry slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WalletEntry records in the query, and panics on error.
+func (q walletEntryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WalletEntry records in the query.
+func (q walletEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count wallet_entry rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q walletEntryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q walletEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if wallet_entry exists")
+	}
+
+	return count > 0, nil
+}
+
+// Account pointed to by the foreign key.
+func (o *WalletEntry) Account(mods ...qm.QueryMod) walletAccountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := WalletAccounts(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_account\"")
+
+	return query
+}
+
+// Transaction pointed to by the foreign key.
+func (o *WalletEntry) Transaction(mods ...qm.QueryMod) walletTransactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransactionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := WalletTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_transaction\"")
+
+	return query
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletEntryL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletEntry interface{}, mods queries.Applicator) error {
+	var slice []*WalletEntry
+	var object *WalletEntry
+
+	if singular {
+		object = maybeWalletEntry.(*WalletEntry)
+	} else {
+		slice = *maybeWalletEntry.(*[]*WalletEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletEntryR{}
+		}
+		args = append(args, object.AccountID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AccountID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AccountID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_account`),
+		qm.WhereIn(`wallet_account.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WalletAccount")
+	}
+
+	var resultSlice []*WalletAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WalletAccount")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for wallet_account")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_account")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &walletAccountR{}
+		}
+		foreign.R.AccountWalletEntries = append(foreign.R.AccountWalletEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &walletAccountR{}
+				}
+				foreign.R.AccountWalletEntries = append(foreign.R.AccountWalletEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletEntryL) LoadTransaction(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletEntry interface{}, mods queries.Applicator) error {
+	var slice []*WalletEntry
+	var object *WalletEntry
+
+	if singular {
+		object = maybeWalletEntry.(*WalletEntry)
+	} else {
+		slice = *maybeWalletEntry.(*[]*WalletEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletEntryR{}
+		}
+		args = append(args, object.TransactionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TransactionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TransactionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_transaction`),
+		qm.WhereIn(`wallet_transaction.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WalletTransaction")
+	}
+
+	var resultSlice []*WalletTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WalletTransaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for wallet_transaction")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_transaction")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Transaction = foreign
+		if foreign.R == nil {
+			foreign.R = &walletTransactionR{}
+		}
+		foreign.R.TransactionWalletEntries = append(foreign.R.TransactionWalletEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TransactionID == foreign.ID {
+				local.R.Transaction = foreign
+				if foreign.R == nil {
+					foreign.R = &walletTransactionR{}
+				}
+				foreign.R.TransactionWalletEntries = append(foreign.R.TransactionWalletEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccountG of the walletEntry to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountWalletEntries.
+// Uses the global database handle.
+func (o *WalletEntry) SetAccountG(ctx context.Context, insert bool, related *WalletAccount) error {
+	return o.SetAccount(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAccount of the walletEntry to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountWalletEntries.
+func (o *WalletEntry) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WalletAccount) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_entry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &walletEntryR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &walletAccountR{
+			AccountWalletEntries: WalletEntrySlice{o},
+		}
+	} else {
+		related.R.AccountWalletEntries = append(related.R.AccountWalletEntries, o)
+	}
+
+	return nil
+}
+
+// SetTransactionG of the walletEntry to the related item.
+// Sets o.R.Transaction to related.
+// Adds o to related.R.TransactionWalletEntries.
+// Uses the global database handle.
+func (o *WalletEntry) SetTransactionG(ctx context.Context, insert bool, related *WalletTransaction) error {
+	return o.SetTransaction(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransaction of the walletEntry to the related item.
+// Sets o.R.Transaction to related.
+// Adds o to related.R.TransactionWalletEntries.
+func (o *WalletEntry) SetTransaction(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WalletTransaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_entry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TransactionID = related.ID
+	if o.R == nil {
+		o.R = &walletEntryR{
+			Transaction: related,
+		}
+	} else {
+		o.R.Transaction = related
+	}
+
+	if related.R == nil {
+		related.R = &walletTransactionR{
+			TransactionWalletEntries: WalletEntrySlice{o},
+		}
+	} else {
+		related.R.TransactionWalletEntries = append(related.R.TransactionWalletEntries, o)
+	}
+
+	return nil
+}
+
+// WalletEntries retrieves all the records using an executor.
+func WalletEntries(mods ...qm.QueryMod) walletEntryQuery {
+	mods = append(mods, qm.From("\"wallet_entry\""))
+	return walletEntryQuery{NewQuery(mods...)}
+}
+
+// FindWalletEntryG retrieves a single record by ID.
+func FindWalletEntryG(ctx context.Context, iD string, selectCols ...string) (*WalletEntry, error) {
+	return FindWalletEntry(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWalletEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWalletEntry(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WalletEntry, error) {
+	walletEntryObj := &WalletEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"wallet_entry\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, walletEntryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from wallet_entry")
+	}
+
+	return walletEntryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WalletEntry) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WalletEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_entry provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	walletEntryInsertCacheMut.RLock()
+	cache, cached := walletEntryInsertCache[key]
+	walletEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			walletEntryAllColumns,
+			walletEntryColumnsWithDefault,
+			walletEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(walletEntryType, walletEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(walletEntryType, walletEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"wallet_entry\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"wallet_entry\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into wallet_entry")
+	}
+
+	if !cached {
+		walletEntryInsertCacheMut.Lock()
+		walletEntryInsertCache[key] = cache
+		walletEntryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single WalletEntry record using the global executor.
+// See Update for more documentation.
+func (o *WalletEntry) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WalletEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WalletEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	walletEntryUpdateCacheMut.RLock()
+	cache, cached := walletEntryUpdateCache[key]
+	walletEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			walletEntryAllColumns,
+			walletEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update wallet_entry, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"wallet_entry\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, walletEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(walletEntryType, walletEntryMapping, append(wl, walletEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update wallet_entry row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for wallet_entry")
+	}
+
+	if !cached {
+		walletEntryUpdateCacheMut.Lock()
+		walletEntryUpdateCache[key] = cache
+		walletEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q walletEntryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q walletEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for wallet_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for wallet_entry")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WalletEntrySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WalletEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"wallet_entry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, walletEntryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in walletEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all walletEntry")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WalletEntry) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WalletEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_entry provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	walletEntryUpsertCacheMut.RLock()
+	cache, cached := walletEntryUpsertCache[key]
+	walletEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			walletEntryAllColumns,
+			walletEntryColumnsWithDefault,
+			walletEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			walletEntryAllColumns,
+			walletEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert wallet_entry, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(walletEntryPrimaryKeyColumns))
+			copy(conflict, walletEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"wallet_entry\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(walletEntryType, walletEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(walletEntryType, walletEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert wallet_entry")
+	}
+
+	if !cached {
+		walletEntryUpsertCacheMut.Lock()
+		walletEntryUpsertCache[key] = cache
+		walletEntryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single WalletEntry record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WalletEntry) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WalletEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WalletEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WalletEntry provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), walletEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"wallet_entry\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from wallet_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for wallet_entry")
+	}
+
+	return rowsAff, nil
+}
+
+func (q walletEntryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q walletEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no walletEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from wallet_entry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_entry")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WalletEntrySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WalletEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"wallet_entry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletEntryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from walletEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_entry")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WalletEntry) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WalletEntry provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WalletEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWalletEntry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletEntrySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WalletEntrySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WalletEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"wallet_entry\".* FROM \"wallet_entry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WalletEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WalletEntryExistsG checks if the WalletEntry row exists.
+func WalletEntryExistsG(ctx context.Context, iD string) (bool, error) {
+	return WalletEntryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WalletEntryExists checks if the WalletEntry row exists.
+func WalletEntryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"wallet_entry\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if wallet_entry exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,808 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWalletEntries(t *testing.T) {
+	t.Parallel()
+
+	query := WalletEntries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWalletEntriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWalletEntriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WalletEntries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWalletEntriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WalletEntrySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWalletEntriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WalletEntryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WalletEntry exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WalletEntryExists to return true, but got false.")
+	}
+}
+
+func testWalletEntriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	walletEntryFound, err := FindWalletEntry(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if walletEntryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWalletEntriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WalletEntries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWalletEntriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WalletEntries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWalletEntriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	walletEntryOne := &WalletEntry{}
+	walletEntryTwo := &WalletEntry{}
+	if err = randomize.Struct(seed, walletEntryOne, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, walletEntryTwo, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = walletEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = walletEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WalletEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWalletEntriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	walletEntryOne := &WalletEntry{}
+	walletEntryTwo := &WalletEntry{}
+	if err = randomize.Struct(seed, walletEntryOne, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, walletEntryTwo, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = walletEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = walletEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testWalletEntriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWalletEntriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(walletEntryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWalletEntryToOneWalletAccountUsingAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WalletEntry
+	var foreign WalletAccount
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, walletAccountDBTypes, false, walletAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletAccount struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AccountID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WalletEntrySlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*WalletEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWalletEntryToOneWalletTransactionUsingTransaction(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WalletEntry
+	var foreign WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, walletEntryDBTypes, false, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, walletTransactionDBTypes, false, walletTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletTransaction struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TransactionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Transaction().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WalletEntrySlice{&local}
+	if err = local.L.LoadTransaction(ctx, tx, false, (*[]*WalletEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transaction == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Transaction = nil
+	if err = local.L.LoadTransaction(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Transaction == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWalletEntryToOneSetOpWalletAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WalletEntry
+	var b, c WalletAccount
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, walletEntryDBTypes, false, strmangle.SetComplement(walletEntryPrimaryKeyColumns, walletEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, walletAccountDBTypes, false, strmangle.SetComplement(walletAccountPrimaryKeyColumns, walletAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletAccountDBTypes, false, strmangle.SetComplement(walletAccountPrimaryKeyColumns, walletAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*WalletAccount{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AccountWalletEntries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+func testWalletEntryToOneSetOpWalletTransactionUsingTransaction(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WalletEntry
+	var b, c WalletTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, walletEntryDBTypes, false, strmangle.SetComplement(walletEntryPrimaryKeyColumns, walletEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, walletTransactionDBTypes, false, strmangle.SetComplement(walletTransactionPrimaryKeyColumns, walletTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, walletTransactionDBTypes, false, strmangle.SetComplement(walletTransactionPrimaryKeyColumns, walletTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*WalletTransaction{&b, &c} {
+		err = a.SetTransaction(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Transaction != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransactionWalletEntries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TransactionID != x.ID {
+			t.Error("foreign key was wrong value", a.TransactionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransactionID))
+		reflect.Indirect(reflect.ValueOf(&a.TransactionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TransactionID != x.ID {
+			t.Error("foreign key was wrong value", a.TransactionID, x.ID)
+		}
+	}
+}
+
+func testWalletEntriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWalletEntriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WalletEntrySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWalletEntriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WalletEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	walletEntryDBTypes = map[string]string{`ID`: `uuid`, `TransactionID`: `uuid`, `AccountID`: `uuid`, `Amount`: `integer`, `CreatedAt`: `timestamp without time zone`}
+	_                  = bytes.MinRead
+)
+
+func testWalletEntriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(walletEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(walletEntryAllColumns) == len(walletEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWalletEntriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(walletEntryAllColumns) == len(walletEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WalletEntry{}
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, walletEntryDBTypes, true, walletEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(walletEntryAllColumns, walletEntryPrimaryKeyColumns) {
+		fields = walletEntryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			walletEntryAllColumns,
+			walletEntryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WalletEntrySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWalletEntriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(walletEntryAllColumns) == len(walletEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WalletEntry{}
+	if err = randomize.Struct(seed, &o, walletEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WalletEntry: %s", err)
+	}
+
+	count, err := WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, walletEntryDBTypes, false, walletEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WalletEntry struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WalletEntry: %s", err)
+	}
+
+	count, err = WalletEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
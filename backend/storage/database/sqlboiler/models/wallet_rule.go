@@ -0,0 +1,973 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WalletRule is an object representing the database table.
+type WalletRule struct {
+	ID         string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CourseID   string      `boil:"course_id" json:"course_id" toml:"course_id" yaml:"course_id"`
+	Year       int         `boil:"year" json:"year" toml:"year" yaml:"year"`
+	Name       string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Event      string      `boil:"event" json:"event" toml:"event" yaml:"event"`
+	TargetID   null.String `boil:"target_id" json:"target_id,omitempty" toml:"target_id" yaml:"target_id,omitempty"`
+	MinPercent float64     `boil:"min_percent" json:"min_percent" toml:"min_percent" yaml:"min_percent"`
+	Points     int         `boil:"points" json:"points" toml:"points" yaml:"points"`
+	CreatedAt  null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt  null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *walletRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L walletRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WalletRuleColumns = struct {
+	ID         string
+	CourseID   string
+	Year       string
+	Name       string
+	Event      string
+	TargetID   string
+	MinPercent string
+	Points     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	CourseID:   "course_id",
+	Year:       "year",
+	Name:       "name",
+	Event:      "event",
+	TargetID:   "target_id",
+	MinPercent: "min_percent",
+	Points:     "points",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+// Generated where
+
+var WalletRuleWhere = struct {
+	ID         whereHelperstring
+	CourseID   whereHelperstring
+	Year       whereHelperint
+	Name       whereHelperstring
+	Event      whereHelperstring
+	TargetID   whereHelpernull_String
+	MinPercent whereHelperfloat64
+	Points     whereHelperint
+	CreatedAt  whereHelpernull_Time
+	UpdatedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"wallet_rule\".\"id\""},
+	CourseID:   whereHelperstring{field: "\"wallet_rule\".\"course_id\""},
+	Year:       whereHelperint{field: "\"wallet_rule\".\"year\""},
+	Name:       whereHelperstring{field: "\"wallet_rule\".\"name\""},
+	Event:      whereHelperstring{field: "\"wallet_rule\".\"event\""},
+	TargetID:   whereHelpernull_String{field: "\"wallet_rule\".\"target_id\""},
+	MinPercent: whereHelperfloat64{field: "\"wallet_rule\".\"min_percent\""},
+	Points:     whereHelperint{field: "\"wallet_rule\".\"points\""},
+	CreatedAt:  whereHelpernull_Time{field: "\"wallet_rule\".\"created_at\""},
+	UpdatedAt:  whereHelpernull_Time{field: "\"wallet_rule\".\"updated_at\""},
+}
+
+// WalletRuleRels is where relationship names are stored.
+var WalletRuleRels = struct {
+	Course string
+}{
+	Course: "Course",
+}
+
+// walletRuleR is where relationships are stored.
+type walletRuleR struct {
+	Course *Course `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+}
+
+// NewStruct creates a new relationship struct
+func (*walletRuleR) NewStruct() *walletRuleR {
+	return &walletRuleR{}
+}
+
+// walletRuleL is where Load methods for each relationship are stored.
+type walletRuleL struct{}
+
+var (
+	walletRuleAllColumns            = []string{"id", "course_id", "year", "name", "event", "target_id", "min_percent", "points", "created_at", "updated_at"}
+	walletRuleColumnsWithoutDefault = []string{"id", "course_id", "year", "name", "event", "target_id", "min_percent", "points", "created_at", "updated_at"}
+	walletRuleColumnsWithDefault    = []string{}
+	walletRulePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WalletRuleSlice is an alias for a slice of pointers to WalletRule.
+	// This should generally be used opposed to []WalletRule.
+	WalletRuleSlice []*WalletRule
+
+	walletRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	walletRuleType                 = reflect.TypeOf(&WalletRule{})
+	walletRuleMapping              = queries.MakeStructMapping(walletRuleType)
+	walletRulePrimaryKeyMapping, _ = queries.BindMapping(walletRuleType, walletRuleMapping, walletRulePrimaryKeyColumns)
+	walletRuleInsertCacheMut       sync.RWMutex
+	walletRuleInsertCache          = make(map[string]insertCache)
+	walletRuleUpdateCacheMut       sync.RWMutex
+	walletRuleUpdateCache          = make(map[string]updateCache)
+	walletRuleUpsertCacheMut       sync.RWMutex
+	walletRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single walletRule record from the query using the global executor.
+func (q walletRuleQuery) OneG(ctx context.Context) (*WalletRule, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single walletRule record from the query.
+func (q walletRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WalletRule, error) {
+	o := &WalletRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for wallet_rule")
+	}
+
+	return o, nil
+}
+
+// AllG returns all WalletRule records from the query using the global executor.
+func (q walletRuleQuery) AllG(ctx context.Context) (WalletRuleSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WalletRule records from the query.
+func (q walletRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (WalletRuleSlice, error) {
+	var o []*WalletRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WalletRule slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WalletRule records in the query, and panics on error.
+func (q walletRuleQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WalletRule records in the query.
+func (q walletRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count wallet_rule rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q walletRuleQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q walletRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if wallet_rule exists")
+	}
+
+	return count > 0, nil
+}
+
+// Course pointed to by the foreign key.
+func (o *WalletRule) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletRuleL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletRule interface{}, mods queries.Applicator) error {
+	var slice []*WalletRule
+	var object *WalletRule
+
+	if singular {
+		object = maybeWalletRule.(*WalletRule)
+	} else {
+		slice = *maybeWalletRule.(*[]*WalletRule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletRuleR{}
+		}
+		args = append(args, object.CourseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletRuleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CourseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CourseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.WalletRules = append(foreign.R.WalletRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CourseID == foreign.ID {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.WalletRules = append(foreign.R.WalletRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCourseG of the walletRule to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.WalletRules.
+// Uses the global database handle.
+func (o *WalletRule) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the walletRule to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.WalletRules.
+func (o *WalletRule) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_rule\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletRulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CourseID = related.ID
+	if o.R == nil {
+		o.R = &walletRuleR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			WalletRules: WalletRuleSlice{o},
+		}
+	} else {
+		related.R.WalletRules = append(related.R.WalletRules, o)
+	}
+
+	return nil
+}
+
+// WalletRules retrieves all the records using an executor.
+func WalletRules(mods ...qm.QueryMod) walletRuleQuery {
+	mods = append(mods, qm.From("\"wallet_rule\""))
+	return walletRuleQuery{NewQuery(mods...)}
+}
+
+// FindWalletRuleG retrieves a single record by ID.
+func FindWalletRuleG(ctx context.Context, iD string, selectCols ...string) (*WalletRule, error) {
+	return FindWalletRule(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWalletRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWalletRule(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WalletRule, error) {
+	walletRuleObj := &WalletRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"wallet_rule\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, walletRuleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from wallet_rule")
+	}
+
+	return walletRuleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WalletRule) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WalletRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_rule provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	walletRuleInsertCacheMut.RLock()
+	cache, cached := walletRuleInsertCache[key]
+	walletRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			walletRuleAllColumns,
+			walletRuleColumnsWithDefault,
+			walletRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(walletRuleType, walletRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(walletRuleType, walletRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"wallet_rule\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"wallet_rule\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into wallet_rule")
+	}
+
+	if !cached {
+		walletRuleInsertCacheMut.Lock()
+		walletRuleInsertCache[key] = cache
+		walletRuleInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single WalletRule record using the global executor.
+// See Update for more documentation.
+func (o *WalletRule) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WalletRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WalletRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	walletRuleUpdateCacheMut.RLock()
+	cache, cached := walletRuleUpdateCache[key]
+	walletRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			walletRuleAllColumns,
+			walletRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update wallet_rule, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"wallet_rule\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, walletRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(walletRuleType, walletRuleMapping, append(wl, walletRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update wallet_rule row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for wallet_rule")
+	}
+
+	if !cached {
+		walletRuleUpdateCacheMut.Lock()
+		walletRuleUpdateCache[key] = cache
+		walletRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q walletRuleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q walletRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for wallet_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for wallet_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WalletRuleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WalletRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"wallet_rule\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, walletRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in walletRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all walletRule")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WalletRule) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WalletRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_rule provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	walletRuleUpsertCacheMut.RLock()
+	cache, cached := walletRuleUpsertCache[key]
+	walletRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			walletRuleAllColumns,
+			walletRuleColumnsWithDefault,
+			walletRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			walletRuleAllColumns,
+			walletRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert wallet_rule, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(walletRulePrimaryKeyColumns))
+			copy(conflict, walletRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"wallet_rule\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(walletRuleType, walletRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(walletRuleType, walletRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert wallet_rule")
+	}
+
+	if !cached {
+		walletRuleUpsertCacheMut.Lock()
+		walletRuleUpsertCache[key] = cache
+		walletRuleUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single WalletRule record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WalletRule) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WalletRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WalletRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WalletRule provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), walletRulePrimaryKeyMapping)
+	sql := "DELETE FROM \"wallet_rule\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from wallet_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for wallet_rule")
+	}
+
+	return rowsAff, nil
+}
+
+func (q walletRuleQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q walletRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no walletRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from wallet_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WalletRuleSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WalletRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"wallet_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from walletRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WalletRule) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WalletRule provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WalletRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWalletRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletRuleSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WalletRuleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WalletRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"wallet_rule\".* FROM \"wallet_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WalletRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WalletRuleExistsG checks if the WalletRule row exists.
+func WalletRuleExistsG(ctx context.Context, iD string) (bool, error) {
+	return WalletRuleExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WalletRuleExists checks if the WalletRule row exists.
+func WalletRuleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"wallet_rule\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if wallet_rule exists")
+	}
+
+	return exists, nil
+}
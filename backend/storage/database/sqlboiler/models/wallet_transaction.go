@@ -0,0 +1,1566 @@
+// Code generated by SQLBoiler 4.3.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WalletTransaction is an object representing the database table.
+type WalletTransaction struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SchoolID  string      `boil:"school_id" json:"school_id" toml:"school_id" yaml:"school_id"`
+	Year      int         `boil:"year" json:"year" toml:"year" yaml:"year"`
+	Kind      string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	CourseID  null.String `boil:"course_id" json:"course_id,omitempty" toml:"course_id" yaml:"course_id,omitempty"`
+	RuleID    null.String `boil:"rule_id" json:"rule_id,omitempty" toml:"rule_id" yaml:"rule_id,omitempty"`
+	Reference null.String `boil:"reference" json:"reference,omitempty" toml:"reference" yaml:"reference,omitempty"`
+	Memo      null.String `boil:"memo" json:"memo,omitempty" toml:"memo" yaml:"memo,omitempty"`
+	AuthorID  null.String `boil:"author_id" json:"author_id,omitempty" toml:"author_id" yaml:"author_id,omitempty"`
+	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+
+	R *walletTransactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L walletTransactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WalletTransactionColumns = struct {
+	ID        string
+	SchoolID  string
+	Year      string
+	Kind      string
+	CourseID  string
+	RuleID    string
+	Reference string
+	Memo      string
+	AuthorID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	SchoolID:  "school_id",
+	Year:      "year",
+	Kind:      "kind",
+	CourseID:  "course_id",
+	RuleID:    "rule_id",
+	Reference: "reference",
+	Memo:      "memo",
+	AuthorID:  "author_id",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var WalletTransactionWhere = struct {
+	ID        whereHelperstring
+	SchoolID  whereHelperstring
+	Year      whereHelperint
+	Kind      whereHelperstring
+	CourseID  whereHelpernull_String
+	RuleID    whereHelpernull_String
+	Reference whereHelpernull_String
+	Memo      whereHelpernull_String
+	AuthorID  whereHelpernull_String
+	CreatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"wallet_transaction\".\"id\""},
+	SchoolID:  whereHelperstring{field: "\"wallet_transaction\".\"school_id\""},
+	Year:      whereHelperint{field: "\"wallet_transaction\".\"year\""},
+	Kind:      whereHelperstring{field: "\"wallet_transaction\".\"kind\""},
+	CourseID:  whereHelpernull_String{field: "\"wallet_transaction\".\"course_id\""},
+	RuleID:    whereHelpernull_String{field: "\"wallet_transaction\".\"rule_id\""},
+	Reference: whereHelpernull_String{field: "\"wallet_transaction\".\"reference\""},
+	Memo:      whereHelpernull_String{field: "\"wallet_transaction\".\"memo\""},
+	AuthorID:  whereHelpernull_String{field: "\"wallet_transaction\".\"author_id\""},
+	CreatedAt: whereHelpernull_Time{field: "\"wallet_transaction\".\"created_at\""},
+}
+
+// WalletTransactionRels is where relationship names are stored.
+var WalletTransactionRels = struct {
+	Author                   string
+	Course                   string
+	School                   string
+	TransactionWalletEntries string
+}{
+	Author:                   "Author",
+	Course:                   "Course",
+	School:                   "School",
+	TransactionWalletEntries: "TransactionWalletEntries",
+}
+
+// walletTransactionR is where relationships are stored.
+type walletTransactionR struct {
+	Author                   *User            `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+	Course                   *Course          `boil:"Course" json:"Course" toml:"Course" yaml:"Course"`
+	School                   *School          `boil:"School" json:"School" toml:"School" yaml:"School"`
+	TransactionWalletEntries WalletEntrySlice `boil:"TransactionWalletEntries" json:"TransactionWalletEntries" toml:"TransactionWalletEntries" yaml:"TransactionWalletEntries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*walletTransactionR) NewStruct() *walletTransactionR {
+	return &walletTransactionR{}
+}
+
+// walletTransactionL is where Load methods for each relationship are stored.
+type walletTransactionL struct{}
+
+var (
+	walletTransactionAllColumns            = []string{"id", "school_id", "year", "kind", "course_id", "rule_id", "reference", "memo", "author_id", "created_at"}
+	walletTransactionColumnsWithoutDefault = []string{"id", "school_id", "year", "kind", "course_id", "rule_id", "reference", "memo", "author_id", "created_at"}
+	walletTransactionColumnsWithDefault    = []string{}
+	walletTransactionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WalletTransactionSlice is an alias for a slice of pointers to WalletTransaction.
+	// This should generally be used opposed to []WalletTransaction.
+	WalletTransactionSlice []*WalletTransaction
+
+	walletTransactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	walletTransactionType                 = reflect.TypeOf(&WalletTransaction{})
+	walletTransactionMapping              = queries.MakeStructMapping(walletTransactionType)
+	walletTransactionPrimaryKeyMapping, _ = queries.BindMapping(walletTransactionType, walletTransactionMapping, walletTransactionPrimaryKeyColumns)
+	walletTransactionInsertCacheMut       sync.RWMutex
+	walletTransactionInsertCache          = make(map[string]insertCache)
+	walletTransactionUpdateCacheMut       sync.RWMutex
+	walletTransactionUpdateCache          = make(map[string]updateCache)
+	walletTransactionUpsertCacheMut       sync.RWMutex
+	walletTransactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single walletTransaction record from the query using the global executor.
+func (q walletTransactionQuery) OneG(ctx context.Context) (*WalletTransaction, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single walletTransaction record from the query.
+func (q walletTransactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WalletTransaction, error) {
+	o := &WalletTransaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for wallet_transaction")
+	}
+
+	return o, nil
+}
+
+// AllG returns all WalletTransaction records from the query using the global executor.
+func (q walletTransactionQuery) AllG(ctx context.Context) (WalletTransactionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WalletTransaction records from the query.
+func (q walletTransactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (WalletTransactionSlice, error) {
+	var o []*WalletTransaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WalletTransaction slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WalletTransaction records in the query, and panics on error.
+func (q walletTransactionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WalletTransaction records in the query.
+func (q walletTransactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count wallet_transaction rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q walletTransactionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q walletTransactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if wallet_transaction exists")
+	}
+
+	return count > 0, nil
+}
+
+// Author pointed to by the foreign key.
+func (o *WalletTransaction) Author(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"user\"")
+
+	return query
+}
+
+// Course pointed to by the foreign key.
+func (o *WalletTransaction) Course(mods ...qm.QueryMod) courseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CourseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Courses(queryMods...)
+	queries.SetFrom(query.Query, "\"course\"")
+
+	return query
+}
+
+// School pointed to by the foreign key.
+func (o *WalletTransaction) School(mods ...qm.QueryMod) schoolQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SchoolID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schools(queryMods...)
+	queries.SetFrom(query.Query, "\"school\"")
+
+	return query
+}
+
+// TransactionWalletEntries retrieves all the wallet_entry's WalletEntries with an executor via transaction_id column.
+func (o *WalletTransaction) TransactionWalletEntries(mods ...qm.QueryMod) walletEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wallet_entry\".\"transaction_id\"=?", o.ID),
+	)
+
+	query := WalletEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"wallet_entry\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"wallet_entry\".*"})
+	}
+
+	return query
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletTransactionL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletTransaction interface{}, mods queries.Applicator) error {
+	var slice []*WalletTransaction
+	var object *WalletTransaction
+
+	if singular {
+		object = maybeWalletTransaction.(*WalletTransaction)
+	} else {
+		slice = *maybeWalletTransaction.(*[]*WalletTransaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletTransactionR{}
+		}
+		if !queries.IsNil(object.AuthorID) {
+			args = append(args, object.AuthorID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletTransactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.AuthorID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.AuthorID) {
+				args = append(args, obj.AuthorID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AuthorWalletTransactions = append(foreign.R.AuthorWalletTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AuthorID, foreign.ID) {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AuthorWalletTransactions = append(foreign.R.AuthorWalletTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCourse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletTransactionL) LoadCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletTransaction interface{}, mods queries.Applicator) error {
+	var slice []*WalletTransaction
+	var object *WalletTransaction
+
+	if singular {
+		object = maybeWalletTransaction.(*WalletTransaction)
+	} else {
+		slice = *maybeWalletTransaction.(*[]*WalletTransaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletTransactionR{}
+		}
+		if !queries.IsNil(object.CourseID) {
+			args = append(args, object.CourseID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletTransactionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CourseID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CourseID) {
+				args = append(args, obj.CourseID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`course`),
+		qm.WhereIn(`course.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Course")
+	}
+
+	var resultSlice []*Course
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Course = foreign
+		if foreign.R == nil {
+			foreign.R = &courseR{}
+		}
+		foreign.R.WalletTransactions = append(foreign.R.WalletTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CourseID, foreign.ID) {
+				local.R.Course = foreign
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.WalletTransactions = append(foreign.R.WalletTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchool allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (walletTransactionL) LoadSchool(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletTransaction interface{}, mods queries.Applicator) error {
+	var slice []*WalletTransaction
+	var object *WalletTransaction
+
+	if singular {
+		object = maybeWalletTransaction.(*WalletTransaction)
+	} else {
+		slice = *maybeWalletTransaction.(*[]*WalletTransaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletTransactionR{}
+		}
+		args = append(args, object.SchoolID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletTransactionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SchoolID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SchoolID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`school`),
+		qm.WhereIn(`school.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load School")
+	}
+
+	var resultSlice []*School
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice School")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for school")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for school")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.School = foreign
+		if foreign.R == nil {
+			foreign.R = &schoolR{}
+		}
+		foreign.R.WalletTransactions = append(foreign.R.WalletTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SchoolID == foreign.ID {
+				local.R.School = foreign
+				if foreign.R == nil {
+					foreign.R = &schoolR{}
+				}
+				foreign.R.WalletTransactions = append(foreign.R.WalletTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactionWalletEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (walletTransactionL) LoadTransactionWalletEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWalletTransaction interface{}, mods queries.Applicator) error {
+	var slice []*WalletTransaction
+	var object *WalletTransaction
+
+	if singular {
+		object = maybeWalletTransaction.(*WalletTransaction)
+	} else {
+		slice = *maybeWalletTransaction.(*[]*WalletTransaction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &walletTransactionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &walletTransactionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wallet_entry`),
+		qm.WhereIn(`wallet_entry.transaction_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wallet_entry")
+	}
+
+	var resultSlice []*WalletEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wallet_entry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wallet_entry")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wallet_entry")
+	}
+
+	if singular {
+		object.R.TransactionWalletEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &walletEntryR{}
+			}
+			foreign.R.Transaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TransactionID {
+				local.R.TransactionWalletEntries = append(local.R.TransactionWalletEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &walletEntryR{}
+				}
+				foreign.R.Transaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAuthorG of the walletTransaction to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorWalletTransactions.
+// Uses the global database handle.
+func (o *WalletTransaction) SetAuthorG(ctx context.Context, insert bool, related *User) error {
+	return o.SetAuthor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAuthor of the walletTransaction to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorWalletTransactions.
+func (o *WalletTransaction) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AuthorID, related.ID)
+	if o.R == nil {
+		o.R = &walletTransactionR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AuthorWalletTransactions: WalletTransactionSlice{o},
+		}
+	} else {
+		related.R.AuthorWalletTransactions = append(related.R.AuthorWalletTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveAuthorG relationship.
+// Sets o.R.Author to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *WalletTransaction) RemoveAuthorG(ctx context.Context, related *User) error {
+	return o.RemoveAuthor(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveAuthor relationship.
+// Sets o.R.Author to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *WalletTransaction) RemoveAuthor(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AuthorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("author_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Author = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AuthorWalletTransactions {
+		if queries.Equal(o.AuthorID, ri.AuthorID) {
+			continue
+		}
+
+		ln := len(related.R.AuthorWalletTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.AuthorWalletTransactions[i] = related.R.AuthorWalletTransactions[ln-1]
+		}
+		related.R.AuthorWalletTransactions = related.R.AuthorWalletTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCourseG of the walletTransaction to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.WalletTransactions.
+// Uses the global database handle.
+func (o *WalletTransaction) SetCourseG(ctx context.Context, insert bool, related *Course) error {
+	return o.SetCourse(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCourse of the walletTransaction to the related item.
+// Sets o.R.Course to related.
+// Adds o to related.R.WalletTransactions.
+func (o *WalletTransaction) SetCourse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Course) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"course_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CourseID, related.ID)
+	if o.R == nil {
+		o.R = &walletTransactionR{
+			Course: related,
+		}
+	} else {
+		o.R.Course = related
+	}
+
+	if related.R == nil {
+		related.R = &courseR{
+			WalletTransactions: WalletTransactionSlice{o},
+		}
+	} else {
+		related.R.WalletTransactions = append(related.R.WalletTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveCourseG relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *WalletTransaction) RemoveCourseG(ctx context.Context, related *Course) error {
+	return o.RemoveCourse(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveCourse relationship.
+// Sets o.R.Course to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *WalletTransaction) RemoveCourse(ctx context.Context, exec boil.ContextExecutor, related *Course) error {
+	var err error
+
+	queries.SetScanner(&o.CourseID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("course_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Course = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.WalletTransactions {
+		if queries.Equal(o.CourseID, ri.CourseID) {
+			continue
+		}
+
+		ln := len(related.R.WalletTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.WalletTransactions[i] = related.R.WalletTransactions[ln-1]
+		}
+		related.R.WalletTransactions = related.R.WalletTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSchoolG of the walletTransaction to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletTransactions.
+// Uses the global database handle.
+func (o *WalletTransaction) SetSchoolG(ctx context.Context, insert bool, related *School) error {
+	return o.SetSchool(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSchool of the walletTransaction to the related item.
+// Sets o.R.School to related.
+// Adds o to related.R.WalletTransactions.
+func (o *WalletTransaction) SetSchool(ctx context.Context, exec boil.ContextExecutor, insert bool, related *School) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"wallet_transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"school_id"}),
+		strmangle.WhereClause("\"", "\"", 2, walletTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SchoolID = related.ID
+	if o.R == nil {
+		o.R = &walletTransactionR{
+			School: related,
+		}
+	} else {
+		o.R.School = related
+	}
+
+	if related.R == nil {
+		related.R = &schoolR{
+			WalletTransactions: WalletTransactionSlice{o},
+		}
+	} else {
+		related.R.WalletTransactions = append(related.R.WalletTransactions, o)
+	}
+
+	return nil
+}
+
+// AddTransactionWalletEntriesG adds the given related objects to the existing relationships
+// of the wallet_transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionWalletEntries.
+// Sets related.R.Transaction appropriately.
+// Uses the global database handle.
+func (o *WalletTransaction) AddTransactionWalletEntriesG(ctx context.Context, insert bool, related ...*WalletEntry) error {
+	return o.AddTransactionWalletEntries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransactionWalletEntries adds the given related objects to the existing relationships
+// of the wallet_transaction, optionally inserting them as new records.
+// Appends related to o.R.TransactionWalletEntries.
+// Sets related.R.Transaction appropriately.
+func (o *WalletTransaction) AddTransactionWalletEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WalletEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TransactionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wallet_entry\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, walletEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TransactionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &walletTransactionR{
+			TransactionWalletEntries: related,
+		}
+	} else {
+		o.R.TransactionWalletEntries = append(o.R.TransactionWalletEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &walletEntryR{
+				Transaction: o,
+			}
+		} else {
+			rel.R.Transaction = o
+		}
+	}
+	return nil
+}
+
+// WalletTransactions retrieves all the records using an executor.
+func WalletTransactions(mods ...qm.QueryMod) walletTransactionQuery {
+	mods = append(mods, qm.From("\"wallet_transaction\""))
+	return walletTransactionQuery{NewQuery(mods...)}
+}
+
+// FindWalletTransactionG retrieves a single record by ID.
+func FindWalletTransactionG(ctx context.Context, iD string, selectCols ...string) (*WalletTransaction, error) {
+	return FindWalletTransaction(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWalletTransaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWalletTransaction(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WalletTransaction, error) {
+	walletTransactionObj := &WalletTransaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"wallet_transaction\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, walletTransactionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from wallet_transaction")
+	}
+
+	return walletTransactionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WalletTransaction) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WalletTransaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_transaction provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletTransactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	walletTransactionInsertCacheMut.RLock()
+	cache, cached := walletTransactionInsertCache[key]
+	walletTransactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			walletTransactionAllColumns,
+			walletTransactionColumnsWithDefault,
+			walletTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(walletTransactionType, walletTransactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(walletTransactionType, walletTransactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"wallet_transaction\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"wallet_transaction\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into wallet_transaction")
+	}
+
+	if !cached {
+		walletTransactionInsertCacheMut.Lock()
+		walletTransactionInsertCache[key] = cache
+		walletTransactionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single WalletTransaction record using the global executor.
+// See Update for more documentation.
+func (o *WalletTransaction) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WalletTransaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WalletTransaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	walletTransactionUpdateCacheMut.RLock()
+	cache, cached := walletTransactionUpdateCache[key]
+	walletTransactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			walletTransactionAllColumns,
+			walletTransactionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update wallet_transaction, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"wallet_transaction\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, walletTransactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(walletTransactionType, walletTransactionMapping, append(wl, walletTransactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update wallet_transaction row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for wallet_transaction")
+	}
+
+	if !cached {
+		walletTransactionUpdateCacheMut.Lock()
+		walletTransactionUpdateCache[key] = cache
+		walletTransactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q walletTransactionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q walletTransactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for wallet_transaction")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for wallet_transaction")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WalletTransactionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WalletTransactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"wallet_transaction\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, walletTransactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in walletTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all walletTransaction")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WalletTransaction) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WalletTransaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no wallet_transaction provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(walletTransactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	walletTransactionUpsertCacheMut.RLock()
+	cache, cached := walletTransactionUpsertCache[key]
+	walletTransactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			walletTransactionAllColumns,
+			walletTransactionColumnsWithDefault,
+			walletTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			walletTransactionAllColumns,
+			walletTransactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert wallet_transaction, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(walletTransactionPrimaryKeyColumns))
+			copy(conflict, walletTransactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"wallet_transaction\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(walletTransactionType, walletTransactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(walletTransactionType, walletTransactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert wallet_transaction")
+	}
+
+	if !cached {
+		walletTransactionUpsertCacheMut.Lock()
+		walletTransactionUpsertCache[key] = cache
+		walletTransactionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single WalletTransaction record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WalletTransaction) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WalletTransaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WalletTransaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WalletTransaction provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), walletTransactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"wallet_transaction\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from wallet_transaction")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for wallet_transaction")
+	}
+
+	return rowsAff, nil
+}
+
+func (q walletTransactionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q walletTransactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no walletTransactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from wallet_transaction")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_transaction")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WalletTransactionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WalletTransactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"wallet_transaction\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletTransactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from walletTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for wallet_transaction")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WalletTransaction) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WalletTransaction provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WalletTransaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWalletTransaction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletTransactionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WalletTransactionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WalletTransactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WalletTransactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), walletTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"wallet_transaction\".* FROM \"wallet_transaction\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, walletTransactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WalletTransactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WalletTransactionExistsG checks if the WalletTransaction row exists.
+func WalletTransactionExistsG(ctx context.Context, iD string) (bool, error) {
+	return WalletTransactionExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WalletTransactionExists checks if the WalletTransaction row exists.
+func WalletTransactionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"wallet_transaction\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if wallet_transaction exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,273 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/notification"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type NotificationRepository struct {
+	db core.DB
+}
+
+var _ notification.Repository = (*NotificationRepository)(nil) // interface compliance check
+
+func NewNotificationRepository(db core.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (repo NotificationRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo NotificationRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ------------------------------------- Notification -------------------------------------
+
+func (repo NotificationRepository) boilNotification(n core.Notification) *models.Notification {
+	return &models.Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Event:     n.Event,
+		Title:     n.Title,
+		Body:      n.Body,
+		Link:      n.Link,
+		ReadAt:    null.NewTime(n.ReadAt.UTC(), !n.ReadAt.IsZero()),
+		CreatedAt: n.CreatedAt.UTC(),
+	}
+}
+
+func (repo NotificationRepository) unboilNotification(n *models.Notification) core.Notification {
+	if n == nil {
+		return core.Notification{}
+	}
+	return core.Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Event:     n.Event,
+		Title:     n.Title,
+		Body:      n.Body,
+		Link:      n.Link,
+		ReadAt:    n.ReadAt.Time,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func (repo NotificationRepository) CreateNotifications(ctx context.Context, ns []core.Notification, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	for _, n := range ns {
+		if n.ID == "" {
+			n.ID = uuid.New().String()
+		}
+		if err := repo.boilNotification(n).Insert(ctx, exe, boil.Infer()); err != nil {
+			return errors.Wrap(err, "inserting notification")
+		}
+	}
+	return nil
+}
+
+func (repo NotificationRepository) QueryNotifications(
+	ctx context.Context,
+	filter notification.Filter,
+	exec ...core.DBExecutor,
+) ([]core.Notification, error) {
+	mods := []qm.QueryMod{
+		models.NotificationWhere.UserID.EQ(filter.UserID),
+		qm.OrderBy(models.NotificationColumns.CreatedAt + " DESC, " + models.NotificationColumns.ID + " DESC"),
+	}
+	if filter.UnreadOnly {
+		mods = append(mods, models.NotificationWhere.ReadAt.IsNull())
+	}
+	if filter.Limit > 0 {
+		mods = append(mods, qm.Limit(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		mods = append(mods, qm.Offset(filter.Offset))
+	}
+
+	ms, err := models.Notifications(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying notifications")
+	}
+	ns := make([]core.Notification, 0, len(ms))
+	for _, m := range ms {
+		ns = append(ns, repo.unboilNotification(m))
+	}
+	return ns, nil
+}
+
+func (repo NotificationRepository) GetNotification(
+	ctx context.Context,
+	id, userID string,
+	exec ...core.DBExecutor,
+) (core.Notification, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return core.Notification{}, notification.ErrNotificationNotFound
+	}
+	m, err := models.Notifications(
+		models.NotificationWhere.ID.EQ(id),
+		models.NotificationWhere.UserID.EQ(userID),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return core.Notification{}, repo.trapNoRowsErr(err, notification.ErrNotificationNotFound, "finding notification")
+	}
+	return repo.unboilNotification(m), nil
+}
+
+func (repo NotificationRepository) CountUnread(ctx context.Context, userID string, exec ...core.DBExecutor) (int, error) {
+	n, err := models.Notifications(
+		models.NotificationWhere.UserID.EQ(userID),
+		models.NotificationWhere.ReadAt.IsNull(),
+	).Count(ctx, repo.getExec(exec))
+	return int(n), errors.Wrap(err, "counting notifications")
+}
+
+func (repo NotificationRepository) MarkRead(
+	ctx context.Context,
+	userID string,
+	at time.Time,
+	ids []string,
+	exec ...core.DBExecutor,
+) error {
+	mods := []qm.QueryMod{
+		models.NotificationWhere.UserID.EQ(userID),
+		models.NotificationWhere.ReadAt.IsNull(),
+	}
+	if len(ids) > 0 {
+		mods = append(mods, models.NotificationWhere.ID.IN(ids))
+	}
+	_, err := models.Notifications(mods...).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.NotificationColumns.ReadAt:    null.TimeFrom(at.UTC()),
+		models.NotificationColumns.UpdatedAt: null.TimeFrom(time.Now().UTC()),
+	})
+	return errors.Wrap(err, "updating notifications")
+}
+
+// ------------------------------------- Preference -------------------------------------
+
+func (repo NotificationRepository) QueryPreferences(ctx context.Context, userID string, exec ...core.DBExecutor) ([]notification.Preference, error) {
+	ms, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.EQ(userID),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying preferences")
+	}
+	prefs := make([]notification.Preference, 0, len(ms))
+	for _, m := range ms {
+		prefs = append(prefs, notification.Preference{Event: m.Event, InApp: m.InApp, Email: m.Email, Push: m.Push})
+	}
+	return prefs, nil
+}
+
+func (repo NotificationRepository) SavePreference(ctx context.Context, userID string, p notification.Preference, exec ...core.DBExecutor) error {
+	m := &models.NotificationPreference{UserID: userID, Event: p.Event, InApp: p.InApp, Email: p.Email, Push: p.Push}
+	err := m.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.NotificationPreferenceColumns.UserID, models.NotificationPreferenceColumns.Event},
+		boil.Whitelist(
+			models.NotificationPreferenceColumns.InApp,
+			models.NotificationPreferenceColumns.Email,
+			models.NotificationPreferenceColumns.Push,
+			models.NotificationPreferenceColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+	return errors.Wrap(err, "upserting preference")
+}
+
+// ------------------------------------- Subscription -------------------------------------
+
+func (repo NotificationRepository) unboilSubscription(s *models.PushSubscription) notification.Subscription {
+	if s == nil {
+		return notification.Subscription{}
+	}
+	return notification.Subscription{
+		PushSubscription: core.PushSubscription{Endpoint: s.Endpoint, P256dh: s.P256DH, Auth: s.Auth},
+		ID:               s.ID,
+		UserID:           s.UserID,
+	}
+}
+
+func (repo NotificationRepository) SaveSubscription(
+	ctx context.Context,
+	s notification.Subscription,
+	exec ...core.DBExecutor,
+) (notification.Subscription, error) {
+	m := &models.PushSubscription{
+		ID:       uuid.New().String(),
+		UserID:   s.UserID,
+		Endpoint: s.Endpoint,
+		P256DH:   s.P256dh,
+		Auth:     s.Auth,
+	}
+	err := m.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.PushSubscriptionColumns.Endpoint},
+		boil.Whitelist(
+			models.PushSubscriptionColumns.UserID,
+			models.PushSubscriptionColumns.P256DH,
+			models.PushSubscriptionColumns.Auth,
+			models.PushSubscriptionColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+	if err != nil {
+		return notification.Subscription{}, errors.Wrap(err, "upserting subscription")
+	}
+	// the ID of an existing subscription is kept on conflict
+	m, err = models.PushSubscriptions(models.PushSubscriptionWhere.Endpoint.EQ(s.Endpoint)).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return notification.Subscription{}, errors.Wrap(err, "finding subscription")
+	}
+	return repo.unboilSubscription(m), nil
+}
+
+func (repo NotificationRepository) QuerySubscriptions(
+	ctx context.Context,
+	userID string,
+	exec ...core.DBExecutor,
+) ([]notification.Subscription, error) {
+	ms, err := models.PushSubscriptions(
+		models.PushSubscriptionWhere.UserID.EQ(userID),
+		qm.OrderBy(models.PushSubscriptionColumns.CreatedAt),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying subscriptions")
+	}
+	ss := make([]notification.Subscription, 0, len(ms))
+	for _, m := range ms {
+		ss = append(ss, repo.unboilSubscription(m))
+	}
+	return ss, nil
+}
+
+func (repo NotificationRepository) DeleteSubscription(ctx context.Context, userID, endpoint string, exec ...core.DBExecutor) error {
+	_, err := models.PushSubscriptions(
+		models.PushSubscriptionWhere.UserID.EQ(userID),
+		models.PushSubscriptionWhere.Endpoint.EQ(endpoint),
+	).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting subscription")
+}
@@ -0,0 +1,185 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/oidc"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type OIDCRepository struct {
+	db core.DB
+}
+
+var _ oidc.Repository = (*OIDCRepository)(nil) // interface compliance check
+
+func NewOIDCRepository(db core.DB) *OIDCRepository {
+	return &OIDCRepository{db: db}
+}
+
+func (repo OIDCRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo OIDCRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// -------------------------------------- Login --------------------------------------
+
+func (repo OIDCRepository) CreateLogin(ctx context.Context, l oidc.Login, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	if _, err := models.OidcLogins(models.OidcLoginWhere.ExpiresAt.LT(time.Now().UTC())).DeleteAll(ctx, exe); err != nil {
+		return errors.Wrap(err, "deleting expired oidc logins")
+	}
+	m := &models.OidcLogin{
+		State:        l.State,
+		Provider:     l.Provider,
+		CodeVerifier: l.CodeVerifier,
+		Nonce:        l.Nonce,
+		ExpiresAt:    l.ExpiresAt.UTC(),
+	}
+	return errors.Wrap(m.Insert(ctx, exe, boil.Infer()), "inserting oidc login")
+}
+
+func (repo OIDCRepository) TakeLogin(ctx context.Context, state string, exec ...core.DBExecutor) (oidc.Login, error) {
+	exe := repo.getExec(exec)
+	m, err := models.FindOidcLogin(ctx, exe, state)
+	if err != nil {
+		return oidc.Login{}, repo.trapNoRowsErr(err, oidc.ErrInvalidState, "finding oidc login")
+	}
+	// single-use: only the request deleting it may use it
+	n, err := m.Delete(ctx, exe)
+	if err != nil {
+		return oidc.Login{}, errors.Wrap(err, "deleting oidc login")
+	}
+	if n == 0 {
+		return oidc.Login{}, oidc.ErrInvalidState
+	}
+	return oidc.Login{
+		State:        m.State,
+		Provider:     m.Provider,
+		CodeVerifier: m.CodeVerifier,
+		Nonce:        m.Nonce,
+		ExpiresAt:    m.ExpiresAt,
+		CreatedAt:    m.CreatedAt.Time,
+	}, nil
+}
+
+// -------------------------------------- Identity --------------------------------------
+
+func (repo OIDCRepository) unboilIdentity(ei *models.ExternalIdentity) oidc.Identity {
+	if ei == nil {
+		return oidc.Identity{}
+	}
+	return oidc.Identity{
+		ID:        ei.ID,
+		UserID:    ei.UserID,
+		Provider:  ei.Provider,
+		Subject:   ei.Subject,
+		Email:     ei.Email,
+		SchoolID:  ei.SchoolID.String,
+		CreatedAt: ei.CreatedAt.Time,
+	}
+}
+
+func (repo OIDCRepository) CreateIdentity(ctx context.Context, i oidc.Identity, exec ...core.DBExecutor) (oidc.Identity, error) {
+	m := &models.ExternalIdentity{
+		ID:       uuid.New().String(),
+		UserID:   i.UserID,
+		Provider: i.Provider,
+		Subject:  i.Subject,
+		Email:    i.Email,
+		SchoolID: null.NewString(i.SchoolID, i.SchoolID != ""),
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return oidc.Identity{}, errors.Wrap(err, "inserting external identity")
+	}
+	return repo.unboilIdentity(m), nil
+}
+
+func (repo OIDCRepository) GetIdentity(ctx context.Context, provider, subject string, exec ...core.DBExecutor) (oidc.Identity, error) {
+	m, err := models.ExternalIdentities(
+		models.ExternalIdentityWhere.Provider.EQ(provider),
+		models.ExternalIdentityWhere.Subject.EQ(subject),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return oidc.Identity{}, repo.trapNoRowsErr(err, oidc.ErrIdentityNotFound, "finding external identity")
+	}
+	return repo.unboilIdentity(m), nil
+}
+
+// -------------------------------------- Domain --------------------------------------
+
+func (repo OIDCRepository) unboilDomain(sed *models.SchoolEmailDomain) oidc.Domain {
+	if sed == nil {
+		return oidc.Domain{}
+	}
+	return oidc.Domain{
+		Domain:    sed.Domain,
+		SchoolID:  sed.SchoolID,
+		Role:      sed.Role,
+		CreatedAt: sed.CreatedAt.Time,
+		UpdatedAt: sed.UpdatedAt.Time,
+	}
+}
+
+func (repo OIDCRepository) SaveDomain(ctx context.Context, d oidc.Domain, exec ...core.DBExecutor) (oidc.Domain, error) {
+	m := &models.SchoolEmailDomain{Domain: d.Domain, SchoolID: d.SchoolID, Role: d.Role}
+	updateCols := boil.Whitelist(
+		models.SchoolEmailDomainColumns.SchoolID,
+		models.SchoolEmailDomainColumns.Role,
+		models.SchoolEmailDomainColumns.UpdatedAt,
+	)
+	if err := m.Upsert(ctx, repo.getExec(exec), true, []string{models.SchoolEmailDomainColumns.Domain}, updateCols, boil.Infer()); err != nil {
+		return oidc.Domain{}, errors.Wrap(err, "upserting school email domain")
+	}
+	return repo.unboilDomain(m), nil
+}
+
+func (repo OIDCRepository) GetDomain(ctx context.Context, domain string, exec ...core.DBExecutor) (oidc.Domain, error) {
+	m, err := models.FindSchoolEmailDomain(ctx, repo.getExec(exec), domain)
+	if err != nil {
+		return oidc.Domain{}, repo.trapNoRowsErr(err, oidc.ErrDomainNotFound, "finding school email domain")
+	}
+	return repo.unboilDomain(m), nil
+}
+
+func (repo OIDCRepository) QueryDomains(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]oidc.Domain, error) {
+	if _, err := uuid.Parse(schoolID); err != nil {
+		return []oidc.Domain{}, nil
+	}
+	ms, err := models.SchoolEmailDomains(
+		models.SchoolEmailDomainWhere.SchoolID.EQ(schoolID),
+		qm.OrderBy(models.SchoolEmailDomainColumns.Domain),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying school email domains")
+	}
+	ds := make([]oidc.Domain, 0, len(ms))
+	for _, m := range ms {
+		ds = append(ds, repo.unboilDomain(m))
+	}
+	return ds, nil
+}
+
+func (repo OIDCRepository) DeleteDomain(ctx context.Context, domain string, exec ...core.DBExecutor) error {
+	_, err := models.SchoolEmailDomains(models.SchoolEmailDomainWhere.Domain.EQ(domain)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting school email domain")
+}
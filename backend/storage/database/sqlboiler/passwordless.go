@@ -0,0 +1,97 @@
+package boiledrepos
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/passwordless"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type PasswordlessRepository struct {
+	db core.DB
+}
+
+var _ passwordless.Repository = (*PasswordlessRepository)(nil) // interface compliance check
+
+func NewPasswordlessRepository(db core.DB) *PasswordlessRepository {
+	return &PasswordlessRepository{db: db}
+}
+
+func (repo PasswordlessRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo PasswordlessRepository) CountRequest(
+	ctx context.Context,
+	userID string,
+	t time.Time,
+	window time.Duration,
+	exec ...core.DBExecutor,
+) (passwordless.Limit, error) {
+	lim := passwordless.Limit{UserID: userID}
+	var invalidBefore null.Time
+	err := repo.getExec(exec).QueryRowContext(ctx, `
+		INSERT INTO passwordless_limit AS l (user_id, requests, requests_reset_at, attempts, attempts_reset_at)
+		VALUES ($1, 1, $2, 0, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			requests = CASE WHEN l.requests_reset_at <= $3 THEN 1 ELSE l.requests + 1 END,
+			requests_reset_at = CASE WHEN l.requests_reset_at <= $3 THEN $2 ELSE l.requests_reset_at END
+		RETURNING requests, CASE WHEN attempts_reset_at <= $3 THEN 0 ELSE attempts END, codes_invalid_before`,
+		userID, t.Add(window).UTC(), t.UTC(),
+	).Scan(&lim.Requests, &lim.Attempts, &invalidBefore)
+	if err != nil {
+		return passwordless.Limit{}, errors.Wrap(err, "counting passwordless login request")
+	}
+	lim.CodesInvalidBefore = invalidBefore.Time
+	return lim, nil
+}
+
+func (repo PasswordlessRepository) CountAttempt(
+	ctx context.Context,
+	userID string,
+	t time.Time,
+	window time.Duration,
+	exec ...core.DBExecutor,
+) (passwordless.Limit, error) {
+	lim := passwordless.Limit{UserID: userID}
+	var invalidBefore null.Time
+	err := repo.getExec(exec).QueryRowContext(ctx, `
+		INSERT INTO passwordless_limit AS l (user_id, requests, requests_reset_at, attempts, attempts_reset_at)
+		VALUES ($1, 0, $3, 1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			attempts = CASE WHEN l.attempts_reset_at <= $3 THEN 1 ELSE l.attempts + 1 END,
+			attempts_reset_at = CASE WHEN l.attempts_reset_at <= $3 THEN $2 ELSE l.attempts_reset_at END
+		RETURNING CASE WHEN requests_reset_at <= $3 THEN 0 ELSE requests END, attempts, codes_invalid_before`,
+		userID, t.Add(window).UTC(), t.UTC(),
+	).Scan(&lim.Requests, &lim.Attempts, &invalidBefore)
+	if err != nil {
+		return passwordless.Limit{}, errors.Wrap(err, "counting passwordless login attempt")
+	}
+	lim.CodesInvalidBefore = invalidBefore.Time
+	return lim, nil
+}
+
+func (repo PasswordlessRepository) ResetAttempts(ctx context.Context, userID string, exec ...core.DBExecutor) error {
+	_, err := models.PasswordlessLimits(models.PasswordlessLimitWhere.UserID.EQ(userID)).
+		UpdateAll(ctx, repo.getExec(exec), models.M{models.PasswordlessLimitColumns.Attempts: 0})
+	return errors.Wrap(err, "resetting passwordless login attempts")
+}
+
+func (repo PasswordlessRepository) InvalidateCodes(
+	ctx context.Context,
+	userID string,
+	t time.Time,
+	exec ...core.DBExecutor,
+) error {
+	_, err := models.PasswordlessLimits(models.PasswordlessLimitWhere.UserID.EQ(userID)).
+		UpdateAll(ctx, repo.getExec(exec), models.M{models.PasswordlessLimitColumns.CodesInvalidBefore: t.UTC()})
+	return errors.Wrap(err, "invalidating passwordless login codes")
+}
@@ -0,0 +1,128 @@
+package boiledrepos
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/privacy"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+// erasedName replaces the name of erased Users in the academic records kept.
+const erasedName = "Deleted User"
+
+type PrivacyRepository struct {
+	db core.DB
+}
+
+var _ privacy.Repository = (*PrivacyRepository)(nil) // interface compliance check
+
+func NewPrivacyRepository(db core.DB) *PrivacyRepository {
+	return &PrivacyRepository{db: db}
+}
+
+func (repo PrivacyRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo PrivacyRepository) EraseUser(ctx context.Context, userID string, t time.Time, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	t = t.UTC()
+
+	// the row is kept for the academic records referencing it; roles too, for their aggregates
+	_, err := models.Users(models.UserWhere.ID.EQ(userID)).UpdateAll(ctx, exe, models.M{
+		models.UserColumns.Name:               erasedName,
+		models.UserColumns.Username:           nil,
+		models.UserColumns.Email:              nil,
+		models.UserColumns.Phone:              nil,
+		models.UserColumns.PasswordHash:       nil,
+		models.UserColumns.IsActive:           false,
+		models.UserColumns.MustChangePassword: false,
+		models.UserColumns.Avatar:             "",
+		models.UserColumns.EmailVerifiedAt:    nil,
+		models.UserColumns.LastLogin:          nil,
+		models.UserColumns.UpdatedAt:          t,
+		models.UserColumns.ErasedAt:           t,
+	})
+	if err != nil {
+		return errors.Wrap(err, "anonymizing user")
+	}
+
+	// the messages stay in their rooms, like the ones deleted by moderators
+	_, err = models.ChatMessages(
+		models.ChatMessageWhere.SenderID.EQ(null.StringFrom(userID)),
+		models.ChatMessageWhere.DeletedAt.IsNull(),
+	).UpdateAll(ctx, exe, models.M{
+		models.ChatMessageColumns.Body:      "",
+		models.ChatMessageColumns.DeletedAt: t,
+		models.ChatMessageColumns.UpdatedAt: t,
+	})
+	if err != nil {
+		return errors.Wrap(err, "erasing chat messages")
+	}
+
+	deletions := []struct {
+		name string
+		del  func() (int64, error)
+	}{
+		{"refresh tokens", func() (int64, error) {
+			return models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"two-factor", func() (int64, error) {
+			return models.TwoFactors(models.TwoFactorWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"backup codes", func() (int64, error) {
+			return models.BackupCodes(models.BackupCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"external identities", func() (int64, error) {
+			return models.ExternalIdentities(models.ExternalIdentityWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"notifications", func() (int64, error) {
+			return models.Notifications(models.NotificationWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"notification preferences", func() (int64, error) {
+			return models.NotificationPreferences(models.NotificationPreferenceWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"push subscriptions", func() (int64, error) {
+			return models.PushSubscriptions(models.PushSubscriptionWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"announcement recipients", func() (int64, error) {
+			return models.AnnouncementRecipients(models.AnnouncementRecipientWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"calendar feeds", func() (int64, error) {
+			return models.CalendarFeeds(models.CalendarFeedWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"guardian links", func() (int64, error) {
+			return models.GuardianLinks(
+				models.GuardianLinkWhere.GuardianID.EQ(userID),
+				qm.Or2(models.GuardianLinkWhere.StudentID.EQ(userID)),
+			).DeleteAll(ctx, exe)
+		}},
+		{"role members", func() (int64, error) {
+			return models.RbacRoleMembers(models.RbacRoleMemberWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"chat room members", func() (int64, error) {
+			return models.ChatRoomMembers(models.ChatRoomMemberWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"chat reads", func() (int64, error) {
+			return models.ChatReads(models.ChatReadWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+		{"chat mutes", func() (int64, error) {
+			return models.ChatMutes(models.ChatMuteWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+		}},
+	}
+	for _, d := range deletions {
+		if _, err = d.del(); err != nil {
+			return errors.Wrap(err, "deleting "+d.name)
+		}
+	}
+	return nil
+}
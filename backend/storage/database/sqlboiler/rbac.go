@@ -0,0 +1,234 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/rbac"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type RBACRepository struct {
+	db core.DB
+}
+
+var _ rbac.Repository = (*RBACRepository)(nil) // interface compliance check
+
+func NewRBACRepository(db core.DB) *RBACRepository {
+	return &RBACRepository{db: db}
+}
+
+func (repo RBACRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo RBACRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ----------------------------------------- Role -----------------------------------------
+
+func (repo RBACRepository) unboilRole(r *models.RbacRole) rbac.Role {
+	if r == nil {
+		return rbac.Role{}
+	}
+	perms := []string(r.Permissions)
+	if perms == nil {
+		perms = []string{}
+	}
+	return rbac.Role{
+		ID:          r.ID,
+		SchoolID:    r.SchoolID,
+		Name:        r.Name,
+		Permissions: perms,
+		CreatedAt:   r.CreatedAt.Time,
+		UpdatedAt:   r.UpdatedAt.Time,
+	}
+}
+
+// checkNameUniqueness returns rbac.ErrRoleExists when another Role of the School has the name of r.
+func (repo RBACRepository) checkNameUniqueness(ctx context.Context, r rbac.Role, exe core.DBExecutor) error {
+	mods := []qm.QueryMod{
+		models.RbacRoleWhere.SchoolID.EQ(r.SchoolID),
+		qm.Where("LOWER("+models.RbacRoleColumns.Name+") = LOWER(?)", r.Name),
+	}
+	if r.ID != "" {
+		mods = append(mods, models.RbacRoleWhere.ID.NEQ(r.ID))
+	}
+	exists, err := models.RbacRoles(mods...).Exists(ctx, exe)
+	if err != nil {
+		return errors.Wrap(err, "checking role name uniqueness")
+	}
+	if exists {
+		return rbac.ErrRoleExists
+	}
+	return nil
+}
+
+func (repo RBACRepository) CreateRole(ctx context.Context, r rbac.Role, exec ...core.DBExecutor) (rbac.Role, error) {
+	exe := repo.getExec(exec)
+	if err := repo.checkNameUniqueness(ctx, r, exe); err != nil {
+		return rbac.Role{}, err
+	}
+	m := &models.RbacRole{
+		ID:          uuid.New().String(),
+		SchoolID:    r.SchoolID,
+		Name:        r.Name,
+		Permissions: types.StringArray(r.Permissions),
+	}
+	if err := m.Insert(ctx, exe, boil.Infer()); err != nil {
+		return rbac.Role{}, errors.Wrap(err, "inserting role")
+	}
+	return repo.unboilRole(m), nil
+}
+
+func (repo RBACRepository) QueryRoles(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]rbac.Role, error) {
+	ms, err := models.RbacRoles(
+		models.RbacRoleWhere.SchoolID.EQ(schoolID),
+		qm.OrderBy(models.RbacRoleColumns.Name),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying roles")
+	}
+	rs := make([]rbac.Role, 0, len(ms))
+	for _, m := range ms {
+		rs = append(rs, repo.unboilRole(m))
+	}
+	return rs, nil
+}
+
+func (repo RBACRepository) GetRole(ctx context.Context, id string, exec ...core.DBExecutor) (rbac.Role, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return rbac.Role{}, rbac.ErrRoleNotFound
+	}
+	m, err := models.FindRbacRole(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return rbac.Role{}, repo.trapNoRowsErr(err, rbac.ErrRoleNotFound, "finding role")
+	}
+	return repo.unboilRole(m), nil
+}
+
+func (repo RBACRepository) UpdateRole(ctx context.Context, r rbac.Role, exec ...core.DBExecutor) (rbac.Role, error) {
+	exe := repo.getExec(exec)
+	if err := repo.checkNameUniqueness(ctx, r, exe); err != nil {
+		return rbac.Role{}, err
+	}
+	m, err := models.FindRbacRole(ctx, exe, r.ID)
+	if err != nil {
+		return rbac.Role{}, repo.trapNoRowsErr(err, rbac.ErrRoleNotFound, "finding role")
+	}
+	m.Name = r.Name
+	m.Permissions = types.StringArray(r.Permissions)
+	if _, err = m.Update(ctx, exe, boil.Infer()); err != nil {
+		return rbac.Role{}, errors.Wrap(err, "updating role")
+	}
+	return repo.unboilRole(m), nil
+}
+
+func (repo RBACRepository) DeleteRole(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	_, err := models.RbacRoles(models.RbacRoleWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting role")
+}
+
+// ---------------------------------------- Member ----------------------------------------
+
+func (repo RBACRepository) AddRoleMembers(ctx context.Context, roleID string, userIDs []string, exec ...core.DBExecutor) error {
+	now := time.Now().UTC()
+	exe := repo.getExec(exec)
+	for _, uid := range userIDs {
+		m := &models.RbacRoleMember{RoleID: roleID, UserID: uid, CreatedAt: null.TimeFrom(now)}
+		if err := m.Upsert(ctx, exe, false, []string{models.RbacRoleMemberColumns.RoleID, models.RbacRoleMemberColumns.UserID}, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrap(err, "inserting role member")
+		}
+	}
+	return nil
+}
+
+func (repo RBACRepository) RemoveRoleMembers(ctx context.Context, roleID string, userIDs []string, exec ...core.DBExecutor) error {
+	_, err := models.RbacRoleMembers(
+		models.RbacRoleMemberWhere.RoleID.EQ(roleID),
+		models.RbacRoleMemberWhere.UserID.IN(userIDs),
+	).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting role members")
+}
+
+func (repo RBACRepository) QueryRoleMemberIDs(ctx context.Context, roleID string, exec ...core.DBExecutor) ([]string, error) {
+	ms, err := models.RbacRoleMembers(
+		models.RbacRoleMemberWhere.RoleID.EQ(roleID),
+		qm.OrderBy(models.RbacRoleMemberColumns.CreatedAt),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying role members")
+	}
+	ids := make([]string, 0, len(ms))
+	for _, m := range ms {
+		ids = append(ids, m.UserID)
+	}
+	return ids, nil
+}
+
+func (repo RBACRepository) QuerySchoolMemberIDs(
+	ctx context.Context,
+	schoolID string,
+	userIDs []string,
+	exec ...core.DBExecutor,
+) ([]string, error) {
+	ids := make([]string, 0, len(userIDs))
+	if len(userIDs) == 0 {
+		return ids, nil
+	}
+	rows, err := repo.getExec(exec).QueryContext(ctx, `
+		SELECT cs.student_id FROM class_student cs JOIN class c ON c.id = cs.class_id
+		WHERE c.school_id = $1 AND cs.student_id = ANY($2)
+		UNION
+		SELECT crs.teacher_id FROM course crs JOIN class c ON c.id = crs.class_id
+		WHERE c.school_id = $1 AND crs.teacher_id = ANY($2)`,
+		schoolID, pq.Array(userIDs),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying school members")
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "scanning school member")
+		}
+		ids = append(ids, id)
+	}
+	return ids, errors.Wrap(rows.Err(), "iterating school members")
+}
+
+func (repo RBACRepository) QueryMemberRoles(ctx context.Context, userID, schoolID string, exec ...core.DBExecutor) ([]rbac.Role, error) {
+	ms, err := models.RbacRoles(
+		qm.InnerJoin(models.TableNames.RbacRoleMember+" m ON m.role_id = "+models.TableNames.RbacRole+".id"),
+		qm.Where("m.user_id = ?", userID),
+		models.RbacRoleWhere.SchoolID.EQ(schoolID),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying member roles")
+	}
+	rs := make([]rbac.Role, 0, len(ms))
+	for _, m := range ms {
+		rs = append(rs, repo.unboilRole(m))
+	}
+	return rs, nil
+}
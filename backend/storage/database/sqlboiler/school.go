@@ -0,0 +1,516 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/school"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type SchoolRepository struct {
+	db core.DB
+}
+
+var _ school.Repository = (*SchoolRepository)(nil) // interface compliance check
+
+func NewSchoolRepository(db core.DB) *SchoolRepository {
+	return &SchoolRepository{db: db}
+}
+
+func (repo SchoolRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo SchoolRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ---------------------------------------- School ----------------------------------------
+
+func (repo SchoolRepository) boilSchool(sch school.School) *models.School {
+	s := &models.School{
+		ID:                   sch.ID,
+		Name:                 sch.Name,
+		IsActive:             null.BoolFromPtr(sch.IsActive),
+		Motto:                null.NewString(sch.Motto, sch.Motto != ""),
+		Logo:                 null.NewString(sch.Logo, sch.Logo != ""),
+		AbsenceThreshold:     sch.AbsenceThreshold,
+		AttendanceContact:    null.NewString(sch.AttendanceContact, sch.AttendanceContact != ""),
+		SMSSenderID:          null.NewString(sch.SMSSenderID, sch.SMSSenderID != ""),
+		SMSMonthlyQuota:      sch.SMSMonthlyQuota,
+		RequireAdmin2fa:      sch.RequireAdmin2FA,
+		PasswordlessLogin:    sch.PasswordlessLogin,
+		RequireVerifiedEmail: sch.RequireVerifiedEmail,
+		CreatedAt:            null.NewTime(sch.CreatedAt.UTC(), !sch.CreatedAt.IsZero()),
+		UpdatedAt:            null.NewTime(sch.UpdatedAt.UTC(), !sch.UpdatedAt.IsZero()),
+	}
+	return s
+}
+
+func (repo SchoolRepository) unboilSchool(sch *models.School) school.School {
+	if sch == nil {
+		return school.School{}
+	}
+	return school.School{
+		ID:                   sch.ID,
+		Name:                 sch.Name,
+		IsActive:             sch.IsActive.Ptr(),
+		Motto:                sch.Motto.String,
+		Logo:                 sch.Logo.String,
+		AbsenceThreshold:     sch.AbsenceThreshold,
+		AttendanceContact:    sch.AttendanceContact.String,
+		SMSSenderID:          sch.SMSSenderID.String,
+		SMSMonthlyQuota:      sch.SMSMonthlyQuota,
+		RequireAdmin2FA:      sch.RequireAdmin2fa,
+		PasswordlessLogin:    sch.PasswordlessLogin,
+		RequireVerifiedEmail: sch.RequireVerifiedEmail,
+		CreatedAt:            sch.CreatedAt.Time,
+		UpdatedAt:            sch.UpdatedAt.Time,
+	}
+}
+
+func (repo SchoolRepository) CreateSchool(ctx context.Context, sch school.School, exec ...core.DBExecutor) (school.School, error) {
+	sch.ID = uuid.New().String()
+	s := repo.boilSchool(sch)
+	if err := s.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.School{}, errors.Wrap(err, "inserting school")
+	}
+	return repo.unboilSchool(s), nil
+}
+
+func (repo SchoolRepository) QuerySchools(ctx context.Context, exec ...core.DBExecutor) ([]school.School, error) {
+	schs, err := models.Schools(qm.OrderBy(models.SchoolColumns.Name)).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying schools")
+	}
+	schools := make([]school.School, 0, len(schs))
+	for _, s := range schs {
+		schools = append(schools, repo.unboilSchool(s))
+	}
+	return schools, nil
+}
+
+func (repo SchoolRepository) QueryMemberSchools(ctx context.Context, userID string, exec ...core.DBExecutor) ([]school.School, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return []school.School{}, nil
+	}
+	schs, err := models.Schools(
+		qm.Where(
+			models.SchoolColumns.ID+` IN (
+				SELECT c.school_id FROM class c JOIN class_student cs ON cs.class_id = c.id WHERE cs.student_id = ?
+				UNION
+				SELECT c.school_id FROM class c JOIN course crs ON crs.class_id = c.id WHERE crs.teacher_id = ?
+			)`,
+			userID, userID,
+		),
+		qm.OrderBy(models.SchoolColumns.Name),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying member schools")
+	}
+	schools := make([]school.School, 0, len(schs))
+	for _, s := range schs {
+		schools = append(schools, repo.unboilSchool(s))
+	}
+	return schools, nil
+}
+
+func (repo SchoolRepository) RequiresVerifiedEmail(
+	ctx context.Context,
+	userID string,
+	exec ...core.DBExecutor,
+) (bool, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return false, nil
+	}
+	var required bool
+	err := repo.getExec(exec).QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM school WHERE require_verified_email AND id IN (
+				SELECT c.school_id FROM class c JOIN class_student cs ON cs.class_id = c.id WHERE cs.student_id = $1
+				UNION
+				SELECT c.school_id FROM class c JOIN course crs ON crs.class_id = c.id WHERE crs.teacher_id = $1
+				UNION
+				SELECT c.school_id FROM class c
+				JOIN class_student cs ON cs.class_id = c.id
+				JOIN guardian_link gl ON gl.student_id = cs.student_id
+				WHERE gl.guardian_id = $1
+			)
+		)`,
+		userID,
+	).Scan(&required)
+	return required, errors.Wrap(err, "checking email verification policy")
+}
+
+func (repo SchoolRepository) GetSchool(ctx context.Context, id string, exec ...core.DBExecutor) (school.School, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return school.School{}, school.ErrSchoolNotFound
+	}
+	s, err := models.FindSchool(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return school.School{}, repo.trapNoRowsErr(err, school.ErrSchoolNotFound, "finding school by ID")
+	}
+	return repo.unboilSchool(s), nil
+}
+
+func (repo SchoolRepository) UpdateSchool(ctx context.Context, sch school.School, exec ...core.DBExecutor) (school.School, error) {
+	s := repo.boilSchool(sch)
+	if _, err := s.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.School{}, errors.Wrap(err, "updating school")
+	}
+	return repo.unboilSchool(s), nil
+}
+
+// -------------------------------------- Department --------------------------------------
+
+func (repo SchoolRepository) unboilDepartment(dept *models.Department) school.Department {
+	if dept == nil {
+		return school.Department{}
+	}
+	return school.Department{
+		ID:        dept.ID,
+		SchoolID:  dept.SchoolID,
+		Name:      dept.Name,
+		CreatedAt: dept.CreatedAt.Time,
+		UpdatedAt: dept.UpdatedAt.Time,
+	}
+}
+
+func (repo SchoolRepository) CreateDepartment(ctx context.Context, dept school.Department, exec ...core.DBExecutor) (school.Department, error) {
+	d := &models.Department{
+		ID:       uuid.New().String(),
+		SchoolID: dept.SchoolID,
+		Name:     dept.Name,
+	}
+	if err := d.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.Department{}, errors.Wrap(err, "inserting department")
+	}
+	return repo.unboilDepartment(d), nil
+}
+
+func (repo SchoolRepository) QueryDepartments(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]school.Department, error) {
+	depts, err := models.Departments(
+		models.DepartmentWhere.SchoolID.EQ(schoolID),
+		qm.OrderBy(models.DepartmentColumns.Name),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying departments")
+	}
+	departments := make([]school.Department, 0, len(depts))
+	for _, d := range depts {
+		departments = append(departments, repo.unboilDepartment(d))
+	}
+	return departments, nil
+}
+
+func (repo SchoolRepository) GetDepartment(ctx context.Context, id string, exec ...core.DBExecutor) (school.Department, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return school.Department{}, school.ErrDepartmentNotFound
+	}
+	d, err := models.FindDepartment(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return school.Department{}, repo.trapNoRowsErr(err, school.ErrDepartmentNotFound, "finding department by ID")
+	}
+	return repo.unboilDepartment(d), nil
+}
+
+// ----------------------------------------- Class -----------------------------------------
+
+func (repo SchoolRepository) unboilClass(cls *models.Class) school.Class {
+	if cls == nil {
+		return school.Class{}
+	}
+	return school.Class{
+		ID:                  cls.ID,
+		SchoolID:            cls.SchoolID,
+		DepartmentID:        cls.DepartmentID.String,
+		Name:                cls.Name,
+		YearLevel:           cls.YearLevel,
+		Year:                cls.Year,
+		CreatedAt:           cls.CreatedAt.Time,
+		UpdatedAt:           cls.UpdatedAt.Time,
+		MarksPublishedUntil: cls.MarksPublishedUntil.Time,
+	}
+}
+
+func (repo SchoolRepository) CreateClass(ctx context.Context, cls school.Class, exec ...core.DBExecutor) (school.Class, error) {
+	c := &models.Class{
+		ID:           uuid.New().String(),
+		SchoolID:     cls.SchoolID,
+		DepartmentID: null.NewString(cls.DepartmentID, cls.DepartmentID != ""),
+		Name:         cls.Name,
+		YearLevel:    cls.YearLevel,
+		Year:         cls.Year,
+	}
+	if err := c.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.Class{}, errors.Wrap(err, "inserting class")
+	}
+	return repo.unboilClass(c), nil
+}
+
+func (repo SchoolRepository) QueryClasses(ctx context.Context, filter school.ClassFilter, exec ...core.DBExecutor) ([]school.Class, error) {
+	mods := []qm.QueryMod{qm.OrderBy(fmt.Sprintf("%s DESC, %s, %s",
+		models.ClassColumns.Year, models.ClassColumns.YearLevel, models.ClassColumns.Name))}
+
+	if filter.SchoolID != "" {
+		mods = append(mods, models.ClassWhere.SchoolID.EQ(filter.SchoolID))
+	}
+	if filter.DepartmentID != "" {
+		mods = append(mods, models.ClassWhere.DepartmentID.EQ(null.StringFrom(filter.DepartmentID)))
+	}
+	if filter.Year != 0 {
+		mods = append(mods, models.ClassWhere.Year.EQ(filter.Year))
+	}
+	if filter.StudentID != "" {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)",
+				models.ClassColumns.ID, models.ClassStudentColumns.ClassID,
+				models.TableNames.ClassStudent, models.ClassStudentColumns.StudentID),
+			filter.StudentID))
+	}
+
+	cls, err := models.Classes(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying classes")
+	}
+	classes := make([]school.Class, 0, len(cls))
+	for _, c := range cls {
+		classes = append(classes, repo.unboilClass(c))
+	}
+	return classes, nil
+}
+
+func (repo SchoolRepository) GetClass(ctx context.Context, id string, exec ...core.DBExecutor) (school.Class, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return school.Class{}, school.ErrClassNotFound
+	}
+	c, err := models.FindClass(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return school.Class{}, repo.trapNoRowsErr(err, school.ErrClassNotFound, "finding class by ID")
+	}
+	return repo.unboilClass(c), nil
+}
+
+func (repo SchoolRepository) UpdateClass(ctx context.Context, cls school.Class, exec ...core.DBExecutor) (school.Class, error) {
+	c := &models.Class{
+		ID:                  cls.ID,
+		SchoolID:            cls.SchoolID,
+		DepartmentID:        null.NewString(cls.DepartmentID, cls.DepartmentID != ""),
+		Name:                cls.Name,
+		YearLevel:           cls.YearLevel,
+		Year:                cls.Year,
+		MarksPublishedUntil: null.NewTime(cls.MarksPublishedUntil.UTC(), !cls.MarksPublishedUntil.IsZero()),
+		CreatedAt:           null.NewTime(cls.CreatedAt.UTC(), !cls.CreatedAt.IsZero()),
+	}
+	if _, err := c.Update(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.Class{}, errors.Wrap(err, "updating class")
+	}
+	return repo.unboilClass(c), nil
+}
+
+func (repo SchoolRepository) PublishClassMarks(ctx context.Context, classID string, exec ...core.DBExecutor) error {
+	_, err := repo.getExec(exec).ExecContext(ctx, `
+		UPDATE mark m SET published_score = m.score
+		FROM assessment a
+		JOIN mark_category mc ON mc.id = a.category_id
+		JOIN course crs ON crs.id = mc.course_id
+		WHERE m.assessment_id = a.id AND crs.class_id = $1`,
+		classID,
+	)
+	return errors.Wrap(err, "publishing marks")
+}
+
+func (repo SchoolRepository) AddClassStudents(ctx context.Context, classID string, studentIDs []string, exec ...core.DBExecutor) error {
+	now := time.Now().UTC()
+	exe := repo.getExec(exec)
+	for _, sid := range studentIDs {
+		cs := &models.ClassStudent{ClassID: classID, StudentID: sid, CreatedAt: null.TimeFrom(now)}
+		if err := cs.Upsert(ctx, exe, false, []string{models.ClassStudentColumns.ClassID, models.ClassStudentColumns.StudentID}, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrap(err, "inserting class student")
+		}
+	}
+	return nil
+}
+
+func (repo SchoolRepository) RemoveClassStudents(ctx context.Context, classID string, studentIDs []string, exec ...core.DBExecutor) (int, error) {
+	cnt, err := models.ClassStudents(
+		models.ClassStudentWhere.ClassID.EQ(classID),
+		models.ClassStudentWhere.StudentID.IN(studentIDs),
+	).DeleteAll(ctx, repo.getExec(exec))
+	if err != nil {
+		return 0, errors.Wrap(err, "deleting class students")
+	}
+	return int(cnt), nil
+}
+
+func (repo SchoolRepository) QueryClassStudentIDs(ctx context.Context, classID string, exec ...core.DBExecutor) ([]string, error) {
+	css, err := models.ClassStudents(
+		models.ClassStudentWhere.ClassID.EQ(classID),
+		qm.OrderBy(models.ClassStudentColumns.CreatedAt),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying class students")
+	}
+	ids := make([]string, 0, len(css))
+	for _, cs := range css {
+		ids = append(ids, cs.StudentID)
+	}
+	return ids, nil
+}
+
+// ------------------------------------------ Term -----------------------------------------
+
+func (repo SchoolRepository) unboilTerm(term *models.Term) school.Term {
+	if term == nil {
+		return school.Term{}
+	}
+	return school.Term{
+		ID:        term.ID,
+		SchoolID:  term.SchoolID,
+		Name:      term.Name,
+		StartsOn:  term.StartsOn,
+		EndsOn:    term.EndsOn,
+		CreatedAt: term.CreatedAt.Time,
+		UpdatedAt: term.UpdatedAt.Time,
+	}
+}
+
+func (repo SchoolRepository) CreateTerm(ctx context.Context, term school.Term, exec ...core.DBExecutor) (school.Term, error) {
+	t := &models.Term{
+		ID:       uuid.New().String(),
+		SchoolID: term.SchoolID,
+		Name:     term.Name,
+		StartsOn: term.StartsOn,
+		EndsOn:   term.EndsOn,
+	}
+	if err := t.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.Term{}, errors.Wrap(err, "inserting term")
+	}
+	return repo.unboilTerm(t), nil
+}
+
+func (repo SchoolRepository) QueryTerms(ctx context.Context, schoolID string, exec ...core.DBExecutor) ([]school.Term, error) {
+	ts, err := models.Terms(
+		models.TermWhere.SchoolID.EQ(schoolID),
+		qm.OrderBy(models.TermColumns.StartsOn),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying terms")
+	}
+	terms := make([]school.Term, 0, len(ts))
+	for _, t := range ts {
+		terms = append(terms, repo.unboilTerm(t))
+	}
+	return terms, nil
+}
+
+func (repo SchoolRepository) GetTerm(ctx context.Context, id string, exec ...core.DBExecutor) (school.Term, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return school.Term{}, school.ErrTermNotFound
+	}
+	t, err := models.FindTerm(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return school.Term{}, repo.trapNoRowsErr(err, school.ErrTermNotFound, "finding term by ID")
+	}
+	return repo.unboilTerm(t), nil
+}
+
+// ----------------------------------------- Course ----------------------------------------
+
+func (repo SchoolRepository) unboilCourse(crs *models.Course) school.Course {
+	if crs == nil {
+		return school.Course{}
+	}
+	return school.Course{
+		ID:        crs.ID,
+		ClassID:   crs.ClassID,
+		TeacherID: crs.TeacherID.String,
+		Name:      crs.Name,
+		CreatedAt: crs.CreatedAt.Time,
+		UpdatedAt: crs.UpdatedAt.Time,
+	}
+}
+
+func (repo SchoolRepository) CreateCourse(ctx context.Context, crs school.Course, exec ...core.DBExecutor) (school.Course, error) {
+	c := &models.Course{
+		ID:        uuid.New().String(),
+		ClassID:   crs.ClassID,
+		TeacherID: null.NewString(crs.TeacherID, crs.TeacherID != ""),
+		Name:      crs.Name,
+	}
+	if err := c.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return school.Course{}, errors.Wrap(err, "inserting course")
+	}
+	return repo.unboilCourse(c), nil
+}
+
+func (repo SchoolRepository) QueryCourses(ctx context.Context, filter school.CourseFilter, exec ...core.DBExecutor) ([]school.Course, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.CourseColumns.Name)}
+
+	if filter.ClassID != "" {
+		mods = append(mods, models.CourseWhere.ClassID.EQ(filter.ClassID))
+	}
+	if filter.TeacherID != "" {
+		mods = append(mods, models.CourseWhere.TeacherID.EQ(null.StringFrom(filter.TeacherID)))
+	}
+	if filter.StudentID != "" {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)",
+				models.CourseColumns.ClassID, models.ClassStudentColumns.ClassID,
+				models.TableNames.ClassStudent, models.ClassStudentColumns.StudentID),
+			filter.StudentID))
+	}
+
+	crs, err := models.Courses(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying courses")
+	}
+	courses := make([]school.Course, 0, len(crs))
+	for _, c := range crs {
+		courses = append(courses, repo.unboilCourse(c))
+	}
+	return courses, nil
+}
+
+func (repo SchoolRepository) GetCourse(ctx context.Context, id string, exec ...core.DBExecutor) (school.Course, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return school.Course{}, school.ErrCourseNotFound
+	}
+	c, err := models.FindCourse(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return school.Course{}, repo.trapNoRowsErr(err, school.ErrCourseNotFound, "finding course by ID")
+	}
+	return repo.unboilCourse(c), nil
+}
+
+func (repo SchoolRepository) IsEnrolled(ctx context.Context, courseID, studentID string, exec ...core.DBExecutor) (bool, error) {
+	exists, err := models.ClassStudents(
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s = %s.%s",
+			models.TableNames.Course,
+			models.TableNames.Course, models.CourseColumns.ClassID,
+			models.TableNames.ClassStudent, models.ClassStudentColumns.ClassID)),
+		qm.Where(fmt.Sprintf("%s.%s = ?", models.TableNames.Course, models.CourseColumns.ID), courseID),
+		models.ClassStudentWhere.StudentID.EQ(studentID),
+	).Exists(ctx, repo.getExec(exec))
+	if err != nil {
+		return false, errors.Wrap(err, "checking enrollment")
+	}
+	return exists, nil
+}
@@ -0,0 +1,111 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/session"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type SessionRepository struct {
+	db core.DB
+}
+
+var _ session.Repository = (*SessionRepository)(nil) // interface compliance check
+
+func NewSessionRepository(db core.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (repo SessionRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo SessionRepository) unboilToken(rt *models.RefreshToken) session.RefreshToken {
+	if rt == nil {
+		return session.RefreshToken{}
+	}
+	return session.RefreshToken{
+		ID:        rt.ID,
+		FamilyID:  rt.FamilyID,
+		UserID:    rt.UserID,
+		ExpiresAt: rt.ExpiresAt,
+		UsedAt:    rt.UsedAt.Time,
+		RevokedAt: rt.RevokedAt.Time,
+		CreatedAt: rt.CreatedAt.Time,
+		UpdatedAt: rt.UpdatedAt.Time,
+	}
+}
+
+func (repo SessionRepository) CreateToken(ctx context.Context, rt session.RefreshToken, tokenHash string, exec ...core.DBExecutor) (session.RefreshToken, error) {
+	m := &models.RefreshToken{
+		ID:        uuid.New().String(),
+		FamilyID:  rt.FamilyID,
+		UserID:    rt.UserID,
+		TokenHash: tokenHash,
+		ExpiresAt: rt.ExpiresAt.UTC(),
+	}
+	if m.FamilyID == "" { // first token of a family
+		m.FamilyID = m.ID
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return session.RefreshToken{}, errors.Wrap(err, "inserting refresh token")
+	}
+	return repo.unboilToken(m), nil
+}
+
+func (repo SessionRepository) LockTokenByHash(ctx context.Context, tokenHash string, exec ...core.DBExecutor) (session.RefreshToken, error) {
+	m, err := models.RefreshTokens(
+		models.RefreshTokenWhere.TokenHash.EQ(tokenHash),
+		qm.For("UPDATE"),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return session.RefreshToken{}, session.ErrTokenInvalid
+		}
+		return session.RefreshToken{}, errors.Wrap(err, "locking refresh token")
+	}
+	return repo.unboilToken(m), nil
+}
+
+func (repo SessionRepository) MarkTokenUsed(ctx context.Context, id string, at time.Time, exec ...core.DBExecutor) error {
+	_, err := models.RefreshTokens(models.RefreshTokenWhere.ID.EQ(id)).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.RefreshTokenColumns.UsedAt:    null.TimeFrom(at),
+		models.RefreshTokenColumns.UpdatedAt: null.TimeFrom(at),
+	})
+	return errors.Wrap(err, "updating refresh token")
+}
+
+func (repo SessionRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time, exec ...core.DBExecutor) error {
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.FamilyID.EQ(familyID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.RefreshTokenColumns.RevokedAt: null.TimeFrom(at),
+		models.RefreshTokenColumns.UpdatedAt: null.TimeFrom(at),
+	})
+	return errors.Wrap(err, "revoking refresh tokens")
+}
+
+func (repo SessionRepository) RevokeUserTokens(ctx context.Context, userID string, at time.Time, exec ...core.DBExecutor) error {
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(userID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.RefreshTokenColumns.RevokedAt: null.TimeFrom(at),
+		models.RefreshTokenColumns.UpdatedAt: null.TimeFrom(at),
+	})
+	return errors.Wrap(err, "revoking refresh tokens")
+}
@@ -0,0 +1,76 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/sms"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type SMSRepository struct {
+	db core.DB
+}
+
+var _ sms.Repository = (*SMSRepository)(nil) // interface compliance check
+
+func NewSMSRepository(db core.DB) *SMSRepository {
+	return &SMSRepository{db: db}
+}
+
+func (repo SMSRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+func (repo SMSRepository) IncrementUsage(
+	ctx context.Context,
+	schoolID string,
+	month time.Time,
+	quota int,
+	exec ...core.DBExecutor,
+) (bool, error) {
+	if quota <= 0 {
+		return false, nil
+	}
+	// a single statement, so concurrent senders cannot exceed the quota
+	var sent int
+	now := time.Now().UTC()
+	err := repo.getExec(exec).QueryRowContext(ctx, fmt.Sprintf(
+		`INSERT INTO %[1]s (school_id, month, sent, created_at, updated_at) VALUES ($1, $2, 1, $3, $3)
+		ON CONFLICT (school_id, month) DO UPDATE SET sent = %[1]s.sent + 1, updated_at = $3
+		WHERE %[1]s.sent < $4
+		RETURNING sent`,
+		models.TableNames.SMSUsage,
+	), schoolID, month.UTC(), now, quota).Scan(&sent)
+	switch err {
+	case nil:
+		return true, nil
+	case sql.ErrNoRows: // quota reached
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "upserting sms usage")
+	}
+}
+
+func (repo SMSRepository) GetSent(ctx context.Context, schoolID string, month time.Time, exec ...core.DBExecutor) (int, error) {
+	m, err := models.SMSUsages(
+		models.SMSUsageWhere.SchoolID.EQ(schoolID),
+		models.SMSUsageWhere.Month.EQ(month.UTC()),
+	).One(ctx, repo.getExec(exec))
+	switch err {
+	case nil:
+		return m.Sent, nil
+	case sql.ErrNoRows:
+		return 0, nil
+	default:
+		return 0, errors.Wrap(err, "finding sms usage")
+	}
+}
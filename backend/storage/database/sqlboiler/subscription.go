@@ -0,0 +1,174 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/subscription"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type SubscriptionRepository struct {
+	db core.DB
+}
+
+var _ subscription.Repository = (*SubscriptionRepository)(nil) // interface compliance check
+
+func NewSubscriptionRepository(db core.DB) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db}
+}
+
+func (repo SubscriptionRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo SubscriptionRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// ------------------------------------- Subscription -------------------------------------
+
+func (repo SubscriptionRepository) unboilSubscription(s *models.Subscription) subscription.Subscription {
+	if s == nil {
+		return subscription.Subscription{}
+	}
+	return subscription.Subscription{
+		SchoolID:             s.SchoolID,
+		Plan:                 s.Plan,
+		Status:               s.Status,
+		OwnerName:            s.OwnerName.String,
+		OwnerEmail:           s.OwnerEmail.String,
+		PaymentDueDate:       s.PaymentDueDate,
+		PaymentExtensionDate: s.PaymentExtensionDate,
+		WarningsSent:         s.WarningsSent,
+		LastWarnedAt:         s.LastWarnedAt.Time,
+		CreatedAt:            s.CreatedAt.Time,
+		UpdatedAt:            s.UpdatedAt.Time,
+	}
+}
+
+func (repo SubscriptionRepository) GetSubscription(
+	ctx context.Context,
+	schoolID string,
+	exec ...core.DBExecutor,
+) (subscription.Subscription, error) {
+	if _, err := uuid.Parse(schoolID); err != nil {
+		return subscription.Subscription{}, subscription.ErrSubscriptionNotFound
+	}
+	s, err := models.FindSubscription(ctx, repo.getExec(exec), schoolID)
+	if err != nil {
+		return subscription.Subscription{}, repo.trapNoRowsErr(err, subscription.ErrSubscriptionNotFound, "finding subscription")
+	}
+	return repo.unboilSubscription(s), nil
+}
+
+func (repo SubscriptionRepository) SaveSubscription(
+	ctx context.Context,
+	sub subscription.Subscription,
+	exec ...core.DBExecutor,
+) (subscription.Subscription, error) {
+	s := &models.Subscription{
+		SchoolID:             sub.SchoolID,
+		Plan:                 sub.Plan,
+		Status:               sub.Status,
+		OwnerName:            null.NewString(sub.OwnerName, sub.OwnerName != ""),
+		OwnerEmail:           null.NewString(sub.OwnerEmail, sub.OwnerEmail != ""),
+		PaymentDueDate:       sub.PaymentDueDate.UTC(),
+		PaymentExtensionDate: sub.PaymentExtensionDate.UTC(),
+		WarningsSent:         sub.WarningsSent,
+		LastWarnedAt:         null.NewTime(sub.LastWarnedAt.UTC(), !sub.LastWarnedAt.IsZero()),
+	}
+	err := s.Upsert(
+		ctx,
+		repo.getExec(exec),
+		true,
+		[]string{models.SubscriptionColumns.SchoolID},
+		boil.Whitelist(
+			models.SubscriptionColumns.Plan,
+			models.SubscriptionColumns.Status,
+			models.SubscriptionColumns.OwnerName,
+			models.SubscriptionColumns.OwnerEmail,
+			models.SubscriptionColumns.PaymentDueDate,
+			models.SubscriptionColumns.PaymentExtensionDate,
+			models.SubscriptionColumns.WarningsSent,
+			models.SubscriptionColumns.LastWarnedAt,
+			models.SubscriptionColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+	if err != nil {
+		return subscription.Subscription{}, errors.Wrap(err, "upserting subscription")
+	}
+	return repo.GetSubscription(ctx, sub.SchoolID, exec...)
+}
+
+// --------------------------------------- Payment ----------------------------------------
+
+func (repo SubscriptionRepository) unboilPayment(p *models.Payment) subscription.Payment {
+	if p == nil {
+		return subscription.Payment{}
+	}
+	return subscription.Payment{
+		ID:        p.ID,
+		SchoolID:  p.SchoolID,
+		Period:    p.Period,
+		Amount:    p.Amount,
+		Currency:  p.Currency,
+		Reference: p.Reference.String,
+		PaidAt:    p.PaidAt,
+		CreatedAt: p.CreatedAt.Time,
+	}
+}
+
+func (repo SubscriptionRepository) CreatePayment(
+	ctx context.Context,
+	p subscription.Payment,
+	exec ...core.DBExecutor,
+) (subscription.Payment, error) {
+	m := &models.Payment{
+		ID:        uuid.New().String(),
+		SchoolID:  p.SchoolID,
+		Period:    p.Period,
+		Amount:    p.Amount,
+		Currency:  p.Currency,
+		Reference: null.NewString(p.Reference, p.Reference != ""),
+		PaidAt:    p.PaidAt.UTC(),
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return subscription.Payment{}, errors.Wrap(err, "inserting payment")
+	}
+	return repo.unboilPayment(m), nil
+}
+
+func (repo SubscriptionRepository) QueryPayments(
+	ctx context.Context,
+	schoolID string,
+	exec ...core.DBExecutor,
+) ([]subscription.Payment, error) {
+	ms, err := models.Payments(
+		models.PaymentWhere.SchoolID.EQ(schoolID),
+		qm.OrderBy(models.PaymentColumns.PaidAt+" DESC"),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying payments")
+	}
+	ps := make([]subscription.Payment, 0, len(ms))
+	for _, m := range ms {
+		ps = append(ps, repo.unboilPayment(m))
+	}
+	return ps, nil
+}
@@ -0,0 +1,155 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/twofactor"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type TwoFactorRepository struct {
+	db core.DB
+}
+
+var _ twofactor.Repository = (*TwoFactorRepository)(nil) // interface compliance check
+
+func NewTwoFactorRepository(db core.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{db: db}
+}
+
+func (repo TwoFactorRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo TwoFactorRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// -------------------------------------- Enrollment --------------------------------------
+
+func (repo TwoFactorRepository) unboilEnrollment(tf *models.TwoFactor) twofactor.Enrollment {
+	if tf == nil {
+		return twofactor.Enrollment{}
+	}
+	return twofactor.Enrollment{
+		UserID:         tf.UserID,
+		Secret:         tf.Secret,
+		EnabledAt:      tf.EnabledAt.Time,
+		LastStep:       tf.LastStep,
+		FailedAttempts: tf.FailedAttempts,
+		LockedUntil:    tf.LockedUntil.Time,
+		CreatedAt:      tf.CreatedAt.Time,
+		UpdatedAt:      tf.UpdatedAt.Time,
+	}
+}
+
+func (repo TwoFactorRepository) SaveEnrollment(ctx context.Context, e twofactor.Enrollment, exec ...core.DBExecutor) (twofactor.Enrollment, error) {
+	m := &models.TwoFactor{
+		UserID:         e.UserID,
+		Secret:         e.Secret,
+		EnabledAt:      null.NewTime(e.EnabledAt.UTC(), !e.EnabledAt.IsZero()),
+		LastStep:       e.LastStep,
+		FailedAttempts: e.FailedAttempts,
+		LockedUntil:    null.NewTime(e.LockedUntil.UTC(), !e.LockedUntil.IsZero()),
+	}
+	updateCols := boil.Whitelist(
+		models.TwoFactorColumns.Secret,
+		models.TwoFactorColumns.EnabledAt,
+		models.TwoFactorColumns.LastStep,
+		models.TwoFactorColumns.FailedAttempts,
+		models.TwoFactorColumns.LockedUntil,
+		models.TwoFactorColumns.UpdatedAt,
+	)
+	if err := m.Upsert(ctx, repo.getExec(exec), true, []string{models.TwoFactorColumns.UserID}, updateCols, boil.Infer()); err != nil {
+		return twofactor.Enrollment{}, errors.Wrap(err, "upserting two factor")
+	}
+	return repo.unboilEnrollment(m), nil
+}
+
+func (repo TwoFactorRepository) GetEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) (twofactor.Enrollment, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return twofactor.Enrollment{}, twofactor.ErrNotEnrolled
+	}
+	m, err := models.FindTwoFactor(ctx, repo.getExec(exec), userID)
+	if err != nil {
+		return twofactor.Enrollment{}, repo.trapNoRowsErr(err, twofactor.ErrNotEnrolled, "finding two factor")
+	}
+	return repo.unboilEnrollment(m), nil
+}
+
+func (repo TwoFactorRepository) LockEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) (twofactor.Enrollment, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return twofactor.Enrollment{}, twofactor.ErrNotEnrolled
+	}
+	m, err := models.TwoFactors(
+		models.TwoFactorWhere.UserID.EQ(userID),
+		qm.For("UPDATE"),
+	).One(ctx, repo.getExec(exec))
+	if err != nil {
+		return twofactor.Enrollment{}, repo.trapNoRowsErr(err, twofactor.ErrNotEnrolled, "locking two factor")
+	}
+	return repo.unboilEnrollment(m), nil
+}
+
+func (repo TwoFactorRepository) DeleteEnrollment(ctx context.Context, userID string, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	if _, err := models.BackupCodes(models.BackupCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exe); err != nil {
+		return errors.Wrap(err, "deleting backup codes")
+	}
+	_, err := models.TwoFactors(models.TwoFactorWhere.UserID.EQ(userID)).DeleteAll(ctx, exe)
+	return errors.Wrap(err, "deleting two factor")
+}
+
+// -------------------------------------- BackupCode --------------------------------------
+
+func (repo TwoFactorRepository) ReplaceBackupCodes(ctx context.Context, userID string, hashes [][]byte, exec ...core.DBExecutor) error {
+	exe := repo.getExec(exec)
+	if _, err := models.BackupCodes(models.BackupCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exe); err != nil {
+		return errors.Wrap(err, "deleting backup codes")
+	}
+	for _, hash := range hashes {
+		m := &models.BackupCode{ID: uuid.New().String(), UserID: userID, CodeHash: hash}
+		if err := m.Insert(ctx, exe, boil.Infer()); err != nil {
+			return errors.Wrap(err, "inserting backup code")
+		}
+	}
+	return nil
+}
+
+func (repo TwoFactorRepository) QueryBackupCodes(ctx context.Context, userID string, exec ...core.DBExecutor) ([]twofactor.BackupCode, error) {
+	ms, err := models.BackupCodes(
+		models.BackupCodeWhere.UserID.EQ(userID),
+		models.BackupCodeWhere.UsedAt.IsNull(),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying backup codes")
+	}
+	bcs := make([]twofactor.BackupCode, 0, len(ms))
+	for _, m := range ms {
+		bcs = append(bcs, twofactor.BackupCode{ID: m.ID, UserID: m.UserID, CodeHash: m.CodeHash, UsedAt: m.UsedAt.Time})
+	}
+	return bcs, nil
+}
+
+func (repo TwoFactorRepository) UseBackupCode(ctx context.Context, id string, at time.Time, exec ...core.DBExecutor) error {
+	_, err := models.BackupCodes(models.BackupCodeWhere.ID.EQ(id)).UpdateAll(ctx, repo.getExec(exec), models.M{
+		models.BackupCodeColumns.UsedAt: null.TimeFrom(at),
+	})
+	return errors.Wrap(err, "using backup code")
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -36,15 +37,21 @@ func (repo UserRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
 
 func (repo UserRepository) boil(usr user.User) *models.User {
 	u := &models.User{
-		Name:         null.NewString(usr.Name, usr.Name != ""),
-		Username:     null.NewString(usr.Username, usr.Username != ""),
-		Email:        null.NewString(usr.Email, usr.Email != ""),
-		IsActive:     null.BoolFromPtr(usr.IsActive),
-		Roles:        usr.Roles,
-		PasswordHash: null.BytesFrom(usr.PasswordHash),
-		CreatedAt:    null.NewTime(usr.CreatedAt.UTC(), !usr.CreatedAt.IsZero()),
-		UpdatedAt:    null.NewTime(usr.UpdatedAt.UTC(), !usr.UpdatedAt.IsZero()),
-		LastLogin:    null.NewTime(usr.LastLogin.UTC(), !usr.LastLogin.IsZero()),
+		Name:               null.NewString(usr.Name, usr.Name != ""),
+		Username:           null.NewString(usr.Username, usr.Username != ""),
+		Email:              null.NewString(usr.Email, usr.Email != ""),
+		Phone:              null.NewString(usr.Phone, usr.Phone != ""),
+		IsActive:           null.BoolFromPtr(usr.IsActive),
+		Roles:              usr.Roles,
+		PasswordHash:       null.BytesFrom(usr.PasswordHash),
+		MustChangePassword: usr.MustChangePassword,
+		InvitedAt:          null.NewTime(usr.InvitedAt.UTC(), !usr.InvitedAt.IsZero()),
+		Avatar:             usr.Avatar,
+		EmailVerifiedAt:    null.NewTime(usr.EmailVerifiedAt.UTC(), !usr.EmailVerifiedAt.IsZero()),
+		CreatedAt:          null.NewTime(usr.CreatedAt.UTC(), !usr.CreatedAt.IsZero()),
+		UpdatedAt:          null.NewTime(usr.UpdatedAt.UTC(), !usr.UpdatedAt.IsZero()),
+		LastLogin:          null.NewTime(usr.LastLogin.UTC(), !usr.LastLogin.IsZero()),
+		DeletedAt:          null.NewTime(usr.DeletedAt.UTC(), !usr.DeletedAt.IsZero()),
 	}
 	if usr.ID != "" {
 		u.ID = usr.ID
@@ -57,16 +64,22 @@ func (repo UserRepository) unboil(usr *models.User) user.User {
 		return user.User{}
 	}
 	return user.User{
-		ID:           usr.ID,
-		Name:         usr.Name.String,
-		Username:     usr.Username.String,
-		Email:        usr.Email.String,
-		IsActive:     usr.IsActive.Ptr(),
-		Roles:        usr.Roles,
-		PasswordHash: usr.PasswordHash.Bytes,
-		CreatedAt:    usr.CreatedAt.Time,
-		UpdatedAt:    usr.UpdatedAt.Time,
-		LastLogin:    usr.LastLogin.Time,
+		ID:                 usr.ID,
+		Name:               usr.Name.String,
+		Username:           usr.Username.String,
+		Email:              usr.Email.String,
+		Phone:              usr.Phone.String,
+		IsActive:           usr.IsActive.Ptr(),
+		Roles:              usr.Roles,
+		PasswordHash:       usr.PasswordHash.Bytes,
+		MustChangePassword: usr.MustChangePassword,
+		InvitedAt:          usr.InvitedAt.Time,
+		Avatar:             usr.Avatar,
+		EmailVerifiedAt:    usr.EmailVerifiedAt.Time,
+		CreatedAt:          usr.CreatedAt.Time,
+		UpdatedAt:          usr.UpdatedAt.Time,
+		LastLogin:          usr.LastLogin.Time,
+		DeletedAt:          usr.DeletedAt.Time,
 	}
 }
 
@@ -78,6 +91,15 @@ func (repo UserRepository) unboilSlice(slice models.UserSlice) []user.User {
 	return users
 }
 
+// deletedMods keeps the soft-deleted Users out of queries, or only them when deleted; erased Users are always out.
+func (repo UserRepository) deletedMods(deleted bool) []qm.QueryMod {
+	mods := []qm.QueryMod{models.UserWhere.ErasedAt.IsNull()}
+	if deleted {
+		return append(mods, models.UserWhere.DeletedAt.IsNotNull())
+	}
+	return append(mods, models.UserWhere.DeletedAt.IsNull())
+}
+
 // trapNoRowsErr maps psql "no rows" err to user.ErrNotFound
 func (repo UserRepository) trapNoRowsErr(err error, msg string) error {
 	if err == sql.ErrNoRows {
@@ -120,7 +142,13 @@ func (repo UserRepository) CreateUser(ctx context.Context, usr user.User, exec .
 func (repo UserRepository) QueryUsers(ctx context.Context, filter *user.QueryFilter, ordering []core.DBOrdering, exec ...core.DBExecutor) ([]user.User, error) {
 	var mods []qm.QueryMod
 
-	if filter != nil {
+	if filter == nil {
+		mods = append(mods, repo.deletedMods(false)...)
+	} else {
+		mods = append(mods, repo.deletedMods(filter.Deleted)...)
+		if filter.Deleted && !filter.DeletedTo.IsZero() {
+			mods = append(mods, models.UserWhere.DeletedAt.LTE(null.TimeFrom(filter.DeletedTo.UTC())))
+		}
 		// users with Name, Username or Email matching the search keyword
 		if filter.Search != "" {
 			val := "%" + filter.Search + "%"
@@ -141,6 +169,9 @@ func (repo UserRepository) QueryUsers(ctx context.Context, filter *user.QueryFil
 			}
 			mods = append(mods, qm.Expr(roleMods...))
 		}
+		if filter.Phone != "" {
+			mods = append(mods, models.UserWhere.Phone.EQ(null.StringFrom(filter.Phone)))
+		}
 		if filter.IsActive != nil {
 			mods = append(mods, models.UserWhere.IsActive.EQ(null.BoolFromPtr(filter.IsActive)))
 		}
@@ -176,7 +207,8 @@ func (repo UserRepository) GetUser(ctx context.Context, filter user.GetFilter, e
 		if _, err = uuid.Parse(filter.ID); err != nil {
 			return user.User{}, user.ErrNotFound
 		}
-		usr, err = models.FindUser(ctx, exe, filter.ID)
+		mods := append(repo.deletedMods(filter.Deleted), models.UserWhere.ID.EQ(filter.ID))
+		usr, err = models.Users(mods...).One(ctx, exe)
 		if err != nil {
 			return user.User{}, repo.trapNoRowsErr(err, "finding user by ID")
 		}
@@ -203,7 +235,7 @@ func (repo UserRepository) GetUser(ctx context.Context, filter user.GetFilter, e
 			}
 		}
 
-		usr, err = models.Users(mod).One(ctx, exe)
+		usr, err = models.Users(append(repo.deletedMods(filter.Deleted), mod)...).One(ctx, exe)
 		if err != nil {
 			return user.User{}, repo.trapNoRowsErr(err, "finding user")
 		}
@@ -227,10 +259,11 @@ func (repo UserRepository) UpdateOrCreateUser(ctx context.Context, usr user.User
 	return repo.UpdateUser(ctx, usr, exec...)
 }
 
-func (repo UserRepository) DeleteUsersByID(ctx context.Context, ids []string, exec ...core.DBExecutor) (int, error) {
-	cnt, err := models.Users(models.UserWhere.ID.IN(ids)).DeleteAll(ctx, repo.getExec(exec))
+func (repo UserRepository) SoftDeleteUsersByID(ctx context.Context, ids []string, t time.Time, exec ...core.DBExecutor) (int, error) {
+	cnt, err := models.Users(models.UserWhere.ID.IN(ids), models.UserWhere.DeletedAt.IsNull()).
+		UpdateAll(ctx, repo.getExec(exec), models.M{models.UserColumns.DeletedAt: t.UTC(), models.UserColumns.UpdatedAt: t.UTC()})
 	if err != nil {
-		return 0, errors.Wrap(err, "deleting users")
+		return 0, errors.Wrap(err, "soft-deleting users")
 	}
 	return int(cnt), nil
 }
@@ -0,0 +1,379 @@
+package boiledrepos
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/trezcool/masomo/core"
+	"github.com/trezcool/masomo/core/wallet"
+	"github.com/trezcool/masomo/storage/database/sqlboiler/models"
+)
+
+type WalletRepository struct {
+	db core.DB
+}
+
+var _ wallet.Repository = (*WalletRepository)(nil) // interface compliance check
+
+func NewWalletRepository(db core.DB) *WalletRepository {
+	return &WalletRepository{db: db}
+}
+
+func (repo WalletRepository) getExec(svcExec []core.DBExecutor) core.DBExecutor {
+	if len(svcExec) > 0 {
+		return svcExec[0]
+	}
+	return repo.db
+}
+
+// trapNoRowsErr maps psql "no rows" err to notFoundErr
+func (repo WalletRepository) trapNoRowsErr(err, notFoundErr error, msg string) error {
+	if err == sql.ErrNoRows {
+		return notFoundErr
+	}
+	return errors.Wrap(err, msg)
+}
+
+// --------------------------------------- Account ----------------------------------------
+
+func (repo WalletRepository) unboilAccount(a *models.WalletAccount) wallet.Account {
+	if a == nil {
+		return wallet.Account{}
+	}
+	return wallet.Account{
+		ID:        a.ID,
+		SchoolID:  a.SchoolID,
+		Kind:      a.Kind,
+		OwnerID:   a.OwnerID,
+		Year:      a.Year,
+		Balance:   a.Balance,
+		CreatedAt: a.CreatedAt.Time,
+		UpdatedAt: a.UpdatedAt.Time,
+	}
+}
+
+func (repo WalletRepository) LockAccount(ctx context.Context, a wallet.Account, exec ...core.DBExecutor) (wallet.Account, error) {
+	exe := repo.getExec(exec)
+	now := time.Now().UTC()
+	_, err := exe.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, school_id, kind, owner_id, year, balance, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, $6)
+		ON CONFLICT (kind, owner_id, year) DO NOTHING`,
+		models.TableNames.WalletAccount,
+	), uuid.New().String(), a.SchoolID, a.Kind, a.OwnerID, a.Year, now)
+	if err != nil {
+		return wallet.Account{}, errors.Wrap(err, "inserting account")
+	}
+
+	m, err := models.WalletAccounts(
+		models.WalletAccountWhere.Kind.EQ(a.Kind),
+		models.WalletAccountWhere.OwnerID.EQ(a.OwnerID),
+		models.WalletAccountWhere.Year.EQ(a.Year),
+		qm.For("UPDATE"),
+	).One(ctx, exe)
+	return repo.unboilAccount(m), errors.Wrap(err, "locking account")
+}
+
+func (repo WalletRepository) QueryAccounts(ctx context.Context, f wallet.AccountFilter, exec ...core.DBExecutor) ([]wallet.Account, error) {
+	var mods []qm.QueryMod
+	if f.SchoolID != "" {
+		mods = append(mods, models.WalletAccountWhere.SchoolID.EQ(f.SchoolID))
+	}
+	if f.Kind != "" {
+		mods = append(mods, models.WalletAccountWhere.Kind.EQ(f.Kind))
+	}
+	if len(f.OwnerIDs) > 0 {
+		mods = append(mods, models.WalletAccountWhere.OwnerID.IN(f.OwnerIDs))
+	}
+	if f.Year != 0 {
+		mods = append(mods, models.WalletAccountWhere.Year.EQ(f.Year))
+	}
+	ms, err := models.WalletAccounts(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying accounts")
+	}
+	as := make([]wallet.Account, 0, len(ms))
+	for _, m := range ms {
+		as = append(as, repo.unboilAccount(m))
+	}
+	return as, nil
+}
+
+// ------------------------------------- Transaction --------------------------------------
+
+func (repo WalletRepository) unboilTransaction(t *models.WalletTransaction) wallet.Transaction {
+	if t == nil {
+		return wallet.Transaction{}
+	}
+	tx := wallet.Transaction{
+		ID:        t.ID,
+		SchoolID:  t.SchoolID,
+		Year:      t.Year,
+		Kind:      t.Kind,
+		CourseID:  t.CourseID.String,
+		RuleID:    t.RuleID.String,
+		Reference: t.Reference.String,
+		Memo:      t.Memo.String,
+		AuthorID:  t.AuthorID.String,
+		Entries:   []wallet.Entry{},
+		CreatedAt: t.CreatedAt.Time,
+	}
+	if t.R != nil {
+		for _, e := range t.R.TransactionWalletEntries {
+			tx.Entries = append(tx.Entries, wallet.Entry{
+				ID:        e.ID,
+				AccountID: e.AccountID,
+				Amount:    e.Amount,
+				CreatedAt: e.CreatedAt.Time,
+			})
+		}
+	}
+	return tx
+}
+
+func (repo WalletRepository) CreateTransaction(
+	ctx context.Context,
+	t wallet.Transaction,
+	exec ...core.DBExecutor,
+) (wallet.Transaction, bool, error) {
+	exe := repo.getExec(exec)
+	now := time.Now().UTC()
+	t.ID = uuid.New().String()
+
+	res, err := exe.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, school_id, year, kind, course_id, rule_id, reference, memo, author_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (reference) DO NOTHING`,
+		models.TableNames.WalletTransaction,
+	),
+		t.ID,
+		t.SchoolID,
+		t.Year,
+		t.Kind,
+		null.NewString(t.CourseID, t.CourseID != ""),
+		null.NewString(t.RuleID, t.RuleID != ""),
+		null.NewString(t.Reference, t.Reference != ""),
+		null.NewString(t.Memo, t.Memo != ""),
+		null.NewString(t.AuthorID, t.AuthorID != ""),
+		now,
+	)
+	if err != nil {
+		return wallet.Transaction{}, false, errors.Wrap(err, "inserting transaction")
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return wallet.Transaction{}, false, errors.Wrap(err, "inserting transaction")
+	}
+
+	for _, e := range t.Entries {
+		m := &models.WalletEntry{ID: uuid.New().String(), TransactionID: t.ID, AccountID: e.AccountID, Amount: e.Amount}
+		if err = m.Insert(ctx, exe, boil.Infer()); err != nil {
+			return wallet.Transaction{}, false, errors.Wrap(err, "inserting entry")
+		}
+		_, err = exe.ExecContext(ctx, fmt.Sprintf(
+			`UPDATE %s SET balance = balance + $1, updated_at = $2 WHERE id = $3`,
+			models.TableNames.WalletAccount,
+		), e.Amount, now, e.AccountID)
+		if err != nil {
+			return wallet.Transaction{}, false, errors.Wrap(err, "updating balance")
+		}
+	}
+
+	m, err := models.WalletTransactions(
+		models.WalletTransactionWhere.ID.EQ(t.ID),
+		qm.Load(models.WalletTransactionRels.TransactionWalletEntries),
+	).One(ctx, exe)
+	return repo.unboilTransaction(m), err == nil, errors.Wrap(err, "finding transaction")
+}
+
+func (repo WalletRepository) QueryTransactions(
+	ctx context.Context,
+	f wallet.TransactionFilter,
+	exec ...core.DBExecutor,
+) ([]wallet.Transaction, error) {
+	mods := []qm.QueryMod{
+		qm.Load(models.WalletTransactionRels.TransactionWalletEntries),
+		qm.OrderBy(models.WalletTransactionColumns.CreatedAt + " DESC"),
+	}
+	if f.AccountID != "" {
+		mods = append(mods, qm.Where(fmt.Sprintf(
+			"%s IN (SELECT transaction_id FROM %s WHERE account_id = ?)",
+			models.WalletTransactionColumns.ID, models.TableNames.WalletEntry,
+		), f.AccountID))
+	}
+	if f.CourseID != "" {
+		mods = append(mods, models.WalletTransactionWhere.CourseID.EQ(null.StringFrom(f.CourseID)))
+	}
+	if f.Kind != "" {
+		mods = append(mods, models.WalletTransactionWhere.Kind.EQ(f.Kind))
+	}
+	if f.Limit > 0 {
+		mods = append(mods, qm.Limit(f.Limit))
+	}
+	ms, err := models.WalletTransactions(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying transactions")
+	}
+	ts := make([]wallet.Transaction, 0, len(ms))
+	for _, m := range ms {
+		ts = append(ts, repo.unboilTransaction(m))
+	}
+	return ts, nil
+}
+
+// ----------------------------------------- Rule -----------------------------------------
+
+func (repo WalletRepository) unboilRule(r *models.WalletRule) wallet.Rule {
+	if r == nil {
+		return wallet.Rule{}
+	}
+	return wallet.Rule{
+		ID:         r.ID,
+		CourseID:   r.CourseID,
+		Year:       r.Year,
+		Name:       r.Name,
+		Event:      r.Event,
+		TargetID:   r.TargetID.String,
+		MinPercent: r.MinPercent,
+		Points:     r.Points,
+		CreatedAt:  r.CreatedAt.Time,
+		UpdatedAt:  r.UpdatedAt.Time,
+	}
+}
+
+func (repo WalletRepository) CreateRule(ctx context.Context, r wallet.Rule, exec ...core.DBExecutor) (wallet.Rule, error) {
+	m := &models.WalletRule{
+		ID:         uuid.New().String(),
+		CourseID:   r.CourseID,
+		Year:       r.Year,
+		Name:       r.Name,
+		Event:      r.Event,
+		TargetID:   null.NewString(r.TargetID, r.TargetID != ""),
+		MinPercent: r.MinPercent,
+		Points:     r.Points,
+	}
+	if err := m.Insert(ctx, repo.getExec(exec), boil.Infer()); err != nil {
+		return wallet.Rule{}, errors.Wrap(err, "inserting rule")
+	}
+	return repo.unboilRule(m), nil
+}
+
+func (repo WalletRepository) QueryRules(ctx context.Context, f wallet.RuleFilter, exec ...core.DBExecutor) ([]wallet.Rule, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.WalletRuleColumns.CreatedAt)}
+	if f.CourseID != "" {
+		mods = append(mods, models.WalletRuleWhere.CourseID.EQ(f.CourseID))
+	}
+	if f.Year != 0 {
+		mods = append(mods, models.WalletRuleWhere.Year.EQ(f.Year))
+	}
+	if f.Event != "" {
+		mods = append(mods, models.WalletRuleWhere.Event.EQ(f.Event))
+	}
+	ms, err := models.WalletRules(mods...).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying rules")
+	}
+	rs := make([]wallet.Rule, 0, len(ms))
+	for _, m := range ms {
+		rs = append(rs, repo.unboilRule(m))
+	}
+	return rs, nil
+}
+
+func (repo WalletRepository) GetRule(ctx context.Context, id string, exec ...core.DBExecutor) (wallet.Rule, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return wallet.Rule{}, wallet.ErrRuleNotFound
+	}
+	m, err := models.FindWalletRule(ctx, repo.getExec(exec), id)
+	if err != nil {
+		return wallet.Rule{}, repo.trapNoRowsErr(err, wallet.ErrRuleNotFound, "finding rule")
+	}
+	return repo.unboilRule(m), nil
+}
+
+func (repo WalletRepository) DeleteRule(ctx context.Context, id string, exec ...core.DBExecutor) error {
+	_, err := models.WalletRules(models.WalletRuleWhere.ID.EQ(id)).DeleteAll(ctx, repo.getExec(exec))
+	return errors.Wrap(err, "deleting rule")
+}
+
+// ---------------------------------------- Award -----------------------------------------
+
+func (repo WalletRepository) unboilAward(a *models.WalletAward) wallet.Award {
+	if a == nil {
+		return wallet.Award{}
+	}
+	return wallet.Award{
+		ID:        a.ID,
+		SchoolID:  a.SchoolID,
+		Year:      a.Year,
+		Scope:     a.Scope,
+		ScopeID:   a.ScopeID,
+		Rank:      a.Rank,
+		StudentID: a.StudentID,
+		ClassID:   a.ClassID,
+		Points:    a.Points,
+		Average:   a.Average,
+		CreatedAt: a.CreatedAt.Time,
+	}
+}
+
+func (repo WalletRepository) ReplaceAwards(
+	ctx context.Context,
+	schoolID string,
+	year int,
+	awards []wallet.Award,
+	exec ...core.DBExecutor,
+) ([]wallet.Award, error) {
+	exe := repo.getExec(exec)
+	_, err := models.WalletAwards(
+		models.WalletAwardWhere.SchoolID.EQ(schoolID),
+		models.WalletAwardWhere.Year.EQ(year),
+	).DeleteAll(ctx, exe)
+	if err != nil {
+		return nil, errors.Wrap(err, "deleting awards")
+	}
+	saved := make([]wallet.Award, 0, len(awards))
+	for _, a := range awards {
+		m := &models.WalletAward{
+			ID:        uuid.New().String(),
+			SchoolID:  schoolID,
+			Year:      year,
+			Scope:     a.Scope,
+			ScopeID:   a.ScopeID,
+			Rank:      a.Rank,
+			StudentID: a.StudentID,
+			ClassID:   a.ClassID,
+			Points:    a.Points,
+			Average:   a.Average,
+		}
+		if err = m.Insert(ctx, exe, boil.Infer()); err != nil {
+			return nil, errors.Wrap(err, "inserting award")
+		}
+		saved = append(saved, repo.unboilAward(m))
+	}
+	return saved, nil
+}
+
+func (repo WalletRepository) QueryAwards(ctx context.Context, schoolID string, year int, exec ...core.DBExecutor) ([]wallet.Award, error) {
+	ms, err := models.WalletAwards(
+		models.WalletAwardWhere.SchoolID.EQ(schoolID),
+		models.WalletAwardWhere.Year.EQ(year),
+		qm.OrderBy(fmt.Sprintf("%s, %s, %s",
+			models.WalletAwardColumns.Scope, models.WalletAwardColumns.ScopeID, models.WalletAwardColumns.Rank)),
+	).All(ctx, repo.getExec(exec))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying awards")
+	}
+	as := make([]wallet.Award, 0, len(ms))
+	for _, m := range ms {
+		as = append(as, repo.unboilAward(m))
+	}
+	return as, nil
+}
@@ -13,12 +13,14 @@ TODO: "github.com/pkg/errors" !!! for context & error stack
 FE: Material Design | PrimeVue | mixture etc..
 	- Admin Site
 		* manage everything
-		* assign admins roles
+		* assign admins roles; custom school roles of named permissions (core/rbac: `/api/schools/:id/roles`)
+		* invite guardians of students (core/guardian: signup link by email)
 		* assign courses to teachers
 		* manage students & assign them to classes
 	- Public Site
 		* Teacher Dashboard
 		* Student Dashboard
+		* Guardian Dashboard: children's published marks, attendance, announcements & due dates (read-only)
 	- avatar: https://github.com/JiriChara/vue-gravatar
 
 ------------------------------------ Version X ----------------------------------------
@@ -26,9 +28,9 @@ FIXME:Edge-case:
 - User belongs to multiple Schools ??? like Teachers ???: 1 User -> diff Teacher per School (creds per School then!!??)
 - Student moving to another School ???: same as above + deactivate prev Student??..
 
-TODO: Subscription Model
+Subscription Model: core/subscription (trialing -> active -> past_due -> expired)
 - Trial Model:
-	* TODO (trial expiry dates..)
+	* trial expiry: School creation + `trialPeriod` (30 days by default); then same as below
 - Model:
 	* when payment made:
 		- paymentDueDate = now + 1 Year (variable: Y | M)
@@ -37,12 +39,13 @@ TODO: Subscription Model
 		- paymentDueDate reached: exponential notifications until payment is made | paymentExtensionDate
 		- paymentExtensionDate reached: deactivate School
 	* NAME IT 💰💰💰 (Y & M costs). Notify owners onPriceChange
-- cmds:
+- cmds (admin CLI):
 	* newPayment: - MANUAL...
 		- update dates
 		- send thank you notification to owner
 	* paymentWarning (periodic - exponential): exponential notifications until payment is made | paymentExtensionDate
 	* deactivateSchool (daily): deactivate if paymentExtensionDate reached
+	* both scheduled by the sidecar (apps/sidecar): `admin jobs list|run|cancel`
 - if Inactive and user logs in display eg. "School Unavailable!"
 
  TODO: Calendar
@@ -63,18 +66,19 @@ TODO: Subscription Model
 			- Tutorial: not affected; can be retaken as many times as possible. TODO: show marks history ??
 			- Assignment: can be retaken as many times as possible (but grades won't update after due date)
 			- show work due soon (2 weeks window) in student home page
-		* TODO: Virtual Wallet: (AaaG: Assessment as a Game)
+		* Virtual Wallet: (AaaG: Assessment as a Game) core/wallet (double-entry ledger: School -> Course -> Student)
 			- Student earns points when they meet criteria
 			  set by Teacher based on allocated budget for the Course by Admin
 			- Admin allocate budget for each Course every year
 			  and reward the `n top richest Students among those with >=65% in final` of each class at the end of the year
 			  and reward the best student of each category even more: `YearLevel`, `Department`, `School`
+			- awards computed by `admin walletawards` once the final marks are in
 
  TODO: Marks
 	- Virtual:
 		* automatically updated by Virtual Tutorials & Assignments
 		* Assignments can be considered for Official Marks by Teacher
-	- Official (sync with Physical): to be manually updated by Teacher (TODO: CSV import?)
+	- Official (sync with Physical): to be manually updated by Teacher, or imported from CSV | XLSX
 		* Admin controls when new Marks will be viewable by Students
 
  TODO: Communication
@@ -86,10 +90,12 @@ TODO: Subscription Model
  		* Course ChatRoom:
  			- General: all Students + Teacher (admin)
  			- Per Topic OR Group work: all | group of Students + Teacher (admin)
- 	- TODO: Notifications:
+ 	- Notifications: in-app inbox (live stream), email & web push; channels chosen by Users per event
  		* Announcements
- 		*
+ 		* Marks published
+ 	- SMS (per-School sender ID & monthly quota): password resets, absence alerts, Announcements
  	- TODO: Video conferencing (Live courses)
+ 	- Calendar: School holidays & exams, Course events, Terms & due dates; iCalendar feed per User
 
 TODO: `Class`
 	- copy new class for every year: